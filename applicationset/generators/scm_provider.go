@@ -93,8 +93,12 @@ func (e ErrDisallowedSCMProvider) Error() string {
 }
 
 func ScmProviderAllowed(applicationSetInfo *argoprojiov1alpha1.ApplicationSet, generator SCMGeneratorWithCustomApiUrl, allowedScmProviders []string) error {
-	url := generator.CustomApiUrl()
+	return scmProviderURLAllowed(applicationSetInfo, generator.CustomApiUrl(), allowedScmProviders)
+}
 
+// scmProviderURLAllowed returns an error if the given URL of an SCM provider is not in the list of allowed SCM
+// providers
+func scmProviderURLAllowed(applicationSetInfo *argoprojiov1alpha1.ApplicationSet, url string, allowedScmProviders []string) error {
 	if url == "" || len(allowedScmProviders) == 0 {
 		return nil
 	}
@@ -133,6 +137,10 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	if err := ScmProviderAllowed(applicationSetInfo, providerConfig, g.allowedSCMProviders); err != nil {
 		return nil, fmt.Errorf("scm provider not allowed: %w", err)
 	}
+	// The token provider receives the service account token of the controller, so it is restricted in the same way
+	if err := scmProviderURLAllowed(applicationSetInfo, providerConfig.TokenProviderURL(), g.allowedSCMProviders); err != nil {
+		return nil, fmt.Errorf("scm provider token provider not allowed: %w", err)
+	}
 
 	ctx := context.Background()
 	var provider scm_provider.SCMProviderService
@@ -154,13 +162,21 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", scmError)
 			}
 		}
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching Gitlab token: %w", err)
+		if providerConfig.TokenProvider != nil {
+			token, err := providerConfig.TokenProvider.GetTokenProvider().Token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Gitlab token from token provider: %w", err)
+			}
+			provider, scmError = scm_provider.NewGitlabProviderOAuthToken(ctx, providerConfig.Group, token, providerConfig.API, providerConfig.AllBranches, providerConfig.IncludeSubgroups, providerConfig.WillIncludeSharedProjects(), providerConfig.Insecure, g.scmRootCAPath, providerConfig.Topic, caCerts)
+		} else {
+			token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Gitlab token: %w", err)
+			}
+			provider, scmError = scm_provider.NewGitlabProvider(ctx, providerConfig.Group, token, providerConfig.API, providerConfig.AllBranches, providerConfig.IncludeSubgroups, providerConfig.WillIncludeSharedProjects(), providerConfig.Insecure, g.scmRootCAPath, providerConfig.Topic, caCerts)
 		}
-		provider, err = scm_provider.NewGitlabProvider(ctx, providerConfig.Group, token, providerConfig.API, providerConfig.AllBranches, providerConfig.IncludeSubgroups, providerConfig.WillIncludeSharedProjects(), providerConfig.Insecure, g.scmRootCAPath, providerConfig.Topic, caCerts)
-		if err != nil {
-			return nil, fmt.Errorf("error initializing Gitlab service: %w", err)
+		if scmError != nil {
+			return nil, fmt.Errorf("error initializing Gitlab service: %w", scmError)
 		}
 	} else if providerConfig.Gitea != nil {
 		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.Gitea.TokenRef, applicationSetInfo.Namespace)
@@ -181,7 +197,13 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", scmError)
 			}
 		}
-		if providerConfig.BearerToken != nil {
+		if providerConfig.TokenProvider != nil {
+			token, err := providerConfig.TokenProvider.GetTokenProvider().Token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Bitbucket Server token from token provider: %w", err)
+			}
+			provider, scmError = scm_provider.NewBitbucketServerProviderBearerToken(ctx, token, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts)
+		} else if providerConfig.BearerToken != nil {
 			appToken, err := utils.GetSecretRef(ctx, g.client, providerConfig.BearerToken.TokenRef, applicationSetInfo.Namespace)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret Bearer token: %w", err)
@@ -200,13 +222,23 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			return nil, fmt.Errorf("error initializing Bitbucket Server service: %w", scmError)
 		}
 	} else if providerConfig.AzureDevOps != nil {
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.AzureDevOps.AccessTokenRef, applicationSetInfo.Namespace)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching Azure Devops access token: %w", err)
+		providerConfig := providerConfig.AzureDevOps
+		var scmError error
+		if providerConfig.TokenProvider != nil {
+			token, err := providerConfig.TokenProvider.GetTokenProvider().Token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Azure Devops token from token provider: %w", err)
+			}
+			provider, scmError = scm_provider.NewAzureDevOpsProviderBearerToken(ctx, token, providerConfig.Organization, providerConfig.API, providerConfig.TeamProject, providerConfig.AllBranches)
+		} else {
+			token, err := utils.GetSecretRef(ctx, g.client, providerConfig.AccessTokenRef, applicationSetInfo.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Azure Devops access token: %w", err)
+			}
+			provider, scmError = scm_provider.NewAzureDevOpsProvider(ctx, token, providerConfig.Organization, providerConfig.API, providerConfig.TeamProject, providerConfig.AllBranches)
 		}
-		provider, err = scm_provider.NewAzureDevOpsProvider(ctx, token, providerConfig.AzureDevOps.Organization, providerConfig.AzureDevOps.API, providerConfig.AzureDevOps.TeamProject, providerConfig.AzureDevOps.AllBranches)
-		if err != nil {
-			return nil, fmt.Errorf("error initializing Azure Devops service: %w", err)
+		if scmError != nil {
+			return nil, fmt.Errorf("error initializing Azure Devops service: %w", scmError)
 		}
 	} else if providerConfig.Bitbucket != nil {
 		appPassword, err := utils.GetSecretRef(ctx, g.client, providerConfig.Bitbucket.AppPasswordRef, applicationSetInfo.Namespace)
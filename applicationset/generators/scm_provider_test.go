@@ -177,6 +177,33 @@ func TestAllowedSCMProvider(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Error Gitlab token provider",
+			providerConfig: &argoprojiov1alpha1.SCMProviderGenerator{
+				Gitlab: &argoprojiov1alpha1.SCMProviderGeneratorGitlab{
+					API:           "gitlab.myorg.com",
+					TokenProvider: &argoprojiov1alpha1.SCMProviderGeneratorTokenProvider{URL: "https://myservice.mynamespace.svc.cluster.local"},
+				},
+			},
+		},
+		{
+			name: "Error Bitbucket token provider",
+			providerConfig: &argoprojiov1alpha1.SCMProviderGenerator{
+				BitbucketServer: &argoprojiov1alpha1.SCMProviderGeneratorBitbucketServer{
+					API:           "bitbucket.myorg.com",
+					TokenProvider: &argoprojiov1alpha1.SCMProviderGeneratorTokenProvider{URL: "https://myservice.mynamespace.svc.cluster.local"},
+				},
+			},
+		},
+		{
+			name: "Error AzureDevops token provider",
+			providerConfig: &argoprojiov1alpha1.SCMProviderGenerator{
+				AzureDevOps: &argoprojiov1alpha1.SCMProviderGeneratorAzureDevOps{
+					API:           "azuredevops.myorg.com",
+					TokenProvider: &argoprojiov1alpha1.SCMProviderGeneratorTokenProvider{URL: "https://myservice.mynamespace.svc.cluster.local"},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -72,6 +72,24 @@ func NewAzureDevOpsProvider(ctx context.Context, accessToken string, org string,
 	return &AzureDevOpsProvider{organization: org, teamProject: project, accessToken: accessToken, clientFactory: &devopsFactoryImpl{connection: connection}, allBranches: allBranches}, nil
 }
 
+// NewAzureDevOpsProviderBearerToken creates an Azure DevOps provider which authenticates with a bearer token, such as
+// a Microsoft Entra ID access token obtained from a token provider, rather than a personal access token.
+func NewAzureDevOpsProviderBearerToken(ctx context.Context, bearerToken string, org string, url string, project string, allBranches bool) (*AzureDevOpsProvider, error) {
+	if bearerToken == "" {
+		return nil, fmt.Errorf("no bearer token provided")
+	}
+
+	devOpsURL, err := getValidDevOpsURL(url, org)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := azuredevops.NewAnonymousConnection(devOpsURL)
+	connection.AuthorizationString = "Bearer " + bearerToken
+
+	return &AzureDevOpsProvider{organization: org, teamProject: project, accessToken: bearerToken, clientFactory: &devopsFactoryImpl{connection: connection}, allBranches: allBranches}, nil
+}
+
 func (g *AzureDevOpsProvider) ListRepos(ctx context.Context, cloneProtocol string) ([]*Repository, error) {
 	gitClient, err := g.clientFactory.GetClient(ctx)
 	if err != nil {
@@ -29,6 +29,19 @@ func NewGitlabProvider(ctx context.Context, organization string, token string, u
 	if token == "" {
 		token = os.Getenv("GITLAB_TOKEN")
 	}
+	return newGitlabProvider(gitlab.NewClient, organization, token, url, allBranches, includeSubgroups, includeSharedProjects, insecure, scmRootCAPath, topic, caCerts)
+}
+
+// NewGitlabProviderOAuthToken creates a GitLab provider which authenticates with an OAuth access token, such as one
+// obtained from a token provider, rather than a personal access token.
+func NewGitlabProviderOAuthToken(ctx context.Context, organization string, token string, url string, allBranches, includeSubgroups, includeSharedProjects, insecure bool, scmRootCAPath, topic string, caCerts []byte) (*GitlabProvider, error) {
+	if token == "" {
+		return nil, fmt.Errorf("no OAuth token provided")
+	}
+	return newGitlabProvider(gitlab.NewOAuthClient, organization, token, url, allBranches, includeSubgroups, includeSharedProjects, insecure, scmRootCAPath, topic, caCerts)
+}
+
+func newGitlabProvider(newClient func(token string, options ...gitlab.ClientOptionFunc) (*gitlab.Client, error), organization string, token string, url string, allBranches, includeSubgroups, includeSharedProjects, insecure bool, scmRootCAPath, topic string, caCerts []byte) (*GitlabProvider, error) {
 	var client *gitlab.Client
 
 	tr := http.DefaultTransport.(*http.Transport).Clone()
@@ -39,13 +52,13 @@ func NewGitlabProvider(ctx context.Context, organization string, token string, u
 
 	if url == "" {
 		var err error
-		client, err = gitlab.NewClient(token, gitlab.WithHTTPClient(retryClient.HTTPClient))
+		client, err = newClient(token, gitlab.WithHTTPClient(retryClient.HTTPClient))
 		if err != nil {
 			return nil, err
 		}
 	} else {
 		var err error
-		client, err = gitlab.NewClient(token, gitlab.WithBaseURL(url), gitlab.WithHTTPClient(retryClient.HTTPClient))
+		client, err = newClient(token, gitlab.WithBaseURL(url), gitlab.WithHTTPClient(retryClient.HTTPClient))
 		if err != nil {
 			return nil, err
 		}
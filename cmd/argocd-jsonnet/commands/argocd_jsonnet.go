@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/util/jsonnet"
+)
+
+// NewCommand returns the command which evaluates Jsonnet files in child processes of the repo-server, so that the
+// evaluation can be stopped once it exceeds a resource limit
+func NewCommand() *cobra.Command {
+	command := cobra.Command{
+		Use:               jsonnet.BinaryName,
+		Short:             "Argo CD Jsonnet evaluator",
+		DisableAutoGenTag: true,
+		// The arguments only identify the evaluated file in logs
+		DisableFlagParsing: true,
+		Run: func(c *cobra.Command, args []string) {
+			jsonnet.RunChildProcess()
+		},
+	}
+
+	return &command
+}
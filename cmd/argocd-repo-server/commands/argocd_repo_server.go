@@ -30,6 +30,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
@@ -76,6 +77,14 @@ func NewCommand() *cobra.Command {
 		disableManifestMaxExtractedSize   bool
 		includeHiddenDirectories          bool
 		cmpUseManifestGeneratePaths       bool
+		gitWorktreesMax                   int
+		gitWorktreesMaxSize               string
+		manifestGenerationTimeout         time.Duration
+		manifestGenerationCPUTimeLimit    time.Duration
+		manifestGenerationMemoryLimit     string
+		manifestGenerationMaxOutputSize   string
+		manifestGenerationCgroup          string
+		jsonnetMaxStack                   int
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -120,6 +129,15 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			gitWorktreesMaxSizeQuantity, err := resource.ParseQuantity(gitWorktreesMaxSize)
+			errors.CheckError(err)
+
+			manifestGenerationMemoryLimitQuantity, err := resource.ParseQuantity(manifestGenerationMemoryLimit)
+			errors.CheckError(err)
+
+			manifestGenerationMaxOutputSizeQuantity, err := resource.ParseQuantity(manifestGenerationMaxOutputSize)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer)
@@ -138,6 +156,16 @@ func NewCommand() *cobra.Command {
 				HelmRegistryMaxIndexSize:                     helmRegistryMaxIndexSizeQuantity.ToDec().Value(),
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
+				GitWorktreesMax:                              gitWorktreesMax,
+				GitWorktreesMaxSize:                          gitWorktreesMaxSizeQuantity.ToDec().Value(),
+				ManifestGenerationLimits: executil.ResourceLimits{
+					Timeout:    manifestGenerationTimeout,
+					CPUTime:    manifestGenerationCPUTimeLimit,
+					Memory:     manifestGenerationMemoryLimitQuantity.ToDec().Value(),
+					OutputSize: manifestGenerationMaxOutputSizeQuantity.ToDec().Value(),
+					Cgroup:     manifestGenerationCgroup,
+				},
+				JsonnetMaxStack: jsonnetMaxStack,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -244,6 +272,14 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
+	command.Flags().IntVar(&gitWorktreesMax, "git-worktrees-max", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_GIT_WORKTREES_MAX", 0, 0, math.MaxInt32), "Maximum number of Git working trees of revisions. If greater than zero, revisions are checked out in separate working trees, so that different revisions of a repository can be processed concurrently.")
+	command.Flags().StringVar(&gitWorktreesMaxSize, "git-worktrees-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_GIT_WORKTREES_MAX_SIZE", "0"), "Maximum total size of the Git working trees of revisions. Zero means no limit.")
+	command.Flags().DurationVar(&manifestGenerationTimeout, "manifest-generation-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_TIMEOUT", 0, 0, math.MaxInt64), "Maximum wall-clock time of the processes run to generate the manifests of an application. Zero means no limit.")
+	command.Flags().DurationVar(&manifestGenerationCPUTimeLimit, "manifest-generation-cpu-time-limit", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_CPU_TIME_LIMIT", 0, 0, math.MaxInt64), "Maximum CPU time of each process run to generate manifests, such as helm or kustomize. Only applied on Linux. Zero means no limit.")
+	command.Flags().StringVar(&manifestGenerationMemoryLimit, "manifest-generation-memory-limit", env.StringFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_MEMORY_LIMIT", "0"), "Maximum address space of each process run to generate manifests, or the maximum memory of all processes of a manifest generation if --manifest-generation-cgroup is set. Only applied on Linux. Zero means no limit.")
+	command.Flags().StringVar(&manifestGenerationMaxOutputSize, "manifest-generation-max-output-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_MAX_OUTPUT_SIZE", "0"), "Maximum size of the output of each process run to generate manifests, and of Jsonnet evaluations. Zero means no limit.")
+	command.Flags().StringVar(&manifestGenerationCgroup, "manifest-generation-cgroup", env.StringFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_CGROUP", ""), "Path to a cgroup v2 directory delegated to the repo server. If set, the processes of each manifest generation are run in a separate child cgroup.")
+	command.Flags().IntVar(&jsonnetMaxStack, "jsonnet-max-stack", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_JSONNET_MAX_STACK", 500, 1, math.MaxInt32), "Maximum stack depth of Jsonnet evaluations")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
@@ -26,7 +26,10 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	"github.com/argoproj/argo-cd/v2/server"
+	"github.com/argoproj/argo-cd/v2/server/audit"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/ratelimit"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -95,6 +98,13 @@ func NewCommand() *cobra.Command {
 
 		// argocd k8s event logging flag
 		enableK8sEvent []string
+
+		// audit log
+		auditLog audit.Config
+		// terminal session recording
+		terminalRecording recording.Config
+		// API rate limits of method groups
+		rateLimits map[string]string
 	)
 	command := &cobra.Command{
 		Use:               cliName,
@@ -204,6 +214,9 @@ func NewCommand() *cobra.Command {
 				contentTypesList = strings.Split(contentTypes, ";")
 			}
 
+			parsedRateLimits, err := ratelimit.ParseLimits(rateLimits)
+			errors.CheckError(err)
+
 			argoCDOpts := server.ArgoCDServerOpts{
 				Insecure:                insecure,
 				ListenPort:              listenPort,
@@ -234,6 +247,9 @@ func NewCommand() *cobra.Command {
 				EnableProxyExtension:    enableProxyExtension,
 				WebhookParallelism:      webhookParallelism,
 				EnableK8sEvent:          enableK8sEvent,
+				AuditLog:                auditLog,
+				TerminalRecording:       terminalRecording,
+				RateLimits:              parsedRateLimits,
 			}
 
 			appsetOpts := server.ApplicationSetOpts{
@@ -309,6 +325,15 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&enableProxyExtension, "enable-proxy-extension", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_PROXY_EXTENSION", false), "Enable Proxy Extension feature")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_SERVER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
+	command.Flags().StringVar(&auditLog.File, "audit-log-file", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_FILE", ""), "Path of a file the audit records of mutating API calls are appended to as JSON lines")
+	command.Flags().StringVar(&auditLog.SyslogAddress, "audit-log-syslog-address", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_SYSLOG_ADDRESS", ""), "Address of a syslog server the audit records of mutating API calls are sent to (e.g. udp://syslog:514), or `local` for the local syslog daemon")
+	command.Flags().StringVar(&auditLog.WebhookURL, "audit-log-webhook-url", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_WEBHOOK_URL", ""), "URL the audit records of mutating API calls are posted to as JSON arrays")
+	command.Flags().StringToStringVar(&auditLog.WebhookHeaders, "audit-log-webhook-headers", env.ParseStringToStringFromEnv("ARGOCD_SERVER_AUDIT_LOG_WEBHOOK_HEADERS", map[string]string{}, ","), "List of headers sent with audit webhook requests, headers are comma-separated key-value pairs(e.g. key1=value1,key2=value2)")
+	command.Flags().IntVar(&auditLog.BufferSize, "audit-log-buffer-size", env.ParseNumFromEnv("ARGOCD_SERVER_AUDIT_LOG_BUFFER_SIZE", audit.DefaultBufferSize, 1, math.MaxInt32), "Number of audit records buffered per sink. Records are dropped if the buffer of a sink is full")
+	command.Flags().StringVar(&terminalRecording.Location, "terminal-recording-location", env.StringFromEnv("ARGOCD_SERVER_TERMINAL_RECORDING_LOCATION", ""), "Location web terminal sessions are recorded to, either a local directory or an S3 bucket with an optional key prefix (e.g. s3://bucket/prefix). Sessions are not recorded if it is empty")
+	command.Flags().StringVar(&terminalRecording.S3Endpoint, "terminal-recording-s3-endpoint", env.StringFromEnv("ARGOCD_SERVER_TERMINAL_RECORDING_S3_ENDPOINT", ""), "Endpoint of the S3-compatible object store terminal sessions are recorded to")
+	command.Flags().StringVar(&terminalRecording.S3Region, "terminal-recording-s3-region", env.StringFromEnv("ARGOCD_SERVER_TERMINAL_RECORDING_S3_REGION", ""), "Region of the S3 bucket terminal sessions are recorded to")
+	command.Flags().StringToStringVar(&rateLimits, "rate-limits", env.ParseStringToStringFromEnv("ARGOCD_SERVER_RATE_LIMITS", map[string]string{}, ","), "Rate limits of the read, write and refresh API method groups, enforced per user and token. Limits are comma-separated group=rate[:burst] pairs, where rate is the number of calls per second (e.g. read=20:40,write=5:10,refresh=1:5)")
 
 	// Flags related to the applicationSet component.
 	command.Flags().StringVar(&scmRootCAPath, "appset-scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
@@ -20,6 +20,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
@@ -58,6 +59,9 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountRotateTokenCommand(clientOpts))
+	command.AddCommand(NewAccountListSessionsCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeSessionsCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
 }
@@ -202,7 +206,8 @@ func NewAccountGetUserInfoCommand(clientOpts *argocdclient.ClientOptions) *cobra
 }
 
 func NewAccountCanICommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	return &cobra.Command{
+	var explain bool
+	command := &cobra.Command{
 		Use:   "can-i ACTION RESOURCE SUBRESOURCE",
 		Short: "Can I",
 		Example: fmt.Sprintf(`
@@ -215,6 +220,9 @@ argocd account can-i update projects 'default'
 # Can I create a cluster?
 argocd account can-i create clusters '*'
 
+# Why can I sync the app 'guestbook' in the 'default' project?
+argocd account can-i sync applications 'default/guestbook' --explain
+
 Actions: %v
 Resources: %v
 `, rbacpolicy.Actions, rbacpolicy.Resources),
@@ -233,11 +241,36 @@ Resources: %v
 				Action:      args[0],
 				Resource:    args[1],
 				Subresource: args[2],
+				Explain:     explain,
 			})
 			errors.CheckError(err)
 			fmt.Println(response.Value)
+			if response.Explanation != nil {
+				printCanIExplanation(response.Explanation)
+			}
 		},
 	}
+	command.Flags().BoolVar(&explain, "explain", false, "Explain the result by printing the matching policy lines, their source and the role assignments used")
+	return command
+}
+
+func printCanIExplanation(exp *accountpkg.CanIExplanation) {
+	for _, subject := range exp.Subjects {
+		result := "denied"
+		if subject.Allowed {
+			result = "allowed"
+		}
+		fmt.Printf("%s (%s): %s\n", subject.Subject, subject.Origin, result)
+		for _, grouping := range subject.Groupings {
+			fmt.Printf("  grouping [%s] %s\n", grouping.Source, grouping.Line)
+		}
+		for _, policy := range subject.Policies {
+			fmt.Printf("  policy   [%s] %s\n", policy.Source, policy.Line)
+		}
+		if len(subject.Policies) == 0 {
+			fmt.Printf("  no matching policy\n")
+		}
+	}
 }
 
 func printAccountNames(accounts []*accountpkg.Account) {
@@ -350,7 +383,7 @@ func printAccountDetails(acc *accountpkg.Account) {
 		fmt.Println("NONE")
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
+		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tLAST USED\tSCOPE\n")
 		for _, t := range acc.Tokens {
 			expiresAtFormatted := "never"
 			if t.ExpiresAt > 0 {
@@ -361,17 +394,41 @@ func printAccountDetails(acc *accountpkg.Account) {
 				}
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+			lastUsedFormatted := "never"
+			if t.LastUsedAt > 0 {
+				lastUsedFormatted = time.Unix(t.LastUsedAt, 0).Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, lastUsedFormatted, formatTokenScope(t.Scope))
 		}
 		_ = w.Flush()
 	}
 }
 
+// formatTokenScope returns a description of the permissions a token is restricted to
+func formatTokenScope(scope *v1alpha1.TokenScope) string {
+	if scope.IsEmpty() {
+		return "-"
+	}
+	var items []string
+	if len(scope.Projects) > 0 {
+		items = append(items, "projects="+strings.Join(scope.Projects, ","))
+	}
+	if len(scope.Resources) > 0 {
+		items = append(items, "resources="+strings.Join(scope.Resources, ","))
+	}
+	if len(scope.Actions) > 0 {
+		items = append(items, "actions="+strings.Join(scope.Actions, ","))
+	}
+	return strings.Join(items, " ")
+}
+
 func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		account   string
 		expiresIn string
 		id        string
+		scope     v1alpha1.TokenScope
 	)
 	cmd := &cobra.Command{
 		Use:   "generate-token",
@@ -380,7 +437,10 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 argocd account generate-token
 
 # Generate token for the account with the specified name
-argocd account generate-token --account <account-name>`,
+argocd account generate-token --account <account-name>
+
+# Generate token which can only get and sync applications of the project my-project
+argocd account generate-token --scope-projects my-project --scope-resources applications --scope-actions get,sync`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -396,6 +456,7 @@ argocd account generate-token --account <account-name>`,
 				Name:      account,
 				ExpiresIn: int64(expiresIn.Seconds()),
 				Id:        id,
+				Scope:     &scope,
 			})
 			errors.CheckError(err)
 			fmt.Println(response.Token)
@@ -404,6 +465,59 @@ argocd account generate-token --account <account-name>`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fall back to uuid if not value specified.")
+	cmd.Flags().StringSliceVar(&scope.Projects, "scope-projects", []string{}, "Restrict the token to the projects. Requests for resources outside of projects are denied.")
+	cmd.Flags().StringSliceVar(&scope.Resources, "scope-resources", []string{}, "Restrict the token to the RBAC resources, e.g. applications")
+	cmd.Flags().StringSliceVar(&scope.Actions, "scope-actions", []string{}, "Restrict the token to the RBAC actions, e.g. get or sync")
+	return cmd
+}
+
+func NewAccountRotateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account     string
+		expiresIn   string
+		gracePeriod string
+	)
+	cmd := &cobra.Command{
+		Use:   "rotate-token ID",
+		Short: "Replace account token with a new token",
+		Long:  "Replace account token with a new token with the same scope. The replaced token remains valid during the grace period.",
+		Example: `# Replace token of the currently logged in account, keeping the replaced token valid for an hour
+argocd account rotate-token ID
+
+# Replace token of the account with the specified name and revoke the replaced token immediately
+argocd account rotate-token --account <account-name> --grace-period 0s ID`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			id := args[0]
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			expiresIn, err := timeutil.ParseDuration(expiresIn)
+			errors.CheckError(err)
+			gracePeriod, err := timeutil.ParseDuration(gracePeriod)
+			errors.CheckError(err)
+			response, err := client.RotateToken(ctx, &accountpkg.RotateTokenRequest{
+				Name:        account,
+				Id:          id,
+				ExpiresIn:   int64(expiresIn.Seconds()),
+				GracePeriod: int64(gracePeriod.Seconds()),
+			})
+			errors.CheckError(err)
+			fmt.Println(response.Token)
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the new token will expire. (Default: Lifetime of the replaced token)")
+	cmd.Flags().StringVar(&gracePeriod, "grace-period", "1h", "Duration during which the replaced token remains valid")
 	return cmd
 }
 
@@ -439,3 +553,99 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func printSessionsTable(items []*session.UserSession) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tUSERNAME\tISSUER\tISSUED AT\tEXPIRING AT\tCURRENT\n")
+	for _, s := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n", s.Id, s.Username, s.Issuer,
+			time.Unix(s.IssuedAt, 0).Format(time.RFC3339), time.Unix(s.ExpiresAt, 0).Format(time.RFC3339), s.Current)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountListSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-sessions",
+		Short: "List the sessions of an account or SSO user",
+		Example: `# List the sessions of the currently logged in user
+argocd account list-sessions
+
+# List the sessions of an account or an SSO user by subject
+argocd account list-sessions --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewSessionClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListSessions(ctx, &session.SessionListRequest{Subject: account})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSessionsTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name or subject of the SSO user. Defaults to the current user.")
+	return cmd
+}
+
+func NewAccountRevokeSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account      string
+		issuedBefore string
+	)
+	cmd := &cobra.Command{
+		Use:   "revoke-sessions [ID]",
+		Short: "Revoke the sessions of an account or SSO user",
+		Example: `# Revoke all sessions of the currently logged in user, including the current one
+argocd account revoke-sessions
+
+# Revoke a single session of the currently logged in user
+argocd account revoke-sessions ID
+
+# Revoke the sessions of an account or an SSO user which were issued before the given time
+argocd account revoke-sessions --account <account-name> --issued-before 2024-01-02T15:04:05Z`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) > 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			req := session.SessionRevokeRequest{Subject: account}
+			if len(args) == 1 {
+				req.Id = args[0]
+			}
+			if issuedBefore != "" {
+				t, err := time.Parse(time.RFC3339, issuedBefore)
+				errors.CheckError(err)
+				req.IssuedBefore = t.Unix()
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewSessionClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.RevokeSessions(ctx, &req)
+			errors.CheckError(err)
+			fmt.Printf("%d session(s) revoked\n", len(response.Items))
+			if len(response.Items) > 0 {
+				printSessionsTable(response.Items)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name or subject of the SSO user. Defaults to the current user.")
+	cmd.Flags().StringVar(&issuedBefore, "issued-before", "", "Only revoke the sessions issued before the given time in RFC3339 format. Defaults to now.")
+	return cmd
+}
@@ -2,8 +2,12 @@ package admin
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -108,6 +112,7 @@ var accountsActions = actionTraitMap{
 
 var execActions = actionTraitMap{
 	rbacpolicy.ActionCreate: rbacTrait{},
+	rbacpolicy.ActionReplay: rbacTrait{},
 }
 
 var logsActions = actionTraitMap{
@@ -129,6 +134,7 @@ func NewRBACCommand(cmdCtx commandContext) *cobra.Command {
 	}
 	command.AddCommand(NewRBACCanCommand(cmdCtx))
 	command.AddCommand(NewRBACValidateCommand())
+	command.AddCommand(NewRBACMatrixCommand())
 	return command
 }
 
@@ -140,10 +146,13 @@ func NewRBACCanCommand(cmdCtx commandContext) *cobra.Command {
 		useBuiltin   bool
 		strict       bool
 		quiet        bool
+		explain      bool
 		subject      string
 		action       string
 		resource     string
 		subResource  string
+		labels       []string
+		attrs        rbac.Attributes
 		clientConfig clientcmd.ClientConfig
 	)
 	command := &cobra.Command{
@@ -170,6 +179,12 @@ argocd admin settings rbac can some:role create application 'default/app' --name
 # You can override a possibly configured default role
 argocd admin settings rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Policies with conditions are evaluated against the given application attributes
+argocd admin settings rbac can some:role sync application 'default/app' --policy-file policy.csv --label team=payments --destination-namespace payments
+
+# Explain the decision by listing the matching policy lines and role assignments
+argocd admin settings rbac can someuser sync application 'default/app' --policy-file policy.csv --explain
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -241,19 +256,37 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 					}
 				}
 			}
-			res := checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict, isLogRbacEnforced)
+			// Policy conditions are only evaluated against the attributes if any were given
+			var reqAttrs *rbac.Attributes
+			if len(labels) > 0 || attrs.DestinationServer != "" || attrs.DestinationName != "" || attrs.DestinationNamespace != "" || len(attrs.SourceRepoURLs) > 0 {
+				attrs.Labels = map[string]string{}
+				for _, label := range labels {
+					key, value, ok := strings.Cut(label, "=")
+					if !ok {
+						log.Fatalf("invalid label '%s': must be of the form key=value", label)
+					}
+					attrs.Labels[key] = value
+				}
+				reqAttrs = &attrs
+			}
+			res := checkPolicy(subject, action, resource, subResource, reqAttrs, builtinPolicy, userPolicy, defaultRole, matchMode, strict, isLogRbacEnforced)
 
 			if res {
 				if !quiet {
 					fmt.Println("Yes")
 				}
-				os.Exit(0)
 			} else {
 				if !quiet {
 					fmt.Println("No")
 				}
-				os.Exit(1)
 			}
+			if explain && !quiet {
+				printExplanation(os.Stdout, explainPolicy(subject, action, resource, subResource, reqAttrs, builtinPolicy, userPolicy, defaultRole, matchMode, strict))
+			}
+			if res {
+				os.Exit(0)
+			}
+			os.Exit(1)
 		},
 	}
 	clientConfig = cli.AddKubectlFlagsToCmd(command)
@@ -262,6 +295,91 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
 	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
 	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "quiet mode - do not print results to stdout")
+	command.Flags().BoolVar(&explain, "explain", false, "explain the result by printing the matching policy lines, their source and the role assignments used")
+	command.Flags().StringArrayVar(&labels, "label", []string{}, "label of the application evaluated by policy conditions, in the form key=value (can be repeated)")
+	command.Flags().StringVar(&attrs.DestinationServer, "destination-server", "", "destination server of the application evaluated by policy conditions")
+	command.Flags().StringVar(&attrs.DestinationName, "destination-name", "", "destination cluster name of the application evaluated by policy conditions")
+	command.Flags().StringVar(&attrs.DestinationNamespace, "destination-namespace", "", "destination namespace of the application evaluated by policy conditions")
+	command.Flags().StringArrayVar(&attrs.SourceRepoURLs, "source-repo", []string{}, "source repository URL of the application evaluated by policy conditions (can be repeated)")
+	return command
+}
+
+// NewRBACMatrixCommand is the command for 'rbac matrix'
+func NewRBACMatrixCommand() *cobra.Command {
+	var (
+		policyFile   string
+		defaultRole  string
+		useBuiltin   bool
+		subjects     []string
+		output       string
+		clientConfig clientcmd.ClientConfig
+	)
+	command := &cobra.Command{
+		Use:   "matrix",
+		Short: "Export the RBAC permissions of all subjects",
+		Long: `
+Export the permissions of all subjects and roles of the policy for all RBAC
+resources and actions. For every subject, resource and action, the object
+patterns of the matching allow and deny policies are listed, including the
+policies of assigned roles and of the default role.
+`,
+		Example: `
+# Export the permission matrix of a local policy.csv file as CSV
+argocd admin settings rbac matrix --policy-file policy.csv
+
+# Export the permissions of a single subject from the ConfigMap 'argocd-rbac-cm' as JSON
+argocd admin settings rbac matrix --namespace argocd --subject someuser -o json
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) > 0 {
+				c.HelpFunc()(c, args)
+				log.Fatalf("too many arguments")
+			}
+
+			namespace, nsOverride, err := clientConfig.Namespace()
+			if err != nil {
+				log.Fatalf("could not create k8s client: %v", err)
+			}
+
+			// Exactly one of --namespace or --policy-file must be given.
+			if (!nsOverride && policyFile == "") || (nsOverride && policyFile != "") {
+				c.HelpFunc()(c, args)
+				log.Fatalf("please provide exactly one of --policy-file or --namespace")
+			}
+
+			restConfig, err := clientConfig.ClientConfig()
+			if err != nil {
+				log.Fatalf("could not create k8s client: %v", err)
+			}
+			realClientset, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				log.Fatalf("could not create k8s client: %v", err)
+			}
+
+			userPolicy, newDefaultRole, matchMode := getPolicy(ctx, policyFile, realClientset, namespace)
+			builtinPolicy := ""
+			if useBuiltin {
+				builtinPolicy = assets.BuiltinPolicyCSV
+			}
+			if newDefaultRole != "" && defaultRole == "" {
+				defaultRole = newDefaultRole
+			}
+
+			enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+			if len(subjects) == 0 {
+				subjects = enf.Subjects()
+			}
+			errors.CheckError(printPermissionMatrix(os.Stdout, permissionMatrix(enf, subjects), output))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&policyFile, "policy-file", "", "path to the policy file to use")
+	command.Flags().StringVar(&defaultRole, "default-role", "", "name of the default role to use")
+	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
+	command.Flags().StringArrayVar(&subjects, "subject", []string{}, "subject or role to export the permissions of (can be repeated, defaults to all subjects and roles of the policy)")
+	command.Flags().StringVarP(&output, "output", "o", "csv", "output format. One of: csv|json")
 	return command
 }
 
@@ -411,27 +529,48 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 
 // checkPolicy checks whether given subject is allowed to execute specified
 // action against specified resource
-func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool, isLogRbacEnforced func() bool) bool {
+func checkPolicy(subject, action, resource, subResource string, attrs *rbac.Attributes, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool, isLogRbacEnforced func() bool) bool {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	rvals := policyRequest(subject, action, resource, subResource, attrs, strict)
+	if rvals[1] == rbacpolicy.ResourceLogs {
+		if isLogRbacEnforced != nil && !isLogRbacEnforced() {
+			return true
+		}
+	}
+	return enf.Enforce(rvals...)
+}
+
+// explainPolicy explains why given subject is allowed or denied to execute
+// specified action against specified resource
+func explainPolicy(subject, action, resource, subResource string, attrs *rbac.Attributes, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) *rbac.Explanation {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	return enf.Explain(policyRequest(subject, action, resource, subResource, attrs, strict)...)
+}
+
+// newPolicyEnforcer returns an enforcer for the given built-in and user policy
+func newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode string) *rbac.Enforcer {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	enf.SetMatchMode(matchMode)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
 			log.Fatalf("could not set built-in policy: %v", err)
-			return false
 		}
 	}
 	if userPolicy != "" {
 		if err := rbac.ValidatePolicy(userPolicy); err != nil {
 			log.Fatalf("invalid user policy: %v", err)
-			return false
 		}
 		if err := enf.SetUserPolicy(userPolicy); err != nil {
 			log.Fatalf("could not set user policy: %v", err)
-			return false
 		}
 	}
+	return enf
+}
 
+// policyRequest returns the values of the RBAC request for given subject,
+// action, resource and sub-resource
+func policyRequest(subject, action, resource, subResource string, attrs *rbac.Attributes, strict bool) []interface{} {
 	// User could have used a mutation of the resource name (i.e. 'cert' for
 	// 'certificate') - let's resolve it to the valid resource.
 	realResource := resolveRBACResourceName(resource)
@@ -441,7 +580,6 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 	if strict {
 		if err := validateRBACResourceAction(realResource, action); err != nil {
 			log.Fatalf("error in RBAC request: %v", err)
-			return false
 		}
 	}
 
@@ -453,12 +591,78 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 			subResource = "*/*"
 		}
 	}
-	if realResource == rbacpolicy.ResourceLogs {
-		if isLogRbacEnforced != nil && !isLogRbacEnforced() {
-			return true
+	if attrs != nil {
+		return []interface{}{subject, realResource, action, subResource, attrs}
+	}
+	return []interface{}{subject, realResource, action, subResource}
+}
+
+// printExplanation prints the subjects evaluated for an RBAC request along
+// with the policy lines and role assignments which matched the request
+func printExplanation(w io.Writer, exp *rbac.Explanation) {
+	for _, subject := range exp.Subjects {
+		result := "denied"
+		if subject.Allowed {
+			result = "allowed"
+		}
+		_, _ = fmt.Fprintf(w, "%s (%s): %s\n", subject.Subject, subject.Origin, result)
+		for _, grouping := range subject.Groupings {
+			_, _ = fmt.Fprintf(w, "  grouping [%s] %s\n", grouping.Source, grouping.Line)
+		}
+		for _, policy := range subject.Policies {
+			_, _ = fmt.Fprintf(w, "  policy   [%s] %s\n", policy.Source, policy.Line)
+		}
+		if len(subject.Policies) == 0 {
+			_, _ = fmt.Fprintf(w, "  no matching policy\n")
+		}
+	}
+}
+
+// permissionMatrix returns the permissions of all given subjects for all
+// known RBAC resources and actions
+func permissionMatrix(enf *rbac.Enforcer, subjects []string) []rbac.Permission {
+	resources := make([]string, 0, len(validRBACResourcesActions))
+	for resource := range validRBACResourcesActions {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	var res []rbac.Permission
+	for _, subject := range subjects {
+		for _, resource := range resources {
+			actions := make([]string, 0, len(validRBACResourcesActions[resource]))
+			for action := range validRBACResourcesActions[resource] {
+				actions = append(actions, action)
+			}
+			sort.Strings(actions)
+			for _, action := range actions {
+				res = append(res, enf.Permission(subject, resource, action))
+			}
+		}
+	}
+	return res
+}
+
+// printPermissionMatrix prints permissions in the given format, i.e. csv or json
+func printPermissionMatrix(w io.Writer, permissions []rbac.Permission, output string) error {
+	switch output {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(permissions)
+	case "csv":
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write([]string{"subject", "resource", "action", "allowed", "denied"}); err != nil {
+			return err
+		}
+		for _, p := range permissions {
+			if err := csvWriter.Write([]string{p.Subject, p.Resource, p.Action, strings.Join(p.Allowed, ";"), strings.Join(p.Denied, ";")}); err != nil {
+				return err
+			}
 		}
+		csvWriter.Flush()
+		return csvWriter.Error()
 	}
-	return enf.Enforce(subject, realResource, action, subResource)
+	return fmt.Errorf("unknown output format: %s", output)
 }
 
 // resolveRBACResourceName resolves a user supplied value to a valid RBAC
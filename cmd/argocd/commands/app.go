@@ -95,7 +95,10 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationDriftCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationTerminalRecordingsCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkOperationsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	return command
@@ -1848,6 +1851,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		retryBackoffDuration    time.Duration
 		retryBackoffMaxDuration time.Duration
 		retryBackoffFactor      int64
+		retryOnTimeout          bool
+		syncTimeout             time.Duration
+		syncHookTimeout         time.Duration
 		local                   string
 		localRepoRoot           string
 		infos                   []string
@@ -2095,6 +2101,16 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 							MaxDuration: retryBackoffMaxDuration.String(),
 							Factor:      ptr.To(retryBackoffFactor),
 						},
+						OnTimeout: retryOnTimeout,
+					}
+				}
+				if syncTimeout > 0 || syncHookTimeout > 0 {
+					syncReq.Timeout = &argoappv1.SyncTimeout{}
+					if syncTimeout > 0 {
+						syncReq.Timeout.Operation = syncTimeout.String()
+					}
+					if syncHookTimeout > 0 {
+						syncReq.Timeout.Hook = syncHookTimeout.String()
 					}
 				}
 				if diffChanges {
@@ -2125,8 +2141,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						return
 					}
 				}
-				_, err = appIf.Sync(ctx, &syncReq)
+				syncedApp, err := appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
+				if syncedApp.Operation != nil && syncedApp.Operation.Approval.IsPending() {
+					fmt.Printf("Sync of application '%s' requires approval. Approve it before %s with: argocd app sync approve %s\n", appQualifiedName, syncedApp.Operation.Approval.ExpiresAt.Format(time.RFC3339), appQualifiedName)
+					continue
+				}
 
 				if !async {
 					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
@@ -2158,6 +2178,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
+	command.Flags().BoolVar(&retryOnTimeout, "retry-on-timeout", false, "Retry the sync if it was terminated because it exceeded its timeout")
+	command.Flags().DurationVar(&syncTimeout, "sync-timeout", 0, "Terminate the sync operation if it does not complete within this duration (e.g. 10m, 1h). Overrides the timeout of the sync policy")
+	command.Flags().DurationVar(&syncHookTimeout, "sync-hook-timeout", 0, "Terminate the sync operation if a single hook runs longer than this duration (e.g. 2m, 1h). Overrides the timeout of the sync policy")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
@@ -2175,9 +2198,115 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
+	command.AddCommand(NewApplicationSyncApproveCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncRejectCommand(clientOpts))
 	return command
 }
 
+// NewApplicationSyncApproveCommand returns a new instance of an `argocd app sync approve` command
+func NewApplicationSyncApproveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		message      string
+		yes          bool
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "approve APPNAME",
+		Short: "Approve a sync of an application which is pending approval",
+		Example: `  # Review the requested sync and approve it
+  argocd app sync approve my-app
+
+  # Approve the requested sync without reviewing it
+  argocd app sync approve my-app --message "approved change CHG-1234" --yes`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			if !yes {
+				app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+				errors.CheckError(err)
+				if app.Operation == nil || !app.Operation.Approval.IsPending() {
+					log.Fatalf("No sync of application '%s' is pending approval", appName)
+				}
+				printPendingOperation(app.Operation)
+				if !cli.AskToProceed(fmt.Sprintf("Do you want to approve the sync of application %s? (y/n): ", appName)) {
+					os.Exit(0)
+				}
+			}
+			_, err := appIf.ReviewSync(ctx, &application.ApplicationSyncReviewRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Approve:      ptr.To(true),
+				Message:      &message,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Sync of application '%s' approved\n", appName)
+		},
+	}
+	command.Flags().StringVar(&message, "message", "", "Message recorded with the approval")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Approve the sync without reviewing it")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationSyncRejectCommand returns a new instance of an `argocd app sync reject` command
+func NewApplicationSyncRejectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		message      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "reject APPNAME",
+		Short: "Reject a sync of an application which is pending approval",
+		Example: `  # Reject the requested sync
+  argocd app sync reject my-app --message "outside of the change window"`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.ReviewSync(ctx, &application.ApplicationSyncReviewRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Approve:      ptr.To(false),
+				Message:      &message,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Sync of application '%s' rejected\n", appName)
+		},
+	}
+	command.Flags().StringVar(&message, "message", "", "Message recorded with the rejection")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// printPendingOperation prints the requester and the diff of an operation which is pending approval
+func printPendingOperation(op *argoappv1.Operation) {
+	fmt.Printf("Requested by: %s\n", op.InitiatedBy.Username)
+	fmt.Printf("Requested at: %s\n", op.Approval.RequestedAt.Format(time.RFC3339))
+	fmt.Printf("Expires at:   %s\n", op.Approval.ExpiresAt.Format(time.RFC3339))
+	if op.Sync != nil && op.Sync.Revision != "" {
+		fmt.Printf("Revision:     %s\n", op.Sync.Revision)
+	}
+	if op.Approval.Diff == "" {
+		fmt.Println("No diff was recorded for the sync")
+		return
+	}
+	fmt.Printf("====== Differences between live and desired state at the time of the request ======\n%s", op.Approval.Diff)
+}
+
 func getAppNamesBySelector(ctx context.Context, appIf application.ApplicationServiceClient, selector string) ([]string, error) {
 	appNames := []string{}
 	if selector != "" {
@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/templates"
+)
+
+// NewApplicationBulkOperationsCommand returns a new instance of an `argocd app bulk-operations` command
+func NewApplicationBulkOperationsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "bulk-operations",
+		Short: "Run operations on multiple applications on the server",
+		Example: templates.Examples(`
+	# Hard refresh all applications with the label team=payments and wait for the operation to finish
+	argocd app bulk-operations start hard-refresh -l team=payments
+
+	# Show the progress of a bulk operation
+	argocd app bulk-operations get ID
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationBulkOperationsStartCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkOperationsGetCommand(clientOpts))
+	return command
+}
+
+// NewApplicationBulkOperationsStartCommand returns a new instance of an `argocd app bulk-operations start` command
+func NewApplicationBulkOperationsStartCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		selector       string
+		projects       []string
+		appNamespace   string
+		prune          bool
+		dryRun         bool
+		helmSets       []string
+		helmSetStrings []string
+		concurrency    int32
+		async          bool
+		output         string
+	)
+	command := &cobra.Command{
+		Use:   "start OPERATION [APPNAME...]",
+		Short: "Start an operation on all applications matching the selectors",
+		Long:  "Start an operation on all applications matching the selectors. OPERATION is one of refresh, hard-refresh, sync, terminate-op or set-parameters. Syncs are only initiated; their progress is tracked by the applications.",
+		Example: templates.Examples(`
+	# Sync all applications of a project, pruning resources
+	argocd app bulk-operations start sync --project payments --prune
+
+	# Terminate the operations of some applications without waiting for the result
+	argocd app bulk-operations start terminate-op app-1 app-2 --async
+
+	# Set a Helm parameter of all applications with the label team=payments
+	argocd app bulk-operations start set-parameters -l team=payments --helm-set image.tag=v1.2.3
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) < 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			operation := args[0]
+			var parameters []*argoappv1.HelmParameter
+			for _, text := range helmSets {
+				p, err := argoappv1.NewHelmParameter(text, false)
+				errors.CheckError(err)
+				parameters = append(parameters, p)
+			}
+			for _, text := range helmSetStrings {
+				p, err := argoappv1.NewHelmParameter(text, true)
+				errors.CheckError(err)
+				parameters = append(parameters, p)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			op, err := appIf.StartBulkOperation(ctx, &applicationpkg.ApplicationBulkOperationRequest{
+				Operation:    &operation,
+				Selector:     &selector,
+				Projects:     projects,
+				Names:        args[1:],
+				AppNamespace: &appNamespace,
+				Prune:        &prune,
+				DryRun:       &dryRun,
+				Parameters:   parameters,
+				Concurrency:  &concurrency,
+			})
+			errors.CheckError(err)
+			if !async && op.GetPhase() == "Running" {
+				op = watchBulkOperation(c, appIf, op.GetId())
+			}
+			printBulkOperation(op, output)
+			if op.GetPhase() == "Failed" {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Run the operation for applications matching the label selector")
+	command.Flags().StringSliceVarP(&projects, "project", "p", []string{}, "Run the operation for applications in the projects")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only run the operation for applications in this namespace")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources when syncing")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview syncs without affecting clusters")
+	command.Flags().StringArrayVar(&helmSets, "helm-set", []string{}, "Helm parameter set by set-parameters operations (can be repeated to set several parameters: --helm-set key1=val1 --helm-set key2=val2)")
+	command.Flags().StringArrayVar(&helmSetStrings, "helm-set-string", []string{}, "Helm string parameter set by set-parameters operations (can be repeated to set several parameters: --helm-set-string key1=val1 --helm-set-string key2=val2)")
+	command.Flags().Int32Var(&concurrency, "concurrency", 0, "Number of applications the operation runs for at once (defaults to 10)")
+	command.Flags().BoolVar(&async, "async", false, "Do not wait for the operation to finish")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewApplicationBulkOperationsGetCommand returns a new instance of an `argocd app bulk-operations get` command
+func NewApplicationBulkOperationsGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		watch  bool
+		output string
+	)
+	command := &cobra.Command{
+		Use:   "get ID",
+		Short: "Show the progress of a bulk operation",
+		Example: templates.Examples(`
+	# Show the progress of a bulk operation
+	argocd app bulk-operations get ID
+
+	# Wait for a bulk operation to finish
+	argocd app bulk-operations get ID --watch
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			var op *applicationpkg.ApplicationBulkOperation
+			if watch {
+				op = watchBulkOperation(c, appIf, args[0])
+			} else {
+				var err error
+				op, err = appIf.GetBulkOperation(ctx, &applicationpkg.ApplicationBulkOperationQuery{Id: &args[0]})
+				errors.CheckError(err)
+			}
+			printBulkOperation(op, output)
+		},
+	}
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Wait for the operation to finish")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// watchBulkOperation waits for the bulk operation to finish, printing the applications as the operation finishes for
+// them, and returns its final progress
+func watchBulkOperation(c *cobra.Command, appIf applicationpkg.ApplicationServiceClient, id string) *applicationpkg.ApplicationBulkOperation {
+	stream, err := appIf.WatchBulkOperation(c.Context(), &applicationpkg.ApplicationBulkOperationQuery{Id: &id})
+	errors.CheckError(err)
+	printed := map[string]bool{}
+	var op *applicationpkg.ApplicationBulkOperation
+	for {
+		next, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		errors.CheckError(err)
+		op = next
+		for _, item := range op.Items {
+			key := item.GetAppNamespace() + "/" + item.GetName()
+			if (item.GetPhase() == "Succeeded" || item.GetPhase() == "Failed") && !printed[key] {
+				printed[key] = true
+				fmt.Fprintf(os.Stderr, "%s: %s %s\n", item.GetName(), item.GetPhase(), item.GetMessage())
+			}
+		}
+	}
+	if op == nil {
+		errors.CheckError(fmt.Errorf("bulk operation %s was not found", id))
+	}
+	return op
+}
+
+func printBulkOperation(op *applicationpkg.ApplicationBulkOperation, output string) {
+	switch output {
+	case "yaml", "json":
+		err := PrintResource(op, output)
+		errors.CheckError(err)
+	case "wide", "":
+		fmt.Printf(printOpFmtStr, "ID:", op.GetId())
+		fmt.Printf(printOpFmtStr, "Operation:", op.GetOperation())
+		fmt.Printf(printOpFmtStr, "Phase:", op.GetPhase())
+		if op.GetMessage() != "" {
+			fmt.Printf(printOpFmtStr, "Message:", op.GetMessage())
+		}
+		if op.StartedAt != nil {
+			fmt.Printf(printOpFmtStr, "Start:", op.StartedAt)
+		}
+		if op.FinishedAt != nil {
+			fmt.Printf(printOpFmtStr, "Finished:", op.FinishedAt)
+		}
+		if len(op.Items) > 0 {
+			fmt.Println()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "NAME\tNAMESPACE\tPROJECT\tPHASE\tMESSAGE\n")
+			for _, item := range op.Items {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.GetName(), item.GetAppNamespace(), item.GetProject(), item.GetPhase(), item.GetMessage())
+			}
+			_ = w.Flush()
+		}
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+}
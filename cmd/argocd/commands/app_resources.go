@@ -3,7 +3,9 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -11,6 +13,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
@@ -276,3 +279,42 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
 	return command
 }
+
+func printResourceDrift(items []*v1alpha1.ResourceStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tDRIFTING-SINCE\tAGE\tMANAGERS\n")
+	for _, res := range items {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name,
+			res.Drift.StartedAt.Format(time.RFC3339), duration.HumanDuration(res.Drift.Age(time.Now())), strings.Join(res.Drift.Managers, ","))
+	}
+	_ = w.Flush()
+}
+
+func NewApplicationDriftCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
+	command := &cobra.Command{
+		Use:   "drift APPNAME",
+		Short: "List resources of application which are drifting from the desired state",
+		Example: `  # List the drifting resources of an application
+  argocd app drift my-app`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			resp, err := appIf.ResourceDrift(ctx, &applicationpkg.ResourcesQuery{
+				ApplicationName: &appName,
+				AppNamespace:    &appNs,
+				Project:         &project,
+			})
+			errors.CheckError(err)
+			printResourceDrift(resp.Items)
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	return command
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/templates"
+)
+
+// NewApplicationTerminalRecordingsCommand returns a new instance of an `argocd app terminal-recordings` command
+func NewApplicationTerminalRecordingsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "terminal-recordings",
+		Short: "Manage recordings of web terminal sessions",
+		Example: templates.Examples(`
+	# List the recorded terminal sessions of an application
+	argocd app terminal-recordings list APPNAME
+
+	# Download a recorded terminal session and replay it with asciinema
+	argocd app terminal-recordings get APPNAME ID --file session.cast
+	asciinema play session.cast
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationTerminalRecordingsListCommand(clientOpts))
+	command.AddCommand(NewApplicationTerminalRecordingsGetCommand(clientOpts))
+	return command
+}
+
+// NewApplicationTerminalRecordingsListCommand returns a new instance of an `argocd app terminal-recordings list` command
+func NewApplicationTerminalRecordingsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the recorded terminal sessions of an application",
+		Example: templates.Examples(`
+	# List the recorded terminal sessions of an application
+	argocd app terminal-recordings list APPNAME
+
+	# List the recorded terminal sessions of an application in another namespace
+	argocd app terminal-recordings list NAMESPACE/APPNAME -o wide
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			recordings, err := appIf.ListTerminalRecordings(ctx, &applicationpkg.ApplicationTerminalRecordingsQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(recordings.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printTerminalRecordingsTable(recordings.Items, output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+func printTerminalRecordingsTable(recordings []*applicationpkg.TerminalRecording, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if wide {
+		_, _ = fmt.Fprintf(w, "ID\tUSER\tNAMESPACE\tPOD\tCONTAINER\tSTARTED\tDURATION\tSIZE\tPROJECT\tSHELL\n")
+	} else {
+		_, _ = fmt.Fprintf(w, "ID\tUSER\tNAMESPACE\tPOD\tCONTAINER\tSTARTED\tDURATION\n")
+	}
+	for _, r := range recordings {
+		started, duration := "", ""
+		if r.StartedAt != nil {
+			started = r.StartedAt.Format(time.RFC3339)
+			if r.EndedAt != nil {
+				duration = r.EndedAt.Sub(r.StartedAt.Time).Round(time.Second).String()
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s", r.GetId(), r.GetUser(), r.GetNamespace(), r.GetPod(), r.GetContainer(), started, duration)
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%d\t%s\t%s", r.GetSize_(), r.GetProject(), r.GetShell())
+		}
+		_, _ = fmt.Fprintf(w, "\n")
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationTerminalRecordingsGetCommand returns a new instance of an `argocd app terminal-recordings get` command
+func NewApplicationTerminalRecordingsGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var file string
+	command := &cobra.Command{
+		Use:   "get APPNAME ID",
+		Short: "Download a recorded terminal session in the asciicast v2 format",
+		Example: templates.Examples(`
+	# Print a recorded terminal session
+	argocd app terminal-recordings get APPNAME ID
+
+	# Download a recorded terminal session to a file
+	argocd app terminal-recordings get APPNAME ID --file session.cast
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			id := args[1]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			stream, err := appIf.GetTerminalRecording(ctx, &applicationpkg.ApplicationTerminalRecordingQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           &id,
+			})
+			errors.CheckError(err)
+
+			var out io.Writer = os.Stdout
+			if file != "" {
+				f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+				errors.CheckError(err)
+				defer argoio.Close(f)
+				out = f
+			}
+			for {
+				chunk, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				errors.CheckError(err)
+				_, err = out.Write(chunk.Data)
+				errors.CheckError(err)
+			}
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "", "File the recording is written to, instead of the standard output")
+	return command
+}
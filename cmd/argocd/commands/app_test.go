@@ -34,6 +34,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	signingkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/signingkey"
 	versionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -2181,10 +2182,18 @@ func (c *fakeAppServiceClient) Sync(ctx context.Context, in *applicationpkg.Appl
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ReviewSync(ctx context.Context, in *applicationpkg.ApplicationSyncReviewRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ManagedResources(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ResourceDrift(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*applicationpkg.ResourceDriftResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(ctx context.Context, in *applicationpkg.ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2233,6 +2242,26 @@ func (c *fakeAppServiceClient) ListResourceLinks(ctx context.Context, in *applic
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ListTerminalRecordings(ctx context.Context, in *applicationpkg.ApplicationTerminalRecordingsQuery, opts ...grpc.CallOption) (*applicationpkg.TerminalRecordingList, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetTerminalRecording(ctx context.Context, in *applicationpkg.ApplicationTerminalRecordingQuery, opts ...grpc.CallOption) (applicationpkg.ApplicationService_GetTerminalRecordingClient, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) StartBulkOperation(ctx context.Context, in *applicationpkg.ApplicationBulkOperationRequest, opts ...grpc.CallOption) (*applicationpkg.ApplicationBulkOperation, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetBulkOperation(ctx context.Context, in *applicationpkg.ApplicationBulkOperationQuery, opts ...grpc.CallOption) (*applicationpkg.ApplicationBulkOperation, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) WatchBulkOperation(ctx context.Context, in *applicationpkg.ApplicationBulkOperationQuery, opts ...grpc.CallOption) (applicationpkg.ApplicationService_WatchBulkOperationClient, error) {
+	return nil, nil
+}
+
 type fakeAcdClient struct{}
 
 func (c *fakeAcdClient) ClientOptions() argocdclient.ClientOptions {
@@ -2283,6 +2312,14 @@ func (c *fakeAcdClient) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServi
 	return nil, nil
 }
 
+func (c *fakeAcdClient) NewSigningKeyClient() (io.Closer, signingkeypkg.SigningKeyServiceClient, error) {
+	return nil, nil, nil
+}
+
+func (c *fakeAcdClient) NewSigningKeyClientOrDie() (io.Closer, signingkeypkg.SigningKeyServiceClient) {
+	return nil, nil
+}
+
 func (c *fakeAcdClient) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	return nil, nil, nil
 }
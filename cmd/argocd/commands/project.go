@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"slices"
 	"strings"
 	"text/tabwriter"
@@ -27,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/signing"
 	"github.com/argoproj/argo-cd/v2/util/templates"
 )
 
@@ -179,25 +181,40 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 // NewProjectAddSignatureKeyCommand returns a new instance of an `argocd proj add-signature-key` command
 func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var principal, sigstoreIssuer, sigstoreSubjectRegex string
+	var cosign bool
 	command := &cobra.Command{
-		Use:   "add-signature-key PROJECT KEY-ID",
-		Short: "Add GnuPG signature key to project",
+		Use:   "add-signature-key PROJECT [KEY]",
+		Short: "Add GnuPG key, SSH key, Sigstore identity or cosign key for signature verification to project",
 		Example: templates.Examples(`
 			# Add GnuPG signature key KEY-ID to project PROJECT
 			argocd proj add-signature-key PROJECT KEY-ID
+
+			# Add SSH signing key with the given fingerprint to project PROJECT, which may only sign for alice@example.com
+			argocd proj add-signature-key PROJECT SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s --principal alice@example.com
+
+			# Add Sigstore identity of GitHub Actions workflows of repository org/repo to project PROJECT
+			argocd proj add-signature-key PROJECT --sigstore-issuer https://token.actions.githubusercontent.com --sigstore-subject-regex 'https://github.com/org/repo/.*'
+
+			# Add cosign key with the given fingerprint for verifying signatures of OCI Helm charts to project PROJECT
+			argocd proj add-signature-key PROJECT SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c --cosign
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 2 {
+			if len(args) != 2 && (len(args) != 1 || sigstoreIssuer == "") {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			projName := args[0]
-			signatureKey := args[1]
+			keyStr := ""
+			if len(args) == 2 {
+				keyStr = args[1]
+			}
 
-			if !gpg.IsShortKeyID(signatureKey) && !gpg.IsLongKeyID(signatureKey) {
-				log.Fatalf("%s is not a valid GnuPG key ID", signatureKey)
+			signatureKey, err := cmdutil.NewSignatureKey(keyStr, principal, sigstoreIssuer, sigstoreSubjectRegex, cosign)
+			if err != nil {
+				log.Fatal(err)
 			}
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
@@ -207,36 +224,65 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 			errors.CheckError(err)
 
 			for _, key := range proj.Spec.SignatureKeys {
-				if key.KeyID == signatureKey {
+				if sameSignatureKey(key, signatureKey) {
 					log.Fatal("Specified signature key is already defined in project")
 				}
 			}
-			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, v1alpha1.SignatureKey{KeyID: signatureKey})
+			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, signatureKey)
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
 		},
 	}
+	command.Flags().StringVar(&principal, "principal", "", "Principal the SSH key may only sign for")
+	command.Flags().StringVar(&sigstoreIssuer, "sigstore-issuer", "", "OIDC issuer of the Sigstore identity")
+	command.Flags().StringVar(&sigstoreSubjectRegex, "sigstore-subject-regex", "", "Regular expression the subject of the Sigstore identity must match")
+	command.Flags().BoolVar(&cosign, "cosign", false, "KEY is the fingerprint of a cosign key for verifying signatures of OCI Helm charts")
 	return command
 }
 
+// sameSignatureKey returns true if both signature keys are equal, comparing GnuPG key IDs by their short form
+func sameSignatureKey(a, b v1alpha1.SignatureKey) bool {
+	a.KeyID, b.KeyID = gpg.KeyID(a.KeyID), gpg.KeyID(b.KeyID)
+	return reflect.DeepEqual(a, b)
+}
+
 // NewProjectRemoveSignatureKeyCommand returns a new instance of an `argocd proj remove-signature-key` command
 func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var principal, sigstoreIssuer, sigstoreSubjectRegex string
+	var cosign bool
 	command := &cobra.Command{
-		Use:   "remove-signature-key PROJECT KEY-ID",
-		Short: "Remove GnuPG signature key from project",
+		Use:   "remove-signature-key PROJECT [KEY]",
+		Short: "Remove GnuPG key, SSH key, Sigstore identity or cosign key for signature verification from project",
 		Example: templates.Examples(`
 			# Remove GnuPG signature key KEY-ID from project PROJECT
 			argocd proj remove-signature-key PROJECT KEY-ID
+
+			# Remove SSH signing key with the given fingerprint from project PROJECT
+			argocd proj remove-signature-key PROJECT SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s
+
+			# Remove Sigstore identity from project PROJECT
+			argocd proj remove-signature-key PROJECT --sigstore-issuer https://token.actions.githubusercontent.com --sigstore-subject-regex 'https://github.com/org/repo/.*'
+
+			# Remove cosign key with the given fingerprint from project PROJECT
+			argocd proj remove-signature-key PROJECT SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c --cosign
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 2 {
+			if len(args) != 2 && (len(args) != 1 || sigstoreIssuer == "") {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			projName := args[0]
-			signatureKey := args[1]
+			keyStr := ""
+			if len(args) == 2 {
+				keyStr = args[1]
+			}
+
+			signatureKey, err := cmdutil.NewSignatureKey(keyStr, principal, sigstoreIssuer, sigstoreSubjectRegex, cosign)
+			if err != nil {
+				log.Fatal(err)
+			}
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer argoio.Close(conn)
@@ -246,7 +292,7 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 
 			index := -1
 			for i, key := range proj.Spec.SignatureKeys {
-				if key.KeyID == signatureKey {
+				if sameSignatureKey(key, signatureKey) {
 					index = i
 					break
 				}
@@ -260,7 +306,10 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 			}
 		},
 	}
-
+	command.Flags().StringVar(&principal, "principal", "", "Principal the SSH key may only sign for")
+	command.Flags().StringVar(&sigstoreIssuer, "sigstore-issuer", "", "OIDC issuer of the Sigstore identity")
+	command.Flags().StringVar(&sigstoreSubjectRegex, "sigstore-subject-regex", "", "Regular expression the subject of the Sigstore identity must match")
+	command.Flags().BoolVar(&cosign, "cosign", false, "KEY is the fingerprint of a cosign key for verifying signatures of OCI Helm charts")
 	return command
 }
 
@@ -987,11 +1036,12 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	if len(p.Spec.SignatureKeys) > 0 {
 		kids := make([]string, 0)
 		for _, key := range p.Spec.SignatureKeys {
-			kids = append(kids, key.KeyID)
+			kids = append(kids, signing.DescribeSignatureKey(key))
 		}
 		signatureKeysStr = strings.Join(kids, ", ")
 	}
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
+	fmt.Printf(printProjFmtStr, "Skip chart verification:", fmt.Sprintf("%t", p.Spec.SkipChartSignatureVerification))
 
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
 }
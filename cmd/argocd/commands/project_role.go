@@ -270,6 +270,7 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 		expiresIn       string
 		outputTokenOnly bool
 		tokenID         string
+		scope           v1alpha1.TokenScope
 	)
 	command := &cobra.Command{
 		Use:   "create-token PROJECT ROLE-NAME",
@@ -303,6 +304,7 @@ Create token succeeded for proj:test-project:test-role.
 				Role:      roleName,
 				ExpiresIn: int64(duration.Seconds()),
 				Id:        tokenID,
+				Scope:     &scope,
 			})
 			errors.CheckError(err)
 
@@ -335,6 +337,8 @@ Create token succeeded for proj:test-project:test-role.
 	)
 	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
 	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
+	command.Flags().StringSliceVar(&scope.Resources, "scope-resources", []string{}, "Restrict the token to the RBAC resources, e.g. applications")
+	command.Flags().StringSliceVar(&scope.Actions, "scope-actions", []string{}, "Restrict the token to the RBAC actions, e.g. get or sync")
 
 	return command
 }
@@ -91,6 +91,12 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
   # Add a private Git repository on Google Cloud Sources via GCP service account credentials
   argocd repo add https://source.developers.google.com/p/my-google-cloud-project/r/my-repo --gcp-service-account-key-path service-account-key.json
+
+  # Add a private Git repository on Azure DevOps via Microsoft Entra workload identity federation
+  argocd repo add https://dev.azure.com/my-org/my-project/_git/my-repo --token-provider-url https://login.microsoftonline.com/my-tenant-id/oauth2/v2.0/token --token-provider-client-id my-client-id --token-provider-scope 499b84ac-1321-427f-aa17-267ca6975798/.default
+
+  # Add a private Git repository via HTTPS using access tokens obtained by an OAuth 2.0 token exchange
+  argocd repo add https://gitlab.example.com/repos/repo --username oauth2 --token-provider-url https://sts.example.com/token --token-provider-audience gitlab
 `
 
 	command := &cobra.Command{
@@ -174,6 +180,8 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			repoOpts.Repo.Insecure = repoOpts.InsecureSkipServerVerification
 			repoOpts.Repo.EnableLFS = repoOpts.EnableLfs
 			repoOpts.Repo.EnableOCI = repoOpts.EnableOci
+			repoOpts.Repo.PartialClone = repoOpts.PartialClone
+			repoOpts.Repo.SparseCheckout = repoOpts.SparseCheckout
 			repoOpts.Repo.GithubAppId = repoOpts.GithubAppId
 			repoOpts.Repo.GithubAppInstallationId = repoOpts.GithubAppInstallationId
 			repoOpts.Repo.GitHubAppEnterpriseBaseURL = repoOpts.GitHubAppEnterpriseBaseURL
@@ -190,7 +198,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
 			// If the user set a username, but didn't supply password via --password,
 			// then we prompt for it
-			if repoOpts.Repo.Username != "" && repoOpts.Repo.Password == "" {
+			if repoOpts.Repo.Username != "" && repoOpts.Repo.Password == "" && repoOpts.Repo.TokenProviderURL == "" {
 				repoOpts.Repo.Password = cli.PromptPassword(repoOpts.Repo.Password)
 			}
 
@@ -220,6 +228,10 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				Project:                    repoOpts.Repo.Project,
 				GcpServiceAccountKey:       repoOpts.Repo.GCPServiceAccountKey,
 				ForceHttpBasicAuth:         repoOpts.Repo.ForceHttpBasicAuth,
+				TokenProviderURL:           repoOpts.Repo.TokenProviderURL,
+				TokenProviderClientID:      repoOpts.Repo.TokenProviderClientID,
+				TokenProviderAudience:      repoOpts.Repo.TokenProviderAudience,
+				TokenProviderScope:         repoOpts.Repo.TokenProviderScope,
 			}
 			_, err := repoIf.ValidateAccess(ctx, &repoAccessReq)
 			errors.CheckError(err)
@@ -77,6 +77,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 
   # Add credentials with GCP credentials for all repositories under https://source.developers.google.com/p/my-google-cloud-project/r/
   argocd repocreds add https://source.developers.google.com/p/my-google-cloud-project/r/ --gcp-service-account-key-path service-account-key.json
+
+  # Add credentials with access tokens obtained via Microsoft Entra workload identity federation for all repositories under https://dev.azure.com/my-org/
+  argocd repocreds add https://dev.azure.com/my-org/ --token-provider-url https://login.microsoftonline.com/my-tenant-id/oauth2/v2.0/token --token-provider-client-id my-client-id --token-provider-scope 499b84ac-1321-427f-aa17-267ca6975798/.default
 `
 
 	command := &cobra.Command{
@@ -159,7 +162,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 
 			// If the user set a username, but didn't supply password via --password,
 			// then we prompt for it
-			if repo.Username != "" && repo.Password == "" {
+			if repo.Username != "" && repo.Password == "" && repo.TokenProviderURL == "" {
 				repo.Password = cli.PromptPassword(repo.Password)
 			}
 
@@ -188,6 +191,10 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().StringVar(&gcpServiceAccountKeyPath, "gcp-service-account-key-path", "", "service account key for the Google Cloud Platform")
 	command.Flags().BoolVar(&repo.ForceHttpBasicAuth, "force-http-basic-auth", false, "whether to force basic auth when connecting via HTTP")
 	command.Flags().StringVar(&repo.Proxy, "proxy-url", "", "If provided, this URL will be used to connect via proxy")
+	command.Flags().StringVar(&repo.TokenProviderURL, "token-provider-url", "", "URL of the token endpoint at which the service account token of the repo server is exchanged for short-lived access tokens")
+	command.Flags().StringVar(&repo.TokenProviderClientID, "token-provider-client-id", "", "client ID used to request access tokens from the token provider, the service account token is then used as a client assertion")
+	command.Flags().StringVar(&repo.TokenProviderAudience, "token-provider-audience", "", "audience of the access tokens requested from the token provider")
+	command.Flags().StringVar(&repo.TokenProviderScope, "token-provider-scope", "", "space-separated scopes of the access tokens requested from the token provider")
 	return command
 }
 
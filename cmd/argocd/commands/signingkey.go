@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	signingkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/signingkey"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/templates"
+)
+
+// NewSigningKeyCommand returns a new instance of an `argocd signing-key` command
+func NewSigningKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "signing-key",
+		Short: "Manage SSH keys used for signature verification",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: ``,
+	}
+	command.AddCommand(NewSigningKeyListCommand(clientOpts))
+	command.AddCommand(NewSigningKeyGetCommand(clientOpts))
+	command.AddCommand(NewSigningKeyAddCommand(clientOpts))
+	command.AddCommand(NewSigningKeyDeleteCommand(clientOpts))
+	return command
+}
+
+// NewSigningKeyListCommand lists all configured SSH signing keys from the server
+func NewSigningKeyListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List configured SSH signing keys",
+		Example: templates.Examples(`
+  # List all configured SSH signing keys in wide format (default).
+  argocd signing-key list
+
+  # List all configured SSH signing keys in JSON format.
+  argocd signing-key list -o json
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, signingKeyIf := headless.NewClientOrDie(clientOpts, c).NewSigningKeyClientOrDie()
+			defer argoio.Close(conn)
+			keys, err := signingKeyIf.List(ctx, &signingkeypkg.SSHSigningKeyQuery{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(keys.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSigningKeyTable(keys.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSigningKeyGetCommand retrieves a single SSH signing key from the server
+func NewSigningKeyGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get FINGERPRINT",
+		Short: "Get the SSH signing key with fingerprint <FINGERPRINT> from the server",
+		Example: templates.Examples(`
+  # Get an SSH signing key with the specified fingerprint in wide format (default).
+  argocd signing-key get SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s
+
+  # Get an SSH signing key with the specified fingerprint in YAML format.
+  argocd signing-key get SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s -o yaml
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.CheckError(fmt.Errorf("Missing FINGERPRINT argument"))
+			}
+			conn, signingKeyIf := headless.NewClientOrDie(clientOpts, c).NewSigningKeyClientOrDie()
+			defer argoio.Close(conn)
+			key, err := signingKeyIf.Get(ctx, &signingkeypkg.SSHSigningKeyQuery{Fingerprint: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(key, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				fmt.Printf("Key fingerprint: %s\n", key.Fingerprint)
+				fmt.Printf("Key type:        %s\n", key.KeyType)
+				fmt.Printf("Key principals:  %s\n", key.Principals)
+				fmt.Printf("Allowed signers entry follows until EOF:\n%s\n", key.KeyData)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSigningKeyAddCommand adds SSH signing keys to the server's configuration
+func NewSigningKeyAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fromFile string
+		upsert   bool
+	)
+	command := &cobra.Command{
+		Use:   "add",
+		Short: "Adds SSH signing keys to the server's configuration",
+		Example: templates.Examples(`
+  # Add the SSH signing keys of an allowed signers file.
+  argocd signing-key add --from /path/to/allowed_signers
+
+  # Add an SSH public key, which may sign commits for any principal.
+  argocd signing-key add --from ~/.ssh/id_ed25519.pub
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if fromFile == "" {
+				errors.CheckError(fmt.Errorf("--from is mandatory"))
+			}
+			keyData, err := os.ReadFile(fromFile)
+			errors.CheckError(err)
+			conn, signingKeyIf := headless.NewClientOrDie(clientOpts, c).NewSigningKeyClientOrDie()
+			defer argoio.Close(conn)
+			resp, err := signingKeyIf.Create(ctx, &signingkeypkg.SSHSigningKeyCreateRequest{Signingkey: &appsv1.SSHSigningKey{KeyData: string(keyData)}, Upsert: upsert})
+			errors.CheckError(err)
+			fmt.Printf("Created %d key(s) from input file", len(resp.Created.Items))
+			if len(resp.Skipped) > 0 {
+				fmt.Printf(", and %d key(s) were skipped because they exist already", len(resp.Skipped))
+			}
+			fmt.Printf(".\n")
+		},
+	}
+	command.Flags().StringVarP(&fromFile, "from", "f", "", "Path to the SSH allowed signers file or SSH public key file to import")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Override the principals of existing keys")
+	return command
+}
+
+// NewSigningKeyDeleteCommand removes an SSH signing key from the server's configuration
+func NewSigningKeyDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rm FINGERPRINT",
+		Short: "Removes an SSH signing key from the server's configuration",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.CheckError(fmt.Errorf("Missing FINGERPRINT argument"))
+			}
+			conn, signingKeyIf := headless.NewClientOrDie(clientOpts, c).NewSigningKeyClientOrDie()
+			defer argoio.Close(conn)
+			_, err := signingKeyIf.Delete(ctx, &signingkeypkg.SSHSigningKeyQuery{Fingerprint: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Deleted key with fingerprint %s\n", args[0])
+		},
+	}
+	return command
+}
+
+// Print table of SSH signing key info
+func printSigningKeyTable(keys []appsv1.SSHSigningKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "FINGERPRINT\tTYPE\tPRINCIPALS\n")
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", k.Fingerprint, k.KeyType, k.Principals)
+	}
+	_ = w.Flush()
+}
@@ -52,6 +52,8 @@ type AppOptions struct {
 	helmNamespace                   string
 	helmKubeVersion                 string
 	helmApiVersions                 []string
+	helmPostRendererKustomize       string
+	helmPostRendererPlugin          string
 	project                         string
 	syncPolicy                      string
 	syncOptions                     []string
@@ -86,6 +88,9 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	retryOnTimeout                  bool
+	syncTimeout                     time.Duration
+	syncHookTimeout                 time.Duration
 	ref                             string
 }
 
@@ -114,6 +119,8 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.helmNamespace, "helm-namespace", "", "Helm namespace to use when running helm template. If not set, use app.spec.destination.namespace")
 	command.Flags().StringVar(&opts.helmKubeVersion, "helm-kube-version", "", "Helm kube-version to use when running helm template. If not set, use the kube version from the destination cluster")
 	command.Flags().StringArrayVar(&opts.helmApiVersions, "helm-api-versions", []string{}, "Helm api-versions (in format [group/]version/kind) to use when running helm template (Can be repeated to set several values: --helm-api-versions traefik.io/v1alpha1/TLSOption --helm-api-versions v1/Service). If not set, use the api-versions from the destination cluster")
+	command.Flags().StringVar(&opts.helmPostRendererKustomize, "helm-post-renderer-kustomize", "", "Path of a Kustomize component to apply to the manifests rendered by Helm, relative to the application path or of the form $ref/path")
+	command.Flags().StringVar(&opts.helmPostRendererPlugin, "helm-post-renderer-plugin", "", "Name of a config management plugin to post-process the manifests rendered by Helm")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: manual (aliases of manual: none), automated (aliases of automated: auto, automatic))")
 	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Add or remove a sync option, e.g add `Prune=false`. Remove using `!` prefix, e.g. `!Prune=false`")
@@ -148,6 +155,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().BoolVar(&opts.retryOnTimeout, "sync-retry-on-timeout", false, "Retry syncs which were terminated because they exceeded their timeout")
+	command.Flags().DurationVar(&opts.syncTimeout, "sync-timeout", 0, "Terminate sync operations which do not complete within this duration (e.g. 10m, 1h). Zero means no timeout")
+	command.Flags().DurationVar(&opts.syncHookTimeout, "sync-hook-timeout", 0, "Terminate sync operations if a single hook runs longer than this duration (e.g. 2m, 1h). Zero means no timeout")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 }
 
@@ -232,6 +242,7 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 						MaxDuration: appOpts.retryBackoffMaxDuration.String(),
 						Factor:      ptr.To(appOpts.retryBackoffFactor),
 					},
+					OnTimeout: appOpts.retryOnTimeout,
 				}
 			} else if appOpts.retryLimit == 0 {
 				if spec.SyncPolicy.IsZero() {
@@ -242,6 +253,30 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "sync-timeout", "sync-hook-timeout":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.Timeout == nil {
+				spec.SyncPolicy.Timeout = &argoappv1.SyncTimeout{}
+			}
+			timeout := ""
+			if f.Name == "sync-timeout" && appOpts.syncTimeout > 0 {
+				timeout = appOpts.syncTimeout.String()
+			} else if f.Name == "sync-hook-timeout" && appOpts.syncHookTimeout > 0 {
+				timeout = appOpts.syncHookTimeout.String()
+			}
+			if f.Name == "sync-timeout" {
+				spec.SyncPolicy.Timeout.Operation = timeout
+			} else {
+				spec.SyncPolicy.Timeout.Hook = timeout
+			}
+			if *spec.SyncPolicy.Timeout == (argoappv1.SyncTimeout{}) {
+				spec.SyncPolicy.Timeout = nil
+			}
+			if spec.SyncPolicy.IsZero() {
+				spec.SyncPolicy = nil
+			}
 		}
 	})
 	if flags.Changed("auto-prune") {
@@ -364,6 +399,7 @@ type helmOpts struct {
 	namespace               string
 	kubeVersion             string
 	apiVersions             []string
+	postRenderer            *argoappv1.HelmPostRenderer
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -406,6 +442,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if len(opts.apiVersions) > 0 {
 		src.Helm.APIVersions = opts.apiVersions
 	}
+	if opts.postRenderer != nil {
+		src.Helm.PostRenderer = opts.postRenderer
+	}
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
@@ -672,6 +711,10 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setHelmOpt(source, helmOpts{kubeVersion: appOpts.helmKubeVersion})
 		case "helm-api-versions":
 			setHelmOpt(source, helmOpts{apiVersions: appOpts.helmApiVersions})
+		case "helm-post-renderer-kustomize":
+			setHelmOpt(source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{Kustomize: &argoappv1.HelmPostRendererKustomize{Path: appOpts.helmPostRendererKustomize}}})
+		case "helm-post-renderer-plugin":
+			setHelmOpt(source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{Plugin: &argoappv1.ApplicationSourcePlugin{Name: appOpts.helmPostRendererPlugin}}})
 		case "directory-recurse":
 			if source.Directory != nil {
 				source.Directory.Recurse = appOpts.directoryRecurse
@@ -85,6 +85,11 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{apiVersions: []string{"v1", "v2"}})
 		assert.Equal(t, []string{"v1", "v2"}, src.Helm.APIVersions)
 	})
+	t.Run("HelmPostRenderer", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{postRenderer: &v1alpha1.HelmPostRenderer{Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "$values/post-render"}}})
+		assert.Equal(t, "$values/post-render", src.Helm.PostRenderer.Kustomize.Path)
+	})
 }
 
 func Test_setKustomizeOpt(t *testing.T) {
@@ -258,6 +263,20 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("SyncTimeout", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-timeout", "10m"))
+		require.NoError(t, f.SetFlag("sync-hook-timeout", "1m"))
+		assert.Equal(t, &v1alpha1.SyncTimeout{Operation: "10m0s", Hook: "1m0s"}, f.spec.SyncPolicy.Timeout)
+
+		require.NoError(t, f.SetFlag("sync-retry-on-timeout", "true"))
+		require.NoError(t, f.SetFlag("sync-retry-limit", "5"))
+		assert.True(t, f.spec.SyncPolicy.Retry.OnTimeout)
+		require.NoError(t, f.SetFlag("sync-retry-limit", "0"))
+
+		require.NoError(t, f.SetFlag("sync-timeout", "0"))
+		require.NoError(t, f.SetFlag("sync-hook-timeout", "0"))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
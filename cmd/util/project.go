@@ -20,12 +20,13 @@ import (
 )
 
 type ProjectOpts struct {
-	Description                string
-	destinations               []string
-	destinationServiceAccounts []string
-	Sources                    []string
-	SignatureKeys              []string
-	SourceNamespaces           []string
+	Description                    string
+	destinations                   []string
+	destinationServiceAccounts     []string
+	Sources                        []string
+	SignatureKeys                  []string
+	SourceNamespaces               []string
+	skipChartSignatureVerification bool
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -40,7 +41,8 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
-	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
+	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs and SSH key fingerprints for commit signature verification")
+	command.Flags().BoolVar(&opts.skipChartSignatureVerification, "skip-chart-signature-verification", false, "Do not require Helm charts to be signed by one of the signature keys")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources")
@@ -117,14 +119,43 @@ func (opts *ProjectOpts) GetDestinationServiceAccounts() []v1alpha1.ApplicationD
 func (opts *ProjectOpts) GetSignatureKeys() []v1alpha1.SignatureKey {
 	signatureKeys := make([]v1alpha1.SignatureKey, 0)
 	for _, keyStr := range opts.SignatureKeys {
-		if !gpg.IsShortKeyID(keyStr) && !gpg.IsLongKeyID(keyStr) {
-			log.Fatalf("'%s' is not a valid GnuPG key ID", keyStr)
+		key, err := NewSignatureKey(keyStr, "", "", "", false)
+		if err != nil {
+			log.Fatal(err)
 		}
-		signatureKeys = append(signatureKeys, v1alpha1.SignatureKey{KeyID: gpg.KeyID(keyStr)})
+		signatureKeys = append(signatureKeys, key)
 	}
 	return signatureKeys
 }
 
+// NewSignatureKey returns a signature key for the given GnuPG key ID, SSH key fingerprint or, if cosign is true, cosign
+// key fingerprint, or for the given Sigstore identity if the issuer is not empty
+func NewSignatureKey(keyStr string, principal string, sigstoreIssuer string, sigstoreSubjectRegex string, cosign bool) (v1alpha1.SignatureKey, error) {
+	var key v1alpha1.SignatureKey
+	switch {
+	case cosign:
+		if principal != "" || sigstoreIssuer != "" {
+			return key, fmt.Errorf("neither a principal nor a Sigstore identity can be specified for cosign keys")
+		}
+		key.Cosign = &v1alpha1.CosignSignatureKey{Fingerprint: keyStr}
+	case sigstoreIssuer != "":
+		if keyStr != "" {
+			return key, fmt.Errorf("a key must not be specified for a Sigstore identity")
+		}
+		key.Sigstore = &v1alpha1.SigstoreIdentity{Issuer: sigstoreIssuer, SubjectRegex: sigstoreSubjectRegex}
+	case strings.HasPrefix(keyStr, "SHA256:"):
+		key.SSH = &v1alpha1.SSHSignatureKey{Fingerprint: keyStr, Principal: principal}
+	case gpg.IsShortKeyID(keyStr) || gpg.IsLongKeyID(keyStr):
+		if principal != "" {
+			return key, fmt.Errorf("a principal can only be specified for SSH keys")
+		}
+		key.KeyID = gpg.KeyID(keyStr)
+	default:
+		return key, fmt.Errorf("'%s' is neither a valid GnuPG key ID nor an SSH key fingerprint", keyStr)
+	}
+	return key, key.Validate()
+}
+
 func (opts *ProjectOpts) GetSourceNamespaces() []string {
 	return opts.SourceNamespaces
 }
@@ -176,6 +207,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceRepos = projOpts.Sources
 		case "signature-keys":
 			spec.SignatureKeys = projOpts.GetSignatureKeys()
+		case "skip-chart-signature-verification":
+			spec.SkipChartSignatureVerification = projOpts.skipChartSignatureVerification
 		case "allow-cluster-resource":
 			spec.ClusterResourceWhitelist = projOpts.GetAllowedClusterResources()
 		case "deny-cluster-resource":
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -48,3 +49,30 @@ func TestProjectOpts_GetDestinationServiceAccounts(t *testing.T) {
 		}, opts.GetDestinationServiceAccounts(),
 	)
 }
+
+func TestNewSignatureKey(t *testing.T) {
+	key, err := NewSignatureKey("4AEE18F83AFDEB23", "", "", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SignatureKey{KeyID: "4AEE18F83AFDEB23"}, key)
+
+	key, err = NewSignatureKey("SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s", "alice@example.com", "", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SignatureKey{SSH: &v1alpha1.SSHSignatureKey{Fingerprint: "SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s", Principal: "alice@example.com"}}, key)
+
+	key, err = NewSignatureKey("", "", "https://accounts.google.com", ".*@example.com", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SignatureKey{Sigstore: &v1alpha1.SigstoreIdentity{Issuer: "https://accounts.google.com", SubjectRegex: ".*@example.com"}}, key)
+
+	_, err = NewSignatureKey("4AEE18F83AFDEB23", "alice@example.com", "", "", false)
+	require.ErrorContains(t, err, "principal")
+	_, err = NewSignatureKey("invalid", "", "", "", false)
+	require.ErrorContains(t, err, "neither a valid GnuPG key ID nor an SSH key fingerprint")
+	_, err = NewSignatureKey("", "", "https://accounts.google.com", "(", false)
+	require.ErrorContains(t, err, "invalid subject regex")
+
+	key, err = NewSignatureKey("SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c", "", "", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SignatureKey{Cosign: &v1alpha1.CosignSignatureKey{Fingerprint: "SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"}}, key)
+	_, err = NewSignatureKey("4AEE18F83AFDEB23", "", "", "", true)
+	require.ErrorContains(t, err, "fingerprint of cosign key must start with SHA256:")
+}
@@ -25,6 +25,8 @@ type RepoOptions struct {
 	NoProxy                        string
 	GCPServiceAccountKeyPath       string
 	ForceHttpBasicAuth             bool
+	PartialClone                   bool
+	SparseCheckout                 bool
 }
 
 func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
@@ -48,4 +50,10 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().StringVar(&opts.Proxy, "no-proxy", "", "don't access these targets via proxy")
 	command.Flags().StringVar(&opts.GCPServiceAccountKeyPath, "gcp-service-account-key-path", "", "service account key for the Google Cloud Platform")
 	command.Flags().BoolVar(&opts.ForceHttpBasicAuth, "force-http-basic-auth", false, "whether to force use of basic auth when connecting repository via HTTP")
+	command.Flags().BoolVar(&opts.PartialClone, "partial-clone", false, "fetch the repository without file contents, which are then fetched on demand (Git only)")
+	command.Flags().BoolVar(&opts.SparseCheckout, "sparse-checkout", false, "only check out the paths used by applications (Git only)")
+	command.Flags().StringVar(&opts.Repo.TokenProviderURL, "token-provider-url", "", "URL of the token endpoint at which the service account token of the repo server is exchanged for short-lived access tokens (Git only)")
+	command.Flags().StringVar(&opts.Repo.TokenProviderClientID, "token-provider-client-id", "", "client ID used to request access tokens from the token provider, the service account token is then used as a client assertion")
+	command.Flags().StringVar(&opts.Repo.TokenProviderAudience, "token-provider-audience", "", "audience of the access tokens requested from the token provider")
+	command.Flags().StringVar(&opts.Repo.TokenProviderScope, "token-provider-scope", "", "space-separated scopes of the access tokens requested from the token provider")
 }
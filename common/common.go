@@ -44,6 +44,10 @@ const (
 	// ArgoCDTLSCertsConfigMapName contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// ArgoCDSigningKeysConfigMapName contains SSH public keys for verifying commit signatures. Will get mounted as volume to pods
+	ArgoCDSigningKeysConfigMapName = "argocd-signing-keys-cm"
+	// ArgoCDCosignKeysConfigMapName contains cosign public keys for verifying signatures of OCI Helm charts. Will get mounted as volume to pods
+	ArgoCDCosignKeysConfigMapName = "argocd-cosign-keys-cm"
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
@@ -83,6 +87,10 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// DefaultSSHSigningKeysPath is the Default path where SSH keys for verifying commit signatures are stored
+	DefaultSSHSigningKeysPath = "/app/config/signing-keys"
+	// DefaultCosignKeysPath is the Default path where cosign public keys for verifying chart signatures are stored
+	DefaultCosignKeysPath = "/app/config/cosign-keys"
 	// DefaultAppConfigPath is the Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// DefaultPluginSockFilePath is the Default path to cmp server plugin socket file
@@ -93,6 +101,18 @@ const (
 	PluginConfigFileName = "plugin.yaml"
 )
 
+// Programs verifying the signatures of commits, tags and Helm charts
+const (
+	// SignatureVerifierGPG verifies GnuPG signatures of commits and tags, and provenance files of charts
+	SignatureVerifierGPG = "gpg"
+	// SignatureVerifierSSH verifies SSH signatures of commits and tags
+	SignatureVerifierSSH = "ssh"
+	// SignatureVerifierGitsign verifies Sigstore signatures of commits and tags
+	SignatureVerifierGitsign = "gitsign"
+	// SignatureVerifierCosign verifies cosign signatures of OCI charts
+	SignatureVerifierCosign = "cosign"
+)
+
 // Argo CD application related constants
 const (
 
@@ -183,6 +203,15 @@ const (
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.argoproj.io/compare-options"
 
+	// AnnotationSyncPolicy is a comma-separated list of options overriding the application sync policy for a single resource
+	AnnotationSyncPolicy = "argocd.argoproj.io/sync-policy"
+	// SyncPolicyOptionManualSyncOnly excludes the resource from automated sync operations
+	SyncPolicyOptionManualSyncOnly = "ManualSyncOnly=true"
+	// SyncPolicyOptionSelfHealDisabled prevents automated sync operations from reverting changes of the resource
+	SyncPolicyOptionSelfHealDisabled = "SelfHeal=false"
+	// SyncPolicyOptionAutoPruneDisabled prevents automated sync operations from pruning the resource
+	SyncPolicyOptionAutoPruneDisabled = "AutoPrune=false"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
@@ -251,6 +280,15 @@ const (
 	EnvEnableGRPCTimeHistogramEnv = "ARGOCD_ENABLE_GRPC_TIME_HISTOGRAM"
 	// EnvGithubAppCredsExpirationDuration controls the caching of Github app credentials. This value is in minutes (default: 60)
 	EnvGithubAppCredsExpirationDuration = "ARGOCD_GITHUB_APP_CREDS_EXPIRATION_DURATION"
+	// EnvTokenProviderSubjectTokenPath is the path of the projected service account token which is exchanged for access
+	// tokens by token providers. Token providers cannot be used unless it is set.
+	EnvTokenProviderSubjectTokenPath = "ARGOCD_TOKEN_PROVIDER_SUBJECT_TOKEN_PATH"
+	// EnvTokenProviderSubjectTokenAudience is the dedicated audience which the projected service account token exchanged
+	// by token providers must only be valid for
+	EnvTokenProviderSubjectTokenAudience = "ARGOCD_TOKEN_PROVIDER_SUBJECT_TOKEN_AUDIENCE"
+	// EnvTokenProviderAllowedURLs is the comma-separated list of the URLs of the token endpoints which token providers
+	// may use. Token providers are disabled if empty.
+	EnvTokenProviderAllowedURLs = "ARGOCD_TOKEN_PROVIDER_ALLOWED_URLS"
 	// EnvHelmIndexCacheDuration controls how the helm repository index file is cached for (default: 0)
 	EnvHelmIndexCacheDuration = "ARGOCD_HELM_INDEX_CACHE_DURATION"
 	// EnvAppConfigPath allows to override the configuration path for repo server
@@ -273,6 +311,12 @@ const (
 	EnvCMPWorkDir = "ARGOCD_CMP_WORKDIR"
 	// EnvGPGDataPath overrides the location where GPG keyring for signature verification is stored
 	EnvGPGDataPath = "ARGOCD_GPG_DATA_PATH"
+	// EnvSSHSigningKeysPath overrides the location where SSH keys for verifying commit signatures are stored
+	EnvSSHSigningKeysPath = "ARGOCD_SSH_SIGNING_KEYS_PATH"
+	// EnvSigstoreTrustRoot is the path to the PEM encoded Fulcio root certificates gitsign signatures are verified against
+	EnvSigstoreTrustRoot = "ARGOCD_SIGSTORE_TRUST_ROOT"
+	// EnvCosignKeysPath overrides the location where cosign public keys for verifying chart signatures are stored
+	EnvCosignKeysPath = "ARGOCD_COSIGN_KEYS_PATH"
 	// EnvServerName is the name of the Argo CD server component, as specified by the value under the LabelKeyAppName label key.
 	EnvServerName = "ARGOCD_SERVER_NAME"
 	// EnvRepoServerName is the name of the Argo CD repo server component, as specified by the value under the LabelKeyAppName label key.
@@ -347,6 +391,24 @@ func GetGnuPGHomePath() string {
 	}
 }
 
+// GetSSHSigningKeysPath retrieves the path of the SSH keys for verifying commit signatures, which is either taken from
+// the ARGOCD_SSH_SIGNING_KEYS_PATH environment or a default value
+func GetSSHSigningKeysPath() string {
+	if path := os.Getenv(EnvSSHSigningKeysPath); path != "" {
+		return path
+	}
+	return DefaultSSHSigningKeysPath
+}
+
+// GetCosignKeysPath retrieves the path of the cosign public keys for verifying chart signatures, which is either taken
+// from the ARGOCD_COSIGN_KEYS_PATH environment or a default value
+func GetCosignKeysPath() string {
+	if path := os.Getenv(EnvCosignKeysPath); path != "" {
+		return path
+	}
+	return DefaultCosignKeysPath
+}
+
 // GetPluginSockFilePath retrieves the path of plugin sock file, which is either taken from PluginSockFilePath environment or a default value
 func GetPluginSockFilePath() string {
 	if pluginSockFilePath := os.Getenv(EnvPluginSockFilePath); pluginSockFilePath == "" {
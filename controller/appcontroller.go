@@ -292,7 +292,7 @@ func NewApplicationController(
 		}
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	appStateManager := NewAppStateManager(db, applicationClientset, kubeClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -980,7 +980,10 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	}
 	ts.AddCheckpoint("get_fresh_app_ms")
 
-	if app.Operation != nil {
+	if app.Operation != nil && app.Operation.Approval != nil && !isOperationInProgress(app) {
+		ctrl.processPendingAppOperation(app)
+		ts.AddCheckpoint("process_pending_app_operation_ms")
+	} else if app.Operation != nil {
 		ctrl.processRequestedAppOperation(app)
 		ts.AddCheckpoint("process_requested_app_operation_ms")
 	} else if app.DeletionTimestamp != nil {
@@ -1315,6 +1318,41 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// processPendingAppOperation handles operations which need to be approved. They are not run until they are approved,
+// and fail once they expire. Approved operations also fail if the spec of the application or their revisions changed
+// since they were requested, since this is not what was approved.
+func (ctrl *ApplicationController) processPendingAppOperation(app *appv1.Application) {
+	approval := app.Operation.Approval
+	if !approval.IsPending() {
+		if err := approval.Verify(&app.Spec, app.Operation.Sync); err != nil {
+			ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationCompleted, Type: v1.EventTypeWarning},
+				fmt.Sprintf("Sync approved by %s was not run: %v", approval.ApprovedBy, err), context.TODO())
+			ctrl.setOperationState(app, &appv1.OperationState{
+				Phase:     synccommon.OperationFailed,
+				Operation: *app.Operation,
+				StartedAt: approval.RequestedAt,
+				Message:   fmt.Sprintf("Approved sync was not run: %v", err),
+			})
+			return
+		}
+		ctrl.processRequestedAppOperation(app)
+		return
+	}
+	if expiresIn := time.Until(approval.ExpiresAt.Time); expiresIn > 0 {
+		getAppLog(app).Debugf("Operation is pending approval until %s", approval.ExpiresAt.Format(time.RFC3339))
+		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), expiresIn)
+		return
+	}
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonSyncApprovalExpired, Type: v1.EventTypeWarning},
+		fmt.Sprintf("sync requested by %s expired without approval", app.Operation.InitiatedBy.Username), context.TODO())
+	ctrl.setOperationState(app, &appv1.OperationState{
+		Phase:     synccommon.OperationFailed,
+		Operation: *app.Operation,
+		StartedAt: approval.RequestedAt,
+		Message:   fmt.Sprintf("Sync request expired without approval at %s", approval.ExpiresAt.Format(time.RFC3339)),
+	})
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := getAppLog(app)
 	var state *appv1.OperationState
@@ -1342,9 +1380,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	terminating := false
 	if isOperationInProgress(app) {
 		state = app.Status.OperationState.DeepCopy()
-		terminating = state.Phase == synccommon.OperationTerminating
+		// attempts terminated because of a timeout are retried if the retry strategy opted in to it when they timed out
+		terminating = state.Phase == synccommon.OperationTerminating && (state.TimeoutMessage == "" || !state.RetryOnTimeout)
 		// Failed  operation with retry strategy might have be in-progress and has completion time
-		if state.FinishedAt != nil && !terminating {
+		if state.FinishedAt != nil && state.Phase != synccommon.OperationTerminating {
 			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
 			if err != nil {
 				state.Phase = synccommon.OperationFailed
@@ -1361,6 +1400,8 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// retrying operation. remove previous failure time in app since it is used as a trigger
 				// that previous failed and operation should be retried
 				state.FinishedAt = nil
+				now := metav1.Now()
+				state.AttemptStartedAt = &now
 				ctrl.setOperationState(app, state)
 				// Get rid of sync results and null out previous operation completion time
 				state.SyncResult = nil
@@ -1375,6 +1416,30 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 	ts.AddCheckpoint("initial_operation_stage_ms")
 
+	timeout := getSyncTimeout(app, state)
+	// the timeout of an attempt which is still being terminated was detected by a previous reconciliation
+	timeoutMessage := state.TimeoutMessage
+	if state.Phase == synccommon.OperationRunning {
+		msg, err := syncTimeoutExceeded(state, timeout, time.Now())
+		if err != nil {
+			state.Phase = synccommon.OperationFailed
+			state.Message = fmt.Sprintf("invalid sync timeout: %v", err)
+			ctrl.setOperationState(app, state)
+			return
+		}
+		if msg != "" {
+			logCtx.Info(msg)
+			// terminate the operation so that running hooks are cleaned up. The operation is only
+			// retried if the retry strategy explicitly opted in to retrying on timeouts. Both are saved in
+			// the operation state, since the termination may take several reconciliations.
+			state.Phase = synccommon.OperationTerminating
+			state.TimeoutMessage = msg
+			state.RetryOnTimeout = state.Operation.Retry.OnTimeout
+			timeoutMessage = msg
+			terminating = !state.RetryOnTimeout
+		}
+	}
+
 	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
@@ -1383,6 +1448,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 	ts.AddCheckpoint("validate_and_sync_app_state_ms")
 
+	if timeoutMessage != "" && state.Phase.Completed() {
+		state.Message = fmt.Sprintf("%s: %s", timeoutMessage, state.Message)
+	}
+
 	// Check whether application is allowed to use project
 	_, err := ctrl.getAppProj(app)
 	ts.AddCheckpoint("get_app_proj_ms")
@@ -1410,6 +1479,14 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// cleanup (e.g. delete jobs, workflows, etc...)
 			}
 		}
+		if operationTimeout, err := timeout.OperationTimeout(); err == nil && operationTimeout > 0 && state.Phase == synccommon.OperationRunning {
+			// make sure the operation gets processed again once the timeout elapses, even if nothing else changes
+			remaining := time.Until(state.CurrentAttemptStartedAt().Add(operationTimeout))
+			if remaining < 0 {
+				remaining = 0
+			}
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remaining)
+		}
 	} else if state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError {
 		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
 			now := metav1.Now()
@@ -1421,6 +1498,9 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				state.Phase = synccommon.OperationRunning
 				state.RetryCount++
 				state.Message = fmt.Sprintf("%s. Retrying attempt #%d at %s.", state.Message, state.RetryCount, retryAt.Format(time.Kitchen))
+				// the next attempt has its own timeout
+				state.TimeoutMessage = ""
+				state.RetryOnTimeout = false
 			}
 		} else if state.RetryCount > 0 {
 			state.Message = fmt.Sprintf("%s (retried %d times).", state.Message, state.RetryCount)
@@ -1695,7 +1775,16 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
+	requiresApproval, err := project.RequiresSyncApproval(app)
+	if err != nil {
+		logCtx.Warnf("Failed to determine whether syncs require approval: %v", err)
+	}
+	if requiresApproval || err != nil {
+		// automated syncs cannot be approved, so they are not run for applications which require approval
+		if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+			logCtx.Info("Skipping auto-sync: syncs require approval")
+		}
+	} else if project.Spec.SyncWindows.Matches(app).CanSync(false) {
 		syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
 		setOpMs = opMS
 		if syncErrCond != nil {
@@ -1949,6 +2038,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if !hasAutoSyncableResources(resources) {
+		logCtx.Infof("Skipping auto-sync: all out-of-sync resources are excluded from automated sync by their sync policy")
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -2002,8 +2096,13 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	} else if alreadyAttempted && selfHeal {
 		if shouldSelfHeal, retryAfter := ctrl.shouldSelfHeal(app); shouldSelfHeal {
+			selfHealDisabled := false
 			for _, resource := range resources {
 				if resource.Status != appv1.SyncStatusCodeSynced {
+					if !isSelfHealPermitted(resource) {
+						selfHealDisabled = true
+						continue
+					}
 					op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
 						Kind:  resource.Kind,
 						Group: resource.Group,
@@ -2011,6 +2110,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 					})
 				}
 			}
+			if selfHealDisabled && len(op.Sync.Resources) == 0 {
+				logCtx.Infof("Skipping auto-sync: self-heal is disabled for all out-of-sync resources by their sync policy")
+				return nil, 0
+			}
 		} else {
 			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", desiredCommitSHA, ctrl.selfHealTimeout, retryAfter)
 			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
@@ -2066,6 +2169,30 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil, setOpTime
 }
 
+// hasAutoSyncableResources returns whether any of the out-of-sync resources may be synced by an automated sync
+// operation according to its sync policy
+func hasAutoSyncableResources(resources []appv1.ResourceStatus) bool {
+	outOfSync := false
+	for _, r := range resources {
+		if r.Status != appv1.SyncStatusCodeOutOfSync {
+			continue
+		}
+		outOfSync = true
+		if r.RequiresPruning && r.SyncPolicy.AllowsAutoPrune() || !r.RequiresPruning && r.SyncPolicy.AllowsAutoSync() {
+			return true
+		}
+	}
+	return !outOfSync
+}
+
+// isSelfHealPermitted returns whether the sync policy of a resource permits self-healing it
+func isSelfHealPermitted(r appv1.ResourceStatus) bool {
+	if r.RequiresPruning {
+		return r.SyncPolicy.AllowsAutoPrune()
+	}
+	return r.SyncPolicy.AllowsSelfHeal()
+}
+
 // alreadyAttemptedSync returns whether the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string, commitSHAsMS []string, hasMultipleSources bool, revisionUpdated bool) (bool, synccommon.OperationPhase) {
@@ -2345,6 +2472,44 @@ func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
 
+// getSyncTimeout returns the sync timeout of the operation, falling back to the one in the application sync policy
+func getSyncTimeout(app *appv1.Application, state *appv1.OperationState) *appv1.SyncTimeout {
+	if state.Operation.Sync != nil && state.Operation.Sync.Timeout != nil {
+		return state.Operation.Sync.Timeout
+	}
+	if app.Spec.SyncPolicy != nil {
+		return app.Spec.SyncPolicy.Timeout
+	}
+	return nil
+}
+
+// syncTimeoutExceeded returns a message describing which of the given timeouts was exceeded by the operation, or an
+// empty string if the operation is still within its time limits.
+func syncTimeoutExceeded(state *appv1.OperationState, timeout *appv1.SyncTimeout, now time.Time) (string, error) {
+	operationTimeout, err := timeout.OperationTimeout()
+	if err != nil {
+		return "", fmt.Errorf("error parsing operation timeout: %w", err)
+	}
+	hookTimeout, err := timeout.HookTimeout()
+	if err != nil {
+		return "", fmt.Errorf("error parsing hook timeout: %w", err)
+	}
+	if operationTimeout > 0 && now.Sub(state.CurrentAttemptStartedAt().Time) > operationTimeout {
+		return fmt.Sprintf("sync operation exceeded timeout of %s", operationTimeout), nil
+	}
+	if hookTimeout > 0 && state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			if res.HookType == "" || res.HookPhase != synccommon.OperationRunning || res.StartedAt == nil {
+				continue
+			}
+			if now.Sub(res.StartedAt.Time) > hookTimeout {
+				return fmt.Sprintf("sync hook %s %s/%s exceeded timeout of %s", res.Kind, res.Namespace, res.Name, hookTimeout), nil
+			}
+		}
+	}
+	return "", nil
+}
+
 // automatedSyncEnabled tests if an app went from auto-sync disabled to enabled.
 // if it was toggled to be enabled, the informer handler will force a refresh
 func automatedSyncEnabled(oldApp *appv1.Application, newApp *appv1.Application) bool {
@@ -738,6 +738,91 @@ func TestSkipAutoSync(t *testing.T) {
 		require.NoError(t, err)
 		assert.Nil(t, app.Operation)
 	})
+
+	t.Run("OutOfSyncResourcesAreManualSyncOnly", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		syncStatus := v1alpha1.SyncStatus{
+			Status:   v1alpha1.SyncStatusCodeOutOfSync,
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, SyncPolicy: &v1alpha1.ResourceSyncPolicy{ManualSyncOnly: true, SelfHealDisabled: true, AutoPruneDisabled: true}},
+			{Name: "guestbook", Kind: kube.ServiceKind, Status: v1alpha1.SyncStatusCodeSynced},
+		}, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("NeedsToPruneResourcesOnlyButAutoPruneDisabledByResource", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.Prune = true
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		syncStatus := v1alpha1.SyncStatus{
+			Status:   v1alpha1.SyncStatusCodeOutOfSync,
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true, SyncPolicy: &v1alpha1.ResourceSyncPolicy{AutoPruneDisabled: true}},
+		}, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+}
+
+// TestAutoSyncSelfHealResourceSyncPolicy verifies that self-heal skips resources which opted out of it
+func TestAutoSyncSelfHealResourceSyncPolicy(t *testing.T) {
+	newSyncedApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = true
+		finishedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{},
+			},
+			Phase:      synccommon.OperationSucceeded,
+			FinishedAt: &finishedAt,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				Source:   *app.Spec.Source.DeepCopy(),
+			},
+		}
+		return app
+	}
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+
+	t.Run("SelfHealOnlyPermittedResources", func(t *testing.T) {
+		app := newSyncedApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, SyncPolicy: &v1alpha1.ResourceSyncPolicy{SelfHealDisabled: true}},
+			{Name: "guestbook", Kind: kube.ServiceKind, Status: v1alpha1.SyncStatusCodeOutOfSync},
+		}, false)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, []v1alpha1.SyncOperationResource{{Kind: kube.ServiceKind, Name: "guestbook"}}, app.Operation.Sync.Resources)
+	})
+
+	t.Run("SelfHealDisabledForAllResources", func(t *testing.T) {
+		app := newSyncedApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
+			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, SyncPolicy: &v1alpha1.ResourceSyncPolicy{SelfHealDisabled: true}},
+		}, false)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
 }
 
 // TestAutoSyncIndicateError verifies we skip auto-sync and return error condition if previous sync failed
@@ -1757,6 +1842,86 @@ func TestProcessRequestedAppOperation_FailedNoRetries(t *testing.T) {
 	assert.Equal(t, string(synccommon.OperationError), phase)
 }
 
+func TestProcessPendingAppOperation(t *testing.T) {
+	newPendingApp := func(expiresAt time.Time) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.Project = "default"
+		app.Operation = &v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"},
+			Approval: &v1alpha1.OperationApproval{
+				Phase:       v1alpha1.OperationApprovalPending,
+				RequestedAt: metav1.NewTime(expiresAt.Add(-time.Hour)),
+				ExpiresAt:   metav1.NewTime(expiresAt),
+			},
+		}
+		return app
+	}
+
+	t.Run("pending", func(t *testing.T) {
+		app := newPendingApp(time.Now().Add(time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		patched := false
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+
+		ctrl.processPendingAppOperation(app)
+
+		assert.False(t, patched)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		app := newPendingApp(time.Now().Add(-time.Minute))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		receivedPatch := map[string]interface{}{}
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+
+		ctrl.processPendingAppOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "expired without approval")
+		operation, ok := receivedPatch["operation"]
+		assert.True(t, ok)
+		assert.Nil(t, operation)
+	})
+
+	t.Run("approved with changed spec", func(t *testing.T) {
+		app := newPendingApp(time.Now().Add(time.Hour))
+		specHash, err := app.Spec.Hash()
+		require.NoError(t, err)
+		app.Operation.Approval.Phase = v1alpha1.OperationApprovalApproved
+		app.Operation.Approval.SpecHash = specHash
+		app.Spec.Source.TargetRevision = "other"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		receivedPatch := map[string]interface{}{}
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+
+		ctrl.processPendingAppOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "application spec changed since the sync was requested")
+	})
+}
+
 func TestProcessRequestedAppOperation_InvalidDestination(t *testing.T) {
 	app := newFakeAppWithDestMismatch()
 	app.Spec.Project = "test-project"
@@ -1887,6 +2052,167 @@ func TestProcessRequestedAppOperation_HasRetriesTerminated(t *testing.T) {
 	assert.Equal(t, string(synccommon.OperationFailed), phase)
 }
 
+func TestProcessRequestedAppOperation_Timeout(t *testing.T) {
+	newApp := func(retry v1alpha1.RetryStrategy) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Timeout: &v1alpha1.SyncTimeout{Operation: "1m"}}
+		app.Operation = &v1alpha1.Operation{
+			Sync:  &v1alpha1.SyncOperation{},
+			Retry: retry,
+		}
+		app.Status.OperationState.Phase = synccommon.OperationRunning
+		app.Status.OperationState.StartedAt = metav1.NewTime(time.Now().Add(-10 * time.Minute))
+		app.Status.OperationState.FinishedAt = nil
+		app.Status.OperationState.Operation = *app.Operation
+		return app
+	}
+	processOperation := func(app *v1alpha1.Application) map[string]interface{} {
+		data := &fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+		}
+		ctrl := newFakeController(data, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		receivedPatch := map[string]interface{}{}
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+		ctrl.processRequestedAppOperation(app)
+		return receivedPatch
+	}
+
+	t.Run("Terminated", func(t *testing.T) {
+		receivedPatch := processOperation(newApp(v1alpha1.RetryStrategy{Limit: 10}))
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "sync operation exceeded timeout of 1m0s")
+	})
+	t.Run("RetriedOnTimeout", func(t *testing.T) {
+		receivedPatch := processOperation(newApp(v1alpha1.RetryStrategy{Limit: 10, OnTimeout: true}))
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationRunning), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "sync operation exceeded timeout of 1m0s")
+		assert.Contains(t, message, "Retrying attempt #1")
+	})
+	t.Run("TimeoutSaved", func(t *testing.T) {
+		app := newApp(v1alpha1.RetryStrategy{Limit: 10, OnTimeout: true})
+		data := &fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+		}
+		ctrl := newFakeController(data, nil)
+		var state *v1alpha1.OperationState
+		ctrl.appStateManager = &fakeTerminatingAppStateManager{AppStateManager: ctrl.appStateManager, onSync: func(s *v1alpha1.OperationState) {
+			state = s.DeepCopy()
+		}}
+		ctrl.processRequestedAppOperation(app)
+		require.NotNil(t, state)
+		assert.Equal(t, synccommon.OperationTerminating, state.Phase)
+		assert.Equal(t, "sync operation exceeded timeout of 1m0s", state.TimeoutMessage)
+		assert.True(t, state.RetryOnTimeout)
+	})
+	t.Run("RetriedOnTimeoutAfterTermination", func(t *testing.T) {
+		// the timeout was detected by a previous reconciliation, which started the termination
+		app := newApp(v1alpha1.RetryStrategy{Limit: 10, OnTimeout: true})
+		app.Status.OperationState.Phase = synccommon.OperationTerminating
+		app.Status.OperationState.TimeoutMessage = "sync operation exceeded timeout of 1m0s"
+		app.Status.OperationState.RetryOnTimeout = true
+		receivedPatch := processOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationRunning), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "sync operation exceeded timeout of 1m0s")
+		assert.Contains(t, message, "Retrying attempt #1")
+		timeoutMessage, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "timeoutMessage")
+		assert.Empty(t, timeoutMessage)
+	})
+	t.Run("NotRetriedAfterTermination", func(t *testing.T) {
+		app := newApp(v1alpha1.RetryStrategy{Limit: 10, OnTimeout: true})
+		app.Status.OperationState.Phase = synccommon.OperationTerminating
+		app.Status.OperationState.TimeoutMessage = "sync operation exceeded timeout of 1m0s"
+		receivedPatch := processOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "sync operation exceeded timeout of 1m0s")
+		assert.NotContains(t, message, "Retrying")
+	})
+	t.Run("OperationOverridesPolicy", func(t *testing.T) {
+		app := newApp(v1alpha1.RetryStrategy{})
+		app.Status.OperationState.Operation.Sync.Timeout = &v1alpha1.SyncTimeout{Operation: "1h"}
+		receivedPatch := processOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	})
+	t.Run("InvalidTimeout", func(t *testing.T) {
+		app := newApp(v1alpha1.RetryStrategy{})
+		app.Spec.SyncPolicy.Timeout.Operation = "invalid"
+		receivedPatch := processOperation(app)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Contains(t, message, "invalid sync timeout")
+	})
+}
+
+func TestSyncTimeoutExceeded(t *testing.T) {
+	now := time.Now()
+	startedAt := metav1.NewTime(now.Add(-5 * time.Minute))
+	state := &v1alpha1.OperationState{
+		StartedAt: startedAt,
+		SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{{
+			Kind:      "Job",
+			Namespace: "default",
+			Name:      "migrate",
+			HookType:  synccommon.HookTypePreSync,
+			HookPhase: synccommon.OperationRunning,
+			StartedAt: &startedAt,
+		}}},
+	}
+
+	msg, err := syncTimeoutExceeded(state, nil, now)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	msg, err = syncTimeoutExceeded(state, &v1alpha1.SyncTimeout{Operation: "10m", Hook: "1m"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "sync hook Job default/migrate exceeded timeout of 1m0s", msg)
+
+	msg, err = syncTimeoutExceeded(state, &v1alpha1.SyncTimeout{Operation: "60"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "sync operation exceeded timeout of 1m0s", msg)
+
+	attemptStartedAt := metav1.NewTime(now.Add(-30 * time.Second))
+	state.AttemptStartedAt = &attemptStartedAt
+	msg, err = syncTimeoutExceeded(state, &v1alpha1.SyncTimeout{Operation: "60"}, now)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	_, err = syncTimeoutExceeded(state, &v1alpha1.SyncTimeout{Hook: "invalid"}, now)
+	require.Error(t, err)
+}
+
 func TestProcessRequestedAppOperation_Successful(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "default"
@@ -2191,3 +2517,13 @@ func TestAlreadyAttemptSync(t *testing.T) {
 		assert.False(t, attempted)
 	})
 }
+
+// fakeTerminatingAppStateManager records the state of the operation when it is synced, and leaves it terminating
+type fakeTerminatingAppStateManager struct {
+	AppStateManager
+	onSync func(state *v1alpha1.OperationState)
+}
+
+func (m *fakeTerminatingAppStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
+	m.onSync(state)
+}
@@ -65,6 +65,13 @@ var (
 		nil,
 	)
 
+	descAppDriftAge = prometheus.NewDesc(
+		"argocd_app_drift_age_seconds",
+		"Time in seconds since the oldest resource drift of the application was first observed.",
+		descAppDefaultLabels,
+		nil,
+	)
+
 	// Deprecated
 	descAppCreated = prometheus.NewDesc(
 		"argocd_app_created_time",
@@ -337,6 +344,7 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 		ch <- descAppConditions
 	}
 	ch <- descAppInfo
+	ch <- descAppDriftAge
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
 }
@@ -391,6 +399,10 @@ func (c *appCollector) collectApps(ch chan<- prometheus.Metric, app *argoappv1.A
 
 	addGauge(descAppInfo, 1, strconv.FormatBool(autoSyncEnabled), git.NormalizeGitURL(app.Spec.GetSource().RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace, string(syncStatus), string(healthStatus), operation)
 
+	if drift := app.Status.OldestDrift(); drift != nil {
+		addGauge(descAppDriftAge, drift.Age(time.Now()).Seconds())
+	}
+
 	if len(c.appLabels) > 0 {
 		labelValues := []string{}
 		for _, desiredLabel := range c.appLabels {
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -423,6 +424,45 @@ argocd_app_sync_status{name="my-app",namespace="argocd",project="important-proje
 	testApp(t, []string{fakeApp}, expectedResponse)
 }
 
+func TestMetricDriftAge(t *testing.T) {
+	app := newFakeApp(fakeApp)
+	app.Status.Resources = []argoappv1.ResourceStatus{
+		{Kind: "Deployment", Name: "guestbook-ui", Drift: &argoappv1.ResourceDrift{StartedAt: metav1.NewTime(time.Now().Add(-time.Hour))}},
+		{Kind: "Service", Name: "guestbook-ui", Drift: &argoappv1.ResourceDrift{StartedAt: metav1.NewTime(time.Now().Add(-time.Minute))}},
+		{Kind: "ConfigMap", Name: "guestbook-ui"},
+	}
+	appYAML, err := yaml.Marshal(app)
+	require.NoError(t, err)
+
+	cancel, appLister := newFakeLister(string(appYAML), fakeApp2)
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+
+	assertMetricsPrinted(t, `
+# HELP argocd_app_drift_age_seconds Time in seconds since the oldest resource drift of the application was first observed.
+# TYPE argocd_app_drift_age_seconds gauge
+`, body)
+	var ages []float64
+	for _, line := range strings.Split(body, "\n") {
+		if value, ok := strings.CutPrefix(line, `argocd_app_drift_age_seconds{name="my-app",namespace="argocd",project="important-project"} `); ok {
+			age, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			ages = append(ages, age)
+		}
+	}
+	require.Len(t, ages, 1)
+	assert.InDelta(t, time.Hour.Seconds(), ages[0], 60)
+	assert.NotContains(t, body, `argocd_app_drift_age_seconds{name="my-app-2"`)
+}
+
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
@@ -2,10 +2,12 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	goSync "sync"
 	"time"
@@ -21,10 +23,14 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -41,7 +47,9 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/manifeststream"
 	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/signing"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
 
@@ -105,6 +113,7 @@ type appStateManager struct {
 	db                    db.ArgoDB
 	settingsMgr           *settings.SettingsManager
 	appclientset          appclientset.Interface
+	kubeClientset         kubernetes.Interface
 	projInformer          cache.SharedIndexInformer
 	kubectl               kubeutil.Kubectl
 	repoClientset         apiclient.Clientset
@@ -116,10 +125,60 @@ type appStateManager struct {
 	persistResourceHealth bool
 	repoErrorCache        goSync.Map
 	repoErrorGracePeriod  time.Duration
+	destinationClients    goSync.Map
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
 }
 
+// destinationClient is a client of a destination cluster, which was created for the given cluster config
+type destinationClient struct {
+	configHash string
+	client     kubernetes.Interface
+}
+
+// getDestinationClient returns a client of the destination cluster of the application. If impersonation is enabled, it
+// impersonates the service account the project assigns to the destination, so that the application cannot read more
+// than it could deploy. Clients are cached per cluster and service account, and replaced once the cluster config
+// changes.
+func (m *appStateManager) getDestinationClient(app *v1alpha1.Application, proj *v1alpha1.AppProject) (kubernetes.Interface, error) {
+	cluster, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccount := ""
+	impersonationEnabled, err := m.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonation setting: %w", err)
+	}
+	if impersonationEnabled {
+		if serviceAccount, err = deriveServiceAccountToImpersonate(proj, app); err != nil {
+			return nil, fmt.Errorf("failed to find a matching service account to impersonate: %w", err)
+		}
+	}
+	configJSON, err := json.Marshal(cluster.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling cluster config: %w", err)
+	}
+	configHash := fmt.Sprintf("%x", sha256.Sum256(configJSON))
+	key := cluster.Server + "|" + serviceAccount
+	if cached, ok := m.destinationClients.Load(key); ok && cached.(*destinationClient).configHash == configHash {
+		return cached.(*destinationClient).client, nil
+	}
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccount != "" {
+		config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	m.destinationClients.Store(key, &destinationClient{configHash: configHash, client: client})
+	return client, nil
+}
+
 // GetRepoObjs will generate the manifests for the given application delegating the
 // task to the repo-server. It returns the list of generated manifests as unstructured
 // objects. It also returns the full response from all calls to the repo server as the
@@ -253,8 +312,15 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			atLeastOneRevisionIsNotPossibleToBeUpdated = true
 		}
 
+		helmValuesFrom, err := argo.GetHelmValuesFrom(context.Background(), app, &source, proj, m.kubeClientset, func() (kubernetes.Interface, error) {
+			return m.getDestinationClient(app, proj)
+		})
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to get Helm values for source %d of %d: %w", i+1, len(sources), err)
+		}
+
 		log.Debugf("Generating Manifest for source %s revision %s", source, revision)
-		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		manifestInfo, err := generateManifest(context.Background(), repoClient, &apiclient.ManifestRequest{
 			Repo:                            repo,
 			Repos:                           permittedHelmRepos,
 			Revision:                        revision,
@@ -267,7 +333,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			KustomizeOptions:                kustomizeOptions,
 			KubeVersion:                     serverVersion,
 			ApiVersions:                     argo.APIResourcesToStrings(apiResources, true),
-			VerifySignature:                 verifySignature,
+			VerifySignature:                 verifySignature && (!source.IsHelm() || !proj.Spec.SkipChartSignatureVerification),
 			HelmRepoCreds:                   permittedHelmCredentials,
 			TrackingMethod:                  string(argo.GetTrackingMethod(m.settingsMgr)),
 			EnabledSourceTypes:              enabledSourceTypes,
@@ -278,6 +344,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
+			HelmValuesFrom:                  helmValuesFrom,
 		})
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
@@ -307,6 +374,22 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 	return targetObjs, manifestInfos, revisionUpdated, nil
 }
 
+// generateManifest generates the manifests of a source. If the response exceeds the maximum gRPC message size, the
+// manifests are generated again using the streaming variant of the RPC, which sends the response in compressed chunks.
+// The repo server caches the manifests of the first request, so they are not actually generated twice.
+func generateManifest(ctx context.Context, repoClient apiclient.RepoServerServiceClient, req *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	res, err := repoClient.GenerateManifest(ctx, req)
+	if status.Code(err) != codes.ResourceExhausted {
+		return res, err
+	}
+	log.WithField("application", req.AppName).Infof("Manifest response exceeds the maximum message size, streaming it instead: %v", err)
+	stream, err := repoClient.GenerateManifestStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return manifeststream.ReceiveManifestResponse(stream)
+}
+
 func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, error) {
 	targetObjs := make([]*unstructured.Unstructured, 0)
 	for _, manifest := range manifests {
@@ -381,40 +464,70 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 	return appLabelKey, resourceOverrides, resFilter, installationID, nil
 }
 
-// verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
+// verifyCommitSignature verifies the result of a GnuPG, SSH or gitsign signature verification for a given git
 // revision.
-func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+func verifyCommitSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+	// We need to have some data in the verification result to parse, otherwise there was no signature
+	if manifestInfo.VerifyResult == "" {
+		now := metav1.Now()
+		msg := fmt.Sprintf("Target revision %s in Git is not signed, but a signature is required", revision)
+		return []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now}}
+	}
+	return verifySignatureResult(project, manifestInfo.SignatureVerifier, manifestInfo.VerifyResult,
+		fmt.Sprintf("Could not verify commit signature on revision '%s', check logs for more information.", revision))
+}
+
+// verifyChartSignature verifies the result of the verification of the provenance file or the cosign signature of a
+// Helm chart.
+func verifyChartSignature(source v1alpha1.ApplicationSource, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
+	if manifestInfo.VerifyResult == "" {
+		now := metav1.Now()
+		msg := fmt.Sprintf("Chart %s version %s is not signed, but a signature is required", source.Chart, manifestInfo.Revision)
+		return []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now}}
+	}
+	return verifySignatureResult(project, manifestInfo.SignatureVerifier, manifestInfo.VerifyResult,
+		fmt.Sprintf("Could not verify signature of chart %s version %s, check logs for more information.", source.Chart, manifestInfo.Revision))
+}
+
+// verifySignatureResult returns conditions for the verification result of a signature by the given verifier, unless it
+// is a good signature made with one of the signature keys of the project.
+func verifySignatureResult(project *v1alpha1.AppProject, verifier string, result string, unknownMsg string) []v1alpha1.ApplicationCondition {
 	now := metav1.Now()
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
-	// We need to have some data in the verification result to parse, otherwise there was no signature
-	if manifestInfo.VerifyResult != "" {
-		verifyResult := gpg.ParseGitCommitVerification(manifestInfo.VerifyResult)
-		switch verifyResult.Result {
-		case gpg.VerifyResultGood:
-			// This is the only case we allow to sync to, but we need to make sure signing key is allowed
-			validKey := false
-			for _, k := range project.Spec.SignatureKeys {
-				if gpg.KeyID(k.KeyID) == gpg.KeyID(verifyResult.KeyID) && gpg.KeyID(k.KeyID) != "" {
-					validKey = true
-					break
-				}
-			}
-			if !validKey {
-				msg := fmt.Sprintf("Found good signature made with %s key %s, but this key is not allowed in AppProject",
+	verifyResult := signing.ParseVerification(verifier, result)
+	switch verifyResult.Result {
+	case gpg.VerifyResultGood:
+		// This is the only case we allow to sync to, but we need to make sure signing key is allowed
+		if !signing.IsKeyAllowed(project.Spec.SignatureKeys, verifyResult) {
+			var msg string
+			switch verifyResult.Type {
+			case signing.SignatureTypeSSH:
+				msg = fmt.Sprintf("Found good signature made with %s SSH key %s for %s, but this key is not allowed in AppProject",
+					verifyResult.Cipher, verifyResult.KeyID, verifyResult.Identity)
+			case signing.SignatureTypeSigstore:
+				msg = fmt.Sprintf("Found good signature made by Sigstore identity %s issued by %s, but this identity is not allowed in AppProject",
+					verifyResult.Identity, verifyResult.Issuer)
+			case signing.SignatureTypeCosign:
+				msg = fmt.Sprintf("Found good signature made with cosign key %s, but this key is not allowed in AppProject", verifyResult.KeyID)
+			default:
+				msg = fmt.Sprintf("Found good signature made with %s key %s, but this key is not allowed in AppProject",
 					verifyResult.Cipher, verifyResult.KeyID)
-				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 			}
-		case gpg.VerifyResultInvalid:
-			msg := fmt.Sprintf("Found signature made with %s key %s, but verification result was invalid: '%s'",
-				verifyResult.Cipher, verifyResult.KeyID, verifyResult.Message)
-			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
-		default:
-			msg := fmt.Sprintf("Could not verify commit signature on revision '%s', check logs for more information.", revision)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		}
-	} else {
-		msg := fmt.Sprintf("Target revision %s in Git is not signed, but a signature is required", revision)
+	case gpg.VerifyResultInvalid:
+		msg := fmt.Sprintf("Found signature made with %s key %s, but verification result was invalid: '%s'",
+			verifyResult.Cipher, verifyResult.KeyID, verifyResult.Message)
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	case gpg.VerifyResultBad:
+		if verifyResult.Type == signing.SignatureTypeCosign {
+			msg := fmt.Sprintf("Found cosign signature, but verification failed: '%s'", verifyResult.Message)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			break
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: unknownMsg, LastTransitionTime: &now})
+	default:
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: unknownMsg, LastTransitionTime: &now})
 	}
 
 	return conditions
@@ -458,7 +571,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	// When signature keys are defined in the project spec, we need to verify the signature on the Git revision
 	verifySignature := false
-	if len(project.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled() {
+	if len(project.Spec.SignatureKeys) > 0 {
 		verifySignature = true
 	}
 
@@ -511,7 +624,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
 		// This is also enforced on API level, but as a last resort, we also enforce it here
-		if gpg.IsGPGEnabled() && verifySignature {
+		if verifySignature {
 			msg := "Cannot use local manifests when signature verification is required"
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -722,6 +835,13 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("diff_ms")
 
+	previousDrifts := make(map[kubeutil.ResourceKey]*v1alpha1.ResourceDrift)
+	for _, res := range app.Status.Resources {
+		if res.Drift != nil {
+			previousDrifts[kubeutil.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Drift
+		}
+	}
+
 	syncCode := v1alpha1.SyncStatusCodeSynced
 	managedResources := make([]managedResource, len(reconciliation.Target))
 	resourceSummaries := make([]v1alpha1.ResourceStatus, len(reconciliation.Target))
@@ -750,6 +870,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		if targetObj != nil {
 			resState.SyncWave = int64(syncwaves.Wave(targetObj))
 		}
+		resState.SyncPolicy = newResourceSyncPolicy(targetObj, liveObj)
 
 		var diffResult diff.DiffResult
 		if i < len(diffResults.Diffs) {
@@ -801,6 +922,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			resState.Status = v1alpha1.SyncStatusCodeUnknown
 		}
 
+		if resState.Status == v1alpha1.SyncStatusCodeOutOfSync && liveObj != nil && targetObj != nil {
+			key := kubeutil.NewResourceKey(resState.Group, resState.Kind, resState.Namespace, resState.Name)
+			resState.Drift = newResourceDrift(previousDrifts[key], liveObj, app.Status.OperationState, now)
+		}
+
 		resourceVersion := ""
 		if liveObj != nil {
 			resourceVersion = liveObj.GetResourceVersion()
@@ -863,10 +989,18 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	// Git has already performed the signature verification via its GPG interface, and the result is available
 	// in the manifest info received from the repository server. We now need to form our opinion about the result
 	// and stop processing if we do not agree about the outcome.
-	for _, manifestInfo := range manifestInfos {
-		if gpg.IsGPGEnabled() && verifySignature && manifestInfo != nil {
-			conditions = append(conditions, verifyGnuPGSignature(manifestInfo.Revision, project, manifestInfo)...)
+	for i, manifestInfo := range manifestInfos {
+		if !verifySignature || manifestInfo == nil {
+			continue
+		}
+		if i < len(sources) && sources[i].IsHelm() {
+			// Helm charts are verified unless the project opts out of it
+			if !project.Spec.SkipChartSignatureVerification {
+				conditions = append(conditions, verifyChartSignature(sources[i], project, manifestInfo)...)
+			}
+			continue
 		}
+		conditions = append(conditions, verifyCommitSignature(manifestInfo.Revision, project, manifestInfo)...)
 	}
 
 	compRes := comparisonResult{
@@ -1000,6 +1134,7 @@ func (m *appStateManager) persistRevisionHistory(
 func NewAppStateManager(
 	db db.ArgoDB,
 	appclientset appclientset.Interface,
+	kubeClientset kubernetes.Interface,
 	repoClientset apiclient.Clientset,
 	namespace string,
 	kubectl kubeutil.Kubectl,
@@ -1020,6 +1155,7 @@ func NewAppStateManager(
 		cache:                 cache,
 		db:                    db,
 		appclientset:          appclientset,
+		kubeClientset:         kubeClientset,
 		kubectl:               kubectl,
 		repoClientset:         repoClientset,
 		namespace:             namespace,
@@ -1091,3 +1227,59 @@ func isSelfReferencedObj(obj *unstructured.Unstructured, aiv argo.AppInstanceVal
 		obj.GetObjectKind().GroupVersionKind().Group == aiv.Group &&
 		obj.GetObjectKind().GroupVersionKind().Kind == aiv.Kind
 }
+
+// newResourceDrift returns the drift of an OutOfSync live resource. The time at which the drift started is carried
+// over from the previous reconciliation, and the managers are the field managers other than Argo CD which updated
+// the resource after the last sync operation has finished.
+func newResourceDrift(previous *v1alpha1.ResourceDrift, liveObj *unstructured.Unstructured, lastOperation *v1alpha1.OperationState, now metav1.Time) *v1alpha1.ResourceDrift {
+	drift := &v1alpha1.ResourceDrift{StartedAt: now}
+	if previous != nil {
+		drift.StartedAt = previous.StartedAt
+	}
+	var lastSyncedAt *metav1.Time
+	if lastOperation != nil {
+		lastSyncedAt = lastOperation.FinishedAt
+	}
+	managers := make(map[string]bool)
+	for _, entry := range liveObj.GetManagedFields() {
+		if entry.Manager == "" || entry.Manager == common.ArgoCDSSAManager {
+			continue
+		}
+		if lastSyncedAt != nil && entry.Time != nil && !entry.Time.After(lastSyncedAt.Time) {
+			continue
+		}
+		managers[entry.Manager] = true
+	}
+	for manager := range managers {
+		drift.Managers = append(drift.Managers, manager)
+	}
+	sort.Strings(drift.Managers)
+	return drift
+}
+
+// newResourceSyncPolicy returns the sync policy overrides which are set on a resource using the
+// argocd.argoproj.io/sync-policy annotation, or nil if the resource follows the application sync policy. The
+// annotation of the desired state takes precedence over the one of the live resource, which is only used for
+// resources that are no longer part of the desired state.
+func newResourceSyncPolicy(targetObj, liveObj *unstructured.Unstructured) *v1alpha1.ResourceSyncPolicy {
+	obj := targetObj
+	if obj == nil {
+		obj = liveObj
+	}
+	if obj == nil {
+		return nil
+	}
+	policy := v1alpha1.ResourceSyncPolicy{
+		ManualSyncOnly:    resourceutil.HasAnnotationOption(obj, common.AnnotationSyncPolicy, common.SyncPolicyOptionManualSyncOnly),
+		SelfHealDisabled:  resourceutil.HasAnnotationOption(obj, common.AnnotationSyncPolicy, common.SyncPolicyOptionSelfHealDisabled),
+		AutoPruneDisabled: resourceutil.HasAnnotationOption(obj, common.AnnotationSyncPolicy, common.SyncPolicyOptionAutoPruneDisabled),
+	}
+	if policy.ManualSyncOnly {
+		policy.SelfHealDisabled = true
+		policy.AutoPruneDisabled = true
+	}
+	if policy == (v1alpha1.ResourceSyncPolicy{}) {
+		return nil
+	}
+	return &policy
+}
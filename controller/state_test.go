@@ -1,8 +1,10 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -15,7 +17,11 @@ import (
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -31,6 +37,7 @@ import (
 	mockrepoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/manifeststream"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -349,6 +356,55 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestCompareAppStateDrift tests that the drift of a modified live resource is recorded and carried over
+func TestCompareAppStateDrift(t *testing.T) {
+	syncedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	pod.Object["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)["image"] = "nginx:1.8.0"
+	pod.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: common.ArgoCDSSAManager, Time: &metav1.Time{Time: time.Now()}},
+		{Manager: "kubectl-edit", Time: &metav1.Time{Time: time.Now()}},
+		{Manager: "kube-controller-manager", Time: &metav1.Time{Time: syncedAt.Add(-time.Minute)}},
+	})
+	app := newFakeApp()
+	app.Status.OperationState = &argoappv1.OperationState{Phase: synccommon.OperationSucceeded, FinishedAt: &syncedAt}
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName()}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{PodManifest},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: pod,
+		},
+	}
+	ctrl := newFakeController(&data, nil)
+	sources := []argoappv1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false, false)
+	require.NoError(t, err)
+	require.Len(t, compRes.resources, 1)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.resources[0].Status)
+	drift := compRes.resources[0].Drift
+	require.NotNil(t, drift)
+	assert.Equal(t, []string{"kubectl-edit"}, drift.Managers)
+
+	startedAt := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+	app.Status.Resources = []argoappv1.ResourceStatus{{Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName(), Drift: &argoappv1.ResourceDrift{StartedAt: startedAt}}}
+	data.managedLiveObjs = map[kube.ResourceKey]*unstructured.Unstructured{key: pod}
+	ctrl = newFakeController(&data, nil)
+	compRes, err = ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false, false)
+	require.NoError(t, err)
+	require.Len(t, compRes.resources, 1)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.resources[0].Status)
+	require.NotNil(t, compRes.resources[0].Drift)
+	assert.Equal(t, startedAt, compRes.resources[0].Drift.StartedAt)
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -1177,7 +1233,8 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 	}
 
 	t.Setenv("ARGOCD_GPG_ENABLED", "false")
-	// We have a bad signature response and signing would be required, but GPG subsystem is disabled - sync
+	// We have a bad signature response and signing is required - signatures are verified even if the GPG subsystem is
+	// disabled, do not sync
 	{
 		app := newFakeApp()
 		data := fakeData{
@@ -1202,10 +1259,10 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
 		assert.Empty(t, compRes.resources)
 		assert.Empty(t, compRes.managedResources)
-		assert.Empty(t, app.Status.Conditions)
+		assert.Len(t, app.Status.Conditions, 1)
 	}
 
-	// Signature required and local manifests supplied and GPG subsystem is disabled - sync
+	// Signature required and local manifests supplied and GPG subsystem is disabled - do not sync
 	{
 		app := newFakeApp()
 		data := fakeData{
@@ -1229,10 +1286,77 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, compRes)
 		assert.NotNil(t, compRes.syncStatus)
-		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
 		assert.Empty(t, compRes.resources)
 		assert.Empty(t, compRes.managedResources)
-		assert.Empty(t, app.Status.Conditions)
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "Cannot use local manifests")
+	}
+}
+
+func TestVerifyCommitSignature_SSHAndSigstore(t *testing.T) {
+	proj := signedProj.DeepCopy()
+	proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys,
+		argoappv1.SignatureKey{SSH: &argoappv1.SSHSignatureKey{Fingerprint: "SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s", Principal: "alice@example.com"}},
+		argoappv1.SignatureKey{Sigstore: &argoappv1.SigstoreIdentity{Issuer: "https://token.actions.githubusercontent.com", SubjectRegex: "https://github.com/org/repo/.*"}},
+	)
+	tests := []struct {
+		name         string
+		verifier     string
+		verifyResult string
+		message      string
+	}{
+		{"SSHAllowed", common.SignatureVerifierSSH, `Good "git" signature for alice@example.com with ED25519 key SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s`, ""},
+		{"SSHOtherPrincipal", common.SignatureVerifierSSH, `Good "git" signature for bob@example.com with ED25519 key SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s`, "this key is not allowed in AppProject"},
+		{"SSHNotConfigured", common.SignatureVerifierSSH, "Good \"git\" signature with ED25519 key SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s\nNo principal matched.", "not a configured SSH signing key"},
+		{"SigstoreAllowed", common.SignatureVerifierGitsign, "gitsign: Signature made using certificate ID 0xabc | CN=sigstore-intermediate,O=sigstore.dev\ngitsign: Good signature from [https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)", ""},
+		{"SigstoreOtherSubject", common.SignatureVerifierGitsign, "gitsign: Good signature from [https://github.com/other/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)", "this identity is not allowed in AppProject"},
+		{"SigstoreBad", common.SignatureVerifierGitsign, "gitsign: failed to verify signature: x509: certificate signed by unknown authority", "Could not verify commit signature"},
+		{"SSHOutputOfGPG", common.SignatureVerifierGPG, `Good "git" signature for alice@example.com with ED25519 key SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s`, "Could not verify commit signature"},
+		{"SigstoreOutputOfSSH", common.SignatureVerifierSSH, "gitsign: Good signature from [https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)", "Could not verify commit signature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := verifyCommitSignature("abc123", proj, &apiclient.ManifestResponse{SignatureVerifier: tt.verifier, VerifyResult: tt.verifyResult})
+			if tt.message == "" {
+				assert.Empty(t, conditions)
+			} else {
+				require.Len(t, conditions, 1)
+				assert.Contains(t, conditions[0].Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestVerifyChartSignature(t *testing.T) {
+	proj := signedProj.DeepCopy()
+	proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys,
+		argoappv1.SignatureKey{Cosign: &argoappv1.CosignSignatureKey{Fingerprint: "SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"}},
+		argoappv1.SignatureKey{Sigstore: &argoappv1.SigstoreIdentity{Issuer: "https://token.actions.githubusercontent.com", SubjectRegex: "https://github.com/org/repo/.*"}},
+	)
+	source := argoappv1.ApplicationSource{RepoURL: "registry.example.com/charts", Chart: "mychart", TargetRevision: "0.1.0"}
+	tests := []struct {
+		name         string
+		verifyResult string
+		message      string
+	}{
+		{"Unsigned", "", "Chart mychart version 0.1.0 is not signed, but a signature is required"},
+		{"CosignAllowed", "cosign: Good signature with key SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c", ""},
+		{"CosignNotAllowed", "cosign: Good signature with key SHA256:C4U93tAg/FKuOHXgckjCTjhcbCsmsKNahlhFu8o1y50", "this key is not allowed in AppProject"},
+		{"CosignKeylessAllowed", "cosign: Good signature from [https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)", ""},
+		{"CosignKeylessNotAllowed", "cosign: Good signature from [https://github.com/other/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)", "this identity is not allowed in AppProject"},
+		{"CosignBad", "cosign: Error: no matching signatures", "Found cosign signature, but verification failed: 'Error: no matching signatures'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := verifyChartSignature(source, proj, &apiclient.ManifestResponse{SignatureVerifier: common.SignatureVerifierCosign, VerifyResult: tt.verifyResult, Revision: "0.1.0"})
+			if tt.message == "" {
+				assert.Empty(t, conditions)
+			} else {
+				require.Len(t, conditions, 1)
+				assert.Contains(t, conditions[0].Message, tt.message)
+			}
+		})
 	}
 }
 
@@ -1756,3 +1880,110 @@ func TestCompareAppStateRevisionUpdatedWithHelmSource(t *testing.T) {
 	assert.NotNil(t, compRes.syncStatus)
 	assert.True(t, compRes.revisionUpdated)
 }
+
+func TestNewResourceSyncPolicy(t *testing.T) {
+	annotated := func(value string) *unstructured.Unstructured {
+		return Annotate(NewPod(), common.AnnotationSyncPolicy, value)
+	}
+
+	assert.Nil(t, newResourceSyncPolicy(nil, nil))
+	assert.Nil(t, newResourceSyncPolicy(NewPod(), annotated(common.SyncPolicyOptionSelfHealDisabled)))
+	assert.Equal(t, &argoappv1.ResourceSyncPolicy{SelfHealDisabled: true}, newResourceSyncPolicy(annotated(common.SyncPolicyOptionSelfHealDisabled), nil))
+	assert.Equal(t, &argoappv1.ResourceSyncPolicy{SelfHealDisabled: true, AutoPruneDisabled: true}, newResourceSyncPolicy(annotated("SelfHeal=false,AutoPrune=false"), nil))
+	assert.Equal(t, &argoappv1.ResourceSyncPolicy{AutoPruneDisabled: true}, newResourceSyncPolicy(nil, annotated(common.SyncPolicyOptionAutoPruneDisabled)))
+	assert.Equal(t, &argoappv1.ResourceSyncPolicy{ManualSyncOnly: true, SelfHealDisabled: true, AutoPruneDisabled: true}, newResourceSyncPolicy(annotated(common.SyncPolicyOptionManualSyncOnly), nil))
+}
+
+type manifestResponseStream struct {
+	grpc.ClientStream
+	chunks []*apiclient.ManifestResponseChunk
+}
+
+func (s *manifestResponseStream) Send(chunk *apiclient.ManifestResponseChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *manifestResponseStream) Recv() (*apiclient.ManifestResponseChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func TestGenerateManifest(t *testing.T) {
+	req := &apiclient.ManifestRequest{AppName: "my-app"}
+	res := &apiclient.ManifestResponse{Manifests: []string{`{"kind":"ConfigMap"}`}, Revision: "abc123"}
+
+	t.Run("Unary", func(t *testing.T) {
+		repoClient := &mockrepoclient.RepoServerServiceClient{}
+		repoClient.On("GenerateManifest", mock.Anything, req).Return(res, nil)
+		actual, err := generateManifest(context.Background(), repoClient, req)
+		require.NoError(t, err)
+		assert.Equal(t, res, actual)
+		repoClient.AssertNotCalled(t, "GenerateManifestStream", mock.Anything, mock.Anything)
+	})
+
+	t.Run("StreamLargeResponse", func(t *testing.T) {
+		stream := &manifestResponseStream{}
+		require.NoError(t, manifeststream.SendManifestResponse(context.Background(), stream, res, manifeststream.DefaultManifestChunkSize))
+		repoClient := &mockrepoclient.RepoServerServiceClient{}
+		repoClient.On("GenerateManifest", mock.Anything, req).Return(nil, status.Error(codes.ResourceExhausted, "grpc: received message larger than max"))
+		repoClient.On("GenerateManifestStream", mock.Anything, req).Return(stream, nil)
+		actual, err := generateManifest(context.Background(), repoClient, req)
+		require.NoError(t, err)
+		assert.Equal(t, res, actual)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		repoClient := &mockrepoclient.RepoServerServiceClient{}
+		repoClient.On("GenerateManifest", mock.Anything, req).Return(nil, status.Error(codes.Unknown, "helm template failed"))
+		_, err := generateManifest(context.Background(), repoClient, req)
+		require.ErrorContains(t, err, "helm template failed")
+		repoClient.AssertNotCalled(t, "GenerateManifestStream", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetDestinationClient(t *testing.T) {
+	setup := func(impersonationEnabled bool, serviceAccountName string) (*appStateManager, *argoappv1.Application, *argoappv1.AppProject) {
+		app := newFakeApp()
+		proj := defaultProj.DeepCopy()
+		proj.Spec.DestinationServiceAccounts = []argoappv1.ApplicationDestinationServiceAccount{{
+			Server:                app.Spec.Destination.Server,
+			Namespace:             app.Spec.Destination.Namespace,
+			DefaultServiceAccount: serviceAccountName,
+		}}
+		ctrl := newFakeController(&fakeData{
+			apps:          []runtime.Object{app, proj},
+			configMapData: map[string]string{"application.sync.impersonation.enabled": fmt.Sprintf("%t", impersonationEnabled)},
+		}, nil)
+		return ctrl.appStateManager.(*appStateManager), app, proj
+	}
+
+	t.Run("Cached", func(t *testing.T) {
+		m, app, proj := setup(false, "")
+		client, err := m.getDestinationClient(app, proj)
+		require.NoError(t, err)
+		cached, err := m.getDestinationClient(app, proj)
+		require.NoError(t, err)
+		assert.Same(t, client, cached)
+	})
+
+	t.Run("Impersonated", func(t *testing.T) {
+		m, app, proj := setup(true, "reader")
+		client, err := m.getDestinationClient(app, proj)
+		require.NoError(t, err)
+		_, ok := m.destinationClients.Load(app.Spec.Destination.Server + "|system:serviceaccount:" + app.Spec.Destination.Namespace + ":reader")
+		assert.True(t, ok)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("NoServiceAccount", func(t *testing.T) {
+		m, app, proj := setup(true, "")
+		proj.Spec.DestinationServiceAccounts = nil
+		_, err := m.getDestinationClient(app, proj)
+		require.ErrorContains(t, err, "failed to find a matching service account to impersonate")
+	})
+}
@@ -359,6 +359,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+			if state.Operation.InitiatedBy.Automated && !isAutoSyncPermitted(target, live) {
+				return false
+			}
 			return (len(syncOp.Resources) == 0 ||
 				isPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
@@ -405,6 +408,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	hookStartTimes := getHookStartTimes(state.SyncResult.Resources)
 	state.SyncResult.Resources = nil
 
 	if app.Spec.SyncPolicy != nil {
@@ -440,6 +444,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			HookPhase: res.HookPhase,
 			Status:    res.Status,
 			Message:   res.Message,
+			StartedAt: getHookStartedAt(hookStartTimes, res),
 		})
 	}
 
@@ -557,6 +562,40 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 	return false, ""
 }
 
+type hookKey struct {
+	resourceKey kube.ResourceKey
+	hookType    common.HookType
+	syncPhase   common.SyncPhase
+}
+
+// getHookStartTimes returns the times at which the hooks of the previous sync results were first observed as running
+func getHookStartTimes(resources v1alpha1.ResourceResults) map[hookKey]*v1.Time {
+	startTimes := map[hookKey]*v1.Time{}
+	for _, res := range resources {
+		if res.HookType == "" || res.StartedAt == nil {
+			continue
+		}
+		key := hookKey{kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name), res.HookType, res.SyncPhase}
+		startTimes[key] = res.StartedAt
+	}
+	return startTimes
+}
+
+// getHookStartedAt returns the time at which the given hook was first observed as running
+func getHookStartedAt(startTimes map[hookKey]*v1.Time, res common.ResourceSyncResult) *v1.Time {
+	if res.HookType == "" {
+		return nil
+	}
+	if startedAt, ok := startTimes[hookKey{res.ResourceKey, res.HookType, res.SyncPhase}]; ok {
+		return startedAt
+	}
+	if res.HookPhase != common.OperationRunning {
+		return nil
+	}
+	now := v1.Now()
+	return &now
+}
+
 // delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
 // between each sync wave. We introduce an artificial delay in order give other controllers a
 // _chance_ to react to the spec change that we just applied. This is important because without
@@ -579,6 +618,16 @@ func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) err
 	return nil
 }
 
+// isAutoSyncPermitted returns whether the sync policy annotation of a resource permits an automated sync operation
+// to apply or prune it
+func isAutoSyncPermitted(target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+	policy := newResourceSyncPolicy(target, live)
+	if target == nil && live != nil {
+		return policy.AllowsAutoPrune()
+	}
+	return policy.AllowsAutoSync()
+}
+
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) bool {
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
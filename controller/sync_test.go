@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/controller/testdata"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
@@ -1411,6 +1413,63 @@ func TestSyncWithImpersonate(t *testing.T) {
 	})
 }
 
+func TestSyncAppStateResourceSyncPolicy(t *testing.T) {
+	setup := func() (*v1alpha1.Application, *ApplicationController) {
+		app := newFakeApp()
+		app.Status.OperationState = nil
+		app.Status.History = nil
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+		}
+		pod := NewPod()
+		pod.SetNamespace(test.FakeDestNamespace)
+		pod.SetAnnotations(map[string]string{cdcommon.AnnotationSyncPolicy: cdcommon.SyncPolicyOptionManualSyncOnly})
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, pod)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		return app, newFakeController(&data, nil)
+	}
+
+	t.Run("automated sync skips manual sync only resource", func(t *testing.T) {
+		app, ctrl := setup()
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync:        &v1alpha1.SyncOperation{},
+				InitiatedBy: v1alpha1.OperationInitiator{Automated: true},
+			},
+			Phase: common.OperationRunning,
+		}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.Equal(t, common.OperationSucceeded, opState.Phase)
+		assert.Empty(t, opState.SyncResult.Resources)
+	})
+
+	t.Run("manual sync includes manual sync only resource", func(t *testing.T) {
+		app, ctrl := setup()
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync:        &v1alpha1.SyncOperation{},
+				InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
+			},
+			Phase: common.OperationRunning,
+		}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		// the fake cluster is not reachable, so only verify that the resource was part of the sync
+		require.Len(t, opState.SyncResult.Resources, 1)
+		assert.Equal(t, "my-pod", opState.SyncResult.Resources[0].Name)
+	})
+}
+
 func dig[T any](obj interface{}, path []interface{}) T {
 	i := obj
 
@@ -0,0 +1,14 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. $(dirname $0)/../tool-versions.sh
+
+export TARGET_FILE=cosign-${INSTALL_OS}-${ARCHITECTURE}
+URL=https://github.com/sigstore/cosign/releases/download/v${cosign_version}
+
+[ -e $DOWNLOADS/${TARGET_FILE} ] || curl -sLf --retry 3 -o $DOWNLOADS/${TARGET_FILE} ${URL}/${TARGET_FILE}
+# The binary is verified against the checksums published with the release
+curl -sLf --retry 3 -o $DOWNLOADS/cosign-${cosign_version}_checksums.txt ${URL}/cosign_checksums.txt
+(cd $DOWNLOADS && grep " ${TARGET_FILE}\$" cosign-${cosign_version}_checksums.txt | shasum -a 256 -c -)
+sudo install -m 0755 $DOWNLOADS/${TARGET_FILE} $BIN/cosign
+cosign version
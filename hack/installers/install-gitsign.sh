@@ -0,0 +1,14 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. $(dirname $0)/../tool-versions.sh
+
+export TARGET_FILE=gitsign_${gitsign_version}_${INSTALL_OS}_${ARCHITECTURE}
+URL=https://github.com/sigstore/gitsign/releases/download/v${gitsign_version}
+
+[ -e $DOWNLOADS/${TARGET_FILE} ] || curl -sLf --retry 3 -o $DOWNLOADS/${TARGET_FILE} ${URL}/${TARGET_FILE}
+# The binary is verified against the checksums published with the release
+curl -sLf --retry 3 -o $DOWNLOADS/gitsign-${gitsign_version}_checksums.txt ${URL}/gitsign_checksums.txt
+(cd $DOWNLOADS && grep " ${TARGET_FILE}\$" gitsign-${gitsign_version}_checksums.txt | shasum -a 256 -c -)
+sudo install -m 0755 $DOWNLOADS/${TARGET_FILE} $BIN/gitsign
+gitsign --version
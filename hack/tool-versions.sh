@@ -10,7 +10,12 @@
 #
 # Use ./hack/installers/checksums/add-helm-checksums.sh and
 # add-kustomize-checksums.sh to help download checksums.
+#
+# The cosign and gitsign binaries are verified against the checksums published
+# with their releases instead.
 ###############################################################################
+cosign_version=2.4.1
+gitsign_version=0.10.2
 helm3_version=3.15.4
 kubectl_version=1.17.8
 kubectx_version=0.6.3
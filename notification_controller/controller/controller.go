@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/glob"
 
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/project"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
@@ -18,10 +20,13 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/util/notification/settings"
 
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/parser"
 	"github.com/argoproj/notifications-engine/pkg/api"
 	"github.com/argoproj/notifications-engine/pkg/controller"
 	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/argoproj/notifications-engine/pkg/subscriptions"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
 	httputil "github.com/argoproj/notifications-engine/pkg/util/http"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +38,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 const (
@@ -40,8 +46,9 @@ const (
 )
 
 var (
-	applications = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
-	appProjects  = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
+	applications    = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
+	appProjects     = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
+	applicationSets = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationSetPlural}
 )
 
 func newAppProjClient(client dynamic.Interface, namespace string) dynamic.ResourceInterface {
@@ -67,14 +74,19 @@ func NewController(
 	selfServiceNotificationEnabled bool,
 ) *notificationController {
 	var appClient dynamic.ResourceInterface
+	var appSetClient dynamic.ResourceInterface
 
 	namespaceableAppClient := client.Resource(applications)
 	appClient = namespaceableAppClient
+	namespaceableAppSetClient := client.Resource(applicationSets)
+	appSetClient = namespaceableAppSetClient
 
 	if len(applicationNamespaces) == 0 {
 		appClient = namespaceableAppClient.Namespace(namespace)
+		appSetClient = namespaceableAppSetClient.Namespace(namespace)
 	}
 	appInformer := newInformer(appClient, namespace, applicationNamespaces, appLabelSelector)
+	appSetInformer := newInformer(appSetClient, namespace, applicationNamespaces, "")
 	appProjInformer := newInformer(newAppProjClient(client, namespace), namespace, []string{namespace}, "")
 	var notificationConfigNamespace string
 	if selfServiceNotificationEnabled {
@@ -84,14 +96,20 @@ func NewController(
 	}
 	secretInformer := k8s.NewSecretInformer(k8sClient, notificationConfigNamespace, secretName)
 	configMapInformer := k8s.NewConfigMapInformer(k8sClient, notificationConfigNamespace, configMapName)
-	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName, selfServiceNotificationEnabled), namespace, secretInformer, configMapInformer)
+	digester := newDigester(configMapInformer, configMapName)
+	apiFactory := &digestFactory{
+		Factory:  api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName, selfServiceNotificationEnabled), namespace, secretInformer, configMapInformer),
+		digester: digester,
+	}
 
 	res := &notificationController{
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
 		appInformer:       appInformer,
+		appSetInformer:    appSetInformer,
 		appProjInformer:   appProjInformer,
 		apiFactory:        apiFactory,
+		digester:          digester,
 	}
 	skipProcessingOpt := controller.WithSkipProcessing(func(obj v1.Object) (bool, string) {
 		app, ok := (obj).(*unstructured.Unstructured)
@@ -103,20 +121,36 @@ func NewController(
 		}
 		return !isAppSyncStatusRefreshed(app, log.WithField("app", obj.GetName())), "sync status out of date"
 	})
+	skipAppSetProcessingOpt := controller.WithSkipProcessing(func(obj v1.Object) (bool, string) {
+		appSet, ok := (obj).(*unstructured.Unstructured)
+		if !ok {
+			return false, ""
+		}
+		if checkAppNotInAdditionalNamespaces(appSet, namespace, applicationNamespaces) {
+			return true, "applicationset is not in one of the application-namespaces, nor the notification controller namespace"
+		}
+		return false, ""
+	})
 	metricsRegistryOpt := controller.WithMetricsRegistry(registry)
 	alterDestinationsOpt := controller.WithAlterDestinations(res.alterDestinations)
+	projToUnstructuredOpt := controller.WithToUnstructured(res.projToUnstructured)
 
-	if !selfServiceNotificationEnabled {
-		res.ctrl = controller.NewController(namespaceableAppClient, appInformer, apiFactory,
-			skipProcessingOpt,
-			metricsRegistryOpt,
-			alterDestinationsOpt)
-	} else {
-		res.ctrl = controller.NewControllerWithNamespaceSupport(namespaceableAppClient, appInformer, apiFactory,
-			skipProcessingOpt,
-			metricsRegistryOpt,
-			alterDestinationsOpt)
+	newController := controller.NewController
+	if selfServiceNotificationEnabled {
+		newController = controller.NewControllerWithNamespaceSupport
 	}
+	res.ctrl = newController(namespaceableAppClient, appInformer, apiFactory,
+		skipProcessingOpt,
+		metricsRegistryOpt,
+		alterDestinationsOpt)
+	res.appSetCtrl = newController(namespaceableAppSetClient, appSetInformer, apiFactory,
+		skipAppSetProcessingOpt,
+		metricsRegistryOpt,
+		controller.WithAlterDestinations(subjectDestinations("appset", "appsets")))
+	res.appProjCtrl = newController(client.Resource(appProjects), appProjInformer, apiFactory,
+		metricsRegistryOpt,
+		projToUnstructuredOpt,
+		controller.WithAlterDestinations(subjectDestinations("project", "projects")))
 	return res
 }
 
@@ -125,6 +159,24 @@ func checkAppNotInAdditionalNamespaces(app *unstructured.Unstructured, namespace
 	return namespace != app.GetNamespace() && !glob.MatchStringInList(applicationNamespaces, app.GetNamespace(), glob.REGEXP)
 }
 
+// projToUnstructured returns the project with the orphaned resource warnings of its applications added to its status,
+// so that triggers and templates can use them.
+func (c *notificationController) projToUnstructured(obj v1.Object) (*unstructured.Unstructured, error) {
+	proj, ok := (obj).(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("Object must be *unstructured.Unstructured but was: %v", obj)
+	}
+	warnings := getOrphanedResourceWarnings(proj, c.appInformer)
+	if len(warnings) == 0 {
+		return proj, nil
+	}
+	res := proj.DeepCopy()
+	if err := unstructured.SetNestedSlice(res.Object, warnings, "status", project.OrphanedResourceWarningsField); err != nil {
+		return nil, fmt.Errorf("failed to set orphaned resource warnings of project %s: %w", proj.GetName(), err)
+	}
+	return res, nil
+}
+
 func (c *notificationController) alterDestinations(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
 	app, ok := (obj).(*unstructured.Unstructured)
 	if !ok {
@@ -135,7 +187,57 @@ func (c *notificationController) alterDestinations(obj v1.Object, destinations s
 		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 	}
-	return destinations
+	// triggers about ApplicationSets and AppProjects are not evaluated for applications
+	res := services.Destinations{}
+	for trigger, dests := range destinations {
+		vars := triggerVars(cfg.Triggers[trigger])
+		if vars["app"] || !(vars["appset"] || vars["appsets"] || vars["project"] || vars["projects"]) {
+			res[trigger] = dests
+		}
+	}
+	return res
+}
+
+// subjectDestinations returns an alterDestinations func which only keeps the destinations of triggers whose conditions
+// use one of the given variables. Subscriptions are shared by all kinds of resources, e.g. the subscriptions of a
+// project are inherited by its applications, so triggers are only evaluated for the kind of resource they are about.
+func subjectDestinations(vars ...string) func(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+	return func(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+		res := services.Destinations{}
+		for trigger, dests := range destinations {
+			used := triggerVars(cfg.Triggers[trigger])
+			for _, v := range vars {
+				if used[v] {
+					res[trigger] = dests
+					break
+				}
+			}
+		}
+		return res
+	}
+}
+
+type identifierVisitor struct {
+	identifiers map[string]bool
+}
+
+func (v *identifierVisitor) Visit(node *ast.Node) {
+	if n, ok := (*node).(*ast.IdentifierNode); ok {
+		v.identifiers[n.Value] = true
+	}
+}
+
+// triggerVars returns the variables used by the conditions of a trigger
+func triggerVars(conditions []triggers.Condition) map[string]bool {
+	visitor := &identifierVisitor{identifiers: map[string]bool{}}
+	for _, condition := range conditions {
+		tree, err := parser.Parse(condition.When)
+		if err != nil {
+			continue
+		}
+		ast.Walk(&tree.Node, visitor)
+	}
+	return visitor.identifiers
 }
 
 func newInformer(resClient dynamic.ResourceInterface, controllerNamespace string, applicationNamespaces []string, selector string) cache.SharedIndexInformer {
@@ -174,8 +276,12 @@ func newInformer(resClient dynamic.ResourceInterface, controllerNamespace string
 
 type notificationController struct {
 	apiFactory        api.Factory
+	digester          *digester
 	ctrl              controller.NotificationController
+	appSetCtrl        controller.NotificationController
+	appProjCtrl       controller.NotificationController
 	appInformer       cache.SharedIndexInformer
+	appSetInformer    cache.SharedIndexInformer
 	appProjInformer   cache.SharedIndexInformer
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
@@ -186,17 +292,21 @@ func (c *notificationController) Init(ctx context.Context) error {
 	httputil.SetCertResolver(argocert.GetCertificateForConnect)
 
 	go c.appInformer.Run(ctx.Done())
+	go c.appSetInformer.Run(ctx.Done())
 	go c.appProjInformer.Run(ctx.Done())
 	go c.secretInformer.Run(ctx.Done())
 	go c.configMapInformer.Run(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appSetInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
 		return errors.New("Timed out waiting for caches to sync")
 	}
 	return nil
 }
 
 func (c *notificationController) Run(ctx context.Context, processors int) {
+	go c.digester.run(ctx)
+	go c.appSetCtrl.Run(processors, ctx.Done())
+	go c.appProjCtrl.Run(processors, ctx.Done())
 	c.ctrl.Run(processors, ctx.Done())
 }
 
@@ -219,6 +329,44 @@ func getAppProj(app *unstructured.Unstructured, appProjInformer cache.SharedInde
 	return proj
 }
 
+// getOrphanedResourceWarnings returns the orphaned resource warnings of the applications of the given project, sorted
+// by application namespace and name
+func getOrphanedResourceWarnings(proj *unstructured.Unstructured, appInformer cache.SharedIndexInformer) []interface{} {
+	var apps []*unstructured.Unstructured
+	for _, obj := range appInformer.GetIndexer().List() {
+		app, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if projName, _, _ := unstructured.NestedString(app.Object, "spec", "project"); projName == proj.GetName() {
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].GetNamespace() != apps[j].GetNamespace() {
+			return apps[i].GetNamespace() < apps[j].GetNamespace()
+		}
+		return apps[i].GetName() < apps[j].GetName()
+	})
+	warnings := []interface{}{}
+	for _, app := range apps {
+		conditions, _, _ := unstructured.NestedSlice(app.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok || condition["type"] != v1alpha1.ApplicationConditionOrphanedResourceWarning {
+				continue
+			}
+			message, _ := condition["message"].(string)
+			warnings = append(warnings, map[string]interface{}{
+				"application": app.GetName(),
+				"namespace":   app.GetNamespace(),
+				"message":     message,
+			})
+		}
+	}
+	return warnings
+}
+
 // Checks if the application SyncStatus has been refreshed by Argo CD after an operation has completed
 func isAppSyncStatusRefreshed(app *unstructured.Unstructured, logEntry *log.Entry) bool {
 	_, ok, err := unstructured.NestedMap(app.Object, "status", "operationState")
@@ -2,9 +2,13 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -197,3 +201,87 @@ func TestCheckAppNotInAdditionalNamespaces(t *testing.T) {
 	app.SetNamespace("namespace3")
 	assert.True(t, checkAppNotInAdditionalNamespaces(app, "", applicationNamespaces))
 }
+
+func TestGetOrphanedResourceWarnings(t *testing.T) {
+	newApp := func(namespace, name, project string, conditions ...interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": namespace,
+				},
+				"spec": map[string]interface{}{
+					"project": project,
+				},
+				"status": map[string]interface{}{
+					"conditions": conditions,
+				},
+			},
+		}
+	}
+	orphanedWarning := func(message string) interface{} {
+		return map[string]interface{}{"type": "OrphanedResourceWarning", "message": message}
+	}
+	informer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	require.NoError(t, informer.GetIndexer().Add(newApp("argocd", "b", "proj", orphanedWarning("b has orphaned resources"))))
+	require.NoError(t, informer.GetIndexer().Add(newApp("argocd", "a", "proj", orphanedWarning("a has orphaned resources"), map[string]interface{}{"type": "SyncError", "message": "failed"})))
+	require.NoError(t, informer.GetIndexer().Add(newApp("argocd", "c", "proj")))
+	require.NoError(t, informer.GetIndexer().Add(newApp("argocd", "d", "other", orphanedWarning("d has orphaned resources"))))
+	proj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	proj.SetName("proj")
+	proj.SetNamespace("argocd")
+
+	c := &notificationController{appInformer: informer}
+	res, err := c.projToUnstructured(proj)
+	require.NoError(t, err)
+
+	warnings, ok, err := unstructured.NestedSlice(res.Object, "status", "orphanedResourceWarnings")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"application": "a", "namespace": "argocd", "message": "a has orphaned resources"},
+		map[string]interface{}{"application": "b", "namespace": "argocd", "message": "b has orphaned resources"},
+	}, warnings)
+	_, ok, _ = unstructured.NestedSlice(proj.Object, "status", "orphanedResourceWarnings")
+	assert.False(t, ok, "the project in the informer must not be modified")
+}
+
+func TestSubjectDestinations(t *testing.T) {
+	cfg := api.Config{
+		Triggers: map[string][]triggers.Condition{
+			"on-sync-failed":            {{When: "app.status.operationState.phase in ['Error', 'Failed'] and app.spec.project == 'default'"}},
+			"on-created":                {{When: "true"}},
+			"on-appset-error":           {{When: "appsets.HasCondition('ErrorOccurred')"}},
+			"on-sync-window-opened":     {{When: "projects.HasActiveSyncWindows()"}},
+			"on-orphaned-resources":     {{When: "len(project.status.orphanedResourceWarnings) > 0"}},
+			"on-appset-template-change": {{When: "appset.metadata.generation > 1"}},
+		},
+	}
+	destinations := services.Destinations{}
+	for trigger := range cfg.Triggers {
+		destinations[trigger] = []services.Destination{{Service: "slack", Recipient: "channel"}}
+	}
+	triggerNames := func(destinations services.Destinations) []string {
+		var names []string
+		for trigger := range destinations {
+			names = append(names, trigger)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	t.Run("ApplicationSet triggers", func(t *testing.T) {
+		res := subjectDestinations("appset", "appsets")(&unstructured.Unstructured{}, destinations, cfg)
+		assert.Equal(t, []string{"on-appset-error", "on-appset-template-change"}, triggerNames(res))
+	})
+	t.Run("AppProject triggers", func(t *testing.T) {
+		res := subjectDestinations("project", "projects")(&unstructured.Unstructured{}, destinations, cfg)
+		assert.Equal(t, []string{"on-orphaned-resources", "on-sync-window-opened"}, triggerNames(res))
+	})
+	t.Run("Application triggers", func(t *testing.T) {
+		c := &notificationController{appProjInformer: cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})}
+		app := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"project": "default"}}}
+		res := c.alterDestinations(app, destinations, cfg)
+		assert.Equal(t, []string{"on-created", "on-sync-failed"}, triggerNames(res))
+	})
+}
@@ -0,0 +1,369 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	v1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+)
+
+const (
+	digestFlushInterval = 10 * time.Second
+	// digestRetryInterval is the delay before the first retry of a digest which failed to be sent. It is doubled for
+	// every further attempt up to digestMaxRetryInterval.
+	digestRetryInterval    = 30 * time.Second
+	digestMaxRetryInterval = 10 * time.Minute
+	// digestMaxAttempts is the number of attempts to send a digest after which it is dropped, since its destination is
+	// likely broken for good
+	digestMaxAttempts = 10
+)
+
+// revisionPaths are the fields of an application which hold the revision a notification is about, in order of
+// precedence. Multi-source applications hold the revisions in the sibling revisions field.
+var revisionPaths = [][]string{
+	{"status", "operationState", "syncResult"},
+	{"status", "operationState", "operation", "sync"},
+	{"status", "sync"},
+}
+
+// digestEvent is a notification about an application buffered by a digest
+type digestEvent struct {
+	trigger string
+	app     map[string]interface{}
+}
+
+// digestBuffer holds the notifications buffered by a digest for a destination until the digest window elapses
+type digestBuffer struct {
+	key      string
+	api      api.API
+	digest   settings.Digest
+	dest     services.Destination
+	trigger  string
+	project  string
+	deadline time.Time
+	attempts int
+	events   map[string]digestEvent
+}
+
+// toUnstructured returns the object the summary template of the digest is rendered for
+func (b *digestBuffer) toUnstructured() map[string]interface{} {
+	keys := make([]string, 0, len(b.events))
+	for k := range b.events {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	apps := []interface{}{}
+	events := []interface{}{}
+	seen := map[string]bool{}
+	for _, k := range keys {
+		event := b.events[k]
+		events = append(events, map[string]interface{}{"trigger": event.trigger, "app": event.app})
+		appKey := objectKey(event.app)
+		if !seen[appKey] {
+			seen[appKey] = true
+			apps = append(apps, event.app)
+		}
+	}
+	return map[string]interface{}{
+		"kind":         settings.DigestKind,
+		"metadata":     map[string]interface{}{"name": b.digest.Name},
+		"trigger":      b.trigger,
+		"project":      b.project,
+		"window":       b.digest.Window.Duration.String(),
+		"applications": apps,
+		"events":       events,
+	}
+}
+
+type namespaceDigests struct {
+	resourceVersion string
+	digests         []settings.Digest
+}
+
+// digester buffers the notifications of the digest subscriptions configured in the notifications ConfigMaps, and
+// suppresses repeated notifications within their cooldown
+type digester struct {
+	cmLister      v1listers.ConfigMapLister
+	configMapName string
+	now           func() time.Time
+
+	lock     sync.Mutex
+	digests  map[string]namespaceDigests
+	triggers map[string]string
+	buffers  map[string]*digestBuffer
+	sent     map[string]time.Time
+}
+
+func newDigester(configMapInformer cache.SharedIndexInformer, configMapName string) *digester {
+	return &digester{
+		cmLister:      v1listers.NewConfigMapLister(configMapInformer.GetIndexer()),
+		configMapName: configMapName,
+		now:           time.Now,
+		digests:       map[string]namespaceDigests{},
+		triggers:      map[string]string{},
+		buffers:       map[string]*digestBuffer{},
+		sent:          map[string]time.Time{},
+	}
+}
+
+// getDigests returns the digests configured in the notifications ConfigMap of the given namespace
+func (d *digester) getDigests(namespace string) []settings.Digest {
+	cm, err := d.cmLister.ConfigMaps(namespace).Get(d.configMapName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Warnf("Failed to get notifications ConfigMap in namespace %s: %v", namespace, err)
+		}
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if cached, ok := d.digests[namespace]; ok && cached.resourceVersion == cm.ResourceVersion {
+		return cached.digests
+	}
+	digests, err := settings.ParseDigests(cm)
+	if err != nil {
+		log.Warnf("Failed to parse notification digests in namespace %s: %v", namespace, err)
+	}
+	d.digests[namespace] = namespaceDigests{resourceVersion: cm.ResourceVersion, digests: digests}
+	return digests
+}
+
+// wrap returns an API which delivers the notifications matching the digests configured for the given API through
+// the digester
+func (d *digester) wrap(notificationAPI api.API) api.API {
+	if notificationAPI == nil {
+		return nil
+	}
+	digests := d.getDigests(notificationAPI.GetConfig().Namespace)
+	if len(digests) == 0 {
+		return notificationAPI
+	}
+	return &digestAPI{API: notificationAPI, digester: d, digests: digests}
+}
+
+func (d *digester) setTrigger(key string, trigger string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.triggers[key] = trigger
+}
+
+func (d *digester) getTrigger(key string) string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.triggers[key]
+}
+
+// send delivers the notification of the given trigger about the given application according to the digest: it is
+// dropped if it was already sent within the cooldown, buffered if the digest has a window and sent immediately
+// otherwise.
+func (d *digester) send(notificationAPI api.API, digest settings.Digest, trigger string, obj map[string]interface{}, templates []string, dest services.Destination) error {
+	app := &unstructured.Unstructured{Object: obj}
+	namespace := notificationAPI.GetConfig().Namespace
+	now := d.now()
+
+	d.lock.Lock()
+	sentKey := ""
+	if digest.Cooldown.Duration > 0 {
+		sentKey = strings.Join([]string{namespace, digest.Name, dest.Service, dest.Recipient, trigger, app.GetNamespace(), app.GetName(), appRevision(app)}, "|")
+		if expiry, ok := d.sent[sentKey]; ok && now.Before(expiry) {
+			d.lock.Unlock()
+			log.Infof("Notification about trigger %s of application %s/%s to %v suppressed within the cooldown of digest %s", trigger, app.GetNamespace(), app.GetName(), dest, digest.Name)
+			return nil
+		}
+		d.sent[sentKey] = now.Add(digest.Cooldown.Duration)
+	}
+	if digest.Window.Duration > 0 {
+		d.buffer(notificationAPI, digest, trigger, app, dest, now)
+		d.lock.Unlock()
+		return nil
+	}
+	d.lock.Unlock()
+
+	if err := notificationAPI.Send(obj, templates, dest); err != nil {
+		if sentKey != "" {
+			d.lock.Lock()
+			delete(d.sent, sentKey)
+			d.lock.Unlock()
+		}
+		return err
+	}
+	return nil
+}
+
+// buffer adds the notification about the application to the buffer of its group. Must be called with the lock held.
+func (d *digester) buffer(notificationAPI api.API, digest settings.Digest, trigger string, app *unstructured.Unstructured, dest services.Destination, now time.Time) {
+	groupTrigger, groupProject := "", ""
+	if digest.GroupedBy(settings.DigestGroupByTrigger) {
+		groupTrigger = trigger
+	}
+	if digest.GroupedBy(settings.DigestGroupByProject) {
+		groupProject, _, _ = unstructured.NestedString(app.Object, "spec", "project")
+	}
+	key := strings.Join([]string{notificationAPI.GetConfig().Namespace, digest.Name, dest.Service, dest.Recipient, groupTrigger, groupProject}, "|")
+	b, ok := d.buffers[key]
+	if !ok {
+		b = &digestBuffer{
+			key:      key,
+			digest:   digest,
+			dest:     dest,
+			trigger:  groupTrigger,
+			project:  groupProject,
+			deadline: now.Add(digest.Window.Duration),
+			events:   map[string]digestEvent{},
+		}
+		d.buffers[key] = b
+	}
+	b.api = notificationAPI
+	b.events[objectKey(app.Object)+"|"+trigger] = digestEvent{trigger: trigger, app: app.Object}
+	log.Infof("Notification about trigger %s of application %s/%s to %v buffered by digest %s", trigger, app.GetNamespace(), app.GetName(), dest, digest.Name)
+}
+
+// retry puts back the buffer whose digest failed to be sent, so that it is sent again after a backoff along with the
+// notifications buffered in the meantime
+func (d *digester) retry(b *digestBuffer, now time.Time) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	b.attempts++
+	backoff := digestRetryInterval
+	for i := 1; i < b.attempts && backoff < digestMaxRetryInterval; i++ {
+		backoff *= 2
+	}
+	if backoff > digestMaxRetryInterval {
+		backoff = digestMaxRetryInterval
+	}
+	b.deadline = now.Add(backoff)
+	if pending, ok := d.buffers[b.key]; ok {
+		for k, event := range pending.events {
+			b.events[k] = event
+		}
+		b.api = pending.api
+	}
+	d.buffers[b.key] = b
+}
+
+// flush sends the summaries of the buffers whose window has elapsed and forgets the expired cooldowns. Buffers which
+// fail to be sent are retried, until they have failed digestMaxAttempts times.
+func (d *digester) flush() {
+	now := d.now()
+	var due []*digestBuffer
+	d.lock.Lock()
+	for key, b := range d.buffers {
+		if !now.Before(b.deadline) {
+			due = append(due, b)
+			delete(d.buffers, key)
+		}
+	}
+	for key, expiry := range d.sent {
+		if !now.Before(expiry) {
+			delete(d.sent, key)
+		}
+	}
+	d.lock.Unlock()
+
+	for _, b := range due {
+		if err := b.api.Send(b.toUnstructured(), []string{b.digest.Template}, b.dest); err != nil {
+			if b.attempts+1 >= digestMaxAttempts {
+				log.Errorf("Failed to send digest %s with %d notifications to %v %d times, dropping it: %v", b.digest.Name, len(b.events), b.dest, digestMaxAttempts, err)
+				continue
+			}
+			log.Warnf("Failed to send digest %s with %d notifications to %v, retrying: %v", b.digest.Name, len(b.events), b.dest, err)
+			d.retry(b, now)
+		} else {
+			log.Infof("Digest %s with %d notifications was sent to %v", b.digest.Name, len(b.events), b.dest)
+		}
+	}
+}
+
+func (d *digester) run(ctx context.Context) {
+	ticker := time.NewTicker(digestFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.flush()
+		}
+	}
+}
+
+// digestAPI delivers the notifications about applications which match a digest through the digester
+type digestAPI struct {
+	api.API
+	digester *digester
+	digests  []settings.Digest
+}
+
+// RunTrigger records the trigger evaluated for the object, so that the notifications sent right after can be
+// matched against the triggers of the digests
+func (a *digestAPI) RunTrigger(trigger string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	a.digester.setTrigger(objectKey(vars), trigger)
+	return a.API.RunTrigger(trigger, vars)
+}
+
+func (a *digestAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	kind := (&unstructured.Unstructured{Object: obj}).GetKind()
+	if kind == application.ApplicationSetKind || kind == application.AppProjectKind {
+		return a.API.Send(obj, templates, dest)
+	}
+	trigger := a.digester.getTrigger(objectKey(obj))
+	for _, digest := range a.digests {
+		if digest.Matches(trigger, dest) {
+			return a.digester.send(a.API, digest, trigger, obj, templates, dest)
+		}
+	}
+	return a.API.Send(obj, templates, dest)
+}
+
+// digestFactory returns APIs which deliver notifications through the digester
+type digestFactory struct {
+	api.Factory
+	digester *digester
+}
+
+func (f *digestFactory) GetAPI() (api.API, error) {
+	notificationAPI, err := f.Factory.GetAPI()
+	if err != nil {
+		return nil, err
+	}
+	return f.digester.wrap(notificationAPI), nil
+}
+
+func (f *digestFactory) GetAPIsFromNamespace(namespace string) (map[string]api.API, error) {
+	apis, err := f.Factory.GetAPIsFromNamespace(namespace)
+	for ns, notificationAPI := range apis {
+		apis[ns] = f.digester.wrap(notificationAPI)
+	}
+	return apis, err
+}
+
+func objectKey(obj map[string]interface{}) string {
+	un := &unstructured.Unstructured{Object: obj}
+	return un.GetKind() + "/" + un.GetNamespace() + "/" + un.GetName()
+}
+
+// appRevision returns the revision the notifications about the application are about
+func appRevision(app *unstructured.Unstructured) string {
+	for _, path := range revisionPaths {
+		if revision, _, _ := unstructured.NestedString(app.Object, append(path, "revision")...); revision != "" {
+			return revision
+		}
+		if revisions, _, _ := unstructured.NestedStringSlice(app.Object, append(path, "revisions")...); len(revisions) > 0 {
+			return strings.Join(revisions, ",")
+		}
+	}
+	return ""
+}
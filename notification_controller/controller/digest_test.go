@@ -0,0 +1,299 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+)
+
+type sentNotification struct {
+	obj       map[string]interface{}
+	templates []string
+	dest      services.Destination
+}
+
+type fakeAPI struct {
+	api.API
+	namespace string
+	sendErr   error
+	sent      []sentNotification
+}
+
+func (a *fakeAPI) GetConfig() api.Config {
+	return api.Config{Namespace: a.namespace}
+}
+
+func (a *fakeAPI) RunTrigger(trigger string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	return []triggers.ConditionResult{{Triggered: true, Templates: []string{trigger}}}, nil
+}
+
+func (a *fakeAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	if a.sendErr != nil {
+		return a.sendErr
+	}
+	a.sent = append(a.sent, sentNotification{obj: obj, templates: templates, dest: dest})
+	return nil
+}
+
+func newTestDigester(t *testing.T, digests string) (*digester, *time.Time) {
+	t.Helper()
+	configMapInformer := k8s.NewConfigMapInformer(k8sfake.NewSimpleClientset(), "argocd", "argocd-notifications-cm")
+	err := configMapInformer.GetIndexer().Add(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-notifications-cm", Namespace: "argocd", ResourceVersion: "1"},
+		Data:       map[string]string{settings.DigestsKey: digests},
+	})
+	require.NoError(t, err)
+	d := newDigester(configMapInformer, "argocd-notifications-cm")
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	d.now = func() time.Time {
+		return now
+	}
+	return d, &now
+}
+
+func newDigestTestApp(name string, project string, revision string) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":     "Application",
+		"metadata": map[string]interface{}{"name": name, "namespace": "argocd"},
+		"spec":     map[string]interface{}{"project": project},
+		"status":   map[string]interface{}{"sync": map[string]interface{}{"revision": revision}},
+	}
+}
+
+// notify sends the notification of the trigger about the app the way the notifications engine does
+func notify(t *testing.T, notificationAPI api.API, trigger string, app map[string]interface{}, dest services.Destination) {
+	t.Helper()
+	res, err := notificationAPI.RunTrigger(trigger, app)
+	require.NoError(t, err)
+	require.NoError(t, notificationAPI.Send(app, res[0].Templates, dest))
+}
+
+func TestDigester_NoDigests(t *testing.T) {
+	d, _ := newTestDigester(t, "")
+	notificationAPI := &fakeAPI{namespace: "argocd"}
+
+	assert.Same(t, notificationAPI, d.wrap(notificationAPI))
+}
+
+func TestDigester_Window(t *testing.T) {
+	d, now := newTestDigester(t, `
+- name: failures
+  recipients: [slack:deployments]
+  triggers: [on-sync-failed, on-health-degraded]
+  window: 5m
+  template: failures-digest
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd"}
+	wrapped := d.wrap(notificationAPI)
+	deployments := services.Destination{Service: "slack", Recipient: "deployments"}
+
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-2", "default", "abc"), deployments)
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), deployments)
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-3", "other", "abc"), deployments)
+	notify(t, wrapped, "on-health-degraded", newDigestTestApp("app-1", "default", "abc"), deployments)
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), services.Destination{Service: "slack", Recipient: "alerts"})
+	notify(t, wrapped, "on-deployed", newDigestTestApp("app-1", "default", "abc"), deployments)
+
+	require.Len(t, notificationAPI.sent, 2)
+	assert.Equal(t, []string{"on-sync-failed"}, notificationAPI.sent[0].templates)
+	assert.Equal(t, []string{"on-deployed"}, notificationAPI.sent[1].templates)
+	notificationAPI.sent = nil
+
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+
+	*now = now.Add(5 * time.Minute)
+	d.flush()
+	require.Len(t, notificationAPI.sent, 3)
+	summaries := map[string]map[string]interface{}{}
+	for _, n := range notificationAPI.sent {
+		assert.Equal(t, []string{"failures-digest"}, n.templates)
+		assert.Equal(t, deployments, n.dest)
+		assert.Equal(t, settings.DigestKind, n.obj["kind"])
+		summaries[n.obj["trigger"].(string)+"/"+n.obj["project"].(string)] = n.obj
+	}
+	failed := summaries["on-sync-failed/default"]
+	require.NotNil(t, failed)
+	apps := failed["applications"].([]interface{})
+	require.Len(t, apps, 2)
+	assert.Equal(t, "app-1", apps[0].(map[string]interface{})["metadata"].(map[string]interface{})["name"])
+	assert.Equal(t, "app-2", apps[1].(map[string]interface{})["metadata"].(map[string]interface{})["name"])
+	assert.Equal(t, "5m0s", failed["window"])
+	assert.Len(t, summaries["on-sync-failed/other"]["applications"], 1)
+	assert.Len(t, summaries["on-health-degraded/default"]["applications"], 1)
+
+	notificationAPI.sent = nil
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+}
+
+func TestDigester_RetryFailedDigest(t *testing.T) {
+	d, now := newTestDigester(t, `
+- recipients: [slack]
+  window: 1m
+  template: digest
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd", sendErr: errors.New("unavailable")}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	*now = now.Add(time.Minute)
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+
+	notificationAPI.sendErr = nil
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-2", "default", "abc"), dest)
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+
+	*now = now.Add(digestRetryInterval)
+	d.flush()
+	require.Len(t, notificationAPI.sent, 1)
+	assert.Len(t, notificationAPI.sent[0].obj["applications"], 2)
+
+	notificationAPI.sent = nil
+	*now = now.Add(digestMaxRetryInterval)
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+}
+
+func TestDigester_DropDigestAfterMaxAttempts(t *testing.T) {
+	d, now := newTestDigester(t, `
+- recipients: [slack]
+  window: 1m
+  template: digest
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd", sendErr: errors.New("channel not found")}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	*now = now.Add(time.Minute)
+	for i := 1; i < digestMaxAttempts; i++ {
+		d.flush()
+		require.Len(t, d.buffers, 1, "attempt %d", i)
+		*now = now.Add(digestMaxRetryInterval)
+	}
+	d.flush()
+	assert.Empty(t, d.buffers)
+
+	// the destination works again, but the dropped digest is not sent
+	notificationAPI.sendErr = nil
+	*now = now.Add(digestMaxRetryInterval)
+	d.flush()
+	assert.Empty(t, notificationAPI.sent)
+}
+
+func TestDigester_GroupByProject(t *testing.T) {
+	d, now := newTestDigester(t, `
+- recipients: [slack]
+  window: 1m
+  groupBy: [project]
+  template: digest
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd"}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	notify(t, wrapped, "on-health-degraded", newDigestTestApp("app-1", "default", "abc"), dest)
+
+	*now = now.Add(time.Minute)
+	d.flush()
+	require.Len(t, notificationAPI.sent, 1)
+	summary := notificationAPI.sent[0].obj
+	assert.Equal(t, "", summary["trigger"])
+	assert.Equal(t, "default", summary["project"])
+	assert.Len(t, summary["applications"], 1)
+	events := summary["events"].([]interface{})
+	require.Len(t, events, 2)
+	assert.Equal(t, "on-health-degraded", events[0].(map[string]interface{})["trigger"])
+	assert.Equal(t, "on-sync-failed", events[1].(map[string]interface{})["trigger"])
+}
+
+func TestDigester_Cooldown(t *testing.T) {
+	d, now := newTestDigester(t, `
+- recipients: [slack:deployments]
+  cooldown: 1h
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd"}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	assert.Len(t, notificationAPI.sent, 1)
+
+	// another revision, trigger or application is not a repeat
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "def"), dest)
+	notify(t, wrapped, "on-health-degraded", newDigestTestApp("app-1", "default", "abc"), dest)
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-2", "default", "abc"), dest)
+	assert.Len(t, notificationAPI.sent, 4)
+
+	*now = now.Add(time.Hour)
+	d.flush()
+	notify(t, wrapped, "on-sync-failed", newDigestTestApp("app-1", "default", "abc"), dest)
+	assert.Len(t, notificationAPI.sent, 5)
+}
+
+func TestDigester_CooldownSendFailure(t *testing.T) {
+	d, _ := newTestDigester(t, `
+- recipients: [slack]
+  cooldown: 1h
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd", sendErr: errors.New("unavailable")}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+	app := newDigestTestApp("app-1", "default", "abc")
+
+	_, err := wrapped.RunTrigger("on-sync-failed", app)
+	require.NoError(t, err)
+	assert.Error(t, wrapped.Send(app, []string{"on-sync-failed"}, dest))
+
+	notificationAPI.sendErr = nil
+	notify(t, wrapped, "on-sync-failed", app, dest)
+	assert.Len(t, notificationAPI.sent, 1)
+}
+
+func TestDigester_IgnoresOtherKinds(t *testing.T) {
+	d, _ := newTestDigester(t, `
+- recipients: [slack]
+  cooldown: 1h
+`)
+	notificationAPI := &fakeAPI{namespace: "argocd"}
+	wrapped := d.wrap(notificationAPI)
+	dest := services.Destination{Service: "slack", Recipient: "deployments"}
+	appSet := map[string]interface{}{
+		"kind":     "ApplicationSet",
+		"metadata": map[string]interface{}{"name": "appset", "namespace": "argocd"},
+	}
+
+	notify(t, wrapped, "on-appset-error", appSet, dest)
+	notify(t, wrapped, "on-appset-error", appSet, dest)
+	assert.Len(t, notificationAPI.sent, 2)
+}
+
+func TestAppRevision(t *testing.T) {
+	app := newDigestTestApp("app", "default", "abc")
+	assert.Equal(t, "abc", appRevision(&unstructured.Unstructured{Object: app}))
+
+	app["status"] = map[string]interface{}{
+		"sync":           map[string]interface{}{"revision": "abc"},
+		"operationState": map[string]interface{}{"syncResult": map[string]interface{}{"revisions": []interface{}{"def", "ghi"}}},
+	}
+	assert.Equal(t, "def,ghi", appRevision(&unstructured.Unstructured{Object: app}))
+}
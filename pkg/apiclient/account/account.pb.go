@@ -6,6 +6,7 @@ package account
 import (
 	context "context"
 	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -130,9 +131,11 @@ func (m *UpdatePasswordResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdatePasswordResponse proto.InternalMessageInfo
 
 type CanIRequest struct {
-	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Subresource          string   `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	Resource    string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Subresource string `protobuf:"bytes,3,opt,name=subresource,proto3" json:"subresource,omitempty"`
+	// explain requests an explanation of the decision, listing the matching policy lines
+	Explain              bool     `protobuf:"varint,4,opt,name=explain,proto3" json:"explain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -192,11 +195,20 @@ func (m *CanIRequest) GetSubresource() string {
 	return ""
 }
 
+func (m *CanIRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
 type CanIResponse struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// explanation of the decision, only set if requested
+	Explanation          *CanIExplanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CanIResponse) Reset()         { *m = CanIResponse{} }
@@ -239,6 +251,209 @@ func (m *CanIResponse) GetValue() string {
 	return ""
 }
 
+func (m *CanIResponse) GetExplanation() *CanIExplanation {
+	if m != nil {
+		return m.Explanation
+	}
+	return nil
+}
+
+// CanIExplanation describes why a request is allowed or denied
+type CanIExplanation struct {
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// subjects are the evaluated subjects, e.g. the default role, the user and its groups
+	Subjects             []*CanISubjectExplanation `protobuf:"bytes,2,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *CanIExplanation) Reset()         { *m = CanIExplanation{} }
+func (m *CanIExplanation) String() string { return proto.CompactTextString(m) }
+func (*CanIExplanation) ProtoMessage()    {}
+func (*CanIExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{4}
+}
+func (m *CanIExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanIExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanIExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanIExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanIExplanation.Merge(m, src)
+}
+func (m *CanIExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanIExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanIExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanIExplanation proto.InternalMessageInfo
+
+func (m *CanIExplanation) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *CanIExplanation) GetSubjects() []*CanISubjectExplanation {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+// CanISubjectExplanation describes why a request is allowed or denied for a single subject
+type CanISubjectExplanation struct {
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// origin describes why the subject was evaluated
+	Origin  string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Allowed bool   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// groupings are the role assignments through which the matching policies apply to the subject
+	Groupings []*CanIPolicyLine `protobuf:"bytes,4,rep,name=groupings,proto3" json:"groupings,omitempty"`
+	// policies are the policy lines matching the request
+	Policies             []*CanIPolicyLine `protobuf:"bytes,5,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CanISubjectExplanation) Reset()         { *m = CanISubjectExplanation{} }
+func (m *CanISubjectExplanation) String() string { return proto.CompactTextString(m) }
+func (*CanISubjectExplanation) ProtoMessage()    {}
+func (*CanISubjectExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{5}
+}
+func (m *CanISubjectExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanISubjectExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanISubjectExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanISubjectExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanISubjectExplanation.Merge(m, src)
+}
+func (m *CanISubjectExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanISubjectExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanISubjectExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanISubjectExplanation proto.InternalMessageInfo
+
+func (m *CanISubjectExplanation) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CanISubjectExplanation) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *CanISubjectExplanation) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *CanISubjectExplanation) GetGroupings() []*CanIPolicyLine {
+	if m != nil {
+		return m.Groupings
+	}
+	return nil
+}
+
+func (m *CanISubjectExplanation) GetPolicies() []*CanIPolicyLine {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+// CanIPolicyLine is a policy line along with its source, i.e. builtin, user or project:<name>
+type CanIPolicyLine struct {
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Line                 string   `protobuf:"bytes,2,opt,name=line,proto3" json:"line,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CanIPolicyLine) Reset()         { *m = CanIPolicyLine{} }
+func (m *CanIPolicyLine) String() string { return proto.CompactTextString(m) }
+func (*CanIPolicyLine) ProtoMessage()    {}
+func (*CanIPolicyLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{6}
+}
+func (m *CanIPolicyLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanIPolicyLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanIPolicyLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanIPolicyLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanIPolicyLine.Merge(m, src)
+}
+func (m *CanIPolicyLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanIPolicyLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanIPolicyLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanIPolicyLine proto.InternalMessageInfo
+
+func (m *CanIPolicyLine) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *CanIPolicyLine) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
 type GetAccountRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -250,7 +465,7 @@ func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
 func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountRequest) ProtoMessage()    {}
 func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{4}
+	return fileDescriptor_56d089a9b5e998c0, []int{7}
 }
 func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,7 +515,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{5}
+	return fileDescriptor_56d089a9b5e998c0, []int{8}
 }
 func (m *Account) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +583,7 @@ func (m *AccountsList) Reset()         { *m = AccountsList{} }
 func (m *AccountsList) String() string { return proto.CompactTextString(m) }
 func (*AccountsList) ProtoMessage()    {}
 func (*AccountsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{6}
+	return fileDescriptor_56d089a9b5e998c0, []int{9}
 }
 func (m *AccountsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,19 +620,23 @@ func (m *AccountsList) GetItems() []*Account {
 }
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IssuedAt             int64    `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
-	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// lastUsedAt is when the token was last used, updated at most every few minutes
+	LastUsedAt int64 `protobuf:"varint,4,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	// scope restricts the token to a subset of the permissions of the account
+	Scope                *v1alpha1.TokenScope `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{7}
+	return fileDescriptor_56d089a9b5e998c0, []int{10}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,6 +686,20 @@ func (m *Token) GetExpiresAt() int64 {
 	return 0
 }
 
+func (m *Token) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *Token) GetScope() *v1alpha1.TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 type TokensList struct {
 	Items                []*Token `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -478,7 +711,7 @@ func (m *TokensList) Reset()         { *m = TokensList{} }
 func (m *TokensList) String() string { return proto.CompactTextString(m) }
 func (*TokensList) ProtoMessage()    {}
 func (*TokensList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{8}
+	return fileDescriptor_56d089a9b5e998c0, []int{11}
 }
 func (m *TokensList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,18 +750,20 @@ func (m *TokensList) GetItems() []*Token {
 type CreateTokenRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ExpiresIn int64  `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// scope restricts the token to a subset of the permissions of the account
+	Scope                *v1alpha1.TokenScope `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CreateTokenRequest) Reset()         { *m = CreateTokenRequest{} }
 func (m *CreateTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTokenRequest) ProtoMessage()    {}
 func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{9}
+	return fileDescriptor_56d089a9b5e998c0, []int{12}
 }
 func (m *CreateTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,6 +813,13 @@ func (m *CreateTokenRequest) GetId() string {
 	return ""
 }
 
+func (m *CreateTokenRequest) GetScope() *v1alpha1.TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 type CreateTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -589,7 +831,7 @@ func (m *CreateTokenResponse) Reset()         { *m = CreateTokenResponse{} }
 func (m *CreateTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateTokenResponse) ProtoMessage()    {}
 func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{10}
+	return fileDescriptor_56d089a9b5e998c0, []int{13}
 }
 func (m *CreateTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,6 +867,80 @@ func (m *CreateTokenResponse) GetToken() string {
 	return ""
 }
 
+type RotateTokenRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// id is the ID of the token to replace
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// gracePeriod is the duration in seconds during which the replaced token remains valid. It is revoked immediately if zero.
+	GracePeriod int64 `protobuf:"varint,3,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	// expiresIn represents a duration in seconds. Defaults to the lifetime of the replaced token.
+	ExpiresIn            int64    `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateTokenRequest) Reset()         { *m = RotateTokenRequest{} }
+func (m *RotateTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateTokenRequest) ProtoMessage()    {}
+func (*RotateTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{14}
+}
+func (m *RotateTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateTokenRequest.Merge(m, src)
+}
+func (m *RotateTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateTokenRequest proto.InternalMessageInfo
+
+func (m *RotateTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RotateTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RotateTokenRequest) GetGracePeriod() int64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *RotateTokenRequest) GetExpiresIn() int64 {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return 0
+}
+
 type DeleteTokenRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -637,7 +953,7 @@ func (m *DeleteTokenRequest) Reset()         { *m = DeleteTokenRequest{} }
 func (m *DeleteTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTokenRequest) ProtoMessage()    {}
 func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{11}
+	return fileDescriptor_56d089a9b5e998c0, []int{15}
 }
 func (m *DeleteTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +1006,7 @@ func (m *ListAccountRequest) Reset()         { *m = ListAccountRequest{} }
 func (m *ListAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAccountRequest) ProtoMessage()    {}
 func (*ListAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{12}
+	return fileDescriptor_56d089a9b5e998c0, []int{16}
 }
 func (m *ListAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,7 +1045,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{13}
+	return fileDescriptor_56d089a9b5e998c0, []int{17}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,6 +1079,9 @@ func init() {
 	proto.RegisterType((*UpdatePasswordResponse)(nil), "account.UpdatePasswordResponse")
 	proto.RegisterType((*CanIRequest)(nil), "account.CanIRequest")
 	proto.RegisterType((*CanIResponse)(nil), "account.CanIResponse")
+	proto.RegisterType((*CanIExplanation)(nil), "account.CanIExplanation")
+	proto.RegisterType((*CanISubjectExplanation)(nil), "account.CanISubjectExplanation")
+	proto.RegisterType((*CanIPolicyLine)(nil), "account.CanIPolicyLine")
 	proto.RegisterType((*GetAccountRequest)(nil), "account.GetAccountRequest")
 	proto.RegisterType((*Account)(nil), "account.Account")
 	proto.RegisterType((*AccountsList)(nil), "account.AccountsList")
@@ -770,6 +1089,7 @@ func init() {
 	proto.RegisterType((*TokensList)(nil), "account.TokensList")
 	proto.RegisterType((*CreateTokenRequest)(nil), "account.CreateTokenRequest")
 	proto.RegisterType((*CreateTokenResponse)(nil), "account.CreateTokenResponse")
+	proto.RegisterType((*RotateTokenRequest)(nil), "account.RotateTokenRequest")
 	proto.RegisterType((*DeleteTokenRequest)(nil), "account.DeleteTokenRequest")
 	proto.RegisterType((*ListAccountRequest)(nil), "account.ListAccountRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "account.EmptyResponse")
@@ -778,54 +1098,73 @@ func init() {
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0xa6, 0x3f, 0x27, 0xb9, 0xe9, 0xed, 0xdc, 0x36, 0xd7, 0xf2, 0xcd, 0x0d, 0xe9,
-	0xb4, 0x6a, 0x43, 0x50, 0x6b, 0x11, 0x10, 0x42, 0x15, 0x2c, 0xda, 0x82, 0x50, 0x25, 0x16, 0x10,
-	0x7e, 0x16, 0x65, 0x35, 0x71, 0x46, 0x61, 0x68, 0x62, 0xbb, 0x9e, 0x71, 0x02, 0x8a, 0xb2, 0x81,
-	0x47, 0x60, 0xcb, 0x03, 0xb1, 0x44, 0xe2, 0x05, 0x50, 0xc5, 0x83, 0x20, 0x8f, 0x67, 0x1c, 0xc7,
-	0x49, 0x11, 0x2b, 0xfb, 0xfc, 0xcc, 0xf9, 0xbe, 0xf3, 0xcd, 0x39, 0x36, 0x54, 0x39, 0x0d, 0x86,
-	0x34, 0xb0, 0x89, 0xe3, 0x78, 0xa1, 0x2b, 0xf4, 0xf3, 0xd0, 0x0f, 0x3c, 0xe1, 0xa1, 0x15, 0x65,
-	0x5a, 0xd5, 0x9e, 0xe7, 0xf5, 0xfa, 0xd4, 0x26, 0x3e, 0xb3, 0x89, 0xeb, 0x7a, 0x82, 0x08, 0xe6,
-	0xb9, 0x3c, 0x4e, 0xc3, 0x23, 0xd8, 0x7a, 0xe5, 0x77, 0x89, 0xa0, 0xcf, 0x08, 0xe7, 0x23, 0x2f,
-	0xe8, 0xb6, 0xe9, 0x65, 0x48, 0xb9, 0x40, 0x75, 0x28, 0xba, 0x74, 0xa4, 0xbd, 0xa6, 0x51, 0x37,
-	0x1a, 0x6b, 0xed, 0xb4, 0x0b, 0x35, 0x60, 0xdd, 0x09, 0x83, 0x80, 0xba, 0x22, 0xc9, 0xca, 0xc9,
-	0xac, 0xac, 0x1b, 0x21, 0x58, 0x72, 0xc9, 0x80, 0x9a, 0x79, 0x19, 0x96, 0xef, 0xd8, 0x84, 0x4a,
-	0x16, 0x98, 0xfb, 0x9e, 0xcb, 0x29, 0x76, 0xa0, 0x78, 0x4a, 0xdc, 0x33, 0x4d, 0xc4, 0x82, 0xd5,
-	0x80, 0x72, 0x2f, 0x0c, 0x1c, 0xaa, 0x58, 0x24, 0x36, 0xaa, 0xc0, 0x32, 0x71, 0xa2, 0x76, 0x14,
-	0xb2, 0xb2, 0x22, 0xf2, 0x3c, 0xec, 0x24, 0xc7, 0x62, 0xdc, 0xb4, 0x0b, 0xef, 0x42, 0x29, 0x06,
-	0x89, 0x41, 0xd1, 0x26, 0x14, 0x86, 0xa4, 0x1f, 0x6a, 0x88, 0xd8, 0xc0, 0xfb, 0xb0, 0xf1, 0x84,
-	0x8a, 0xe3, 0x58, 0x49, 0x4d, 0x48, 0x77, 0x63, 0xa4, 0xba, 0xf9, 0x64, 0xc0, 0x8a, 0x4a, 0x5b,
-	0x14, 0x47, 0x26, 0xac, 0x50, 0x97, 0x74, 0xfa, 0x34, 0xd6, 0x68, 0xb5, 0xad, 0x4d, 0x84, 0xa1,
-	0xe4, 0x10, 0x9f, 0x74, 0x58, 0x9f, 0x09, 0x46, 0xb9, 0x99, 0xaf, 0xe7, 0x1b, 0x6b, 0xed, 0x19,
-	0x1f, 0xda, 0x83, 0x65, 0xe1, 0x5d, 0x50, 0x97, 0x9b, 0x4b, 0xf5, 0x7c, 0xa3, 0xd8, 0x2a, 0x1f,
-	0xea, 0xbb, 0x7e, 0x19, 0xb9, 0xdb, 0x2a, 0x8a, 0xef, 0x41, 0x49, 0x91, 0xe0, 0x4f, 0x19, 0x17,
-	0x68, 0x0f, 0x0a, 0x4c, 0xd0, 0x01, 0x37, 0x0d, 0x79, 0xec, 0xef, 0xe4, 0x98, 0xee, 0x28, 0x0e,
-	0xe3, 0xe7, 0x50, 0x90, 0x85, 0x50, 0x19, 0x72, 0x4c, 0xdf, 0x75, 0x8e, 0x75, 0x23, 0xed, 0x19,
-	0xe7, 0x21, 0xed, 0x1e, 0x0b, 0xc9, 0x3b, 0xdf, 0x4e, 0x6c, 0x54, 0x85, 0x35, 0xfa, 0xde, 0x67,
-	0x01, 0xe5, 0xc7, 0x42, 0x2a, 0x9c, 0x6f, 0x4f, 0x1d, 0xb8, 0x05, 0x20, 0x4b, 0xc6, 0x44, 0x76,
-	0x67, 0x89, 0x64, 0xf9, 0x2b, 0x1a, 0xaf, 0x01, 0x9d, 0x06, 0x94, 0x08, 0x1a, 0x7b, 0xaf, 0x97,
-	0x3b, 0x85, 0x7d, 0xe6, 0x2a, 0x62, 0x53, 0x87, 0xea, 0x22, 0xaf, 0xbb, 0xc0, 0xb7, 0xe0, 0x9f,
-	0x99, 0xba, 0xd3, 0x2b, 0x97, 0xba, 0xe9, 0x2b, 0x97, 0x06, 0xbe, 0x0f, 0xe8, 0x11, 0xed, 0xd3,
-	0x3f, 0x20, 0x11, 0xc3, 0xe4, 0x12, 0x98, 0x4d, 0x40, 0x51, 0xb3, 0xb3, 0xd3, 0x82, 0xd7, 0xe1,
-	0xaf, 0xc7, 0x03, 0x5f, 0x7c, 0xd0, 0xb0, 0xad, 0x2f, 0x05, 0x28, 0xab, 0x9c, 0x17, 0x34, 0x18,
-	0x32, 0x87, 0xa2, 0x11, 0x2c, 0x45, 0xc3, 0x88, 0x36, 0x13, 0x5d, 0x52, 0x0b, 0x60, 0x6d, 0x65,
-	0xbc, 0x6a, 0x4d, 0x4e, 0x3e, 0x7e, 0xff, 0xf9, 0x39, 0xf7, 0x00, 0x1d, 0xc9, 0xcd, 0x1e, 0xde,
-	0x4e, 0xbe, 0x03, 0x0e, 0x71, 0x0f, 0x98, 0x3d, 0xd6, 0xa3, 0x3e, 0xb1, 0xc7, 0xf1, 0x56, 0x4c,
-	0xec, 0x71, 0x6a, 0x03, 0x1e, 0x36, 0x9b, 0x13, 0x34, 0x84, 0xf2, 0xec, 0x12, 0xa2, 0x5a, 0x02,
-	0xb6, 0xf0, 0xb3, 0x60, 0xdd, 0xb8, 0x36, 0xae, 0x68, 0xed, 0x48, 0x5a, 0xff, 0x5b, 0x66, 0x96,
-	0x96, 0xaf, 0x32, 0x8f, 0x8c, 0x26, 0x7a, 0x03, 0xa5, 0x94, 0x54, 0x1c, 0xfd, 0x97, 0x54, 0x9d,
-	0x57, 0x30, 0xd5, 0x7f, 0x7a, 0xb8, 0xf1, 0xbf, 0x12, 0x68, 0x03, 0xad, 0x67, 0x80, 0xd0, 0x39,
-	0xc0, 0x74, 0x69, 0x91, 0x95, 0x9c, 0x9e, 0xdb, 0x64, 0x6b, 0x6e, 0x21, 0x70, 0x4d, 0x16, 0x35,
-	0x51, 0x25, 0xcb, 0x7e, 0x1c, 0x5d, 0xf9, 0x04, 0x5d, 0x42, 0x31, 0x35, 0x4a, 0x29, 0xde, 0xf3,
-	0x83, 0x6b, 0x55, 0x17, 0x07, 0x95, 0x4e, 0xfb, 0x12, 0x69, 0x1b, 0x57, 0x17, 0x23, 0xd9, 0x72,
-	0x1a, 0x23, 0xad, 0x06, 0x50, 0x4c, 0x0d, 0x64, 0x0a, 0x72, 0x7e, 0x4c, 0xad, 0x4a, 0x12, 0x9c,
-	0x99, 0x39, 0x7c, 0x53, 0x82, 0xed, 0x34, 0xb7, 0x7f, 0x07, 0x66, 0x8f, 0x59, 0x77, 0x72, 0x72,
-	0xf2, 0xf5, 0xaa, 0x66, 0x7c, 0xbb, 0xaa, 0x19, 0x3f, 0xae, 0x6a, 0xc6, 0xf9, 0xdd, 0x1e, 0x13,
-	0x6f, 0xc3, 0xce, 0xa1, 0xe3, 0x0d, 0x6c, 0x12, 0xf4, 0x3c, 0x3f, 0xf0, 0xde, 0xc9, 0x97, 0x03,
-	0xa7, 0x6b, 0x0f, 0x5b, 0xb6, 0x7f, 0xd1, 0x8b, 0x4a, 0x3a, 0x7d, 0x46, 0xa7, 0x7f, 0xa0, 0xce,
-	0xb2, 0xfc, 0xb7, 0xdc, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x62, 0xcc, 0x3d, 0xa2, 0x06,
-	0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xfa, 0x27, 0x71, 0x8e, 0x43, 0x42, 0x87, 0x34, 0x5d, 0x99, 0xe0, 0xba, 0x53, 0xd4,
+	0x9a, 0xa0, 0x78, 0x15, 0x07, 0x10, 0x0a, 0xe5, 0x22, 0x29, 0x15, 0x54, 0xca, 0x45, 0xb4, 0xa1,
+	0x37, 0x45, 0x02, 0xc6, 0xeb, 0xd1, 0x66, 0x9a, 0xcd, 0xce, 0x76, 0x67, 0xec, 0xa4, 0x8a, 0x7c,
+	0x03, 0x8f, 0xc0, 0x25, 0xef, 0xc1, 0x33, 0x70, 0x59, 0xc4, 0x0b, 0xa0, 0x88, 0x07, 0x41, 0x33,
+	0x3b, 0xbb, 0x9e, 0xb5, 0xdd, 0x1f, 0x2e, 0x7a, 0xe5, 0x3d, 0x7f, 0xf3, 0x9d, 0xf3, 0xcd, 0x39,
+	0x67, 0x0c, 0x5b, 0x82, 0xa6, 0x63, 0x9a, 0x7a, 0x24, 0x08, 0xf8, 0x28, 0x96, 0xf9, 0x6f, 0x2f,
+	0x49, 0xb9, 0xe4, 0x68, 0xd9, 0x88, 0xad, 0xad, 0x90, 0xf3, 0x30, 0xa2, 0x1e, 0x49, 0x98, 0x47,
+	0xe2, 0x98, 0x4b, 0x22, 0x19, 0x8f, 0x45, 0xe6, 0xd6, 0x3a, 0x0a, 0x99, 0x3c, 0x1d, 0x0d, 0x7a,
+	0x01, 0x3f, 0xf7, 0x48, 0x1a, 0xf2, 0x24, 0xe5, 0xcf, 0xf4, 0xc7, 0x4e, 0x30, 0xf4, 0xc6, 0x7d,
+	0x2f, 0x39, 0x0b, 0x55, 0xa4, 0xf0, 0x48, 0x92, 0x44, 0x2c, 0xd0, 0xb1, 0xde, 0x78, 0x97, 0x44,
+	0xc9, 0x29, 0xd9, 0xf5, 0x42, 0x1a, 0xd3, 0x94, 0x48, 0x3a, 0xcc, 0x4e, 0xc3, 0x17, 0x70, 0xf3,
+	0x49, 0x32, 0x24, 0x92, 0x1e, 0x13, 0x21, 0x2e, 0x78, 0x3a, 0xf4, 0xe9, 0xf3, 0x11, 0x15, 0x12,
+	0x75, 0xa0, 0x19, 0xd3, 0x8b, 0x5c, 0xeb, 0x3a, 0x1d, 0xa7, 0xbb, 0xe2, 0xdb, 0x2a, 0xd4, 0x85,
+	0xf5, 0x60, 0x94, 0xa6, 0x34, 0x96, 0x85, 0x57, 0x45, 0x7b, 0xcd, 0xaa, 0x11, 0x82, 0x5a, 0x4c,
+	0xce, 0xa9, 0x5b, 0xd5, 0x66, 0xfd, 0x8d, 0x5d, 0xd8, 0x9c, 0x05, 0x16, 0x09, 0x8f, 0x05, 0xc5,
+	0x13, 0x68, 0x3e, 0x24, 0xf1, 0xe3, 0x3c, 0x91, 0x16, 0x34, 0x52, 0x2a, 0xf8, 0x28, 0x0d, 0xa8,
+	0xc9, 0xa2, 0x90, 0xd1, 0x26, 0x2c, 0x91, 0x40, 0x15, 0x68, 0x90, 0x8d, 0xa4, 0x92, 0x17, 0xa3,
+	0x41, 0x11, 0x96, 0xe1, 0xda, 0x2a, 0xe4, 0xc2, 0x32, 0xbd, 0x4c, 0x22, 0xc2, 0x62, 0xb7, 0xd6,
+	0x71, 0xba, 0x0d, 0x3f, 0x17, 0xf1, 0xcf, 0xb0, 0x9a, 0xc1, 0x67, 0xe9, 0xa0, 0x0d, 0xa8, 0x8f,
+	0x49, 0x34, 0xca, 0xc1, 0x33, 0x01, 0xed, 0x43, 0x53, 0x07, 0xc4, 0xa4, 0x80, 0x6f, 0xf6, 0xdd,
+	0x5e, 0x7e, 0xa3, 0xea, 0x84, 0x47, 0x53, 0xbb, 0x6f, 0x3b, 0xe3, 0x53, 0x58, 0x9f, 0xb1, 0xab,
+	0x74, 0x48, 0x14, 0xf1, 0x0b, 0x9a, 0x31, 0xdd, 0xf0, 0x73, 0x11, 0x7d, 0x05, 0x0d, 0x31, 0x1a,
+	0x3c, 0xa3, 0x81, 0x14, 0x6e, 0xa5, 0x53, 0xed, 0x36, 0xfb, 0xb7, 0x4b, 0x28, 0x27, 0x99, 0xd1,
+	0x06, 0x2b, 0x02, 0xf0, 0x4b, 0x07, 0x36, 0x17, 0x3b, 0x29, 0x44, 0xe3, 0x66, 0x0a, 0xcb, 0x45,
+	0x45, 0x2a, 0x4f, 0x59, 0xc8, 0x0a, 0x52, 0x33, 0xc9, 0xce, 0xb1, 0x5a, 0xce, 0xf1, 0x73, 0x58,
+	0x09, 0x53, 0x3e, 0x4a, 0x58, 0x1c, 0x0a, 0xb7, 0xa6, 0x93, 0xbc, 0x55, 0x4a, 0xf2, 0x98, 0x47,
+	0x2c, 0x78, 0x71, 0xc4, 0x62, 0xea, 0x4f, 0x3d, 0xd1, 0x1e, 0x34, 0x12, 0x65, 0x60, 0x54, 0xb8,
+	0xf5, 0xd7, 0x47, 0x15, 0x8e, 0xf8, 0x01, 0xac, 0x95, 0x6d, 0x2a, 0xdf, 0x52, 0x7b, 0x18, 0x49,
+	0x75, 0x5d, 0xc4, 0x62, 0x6a, 0xaa, 0xd0, 0xdf, 0xf8, 0x3e, 0xdc, 0xf8, 0x96, 0xca, 0x83, 0x0c,
+	0x24, 0xef, 0xb0, 0xbc, 0x3d, 0x1d, 0xab, 0x3d, 0x7f, 0x75, 0x60, 0xd9, 0xb8, 0x2d, 0xb2, 0xeb,
+	0xfe, 0x89, 0xc9, 0x20, 0xa2, 0x59, 0xd3, 0x37, 0xfc, 0x5c, 0x44, 0x18, 0x56, 0x03, 0x92, 0x90,
+	0x01, 0x8b, 0x98, 0x54, 0x95, 0x55, 0x3b, 0xd5, 0xee, 0x8a, 0x5f, 0xd2, 0xa1, 0x7b, 0xb0, 0x24,
+	0xf9, 0x19, 0x8d, 0x73, 0xb6, 0xd6, 0x8a, 0xba, 0xbf, 0x57, 0x6a, 0xdf, 0x58, 0xf1, 0x17, 0xb0,
+	0x6a, 0x92, 0x10, 0x47, 0x4c, 0x48, 0x74, 0x0f, 0xea, 0x4c, 0xd2, 0x73, 0xe1, 0x3a, 0x3a, 0xec,
+	0xfd, 0x22, 0x2c, 0xaf, 0x28, 0x33, 0xe3, 0xbf, 0x1c, 0xa8, 0xeb, 0x93, 0xd0, 0x1a, 0x54, 0x58,
+	0x3e, 0xbd, 0x15, 0x36, 0x54, 0xd3, 0xc4, 0x84, 0x18, 0xd1, 0xe1, 0x81, 0xd4, 0x89, 0x57, 0xfd,
+	0x42, 0x46, 0x5b, 0xb0, 0x42, 0x2f, 0x13, 0x96, 0x52, 0x71, 0x20, 0xf5, 0x15, 0x57, 0xfd, 0xa9,
+	0x02, 0xb5, 0x01, 0x22, 0x22, 0xe4, 0x13, 0xa1, 0x63, 0x6b, 0xda, 0x6c, 0x69, 0xd0, 0x8f, 0x50,
+	0x17, 0x01, 0x4f, 0xa8, 0x5b, 0xd7, 0xb3, 0xf0, 0x5d, 0x6f, 0xba, 0xa7, 0x7a, 0xf9, 0x9e, 0xd2,
+	0x1f, 0x3f, 0x05, 0xc3, 0xde, 0xb8, 0xdf, 0x4b, 0xce, 0xc2, 0x9e, 0xda, 0x53, 0x3d, 0x6b, 0x4f,
+	0xf5, 0xf2, 0x3d, 0x95, 0xf1, 0x70, 0xa2, 0xce, 0xf3, 0xb3, 0x63, 0x71, 0x1f, 0x40, 0x2b, 0x33,
+	0x26, 0x3e, 0x2e, 0x33, 0x31, 0x4b, 0xa0, 0xe1, 0xe1, 0x0f, 0x07, 0xd0, 0xc3, 0x94, 0x12, 0x49,
+	0x33, 0xf5, 0xab, 0x2f, 0xdc, 0x2a, 0xfe, 0x71, 0x6c, 0x98, 0x99, 0x2a, 0x0c, 0x8d, 0xd5, 0x82,
+	0xc6, 0xa2, 0xd8, 0xda, 0xbb, 0x29, 0xf6, 0x53, 0xf8, 0xa0, 0x94, 0xf7, 0x74, 0x17, 0xe9, 0xce,
+	0xc8, 0x77, 0x91, 0x16, 0xf0, 0x25, 0x20, 0x9f, 0xcb, 0xb7, 0x29, 0x32, 0x2b, 0xa3, 0x52, 0x94,
+	0xd1, 0x81, 0x66, 0x98, 0x92, 0x80, 0x1e, 0xd3, 0x94, 0xf1, 0xa1, 0xb9, 0x73, 0x5b, 0x55, 0xa6,
+	0xa5, 0x36, 0x43, 0x0b, 0xfe, 0x12, 0xd0, 0x37, 0x34, 0xa2, 0xff, 0x1f, 0x19, 0x6f, 0x00, 0x52,
+	0xf7, 0x58, 0x9e, 0x44, 0xbc, 0x0e, 0xef, 0x3d, 0x3a, 0x4f, 0xe4, 0x8b, 0xbc, 0xe0, 0xfe, 0xef,
+	0x4b, 0xb0, 0x66, 0x7c, 0x4e, 0x68, 0x3a, 0x66, 0x01, 0x45, 0x17, 0x50, 0x53, 0x0b, 0x00, 0x6d,
+	0x94, 0x76, 0x85, 0x39, 0xa1, 0x75, 0x73, 0x46, 0x6b, 0xde, 0x94, 0xc3, 0x5f, 0xfe, 0xfe, 0xf7,
+	0xb7, 0xca, 0x03, 0xb4, 0xaf, 0x1f, 0xd5, 0xf1, 0x6e, 0xf1, 0x04, 0x07, 0x24, 0xde, 0x61, 0xde,
+	0x55, 0xfe, 0x2e, 0x4c, 0xbc, 0xab, 0xec, 0x09, 0x99, 0x78, 0x57, 0xd6, 0x73, 0xf1, 0xf5, 0xf6,
+	0xf6, 0x04, 0x8d, 0x61, 0xad, 0xfc, 0x62, 0xa1, 0x76, 0x01, 0xb6, 0xf0, 0x0d, 0x6d, 0xdd, 0x7e,
+	0xa5, 0xdd, 0xa4, 0x75, 0x57, 0xa7, 0xf5, 0x51, 0xcb, 0x9d, 0x4d, 0x2b, 0x31, 0x9e, 0xfb, 0xce,
+	0x36, 0xfa, 0x01, 0x56, 0x2d, 0xaa, 0x04, 0xfa, 0xb0, 0x38, 0x75, 0x9e, 0x41, 0xab, 0x7e, 0x7b,
+	0x71, 0xe0, 0x5b, 0x1a, 0xe8, 0x06, 0x5a, 0x9f, 0x01, 0x42, 0x4f, 0x01, 0xa6, 0x0b, 0x11, 0xb5,
+	0x8a, 0xe8, 0xb9, 0x2d, 0xd9, 0x9a, 0x5b, 0x36, 0xb8, 0xad, 0x0f, 0x75, 0xd1, 0xe6, 0x6c, 0xf6,
+	0x57, 0xea, 0xca, 0x27, 0xe8, 0x39, 0x34, 0xad, 0x26, 0xb6, 0xf2, 0x9e, 0x1f, 0xc9, 0xd6, 0xd6,
+	0x62, 0xa3, 0xe1, 0xe9, 0xbe, 0x46, 0xba, 0x83, 0xb7, 0x16, 0x23, 0x79, 0x7a, 0x0e, 0x14, 0x57,
+	0x13, 0x68, 0x5a, 0xa3, 0x60, 0x41, 0xce, 0x0f, 0xc8, 0x1b, 0x20, 0xf7, 0x34, 0xe4, 0x0e, 0xee,
+	0xbe, 0x0e, 0xd2, 0xbb, 0x62, 0xc3, 0x89, 0x97, 0xea, 0xb3, 0x15, 0xfc, 0x39, 0x34, 0xad, 0x79,
+	0xb0, 0xe0, 0xe7, 0xa7, 0xa4, 0xb5, 0x59, 0x18, 0x4b, 0x2d, 0x8f, 0x3f, 0xd1, 0xc0, 0x77, 0xb7,
+	0xef, 0xbc, 0x11, 0xf8, 0xf0, 0xf0, 0xcf, 0xeb, 0xb6, 0xf3, 0xf2, 0xba, 0xed, 0xfc, 0x73, 0xdd,
+	0x76, 0x9e, 0x7e, 0xf6, 0x76, 0x7f, 0x0c, 0x83, 0x88, 0xd1, 0xe9, 0x7f, 0xcf, 0xc1, 0x92, 0xfe,
+	0x1f, 0xb8, 0xf7, 0xdf, 0x00, 0x1d, 0x88, 0xaa, 0x1e, 0x9c, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -850,6 +1189,8 @@ type AccountServiceClient interface {
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
+	// RotateToken creates a token with the scope of an existing token, which is revoked after a grace period
+	RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
@@ -907,6 +1248,15 @@ func (c *accountServiceClient) CreateToken(ctx context.Context, in *CreateTokenR
 	return out, nil
 }
 
+func (c *accountServiceClient) RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
+	out := new(CreateTokenResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/RotateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, "/account.AccountService/DeleteToken", in, out, opts...)
@@ -928,6 +1278,8 @@ type AccountServiceServer interface {
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
+	// RotateToken creates a token with the scope of an existing token, which is revoked after a grace period
+	RotateToken(context.Context, *RotateTokenRequest) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(context.Context, *DeleteTokenRequest) (*EmptyResponse, error)
 }
@@ -951,6 +1303,9 @@ func (*UnimplementedAccountServiceServer) GetAccount(ctx context.Context, req *G
 func (*UnimplementedAccountServiceServer) CreateToken(ctx context.Context, req *CreateTokenRequest) (*CreateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
+func (*UnimplementedAccountServiceServer) RotateToken(ctx context.Context, req *RotateTokenRequest) (*CreateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateToken not implemented")
+}
 func (*UnimplementedAccountServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
@@ -1049,6 +1404,24 @@ func _AccountService_CreateToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_RotateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RotateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/RotateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RotateToken(ctx, req.(*RotateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTokenRequest)
 	if err := dec(in); err != nil {
@@ -1091,6 +1464,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateToken",
 			Handler:    _AccountService_CreateToken_Handler,
 		},
+		{
+			MethodName: "RotateToken",
+			Handler:    _AccountService_RotateToken_Handler,
+		},
 		{
 			MethodName: "DeleteToken",
 			Handler:    _AccountService_DeleteToken_Handler,
@@ -1199,6 +1576,16 @@ func (m *CanIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Explain {
+		i--
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Subresource) > 0 {
 		i -= len(m.Subresource)
 		copy(dAtA[i:], m.Subresource)
@@ -1247,6 +1634,18 @@ func (m *CanIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Explanation != nil {
+		{
+			size, err := m.Explanation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -1257,7 +1656,7 @@ func (m *CanIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *CanIExplanation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1267,12 +1666,12 @@ func (m *GetAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CanIExplanation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CanIExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1281,11 +1680,182 @@ func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
-		i--
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanISubjectExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanISubjectExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanISubjectExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Groupings) > 0 {
+		for iNdEx := len(m.Groupings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groupings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanIPolicyLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanIPolicyLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanIPolicyLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Line) > 0 {
+		i -= len(m.Line)
+		copy(dAtA[i:], m.Line)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Line)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1423,6 +1993,23 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ExpiresAt != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
 		i--
@@ -1508,6 +2095,18 @@ func (m *CreateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1564,6 +2163,57 @@ func (m *CreateTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RotateTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresIn != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1724,6 +2374,9 @@ func (m *CanIRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.Explain {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1740,21 +2393,30 @@ func (m *CanIResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.Explanation != nil {
+		l = m.Explanation.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetAccountRequest) Size() (n int) {
+func (m *CanIExplanation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+	if m.Allowed {
+		n += 2
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1762,27 +2424,31 @@ func (m *GetAccountRequest) Size() (n int) {
 	return n
 }
 
-func (m *Account) Size() (n int) {
+func (m *CanISubjectExplanation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Subject)
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
-	if m.Enabled {
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Allowed {
 		n += 2
 	}
-	if len(m.Capabilities) > 0 {
-		for _, s := range m.Capabilities {
-			l = len(s)
+	if len(m.Groupings) > 0 {
+		for _, e := range m.Groupings {
+			l = e.Size()
 			n += 1 + l + sovAccount(uint64(l))
 		}
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
 			l = e.Size()
 			n += 1 + l + sovAccount(uint64(l))
 		}
@@ -1793,17 +2459,19 @@ func (m *Account) Size() (n int) {
 	return n
 }
 
-func (m *AccountsList) Size() (n int) {
+func (m *CanIPolicyLine) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovAccount(uint64(l))
-		}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1811,42 +2479,114 @@ func (m *AccountsList) Size() (n int) {
 	return n
 }
 
-func (m *Token) Size() (n int) {
+func (m *GetAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
-	if m.IssuedAt != 0 {
-		n += 1 + sovAccount(uint64(m.IssuedAt))
-	}
-	if m.ExpiresAt != 0 {
-		n += 1 + sovAccount(uint64(m.ExpiresAt))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TokensList) Size() (n int) {
+func (m *Account) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovAccount(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovAccount(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAccount(uint64(m.ExpiresAt))
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovAccount(uint64(m.LastUsedAt))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokensList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -1868,6 +2608,10 @@ func (m *CreateTokenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1890,6 +2634,32 @@ func (m *CreateTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *RotateTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovAccount(uint64(m.GracePeriod))
+	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovAccount(uint64(m.ExpiresIn))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DeleteTokenRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2263,6 +3033,26 @@ func (m *CanIRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Subresource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -2295,28 +3085,487 @@ func (m *CanIResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAccount
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Explanation == nil {
+				m.Explanation = &CanIExplanation{}
+			}
+			if err := m.Explanation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanIExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanIExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanIExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, &CanISubjectExplanation{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanISubjectExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanISubjectExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanISubjectExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groupings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groupings = append(m.Groupings, &CanIPolicyLine{})
+			if err := m.Groupings[len(m.Groupings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &CanIPolicyLine{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanIPolicyLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanIPolicyLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanIPolicyLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CanIResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2344,7 +3593,7 @@ func (m *CanIResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2804,6 +4053,61 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &v1alpha1.TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3023,6 +4327,42 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &v1alpha1.TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3128,6 +4468,159 @@ func (m *CreateTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RotateTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeleteTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
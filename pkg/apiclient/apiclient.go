@@ -43,6 +43,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	signingkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/signingkey"
 	versionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
@@ -82,6 +83,8 @@ type Client interface {
 	NewClusterClientOrDie() (io.Closer, clusterpkg.ClusterServiceClient)
 	NewGPGKeyClient() (io.Closer, gpgkeypkg.GPGKeyServiceClient, error)
 	NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClient)
+	NewSigningKeyClient() (io.Closer, signingkeypkg.SigningKeyServiceClient, error)
+	NewSigningKeyClientOrDie() (io.Closer, signingkeypkg.SigningKeyServiceClient)
 	NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error)
 	NewApplicationSetClient() (io.Closer, applicationsetpkg.ApplicationSetServiceClient, error)
 	NewApplicationClientOrDie() (io.Closer, applicationpkg.ApplicationServiceClient)
@@ -664,6 +667,23 @@ func (c *client) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClien
 	return conn, gpgkeyIf
 }
 
+func (c *client) NewSigningKeyClient() (io.Closer, signingkeypkg.SigningKeyServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	signingkeyIf := signingkeypkg.NewSigningKeyServiceClient(conn)
+	return closer, signingkeyIf, nil
+}
+
+func (c *client) NewSigningKeyClientOrDie() (io.Closer, signingkeypkg.SigningKeyServiceClient) {
+	conn, signingkeyIf, err := c.NewSigningKeyClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, signingkeyIf
+}
+
 func (c *client) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	conn, closer, err := c.newConn()
 	if err != nil {
@@ -168,11 +168,13 @@ type ProjectTokenCreateRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Role        string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ExpiresIn int64  `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// scope restricts the token to a subset of the permissions of the role
+	Scope                *v1alpha1.TokenScope `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ProjectTokenCreateRequest) Reset()         { *m = ProjectTokenCreateRequest{} }
@@ -243,6 +245,13 @@ func (m *ProjectTokenCreateRequest) GetId() string {
 	return ""
 }
 
+func (m *ProjectTokenCreateRequest) GetScope() *v1alpha1.TokenScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 // ProjectTokenResponse wraps the created token or returns an empty string if deleted.
 type ProjectTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -702,70 +711,71 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
+	// 1024 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0x9a, 0x82, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xad, 0xb7, 0xad, 0x09, 0x9f, 0x95, 0xf1, 0xa9, 0xc1, 0xa7, 0x9e, 0x6f,
-	0x0d, 0x5b, 0x56, 0xdc, 0xeb, 0x5a, 0x52, 0xbd, 0x35, 0xcd, 0x92, 0xa9, 0xb7, 0xde, 0x8a, 0x63,
-	0xcd, 0xe3, 0x64, 0xc0, 0x64, 0x13, 0x6a, 0x83, 0x98, 0x63, 0x22, 0xcc, 0x4a, 0xc3, 0x68, 0xde,
-	0x76, 0xf4, 0x13, 0xed, 0xc1, 0x96, 0x8e, 0x7d, 0x3f, 0xea, 0x61, 0xf8, 0x18, 0x03, 0x9c, 0x08,
-	0x33, 0x8b, 0xc2, 0x56, 0x26, 0x70, 0x04, 0x96, 0x92, 0x28, 0x40, 0x05, 0xb6, 0xe2, 0xa8, 0x31,
-	0xd9, 0x80, 0x2a, 0x73, 0x85, 0x59, 0x6d, 0x18, 0xcd, 0xaa, 0x23, 0x87, 0x64, 0x1d, 0x2a, 0xcc,
-	0x37, 0x97, 0x54, 0x4c, 0x85, 0xf9, 0xf4, 0x07, 0xa3, 0xc8, 0x56, 0x2c, 0x43, 0x39, 0x5b, 0x03,
-	0x56, 0x7d, 0xe4, 0x5e, 0xc2, 0x62, 0x99, 0xa8, 0x26, 0x9d, 0x9e, 0xca, 0xf5, 0x54, 0xa7, 0xf4,
-	0x6c, 0xc3, 0x0a, 0x5e, 0xc4, 0x2c, 0x41, 0xfe, 0x4e, 0xa8, 0x44, 0x54, 0x9d, 0xc9, 0x84, 0xd6,
-	0xb6, 0x9c, 0x6b, 0x7b, 0x35, 0x5f, 0x1c, 0x25, 0xcd, 0x41, 0x1e, 0x47, 0x21, 0x47, 0x72, 0x17,
-	0x96, 0x85, 0x9c, 0xd0, 0x9a, 0xd2, 0x07, 0x4a, 0x61, 0x4d, 0x47, 0xbf, 0x37, 0xc0, 0x64, 0x24,
-	0xf9, 0x43, 0xb7, 0x8f, 0x3a, 0x48, 0x8d, 0xe9, 0xe7, 0x39, 0xe2, 0x07, 0xb1, 0xff, 0xff, 0x2e,
-	0x37, 0x7d, 0x1e, 0x9e, 0x3b, 0xed, 0xc7, 0x62, 0x94, 0xa5, 0x41, 0xf7, 0x61, 0xe3, 0x7c, 0x14,
-	0x7a, 0x1f, 0xb2, 0xd0, 0x8f, 0x9e, 0xf1, 0x72, 0xd1, 0x23, 0xb8, 0x33, 0x15, 0x97, 0x57, 0xa1,
-	0x03, 0xb7, 0x9e, 0xa5, 0x53, 0xa6, 0xd1, 0xa8, 0xde, 0x5c, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9,
-	0x05, 0x6c, 0xb6, 0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x27, 0xb0, 0xcc, 0x04, 0xf6,
-	0x17, 0xc4, 0x3d, 0x55, 0xaf, 0x14, 0x96, 0xfe, 0x5c, 0x05, 0xf3, 0x31, 0x0a, 0x97, 0x05, 0xe8,
-	0xcf, 0x91, 0xc7, 0xb0, 0xde, 0x2d, 0xc8, 0x5a, 0xb8, 0x8a, 0x19, 0xfc, 0xe9, 0x06, 0xa9, 0xfc,
-	0x57, 0x7e, 0x10, 0xc0, 0x5a, 0x82, 0x71, 0xc4, 0x99, 0x88, 0x12, 0x86, 0xdc, 0xac, 0x2e, 0x22,
-	0x27, 0x27, 0x43, 0x1c, 0x39, 0x05, 0x74, 0xe2, 0xc2, 0x6d, 0x2f, 0x18, 0x70, 0x81, 0x09, 0x37,
-	0x97, 0x14, 0xd3, 0xe9, 0xcd, 0x98, 0x4e, 0x52, 0x34, 0x27, 0x87, 0xa5, 0x87, 0x70, 0xff, 0x8c,
-	0x71, 0xa1, 0x13, 0x3d, 0x63, 0x61, 0x8f, 0x67, 0x1b, 0xee, 0x9a, 0x3e, 0x6f, 0xfd, 0xb8, 0x06,
-	0xeb, 0x3a, 0xf6, 0x1c, 0x93, 0x21, 0xf3, 0x90, 0x7c, 0x63, 0xc0, 0x6a, 0xea, 0x48, 0xca, 0x01,
-	0x08, 0xb5, 0xb2, 0xd3, 0xa9, 0xd4, 0xb3, 0xea, 0x3b, 0xd7, 0xc6, 0xe4, 0xbb, 0xee, 0xe1, 0x17,
-	0xbf, 0xfd, 0xf1, 0x7d, 0xa5, 0x45, 0x0f, 0xd5, 0x59, 0x35, 0x3c, 0xca, 0xce, 0x3b, 0x6e, 0x8f,
-	0xf5, 0xe8, 0xd2, 0x96, 0x5e, 0xc5, 0xed, 0xb1, 0xfc, 0xb9, 0xb4, 0x95, 0xbb, 0xbc, 0x61, 0x1c,
-	0x90, 0xaf, 0x0c, 0x58, 0x4d, 0xcd, 0xf8, 0xaf, 0xc4, 0x14, 0xec, 0xba, 0xbe, 0x99, 0xc7, 0x14,
-	0xf7, 0xfe, 0x9b, 0x4a, 0xc5, 0xeb, 0x07, 0xc7, 0xff, 0x4a, 0x85, 0x3d, 0x66, 0xae, 0xb8, 0x24,
-	0xdf, 0x1a, 0x50, 0x4b, 0x73, 0x26, 0x73, 0xc9, 0x16, 0x6b, 0xb1, 0xb0, 0x2e, 0xa5, 0x2f, 0x2a,
-	0xc1, 0xf7, 0xe8, 0xc6, 0xac, 0x60, 0x59, 0x99, 0x2f, 0x0d, 0x58, 0x92, 0x2b, 0x4d, 0xee, 0xcd,
-	0xca, 0x51, 0xae, 0x56, 0x3f, 0x5b, 0x94, 0x0c, 0x49, 0x42, 0x4d, 0x25, 0x85, 0x90, 0x39, 0x29,
-	0xe4, 0x02, 0x48, 0x1b, 0xc5, 0x8c, 0x6d, 0x94, 0x89, 0x7a, 0x29, 0x9f, 0x2e, 0xf3, 0x19, 0xda,
-	0x54, 0x4c, 0x94, 0x34, 0xe6, 0x57, 0x49, 0x76, 0xec, 0xa5, 0xed, 0xeb, 0x2f, 0xc9, 0xd7, 0x06,
-	0x54, 0xdb, 0x58, 0xca, 0xb5, 0xb8, 0x75, 0xd8, 0x53, 0x92, 0xb6, 0xc8, 0xfd, 0x12, 0x49, 0x64,
-	0x0c, 0x2f, 0xb4, 0x51, 0x14, 0x5d, 0xbb, 0x4c, 0xd6, 0x5e, 0x3e, 0x7d, 0xbd, 0xcb, 0x53, 0x4b,
-	0xb1, 0x35, 0xc9, 0x7e, 0x59, 0x01, 0x52, 0x9b, 0xcc, 0x17, 0xe0, 0x27, 0x03, 0x6a, 0xe9, 0xc9,
-	0x3a, 0xdf, 0x99, 0x85, 0x13, 0x77, 0x81, 0x15, 0x39, 0x56, 0x1a, 0x0f, 0xeb, 0xcd, 0xd2, 0xad,
-	0x64, 0xf5, 0x51, 0xb8, 0xbe, 0x2b, 0x5c, 0x4b, 0x89, 0x96, 0x1d, 0xfb, 0x11, 0xd4, 0xd2, 0x8d,
-	0x5a, 0x56, 0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0x7f, 0x50, 0x5a, 0xff, 0xa7, 0x00, 0xb2, 0x4b, 0x4f,
-	0x87, 0x18, 0x96, 0x17, 0x7e, 0xc7, 0x4a, 0xef, 0xcb, 0x32, 0x43, 0x4b, 0xde, 0x97, 0xad, 0xe1,
-	0x91, 0xa5, 0x3e, 0x51, 0x1d, 0xbe, 0xaf, 0x48, 0x1a, 0x64, 0xb7, 0xac, 0xec, 0x98, 0xa2, 0x8f,
-	0xe1, 0x4e, 0x1b, 0xc5, 0xd4, 0xe5, 0xe0, 0x5c, 0xc8, 0xd2, 0x6f, 0xe5, 0xa4, 0xb3, 0xf7, 0x8b,
-	0xfa, 0xf6, 0x75, 0xaf, 0xf2, 0xe4, 0x5e, 0x51, 0xbc, 0x0f, 0xc8, 0xcb, 0x65, 0xbc, 0x7c, 0x14,
-	0x7a, 0xfa, 0x6e, 0x40, 0x62, 0x58, 0x91, 0x62, 0x95, 0xad, 0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf8,
-	0xf5, 0x7a, 0x61, 0x21, 0xf5, 0x2b, 0xcd, 0xfb, 0x40, 0xf1, 0xee, 0x91, 0x9d, 0x32, 0xde, 0x40,
-	0x86, 0x3f, 0x7a, 0xf4, 0xcb, 0xd5, 0xae, 0xf1, 0xeb, 0xd5, 0xae, 0xf1, 0xfb, 0xd5, 0xae, 0xf1,
-	0xf1, 0x6b, 0xff, 0xec, 0xef, 0x84, 0x17, 0x30, 0x0c, 0xf3, 0x7f, 0x35, 0x9d, 0x9a, 0xba, 0xf8,
-	0x1f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x28, 0x9d, 0xea, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	0x14, 0x96, 0x93, 0x36, 0xbb, 0x9d, 0x96, 0x52, 0x66, 0x77, 0xbb, 0x6e, 0xe8, 0x8f, 0x30, 0x68,
+	0xab, 0xa8, 0x50, 0x5b, 0x4d, 0x41, 0x5a, 0xc1, 0x89, 0xed, 0x56, 0x01, 0xa9, 0x07, 0x70, 0x41,
+	0x20, 0x0e, 0x8b, 0x1c, 0xfb, 0x29, 0x3b, 0x1b, 0xc7, 0x33, 0x78, 0x26, 0xd9, 0x86, 0xa8, 0x17,
+	0x24, 0x40, 0xe2, 0xc0, 0x01, 0xee, 0x1c, 0xf9, 0x3f, 0xb8, 0x71, 0x44, 0xe2, 0x1f, 0x40, 0x15,
+	0xff, 0x04, 0x37, 0x34, 0xe3, 0xb1, 0x13, 0x27, 0x35, 0x3f, 0xb4, 0x61, 0x4f, 0x1e, 0x8f, 0x9f,
+	0xbf, 0xef, 0x7b, 0x6f, 0xde, 0x7c, 0x63, 0xa3, 0x6d, 0x01, 0xc9, 0x10, 0x12, 0x97, 0x27, 0xec,
+	0x09, 0x04, 0x32, 0xbb, 0x3a, 0x3c, 0x61, 0x92, 0xe1, 0x1b, 0xe6, 0xb6, 0xbe, 0xdd, 0x65, 0xac,
+	0x1b, 0x81, 0xeb, 0x73, 0xea, 0xfa, 0x71, 0xcc, 0xa4, 0x2f, 0x29, 0x8b, 0x45, 0x1a, 0x56, 0x27,
+	0xbd, 0xfb, 0xc2, 0xa1, 0x4c, 0x3f, 0x0d, 0x58, 0x02, 0xee, 0xf0, 0xc8, 0xed, 0x42, 0x0c, 0x89,
+	0x2f, 0x21, 0x34, 0x31, 0x67, 0x5d, 0x2a, 0x1f, 0x0f, 0x3a, 0x4e, 0xc0, 0xfa, 0xae, 0x9f, 0x74,
+	0x99, 0x42, 0xd6, 0x83, 0xc3, 0x20, 0x74, 0x87, 0x2d, 0x97, 0xf7, 0xba, 0xea, 0x7d, 0xe1, 0xfa,
+	0x9c, 0x47, 0x34, 0xd0, 0xf8, 0xee, 0xf0, 0xc8, 0x8f, 0xf8, 0x63, 0x7f, 0x1e, 0xed, 0xe4, 0x1f,
+	0xd0, 0x4c, 0x56, 0xd3, 0x58, 0x53, 0xe3, 0x14, 0x84, 0x7c, 0x6f, 0xa1, 0xdb, 0xef, 0xa7, 0x09,
+	0x9e, 0x24, 0xe0, 0x4b, 0xf0, 0xe0, 0xf3, 0x01, 0x08, 0x89, 0x3b, 0x28, 0x4b, 0xdc, 0xb6, 0x1a,
+	0x56, 0x73, 0xb5, 0xf5, 0xae, 0x33, 0xe1, 0x73, 0x32, 0x3e, 0x3d, 0xf8, 0x2c, 0x08, 0x9d, 0x61,
+	0xcb, 0xe1, 0xbd, 0xae, 0xa3, 0xd4, 0x3b, 0xd3, 0x2c, 0x99, 0x7a, 0xe7, 0x1d, 0xce, 0x0d, 0x8f,
+	0x97, 0x01, 0xe3, 0x4d, 0x54, 0x1b, 0x70, 0x01, 0x89, 0xb4, 0x2b, 0x0d, 0xab, 0x79, 0xd3, 0x33,
+	0x77, 0xa4, 0x87, 0xb6, 0x4c, 0xec, 0x87, 0xac, 0x07, 0xf1, 0x43, 0x88, 0x60, 0x22, 0xcc, 0x2e,
+	0x0a, 0x5b, 0x99, 0xc0, 0x61, 0xb4, 0x94, 0xb0, 0x08, 0x34, 0xd8, 0x8a, 0xa7, 0xc7, 0x78, 0x03,
+	0x55, 0xa9, 0x2f, 0xed, 0x6a, 0xc3, 0x6a, 0x56, 0x3d, 0x35, 0xc4, 0xeb, 0xa8, 0x42, 0x43, 0x7b,
+	0x49, 0xc7, 0x54, 0x68, 0x48, 0xfe, 0xb4, 0x8a, 0x6c, 0xc5, 0x32, 0x94, 0xb3, 0x35, 0xd0, 0x6a,
+	0x08, 0x22, 0x48, 0x28, 0x57, 0x89, 0x1a, 0xd2, 0xe9, 0xa9, 0x5c, 0x4f, 0x75, 0x4a, 0xcf, 0x36,
+	0x5a, 0x81, 0x0b, 0x4e, 0x13, 0x10, 0xef, 0xc5, 0x5a, 0x44, 0xd5, 0x9b, 0x4c, 0x18, 0x6d, 0xcb,
+	0x99, 0x36, 0xfc, 0x08, 0x2d, 0x8b, 0x80, 0x71, 0xb0, 0x6b, 0x8b, 0x58, 0x02, 0x9d, 0xde, 0xb9,
+	0xc2, 0xf3, 0x52, 0x58, 0xf2, 0x7a, 0xbe, 0xf8, 0xfa, 0x99, 0x07, 0x82, 0xb3, 0x58, 0x00, 0xbe,
+	0x8d, 0x96, 0xa5, 0x9a, 0x30, 0x39, 0xa7, 0x37, 0x84, 0xa0, 0x35, 0x13, 0xfd, 0xc1, 0x00, 0x92,
+	0x91, 0xca, 0x2f, 0xf6, 0xfb, 0x60, 0x82, 0xf4, 0x98, 0x7c, 0x91, 0x23, 0x7e, 0xc4, 0xc3, 0xe7,
+	0xdb, 0x4e, 0xe4, 0x45, 0xf4, 0xc2, 0x69, 0x9f, 0xcb, 0x51, 0x96, 0x06, 0xd9, 0x47, 0x1b, 0xe7,
+	0xa3, 0x38, 0xf8, 0x98, 0xc6, 0x21, 0x7b, 0x2a, 0xca, 0x45, 0x8f, 0xd0, 0xad, 0xa9, 0xb8, 0xbc,
+	0x0a, 0x1d, 0x74, 0xe3, 0x69, 0x3a, 0x65, 0x5b, 0x8d, 0xea, 0xb3, 0x6b, 0x9e, 0x70, 0x78, 0x19,
+	0x30, 0xb9, 0x40, 0x9b, 0xed, 0x88, 0x75, 0xfc, 0xc8, 0x64, 0x33, 0x61, 0x7f, 0x84, 0x96, 0xa9,
+	0x84, 0xfe, 0x82, 0xb8, 0xa7, 0xea, 0x95, 0xc2, 0x92, 0x9f, 0xab, 0xc8, 0x7e, 0x08, 0xd2, 0xa7,
+	0x11, 0x84, 0x73, 0xe4, 0x1c, 0xad, 0x77, 0x0b, 0xb2, 0x16, 0xae, 0x62, 0x06, 0x7f, 0xba, 0x41,
+	0x2a, 0xff, 0x97, 0xdf, 0x44, 0x68, 0x2d, 0x01, 0xce, 0x04, 0x95, 0x2c, 0xa1, 0x20, 0xec, 0xea,
+	0x22, 0x72, 0xf2, 0x32, 0xc4, 0x91, 0x57, 0x40, 0xc7, 0x3e, 0xba, 0x19, 0x44, 0x03, 0x21, 0x21,
+	0x11, 0xf6, 0x92, 0x66, 0x3a, 0x7d, 0x36, 0xa6, 0x93, 0x14, 0xcd, 0xcb, 0x61, 0xc9, 0x21, 0xba,
+	0x7b, 0x46, 0x85, 0x34, 0x89, 0x9e, 0xd1, 0xb8, 0x27, 0xb2, 0x0d, 0x77, 0x4d, 0x9f, 0xb7, 0x7e,
+	0x5c, 0x43, 0xeb, 0x26, 0xf6, 0x1c, 0x92, 0x21, 0x0d, 0x00, 0x7f, 0x6b, 0xa1, 0xd5, 0xd4, 0xf1,
+	0xb4, 0x03, 0x60, 0xe2, 0x64, 0xa7, 0x5f, 0xa9, 0x27, 0xd6, 0x77, 0xae, 0x8d, 0xc9, 0x77, 0xdd,
+	0xfd, 0x2f, 0x7f, 0xfb, 0xe3, 0x87, 0x4a, 0x8b, 0x1c, 0xea, 0xb3, 0x70, 0x78, 0x94, 0x9d, 0xa7,
+	0xc2, 0x1d, 0x9b, 0xd1, 0xa5, 0xab, 0xbc, 0x50, 0xb8, 0x63, 0x75, 0xb9, 0x74, 0xb5, 0xbb, 0xbc,
+	0x65, 0x1d, 0xe0, 0xaf, 0x2d, 0xb4, 0x9a, 0x9a, 0xfd, 0xdf, 0x89, 0x29, 0x1c, 0x07, 0xf5, 0xcd,
+	0x3c, 0xa6, 0xb8, 0xf7, 0xdf, 0xd6, 0x2a, 0xde, 0x3c, 0x38, 0xfe, 0x4f, 0x2a, 0xdc, 0x31, 0xf5,
+	0xe5, 0x25, 0xfe, 0xce, 0x42, 0xb5, 0x34, 0x67, 0x3c, 0x97, 0x6c, 0xb1, 0x16, 0x0b, 0xeb, 0x52,
+	0xf2, 0xb2, 0x16, 0x7c, 0x87, 0x6c, 0xcc, 0x0a, 0x56, 0x95, 0xf9, 0xca, 0x42, 0x4b, 0x6a, 0xa5,
+	0xf1, 0x9d, 0x59, 0x39, 0xda, 0xd5, 0xea, 0x67, 0x8b, 0x92, 0xa1, 0x48, 0x88, 0xad, 0xa5, 0x60,
+	0x3c, 0x27, 0x05, 0x5f, 0x20, 0xdc, 0x06, 0x39, 0x63, 0x1b, 0x65, 0xa2, 0x5e, 0xc9, 0xa7, 0xcb,
+	0x7c, 0x86, 0x34, 0x35, 0x13, 0xc1, 0x8d, 0xf9, 0x55, 0x52, 0x1d, 0x7b, 0xe9, 0x86, 0xe6, 0x4d,
+	0xfc, 0x8d, 0x85, 0xaa, 0x6d, 0x28, 0xe5, 0x5a, 0xdc, 0x3a, 0xec, 0x69, 0x49, 0x5b, 0xf8, 0x6e,
+	0x89, 0x24, 0x3c, 0x46, 0x2f, 0xb5, 0x41, 0x16, 0x5d, 0xbb, 0x4c, 0xd6, 0x5e, 0x3e, 0x7d, 0xbd,
+	0xcb, 0x13, 0x47, 0xb3, 0x35, 0xf1, 0x7e, 0x59, 0x01, 0x52, 0x9b, 0xcc, 0x17, 0xe0, 0x27, 0x0b,
+	0xd5, 0xd2, 0x93, 0x75, 0xbe, 0x33, 0x0b, 0x27, 0xee, 0x02, 0x2b, 0x72, 0xac, 0x35, 0x1e, 0xd6,
+	0x9b, 0xa5, 0x5b, 0xc9, 0xe9, 0x83, 0xf4, 0x43, 0x5f, 0xfa, 0x8e, 0x16, 0xad, 0x3a, 0xf6, 0x13,
+	0x54, 0x4b, 0x37, 0x6a, 0x59, 0x69, 0xca, 0x36, 0xae, 0xa9, 0xff, 0x41, 0x69, 0xfd, 0x9f, 0x20,
+	0xa4, 0xba, 0xf4, 0x74, 0x08, 0x71, 0x79, 0xe1, 0x77, 0x9c, 0xf4, 0x7b, 0x5c, 0x65, 0xe8, 0x04,
+	0x2c, 0x01, 0x67, 0x78, 0xe4, 0xe8, 0x57, 0x74, 0x87, 0xef, 0x6b, 0x92, 0x06, 0xde, 0x2d, 0x2b,
+	0x3b, 0xa4, 0xe8, 0x63, 0x74, 0xab, 0x0d, 0x72, 0xea, 0xe3, 0xe0, 0x5c, 0xaa, 0xd2, 0x6f, 0xe5,
+	0xa4, 0xb3, 0xdf, 0x17, 0xf5, 0xed, 0xeb, 0x1e, 0xe5, 0xc9, 0xbd, 0xa6, 0x79, 0xef, 0xe1, 0x57,
+	0xcb, 0x78, 0xc5, 0x28, 0x0e, 0xcc, 0xb7, 0x01, 0xe6, 0x68, 0x45, 0x89, 0xd5, 0xb6, 0x8e, 0x1b,
+	0x39, 0x6e, 0x89, 0xe3, 0xd7, 0xeb, 0x85, 0x85, 0x34, 0x8f, 0x0c, 0xef, 0x3d, 0xcd, 0xbb, 0x87,
+	0x77, 0xca, 0x78, 0x23, 0x15, 0xfe, 0xe0, 0xc1, 0x2f, 0x57, 0xbb, 0xd6, 0xaf, 0x57, 0xbb, 0xd6,
+	0xef, 0x57, 0xbb, 0xd6, 0xa7, 0x6f, 0xfc, 0xbb, 0xdf, 0x95, 0x20, 0xa2, 0x10, 0xe7, 0x7f, 0x4d,
+	0x9d, 0x9a, 0xfe, 0xb1, 0x38, 0xfe, 0x6b, 0x00, 0xeb, 0x68, 0xca, 0x10, 0x56, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1394,6 +1404,18 @@ func (m *ProjectTokenCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1877,6 +1899,10 @@ func (m *ProjectTokenCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2500,6 +2526,42 @@ func (m *ProjectTokenCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &v1alpha1.TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
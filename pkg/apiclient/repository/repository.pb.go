@@ -395,7 +395,15 @@ type RepoAccessQuery struct {
 	// Google Cloud Platform service account key
 	GcpServiceAccountKey string `protobuf:"bytes,18,opt,name=gcpServiceAccountKey,proto3" json:"gcpServiceAccountKey,omitempty"`
 	// Whether to force HTTP basic auth
-	ForceHttpBasicAuth   bool     `protobuf:"varint,19,opt,name=forceHttpBasicAuth,proto3" json:"forceHttpBasicAuth,omitempty"`
+	ForceHttpBasicAuth bool `protobuf:"varint,19,opt,name=forceHttpBasicAuth,proto3" json:"forceHttpBasicAuth,omitempty"`
+	// URL of the token endpoint at which the service account token is exchanged for access tokens
+	TokenProviderURL string `protobuf:"bytes,20,opt,name=tokenProviderURL,proto3" json:"tokenProviderURL,omitempty"`
+	// Client ID used to request access tokens from the token provider
+	TokenProviderClientID string `protobuf:"bytes,21,opt,name=tokenProviderClientID,proto3" json:"tokenProviderClientID,omitempty"`
+	// Audience of the access tokens requested from the token provider
+	TokenProviderAudience string `protobuf:"bytes,22,opt,name=tokenProviderAudience,proto3" json:"tokenProviderAudience,omitempty"`
+	// Scope of the access tokens requested from the token provider
+	TokenProviderScope   string   `protobuf:"bytes,23,opt,name=tokenProviderScope,proto3" json:"tokenProviderScope,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -560,6 +568,34 @@ func (m *RepoAccessQuery) GetForceHttpBasicAuth() bool {
 	return false
 }
 
+func (m *RepoAccessQuery) GetTokenProviderURL() string {
+	if m != nil {
+		return m.TokenProviderURL
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetTokenProviderClientID() string {
+	if m != nil {
+		return m.TokenProviderClientID
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetTokenProviderAudience() string {
+	if m != nil {
+		return m.TokenProviderAudience
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetTokenProviderScope() string {
+	if m != nil {
+		return m.TokenProviderScope
+	}
+	return ""
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -730,81 +766,85 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1178 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xd6, 0x26, 0x8d, 0x93, 0x9c, 0x7c, 0xd4, 0x99, 0xe4, 0xed, 0xbb, 0xb8, 0x6e, 0x1a, 0x6d,
-	0x4b, 0x15, 0xa2, 0xb2, 0x6e, 0x8c, 0x10, 0xa8, 0x08, 0x24, 0xe7, 0x43, 0x4d, 0x44, 0x44, 0xca,
-	0x56, 0xe1, 0x02, 0x81, 0xd0, 0x64, 0x7d, 0x62, 0x6f, 0xbb, 0xde, 0x9d, 0xce, 0x8c, 0x4d, 0xad,
-	0xaa, 0x37, 0x5c, 0x21, 0xc1, 0x0d, 0x42, 0x48, 0xdc, 0x21, 0x24, 0x24, 0x2e, 0xf8, 0x23, 0x5c,
-	0x22, 0xf1, 0x07, 0x50, 0xc4, 0x8f, 0xe0, 0x0a, 0xa1, 0x99, 0x59, 0xef, 0xae, 0x13, 0xdb, 0x49,
-	0x45, 0xc8, 0xdd, 0xcc, 0x73, 0xce, 0x9e, 0xf3, 0xcc, 0xb3, 0xe7, 0x9c, 0x9d, 0x05, 0x47, 0x20,
-	0xef, 0x20, 0xaf, 0x70, 0x64, 0xb1, 0x08, 0x64, 0xcc, 0xbb, 0xb9, 0xa5, 0xcb, 0x78, 0x2c, 0x63,
-	0x02, 0x19, 0x52, 0x2a, 0x37, 0xe2, 0xb8, 0x11, 0x62, 0x85, 0xb2, 0xa0, 0x42, 0xa3, 0x28, 0x96,
-	0x54, 0x06, 0x71, 0x24, 0x8c, 0x67, 0x69, 0xaf, 0x11, 0xc8, 0x66, 0xfb, 0xd0, 0xf5, 0xe3, 0x56,
-	0x85, 0xf2, 0x46, 0xcc, 0x78, 0xfc, 0x58, 0x2f, 0x5e, 0xf7, 0xeb, 0x95, 0x4e, 0xb5, 0xc2, 0x9e,
-	0x34, 0xd4, 0x93, 0xa2, 0x42, 0x19, 0x0b, 0x03, 0x5f, 0x3f, 0x5b, 0xe9, 0xac, 0xd3, 0x90, 0x35,
-	0xe9, 0x7a, 0xa5, 0x81, 0x11, 0x72, 0x2a, 0xb1, 0x9e, 0x44, 0xdb, 0x3e, 0x23, 0x9a, 0xa6, 0x75,
-	0x26, 0x7d, 0xa7, 0x0b, 0x73, 0x1e, 0xb2, 0xb8, 0xc6, 0x98, 0xf8, 0xb0, 0x8d, 0xbc, 0x4b, 0x08,
-	0x5c, 0x51, 0x4e, 0xb6, 0xb5, 0x62, 0xad, 0x4e, 0x7b, 0x7a, 0x4d, 0x4a, 0x30, 0xc5, 0xb1, 0x13,
-	0x88, 0x20, 0x8e, 0xec, 0x31, 0x8d, 0xa7, 0x7b, 0x62, 0xc3, 0x24, 0x65, 0xec, 0x03, 0xda, 0x42,
-	0x7b, 0x5c, 0x9b, 0x7a, 0x5b, 0xb2, 0x0c, 0x40, 0x19, 0x7b, 0xc8, 0xe3, 0xc7, 0xe8, 0x4b, 0xfb,
-	0x8a, 0x36, 0xe6, 0x10, 0x67, 0x1d, 0x26, 0x6b, 0x8c, 0xed, 0x46, 0x47, 0xb1, 0x4a, 0x2a, 0xbb,
-	0x0c, 0x7b, 0x49, 0xd5, 0x5a, 0x61, 0x8c, 0xca, 0x66, 0x92, 0x50, 0xaf, 0x9d, 0xbf, 0x2c, 0x58,
-	0x4c, 0xe8, 0x6e, 0xa1, 0xa4, 0x41, 0x98, 0x90, 0x6e, 0x40, 0x41, 0xc4, 0x6d, 0xee, 0x9b, 0x08,
-	0x33, 0xd5, 0x7d, 0x37, 0x53, 0xc7, 0xed, 0xa9, 0xa3, 0x17, 0x9f, 0xf9, 0x75, 0xb7, 0x53, 0x75,
-	0xd9, 0x93, 0x86, 0xab, 0xb4, 0x76, 0x73, 0x5a, 0xbb, 0x3d, 0xad, 0xdd, 0x5a, 0x06, 0x3e, 0xd2,
-	0x61, 0xbd, 0x24, 0x7c, 0xfe, 0xb4, 0x63, 0xa3, 0x4e, 0x3b, 0x7e, 0xf2, 0xb4, 0x64, 0x05, 0x66,
-	0x4c, 0x8c, 0xdd, 0xa8, 0x8e, 0xcf, 0xb4, 0x1c, 0x13, 0x5e, 0x1e, 0x22, 0x65, 0x98, 0xee, 0x20,
-	0x57, 0xa2, 0xee, 0xd6, 0xed, 0x09, 0x6d, 0xcf, 0x00, 0xe7, 0x5d, 0x28, 0xf6, 0x5e, 0x94, 0x87,
-	0x82, 0xc5, 0x91, 0x40, 0xf2, 0x1a, 0x4c, 0x04, 0x12, 0x5b, 0xc2, 0xb6, 0x56, 0xc6, 0x57, 0x67,
-	0xaa, 0x8b, 0x6e, 0xee, 0xf5, 0x26, 0xd2, 0x7a, 0xc6, 0xc3, 0xf1, 0x61, 0x5a, 0x3d, 0x3e, 0xfc,
-	0x1d, 0x3b, 0x30, 0x7b, 0x14, 0xab, 0xa3, 0xe2, 0x11, 0x47, 0x61, 0x64, 0x9f, 0xf2, 0xfa, 0xb0,
-	0xb3, 0xce, 0xe8, 0xfc, 0x38, 0x01, 0x57, 0x35, 0x49, 0xdf, 0x47, 0x31, 0xba, 0x9e, 0xda, 0x02,
-	0x79, 0x94, 0xc9, 0x98, 0xee, 0x95, 0x8d, 0x51, 0x21, 0x3e, 0x8f, 0x79, 0x3d, 0xc9, 0x90, 0xee,
-	0xc9, 0x6d, 0x98, 0x13, 0xa2, 0xf9, 0x90, 0x07, 0x1d, 0x2a, 0xf1, 0x7d, 0xec, 0x26, 0x45, 0xd5,
-	0x0f, 0xaa, 0x08, 0x41, 0x24, 0xd0, 0x6f, 0x73, 0xd4, 0x32, 0x4e, 0x79, 0xe9, 0x9e, 0xdc, 0x85,
-	0x05, 0x19, 0x8a, 0xcd, 0x30, 0xc0, 0x48, 0x6e, 0x22, 0x97, 0x5b, 0x54, 0x52, 0xbb, 0xa0, 0xa3,
-	0x9c, 0x36, 0x90, 0x35, 0x28, 0xf6, 0x81, 0x2a, 0xe5, 0xa4, 0x76, 0x3e, 0x85, 0xa7, 0x25, 0x3c,
-	0xdd, 0x5f, 0xc2, 0xfa, 0x8c, 0x60, 0x30, 0x7d, 0xbe, 0x32, 0x4c, 0x63, 0x44, 0x0f, 0x43, 0xdc,
-	0xf7, 0x03, 0x7b, 0x46, 0xd3, 0xcb, 0x00, 0x72, 0x0f, 0x16, 0x4d, 0xe5, 0xd6, 0x94, 0xaa, 0xe9,
-	0x39, 0x67, 0x75, 0x80, 0x41, 0x26, 0x55, 0x57, 0x29, 0xbc, 0xbb, 0x65, 0xcf, 0xad, 0x58, 0xab,
-	0xe3, 0x5e, 0x1e, 0x22, 0x6f, 0xc3, 0xff, 0xb3, 0x6d, 0x24, 0x24, 0x0d, 0x43, 0x5d, 0xda, 0xbb,
-	0x5b, 0xf6, 0xbc, 0xf6, 0x1e, 0x66, 0x26, 0xef, 0x41, 0x29, 0x35, 0x6d, 0x47, 0x12, 0x39, 0xe3,
-	0x81, 0xc0, 0x0d, 0x2a, 0xf0, 0x80, 0x87, 0xf6, 0x55, 0x4d, 0x6a, 0x84, 0x07, 0x59, 0x82, 0x09,
-	0xc6, 0xe3, 0x67, 0x5d, 0xbb, 0xa8, 0x5d, 0xcd, 0x46, 0xf5, 0x10, 0x4b, 0x4a, 0x68, 0xc1, 0xf4,
-	0x50, 0xb2, 0x25, 0x55, 0x58, 0x6a, 0xf8, 0xec, 0x11, 0xf2, 0x4e, 0xe0, 0x63, 0xcd, 0xf7, 0xe3,
-	0x76, 0xa4, 0x35, 0x27, 0xda, 0x6d, 0xa0, 0x8d, 0xb8, 0x40, 0x74, 0x8d, 0xee, 0x48, 0xc9, 0x36,
-	0xa8, 0x08, 0xfc, 0x5a, 0x5b, 0x36, 0xed, 0x45, 0x2d, 0xec, 0x00, 0x8b, 0x33, 0x0f, 0xb3, 0xaa,
-	0x44, 0x7b, 0x3d, 0xe4, 0xfc, 0x6c, 0xc1, 0x82, 0x02, 0x36, 0x39, 0x52, 0x89, 0x1e, 0x3e, 0x6d,
-	0xa3, 0x90, 0xe4, 0x93, 0x5c, 0xd5, 0xce, 0x54, 0x77, 0xfe, 0xdd, 0x38, 0xf1, 0xd2, 0xae, 0x4c,
-	0xea, 0xff, 0x1a, 0x14, 0xda, 0x4c, 0x20, 0x97, 0x49, 0x97, 0x25, 0x3b, 0x55, 0x1b, 0x3e, 0xc7,
-	0xba, 0xd8, 0x8f, 0xc2, 0xae, 0x2e, 0xfe, 0x29, 0x2f, 0x03, 0x9c, 0xa7, 0x86, 0xe8, 0x01, 0xab,
-	0x5f, 0x16, 0xd1, 0xea, 0xdf, 0xf3, 0x26, 0xa7, 0x01, 0x13, 0xf1, 0xc9, 0xd7, 0x16, 0x5c, 0xd9,
-	0x0b, 0x84, 0x24, 0xff, 0xcb, 0x0f, 0x9c, 0x74, 0xbc, 0x94, 0xf6, 0x2e, 0x8a, 0x85, 0x4a, 0xe2,
-	0xdc, 0xfc, 0xe2, 0xf7, 0x3f, 0xbf, 0x1d, 0xbb, 0x46, 0x96, 0xf4, 0x67, 0xb5, 0xb3, 0x9e, 0x7d,
-	0xc3, 0x02, 0x14, 0x5f, 0x8e, 0x59, 0xe4, 0x2b, 0x0b, 0xc6, 0x1f, 0xe0, 0x50, 0x36, 0x17, 0xa6,
-	0x89, 0x73, 0x4b, 0x33, 0xb9, 0x41, 0xae, 0x0f, 0x62, 0x52, 0x79, 0xae, 0x76, 0x2f, 0xc8, 0x77,
-	0x16, 0x14, 0x15, 0x6f, 0x2f, 0x67, 0xbb, 0x1c, 0xa1, 0xca, 0xa3, 0x84, 0x22, 0x9f, 0xc2, 0x94,
-	0xa1, 0x75, 0x34, 0x94, 0x4e, 0xb1, 0x1f, 0x3e, 0x12, 0xce, 0xaa, 0x0e, 0xe9, 0x90, 0x95, 0x11,
-	0x27, 0xae, 0x70, 0x15, 0xb2, 0x65, 0xc2, 0xab, 0xcf, 0x13, 0x79, 0xe5, 0x64, 0xf8, 0xf4, 0x76,
-	0x51, 0x2a, 0x0f, 0x32, 0xa5, 0xbd, 0x78, 0xae, 0x74, 0x54, 0xa5, 0xf8, 0xc6, 0x82, 0xb9, 0x07,
-	0x28, 0xb3, 0x7b, 0x00, 0xb9, 0x39, 0x20, 0x72, 0xfe, 0x8e, 0x50, 0x72, 0x86, 0x3b, 0xa4, 0x04,
-	0xde, 0xd1, 0x04, 0xde, 0x74, 0xee, 0x0d, 0x26, 0x60, 0xbe, 0xd6, 0x3a, 0xce, 0x81, 0xb7, 0xa7,
-	0xa9, 0xd4, 0x4d, 0x84, 0xfb, 0xd6, 0x1a, 0xe9, 0x68, 0x4a, 0x3b, 0x18, 0xb6, 0x36, 0x9b, 0x94,
-	0xcb, 0xa1, 0x32, 0x2f, 0xe7, 0xe1, 0xcc, 0x3d, 0x25, 0xe1, 0x6a, 0x12, 0xab, 0xe4, 0xce, 0x28,
-	0x15, 0x9a, 0x18, 0xb6, 0x7c, 0x93, 0xe6, 0x7b, 0x0b, 0x0a, 0x66, 0x7a, 0x91, 0x1b, 0x27, 0x33,
-	0xf6, 0x4d, 0xb5, 0x0b, 0x6c, 0x85, 0x57, 0x35, 0xc7, 0xb2, 0x33, 0xb0, 0xd6, 0xee, 0xeb, 0xe1,
-	0xa1, 0x5a, 0xf3, 0x07, 0x0b, 0x8a, 0x3d, 0x0a, 0xbd, 0x67, 0x2f, 0x8f, 0xa4, 0x73, 0x36, 0x49,
-	0xf2, 0x93, 0x05, 0x05, 0x33, 0x51, 0x4f, 0xf3, 0xea, 0x9b, 0xb4, 0x17, 0xc8, 0x6b, 0xdd, 0xbc,
-	0xe0, 0xd2, 0x88, 0x32, 0xd7, 0x54, 0x5e, 0x64, 0x42, 0xfe, 0x62, 0x41, 0xb1, 0x47, 0x67, 0xb8,
-	0x90, 0xff, 0x15, 0x61, 0xf7, 0xe5, 0x08, 0x13, 0x0a, 0x85, 0x2d, 0x0c, 0x51, 0xe2, 0xb0, 0x16,
-	0xb0, 0x4f, 0xc2, 0x69, 0xf1, 0xdf, 0x31, 0x33, 0x76, 0x6d, 0xd4, 0x8c, 0x55, 0x82, 0x34, 0xa1,
-	0x68, 0x52, 0xe4, 0xf4, 0x78, 0xe9, 0x64, 0xb7, 0xce, 0x91, 0x8c, 0x3c, 0x87, 0xf9, 0x8f, 0x68,
-	0x18, 0x28, 0x65, 0xcd, 0xbd, 0x96, 0x5c, 0x3f, 0x35, 0x49, 0xb2, 0xfb, 0xee, 0x88, 0x6c, 0x55,
-	0x9d, 0xed, 0xae, 0x73, 0x7b, 0x54, 0x5f, 0x77, 0x92, 0x54, 0x46, 0xc9, 0x8d, 0xed, 0x5f, 0x8f,
-	0x97, 0xad, 0xdf, 0x8e, 0x97, 0xad, 0x3f, 0x8e, 0x97, 0xad, 0x8f, 0xdf, 0x3a, 0xdf, 0x1f, 0xa4,
-	0xaf, 0x2f, 0xa6, 0xb9, 0x7f, 0xbd, 0xc3, 0x82, 0xfe, 0xd9, 0x7b, 0xe3, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x56, 0xc6, 0x8e, 0x59, 0xd1, 0x0e, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0xe6, 0x8f, 0x93, 0x4c, 0xfe, 0xd4, 0x99, 0xfc, 0xe9, 0xe2, 0xba, 0x69, 0xb4, 0x2d,
+	0x55, 0x88, 0xca, 0xba, 0x31, 0x20, 0x50, 0x11, 0x48, 0x4e, 0x1c, 0x35, 0x16, 0x11, 0x09, 0x1b,
+	0x85, 0x03, 0x02, 0xa1, 0xc9, 0xfa, 0xc5, 0xde, 0x66, 0xbd, 0x33, 0x9d, 0x19, 0x2f, 0xb5, 0xaa,
+	0x5e, 0x38, 0x21, 0xc1, 0x05, 0x21, 0x24, 0x6e, 0x5c, 0x90, 0x38, 0xf0, 0x45, 0x38, 0x22, 0xf1,
+	0x05, 0x50, 0xc4, 0x85, 0x6f, 0xc0, 0x09, 0xa1, 0x99, 0x5d, 0xef, 0xae, 0x13, 0xdb, 0x49, 0x45,
+	0xc8, 0x6d, 0xe7, 0xf7, 0x66, 0xde, 0xfb, 0xbd, 0xdf, 0xbc, 0xf7, 0x3c, 0x46, 0x96, 0x00, 0x1e,
+	0x02, 0x2f, 0x71, 0x60, 0x54, 0x78, 0x92, 0xf2, 0x4e, 0xe6, 0xd3, 0x66, 0x9c, 0x4a, 0x8a, 0x51,
+	0x8a, 0x14, 0x8a, 0x0d, 0x4a, 0x1b, 0x3e, 0x94, 0x08, 0xf3, 0x4a, 0x24, 0x08, 0xa8, 0x24, 0xd2,
+	0xa3, 0x81, 0x88, 0x76, 0x16, 0x76, 0x1b, 0x9e, 0x6c, 0xb6, 0x8f, 0x6c, 0x97, 0xb6, 0x4a, 0x84,
+	0x37, 0x28, 0xe3, 0xf4, 0x89, 0xfe, 0x78, 0xdd, 0xad, 0x97, 0xc2, 0x72, 0x89, 0x9d, 0x34, 0xd4,
+	0x49, 0x51, 0x22, 0x8c, 0xf9, 0x9e, 0xab, 0xcf, 0x96, 0xc2, 0x0d, 0xe2, 0xb3, 0x26, 0xd9, 0x28,
+	0x35, 0x20, 0x00, 0x4e, 0x24, 0xd4, 0x63, 0x6f, 0xdb, 0x17, 0x78, 0xd3, 0xb4, 0x2e, 0xa4, 0x6f,
+	0x75, 0xd0, 0xac, 0x03, 0x8c, 0x56, 0x18, 0x13, 0x1f, 0xb5, 0x81, 0x77, 0x30, 0x46, 0x63, 0x6a,
+	0x93, 0x69, 0xac, 0x1a, 0x6b, 0x53, 0x8e, 0xfe, 0xc6, 0x05, 0x34, 0xc9, 0x21, 0xf4, 0x84, 0x47,
+	0x03, 0x73, 0x44, 0xe3, 0xc9, 0x1a, 0x9b, 0x68, 0x82, 0x30, 0xf6, 0x21, 0x69, 0x81, 0x39, 0xaa,
+	0x4d, 0xdd, 0x25, 0x5e, 0x41, 0x88, 0x30, 0xb6, 0xcf, 0xe9, 0x13, 0x70, 0xa5, 0x39, 0xa6, 0x8d,
+	0x19, 0xc4, 0xda, 0x40, 0x13, 0x15, 0xc6, 0x6a, 0xc1, 0x31, 0x55, 0x41, 0x65, 0x87, 0x41, 0x37,
+	0xa8, 0xfa, 0x56, 0x18, 0x23, 0xb2, 0x19, 0x07, 0xd4, 0xdf, 0xd6, 0xdf, 0x06, 0x5a, 0x88, 0xe9,
+	0x56, 0x41, 0x12, 0xcf, 0x8f, 0x49, 0x37, 0x50, 0x4e, 0xd0, 0x36, 0x77, 0x23, 0x0f, 0xd3, 0xe5,
+	0x3d, 0x3b, 0x55, 0xc7, 0xee, 0xaa, 0xa3, 0x3f, 0x3e, 0x77, 0xeb, 0x76, 0x58, 0xb6, 0xd9, 0x49,
+	0xc3, 0x56, 0x5a, 0xdb, 0x19, 0xad, 0xed, 0xae, 0xd6, 0x76, 0x25, 0x05, 0x0f, 0xb4, 0x5b, 0x27,
+	0x76, 0x9f, 0xcd, 0x76, 0x64, 0x58, 0xb6, 0xa3, 0x67, 0xb3, 0xc5, 0xab, 0x68, 0x3a, 0xf2, 0x51,
+	0x0b, 0xea, 0xf0, 0x4c, 0xcb, 0x31, 0xee, 0x64, 0x21, 0x5c, 0x44, 0x53, 0x21, 0x70, 0x25, 0x6a,
+	0xad, 0x6e, 0x8e, 0x6b, 0x7b, 0x0a, 0x58, 0xef, 0xa1, 0x7c, 0xf7, 0xa2, 0x1c, 0x10, 0x8c, 0x06,
+	0x02, 0xf0, 0x6b, 0x68, 0xdc, 0x93, 0xd0, 0x12, 0xa6, 0xb1, 0x3a, 0xba, 0x36, 0x5d, 0x5e, 0xb0,
+	0x33, 0xd7, 0x1b, 0x4b, 0xeb, 0x44, 0x3b, 0x2c, 0x17, 0x4d, 0xa9, 0xe3, 0x83, 0xef, 0xd8, 0x42,
+	0x33, 0xc7, 0x54, 0xa5, 0x0a, 0xc7, 0x1c, 0x44, 0x24, 0xfb, 0xa4, 0xd3, 0x83, 0x5d, 0x94, 0xa3,
+	0xf5, 0x57, 0x0e, 0xdd, 0xd0, 0x24, 0x5d, 0x17, 0xc4, 0xf0, 0x7a, 0x6a, 0x0b, 0xe0, 0x41, 0x2a,
+	0x63, 0xb2, 0x56, 0x36, 0x46, 0x84, 0xf8, 0x82, 0xf2, 0x7a, 0x1c, 0x21, 0x59, 0xe3, 0x7b, 0x68,
+	0x56, 0x88, 0xe6, 0x3e, 0xf7, 0x42, 0x22, 0xe1, 0x03, 0xe8, 0xc4, 0x45, 0xd5, 0x0b, 0x2a, 0x0f,
+	0x5e, 0x20, 0xc0, 0x6d, 0x73, 0xd0, 0x32, 0x4e, 0x3a, 0xc9, 0x1a, 0x3f, 0x40, 0xf3, 0xd2, 0x17,
+	0x5b, 0xbe, 0x07, 0x81, 0xdc, 0x02, 0x2e, 0xab, 0x44, 0x12, 0x33, 0xa7, 0xbd, 0x9c, 0x37, 0xe0,
+	0x75, 0x94, 0xef, 0x01, 0x55, 0xc8, 0x09, 0xbd, 0xf9, 0x1c, 0x9e, 0x94, 0xf0, 0x54, 0x6f, 0x09,
+	0xeb, 0x1c, 0x51, 0x84, 0xe9, 0xfc, 0x8a, 0x68, 0x0a, 0x02, 0x72, 0xe4, 0xc3, 0x9e, 0xeb, 0x99,
+	0xd3, 0x9a, 0x5e, 0x0a, 0xe0, 0x87, 0x68, 0x21, 0xaa, 0xdc, 0x0a, 0x63, 0x69, 0x4a, 0xe6, 0x8c,
+	0x76, 0xd0, 0xcf, 0xa4, 0xea, 0x2a, 0x81, 0x6b, 0x55, 0x73, 0x76, 0xd5, 0x58, 0x1b, 0x75, 0xb2,
+	0x10, 0x7e, 0x07, 0xdd, 0x4c, 0x97, 0x81, 0x90, 0xc4, 0xf7, 0x75, 0x69, 0xd7, 0xaa, 0xe6, 0x9c,
+	0xde, 0x3d, 0xc8, 0x8c, 0xdf, 0x47, 0x85, 0xc4, 0xb4, 0x1d, 0x48, 0xe0, 0x8c, 0x7b, 0x02, 0x36,
+	0x89, 0x80, 0x43, 0xee, 0x9b, 0x37, 0x34, 0xa9, 0x21, 0x3b, 0xf0, 0x22, 0x1a, 0x67, 0x9c, 0x3e,
+	0xeb, 0x98, 0x79, 0xbd, 0x35, 0x5a, 0xa8, 0x1e, 0x62, 0x71, 0x09, 0xcd, 0x47, 0x3d, 0x14, 0x2f,
+	0x71, 0x19, 0x2d, 0x36, 0x5c, 0x76, 0x00, 0x3c, 0xf4, 0x5c, 0xa8, 0xb8, 0x2e, 0x6d, 0x07, 0x5a,
+	0x73, 0xac, 0xb7, 0xf5, 0xb5, 0x61, 0x1b, 0x61, 0x5d, 0xa3, 0x3b, 0x52, 0xb2, 0x4d, 0x22, 0x3c,
+	0xb7, 0xd2, 0x96, 0x4d, 0x73, 0x41, 0x0b, 0xdb, 0xc7, 0xa2, 0xef, 0x94, 0x9e, 0x40, 0xb0, 0xcf,
+	0x69, 0xe8, 0xd5, 0x81, 0x1f, 0x3a, 0xbb, 0xe6, 0x62, 0x7c, 0xa7, 0x67, 0x70, 0xfc, 0x26, 0x5a,
+	0xea, 0xc1, 0xa2, 0x1b, 0xaf, 0x55, 0xcd, 0x25, 0x7d, 0xa0, 0xbf, 0xf1, 0xdc, 0xa9, 0x4a, 0xbb,
+	0xee, 0x41, 0xe0, 0x82, 0xb9, 0xdc, 0xe7, 0x54, 0xd7, 0xa8, 0xf2, 0xe8, 0x31, 0x1c, 0xb8, 0x94,
+	0x81, 0x79, 0x53, 0x1f, 0xe9, 0x63, 0xb1, 0xe6, 0xd0, 0x8c, 0x6a, 0xb5, 0xee, 0x2c, 0xb0, 0x7e,
+	0x36, 0xd0, 0xbc, 0x02, 0xb6, 0x38, 0x10, 0x09, 0x0e, 0x3c, 0x6d, 0x83, 0x90, 0xf8, 0xd3, 0x4c,
+	0xf7, 0x4d, 0x97, 0x77, 0xfe, 0xdb, 0x58, 0x74, 0x92, 0xe9, 0x12, 0xf7, 0xf1, 0x32, 0xca, 0xb5,
+	0x99, 0x00, 0x2e, 0xe3, 0x69, 0x11, 0xaf, 0x54, 0x8d, 0xbb, 0x1c, 0xea, 0x62, 0x2f, 0xf0, 0x3b,
+	0xba, 0x89, 0x27, 0x9d, 0x14, 0xb0, 0x9e, 0x46, 0x44, 0x0f, 0x59, 0xfd, 0xba, 0x88, 0x96, 0xff,
+	0x99, 0x43, 0xf3, 0x29, 0x18, 0x17, 0x11, 0xfe, 0xc6, 0x40, 0x63, 0xbb, 0x9e, 0x90, 0x78, 0x29,
+	0x3b, 0x38, 0x93, 0x31, 0x59, 0xd8, 0xbd, 0x2a, 0x16, 0x2a, 0x88, 0x75, 0xe7, 0xcb, 0xdf, 0xff,
+	0xfc, 0x6e, 0x64, 0x19, 0x2f, 0xea, 0xe7, 0x41, 0xb8, 0x91, 0xfe, 0x16, 0x7b, 0x20, 0xbe, 0x1a,
+	0x31, 0xf0, 0xd7, 0x06, 0x1a, 0x7d, 0x0c, 0x03, 0xd9, 0x5c, 0x99, 0x26, 0xd6, 0x5d, 0xcd, 0xe4,
+	0x36, 0xbe, 0xd5, 0x8f, 0x49, 0xe9, 0xb9, 0x5a, 0xbd, 0xc0, 0xdf, 0x1b, 0x28, 0xaf, 0x78, 0x3b,
+	0x19, 0xdb, 0xf5, 0x08, 0x55, 0x1c, 0x26, 0x14, 0xfe, 0x0c, 0x4d, 0x46, 0xb4, 0x8e, 0x07, 0xd2,
+	0xc9, 0xf7, 0xc2, 0xc7, 0xc2, 0x5a, 0xd3, 0x2e, 0x2d, 0xbc, 0x3a, 0x24, 0xe3, 0x12, 0x57, 0x2e,
+	0x5b, 0x91, 0x7b, 0xf5, 0x33, 0x8b, 0x5f, 0x39, 0xeb, 0x3e, 0x79, 0x25, 0x15, 0x8a, 0xfd, 0x4c,
+	0x49, 0x2f, 0x5e, 0x2a, 0x1c, 0x51, 0x21, 0xbe, 0x35, 0xd0, 0xec, 0x63, 0x90, 0xe9, 0x7b, 0x06,
+	0xdf, 0xe9, 0xe3, 0x39, 0xfb, 0xd6, 0x29, 0x58, 0x83, 0x37, 0x24, 0x04, 0xde, 0xd5, 0x04, 0xde,
+	0xb2, 0x1e, 0xf6, 0x27, 0x10, 0xbd, 0x3a, 0xb4, 0x9f, 0x43, 0x67, 0x57, 0x53, 0xa9, 0x47, 0x1e,
+	0x1e, 0x19, 0xeb, 0x38, 0xd4, 0x94, 0x76, 0xc0, 0x6f, 0x6d, 0x35, 0x09, 0x97, 0x03, 0x65, 0x5e,
+	0xc9, 0xc2, 0xe9, 0xf6, 0x84, 0x84, 0xad, 0x49, 0xac, 0xe1, 0xfb, 0xc3, 0x54, 0x68, 0x82, 0xdf,
+	0x72, 0xa3, 0x30, 0x3f, 0x18, 0x28, 0x17, 0x4d, 0x2f, 0x7c, 0xfb, 0x6c, 0xc4, 0x9e, 0xa9, 0x76,
+	0x85, 0xad, 0xf0, 0xaa, 0xe6, 0x58, 0xb4, 0xfa, 0xd6, 0xda, 0x23, 0x3d, 0x3c, 0x54, 0x6b, 0xfe,
+	0x68, 0xa0, 0x7c, 0x97, 0x42, 0xf7, 0xec, 0xf5, 0x91, 0xb4, 0x2e, 0x26, 0x89, 0x7f, 0x32, 0x50,
+	0x2e, 0x9a, 0xa8, 0xe7, 0x79, 0xf5, 0x4c, 0xda, 0x2b, 0xe4, 0xb5, 0x11, 0x5d, 0x70, 0x61, 0x48,
+	0x99, 0x6b, 0x2a, 0x2f, 0x52, 0x21, 0x7f, 0x31, 0x50, 0xbe, 0x4b, 0x67, 0xb0, 0x90, 0xff, 0x17,
+	0x61, 0xfb, 0xe5, 0x08, 0x63, 0x82, 0x72, 0x55, 0xf0, 0x41, 0xc2, 0xa0, 0x16, 0x30, 0xcf, 0xc2,
+	0x49, 0xf1, 0xdf, 0x8f, 0x66, 0xec, 0xfa, 0xb0, 0x19, 0xab, 0x04, 0x69, 0xa2, 0x7c, 0x14, 0x22,
+	0xa3, 0xc7, 0x4b, 0x07, 0xbb, 0x7b, 0x89, 0x60, 0xf8, 0x39, 0x9a, 0xfb, 0x98, 0xf8, 0x9e, 0x52,
+	0x36, 0x7a, 0x9f, 0xe3, 0x5b, 0xe7, 0x26, 0x49, 0xfa, 0x6e, 0x1f, 0x12, 0xad, 0xac, 0xa3, 0x3d,
+	0xb0, 0xee, 0x0d, 0xeb, 0xeb, 0x30, 0x0e, 0x15, 0x29, 0xb9, 0xb9, 0xfd, 0xeb, 0xe9, 0x8a, 0xf1,
+	0xdb, 0xe9, 0x8a, 0xf1, 0xc7, 0xe9, 0x8a, 0xf1, 0xc9, 0xdb, 0x97, 0xfb, 0x27, 0xec, 0xea, 0x17,
+	0x55, 0xea, 0xbe, 0x73, 0x94, 0xd3, 0x7f, 0x5a, 0xdf, 0xf8, 0x77, 0x00, 0xda, 0x21, 0x65, 0xfa,
+	0x99, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1660,6 +1700,42 @@ func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TokenProviderScope) > 0 {
+		i -= len(m.TokenProviderScope)
+		copy(dAtA[i:], m.TokenProviderScope)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TokenProviderScope)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.TokenProviderAudience) > 0 {
+		i -= len(m.TokenProviderAudience)
+		copy(dAtA[i:], m.TokenProviderAudience)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TokenProviderAudience)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.TokenProviderClientID) > 0 {
+		i -= len(m.TokenProviderClientID)
+		copy(dAtA[i:], m.TokenProviderClientID)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TokenProviderClientID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.TokenProviderURL) > 0 {
+		i -= len(m.TokenProviderURL)
+		copy(dAtA[i:], m.TokenProviderURL)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TokenProviderURL)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.ForceHttpBasicAuth {
 		i--
 		if m.ForceHttpBasicAuth {
@@ -2130,6 +2206,22 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if m.ForceHttpBasicAuth {
 		n += 3
 	}
+	l = len(m.TokenProviderURL)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TokenProviderClientID)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TokenProviderAudience)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TokenProviderScope)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3438,6 +3530,134 @@ func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ForceHttpBasicAuth = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderAudience", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderAudience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -295,44 +295,321 @@ func (m *GetUserInfoResponse) GetGroups() []string {
 	return nil
 }
 
+// SessionListRequest is for listing the sessions of a user.
+type SessionListRequest struct {
+	// subject is the local account name or the subject of the SSO user, defaults to the current user
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionListRequest) Reset()         { *m = SessionListRequest{} }
+func (m *SessionListRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionListRequest) ProtoMessage()    {}
+func (*SessionListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{5}
+}
+func (m *SessionListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionListRequest.Merge(m, src)
+}
+func (m *SessionListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionListRequest proto.InternalMessageInfo
+
+func (m *SessionListRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+// UserSession is a session of a user, i.e. a token issued by Argo CD for a login or by the SSO provider.
+type UserSession struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject   string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Username  string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Issuer    string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,5,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// current is whether the session is the one of the request
+	Current              bool     `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserSession) Reset()         { *m = UserSession{} }
+func (m *UserSession) String() string { return proto.CompactTextString(m) }
+func (*UserSession) ProtoMessage()    {}
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{6}
+}
+func (m *UserSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession.Merge(m, src)
+}
+func (m *UserSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession proto.InternalMessageInfo
+
+func (m *UserSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UserSession) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *UserSession) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserSession) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *UserSession) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *UserSession) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *UserSession) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+// UserSessionList is a list of sessions of a user.
+type UserSessionList struct {
+	Items                []*UserSession `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UserSessionList) Reset()         { *m = UserSessionList{} }
+func (m *UserSessionList) String() string { return proto.CompactTextString(m) }
+func (*UserSessionList) ProtoMessage()    {}
+func (*UserSessionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{7}
+}
+func (m *UserSessionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserSessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserSessionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserSessionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSessionList.Merge(m, src)
+}
+func (m *UserSessionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserSessionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSessionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSessionList proto.InternalMessageInfo
+
+func (m *UserSessionList) GetItems() []*UserSession {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// SessionRevokeRequest is for revoking the sessions of a user.
+type SessionRevokeRequest struct {
+	// subject is the local account name or the subject of the SSO user, defaults to the current user
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// id is the ID of a single session to revoke
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// issuedBefore revokes the sessions issued before the given unix timestamp, all sessions are revoked if not set
+	IssuedBefore         int64    `protobuf:"varint,3,opt,name=issuedBefore,proto3" json:"issuedBefore,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionRevokeRequest) Reset()         { *m = SessionRevokeRequest{} }
+func (m *SessionRevokeRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionRevokeRequest) ProtoMessage()    {}
+func (*SessionRevokeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{8}
+}
+func (m *SessionRevokeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionRevokeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionRevokeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionRevokeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionRevokeRequest.Merge(m, src)
+}
+func (m *SessionRevokeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionRevokeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionRevokeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionRevokeRequest proto.InternalMessageInfo
+
+func (m *SessionRevokeRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *SessionRevokeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SessionRevokeRequest) GetIssuedBefore() int64 {
+	if m != nil {
+		return m.IssuedBefore
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "session.GetUserInfoRequest")
 	proto.RegisterType((*GetUserInfoResponse)(nil), "session.GetUserInfoResponse")
+	proto.RegisterType((*SessionListRequest)(nil), "session.SessionListRequest")
+	proto.RegisterType((*UserSession)(nil), "session.UserSession")
+	proto.RegisterType((*UserSessionList)(nil), "session.UserSessionList")
+	proto.RegisterType((*SessionRevokeRequest)(nil), "session.SessionRevokeRequest")
 }
 
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptor_87870a51a62685ed) }
 
 var fileDescriptor_87870a51a62685ed = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x95, 0x14, 0x42, 0xc7, 0x48, 0x0c, 0x98, 0x68, 0x88, 0x42, 0xa9, 0xaa, 0x6c, 0x18,
-	0x8d, 0x44, 0x23, 0x06, 0x56, 0x2c, 0x0b, 0x12, 0xea, 0x36, 0x15, 0x9b, 0x4a, 0x2c, 0xd2, 0xe4,
-	0xc7, 0xb8, 0x4d, 0xfd, 0x07, 0xdb, 0x49, 0xf7, 0x5c, 0x81, 0xc3, 0x70, 0x05, 0x96, 0x48, 0x5c,
-	0x00, 0x55, 0x1c, 0x04, 0x25, 0x4e, 0x42, 0x9b, 0x56, 0xac, 0xe2, 0xe7, 0xdf, 0xf9, 0xde, 0xb3,
-	0x9e, 0xc9, 0x48, 0x81, 0x2c, 0x41, 0x86, 0x0a, 0x94, 0xe2, 0x28, 0xda, 0xef, 0x34, 0x97, 0xa8,
-	0x91, 0xde, 0x6b, 0xa4, 0x3f, 0x62, 0x88, 0x2c, 0x83, 0x30, 0xce, 0x79, 0x18, 0x0b, 0x81, 0x3a,
-	0xd6, 0x1c, 0x85, 0x32, 0xc7, 0x82, 0x94, 0xb8, 0x0b, 0x73, 0xf0, 0xad, 0x84, 0x58, 0x43, 0x04,
-	0x5f, 0x0a, 0x50, 0x9a, 0xfa, 0x64, 0x58, 0x28, 0x90, 0x22, 0xde, 0x82, 0x67, 0x4d, 0xac, 0xeb,
-	0x8b, 0xa8, 0xd3, 0xd5, 0x2c, 0x8f, 0x95, 0xda, 0xa1, 0x4c, 0x3d, 0xdb, 0xcc, 0x5a, 0x4d, 0x5d,
-	0x72, 0x57, 0xe3, 0x06, 0x84, 0x37, 0xa8, 0x07, 0x46, 0x04, 0x57, 0x9d, 0xcb, 0x3b, 0xc8, 0xa0,
-	0x73, 0x09, 0x9e, 0x93, 0xcb, 0x66, 0x3f, 0x02, 0x95, 0xa3, 0x50, 0xf0, 0x0f, 0x60, 0x1d, 0x02,
-	0x5c, 0x42, 0xdf, 0x83, 0xfe, 0xa0, 0x40, 0xce, 0xc5, 0x27, 0x6c, 0x7f, 0xdf, 0x91, 0xc7, 0x47,
-	0xbb, 0x0d, 0xc2, 0x27, 0xc3, 0x0c, 0x19, 0x83, 0x74, 0x6e, 0x28, 0xc3, 0xa8, 0xd3, 0x47, 0xf7,
-	0xb2, 0x7b, 0xf7, 0x7a, 0x48, 0x06, 0x5c, 0xa9, 0x26, 0x79, 0xb5, 0xa4, 0x57, 0xc4, 0x61, 0x12,
-	0x8b, 0x5c, 0x79, 0x77, 0x26, 0x83, 0xeb, 0x8b, 0xa8, 0x51, 0xb7, 0xdf, 0x6d, 0xf2, 0xa0, 0x09,
-	0xbe, 0x00, 0x59, 0xf2, 0x04, 0xe8, 0x9a, 0xdc, 0x3f, 0xc8, 0x42, 0x9f, 0x4e, 0xdb, 0x3a, 0x4e,
-	0x73, 0xfb, 0xa3, 0xf3, 0x43, 0x13, 0x3f, 0x98, 0x7c, 0xfd, 0xf5, 0xe7, 0x9b, 0xed, 0x53, 0xaf,
-	0xae, 0xac, 0x7c, 0xd9, 0x15, 0x5c, 0x05, 0xe5, 0x15, 0xfc, 0x23, 0x71, 0x4c, 0x5b, 0xf4, 0x59,
-	0x47, 0x3a, 0xd7, 0xa2, 0xef, 0xf5, 0xc7, 0x9d, 0x89, 0x5f, 0x9b, 0xb8, 0xc1, 0x65, 0xcf, 0xe4,
-	0x8d, 0x75, 0x43, 0x97, 0xc4, 0x31, 0x35, 0x9d, 0xe2, 0x8f, 0xea, 0xfb, 0x0f, 0xfe, 0x49, 0x8d,
-	0x7f, 0x74, 0xd3, 0xc7, 0xcf, 0x66, 0x3f, 0xf6, 0x63, 0xeb, 0xe7, 0x7e, 0x6c, 0xfd, 0xde, 0x8f,
-	0xad, 0xe5, 0x6b, 0xc6, 0xf5, 0xe7, 0x62, 0x35, 0x4d, 0x70, 0x1b, 0xc6, 0x92, 0x61, 0x2e, 0x71,
-	0x5d, 0x2f, 0x5e, 0x24, 0x69, 0x58, 0xde, 0x86, 0xf9, 0x86, 0x55, 0x80, 0x24, 0xe3, 0x20, 0x74,
-	0xcb, 0x58, 0x39, 0xf5, 0xd3, 0x7d, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0xda, 0x7e, 0xce, 0xb3,
-	0x01, 0x03, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0x4e, 0x5b, 0x28, 0xf0, 0x20, 0xa0, 0xe3, 0x06, 0x27, 0x75, 0x21, 0x9b, 0x5e, 0xdc, 0x6c,
+	0xe2, 0x36, 0xa2, 0x27, 0x13, 0x0f, 0xa0, 0x89, 0x21, 0xf1, 0x54, 0xe2, 0x85, 0xc4, 0x43, 0x69,
+	0x1f, 0x75, 0xd8, 0xa5, 0x53, 0x67, 0xa6, 0x8b, 0x67, 0xef, 0x9e, 0xfc, 0x41, 0x5e, 0x3d, 0x9a,
+	0xf8, 0x07, 0x0c, 0xf1, 0x87, 0x98, 0x76, 0xa6, 0xa5, 0xed, 0x82, 0x9c, 0x76, 0xbe, 0x79, 0x6f,
+	0xbe, 0xf7, 0xbd, 0xd7, 0xef, 0x2d, 0x0c, 0x25, 0x8a, 0x05, 0x8a, 0x40, 0xa2, 0x94, 0x8c, 0x67,
+	0xf5, 0xef, 0x34, 0x17, 0x5c, 0x71, 0xb2, 0x66, 0xa0, 0x37, 0x4c, 0x39, 0x4f, 0xe7, 0x18, 0x44,
+	0x39, 0x0b, 0xa2, 0x2c, 0xe3, 0x2a, 0x52, 0x8c, 0x67, 0x52, 0xa7, 0xf9, 0x09, 0x0c, 0x4e, 0x74,
+	0xe2, 0x1b, 0x81, 0x91, 0xc2, 0x10, 0x3f, 0x17, 0x28, 0x15, 0xf1, 0x60, 0xbd, 0x90, 0x28, 0xb2,
+	0xe8, 0x12, 0xa9, 0x35, 0xb2, 0xc6, 0x1b, 0x61, 0x83, 0xcb, 0x58, 0x1e, 0x49, 0x79, 0xc5, 0x45,
+	0x42, 0x6d, 0x1d, 0xab, 0x31, 0x19, 0xc0, 0xaa, 0xe2, 0x33, 0xcc, 0xa8, 0x53, 0x05, 0x34, 0xf0,
+	0x77, 0x9b, 0x2a, 0x6f, 0x71, 0x8e, 0x4d, 0x15, 0xff, 0x29, 0xec, 0x98, 0xfb, 0x10, 0x65, 0xce,
+	0x33, 0x89, 0x37, 0x04, 0x56, 0x9b, 0x60, 0x00, 0xe4, 0x1d, 0xaa, 0x0f, 0x12, 0xc5, 0x71, 0x76,
+	0xce, 0xeb, 0xe7, 0x57, 0xf0, 0xa8, 0x73, 0x6b, 0x28, 0x3c, 0x58, 0x9f, 0xf3, 0x34, 0xc5, 0xe4,
+	0x58, 0xb3, 0xac, 0x87, 0x0d, 0xee, 0xf4, 0x65, 0xf7, 0xfa, 0x7a, 0x00, 0x0e, 0x93, 0xd2, 0x28,
+	0x2f, 0x8f, 0x64, 0x17, 0xdc, 0x54, 0xf0, 0x22, 0x97, 0x74, 0x65, 0xe4, 0x8c, 0x37, 0x42, 0x83,
+	0xfc, 0x29, 0x10, 0xa3, 0xfb, 0x3d, 0x93, 0xaa, 0x9e, 0x19, 0x85, 0x35, 0x59, 0x9c, 0x5d, 0x60,
+	0xac, 0x8c, 0xf8, 0x1a, 0xfa, 0x3f, 0x2c, 0xd8, 0x2c, 0x65, 0x9a, 0x47, 0x64, 0x1b, 0x6c, 0x96,
+	0x98, 0x24, 0x9b, 0x25, 0xed, 0x97, 0x76, 0xe7, 0x65, 0x47, 0xaf, 0xd3, 0xd3, 0xbb, 0x0b, 0x2e,
+	0x93, 0xb2, 0x40, 0x41, 0x57, 0xaa, 0x88, 0x41, 0xe5, 0x9b, 0xea, 0x94, 0x1c, 0x2a, 0xba, 0x3a,
+	0xb2, 0xc6, 0x4e, 0xd8, 0x60, 0x32, 0x84, 0x0d, 0xfc, 0x92, 0x33, 0x81, 0xf2, 0x50, 0x51, 0xb7,
+	0x0a, 0xde, 0x5c, 0x94, 0x3a, 0xe2, 0x42, 0x08, 0xcc, 0x14, 0x5d, 0xab, 0x06, 0x57, 0x43, 0xff,
+	0x35, 0xec, 0xb4, 0x1a, 0x28, 0xbb, 0x26, 0x13, 0x58, 0x65, 0x0a, 0x2f, 0x25, 0xb5, 0x46, 0xce,
+	0x78, 0xf3, 0x60, 0x30, 0xad, 0x0d, 0xd8, 0x4a, 0x0c, 0x75, 0x4a, 0xcb, 0x66, 0x21, 0x2e, 0xf8,
+	0x0c, 0xef, 0x1d, 0x99, 0x19, 0x91, 0xdd, 0x8c, 0xc8, 0x87, 0x2d, 0xdd, 0xc4, 0x11, 0x9e, 0x73,
+	0xa1, 0x87, 0xe1, 0x84, 0x9d, 0xbb, 0x83, 0x6f, 0x2b, 0xb0, 0x6d, 0xca, 0x9c, 0xa0, 0x58, 0xb0,
+	0x18, 0xc9, 0x05, 0x6c, 0xb6, 0x2c, 0x42, 0x9e, 0x34, 0x22, 0x97, 0xed, 0xe4, 0x0d, 0x6f, 0x0f,
+	0x6a, 0x57, 0xf9, 0xa3, 0xaf, 0xbf, 0xff, 0x7e, 0xb7, 0x3d, 0x42, 0xab, 0x4d, 0x5a, 0x3c, 0x6f,
+	0xf6, 0xae, 0xfc, 0x1e, 0xac, 0x24, 0xff, 0x08, 0xae, 0x5e, 0x22, 0xb2, 0xd7, 0x30, 0xdd, 0xb6,
+	0x5c, 0x1e, 0xed, 0x87, 0x9b, 0x22, 0x5e, 0x55, 0x64, 0xe0, 0xef, 0xf4, 0x8a, 0xbc, 0xb2, 0x26,
+	0xe4, 0x14, 0x5c, 0xbd, 0x3d, 0xcb, 0xf4, 0x9d, 0xad, 0xfa, 0x0f, 0xfd, 0xe3, 0x8a, 0xfe, 0xe1,
+	0xa4, 0x4f, 0x4f, 0x52, 0xd8, 0x2a, 0xbf, 0xa9, 0xc9, 0x97, 0xad, 0x39, 0x2d, 0xfb, 0xbc, 0xc5,
+	0xdf, 0xb3, 0xc4, 0xdd, 0x33, 0x92, 0x35, 0xf1, 0x0c, 0xb6, 0xb5, 0x03, 0x9a, 0x52, 0x7b, 0xcb,
+	0x6a, 0x5b, 0x0e, 0xb9, 0xbf, 0xd8, 0xe4, 0xce, 0x62, 0x47, 0x47, 0x3f, 0xaf, 0xf7, 0xad, 0x5f,
+	0xd7, 0xfb, 0xd6, 0x9f, 0xeb, 0x7d, 0xeb, 0xf4, 0x65, 0xca, 0xd4, 0xa7, 0xe2, 0x6c, 0x1a, 0xf3,
+	0xcb, 0x20, 0x12, 0x29, 0xcf, 0x05, 0xbf, 0xa8, 0x0e, 0xcf, 0xe2, 0x24, 0x58, 0x1c, 0x04, 0xf9,
+	0x2c, 0x2d, 0x99, 0xe2, 0x39, 0xc3, 0x4c, 0xd5, 0x24, 0x67, 0x6e, 0xf5, 0x3f, 0xf9, 0xe2, 0xdf,
+	0x00, 0x22, 0x5d, 0x74, 0x45, 0x6e, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,6 +630,10 @@ type SessionServiceClient interface {
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// ListSessions returns the sessions of a user
+	ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*UserSessionList, error)
+	// RevokeSessions revokes the sessions of a user and returns the revoked sessions
+	RevokeSessions(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*UserSessionList, error)
 }
 
 type sessionServiceClient struct {
@@ -390,6 +671,24 @@ func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequ
 	return out, nil
 }
 
+func (c *sessionServiceClient) ListSessions(ctx context.Context, in *SessionListRequest, opts ...grpc.CallOption) (*UserSessionList, error) {
+	out := new(UserSessionList)
+	err := c.cc.Invoke(ctx, "/session.SessionService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeSessions(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*UserSessionList, error) {
+	out := new(UserSessionList)
+	err := c.cc.Invoke(ctx, "/session.SessionService/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 type SessionServiceServer interface {
 	// Get the current user's info
@@ -398,6 +697,10 @@ type SessionServiceServer interface {
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
+	// ListSessions returns the sessions of a user
+	ListSessions(context.Context, *SessionListRequest) (*UserSessionList, error)
+	// RevokeSessions revokes the sessions of a user and returns the revoked sessions
+	RevokeSessions(context.Context, *SessionRevokeRequest) (*UserSessionList, error)
 }
 
 // UnimplementedSessionServiceServer can be embedded to have forward compatible implementations.
@@ -413,6 +716,12 @@ func (*UnimplementedSessionServiceServer) Create(ctx context.Context, req *Sessi
 func (*UnimplementedSessionServiceServer) Delete(ctx context.Context, req *SessionDeleteRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedSessionServiceServer) ListSessions(ctx context.Context, req *SessionListRequest) (*UserSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedSessionServiceServer) RevokeSessions(ctx context.Context, req *SessionRevokeRequest) (*UserSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
 	s.RegisterService(&_SessionService_serviceDesc, srv)
@@ -472,6 +781,42 @@ func _SessionService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessions(ctx, req.(*SessionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeSessions(ctx, req.(*SessionRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -488,6 +833,14 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SessionService_Delete_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _SessionService_RevokeSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/session/session.proto",
@@ -689,51 +1042,247 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSession(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SessionListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SessionCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SessionListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovSession(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SessionDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UserSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Current {
+		i--
+		if m.Current {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserSessionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserSessionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSessionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionRevokeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionRevokeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionRevokeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IssuedBefore != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.IssuedBefore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSession(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SessionCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *SessionResponse) Size() (n int) {
@@ -793,6 +1342,100 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *SessionListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovSession(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovSession(uint64(m.ExpiresAt))
+	}
+	if m.Current {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserSessionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionRevokeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.IssuedBefore != 0 {
+		n += 1 + sovSession(uint64(m.IssuedBefore))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSession(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1298,6 +1941,545 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SessionListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Current = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserSessionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserSessionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserSessionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &UserSession{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionRevokeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionRevokeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedBefore", wireType)
+			}
+			m.IssuedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedBefore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSession(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
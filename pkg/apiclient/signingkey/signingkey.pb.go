@@ -0,0 +1,1169 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/signingkey/signingkey.proto
+
+// Signing key service
+//
+// Signing key API performs CRUD actions against SSHSigningKey resources
+
+package signingkey
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Message to query the server for configured SSH signing keys
+type SSHSigningKeyQuery struct {
+	// The SHA256 fingerprint of the key to query for
+	Fingerprint          string   `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSigningKeyQuery) Reset()         { *m = SSHSigningKeyQuery{} }
+func (m *SSHSigningKeyQuery) String() string { return proto.CompactTextString(m) }
+func (*SSHSigningKeyQuery) ProtoMessage()    {}
+func (*SSHSigningKeyQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdf36445118bfd0e, []int{0}
+}
+func (m *SSHSigningKeyQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSigningKeyQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSigningKeyQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyQuery.Merge(m, src)
+}
+func (m *SSHSigningKeyQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyQuery proto.InternalMessageInfo
+
+func (m *SSHSigningKeyQuery) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+// Request to create one or more SSH signing keys on the server
+type SSHSigningKeyCreateRequest struct {
+	// Entries of an SSH allowed signers file or SSH public keys to create
+	Signingkey *v1alpha1.SSHSigningKey `protobuf:"bytes,1,opt,name=signingkey,proto3" json:"signingkey,omitempty"`
+	// Whether to upsert already existing signing keys
+	Upsert               bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSigningKeyCreateRequest) Reset()         { *m = SSHSigningKeyCreateRequest{} }
+func (m *SSHSigningKeyCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*SSHSigningKeyCreateRequest) ProtoMessage()    {}
+func (*SSHSigningKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdf36445118bfd0e, []int{1}
+}
+func (m *SSHSigningKeyCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSigningKeyCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSigningKeyCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyCreateRequest.Merge(m, src)
+}
+func (m *SSHSigningKeyCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyCreateRequest proto.InternalMessageInfo
+
+func (m *SSHSigningKeyCreateRequest) GetSigningkey() *v1alpha1.SSHSigningKey {
+	if m != nil {
+		return m.Signingkey
+	}
+	return nil
+}
+
+func (m *SSHSigningKeyCreateRequest) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
+// Response to a signing key creation request
+type SSHSigningKeyCreateResponse struct {
+	// List of SSH signing keys that have been created
+	Created *v1alpha1.SSHSigningKeyList `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
+	// List of fingerprints of keys that have been skipped because they already exist on the server
+	Skipped              []string `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSigningKeyCreateResponse) Reset()         { *m = SSHSigningKeyCreateResponse{} }
+func (m *SSHSigningKeyCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*SSHSigningKeyCreateResponse) ProtoMessage()    {}
+func (*SSHSigningKeyCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdf36445118bfd0e, []int{2}
+}
+func (m *SSHSigningKeyCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSigningKeyCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSigningKeyCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyCreateResponse.Merge(m, src)
+}
+func (m *SSHSigningKeyCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyCreateResponse proto.InternalMessageInfo
+
+func (m *SSHSigningKeyCreateResponse) GetCreated() *v1alpha1.SSHSigningKeyList {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *SSHSigningKeyCreateResponse) GetSkipped() []string {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
+// Generic (empty) response for signing key CRUD requests
+type SSHSigningKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSigningKeyResponse) Reset()         { *m = SSHSigningKeyResponse{} }
+func (m *SSHSigningKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*SSHSigningKeyResponse) ProtoMessage()    {}
+func (*SSHSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdf36445118bfd0e, []int{3}
+}
+func (m *SSHSigningKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSigningKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSigningKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyResponse.Merge(m, src)
+}
+func (m *SSHSigningKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SSHSigningKeyQuery)(nil), "signingkey.SSHSigningKeyQuery")
+	proto.RegisterType((*SSHSigningKeyCreateRequest)(nil), "signingkey.SSHSigningKeyCreateRequest")
+	proto.RegisterType((*SSHSigningKeyCreateResponse)(nil), "signingkey.SSHSigningKeyCreateResponse")
+	proto.RegisterType((*SSHSigningKeyResponse)(nil), "signingkey.SSHSigningKeyResponse")
+}
+
+func init() {
+	proto.RegisterFile("server/signingkey/signingkey.proto", fileDescriptor_cdf36445118bfd0e)
+}
+
+var fileDescriptor_cdf36445118bfd0e = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xdf, 0x8a, 0x13, 0x31,
+	0x14, 0xc6, 0x49, 0x77, 0xe9, 0xba, 0xd9, 0x2b, 0x23, 0xab, 0x65, 0x56, 0x4a, 0x3b, 0x82, 0x5b,
+	0x05, 0x13, 0x5a, 0x41, 0xc1, 0x4b, 0xff, 0xa0, 0xb0, 0x0b, 0xe2, 0xf4, 0xce, 0x1b, 0x99, 0x9d,
+	0x39, 0xa6, 0x71, 0xc6, 0x24, 0x26, 0xe9, 0x40, 0x11, 0x6f, 0xf6, 0x15, 0xf4, 0xd6, 0x0b, 0x7d,
+	0x0b, 0xdf, 0xc0, 0x4b, 0xc1, 0x17, 0x90, 0xe2, 0x83, 0x48, 0x33, 0xad, 0x4d, 0xb1, 0x4b, 0xbd,
+	0xe8, 0xdd, 0x99, 0x33, 0x39, 0xdf, 0xf9, 0x9d, 0x9c, 0x8f, 0xe0, 0xd8, 0x82, 0xa9, 0xc0, 0x30,
+	0x2b, 0xb8, 0x14, 0x92, 0x17, 0x30, 0x09, 0x42, 0xaa, 0x8d, 0x72, 0x8a, 0xe0, 0x65, 0x26, 0xba,
+	0xce, 0x95, 0xe2, 0x25, 0xb0, 0x54, 0x0b, 0x96, 0x4a, 0xa9, 0x5c, 0xea, 0x84, 0x92, 0xb6, 0x3e,
+	0x19, 0x9d, 0x72, 0xe1, 0x46, 0xe3, 0x33, 0x9a, 0xa9, 0xb7, 0x2c, 0x35, 0x5c, 0x69, 0xa3, 0xde,
+	0xf8, 0xe0, 0x4e, 0x96, 0xb3, 0x6a, 0xc0, 0x74, 0xc1, 0x67, 0x95, 0x96, 0xa5, 0x5a, 0x97, 0x22,
+	0xf3, 0xb5, 0xac, 0xea, 0xa7, 0xa5, 0x1e, 0xa5, 0x7d, 0xc6, 0x41, 0x82, 0x49, 0x1d, 0xe4, 0xb5,
+	0x5a, 0x7c, 0x0f, 0x93, 0xe1, 0xf0, 0xd9, 0xb0, 0x6e, 0x7e, 0x02, 0x93, 0x17, 0x63, 0x30, 0x13,
+	0xd2, 0xc1, 0x07, 0xaf, 0x85, 0xe4, 0x60, 0xb4, 0x11, 0xd2, 0xb5, 0x50, 0x07, 0xf5, 0xf6, 0x93,
+	0x30, 0x15, 0x7f, 0x41, 0x38, 0x5a, 0x29, 0x7c, 0x64, 0x20, 0x75, 0x90, 0xc0, 0xbb, 0x31, 0x58,
+	0x47, 0x0a, 0x1c, 0x0c, 0xe4, 0xeb, 0x0f, 0x06, 0x27, 0x74, 0x49, 0x4e, 0x17, 0xe4, 0x3e, 0x78,
+	0x95, 0xe5, 0xb4, 0x1a, 0x50, 0x5d, 0x70, 0x3a, 0x23, 0xa7, 0x01, 0x39, 0x5d, 0x90, 0xd3, 0x95,
+	0x6e, 0x49, 0x20, 0x4f, 0xae, 0xe2, 0xe6, 0x58, 0x5b, 0x30, 0xae, 0xd5, 0xe8, 0xa0, 0xde, 0xa5,
+	0x64, 0xfe, 0x15, 0x7f, 0x45, 0xf8, 0x68, 0x2d, 0xa3, 0xd5, 0x4a, 0x5a, 0x20, 0x02, 0xef, 0x65,
+	0x3e, 0x93, 0xcf, 0x09, 0x9f, 0x6f, 0x91, 0xf0, 0x54, 0x58, 0x97, 0x2c, 0xf4, 0x49, 0x0b, 0xef,
+	0xd9, 0x42, 0x68, 0x0d, 0x79, 0xab, 0xd1, 0xd9, 0xe9, 0xed, 0x27, 0x8b, 0xcf, 0xf8, 0x1a, 0x3e,
+	0x5c, 0x9d, 0x6c, 0x4e, 0x37, 0xf8, 0xb6, 0x8b, 0x2f, 0x2f, 0xd3, 0x43, 0x30, 0x95, 0xc8, 0x80,
+	0x7c, 0x42, 0x78, 0x77, 0x26, 0x4d, 0xda, 0x34, 0xf0, 0xd0, 0xbf, 0x2b, 0x8c, 0xb6, 0x3d, 0x4b,
+	0x7c, 0x74, 0xfe, 0xf3, 0xf7, 0xc7, 0xc6, 0x21, 0xb9, 0xe2, 0x7d, 0x59, 0xf5, 0x03, 0x0f, 0x5b,
+	0xf2, 0x19, 0xe1, 0x9d, 0xa7, 0xb0, 0x99, 0x6a, 0x9b, 0x1e, 0x88, 0x6f, 0x79, 0xa2, 0x1b, 0xa4,
+	0xbb, 0x86, 0x88, 0xbd, 0x0f, 0xdc, 0xfa, 0x81, 0x9c, 0x23, 0xdc, 0xac, 0xb7, 0x4f, 0x6e, 0x5e,
+	0x88, 0xb8, 0x62, 0xe1, 0xe8, 0x78, 0xe3, 0xb9, 0x7a, 0x51, 0xf1, 0xb1, 0xc7, 0xe8, 0xc6, 0xeb,
+	0x2e, 0xe6, 0x41, 0xe8, 0xd3, 0x11, 0x6e, 0x3e, 0x86, 0x12, 0x1c, 0x6c, 0xbc, 0xa6, 0xee, 0x85,
+	0xff, 0xff, 0x76, 0x9d, 0xaf, 0xe3, 0xf6, 0xba, 0xae, 0x0f, 0x9f, 0x7c, 0x9f, 0xb6, 0xd1, 0x8f,
+	0x69, 0x1b, 0xfd, 0x9a, 0xb6, 0xd1, 0xcb, 0xfb, 0xff, 0xf7, 0x62, 0x64, 0xa5, 0x00, 0xe9, 0x02,
+	0x9d, 0xb3, 0xa6, 0x7f, 0x23, 0xee, 0xfe, 0x19, 0x00, 0x04, 0x09, 0x40, 0xc6, 0xc1, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SigningKeyServiceClient is the client API for SigningKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SigningKeyServiceClient interface {
+	// List all available SSH signing keys
+	List(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigningKeyList, error)
+	// Get information about specified SSH signing key from the server
+	Get(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigningKey, error)
+	// Create one or more SSH signing keys in the server's configuration
+	Create(ctx context.Context, in *SSHSigningKeyCreateRequest, opts ...grpc.CallOption) (*SSHSigningKeyCreateResponse, error)
+	// Delete specified SSH signing key from the server's configuration
+	Delete(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*SSHSigningKeyResponse, error)
+}
+
+type signingKeyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSigningKeyServiceClient(cc *grpc.ClientConn) SigningKeyServiceClient {
+	return &signingKeyServiceClient{cc}
+}
+
+func (c *signingKeyServiceClient) List(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigningKeyList, error) {
+	out := new(v1alpha1.SSHSigningKeyList)
+	err := c.cc.Invoke(ctx, "/signingkey.SigningKeyService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyServiceClient) Get(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigningKey, error) {
+	out := new(v1alpha1.SSHSigningKey)
+	err := c.cc.Invoke(ctx, "/signingkey.SigningKeyService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyServiceClient) Create(ctx context.Context, in *SSHSigningKeyCreateRequest, opts ...grpc.CallOption) (*SSHSigningKeyCreateResponse, error) {
+	out := new(SSHSigningKeyCreateResponse)
+	err := c.cc.Invoke(ctx, "/signingkey.SigningKeyService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signingKeyServiceClient) Delete(ctx context.Context, in *SSHSigningKeyQuery, opts ...grpc.CallOption) (*SSHSigningKeyResponse, error) {
+	out := new(SSHSigningKeyResponse)
+	err := c.cc.Invoke(ctx, "/signingkey.SigningKeyService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SigningKeyServiceServer is the server API for SigningKeyService service.
+type SigningKeyServiceServer interface {
+	// List all available SSH signing keys
+	List(context.Context, *SSHSigningKeyQuery) (*v1alpha1.SSHSigningKeyList, error)
+	// Get information about specified SSH signing key from the server
+	Get(context.Context, *SSHSigningKeyQuery) (*v1alpha1.SSHSigningKey, error)
+	// Create one or more SSH signing keys in the server's configuration
+	Create(context.Context, *SSHSigningKeyCreateRequest) (*SSHSigningKeyCreateResponse, error)
+	// Delete specified SSH signing key from the server's configuration
+	Delete(context.Context, *SSHSigningKeyQuery) (*SSHSigningKeyResponse, error)
+}
+
+// UnimplementedSigningKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSigningKeyServiceServer struct {
+}
+
+func (*UnimplementedSigningKeyServiceServer) List(ctx context.Context, req *SSHSigningKeyQuery) (*v1alpha1.SSHSigningKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedSigningKeyServiceServer) Get(ctx context.Context, req *SSHSigningKeyQuery) (*v1alpha1.SSHSigningKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedSigningKeyServiceServer) Create(ctx context.Context, req *SSHSigningKeyCreateRequest) (*SSHSigningKeyCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedSigningKeyServiceServer) Delete(ctx context.Context, req *SSHSigningKeyQuery) (*SSHSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterSigningKeyServiceServer(s *grpc.Server, srv SigningKeyServiceServer) {
+	s.RegisterService(&_SigningKeyService_serviceDesc, srv)
+}
+
+func _SigningKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSigningKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signingkey.SigningKeyService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServiceServer).List(ctx, req.(*SSHSigningKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKeyService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSigningKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signingkey.SigningKeyService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServiceServer).Get(ctx, req.(*SSHSigningKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKeyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSigningKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signingkey.SigningKeyService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServiceServer).Create(ctx, req.(*SSHSigningKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SigningKeyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSigningKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SigningKeyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signingkey.SigningKeyService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SigningKeyServiceServer).Delete(ctx, req.(*SSHSigningKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SigningKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signingkey.SigningKeyService",
+	HandlerType: (*SigningKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _SigningKeyService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _SigningKeyService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _SigningKeyService_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SigningKeyService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/signingkey/signingkey.proto",
+}
+
+func (m *SSHSigningKeyQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintSigningkey(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKeyCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Signingkey != nil {
+		{
+			size, err := m.Signingkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigningkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKeyCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Skipped) > 0 {
+		for iNdEx := len(m.Skipped) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skipped[iNdEx])
+			copy(dAtA[i:], m.Skipped[iNdEx])
+			i = encodeVarintSigningkey(dAtA, i, uint64(len(m.Skipped[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigningkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigningkey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigningkey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SSHSigningKeyQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovSigningkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHSigningKeyCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Signingkey != nil {
+		l = m.Signingkey.Size()
+		n += 1 + l + sovSigningkey(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHSigningKeyCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovSigningkey(uint64(l))
+	}
+	if len(m.Skipped) > 0 {
+		for _, s := range m.Skipped {
+			l = len(s)
+			n += 1 + l + sovSigningkey(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHSigningKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSigningkey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigningkey(x uint64) (n int) {
+	return sovSigningkey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SSHSigningKeyQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSigningKeyQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSigningKeyQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSigningKeyCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSigningKeyCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSigningKeyCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signingkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signingkey == nil {
+				m.Signingkey = &v1alpha1.SSHSigningKey{}
+			}
+			if err := m.Signingkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSigningKeyCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSigningKeyCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSigningKeyCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &v1alpha1.SSHSigningKeyList{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skipped = append(m.Skipped, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSigningKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigningkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSigningKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSigningKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigningkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigningkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigningkey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigningkey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigningkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigningkey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigningkey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigningkey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigningkey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigningkey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigningkey = fmt.Errorf("proto: unexpected end of group")
+)
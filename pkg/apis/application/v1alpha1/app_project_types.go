@@ -1,10 +1,13 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	globutil "github.com/gobwas/glob"
 	"github.com/google/go-cmp/cmp"
@@ -12,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -272,6 +276,21 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	if p.Spec.SyncApproval != nil {
+		if _, err := metav1.LabelSelectorAsSelector(&p.Spec.SyncApproval.ApplicationSelector); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync approval has an invalid application selector: %v", err)
+		}
+		if _, err := p.Spec.SyncApproval.GetExpiration(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync approval has an invalid expiration: %v", err)
+		}
+	}
+
+	for _, key := range p.Spec.SignatureKeys {
+		if err := key.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "signature key has an invalid format: %v", err)
+		}
+	}
+
 	destServiceAccts := make(map[string]bool)
 	for _, destServiceAcct := range p.Spec.DestinationServiceAccounts {
 		if strings.Contains(destServiceAcct.Server, "!") {
@@ -460,6 +479,50 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return anySourceMatched
 }
 
+// IsHelmValuesFromPermitted returns true if the project allows applications to read Helm values from the given ConfigMap
+// or Secret, which is located in the given namespace
+func (proj AppProject) IsHelmValuesFromPermitted(src HelmValuesFromSource, namespace string) bool {
+	for _, entry := range proj.Spec.HelmValuesFromAllowList {
+		if entry.Destination != src.Destination || (entry.Kind != "" && entry.Kind != "*" && entry.Kind != src.Kind) {
+			continue
+		}
+		if globMatch(entry.Namespace, namespace, false) && globMatch(entry.Name, src.Name, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresSyncApproval returns true if syncs of the given application need to be approved by a second person
+func (proj AppProject) RequiresSyncApproval(app *Application) (bool, error) {
+	if proj.Spec.SyncApproval == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&proj.Spec.SyncApproval.ApplicationSelector)
+	if err != nil {
+		return false, fmt.Errorf("error parsing sync approval application selector: %w", err)
+	}
+	return selector.Matches(labels.Set(app.Labels)), nil
+}
+
+// DefaultSyncApprovalExpiration is the duration after which sync requests expire by default
+const DefaultSyncApprovalExpiration = 24 * time.Hour
+
+// GetExpiration returns the duration after which sync requests expire
+func (s *SyncApproval) GetExpiration() (time.Duration, error) {
+	if s.Expiration == "" {
+		return DefaultSyncApprovalExpiration, nil
+	}
+	expiration, err := time.ParseDuration(s.Expiration)
+	if err != nil {
+		return 0, err
+	}
+	if expiration <= 0 {
+		return 0, fmt.Errorf("expiration must be positive")
+	}
+	return expiration, nil
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
 	destinationMatched := proj.isDestinationMatched(dst)
@@ -604,3 +667,50 @@ func (p AppProject) IsAppNamespacePermitted(app *Application, controllerNs strin
 
 	return glob.MatchStringInList(p.Spec.SourceNamespaces, app.Namespace, glob.REGEXP)
 }
+
+// Validate checks that exactly one kind of key is specified, that fingerprints are SHA256 fingerprints and that the
+// subject regular expression of a Sigstore identity compiles
+func (k SignatureKey) Validate() error {
+	kinds := 0
+	if k.KeyID != "" {
+		kinds++
+	}
+	if k.SSH != nil {
+		if !strings.HasPrefix(k.SSH.Fingerprint, "SHA256:") {
+			return fmt.Errorf("fingerprint of SSH key must start with SHA256:, got '%s'", k.SSH.Fingerprint)
+		}
+		kinds++
+	}
+	if k.Sigstore != nil {
+		if k.Sigstore.Issuer == "" || k.Sigstore.SubjectRegex == "" {
+			return errors.New("issuer and subject regex of Sigstore identity must not be empty")
+		}
+		if _, err := regexp.Compile(k.Sigstore.SubjectRegex); err != nil {
+			return fmt.Errorf("invalid subject regex of Sigstore identity: %w", err)
+		}
+		kinds++
+	}
+	if k.Cosign != nil {
+		if !strings.HasPrefix(k.Cosign.Fingerprint, "SHA256:") {
+			return fmt.Errorf("fingerprint of cosign key must start with SHA256:, got '%s'", k.Cosign.Fingerprint)
+		}
+		kinds++
+	}
+	if kinds != 1 {
+		return errors.New("exactly one of keyID, ssh, sigstore and cosign must be specified")
+	}
+	return nil
+}
+
+// Matches returns true if the given issuer equals the issuer of the identity and the given subject fully matches its
+// subject regular expression
+func (i *SigstoreIdentity) Matches(issuer, subject string) bool {
+	if i.Issuer != issuer {
+		return false
+	}
+	re, err := regexp.Compile("^(?:" + i.SubjectRegex + ")$")
+	if err != nil {
+		return false
+	}
+	return re.MatchString(subject)
+}
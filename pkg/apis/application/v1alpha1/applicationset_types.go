@@ -22,6 +22,7 @@ import (
 	"sort"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/security"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -461,6 +462,22 @@ func (g *SCMProviderGenerator) CustomApiUrl() string {
 	return ""
 }
 
+// TokenProviderURL returns the URL of the token endpoint the SCM provider obtains its access tokens from, if any
+func (g *SCMProviderGenerator) TokenProviderURL() string {
+	var tokenProvider *SCMProviderGeneratorTokenProvider
+	if g.Gitlab != nil {
+		tokenProvider = g.Gitlab.TokenProvider
+	} else if g.BitbucketServer != nil {
+		tokenProvider = g.BitbucketServer.TokenProvider
+	} else if g.AzureDevOps != nil {
+		tokenProvider = g.AzureDevOps.TokenProvider
+	}
+	if tokenProvider == nil {
+		return ""
+	}
+	return tokenProvider.URL
+}
+
 // SCMProviderGeneratorGitea defines a connection info specific to Gitea.
 type SCMProviderGeneratorGitea struct {
 	// Gitea organization or user to scan. Required.
@@ -509,6 +526,8 @@ type SCMProviderGeneratorGitlab struct {
 	Topic string `json:"topic,omitempty" protobuf:"bytes,8,opt,name=topic"`
 	// ConfigMap key holding the trusted certificates
 	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,9,opt,name=caRef"`
+	// TokenProvider obtains short-lived OAuth access tokens, which are used instead of the token referenced by TokenRef
+	TokenProvider *SCMProviderGeneratorTokenProvider `json:"tokenProvider,omitempty" protobuf:"bytes,10,opt,name=tokenProvider"`
 }
 
 func (s *SCMProviderGeneratorGitlab) WillIncludeSharedProjects() bool {
@@ -527,6 +546,31 @@ type SCMProviderGeneratorBitbucket struct {
 	AllBranches bool `json:"allBranches,omitempty" protobuf:"varint,4,opt,name=allBranches"`
 }
 
+// SCMProviderGeneratorTokenProvider defines the OAuth 2.0 token endpoint from which an SCM provider obtains short-lived
+// access tokens, in exchange for a projected service account token of the ApplicationSet controller. If a client ID is
+// set, the service account token is used as a client assertion, as done by Microsoft Entra workload identity
+// federation. Otherwise, it is exchanged using an OAuth 2.0 token exchange (RFC 8693).
+type SCMProviderGeneratorTokenProvider struct {
+	// URL of the token endpoint. Required. It must be one of the URLs allowed by the administrator.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// ClientID used to request the access tokens.
+	ClientID string `json:"clientID,omitempty" protobuf:"bytes,2,opt,name=clientID"`
+	// Audience of the requested access tokens.
+	Audience string `json:"audience,omitempty" protobuf:"bytes,3,opt,name=audience"`
+	// Space-separated list of scopes of the requested access tokens.
+	Scope string `json:"scope,omitempty" protobuf:"bytes,4,opt,name=scope"`
+}
+
+// GetTokenProvider returns the token provider which obtains the access tokens
+func (p *SCMProviderGeneratorTokenProvider) GetTokenProvider() git.TokenProvider {
+	return git.TokenProvider{
+		URL:      p.URL,
+		ClientID: p.ClientID,
+		Audience: p.Audience,
+		Scope:    p.Scope,
+	}
+}
+
 // SCMProviderGeneratorBitbucketServer defines connection info specific to Bitbucket Server.
 type SCMProviderGeneratorBitbucketServer struct {
 	// Project to scan. Required.
@@ -543,6 +587,8 @@ type SCMProviderGeneratorBitbucketServer struct {
 	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
 	// ConfigMap key holding the trusted certificates
 	CARef *ConfigMapKeyRef `json:"caRef,omitempty" protobuf:"bytes,7,opt,name=caRef"`
+	// TokenProvider obtains short-lived access tokens, which are used as bearer tokens
+	TokenProvider *SCMProviderGeneratorTokenProvider `json:"tokenProvider,omitempty" protobuf:"bytes,8,opt,name=tokenProvider"`
 }
 
 // SCMProviderGeneratorAzureDevOps defines connection info specific to Azure DevOps.
@@ -553,10 +599,12 @@ type SCMProviderGeneratorAzureDevOps struct {
 	API string `json:"api,omitempty" protobuf:"bytes,6,opt,name=api"`
 	// Azure Devops team project. Required. E.g. "my-team".
 	TeamProject string `json:"teamProject" protobuf:"bytes,7,opt,name=teamProject"`
-	// The Personal Access Token (PAT) to use when connecting. Required.
-	AccessTokenRef *SecretRef `json:"accessTokenRef" protobuf:"bytes,8,opt,name=accessTokenRef"`
+	// The Personal Access Token (PAT) to use when connecting. Required unless TokenProvider is set.
+	AccessTokenRef *SecretRef `json:"accessTokenRef,omitempty" protobuf:"bytes,8,opt,name=accessTokenRef"`
 	// Scan all branches instead of just the default branch.
 	AllBranches bool `json:"allBranches,omitempty" protobuf:"varint,9,opt,name=allBranches"`
+	// TokenProvider obtains short-lived Microsoft Entra access tokens, which are used instead of the Personal Access Token
+	TokenProvider *SCMProviderGeneratorTokenProvider `json:"tokenProvider,omitempty" protobuf:"bytes,10,opt,name=tokenProvider"`
 }
 
 type TagFilter struct {
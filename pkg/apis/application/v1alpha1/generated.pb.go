@@ -1665,10 +1665,38 @@ func (m *ConnectionState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 
+func (m *CosignSignatureKey) Reset()      { *m = CosignSignatureKey{} }
+func (*CosignSignatureKey) ProtoMessage() {}
+func (*CosignSignatureKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{58}
+}
+func (m *CosignSignatureKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignSignatureKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CosignSignatureKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignSignatureKey.Merge(m, src)
+}
+func (m *CosignSignatureKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignSignatureKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignSignatureKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignSignatureKey proto.InternalMessageInfo
+
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{59}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1724,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{60}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1752,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{61}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1780,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1808,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1836,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1864,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1892,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1920,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +1948,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +1976,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2004,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2032,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2029,10 +2057,122 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmPostRenderer) Reset()      { *m = HelmPostRenderer{} }
+func (*HelmPostRenderer) ProtoMessage() {}
+func (*HelmPostRenderer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{72}
+}
+func (m *HelmPostRenderer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRenderer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRenderer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRenderer.Merge(m, src)
+}
+func (m *HelmPostRenderer) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRenderer) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRenderer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRenderer proto.InternalMessageInfo
+
+func (m *HelmPostRendererKustomize) Reset()      { *m = HelmPostRendererKustomize{} }
+func (*HelmPostRendererKustomize) ProtoMessage() {}
+func (*HelmPostRendererKustomize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{73}
+}
+func (m *HelmPostRendererKustomize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRendererKustomize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRendererKustomize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRendererKustomize.Merge(m, src)
+}
+func (m *HelmPostRendererKustomize) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRendererKustomize) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRendererKustomize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRendererKustomize proto.InternalMessageInfo
+
+func (m *HelmValuesFromAllowListEntry) Reset()      { *m = HelmValuesFromAllowListEntry{} }
+func (*HelmValuesFromAllowListEntry) ProtoMessage() {}
+func (*HelmValuesFromAllowListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{74}
+}
+func (m *HelmValuesFromAllowListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValuesFromAllowListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmValuesFromAllowListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValuesFromAllowListEntry.Merge(m, src)
+}
+func (m *HelmValuesFromAllowListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValuesFromAllowListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValuesFromAllowListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValuesFromAllowListEntry proto.InternalMessageInfo
+
+func (m *HelmValuesFromSource) Reset()      { *m = HelmValuesFromSource{} }
+func (*HelmValuesFromSource) ProtoMessage() {}
+func (*HelmValuesFromSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{75}
+}
+func (m *HelmValuesFromSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValuesFromSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmValuesFromSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValuesFromSource.Merge(m, src)
+}
+func (m *HelmValuesFromSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValuesFromSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValuesFromSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValuesFromSource proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{76}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2200,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2228,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2256,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2284,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2312,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2340,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2368,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2396,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2424,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2452,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2480,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2508,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2536,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2564,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{90}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2592,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{91}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2620,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{92}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2648,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{93}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2676,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{89}
+	return fileDescriptor_030104ce3b95bcac, []int{94}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2704,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{90}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2732,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{91}
+	return fileDescriptor_030104ce3b95bcac, []int{96}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2617,10 +2757,38 @@ func (m *Operation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Operation proto.InternalMessageInfo
 
+func (m *OperationApproval) Reset()      { *m = OperationApproval{} }
+func (*OperationApproval) ProtoMessage() {}
+func (*OperationApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{97}
+}
+func (m *OperationApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OperationApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationApproval.Merge(m, src)
+}
+func (m *OperationApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationApproval proto.InternalMessageInfo
+
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{92}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2816,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{93}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2844,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{94}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2872,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{95}
+	return fileDescriptor_030104ce3b95bcac, []int{101}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2900,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{96}
+	return fileDescriptor_030104ce3b95bcac, []int{102}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2928,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{97}
+	return fileDescriptor_030104ce3b95bcac, []int{103}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2956,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{98}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2984,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{99}
+	return fileDescriptor_030104ce3b95bcac, []int{105}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3012,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{100}
+	return fileDescriptor_030104ce3b95bcac, []int{106}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3040,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{101}
+	return fileDescriptor_030104ce3b95bcac, []int{107}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3068,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{102}
+	return fileDescriptor_030104ce3b95bcac, []int{108}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3096,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{103}
+	return fileDescriptor_030104ce3b95bcac, []int{109}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3124,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{104}
+	return fileDescriptor_030104ce3b95bcac, []int{110}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3152,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{105}
+	return fileDescriptor_030104ce3b95bcac, []int{111}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3180,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{106}
+	return fileDescriptor_030104ce3b95bcac, []int{112}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3208,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{107}
+	return fileDescriptor_030104ce3b95bcac, []int{113}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3236,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{108}
+	return fileDescriptor_030104ce3b95bcac, []int{114}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3264,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{109}
+	return fileDescriptor_030104ce3b95bcac, []int{115}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3292,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{110}
+	return fileDescriptor_030104ce3b95bcac, []int{116}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{111}
+	return fileDescriptor_030104ce3b95bcac, []int{117}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3348,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{112}
+	return fileDescriptor_030104ce3b95bcac, []int{118}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3376,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{113}
+	return fileDescriptor_030104ce3b95bcac, []int{119}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3404,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{114}
+	return fileDescriptor_030104ce3b95bcac, []int{120}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3432,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{115}
+	return fileDescriptor_030104ce3b95bcac, []int{121}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3460,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{116}
+	return fileDescriptor_030104ce3b95bcac, []int{122}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3488,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{117}
+	return fileDescriptor_030104ce3b95bcac, []int{123}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3516,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{118}
+	return fileDescriptor_030104ce3b95bcac, []int{124}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3544,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{119}
+	return fileDescriptor_030104ce3b95bcac, []int{125}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3572,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{120}
+	return fileDescriptor_030104ce3b95bcac, []int{126}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3600,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{121}
+	return fileDescriptor_030104ce3b95bcac, []int{127}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3628,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{122}
+	return fileDescriptor_030104ce3b95bcac, []int{128}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3485,10 +3653,38 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceDrift) Reset()      { *m = ResourceDrift{} }
+func (*ResourceDrift) ProtoMessage() {}
+func (*ResourceDrift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{129}
+}
+func (m *ResourceDrift) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDrift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceDrift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDrift.Merge(m, src)
+}
+func (m *ResourceDrift) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDrift) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDrift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDrift proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{123}
+	return fileDescriptor_030104ce3b95bcac, []int{130}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3712,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{124}
+	return fileDescriptor_030104ce3b95bcac, []int{131}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3740,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{125}
+	return fileDescriptor_030104ce3b95bcac, []int{132}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3768,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{126}
+	return fileDescriptor_030104ce3b95bcac, []int{133}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3796,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{127}
+	return fileDescriptor_030104ce3b95bcac, []int{134}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3824,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{128}
+	return fileDescriptor_030104ce3b95bcac, []int{135}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3852,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{129}
+	return fileDescriptor_030104ce3b95bcac, []int{136}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +3877,38 @@ func (m *ResourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 
+func (m *ResourceSyncPolicy) Reset()      { *m = ResourceSyncPolicy{} }
+func (*ResourceSyncPolicy) ProtoMessage() {}
+func (*ResourceSyncPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{137}
+}
+func (m *ResourceSyncPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceSyncPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceSyncPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSyncPolicy.Merge(m, src)
+}
+func (m *ResourceSyncPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceSyncPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSyncPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceSyncPolicy proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{130}
+	return fileDescriptor_030104ce3b95bcac, []int{138}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3936,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{131}
+	return fileDescriptor_030104ce3b95bcac, []int{139}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3964,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{132}
+	return fileDescriptor_030104ce3b95bcac, []int{140}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3992,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{133}
+	return fileDescriptor_030104ce3b95bcac, []int{141}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4020,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{134}
+	return fileDescriptor_030104ce3b95bcac, []int{142}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4048,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{135}
+	return fileDescriptor_030104ce3b95bcac, []int{143}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4076,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{136}
+	return fileDescriptor_030104ce3b95bcac, []int{144}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4104,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{137}
+	return fileDescriptor_030104ce3b95bcac, []int{145}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4132,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{138}
+	return fileDescriptor_030104ce3b95bcac, []int{146}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4160,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{139}
+	return fileDescriptor_030104ce3b95bcac, []int{147}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4188,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{140}
+	return fileDescriptor_030104ce3b95bcac, []int{148}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{141}
+	return fileDescriptor_030104ce3b95bcac, []int{149}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4017,10 +4241,122 @@ func (m *SCMProviderGeneratorGitlab) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 
+func (m *SCMProviderGeneratorTokenProvider) Reset()      { *m = SCMProviderGeneratorTokenProvider{} }
+func (*SCMProviderGeneratorTokenProvider) ProtoMessage() {}
+func (*SCMProviderGeneratorTokenProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{150}
+}
+func (m *SCMProviderGeneratorTokenProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SCMProviderGeneratorTokenProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SCMProviderGeneratorTokenProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SCMProviderGeneratorTokenProvider.Merge(m, src)
+}
+func (m *SCMProviderGeneratorTokenProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *SCMProviderGeneratorTokenProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_SCMProviderGeneratorTokenProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SCMProviderGeneratorTokenProvider proto.InternalMessageInfo
+
+func (m *SSHSignatureKey) Reset()      { *m = SSHSignatureKey{} }
+func (*SSHSignatureKey) ProtoMessage() {}
+func (*SSHSignatureKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{151}
+}
+func (m *SSHSignatureKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSignatureKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSignatureKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSignatureKey.Merge(m, src)
+}
+func (m *SSHSignatureKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSignatureKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSignatureKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSignatureKey proto.InternalMessageInfo
+
+func (m *SSHSigningKey) Reset()      { *m = SSHSigningKey{} }
+func (*SSHSigningKey) ProtoMessage() {}
+func (*SSHSigningKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{152}
+}
+func (m *SSHSigningKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSigningKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKey.Merge(m, src)
+}
+func (m *SSHSigningKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKey proto.InternalMessageInfo
+
+func (m *SSHSigningKeyList) Reset()      { *m = SSHSigningKeyList{} }
+func (*SSHSigningKeyList) ProtoMessage() {}
+func (*SSHSigningKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{153}
+}
+func (m *SSHSigningKeyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSigningKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyList.Merge(m, src)
+}
+func (m *SSHSigningKeyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyList proto.InternalMessageInfo
+
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{142}
+	return fileDescriptor_030104ce3b95bcac, []int{154}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4384,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{143}
+	return fileDescriptor_030104ce3b95bcac, []int{155}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4073,10 +4409,66 @@ func (m *SignatureKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 
+func (m *SigstoreIdentity) Reset()      { *m = SigstoreIdentity{} }
+func (*SigstoreIdentity) ProtoMessage() {}
+func (*SigstoreIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{156}
+}
+func (m *SigstoreIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigstoreIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SigstoreIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigstoreIdentity.Merge(m, src)
+}
+func (m *SigstoreIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigstoreIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigstoreIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigstoreIdentity proto.InternalMessageInfo
+
+func (m *SyncApproval) Reset()      { *m = SyncApproval{} }
+func (*SyncApproval) ProtoMessage() {}
+func (*SyncApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{157}
+}
+func (m *SyncApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncApproval.Merge(m, src)
+}
+func (m *SyncApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncApproval proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{144}
+	return fileDescriptor_030104ce3b95bcac, []int{158}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4496,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{145}
+	return fileDescriptor_030104ce3b95bcac, []int{159}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4524,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{146}
+	return fileDescriptor_030104ce3b95bcac, []int{160}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4552,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{147}
+	return fileDescriptor_030104ce3b95bcac, []int{161}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4580,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{148}
+	return fileDescriptor_030104ce3b95bcac, []int{162}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4608,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{149}
+	return fileDescriptor_030104ce3b95bcac, []int{163}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4636,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{150}
+	return fileDescriptor_030104ce3b95bcac, []int{164}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4664,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{151}
+	return fileDescriptor_030104ce3b95bcac, []int{165}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4692,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{152}
+	return fileDescriptor_030104ce3b95bcac, []int{166}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4325,10 +4717,38 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncTimeout) Reset()      { *m = SyncTimeout{} }
+func (*SyncTimeout) ProtoMessage() {}
+func (*SyncTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{167}
+}
+func (m *SyncTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTimeout.Merge(m, src)
+}
+func (m *SyncTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTimeout proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{153}
+	return fileDescriptor_030104ce3b95bcac, []int{168}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4776,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{154}
+	return fileDescriptor_030104ce3b95bcac, []int{169}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4804,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{155}
+	return fileDescriptor_030104ce3b95bcac, []int{170}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4409,6 +4829,34 @@ func (m *TagFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TagFilter proto.InternalMessageInfo
 
+func (m *TokenScope) Reset()      { *m = TokenScope{} }
+func (*TokenScope) ProtoMessage() {}
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{171}
+}
+func (m *TokenScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenScope.Merge(m, src)
+}
+func (m *TokenScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenScope proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AWSAuthConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AWSAuthConfig")
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AppProject")
@@ -4476,6 +4924,7 @@ func init() {
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConfigMapKeyRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConfigMapKeyRef")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConnectionState")
+	proto.RegisterType((*CosignSignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.CosignSignatureKey")
 	proto.RegisterType((*DuckTypeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DuckTypeGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.DuckTypeGenerator.ValuesEntry")
 	proto.RegisterType((*EnvEntry)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.EnvEntry")
@@ -4492,6 +4941,10 @@ func init() {
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmPostRenderer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmPostRenderer")
+	proto.RegisterType((*HelmPostRendererKustomize)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmPostRendererKustomize")
+	proto.RegisterType((*HelmValuesFromAllowListEntry)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmValuesFromAllowListEntry")
+	proto.RegisterType((*HelmValuesFromSource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmValuesFromSource")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Info")
@@ -4516,6 +4969,7 @@ func init() {
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationApproval)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationApproval")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OptionalArray)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalArray")
@@ -4550,6 +5004,7 @@ func init() {
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceDrift)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceDrift")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
@@ -4559,6 +5014,7 @@ func init() {
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceStatus")
+	proto.RegisterType((*ResourceSyncPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ResourceSyncPolicy")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RevisionHistory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionHistory")
 	proto.RegisterType((*RevisionMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RevisionMetadata")
@@ -4572,8 +5028,14 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitea")
 	proto.RegisterType((*SCMProviderGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGithub")
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
+	proto.RegisterType((*SCMProviderGeneratorTokenProvider)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorTokenProvider")
+	proto.RegisterType((*SSHSignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SSHSignatureKey")
+	proto.RegisterType((*SSHSigningKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SSHSigningKey")
+	proto.RegisterType((*SSHSigningKeyList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SSHSigningKeyList")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SignatureKey")
+	proto.RegisterType((*SigstoreIdentity)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SigstoreIdentity")
+	proto.RegisterType((*SyncApproval)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncApproval")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -4583,9 +5045,11 @@ func init() {
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncTimeout)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncTimeout")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TagFilter")
+	proto.RegisterType((*TokenScope)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TokenScope")
 }
 
 func init() {
@@ -4593,720 +5057,794 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 11394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0x11, 0x4d, 0xf2, 0x0e, 0xa4, 0xee, 0x0e, 0xf4,
-	0x9c, 0x7d, 0x3a, 0x45, 0x77, 0x80, 0x8f, 0xba, 0x93, 0x2f, 0x3a, 0x4b, 0x32, 0x3e, 0x48, 0x10,
-	0x24, 0x40, 0xe0, 0x1a, 0x20, 0x29, 0x9d, 0x7c, 0x3a, 0x0d, 0x66, 0x1b, 0x8b, 0x21, 0x66, 0x67,
-	0xf6, 0x66, 0x66, 0x41, 0xe2, 0x2c, 0xc9, 0x92, 0x25, 0xd9, 0x72, 0xf4, 0x71, 0x8a, 0x94, 0xaa,
-	0x9c, 0x13, 0x4b, 0x91, 0x2d, 0x27, 0x95, 0x54, 0x4a, 0x15, 0x25, 0xf9, 0x11, 0xa7, 0x6c, 0x97,
-	0x2b, 0x76, 0xca, 0xa5, 0xc4, 0x49, 0xd9, 0xa5, 0x52, 0x59, 0x4a, 0x62, 0x23, 0x12, 0xe3, 0x54,
-	0x5c, 0xf9, 0xe1, 0xaa, 0x38, 0xf9, 0x91, 0x62, 0xf2, 0x23, 0xd5, 0xdf, 0x3d, 0xb3, 0xb3, 0xc0,
-	0x82, 0x18, 0x80, 0x94, 0x72, 0xff, 0x76, 0xfb, 0xbd, 0xe9, 0xf7, 0xa6, 0xa7, 0xfb, 0xbd, 0xd7,
-	0xaf, 0xdf, 0x7b, 0x0d, 0x8b, 0x0d, 0x2f, 0xd9, 0x6c, 0xaf, 0x4f, 0xba, 0x61, 0x73, 0xca, 0x89,
-	0x1a, 0x61, 0x2b, 0x0a, 0x6f, 0xb2, 0x1f, 0x4f, 0xbb, 0xf5, 0xa9, 0xed, 0xf3, 0x53, 0xad, 0xad,
-	0xc6, 0x94, 0xd3, 0xf2, 0xe2, 0x29, 0xa7, 0xd5, 0xf2, 0x3d, 0xd7, 0x49, 0xbc, 0x30, 0x98, 0xda,
-	0x7e, 0xc6, 0xf1, 0x5b, 0x9b, 0xce, 0x33, 0x53, 0x0d, 0x12, 0x90, 0xc8, 0x49, 0x48, 0x7d, 0xb2,
-	0x15, 0x85, 0x49, 0x88, 0x7e, 0x5a, 0xf7, 0x36, 0x29, 0x7b, 0x63, 0x3f, 0x5e, 0x71, 0xeb, 0x93,
-	0xdb, 0xe7, 0x27, 0x5b, 0x5b, 0x8d, 0x49, 0xda, 0xdb, 0xa4, 0xd1, 0xdb, 0xa4, 0xec, 0xed, 0xec,
-	0xd3, 0x06, 0x2f, 0x8d, 0xb0, 0x11, 0x4e, 0xb1, 0x4e, 0xd7, 0xdb, 0x1b, 0xec, 0x1f, 0xfb, 0xc3,
-	0x7e, 0x71, 0x62, 0x67, 0xed, 0xad, 0xe7, 0xe3, 0x49, 0x2f, 0xa4, 0xec, 0x4d, 0xb9, 0x61, 0x44,
-	0xa6, 0xb6, 0x3b, 0x18, 0x3a, 0x7b, 0x49, 0xe3, 0x90, 0xdb, 0x09, 0x09, 0x62, 0x2f, 0x0c, 0xe2,
-	0xa7, 0x29, 0x0b, 0x24, 0xda, 0x26, 0x91, 0xf9, 0x7a, 0x06, 0x42, 0x5e, 0x4f, 0xcf, 0xea, 0x9e,
-	0x9a, 0x8e, 0xbb, 0xe9, 0x05, 0x24, 0xda, 0xd1, 0x8f, 0x37, 0x49, 0xe2, 0xe4, 0x3d, 0x35, 0xd5,
-	0xed, 0xa9, 0xa8, 0x1d, 0x24, 0x5e, 0x93, 0x74, 0x3c, 0xf0, 0xae, 0xfd, 0x1e, 0x88, 0xdd, 0x4d,
-	0xd2, 0x74, 0x3a, 0x9e, 0x7b, 0x67, 0xb7, 0xe7, 0xda, 0x89, 0xe7, 0x4f, 0x79, 0x41, 0x12, 0x27,
-	0x51, 0xf6, 0x21, 0xfb, 0x57, 0x2d, 0x18, 0x9e, 0xbe, 0xb1, 0x3a, 0xdd, 0x4e, 0x36, 0x67, 0xc3,
-	0x60, 0xc3, 0x6b, 0xa0, 0xe7, 0x60, 0xd0, 0xf5, 0xdb, 0x71, 0x42, 0xa2, 0xab, 0x4e, 0x93, 0x8c,
-	0x5b, 0xe7, 0xac, 0x27, 0x6b, 0x33, 0x27, 0xbf, 0xb5, 0x3b, 0xf1, 0x96, 0x3b, 0xbb, 0x13, 0x83,
-	0xb3, 0x1a, 0x84, 0x4d, 0x3c, 0xf4, 0x76, 0x18, 0x88, 0x42, 0x9f, 0x4c, 0xe3, 0xab, 0xe3, 0x25,
-	0xf6, 0xc8, 0xa8, 0x78, 0x64, 0x00, 0xf3, 0x66, 0x2c, 0xe1, 0x14, 0xb5, 0x15, 0x85, 0x1b, 0x9e,
-	0x4f, 0xc6, 0xcb, 0x69, 0xd4, 0x15, 0xde, 0x8c, 0x25, 0xdc, 0xfe, 0x93, 0x12, 0xc0, 0x74, 0xab,
-	0xb5, 0x12, 0x85, 0x37, 0x89, 0x9b, 0xa0, 0x0f, 0x43, 0x95, 0x0e, 0x73, 0xdd, 0x49, 0x1c, 0xc6,
-	0xd8, 0xe0, 0xf9, 0x9f, 0x9c, 0xe4, 0x6f, 0x3d, 0x69, 0xbe, 0xb5, 0x9e, 0x64, 0x14, 0x7b, 0x72,
-	0xfb, 0x99, 0xc9, 0xe5, 0x75, 0xfa, 0xfc, 0x12, 0x49, 0x9c, 0x19, 0x24, 0x88, 0x81, 0x6e, 0xc3,
-	0xaa, 0x57, 0x14, 0x40, 0x5f, 0xdc, 0x22, 0x2e, 0x7b, 0x87, 0xc1, 0xf3, 0x8b, 0x93, 0x87, 0x99,
-	0xcd, 0x93, 0x9a, 0xf3, 0xd5, 0x16, 0x71, 0x67, 0x86, 0x04, 0xe5, 0x3e, 0xfa, 0x0f, 0x33, 0x3a,
-	0x68, 0x1b, 0xfa, 0xe3, 0xc4, 0x49, 0xda, 0x31, 0x1b, 0x8a, 0xc1, 0xf3, 0x57, 0x0b, 0xa3, 0xc8,
-	0x7a, 0x9d, 0x19, 0x11, 0x34, 0xfb, 0xf9, 0x7f, 0x2c, 0xa8, 0xd9, 0x7f, 0x66, 0xc1, 0x88, 0x46,
-	0x5e, 0xf4, 0xe2, 0x04, 0xfd, 0x6c, 0xc7, 0xe0, 0x4e, 0xf6, 0x36, 0xb8, 0xf4, 0x69, 0x36, 0xb4,
-	0x27, 0x04, 0xb1, 0xaa, 0x6c, 0x31, 0x06, 0xb6, 0x09, 0x15, 0x2f, 0x21, 0xcd, 0x78, 0xbc, 0x74,
-	0xae, 0xfc, 0xe4, 0xe0, 0xf9, 0x4b, 0x45, 0xbd, 0xe7, 0xcc, 0xb0, 0x20, 0x5a, 0x59, 0xa0, 0xdd,
-	0x63, 0x4e, 0xc5, 0xfe, 0xab, 0x61, 0xf3, 0xfd, 0xe8, 0x80, 0xa3, 0x67, 0x60, 0x30, 0x0e, 0xdb,
-	0x91, 0x4b, 0x30, 0x69, 0x85, 0xf1, 0xb8, 0x75, 0xae, 0x4c, 0xa7, 0x1e, 0x9d, 0xd4, 0xab, 0xba,
-	0x19, 0x9b, 0x38, 0xe8, 0x0b, 0x16, 0x0c, 0xd5, 0x49, 0x9c, 0x78, 0x01, 0xa3, 0x2f, 0x99, 0x5f,
-	0x3b, 0x34, 0xf3, 0xb2, 0x71, 0x4e, 0x77, 0x3e, 0x73, 0x4a, 0xbc, 0xc8, 0x90, 0xd1, 0x18, 0xe3,
-	0x14, 0x7d, 0xba, 0x38, 0xeb, 0x24, 0x76, 0x23, 0xaf, 0x45, 0xff, 0x8b, 0xe5, 0xa3, 0x16, 0xe7,
-	0x9c, 0x06, 0x61, 0x13, 0x0f, 0x05, 0x50, 0xa1, 0x8b, 0x2f, 0x1e, 0xef, 0x63, 0xfc, 0x2f, 0x1c,
-	0x8e, 0x7f, 0x31, 0xa8, 0x74, 0x5d, 0xeb, 0xd1, 0xa7, 0xff, 0x62, 0xcc, 0xc9, 0xa0, 0xcf, 0x5b,
-	0x30, 0x2e, 0x84, 0x03, 0x26, 0x7c, 0x40, 0x6f, 0x6c, 0x7a, 0x09, 0xf1, 0xbd, 0x38, 0x19, 0xaf,
-	0x30, 0x1e, 0xa6, 0x7a, 0x9b, 0x5b, 0xf3, 0x51, 0xd8, 0x6e, 0x5d, 0xf1, 0x82, 0xfa, 0xcc, 0x39,
-	0x41, 0x69, 0x7c, 0xb6, 0x4b, 0xc7, 0xb8, 0x2b, 0x49, 0xf4, 0x65, 0x0b, 0xce, 0x06, 0x4e, 0x93,
-	0xc4, 0x2d, 0x87, 0x7e, 0x5a, 0x0e, 0x9e, 0xf1, 0x1d, 0x77, 0x8b, 0x71, 0xd4, 0x7f, 0x6f, 0x1c,
-	0xd9, 0x82, 0xa3, 0xb3, 0x57, 0xbb, 0x76, 0x8d, 0xf7, 0x20, 0x8b, 0xbe, 0x6e, 0xc1, 0x58, 0x18,
-	0xb5, 0x36, 0x9d, 0x80, 0xd4, 0x25, 0x34, 0x1e, 0x1f, 0x60, 0x4b, 0xef, 0x43, 0x87, 0xfb, 0x44,
-	0xcb, 0xd9, 0x6e, 0x97, 0xc2, 0xc0, 0x4b, 0xc2, 0x68, 0x95, 0x24, 0x89, 0x17, 0x34, 0xe2, 0x99,
-	0xd3, 0x77, 0x76, 0x27, 0xc6, 0x3a, 0xb0, 0x70, 0x27, 0x3f, 0xe8, 0xe7, 0x60, 0x30, 0xde, 0x09,
-	0xdc, 0x1b, 0x5e, 0x50, 0x0f, 0x6f, 0xc5, 0xe3, 0xd5, 0x22, 0x96, 0xef, 0xaa, 0xea, 0x50, 0x2c,
-	0x40, 0x4d, 0x00, 0x9b, 0xd4, 0xf2, 0x3f, 0x9c, 0x9e, 0x4a, 0xb5, 0xa2, 0x3f, 0x9c, 0x9e, 0x4c,
-	0x7b, 0x90, 0x45, 0xbf, 0x64, 0xc1, 0x70, 0xec, 0x35, 0x02, 0x27, 0x69, 0x47, 0xe4, 0x0a, 0xd9,
-	0x89, 0xc7, 0x81, 0x31, 0x72, 0xf9, 0x90, 0xa3, 0x62, 0x74, 0x39, 0x73, 0x5a, 0xf0, 0x38, 0x6c,
-	0xb6, 0xc6, 0x38, 0x4d, 0x37, 0x6f, 0xa1, 0xe9, 0x69, 0x3d, 0x58, 0xec, 0x42, 0xd3, 0x93, 0xba,
-	0x2b, 0x49, 0xf4, 0x33, 0x70, 0x82, 0x37, 0xa9, 0x91, 0x8d, 0xc7, 0x87, 0x98, 0xa0, 0x3d, 0x75,
-	0x67, 0x77, 0xe2, 0xc4, 0x6a, 0x06, 0x86, 0x3b, 0xb0, 0xd1, 0xab, 0x30, 0xd1, 0x22, 0x51, 0xd3,
-	0x4b, 0x96, 0x03, 0x7f, 0x47, 0x8a, 0x6f, 0x37, 0x6c, 0x91, 0xba, 0x60, 0x27, 0x1e, 0x1f, 0x3e,
-	0x67, 0x3d, 0x59, 0x9d, 0x79, 0x9b, 0x60, 0x73, 0x62, 0x65, 0x6f, 0x74, 0xbc, 0x5f, 0x7f, 0xe8,
-	0x0f, 0x2c, 0x38, 0x6b, 0x48, 0xd9, 0x55, 0x12, 0x6d, 0x7b, 0x2e, 0x99, 0x76, 0xdd, 0xb0, 0x1d,
-	0x24, 0xf1, 0xf8, 0x08, 0x1b, 0xc6, 0xf5, 0xa3, 0x90, 0xf9, 0x69, 0x52, 0x7a, 0x5e, 0x76, 0x45,
-	0x89, 0xf1, 0x1e, 0x9c, 0xda, 0xff, 0xa6, 0x04, 0x27, 0xb2, 0x16, 0x00, 0xfa, 0x07, 0x16, 0x8c,
-	0xde, 0xbc, 0x95, 0xac, 0x85, 0x5b, 0x24, 0x88, 0x67, 0x76, 0xa8, 0x9c, 0x66, 0xba, 0x6f, 0xf0,
-	0xbc, 0x5b, 0xac, 0xad, 0x31, 0x79, 0x39, 0x4d, 0xe5, 0x42, 0x90, 0x44, 0x3b, 0x33, 0x0f, 0x8b,
-	0x77, 0x1a, 0xbd, 0x7c, 0x63, 0xcd, 0x84, 0xe2, 0x2c, 0x53, 0x67, 0x3f, 0x6b, 0xc1, 0xa9, 0xbc,
-	0x2e, 0xd0, 0x09, 0x28, 0x6f, 0x91, 0x1d, 0x6e, 0x89, 0x62, 0xfa, 0x13, 0xbd, 0x0c, 0x95, 0x6d,
-	0xc7, 0x6f, 0x13, 0x61, 0xa6, 0xcd, 0x1f, 0xee, 0x45, 0x14, 0x67, 0x98, 0xf7, 0xfa, 0xee, 0xd2,
-	0xf3, 0x96, 0xfd, 0x47, 0x65, 0x18, 0x34, 0x3e, 0xda, 0x31, 0x98, 0x9e, 0x61, 0xca, 0xf4, 0x5c,
-	0x2a, 0x6c, 0xbe, 0x75, 0xb5, 0x3d, 0x6f, 0x65, 0x6c, 0xcf, 0xe5, 0xe2, 0x48, 0xee, 0x69, 0x7c,
-	0xa2, 0x04, 0x6a, 0x61, 0x8b, 0x6e, 0x43, 0xa8, 0x0d, 0xd3, 0x57, 0xc4, 0x27, 0x5c, 0x96, 0xdd,
-	0xcd, 0x0c, 0xdf, 0xd9, 0x9d, 0xa8, 0xa9, 0xbf, 0x58, 0x13, 0xb2, 0xbf, 0x6b, 0xc1, 0x29, 0x83,
-	0xc7, 0xd9, 0x30, 0xa8, 0x7b, 0xec, 0xd3, 0x9e, 0x83, 0xbe, 0x64, 0xa7, 0x25, 0xb7, 0x3a, 0x6a,
-	0xa4, 0xd6, 0x76, 0x5a, 0x04, 0x33, 0x08, 0xdd, 0xb1, 0x34, 0x49, 0x1c, 0x3b, 0x0d, 0x92, 0xdd,
-	0xdc, 0x2c, 0xf1, 0x66, 0x2c, 0xe1, 0x28, 0x02, 0xe4, 0x3b, 0x71, 0xb2, 0x16, 0x39, 0x41, 0xcc,
-	0xba, 0x5f, 0xf3, 0x9a, 0x44, 0x0c, 0xf0, 0x5f, 0xeb, 0x6d, 0xc6, 0xd0, 0x27, 0x66, 0x1e, 0xba,
-	0xb3, 0x3b, 0x81, 0x16, 0x3b, 0x7a, 0xc2, 0x39, 0xbd, 0xdb, 0x5f, 0xb6, 0xe0, 0xa1, 0x7c, 0x01,
-	0x83, 0x9e, 0x80, 0x7e, 0xbe, 0xcf, 0x15, 0x6f, 0xa7, 0x3f, 0x09, 0x6b, 0xc5, 0x02, 0x8a, 0xa6,
-	0xa0, 0xa6, 0x14, 0x9e, 0x78, 0xc7, 0x31, 0x81, 0x5a, 0xd3, 0x5a, 0x52, 0xe3, 0xd0, 0x41, 0xa3,
-	0x7f, 0x84, 0x09, 0xaa, 0x06, 0x8d, 0x6d, 0x0c, 0x19, 0xc4, 0xfe, 0x8e, 0x05, 0x3f, 0xde, 0x8b,
-	0xd8, 0x3b, 0x3a, 0x1e, 0x57, 0xe1, 0x74, 0x9d, 0x6c, 0x38, 0x6d, 0x3f, 0x49, 0x53, 0x14, 0x4c,
-	0x3f, 0x2a, 0x1e, 0x3e, 0x3d, 0x97, 0x87, 0x84, 0xf3, 0x9f, 0xb5, 0xff, 0xb3, 0x05, 0xa3, 0xc6,
-	0x6b, 0x1d, 0xc3, 0xd6, 0x29, 0x48, 0x6f, 0x9d, 0x16, 0x0a, 0x5b, 0xa6, 0x5d, 0xf6, 0x4e, 0x9f,
-	0xb7, 0xe0, 0xac, 0x81, 0xb5, 0xe4, 0x24, 0xee, 0xe6, 0x85, 0xdb, 0xad, 0x88, 0xc4, 0x31, 0x9d,
-	0x52, 0x8f, 0x1a, 0xe2, 0x78, 0x66, 0x50, 0xf4, 0x50, 0xbe, 0x42, 0x76, 0xb8, 0x6c, 0x7e, 0x0a,
-	0xaa, 0x7c, 0xcd, 0x85, 0x91, 0xf8, 0x48, 0xea, 0xdd, 0x96, 0x45, 0x3b, 0x56, 0x18, 0xc8, 0x86,
-	0x7e, 0x26, 0x73, 0xa9, 0x0c, 0xa2, 0x66, 0x02, 0xd0, 0xef, 0x7e, 0x9d, 0xb5, 0x60, 0x01, 0xb1,
-	0xe3, 0x14, 0x3b, 0x2b, 0x11, 0x61, 0xf3, 0xa1, 0x7e, 0xd1, 0x23, 0x7e, 0x3d, 0xa6, 0xdb, 0x3a,
-	0x27, 0x08, 0xc2, 0x44, 0xec, 0xd0, 0x8c, 0x6d, 0xdd, 0xb4, 0x6e, 0xc6, 0x26, 0x0e, 0x25, 0xea,
-	0x3b, 0xeb, 0xc4, 0xe7, 0x23, 0x2a, 0x88, 0x2e, 0xb2, 0x16, 0x2c, 0x20, 0xf6, 0x9d, 0x12, 0xdb,
-	0x40, 0x2a, 0x89, 0x46, 0x8e, 0xc3, 0xfb, 0x10, 0xa5, 0x54, 0xc0, 0x4a, 0x71, 0xf2, 0x98, 0x74,
-	0xf7, 0x40, 0xbc, 0x96, 0xd1, 0x02, 0xb8, 0x50, 0xaa, 0x7b, 0x7b, 0x21, 0x3e, 0x5e, 0x86, 0x89,
-	0xf4, 0x03, 0x1d, 0x4a, 0x84, 0x6e, 0x79, 0x0d, 0x42, 0x59, 0x7f, 0x94, 0x81, 0x8f, 0x4d, 0xbc,
-	0x2e, 0x72, 0xb8, 0x74, 0x94, 0x72, 0xd8, 0x54, 0x13, 0xe5, 0x7d, 0xd4, 0xc4, 0x13, 0x6a, 0xd4,
-	0xfb, 0x32, 0x32, 0x2f, 0xad, 0x2a, 0xcf, 0x41, 0x5f, 0x9c, 0x90, 0xd6, 0x78, 0x25, 0x2d, 0x66,
-	0x57, 0x13, 0xd2, 0xc2, 0x0c, 0x82, 0xde, 0x03, 0xa3, 0x89, 0x13, 0x35, 0x48, 0x12, 0x91, 0x6d,
-	0x8f, 0xf9, 0x2e, 0xd9, 0x7e, 0xb6, 0x36, 0x73, 0x92, 0x5a, 0x5d, 0x6b, 0x0c, 0x84, 0x25, 0x08,
-	0x67, 0x71, 0xed, 0xff, 0x5e, 0x82, 0x87, 0xd3, 0x9f, 0x40, 0x2b, 0xc6, 0xf7, 0xa5, 0x14, 0xe3,
-	0x3b, 0x4c, 0xc5, 0x78, 0x77, 0x77, 0xe2, 0xad, 0x5d, 0x1e, 0xfb, 0xa1, 0xd1, 0x9b, 0x68, 0x3e,
-	0xf3, 0x11, 0xa6, 0xd2, 0x1f, 0xe1, 0xee, 0xee, 0xc4, 0xa3, 0x5d, 0xde, 0x31, 0xf3, 0x95, 0x9e,
-	0x80, 0xfe, 0x88, 0x38, 0x71, 0x18, 0x88, 0xef, 0xa4, 0xbe, 0x26, 0x66, 0xad, 0x58, 0x40, 0xed,
-	0x6f, 0xd7, 0xb2, 0x83, 0x3d, 0xcf, 0xfd, 0xb1, 0x61, 0x84, 0x3c, 0xe8, 0x63, 0xbb, 0x36, 0x2e,
-	0x59, 0xae, 0x1c, 0x6e, 0x15, 0x52, 0x2d, 0xa2, 0xba, 0x9e, 0xa9, 0xd2, 0xaf, 0x46, 0x9b, 0x30,
-	0x23, 0x81, 0x6e, 0x43, 0xd5, 0x95, 0x9b, 0xa9, 0x52, 0x11, 0x6e, 0x47, 0xb1, 0x95, 0xd2, 0x14,
-	0x87, 0xa8, 0xb8, 0x57, 0x3b, 0x30, 0x45, 0x0d, 0x11, 0x28, 0x37, 0xbc, 0x44, 0x7c, 0xd6, 0x43,
-	0x6e, 0x97, 0xe7, 0x3d, 0xe3, 0x15, 0x07, 0xa8, 0x0e, 0x9a, 0xf7, 0x12, 0x4c, 0xfb, 0x47, 0x9f,
-	0xb6, 0x60, 0x30, 0x76, 0x9b, 0x2b, 0x51, 0xb8, 0xed, 0xd5, 0x49, 0x24, 0x6c, 0xcc, 0x43, 0x4a,
-	0xb6, 0xd5, 0xd9, 0x25, 0xd9, 0xa1, 0xa6, 0xcb, 0xdd, 0x17, 0x1a, 0x82, 0x4d, 0xba, 0x74, 0xef,
-	0xf5, 0xb0, 0x78, 0xf7, 0x39, 0xe2, 0xb2, 0x15, 0x27, 0xf7, 0xcc, 0x6c, 0xa6, 0x1c, 0xda, 0xe6,
-	0x9e, 0x6b, 0xbb, 0x5b, 0x74, 0xbd, 0x69, 0x86, 0xde, 0x7a, 0x67, 0x77, 0xe2, 0xe1, 0xd9, 0x7c,
-	0x9a, 0xb8, 0x1b, 0x33, 0x6c, 0xc0, 0x5a, 0x6d, 0xdf, 0xc7, 0xe4, 0xd5, 0x36, 0x61, 0x1e, 0xb1,
-	0x02, 0x06, 0x6c, 0x45, 0x77, 0x98, 0x19, 0x30, 0x03, 0x82, 0x4d, 0xba, 0xe8, 0x55, 0xe8, 0x6f,
-	0x3a, 0x49, 0xe4, 0xdd, 0x16, 0x6e, 0xb0, 0x43, 0xee, 0x82, 0x96, 0x58, 0x5f, 0x9a, 0x38, 0x53,
-	0xf4, 0xbc, 0x11, 0x0b, 0x42, 0xa8, 0x09, 0x95, 0x26, 0x89, 0x1a, 0x64, 0xbc, 0x5a, 0x84, 0xcb,
-	0x7f, 0x89, 0x76, 0xa5, 0x09, 0xd6, 0xa8, 0x71, 0xc5, 0xda, 0x30, 0xa7, 0x82, 0x5e, 0x86, 0x6a,
-	0x4c, 0x7c, 0xe2, 0x52, 0xf3, 0xa8, 0xc6, 0x28, 0xbe, 0xb3, 0x47, 0x53, 0x91, 0xda, 0x25, 0xab,
-	0xe2, 0x51, 0xbe, 0xc0, 0xe4, 0x3f, 0xac, 0xba, 0xa4, 0x03, 0xd8, 0xf2, 0xdb, 0x0d, 0x2f, 0x18,
-	0x87, 0x22, 0x06, 0x70, 0x85, 0xf5, 0x95, 0x19, 0x40, 0xde, 0x88, 0x05, 0x21, 0xfb, 0xbf, 0x5a,
-	0x80, 0xd2, 0x42, 0xed, 0x18, 0x6c, 0xe2, 0x57, 0xd3, 0x36, 0xf1, 0x62, 0x91, 0x46, 0x4b, 0x17,
-	0xb3, 0xf8, 0xb7, 0x6a, 0x90, 0x51, 0x07, 0x57, 0x49, 0x9c, 0x90, 0xfa, 0x9b, 0x22, 0xfc, 0x4d,
-	0x11, 0xfe, 0xa6, 0x08, 0x57, 0x22, 0x7c, 0x3d, 0x23, 0xc2, 0xdf, 0x6b, 0xac, 0x7a, 0x7d, 0xbe,
-	0xfe, 0x8a, 0x3a, 0x80, 0x37, 0x39, 0x30, 0x10, 0xa8, 0x24, 0xb8, 0xbc, 0xba, 0x7c, 0x35, 0x57,
-	0x66, 0xbf, 0x92, 0x96, 0xd9, 0x87, 0x25, 0xf1, 0xff, 0x83, 0x94, 0xfe, 0x03, 0x0b, 0xde, 0x96,
-	0x96, 0x5e, 0x72, 0xe6, 0x2c, 0x34, 0x82, 0x30, 0x22, 0x73, 0xde, 0xc6, 0x06, 0x89, 0x48, 0xe0,
-	0x92, 0x58, 0xf9, 0x76, 0xac, 0x6e, 0xbe, 0x1d, 0xf4, 0x2c, 0x0c, 0xdd, 0x8c, 0xc3, 0x60, 0x25,
-	0xf4, 0x02, 0x21, 0x82, 0xe8, 0x8e, 0xe3, 0xc4, 0x9d, 0xdd, 0x89, 0x21, 0x3a, 0xa2, 0xb2, 0x1d,
-	0xa7, 0xb0, 0xd0, 0x2c, 0x8c, 0xdd, 0x7c, 0x75, 0xc5, 0x49, 0x0c, 0x6f, 0x82, 0xdc, 0xf7, 0xb3,
-	0xf3, 0xa8, 0xcb, 0x2f, 0x66, 0x80, 0xb8, 0x13, 0xdf, 0xfe, 0xbb, 0x25, 0x38, 0x93, 0x79, 0x91,
-	0xd0, 0xf7, 0xc3, 0x76, 0x42, 0xf7, 0x44, 0xe8, 0xab, 0x16, 0x9c, 0x68, 0xa6, 0x1d, 0x16, 0xb1,
-	0x70, 0x77, 0xbf, 0xbf, 0x30, 0x1d, 0x91, 0xf1, 0x88, 0xcc, 0x8c, 0x8b, 0x11, 0x3a, 0x91, 0x01,
-	0xc4, 0xb8, 0x83, 0x17, 0xf4, 0x32, 0xd4, 0x9a, 0xce, 0xed, 0x6b, 0xad, 0xba, 0x93, 0xc8, 0xed,
-	0x68, 0x77, 0x2f, 0x42, 0x3b, 0xf1, 0xfc, 0x49, 0x1e, 0xb9, 0x31, 0xb9, 0x10, 0x24, 0xcb, 0xd1,
-	0x6a, 0x12, 0x79, 0x41, 0x83, 0x3b, 0x39, 0x97, 0x64, 0x37, 0x58, 0xf7, 0x68, 0x7f, 0xc5, 0xca,
-	0x2a, 0x29, 0x35, 0x3a, 0x91, 0x93, 0x90, 0xc6, 0x0e, 0xfa, 0x08, 0x54, 0xe8, 0xbe, 0x51, 0x8e,
-	0xca, 0x8d, 0x22, 0x35, 0xa7, 0xf1, 0x25, 0xb4, 0x12, 0xa5, 0xff, 0x62, 0xcc, 0x89, 0xda, 0x5f,
-	0xad, 0x65, 0x8d, 0x05, 0x76, 0x36, 0x7f, 0x1e, 0xa0, 0x11, 0xae, 0x91, 0x66, 0xcb, 0xa7, 0xc3,
-	0x62, 0xb1, 0x03, 0x1e, 0xe5, 0x2a, 0x99, 0x57, 0x10, 0x6c, 0x60, 0xa1, 0x5f, 0xb6, 0x00, 0x1a,
-	0x72, 0xce, 0x4b, 0x43, 0xe0, 0x5a, 0x91, 0xaf, 0xa3, 0x57, 0x94, 0xe6, 0x45, 0x11, 0xc4, 0x06,
-	0x71, 0xf4, 0x0b, 0x16, 0x54, 0x13, 0xc9, 0x3e, 0x57, 0x8d, 0x6b, 0x45, 0x72, 0x22, 0x5f, 0x5a,
-	0xdb, 0x44, 0x6a, 0x48, 0x14, 0x5d, 0xf4, 0x8b, 0x16, 0x40, 0xbc, 0x13, 0xb8, 0x2b, 0xa1, 0xef,
-	0xb9, 0x3b, 0x42, 0x63, 0x5e, 0x2f, 0xd4, 0x9d, 0xa3, 0x7a, 0x9f, 0x19, 0xa1, 0xa3, 0xa1, 0xff,
-	0x63, 0x83, 0x32, 0xfa, 0x18, 0x54, 0x63, 0x31, 0xdd, 0x84, 0x8e, 0x5c, 0x2b, 0xd6, 0xa9, 0xc4,
-	0xfb, 0x16, 0xe2, 0x55, 0xfc, 0xc3, 0x8a, 0x26, 0xfa, 0xdb, 0x16, 0x8c, 0xb6, 0xd2, 0x6e, 0x42,
-	0xa1, 0x0e, 0x8b, 0x93, 0x01, 0x19, 0x37, 0x24, 0xf7, 0xb6, 0x64, 0x1a, 0x71, 0x96, 0x0b, 0x2a,
-	0x01, 0xf5, 0x0c, 0x5e, 0x6e, 0x71, 0x97, 0xe5, 0x80, 0x96, 0x80, 0xf3, 0x59, 0x20, 0xee, 0xc4,
-	0x47, 0x2b, 0x70, 0x8a, 0x72, 0xb7, 0xc3, 0xcd, 0x4f, 0xa9, 0x5e, 0x62, 0xa6, 0x0c, 0xab, 0x33,
-	0x8f, 0x88, 0x19, 0xc2, 0xce, 0x3a, 0xb2, 0x38, 0x38, 0xf7, 0x49, 0xf4, 0x47, 0x16, 0x3c, 0xe2,
-	0x31, 0x35, 0x60, 0x3a, 0xec, 0xb5, 0x46, 0x10, 0x07, 0xed, 0xa4, 0x50, 0x59, 0xd1, 0x4d, 0xfd,
-	0xcc, 0xfc, 0xb8, 0x78, 0x83, 0x47, 0x16, 0xf6, 0x60, 0x09, 0xef, 0xc9, 0x30, 0xfa, 0x29, 0x18,
-	0x96, 0xeb, 0x62, 0x85, 0x8a, 0x60, 0xa6, 0x68, 0x6b, 0x33, 0x63, 0x77, 0x76, 0x27, 0x86, 0xd7,
-	0x4c, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xb6, 0x9c, 0x3a, 0x25, 0x52, 0x3e, 0x4c, 0x26, 0x6e, 0x5c,
-	0xe9, 0xff, 0x91, 0xd2, 0xb3, 0x50, 0x71, 0xa3, 0xbc, 0x4b, 0x5a, 0xdc, 0xa8, 0xa6, 0x18, 0x1b,
-	0xc4, 0xa9, 0x51, 0x3a, 0xe6, 0x64, 0x3d, 0xa5, 0x42, 0x02, 0xbe, 0x5c, 0x24, 0x4b, 0x9d, 0x67,
-	0x7a, 0x67, 0x04, 0x6b, 0x63, 0x1d, 0x20, 0xdc, 0xc9, 0x12, 0xfa, 0x28, 0xd4, 0x22, 0x15, 0xd9,
-	0x52, 0x2e, 0x62, 0xab, 0x26, 0xa7, 0x8d, 0x60, 0x47, 0x1d, 0x00, 0xe9, 0x18, 0x16, 0x4d, 0xd1,
-	0xfe, 0xc3, 0xf4, 0xc1, 0x98, 0x21, 0x3b, 0x7a, 0x38, 0xf4, 0xfb, 0x82, 0x05, 0x83, 0x51, 0xe8,
-	0xfb, 0x5e, 0xd0, 0xa0, 0x72, 0x4e, 0x28, 0xeb, 0x0f, 0x1e, 0x89, 0xbe, 0x14, 0x02, 0x8d, 0x59,
-	0xd6, 0x58, 0xd3, 0xc4, 0x26, 0x03, 0xf6, 0x9f, 0x59, 0x30, 0xde, 0x4d, 0x1e, 0x23, 0x02, 0x6f,
-	0x95, 0xc2, 0x46, 0x0d, 0xc5, 0x72, 0x30, 0x47, 0x7c, 0xa2, 0xdc, 0xe6, 0xd5, 0x99, 0xc7, 0xc5,
-	0x6b, 0xbe, 0x75, 0xa5, 0x3b, 0x2a, 0xde, 0xab, 0x1f, 0xf4, 0x12, 0x9c, 0x30, 0xde, 0x2b, 0x56,
-	0x03, 0x53, 0x9b, 0x99, 0xa4, 0x06, 0xd0, 0x74, 0x06, 0x76, 0x77, 0x77, 0xe2, 0xa1, 0x6c, 0x9b,
-	0x50, 0x18, 0x1d, 0xfd, 0xd8, 0xbf, 0x51, 0xca, 0x7e, 0x2d, 0xa5, 0xeb, 0xdf, 0xb0, 0x3a, 0xbc,
-	0x09, 0xef, 0x3f, 0x0a, 0xfd, 0xca, 0xfc, 0x0e, 0x2a, 0x0c, 0xa3, 0x3b, 0xce, 0x7d, 0x3c, 0xb6,
-	0xb7, 0xff, 0x5d, 0x1f, 0xec, 0xc1, 0x59, 0x0f, 0xc6, 0xfb, 0x81, 0xcf, 0x51, 0x3f, 0x67, 0xa9,
-	0x03, 0x33, 0xbe, 0x86, 0xeb, 0x47, 0x35, 0xf6, 0x7c, 0xff, 0x14, 0xf3, 0xd0, 0x11, 0xe5, 0x45,
-	0x4f, 0x1f, 0xcd, 0xa1, 0xaf, 0x59, 0xe9, 0x23, 0x3f, 0x1e, 0xd4, 0xe8, 0x1d, 0x19, 0x4f, 0xc6,
-	0x39, 0x22, 0x67, 0x4c, 0x9f, 0x3e, 0x75, 0x3b, 0x61, 0x9c, 0x04, 0xd8, 0xf0, 0x02, 0xc7, 0xf7,
-	0x5e, 0xa3, 0xbb, 0xa3, 0x0a, 0x53, 0xf0, 0xcc, 0x62, 0xba, 0xa8, 0x5a, 0xb1, 0x81, 0x71, 0xf6,
-	0xaf, 0xc3, 0xa0, 0xf1, 0xe6, 0x39, 0x11, 0x2f, 0xa7, 0xcc, 0x88, 0x97, 0x9a, 0x11, 0xa8, 0x72,
-	0xf6, 0xbd, 0x70, 0x22, 0xcb, 0xe0, 0x41, 0x9e, 0xb7, 0xff, 0xf7, 0x40, 0xf6, 0x0c, 0x6e, 0x8d,
-	0x44, 0x4d, 0xca, 0xda, 0x9b, 0x8e, 0xad, 0x37, 0x1d, 0x5b, 0x6f, 0x3a, 0xb6, 0xcc, 0xb3, 0x09,
-	0xe1, 0xb4, 0x19, 0x38, 0x26, 0xa7, 0x4d, 0xca, 0x0d, 0x55, 0x2d, 0xdc, 0x0d, 0x65, 0x7f, 0xba,
-	0xc3, 0x73, 0xbf, 0x16, 0x11, 0x82, 0x42, 0xa8, 0x04, 0x61, 0x9d, 0x48, 0x1b, 0xf7, 0x72, 0x31,
-	0x06, 0xdb, 0xd5, 0xb0, 0x6e, 0x84, 0x8b, 0xd3, 0x7f, 0x31, 0xe6, 0x74, 0xec, 0x3b, 0x15, 0x48,
-	0x99, 0x93, 0xfc, 0xbb, 0xbf, 0x1d, 0x06, 0x22, 0xd2, 0x0a, 0xaf, 0xe1, 0x45, 0xa1, 0xcb, 0x74,
-	0x46, 0x09, 0x6f, 0xc6, 0x12, 0x4e, 0x75, 0x5e, 0xcb, 0x49, 0x36, 0x85, 0x32, 0x53, 0x3a, 0x6f,
-	0xc5, 0x49, 0x36, 0x31, 0x83, 0xa0, 0xf7, 0xc2, 0x48, 0x92, 0x3a, 0x0a, 0x17, 0x47, 0xbe, 0x0f,
-	0x09, 0xdc, 0x91, 0xf4, 0x41, 0x39, 0xce, 0x60, 0xa3, 0x57, 0xa1, 0x6f, 0x93, 0xf8, 0x4d, 0xf1,
-	0xe9, 0x57, 0x8b, 0xd3, 0x35, 0xec, 0x5d, 0x2f, 0x11, 0xbf, 0xc9, 0x25, 0x21, 0xfd, 0x85, 0x19,
-	0x29, 0x3a, 0xef, 0x6b, 0x5b, 0xed, 0x38, 0x09, 0x9b, 0xde, 0x6b, 0xd2, 0xd3, 0xf9, 0xfe, 0x82,
-	0x09, 0x5f, 0x91, 0xfd, 0x73, 0x97, 0x92, 0xfa, 0x8b, 0x35, 0x65, 0xc6, 0x47, 0xdd, 0x8b, 0xd8,
-	0x94, 0xd9, 0x11, 0x0e, 0xcb, 0xa2, 0xf9, 0x98, 0x93, 0xfd, 0x73, 0x3e, 0xd4, 0x5f, 0xac, 0x29,
-	0xa3, 0x1d, 0xb5, 0xfe, 0x06, 0x19, 0x0f, 0xd7, 0x0a, 0xe6, 0x81, 0xaf, 0xbd, 0xdc, 0x75, 0xf8,
-	0x38, 0x54, 0xdc, 0x4d, 0x27, 0x4a, 0xc6, 0x87, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x96, 0x36, 0x62,
-	0x0e, 0x43, 0x8f, 0x42, 0x39, 0x22, 0x1b, 0x2c, 0x3a, 0xd9, 0x88, 0x8b, 0xc2, 0x64, 0x03, 0xd3,
-	0x76, 0xfb, 0xd7, 0x4a, 0x69, 0xb3, 0x2d, 0xfd, 0xde, 0x7c, 0xb6, 0xbb, 0xed, 0x28, 0x96, 0xee,
-	0x2f, 0x63, 0xb6, 0xb3, 0x66, 0x2c, 0xe1, 0xe8, 0x13, 0x16, 0x0c, 0xdc, 0x8c, 0xc3, 0x20, 0x20,
-	0x89, 0x50, 0x91, 0xd7, 0x0b, 0x1e, 0x8a, 0xcb, 0xbc, 0x77, 0xcd, 0x83, 0x68, 0xc0, 0x92, 0x2e,
-	0x65, 0x97, 0xdc, 0x76, 0xfd, 0x76, 0xbd, 0x23, 0xd4, 0xe5, 0x02, 0x6f, 0xc6, 0x12, 0x4e, 0x51,
-	0xbd, 0x80, 0xa3, 0xf6, 0xa5, 0x51, 0x17, 0x02, 0x81, 0x2a, 0xe0, 0xf6, 0x0f, 0x06, 0xe0, 0x74,
-	0xee, 0xe2, 0xa0, 0x06, 0x15, 0x33, 0x59, 0x2e, 0x7a, 0x3e, 0x91, 0x41, 0x5e, 0xcc, 0xa0, 0xba,
-	0xae, 0x5a, 0xb1, 0x81, 0x81, 0x7e, 0x1e, 0xa0, 0xe5, 0x44, 0x4e, 0x93, 0x28, 0xf7, 0xf4, 0xa1,
-	0xed, 0x16, 0xca, 0xc7, 0x8a, 0xec, 0x53, 0x6f, 0xd1, 0x55, 0x53, 0x8c, 0x0d, 0x92, 0xe8, 0x39,
-	0x18, 0x8c, 0x88, 0x4f, 0x9c, 0x98, 0x05, 0xb7, 0x67, 0x33, 0x75, 0xb0, 0x06, 0x61, 0x13, 0x0f,
-	0x3d, 0xa1, 0xe2, 0xe1, 0x32, 0x71, 0x41, 0xe9, 0x98, 0x38, 0xf4, 0xba, 0x05, 0x23, 0x1b, 0x9e,
-	0x4f, 0x34, 0x75, 0x91, 0x57, 0xb3, 0x7c, 0xf8, 0x97, 0xbc, 0x68, 0xf6, 0xab, 0x25, 0x64, 0xaa,
-	0x39, 0xc6, 0x19, 0xf2, 0xf4, 0x33, 0x6f, 0x93, 0x88, 0x89, 0xd6, 0xfe, 0xf4, 0x67, 0xbe, 0xce,
-	0x9b, 0xb1, 0x84, 0xa3, 0x69, 0x18, 0x6d, 0x39, 0x71, 0x3c, 0x1b, 0x91, 0x3a, 0x09, 0x12, 0xcf,
-	0xf1, 0x79, 0xd6, 0x4b, 0x55, 0x07, 0x8b, 0xaf, 0xa4, 0xc1, 0x38, 0x8b, 0x8f, 0x3e, 0x00, 0x0f,
-	0x73, 0xff, 0xcf, 0x92, 0x17, 0xc7, 0x5e, 0xd0, 0xd0, 0xd3, 0x40, 0xb8, 0xc1, 0x26, 0x44, 0x57,
-	0x0f, 0x2f, 0xe4, 0xa3, 0xe1, 0x6e, 0xcf, 0xa3, 0xa7, 0xa0, 0x1a, 0x6f, 0x79, 0xad, 0xd9, 0xa8,
-	0x1e, 0xb3, 0xb3, 0x9f, 0xaa, 0x76, 0xba, 0xae, 0x8a, 0x76, 0xac, 0x30, 0x90, 0x0b, 0x43, 0xfc,
-	0x93, 0xf0, 0x80, 0x3e, 0x21, 0x1f, 0x9f, 0xee, 0xaa, 0xa6, 0x45, 0x12, 0xe7, 0x24, 0x76, 0x6e,
-	0x5d, 0x90, 0x27, 0x51, 0xfc, 0xe0, 0xe4, 0xba, 0xd1, 0x0d, 0x4e, 0x75, 0x9a, 0xde, 0xb1, 0x0d,
-	0xf6, 0xb0, 0x63, 0x7b, 0x0e, 0x06, 0xb7, 0xda, 0xeb, 0x44, 0x8c, 0xbc, 0x10, 0x5b, 0x6a, 0xf6,
-	0x5d, 0xd1, 0x20, 0x6c, 0xe2, 0xb1, 0x58, 0xca, 0x96, 0x27, 0xfe, 0xc5, 0xe3, 0xc3, 0x46, 0x2c,
-	0xe5, 0xca, 0x82, 0x6c, 0xc6, 0x26, 0x0e, 0x65, 0x8d, 0x8e, 0xc5, 0x1a, 0x89, 0x59, 0xaa, 0x04,
-	0x1d, 0x2e, 0xc5, 0xda, 0xaa, 0x04, 0x60, 0x8d, 0x63, 0xff, 0x4a, 0x29, 0xed, 0xc5, 0x30, 0x05,
-	0x0e, 0x8a, 0xa9, 0x58, 0x49, 0xae, 0x3b, 0x91, 0x34, 0x3e, 0x0e, 0x99, 0x68, 0x24, 0xfa, 0xbd,
-	0xee, 0x44, 0xa6, 0x80, 0x62, 0x04, 0xb0, 0xa4, 0x84, 0x6e, 0x42, 0x5f, 0xe2, 0x3b, 0x05, 0x65,
-	0x26, 0x1a, 0x14, 0xb5, 0x53, 0x69, 0x71, 0x3a, 0xc6, 0x8c, 0x06, 0x7a, 0x84, 0xee, 0xa4, 0xd6,
-	0xe5, 0xa9, 0x97, 0xd8, 0xfc, 0xac, 0xc7, 0x98, 0xb5, 0xda, 0x7f, 0x3e, 0x98, 0xa3, 0x23, 0x94,
-	0x52, 0x46, 0xe7, 0x01, 0xe8, 0x27, 0x5e, 0x89, 0xc8, 0x86, 0x77, 0x5b, 0x18, 0x45, 0x4a, 0x0e,
-	0x5d, 0x55, 0x10, 0x6c, 0x60, 0xc9, 0x67, 0x56, 0xdb, 0x1b, 0xf4, 0x99, 0x52, 0xe7, 0x33, 0x1c,
-	0x82, 0x0d, 0x2c, 0xf4, 0x2c, 0xf4, 0x7b, 0x4d, 0xa7, 0xa1, 0x82, 0x72, 0x1f, 0xa1, 0x02, 0x68,
-	0x81, 0xb5, 0xdc, 0xdd, 0x9d, 0x18, 0x51, 0x0c, 0xb1, 0x26, 0x2c, 0x70, 0xd1, 0x6f, 0x58, 0x30,
-	0xe4, 0x86, 0xcd, 0x66, 0x18, 0xf0, 0xad, 0xac, 0xd8, 0x97, 0xdf, 0x3c, 0x2a, 0x93, 0x65, 0x72,
-	0xd6, 0x20, 0xc6, 0x37, 0xe6, 0x2a, 0x85, 0xd2, 0x04, 0xe1, 0x14, 0x57, 0xa6, 0x9c, 0xaa, 0xec,
-	0x23, 0xa7, 0x7e, 0xd3, 0x82, 0x31, 0xfe, 0xac, 0xb1, 0xc3, 0x16, 0xd9, 0x82, 0xe1, 0x11, 0xbf,
-	0x56, 0x87, 0xd3, 0x41, 0x39, 0x5e, 0x3b, 0xe0, 0xb8, 0x93, 0x49, 0x34, 0x0f, 0x63, 0x1b, 0x61,
-	0xe4, 0x12, 0x73, 0x20, 0x84, 0x90, 0x55, 0x1d, 0x5d, 0xcc, 0x22, 0xe0, 0xce, 0x67, 0xd0, 0x75,
-	0x78, 0xc8, 0x68, 0x34, 0xc7, 0x81, 0xcb, 0xd9, 0xc7, 0x44, 0x6f, 0x0f, 0x5d, 0xcc, 0xc5, 0xc2,
-	0x5d, 0x9e, 0x4e, 0x8b, 0xb4, 0x5a, 0x0f, 0x22, 0xed, 0x15, 0x38, 0xe3, 0x76, 0x8e, 0xcc, 0x76,
-	0xdc, 0x5e, 0x8f, 0xb9, 0xd4, 0xad, 0xce, 0xfc, 0x98, 0xe8, 0xe0, 0xcc, 0x6c, 0x37, 0x44, 0xdc,
-	0xbd, 0x0f, 0xf4, 0x11, 0xa8, 0x46, 0x84, 0x7d, 0x95, 0x58, 0xa4, 0xce, 0x1d, 0xd2, 0xf3, 0xa0,
-	0xad, 0x69, 0xde, 0xad, 0xd6, 0x23, 0xa2, 0x21, 0xc6, 0x8a, 0x22, 0xba, 0x05, 0x03, 0x2d, 0x27,
-	0x71, 0x37, 0x45, 0xc2, 0xdc, 0xa1, 0xfd, 0xe4, 0x8a, 0x38, 0x3b, 0xd6, 0x30, 0x52, 0xec, 0x39,
-	0x11, 0x2c, 0xa9, 0x51, 0xcb, 0xca, 0x0d, 0x9b, 0xad, 0x30, 0x20, 0x41, 0x22, 0x45, 0xfe, 0x08,
-	0x3f, 0x7b, 0x90, 0xad, 0xd8, 0xc0, 0x40, 0x2b, 0x70, 0x8a, 0xf9, 0xe1, 0x6e, 0x78, 0xc9, 0x66,
-	0xd8, 0x4e, 0xe4, 0xb6, 0x52, 0xc8, 0x7e, 0x75, 0xfa, 0xb4, 0x98, 0x83, 0x83, 0x73, 0x9f, 0xcc,
-	0x2a, 0xab, 0xd1, 0x7b, 0x53, 0x56, 0x27, 0xf6, 0x57, 0x56, 0x67, 0xdf, 0x07, 0x63, 0x1d, 0x42,
-	0xe3, 0x40, 0xce, 0xb6, 0x39, 0x78, 0x28, 0x7f, 0x79, 0x1e, 0xc8, 0xe5, 0xf6, 0xcf, 0x33, 0x31,
-	0xd7, 0xc6, 0xf6, 0xa3, 0x07, 0xf7, 0xad, 0x03, 0x65, 0x12, 0x6c, 0x0b, 0x6d, 0x75, 0xf1, 0x70,
-	0xb3, 0xe4, 0x42, 0xb0, 0xcd, 0xa5, 0x0b, 0xf3, 0x51, 0x5d, 0x08, 0xb6, 0x31, 0xed, 0x1b, 0x7d,
-	0xc9, 0x4a, 0x99, 0xcf, 0xdc, 0xe9, 0xfb, 0xa1, 0x23, 0xd9, 0x6f, 0xf5, 0x6c, 0x51, 0xdb, 0xff,
-	0xbe, 0x04, 0xe7, 0xf6, 0xeb, 0xa4, 0x87, 0xe1, 0x7b, 0x1c, 0xfa, 0x63, 0x16, 0x45, 0x21, 0xc4,
-	0xff, 0x20, 0x5d, 0x15, 0x3c, 0xae, 0xe2, 0x15, 0x2c, 0x40, 0xc8, 0x87, 0x72, 0xd3, 0x69, 0x09,
-	0x5f, 0xe0, 0xc2, 0x61, 0x73, 0xd3, 0xe8, 0x7f, 0xc7, 0x5f, 0x72, 0x5a, 0x7c, 0x7a, 0x1a, 0x0d,
-	0x98, 0x92, 0x41, 0x09, 0x54, 0x9c, 0x28, 0x72, 0xe4, 0x91, 0xfd, 0x95, 0x62, 0xe8, 0x4d, 0xd3,
-	0x2e, 0xf9, 0x89, 0x67, 0xaa, 0x09, 0x73, 0x62, 0xf6, 0xe7, 0x06, 0x52, 0x89, 0x4c, 0x2c, 0x0e,
-	0x23, 0x86, 0x7e, 0xe1, 0x02, 0xb4, 0x8a, 0x4e, 0x09, 0xe4, 0x99, 0xc2, 0x6c, 0x77, 0x2d, 0xea,
-	0x2d, 0x08, 0x52, 0xe8, 0xb3, 0x16, 0xab, 0x6a, 0x20, 0xb3, 0xc3, 0xc4, 0x9e, 0xf6, 0x68, 0x8a,
-	0x2c, 0x98, 0xb5, 0x12, 0x64, 0x23, 0x36, 0xa9, 0x8b, 0xea, 0x24, 0xcc, 0x96, 0xef, 0xac, 0x4e,
-	0xc2, 0x6c, 0x73, 0x09, 0x47, 0xb7, 0x73, 0xe2, 0x2d, 0x0a, 0xc8, 0x8c, 0xef, 0x21, 0xc2, 0xe2,
-	0x6b, 0x16, 0x8c, 0x79, 0xd9, 0x83, 0x73, 0xb1, 0x03, 0xbc, 0x51, 0x8c, 0xbf, 0xae, 0xf3, 0x5c,
-	0x5e, 0x19, 0x0e, 0x1d, 0x20, 0xdc, 0xc9, 0x0c, 0xaa, 0x43, 0x9f, 0x17, 0x6c, 0x84, 0xc2, 0x5c,
-	0x9a, 0x39, 0x1c, 0x53, 0x0b, 0xc1, 0x46, 0xa8, 0x57, 0x33, 0xfd, 0x87, 0x59, 0xef, 0x68, 0x11,
-	0x4e, 0xc9, 0x5c, 0x96, 0x4b, 0x5e, 0x9c, 0x84, 0xd1, 0xce, 0xa2, 0xd7, 0xf4, 0x12, 0x66, 0xea,
-	0x94, 0x67, 0xc6, 0xa9, 0x26, 0xc2, 0x39, 0x70, 0x9c, 0xfb, 0x14, 0x7a, 0x0d, 0x06, 0xe4, 0x61,
-	0x75, 0xb5, 0x88, 0xdd, 0x74, 0xe7, 0xfc, 0x57, 0x93, 0x69, 0x55, 0x9c, 0x56, 0x4b, 0x82, 0xf6,
-	0xeb, 0x83, 0xd0, 0x79, 0xa6, 0x9e, 0x3e, 0x40, 0xb7, 0x8e, 0xfb, 0x00, 0x9d, 0x6e, 0x8d, 0x62,
-	0x7d, 0xf6, 0x5d, 0xc0, 0xdc, 0x16, 0x54, 0xf5, 0xb9, 0xe6, 0x4e, 0xe0, 0x62, 0x46, 0x03, 0x45,
-	0xd0, 0xbf, 0x49, 0x1c, 0x3f, 0xd9, 0x2c, 0xe6, 0x08, 0xe6, 0x12, 0xeb, 0x2b, 0x9b, 0x80, 0xc6,
-	0x5b, 0xb1, 0xa0, 0x84, 0x6e, 0xc3, 0xc0, 0x26, 0x9f, 0x00, 0x62, 0xb7, 0xb2, 0x74, 0xd8, 0xc1,
-	0x4d, 0xcd, 0x2a, 0xfd, 0xb9, 0x45, 0x03, 0x96, 0xe4, 0x58, 0xb0, 0x96, 0x11, 0x4e, 0xc2, 0x97,
-	0x6e, 0x71, 0xb9, 0x77, 0xbd, 0xc7, 0x92, 0x7c, 0x18, 0x86, 0x22, 0xe2, 0x86, 0x81, 0xeb, 0xf9,
-	0xa4, 0x3e, 0x2d, 0x8f, 0x57, 0x0e, 0x92, 0x72, 0xc5, 0xbc, 0x17, 0xd8, 0xe8, 0x03, 0xa7, 0x7a,
-	0x44, 0x9f, 0xb1, 0x60, 0x44, 0xa5, 0x61, 0xd3, 0x0f, 0x42, 0x84, 0x1b, 0x7d, 0xb1, 0xa0, 0xa4,
-	0x6f, 0xd6, 0xe7, 0x0c, 0xba, 0xb3, 0x3b, 0x31, 0x92, 0x6e, 0xc3, 0x19, 0xba, 0xe8, 0x25, 0x80,
-	0x70, 0x9d, 0x47, 0x64, 0x4d, 0x27, 0xc2, 0xa7, 0x7e, 0x90, 0x57, 0x1d, 0xe1, 0xa9, 0x9b, 0xb2,
-	0x07, 0x6c, 0xf4, 0x86, 0xae, 0x00, 0xf0, 0x65, 0xb3, 0xb6, 0xd3, 0x92, 0x5b, 0x1a, 0x99, 0x33,
-	0x07, 0xab, 0x0a, 0x72, 0x77, 0x77, 0xa2, 0xd3, 0xc7, 0xc9, 0xc2, 0x4e, 0x8c, 0xc7, 0xd1, 0xcf,
-	0xc1, 0x40, 0xdc, 0x6e, 0x36, 0x1d, 0xe5, 0x71, 0x2f, 0x30, 0x19, 0x94, 0xf7, 0x6b, 0x88, 0x22,
-	0xde, 0x80, 0x25, 0x45, 0x74, 0x93, 0x0a, 0xd5, 0x58, 0x38, 0x5f, 0xd9, 0x2a, 0xe2, 0x36, 0x01,
-	0xf7, 0x3c, 0xbd, 0x4b, 0x9a, 0xf8, 0x38, 0x07, 0xe7, 0xee, 0xee, 0xc4, 0x43, 0xe9, 0xf6, 0xc5,
-	0x50, 0xa4, 0x67, 0xe6, 0xf6, 0x89, 0x2e, 0xcb, 0xaa, 0x4c, 0xf4, 0xb5, 0x65, 0xb1, 0x90, 0x27,
-	0x75, 0x55, 0x26, 0xd6, 0xdc, 0x7d, 0xcc, 0xcc, 0x87, 0xd1, 0x12, 0x9c, 0x74, 0xc3, 0x20, 0x89,
-	0x42, 0xdf, 0xe7, 0x55, 0xc9, 0xf8, 0xee, 0x92, 0x7b, 0xe4, 0xdf, 0x2a, 0xd8, 0x3e, 0x39, 0xdb,
-	0x89, 0x82, 0xf3, 0x9e, 0xb3, 0x83, 0xf4, 0xe9, 0x98, 0x18, 0x9c, 0x67, 0x61, 0x88, 0xdc, 0x4e,
-	0x48, 0x14, 0x38, 0xfe, 0x35, 0xbc, 0x28, 0x7d, 0xd1, 0x6c, 0x0d, 0x5c, 0x30, 0xda, 0x71, 0x0a,
-	0x0b, 0xd9, 0xca, 0xa5, 0x62, 0xa4, 0x1c, 0x73, 0x97, 0x8a, 0x74, 0xa0, 0xd8, 0xdf, 0x2c, 0xa7,
-	0x0c, 0xb2, 0xfb, 0x72, 0x16, 0xc7, 0x6a, 0xdb, 0xc8, 0x22, 0x40, 0x0c, 0x20, 0x36, 0x1a, 0x45,
-	0x52, 0x56, 0xb5, 0x6d, 0x96, 0x4d, 0x42, 0x38, 0x4d, 0x17, 0x6d, 0x41, 0x65, 0x33, 0x8c, 0x13,
-	0xb9, 0xfd, 0x38, 0xe4, 0x4e, 0xe7, 0x52, 0x18, 0x27, 0xcc, 0x8a, 0x50, 0xaf, 0x4d, 0x5b, 0x62,
-	0xcc, 0x69, 0xd0, 0x3d, 0x68, 0xbc, 0xe9, 0x44, 0xf5, 0x78, 0x96, 0x15, 0x08, 0xe8, 0x63, 0xe6,
-	0x83, 0x32, 0x16, 0x57, 0x35, 0x08, 0x9b, 0x78, 0xf6, 0x7f, 0xb3, 0x52, 0x07, 0x16, 0x37, 0x58,
-	0xb4, 0xf7, 0x36, 0x09, 0xa8, 0x34, 0x30, 0xe3, 0xcb, 0x7e, 0x2a, 0x93, 0x3b, 0xfb, 0xb6, 0x6e,
-	0xb5, 0xfa, 0x6e, 0xd1, 0x1e, 0x26, 0x59, 0x17, 0x46, 0x28, 0xda, 0xc7, 0xad, 0x74, 0x12, 0x74,
-	0xa9, 0x88, 0x7d, 0x89, 0x59, 0x08, 0x60, 0xdf, 0x7c, 0x6a, 0xfb, 0x4b, 0x16, 0x0c, 0xcc, 0x38,
-	0xee, 0x56, 0xb8, 0xb1, 0x81, 0x9e, 0x82, 0x6a, 0xbd, 0x1d, 0x99, 0xf9, 0xd8, 0xca, 0xb3, 0x31,
-	0x27, 0xda, 0xb1, 0xc2, 0xa0, 0x53, 0x7f, 0xc3, 0x71, 0x65, 0x39, 0x80, 0x32, 0x9f, 0xfa, 0x17,
-	0x59, 0x0b, 0x16, 0x10, 0x3a, 0xfc, 0x4d, 0xe7, 0xb6, 0x7c, 0x38, 0x7b, 0x5a, 0xb2, 0xa4, 0x41,
-	0xd8, 0xc4, 0xb3, 0xff, 0xb5, 0x05, 0xe3, 0x33, 0x4e, 0xec, 0xb9, 0xd3, 0xed, 0x64, 0x73, 0xc6,
-	0x4b, 0xd6, 0xdb, 0xee, 0x16, 0x49, 0x78, 0xd9, 0x08, 0xca, 0x65, 0x3b, 0xa6, 0x2b, 0x50, 0x6d,
-	0x07, 0x15, 0x97, 0xd7, 0x44, 0x3b, 0x56, 0x18, 0xe8, 0x35, 0x18, 0x6c, 0x39, 0x71, 0x7c, 0x2b,
-	0x8c, 0xea, 0x98, 0x6c, 0x14, 0x53, 0x58, 0x66, 0x95, 0xb8, 0x11, 0x49, 0x30, 0xd9, 0x10, 0x91,
-	0x05, 0xba, 0x7f, 0x6c, 0x12, 0xb3, 0x7f, 0xd9, 0x82, 0x53, 0x33, 0xc4, 0x89, 0x48, 0xc4, 0xea,
-	0xd0, 0xa8, 0x17, 0x41, 0xaf, 0x42, 0x35, 0xa1, 0x2d, 0x94, 0x23, 0xab, 0x58, 0x8e, 0x58, 0x4c,
-	0xc0, 0x9a, 0xe8, 0x1c, 0x2b, 0x32, 0xf6, 0x17, 0x2c, 0x38, 0x93, 0xc7, 0xcb, 0xac, 0x1f, 0xb6,
-	0xeb, 0xf7, 0x83, 0xa1, 0xbf, 0x63, 0xc1, 0x10, 0x3b, 0x67, 0x9d, 0x23, 0x89, 0xe3, 0xf9, 0x1d,
-	0x35, 0xf0, 0xac, 0x1e, 0x6b, 0xe0, 0x9d, 0x83, 0xbe, 0xcd, 0xb0, 0x49, 0xb2, 0x31, 0x02, 0x97,
-	0xc2, 0x26, 0xc1, 0x0c, 0x82, 0x9e, 0xa1, 0x93, 0xd0, 0x0b, 0x12, 0x87, 0x2e, 0x47, 0xe9, 0xfb,
-	0x1e, 0xe5, 0x13, 0x50, 0x35, 0x63, 0x13, 0xc7, 0xfe, 0x57, 0x35, 0x18, 0x10, 0x01, 0x2d, 0x3d,
-	0x97, 0x31, 0x91, 0x2e, 0x8a, 0x52, 0x57, 0x17, 0x45, 0x0c, 0xfd, 0x2e, 0x2b, 0xc6, 0x29, 0x2c,
-	0xe1, 0x2b, 0x85, 0x44, 0x40, 0xf1, 0xfa, 0x9e, 0x9a, 0x2d, 0xfe, 0x1f, 0x0b, 0x52, 0xe8, 0x8b,
-	0x16, 0x8c, 0xba, 0x61, 0x10, 0x10, 0x57, 0x9b, 0x69, 0x7d, 0x45, 0x04, 0xba, 0xcc, 0xa6, 0x3b,
-	0xd5, 0x87, 0x7c, 0x19, 0x00, 0xce, 0x92, 0x47, 0x2f, 0xc0, 0x30, 0x1f, 0xb3, 0xeb, 0x29, 0x87,
-	0xbd, 0x2e, 0x8d, 0x66, 0x02, 0x71, 0x1a, 0x17, 0x4d, 0xf2, 0x83, 0x0f, 0x51, 0x84, 0xac, 0x5f,
-	0xfb, 0x35, 0x8d, 0xf2, 0x63, 0x06, 0x06, 0x8a, 0x00, 0x45, 0x64, 0x23, 0x22, 0xf1, 0xa6, 0x08,
-	0xf8, 0x61, 0x26, 0xe2, 0xc0, 0xbd, 0x15, 0x20, 0xc0, 0x1d, 0x3d, 0xe1, 0x9c, 0xde, 0xd1, 0x96,
-	0xd8, 0x23, 0x57, 0x8b, 0x90, 0xe7, 0xe2, 0x33, 0x77, 0xdd, 0x2a, 0x4f, 0x40, 0x85, 0xa9, 0x2e,
-	0x66, 0x9a, 0x96, 0x79, 0xd2, 0x1b, 0x53, 0x6c, 0x98, 0xb7, 0xa3, 0x39, 0x38, 0x91, 0x29, 0xec,
-	0x16, 0x0b, 0xc7, 0xba, 0x4a, 0x70, 0xca, 0x94, 0x84, 0x8b, 0x71, 0xc7, 0x13, 0xa6, 0xff, 0x64,
-	0x70, 0x1f, 0xff, 0xc9, 0x8e, 0x0a, 0x2b, 0xe5, 0x2e, 0xef, 0x17, 0x0b, 0x19, 0x80, 0x9e, 0x62,
-	0x48, 0x3f, 0x9f, 0x89, 0x21, 0x1d, 0x66, 0x0c, 0x5c, 0x2f, 0x86, 0x81, 0x83, 0x07, 0x8c, 0xde,
-	0xcf, 0x00, 0xd0, 0xff, 0x65, 0x81, 0xfc, 0xae, 0xb3, 0x8e, 0xbb, 0x49, 0xe8, 0x94, 0x41, 0xef,
-	0x85, 0x11, 0xe5, 0x05, 0xe0, 0x26, 0x91, 0xc5, 0x66, 0x8d, 0x8a, 0x06, 0xc0, 0x29, 0x28, 0xce,
-	0x60, 0xa3, 0x29, 0xa8, 0xd1, 0x71, 0xe2, 0x8f, 0x72, 0xbd, 0xaf, 0x3c, 0x0d, 0xd3, 0x2b, 0x0b,
-	0xe2, 0x29, 0x8d, 0x83, 0x42, 0x18, 0xf3, 0x9d, 0x38, 0x61, 0x1c, 0xac, 0xee, 0x04, 0xee, 0x3d,
-	0x96, 0xff, 0x60, 0x59, 0x34, 0x8b, 0xd9, 0x8e, 0x70, 0x67, 0xdf, 0xf6, 0x77, 0xfb, 0x60, 0x38,
-	0x25, 0x19, 0x0f, 0x68, 0x30, 0x3c, 0x05, 0x55, 0xa9, 0xc3, 0xb3, 0x75, 0x8e, 0x94, 0xa2, 0x57,
-	0x18, 0x54, 0x69, 0xad, 0x6b, 0xad, 0x9a, 0x35, 0x70, 0x0c, 0x85, 0x8b, 0x4d, 0x3c, 0x26, 0x94,
-	0x13, 0x3f, 0x9e, 0xf5, 0x3d, 0x12, 0x24, 0x9c, 0xcd, 0x62, 0x84, 0xf2, 0xda, 0xe2, 0xaa, 0xd9,
-	0xa9, 0x16, 0xca, 0x19, 0x00, 0xce, 0x92, 0x47, 0x9f, 0xb2, 0x60, 0xd8, 0xb9, 0x15, 0xeb, 0x8a,
-	0xd1, 0x22, 0x5a, 0xf4, 0x90, 0x4a, 0x2a, 0x55, 0x84, 0x9a, 0x7b, 0xad, 0x53, 0x4d, 0x38, 0x4d,
-	0x14, 0xbd, 0x61, 0x01, 0x22, 0xb7, 0x89, 0x2b, 0xe3, 0x59, 0x05, 0x2f, 0xfd, 0x45, 0x6c, 0x96,
-	0x2f, 0x74, 0xf4, 0xcb, 0xa5, 0x7a, 0x67, 0x3b, 0xce, 0xe1, 0xc1, 0xfe, 0x8b, 0xb2, 0x5a, 0x50,
-	0x3a, 0x84, 0xda, 0x31, 0x42, 0x39, 0xad, 0x7b, 0x0f, 0xe5, 0xd4, 0xa1, 0x28, 0x9d, 0x59, 0xc5,
-	0xa9, 0x24, 0xc4, 0xd2, 0x7d, 0x4a, 0x42, 0xfc, 0x05, 0x2b, 0x55, 0xd1, 0x6b, 0xf0, 0xfc, 0x4b,
-	0xc5, 0x86, 0x6f, 0x4f, 0xf2, 0x30, 0x99, 0x8c, 0x74, 0xcf, 0x44, 0x47, 0x3d, 0x05, 0xd5, 0x0d,
-	0xdf, 0x61, 0x75, 0x28, 0xd8, 0x72, 0x31, 0x42, 0x78, 0x2e, 0x8a, 0x76, 0xac, 0x30, 0xa8, 0xec,
-	0x35, 0x3a, 0x3d, 0x90, 0xec, 0xfc, 0x8f, 0x65, 0x18, 0x34, 0xf4, 0x6e, 0xae, 0x11, 0x65, 0x3d,
-	0x60, 0x46, 0x54, 0xe9, 0x00, 0x46, 0xd4, 0xcf, 0x43, 0xcd, 0x95, 0x3a, 0xa1, 0x98, 0x0a, 0xe5,
-	0x59, 0x4d, 0xa3, 0xd5, 0x82, 0x6a, 0xc2, 0x9a, 0x26, 0x9a, 0x4f, 0x25, 0xba, 0xa5, 0x76, 0xe7,
-	0x79, 0x99, 0x68, 0x42, 0xaf, 0x74, 0x3e, 0x93, 0x3d, 0x2d, 0xae, 0xec, 0x7f, 0x5a, 0x6c, 0x7f,
-	0xd7, 0x52, 0x1f, 0xf7, 0x18, 0x2a, 0x9a, 0xdc, 0x4c, 0x57, 0x34, 0xb9, 0x50, 0xc8, 0x30, 0x77,
-	0x29, 0x65, 0x72, 0x15, 0x06, 0x66, 0xc3, 0x66, 0xd3, 0x09, 0xea, 0xe8, 0x27, 0x60, 0xc0, 0xe5,
-	0x3f, 0x85, 0x27, 0x8b, 0x9d, 0x87, 0x0a, 0x28, 0x96, 0x30, 0xf4, 0x08, 0xf4, 0x39, 0x51, 0x43,
-	0x7a, 0xaf, 0x58, 0xdc, 0xd2, 0x74, 0xd4, 0x88, 0x31, 0x6b, 0xb5, 0xff, 0x59, 0x1f, 0xb0, 0x70,
-	0x01, 0x27, 0x22, 0xf5, 0xb5, 0x90, 0x15, 0x16, 0x3d, 0xd2, 0x53, 0x44, 0xbd, 0xb5, 0x7a, 0x90,
-	0x4f, 0x12, 0x8d, 0xd3, 0xa4, 0xf2, 0x31, 0x9f, 0x26, 0x75, 0x39, 0x20, 0xec, 0x7b, 0x80, 0x0e,
-	0x08, 0xed, 0xcf, 0x59, 0x80, 0x54, 0x8c, 0x89, 0x3e, 0xc1, 0x9f, 0x82, 0x9a, 0x8a, 0x36, 0x11,
-	0x66, 0x98, 0x16, 0x11, 0x12, 0x80, 0x35, 0x4e, 0x0f, 0xfb, 0xe9, 0xc7, 0xa5, 0xfc, 0x2e, 0xa7,
-	0xc3, 0xb7, 0x99, 0xd4, 0x17, 0xe2, 0xdc, 0xfe, 0xbd, 0x12, 0x3c, 0xc4, 0x15, 0xf8, 0x92, 0x13,
-	0x38, 0x0d, 0xd2, 0xa4, 0x5c, 0xf5, 0x1a, 0x93, 0xe1, 0xd2, 0x8d, 0x9c, 0x27, 0xc3, 0xb1, 0x0f,
-	0xbb, 0x76, 0xf9, 0x9a, 0xe3, 0xab, 0x6c, 0x21, 0xf0, 0x12, 0xcc, 0x3a, 0x47, 0x31, 0x54, 0xe5,
-	0xf5, 0x1d, 0x42, 0x16, 0x17, 0x44, 0x48, 0x89, 0x25, 0xa1, 0x65, 0x09, 0x56, 0x84, 0xa8, 0x2a,
-	0xf5, 0x43, 0x77, 0x0b, 0x93, 0x56, 0x98, 0x55, 0xa5, 0x8b, 0xa2, 0x1d, 0x2b, 0x0c, 0xbb, 0x09,
-	0xa3, 0x72, 0x0c, 0x5b, 0x57, 0xc8, 0x0e, 0x26, 0x1b, 0x54, 0xff, 0xb8, 0xb2, 0xc9, 0xb8, 0x51,
-	0x44, 0xe9, 0x9f, 0x59, 0x13, 0x88, 0xd3, 0xb8, 0xb2, 0xd6, 0x68, 0x29, 0xbf, 0xd6, 0xa8, 0xfd,
-	0x7b, 0x16, 0x64, 0x15, 0xa0, 0x51, 0x59, 0xd1, 0xda, 0xb3, 0xb2, 0xe2, 0x01, 0x6a, 0x13, 0xfe,
-	0x2c, 0x0c, 0x3a, 0x09, 0xb5, 0x70, 0xb8, 0x4f, 0xa0, 0x7c, 0x6f, 0xc7, 0x46, 0x4b, 0x61, 0xdd,
-	0xdb, 0xf0, 0x98, 0x2f, 0xc0, 0xec, 0xce, 0xfe, 0xab, 0x3e, 0x18, 0xeb, 0xc8, 0x95, 0x42, 0xcf,
-	0xc3, 0x90, 0x1a, 0x0a, 0xe9, 0x6d, 0xab, 0x99, 0x01, 0x8e, 0x1a, 0x86, 0x53, 0x98, 0x3d, 0xac,
-	0x87, 0x05, 0x38, 0x19, 0x91, 0x57, 0xdb, 0xa4, 0x4d, 0xa6, 0x37, 0x12, 0x12, 0xad, 0x12, 0x37,
-	0x0c, 0xea, 0xbc, 0xfe, 0x67, 0x79, 0xe6, 0xe1, 0x3b, 0xbb, 0x13, 0x27, 0x71, 0x27, 0x18, 0xe7,
-	0x3d, 0x83, 0x5a, 0x30, 0xec, 0x9b, 0x06, 0xaa, 0xd8, 0x9d, 0xdc, 0x93, 0x6d, 0xab, 0xa6, 0x44,
-	0xaa, 0x19, 0xa7, 0x09, 0xa4, 0xad, 0xdc, 0xca, 0x7d, 0xb2, 0x72, 0x3f, 0xa9, 0xad, 0x5c, 0x1e,
-	0xdf, 0xf0, 0xc1, 0x82, 0x73, 0xe5, 0x7a, 0x31, 0x73, 0x0f, 0x63, 0xb8, 0xbe, 0x08, 0x55, 0x19,
-	0xfb, 0xd5, 0x53, 0xcc, 0x94, 0xd9, 0x4f, 0x17, 0x01, 0xfa, 0x04, 0xfc, 0xf8, 0x85, 0x28, 0x32,
-	0x06, 0xf3, 0x6a, 0x98, 0x4c, 0xfb, 0x7e, 0x78, 0x8b, 0xda, 0x04, 0xd7, 0x62, 0x22, 0xdc, 0x3f,
-	0xf6, 0xdd, 0x12, 0xe4, 0xec, 0xa4, 0xe8, 0x7a, 0xd4, 0x86, 0x48, 0x6a, 0x3d, 0x1e, 0xcc, 0x18,
-	0x41, 0xb7, 0x79, 0x7c, 0x1c, 0x57, 0xb9, 0x1f, 0x28, 0x7a, 0x27, 0xa8, 0x43, 0xe6, 0x94, 0x38,
-	0x52, 0x61, 0x73, 0xe7, 0x01, 0xb4, 0xfd, 0x28, 0x12, 0x38, 0xd4, 0xf1, 0xbb, 0x36, 0x33, 0xb1,
-	0x81, 0x85, 0x9e, 0x83, 0x41, 0x2f, 0x88, 0x13, 0xc7, 0xf7, 0x2f, 0x79, 0x41, 0x22, 0x3c, 0x9c,
-	0xca, 0xb6, 0x58, 0xd0, 0x20, 0x6c, 0xe2, 0x9d, 0x7d, 0x97, 0xf1, 0xfd, 0x0e, 0xf2, 0xdd, 0x37,
-	0xe1, 0xcc, 0xbc, 0x97, 0xa8, 0xb4, 0x23, 0x35, 0xdf, 0xa8, 0x79, 0xa8, 0xd2, 0xe8, 0xac, 0xae,
-	0x69, 0x74, 0x46, 0xda, 0x4f, 0x29, 0x9d, 0xa5, 0x94, 0x4d, 0xfb, 0xb1, 0x9f, 0x87, 0x53, 0xf3,
-	0x5e, 0x72, 0xd1, 0xf3, 0xc9, 0x01, 0x89, 0xd8, 0xbf, 0xdb, 0x0f, 0x43, 0x66, 0x02, 0xed, 0x41,
-	0x32, 0x01, 0xbf, 0x40, 0x2d, 0x40, 0xf1, 0x76, 0x9e, 0x3a, 0xbc, 0xbc, 0x71, 0xe8, 0x6c, 0xde,
-	0xfc, 0x11, 0x33, 0x8c, 0x40, 0x4d, 0x13, 0x9b, 0x0c, 0xa0, 0x5b, 0x50, 0xd9, 0x60, 0x69, 0x29,
-	0xe5, 0x22, 0x22, 0x3c, 0xf2, 0x46, 0x54, 0x2f, 0x47, 0x9e, 0xd8, 0xc2, 0xe9, 0x51, 0xc5, 0x1d,
-	0xa5, 0x73, 0x1d, 0x8d, 0xf0, 0x63, 0x91, 0xe5, 0xa8, 0x30, 0xba, 0xa9, 0x84, 0xca, 0x3d, 0xa8,
-	0x84, 0x94, 0x80, 0xee, 0xbf, 0x4f, 0x02, 0x9a, 0xa5, 0x18, 0x25, 0x9b, 0xcc, 0xac, 0x14, 0xf9,
-	0x12, 0x03, 0x6c, 0x10, 0x8c, 0x14, 0xa3, 0x14, 0x18, 0x67, 0xf1, 0xd1, 0xc7, 0x94, 0x88, 0xaf,
-	0x16, 0xe1, 0x1c, 0x36, 0x67, 0xf4, 0x51, 0x4b, 0xf7, 0xcf, 0x95, 0x60, 0x64, 0x3e, 0x68, 0xaf,
-	0xcc, 0xaf, 0xb4, 0xd7, 0x7d, 0xcf, 0xbd, 0x42, 0x76, 0xa8, 0x08, 0xdf, 0x22, 0x3b, 0x0b, 0x73,
-	0x62, 0x05, 0xa9, 0x39, 0x73, 0x85, 0x36, 0x62, 0x0e, 0xa3, 0xc2, 0x68, 0xc3, 0x0b, 0x1a, 0x24,
-	0x6a, 0x45, 0x9e, 0xf0, 0xdb, 0x1a, 0xc2, 0xe8, 0xa2, 0x06, 0x61, 0x13, 0x8f, 0xf6, 0x1d, 0xde,
-	0x0a, 0x48, 0x94, 0xb5, 0xaf, 0x97, 0x69, 0x23, 0xe6, 0x30, 0x8a, 0x94, 0x44, 0x6d, 0xe1, 0x90,
-	0x31, 0x90, 0xd6, 0x68, 0x23, 0xe6, 0x30, 0xba, 0xd2, 0xe3, 0xf6, 0x3a, 0x0b, 0xa0, 0xc9, 0x24,
-	0x67, 0xac, 0xf2, 0x66, 0x2c, 0xe1, 0x14, 0x75, 0x8b, 0xec, 0xcc, 0xd1, 0xcd, 0x78, 0x26, 0xdf,
-	0xec, 0x0a, 0x6f, 0xc6, 0x12, 0xce, 0x2a, 0x94, 0xa6, 0x87, 0xe3, 0x87, 0xae, 0x42, 0x69, 0x9a,
-	0xfd, 0x2e, 0xdb, 0xfa, 0x5f, 0xb7, 0x60, 0xc8, 0x0c, 0x7b, 0x43, 0x8d, 0x8c, 0x2d, 0xbc, 0xdc,
-	0x51, 0xe0, 0xfa, 0x3d, 0x79, 0x97, 0x3f, 0x36, 0xbc, 0x24, 0x6c, 0xc5, 0x4f, 0x93, 0xa0, 0xe1,
-	0x05, 0x84, 0x85, 0x25, 0xf0, 0x70, 0xb9, 0x54, 0x4c, 0xdd, 0x6c, 0x58, 0x27, 0xf7, 0x60, 0x4c,
-	0xdb, 0x37, 0x60, 0xac, 0x23, 0xc9, 0xb0, 0x07, 0x13, 0x64, 0xdf, 0x14, 0x6f, 0x1b, 0xc3, 0x20,
-	0xed, 0x58, 0x56, 0xc9, 0x9a, 0x85, 0x31, 0xbe, 0x90, 0x28, 0xa5, 0x55, 0x77, 0x93, 0x34, 0x55,
-	0xe2, 0x28, 0x3b, 0x24, 0xb8, 0x9e, 0x05, 0xe2, 0x4e, 0x7c, 0xfb, 0xf3, 0x16, 0x0c, 0xa7, 0xf2,
-	0x3e, 0x0b, 0x32, 0x96, 0xd8, 0x4a, 0x0b, 0x59, 0x14, 0x26, 0x0b, 0x45, 0x2f, 0x33, 0x65, 0xaa,
-	0x57, 0x9a, 0x06, 0x61, 0x13, 0xcf, 0xfe, 0x52, 0x09, 0xaa, 0x32, 0x92, 0xa5, 0x07, 0x56, 0x3e,
-	0x6b, 0xc1, 0xb0, 0x3a, 0x98, 0x61, 0x3e, 0xbc, 0x52, 0x11, 0x89, 0x2d, 0x94, 0x03, 0xe5, 0x05,
-	0x08, 0x36, 0x42, 0x6d, 0xb9, 0x63, 0x93, 0x18, 0x4e, 0xd3, 0x46, 0xd7, 0x01, 0xe2, 0x9d, 0x38,
-	0x21, 0x4d, 0xc3, 0x9b, 0x68, 0x1b, 0x2b, 0x6e, 0xd2, 0x0d, 0x23, 0x42, 0xd7, 0xd7, 0xd5, 0xb0,
-	0x4e, 0x56, 0x15, 0xa6, 0x36, 0xa1, 0x74, 0x1b, 0x36, 0x7a, 0xb2, 0xff, 0x49, 0x09, 0x4e, 0x64,
-	0x59, 0x42, 0x1f, 0x84, 0x21, 0x49, 0xdd, 0xd8, 0x75, 0xca, 0x38, 0x9c, 0x21, 0x6c, 0xc0, 0xee,
-	0xee, 0x4e, 0x4c, 0x74, 0x5e, 0x24, 0x3a, 0x69, 0xa2, 0xe0, 0x54, 0x67, 0xfc, 0x74, 0x4c, 0x1c,
-	0xe3, 0xce, 0xec, 0x4c, 0xb7, 0x5a, 0xe2, 0x88, 0xcb, 0x38, 0x1d, 0x33, 0xa1, 0x38, 0x83, 0x8d,
-	0x56, 0xe0, 0x94, 0xd1, 0x72, 0x95, 0x78, 0x8d, 0xcd, 0xf5, 0x30, 0x92, 0x3b, 0xb0, 0x47, 0x74,
-	0x80, 0x5d, 0x27, 0x0e, 0xce, 0x7d, 0x92, 0x6a, 0x7b, 0xd7, 0x69, 0x39, 0xae, 0x97, 0xec, 0x08,
-	0xf7, 0xa8, 0x92, 0x4d, 0xb3, 0xa2, 0x1d, 0x2b, 0x0c, 0x7b, 0x09, 0xfa, 0x7a, 0x9c, 0x41, 0x3d,
-	0x59, 0xfe, 0x2f, 0x42, 0x95, 0x76, 0x27, 0xcd, 0xbb, 0x22, 0xba, 0x0c, 0xa1, 0x2a, 0xaf, 0x65,
-	0x42, 0x36, 0x94, 0x3d, 0x47, 0x1e, 0x40, 0xaa, 0xd7, 0x5a, 0x88, 0xe3, 0x36, 0xdb, 0x4c, 0x53,
-	0x20, 0x7a, 0x1c, 0xca, 0xe4, 0x76, 0x2b, 0x7b, 0xd2, 0x78, 0xe1, 0x76, 0xcb, 0x8b, 0x48, 0x4c,
-	0x91, 0xc8, 0xed, 0x16, 0x3a, 0x0b, 0x25, 0xaf, 0x2e, 0x94, 0x14, 0x08, 0x9c, 0xd2, 0xc2, 0x1c,
-	0x2e, 0x79, 0x75, 0xfb, 0x36, 0xd4, 0xd4, 0x3d, 0x50, 0x68, 0x4b, 0xca, 0x6e, 0xab, 0x88, 0xd0,
-	0x33, 0xd9, 0x6f, 0x17, 0xa9, 0xdd, 0x06, 0xd0, 0x49, 0xa3, 0x45, 0xc9, 0x97, 0x73, 0xd0, 0xe7,
-	0x86, 0x22, 0x39, 0xbf, 0xaa, 0xbb, 0x61, 0x42, 0x9b, 0x41, 0xec, 0x1b, 0x30, 0x72, 0x25, 0x08,
-	0x6f, 0xb1, 0xeb, 0x1a, 0x58, 0x75, 0x42, 0xda, 0xf1, 0x06, 0xfd, 0x91, 0x35, 0x11, 0x18, 0x14,
-	0x73, 0x98, 0xaa, 0x9b, 0x56, 0xea, 0x56, 0x37, 0xcd, 0xfe, 0xb8, 0x05, 0x43, 0x2a, 0xfb, 0x6c,
-	0x7e, 0x7b, 0x8b, 0xf6, 0xdb, 0x88, 0xc2, 0x76, 0x2b, 0xdb, 0x2f, 0xbb, 0x72, 0x0e, 0x73, 0x98,
-	0x99, 0x96, 0x59, 0xda, 0x27, 0x2d, 0xf3, 0x1c, 0xf4, 0x6d, 0x79, 0x41, 0x3d, 0x7b, 0xf5, 0xd0,
-	0x15, 0x2f, 0xa8, 0x63, 0x06, 0xa1, 0x2c, 0x9c, 0x50, 0x2c, 0x48, 0x85, 0xf0, 0x3c, 0x0c, 0xad,
-	0xb7, 0x3d, 0xbf, 0x2e, 0xcb, 0x2e, 0x66, 0x3c, 0x2a, 0x33, 0x06, 0x0c, 0xa7, 0x30, 0xe9, 0xbe,
-	0x6e, 0xdd, 0x0b, 0x9c, 0x68, 0x67, 0x45, 0x6b, 0x20, 0x25, 0x94, 0x66, 0x14, 0x04, 0x1b, 0x58,
-	0xf6, 0xeb, 0x65, 0x18, 0x49, 0xe7, 0xe0, 0xf5, 0xb0, 0xbd, 0x7a, 0x1c, 0x2a, 0x2c, 0x2d, 0x2f,
-	0xfb, 0x69, 0x79, 0xa5, 0x42, 0x0e, 0x43, 0x31, 0xf4, 0xf3, 0xe2, 0x24, 0xc5, 0x5c, 0xdb, 0xa5,
-	0x98, 0x54, 0x7e, 0x18, 0x16, 0xa0, 0x27, 0xea, 0xa1, 0x08, 0x52, 0xe8, 0x53, 0x16, 0x0c, 0x84,
-	0x2d, 0xb3, 0xde, 0xd6, 0x07, 0x8a, 0xcc, 0x4f, 0x14, 0x49, 0x4b, 0xc2, 0x22, 0x56, 0x9f, 0x5e,
-	0x7e, 0x0e, 0x49, 0xfa, 0xec, 0xbb, 0x61, 0xc8, 0xc4, 0xdc, 0xcf, 0x28, 0xae, 0x9a, 0x46, 0xf1,
-	0x67, 0xcd, 0x49, 0x21, 0x32, 0x30, 0x7b, 0x58, 0x6e, 0xd7, 0xa0, 0xe2, 0xaa, 0x28, 0x86, 0x7b,
-	0x2a, 0xd6, 0xab, 0xaa, 0x85, 0xb0, 0xb3, 0x29, 0xde, 0x9b, 0xfd, 0x5d, 0xcb, 0x98, 0x1f, 0x98,
-	0xc4, 0x0b, 0x75, 0x14, 0x41, 0xb9, 0xb1, 0xbd, 0x25, 0x4c, 0xd1, 0xcb, 0x05, 0x0d, 0xef, 0xfc,
-	0xf6, 0x96, 0x9e, 0xe3, 0x66, 0x2b, 0xa6, 0xc4, 0x7a, 0x70, 0x16, 0xa6, 0x12, 0x75, 0xcb, 0xfb,
-	0x27, 0xea, 0xda, 0x6f, 0x94, 0x60, 0xac, 0x63, 0x52, 0xa1, 0xd7, 0xa0, 0x12, 0xd1, 0xb7, 0x14,
-	0xaf, 0xb7, 0x58, 0x58, 0x6a, 0x6d, 0xbc, 0x50, 0xd7, 0x7a, 0x37, 0xdd, 0x8e, 0x39, 0x49, 0x74,
-	0x19, 0x90, 0x8e, 0xb5, 0x51, 0x9e, 0x4a, 0xfe, 0xca, 0x67, 0xc5, 0xa3, 0x68, 0xba, 0x03, 0x03,
-	0xe7, 0x3c, 0x85, 0x5e, 0xc8, 0x3a, 0x3c, 0xcb, 0x69, 0x77, 0xf6, 0x5e, 0xbe, 0x4b, 0xfb, 0xb7,
-	0x4b, 0x30, 0x9c, 0x2a, 0x7f, 0x86, 0x7c, 0xa8, 0x12, 0x9f, 0x9d, 0x35, 0x48, 0x65, 0x73, 0xd8,
-	0x62, 0xe6, 0x4a, 0x41, 0x5e, 0x10, 0xfd, 0x62, 0x45, 0xe1, 0xc1, 0x88, 0x10, 0x78, 0x1e, 0x86,
-	0x24, 0x43, 0x1f, 0x70, 0x9a, 0xbe, 0x18, 0x40, 0x35, 0x47, 0x2f, 0x18, 0x30, 0x9c, 0xc2, 0xb4,
-	0x7f, 0xbf, 0x0c, 0xe3, 0xfc, 0x70, 0xa6, 0xae, 0x66, 0xde, 0x92, 0xdc, 0x6f, 0xfd, 0x0d, 0x5d,
-	0xa4, 0xd0, 0x2a, 0xe2, 0xc6, 0xce, 0x6e, 0x84, 0x7a, 0x0a, 0x2f, 0xfb, 0x6a, 0x26, 0xbc, 0x8c,
-	0x9b, 0xdd, 0x8d, 0x23, 0xe2, 0xe8, 0x87, 0x2b, 0xde, 0xec, 0x1f, 0x96, 0x60, 0x34, 0x73, 0x31,
-	0x0b, 0x7a, 0x3d, 0x5d, 0xcb, 0xdb, 0x2a, 0xc2, 0xa7, 0xbe, 0xe7, 0x5d, 0x1d, 0x07, 0xab, 0xe8,
-	0x7d, 0x9f, 0x96, 0x8a, 0xfd, 0x9d, 0x12, 0x8c, 0xa4, 0x6f, 0x94, 0x79, 0x00, 0x47, 0xea, 0x1d,
-	0x50, 0x63, 0x97, 0x26, 0xb0, 0x8b, 0x90, 0xb9, 0x4b, 0x9e, 0xd7, 0xa7, 0x97, 0x8d, 0x58, 0xc3,
-	0x1f, 0x88, 0x42, 0xe9, 0xf6, 0x3f, 0xb6, 0xe0, 0x34, 0x7f, 0xcb, 0xec, 0x3c, 0xfc, 0x9b, 0x79,
-	0xa3, 0xfb, 0x72, 0xb1, 0x0c, 0x66, 0x8a, 0x6b, 0xee, 0x37, 0xbe, 0xec, 0xde, 0x52, 0xc1, 0x6d,
-	0x7a, 0x2a, 0x3c, 0x80, 0xcc, 0x1e, 0x68, 0x32, 0xd8, 0xdf, 0x29, 0x83, 0xbe, 0xaa, 0x15, 0x79,
-	0x22, 0xd7, 0xb4, 0x90, 0x22, 0xa3, 0xab, 0x3b, 0x81, 0xab, 0x2f, 0x85, 0xad, 0x66, 0x52, 0x4d,
-	0x7f, 0xc9, 0x82, 0x41, 0x2f, 0xf0, 0x12, 0xcf, 0x61, 0xdb, 0xe8, 0x62, 0xee, 0x5b, 0x54, 0xe4,
-	0x16, 0x78, 0xcf, 0x61, 0x64, 0x9e, 0xe3, 0x28, 0x62, 0xd8, 0xa4, 0x8c, 0x3e, 0x2c, 0x22, 0xc0,
-	0xcb, 0x85, 0x65, 0x49, 0x57, 0x33, 0x61, 0xdf, 0x2d, 0x6a, 0x78, 0x25, 0x51, 0x41, 0xc5, 0x05,
-	0x30, 0xed, 0x4a, 0xd5, 0xab, 0xd6, 0xb7, 0xff, 0xd3, 0x66, 0xcc, 0x09, 0xd9, 0x31, 0xa0, 0xce,
-	0xb1, 0x38, 0x60, 0x74, 0xed, 0x14, 0xd4, 0x9c, 0x76, 0x12, 0x36, 0xe9, 0x30, 0x89, 0xa3, 0x26,
-	0x1d, 0x3f, 0x2c, 0x01, 0x58, 0xe3, 0xd8, 0xaf, 0x57, 0x20, 0x93, 0xfc, 0x89, 0x6e, 0x9b, 0xd7,
-	0x0c, 0x5b, 0xc5, 0x5e, 0x33, 0xac, 0x98, 0xc9, 0xbb, 0x6a, 0x18, 0x35, 0xa0, 0xd2, 0xda, 0x74,
-	0x62, 0x69, 0x56, 0xbf, 0xa8, 0xf6, 0x71, 0xb4, 0xf1, 0xee, 0xee, 0xc4, 0xcf, 0xf4, 0xe6, 0x75,
-	0xa5, 0x73, 0x75, 0x8a, 0x17, 0xac, 0xd1, 0xa4, 0x59, 0x1f, 0x98, 0xf7, 0x7f, 0x90, 0x1b, 0x27,
-	0x3f, 0x21, 0x6e, 0x87, 0xc0, 0x24, 0x6e, 0xfb, 0x89, 0x98, 0x0d, 0x2f, 0x16, 0xb8, 0xca, 0x78,
-	0xc7, 0xba, 0x6c, 0x01, 0xff, 0x8f, 0x0d, 0xa2, 0xe8, 0x83, 0x50, 0x8b, 0x13, 0x27, 0x4a, 0xee,
-	0x31, 0xd1, 0x58, 0x17, 0x16, 0x93, 0x9d, 0x60, 0xdd, 0x1f, 0x7a, 0x89, 0xd5, 0x5c, 0xf6, 0xe2,
-	0xcd, 0x7b, 0x4c, 0xdc, 0x90, 0xf5, 0x99, 0x45, 0x0f, 0xd8, 0xe8, 0x0d, 0x9d, 0x07, 0x60, 0x73,
-	0x9b, 0xc7, 0x1f, 0x56, 0x99, 0x97, 0x49, 0x89, 0x42, 0xac, 0x20, 0xd8, 0xc0, 0xb2, 0x7f, 0x12,
-	0xd2, 0x75, 0x37, 0xd0, 0x84, 0x2c, 0xf3, 0xc1, 0xbd, 0xd0, 0x2c, 0x01, 0x23, 0x55, 0x91, 0xe3,
-	0x37, 0x2d, 0x30, 0x8b, 0x83, 0xa0, 0x57, 0x79, 0x15, 0x12, 0xab, 0x88, 0x93, 0x43, 0xa3, 0xdf,
-	0xc9, 0x25, 0xa7, 0x95, 0x39, 0xc2, 0x96, 0xa5, 0x48, 0xce, 0xbe, 0x0b, 0xaa, 0x12, 0x7a, 0x20,
-	0xa3, 0xee, 0x63, 0x70, 0x52, 0x26, 0x73, 0x4a, 0xbf, 0xa9, 0x38, 0x75, 0xda, 0xdf, 0xf5, 0x23,
-	0xfd, 0x39, 0xa5, 0x6e, 0xfe, 0x9c, 0x1e, 0x2e, 0x9b, 0xfe, 0x2d, 0x0b, 0xce, 0x65, 0x19, 0x88,
-	0x97, 0xc2, 0xc0, 0x4b, 0xc2, 0x68, 0x95, 0x24, 0x89, 0x17, 0x34, 0x58, 0xf1, 0xb5, 0x5b, 0x4e,
-	0x24, 0x8b, 0xe1, 0x33, 0x41, 0x79, 0xc3, 0x89, 0x02, 0xcc, 0x5a, 0xd1, 0x0e, 0xf4, 0xf3, 0x20,
-	0x35, 0x61, 0xad, 0x1f, 0x72, 0x6d, 0xe4, 0x0c, 0x87, 0xde, 0x2e, 0xf0, 0x00, 0x39, 0x2c, 0x08,
-	0xda, 0xdf, 0xb7, 0x00, 0x2d, 0x6f, 0x93, 0x28, 0xf2, 0xea, 0x46, 0x58, 0x1d, 0xbb, 0x65, 0xc9,
-	0xb8, 0x4d, 0xc9, 0x4c, 0x35, 0xce, 0xdc, 0xb2, 0x64, 0xfc, 0xcb, 0xbf, 0x65, 0xa9, 0x74, 0xb0,
-	0x5b, 0x96, 0xd0, 0x32, 0x9c, 0x6e, 0xf2, 0xed, 0x06, 0xbf, 0xb9, 0x84, 0xef, 0x3d, 0x54, 0x56,
-	0xdc, 0x99, 0x3b, 0xbb, 0x13, 0xa7, 0x97, 0xf2, 0x10, 0x70, 0xfe, 0x73, 0xf6, 0xbb, 0x00, 0xf1,
-	0x68, 0xba, 0xd9, 0xbc, 0x58, 0xa5, 0xae, 0xee, 0x17, 0xfb, 0x2b, 0x15, 0x18, 0xcd, 0x94, 0x4a,
-	0xa6, 0x5b, 0xbd, 0xce, 0xe0, 0xa8, 0x43, 0xeb, 0xef, 0x4e, 0xf6, 0x7a, 0x0a, 0xb7, 0x0a, 0xa0,
-	0xe2, 0x05, 0xad, 0x76, 0x52, 0x4c, 0x52, 0x2e, 0x67, 0x62, 0x81, 0x76, 0x68, 0xb8, 0x8b, 0xe9,
-	0x5f, 0xcc, 0xc9, 0x14, 0x19, 0xbc, 0x95, 0x32, 0xc6, 0xfb, 0xee, 0x93, 0x3b, 0xe0, 0x13, 0x3a,
-	0x94, 0xaa, 0x52, 0x84, 0x63, 0x31, 0x33, 0x59, 0x8e, 0xfa, 0xa8, 0xfd, 0x9b, 0x25, 0x18, 0x34,
-	0x3e, 0x1a, 0xfa, 0xb5, 0x74, 0xe9, 0x2c, 0xab, 0xb8, 0x57, 0x62, 0xfd, 0x4f, 0xea, 0xe2, 0x58,
-	0xfc, 0x95, 0x9e, 0xe8, 0xac, 0x9a, 0x75, 0x77, 0x77, 0xe2, 0x44, 0xa6, 0x2e, 0x56, 0xaa, 0x92,
-	0xd6, 0xd9, 0x8f, 0xc2, 0x68, 0xa6, 0x9b, 0x9c, 0x57, 0x5e, 0x33, 0x5f, 0xf9, 0xd0, 0x6e, 0x29,
-	0x73, 0xc8, 0xbe, 0x41, 0x87, 0x4c, 0xe4, 0x02, 0x86, 0x3e, 0xe9, 0xc1, 0x07, 0x9b, 0x49, 0xf9,
-	0x2d, 0xf5, 0x98, 0xf2, 0xfb, 0x24, 0x54, 0x5b, 0xa1, 0xef, 0xb9, 0x9e, 0xaa, 0x64, 0xc9, 0x92,
-	0x8c, 0x57, 0x44, 0x1b, 0x56, 0x50, 0x74, 0x0b, 0x6a, 0x37, 0x6f, 0x25, 0xfc, 0xf4, 0x47, 0xf8,
-	0xb7, 0x8b, 0x3a, 0xf4, 0x51, 0x46, 0x8b, 0x3a, 0x5e, 0xc2, 0x9a, 0x16, 0xb2, 0xa1, 0x9f, 0x29,
-	0x41, 0x99, 0x91, 0xc0, 0x7c, 0xef, 0x4c, 0x3b, 0xc6, 0x58, 0x40, 0xec, 0xaf, 0xd7, 0xe0, 0x54,
-	0x5e, 0xbd, 0x7a, 0xf4, 0x11, 0xe8, 0xe7, 0x3c, 0x16, 0x73, 0x25, 0x4a, 0x1e, 0x8d, 0x79, 0xd6,
-	0xa1, 0x60, 0x8b, 0xfd, 0xc6, 0x82, 0xa6, 0xa0, 0xee, 0x3b, 0xeb, 0x62, 0x86, 0x1c, 0x0d, 0xf5,
-	0x45, 0x47, 0x53, 0x5f, 0x74, 0x38, 0x75, 0xdf, 0x59, 0x47, 0xb7, 0xa1, 0xd2, 0xf0, 0x12, 0xe2,
-	0x08, 0x27, 0xc2, 0x8d, 0x23, 0x21, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x13, 0x44, 0x5f,
-	0xb3, 0x60, 0x74, 0x3d, 0x5d, 0x6b, 0x40, 0x08, 0x4f, 0xe7, 0x08, 0xee, 0x24, 0x48, 0x13, 0xe2,
-	0xd7, 0x8c, 0x65, 0x1a, 0x71, 0x96, 0x1d, 0xf4, 0x49, 0x0b, 0x06, 0x36, 0x3c, 0xdf, 0x28, 0x0b,
-	0x7d, 0x04, 0x1f, 0xe7, 0x22, 0x23, 0xa0, 0x77, 0x1c, 0xfc, 0x7f, 0x8c, 0x25, 0xe5, 0x6e, 0x9a,
-	0xaa, 0xff, 0xb0, 0x9a, 0x6a, 0xe0, 0x3e, 0x69, 0xaa, 0xcf, 0x58, 0x50, 0x53, 0x23, 0x2d, 0x72,
-	0xb6, 0x3f, 0x78, 0x84, 0x9f, 0x9c, 0x7b, 0x4e, 0xd4, 0x5f, 0xac, 0x89, 0xa3, 0x2f, 0x5a, 0x30,
-	0xe8, 0xbc, 0xd6, 0x8e, 0x48, 0x9d, 0x6c, 0x87, 0xad, 0x58, 0xdc, 0x51, 0xfa, 0x72, 0xf1, 0xcc,
-	0x4c, 0x53, 0x22, 0x73, 0x64, 0x7b, 0xb9, 0x15, 0x8b, 0x6c, 0x29, 0xdd, 0x80, 0x4d, 0x16, 0xec,
-	0xdd, 0x12, 0x4c, 0xec, 0xd3, 0x03, 0x7a, 0x1e, 0x86, 0xc2, 0xa8, 0xe1, 0x04, 0xde, 0x6b, 0x66,
-	0xf1, 0x10, 0x65, 0x65, 0x2d, 0x1b, 0x30, 0x9c, 0xc2, 0x34, 0xb3, 0xca, 0x4b, 0xfb, 0x64, 0x95,
-	0x9f, 0x83, 0xbe, 0x88, 0xb4, 0xc2, 0xec, 0x66, 0x81, 0x65, 0x2a, 0x30, 0x08, 0x7a, 0x14, 0xca,
-	0x4e, 0xcb, 0x13, 0x81, 0x68, 0x6a, 0x0f, 0x34, 0xbd, 0xb2, 0x80, 0x69, 0x7b, 0xaa, 0xc8, 0x45,
-	0xe5, 0x58, 0x8a, 0x5c, 0x50, 0x35, 0x20, 0xce, 0x2e, 0xfa, 0xb5, 0x1a, 0x48, 0x9f, 0x29, 0xd8,
-	0x6f, 0x94, 0xe1, 0xd1, 0x3d, 0xe7, 0x8b, 0x8e, 0xc3, 0xb3, 0xf6, 0x88, 0xc3, 0x93, 0xc3, 0x53,
-	0xda, 0x6f, 0x78, 0xca, 0x5d, 0x86, 0xe7, 0x93, 0x74, 0x19, 0xc8, 0xa2, 0x2b, 0xc5, 0xdc, 0x32,
-	0xd9, 0xad, 0x86, 0x8b, 0x58, 0x01, 0x12, 0x8a, 0x35, 0x5d, 0xba, 0x07, 0x48, 0x65, 0x54, 0x57,
-	0x8a, 0x50, 0x03, 0x5d, 0x0b, 0x9f, 0xf0, 0xb9, 0xdf, 0x2d, 0x4d, 0xdb, 0xfe, 0x9d, 0x3e, 0x78,
-	0xbc, 0x07, 0xe9, 0x6d, 0xce, 0x62, 0xab, 0xc7, 0x59, 0xfc, 0x43, 0xfe, 0x99, 0x3e, 0x9d, 0xfb,
-	0x99, 0x70, 0xf1, 0x9f, 0x69, 0xef, 0x2f, 0x84, 0x9e, 0x82, 0xaa, 0x17, 0xc4, 0xc4, 0x6d, 0x47,
-	0x3c, 0x26, 0xd9, 0x48, 0x63, 0x5a, 0x10, 0xed, 0x58, 0x61, 0xd0, 0x3d, 0x9d, 0xeb, 0xd0, 0xe5,
-	0x3f, 0x50, 0x50, 0xee, 0xae, 0x99, 0x11, 0xc5, 0x4d, 0x8a, 0xd9, 0x69, 0x2a, 0x01, 0x38, 0x19,
-	0xfb, 0x6f, 0x59, 0x70, 0xb6, 0xbb, 0x8a, 0x45, 0xcf, 0xc0, 0xe0, 0x7a, 0xe4, 0x04, 0xee, 0x26,
-	0xbb, 0x5f, 0x58, 0x4e, 0x1d, 0xf6, 0xbe, 0xba, 0x19, 0x9b, 0x38, 0x68, 0x16, 0xc6, 0x78, 0xe4,
-	0x86, 0x81, 0x21, 0x33, 0x7f, 0xef, 0xec, 0x4e, 0x8c, 0xad, 0x65, 0x81, 0xb8, 0x13, 0xdf, 0xfe,
-	0x41, 0x39, 0x9f, 0x2d, 0x6e, 0x8a, 0x1d, 0x64, 0x36, 0x8b, 0xb9, 0x5a, 0xea, 0x41, 0xe2, 0x96,
-	0x8f, 0x5b, 0xe2, 0xf6, 0x75, 0x93, 0xb8, 0x68, 0x0e, 0x4e, 0x18, 0x17, 0x40, 0xf1, 0x6c, 0x6e,
-	0x1e, 0x96, 0xac, 0x0a, 0xa2, 0xac, 0x64, 0xe0, 0xb8, 0xe3, 0x89, 0x07, 0x7c, 0xea, 0xfd, 0x7a,
-	0x09, 0xce, 0x74, 0xb5, 0x7e, 0x8f, 0x49, 0xa3, 0x98, 0x9f, 0xbf, 0xef, 0x78, 0x3e, 0xbf, 0xf9,
-	0x51, 0x2a, 0xfb, 0x7d, 0x14, 0xfb, 0x4f, 0x4a, 0x5d, 0x17, 0x02, 0xdd, 0x09, 0xfd, 0xc8, 0x8e,
-	0xd2, 0x0b, 0x30, 0xec, 0xb4, 0x5a, 0x1c, 0x8f, 0x45, 0xd1, 0x66, 0x0a, 0x30, 0x4d, 0x9b, 0x40,
-	0x9c, 0xc6, 0xed, 0xc9, 0xa6, 0xf9, 0x53, 0x0b, 0x6a, 0x98, 0x6c, 0x70, 0x69, 0x84, 0x6e, 0x8a,
-	0x21, 0xb2, 0x8a, 0xa8, 0x36, 0x4b, 0x07, 0x36, 0xf6, 0x58, 0x15, 0xd6, 0xbc, 0xc1, 0xee, 0xbc,
-	0x10, 0xac, 0x74, 0xa0, 0x0b, 0xc1, 0xd4, 0x95, 0x50, 0xe5, 0xee, 0x57, 0x42, 0xd9, 0xdf, 0x1b,
-	0xa0, 0xaf, 0xd7, 0x0a, 0x67, 0x23, 0x52, 0x8f, 0xe9, 0xf7, 0x6d, 0x47, 0xbe, 0x98, 0x24, 0xea,
-	0xfb, 0x5e, 0xc3, 0x8b, 0x98, 0xb6, 0xa7, 0x0e, 0xc8, 0x4a, 0x07, 0x2a, 0x3f, 0x53, 0xde, 0xb7,
-	0xfc, 0xcc, 0x0b, 0x30, 0x1c, 0xc7, 0x9b, 0x2b, 0x91, 0xb7, 0xed, 0x24, 0xe4, 0x0a, 0xd9, 0x11,
-	0xb6, 0xaf, 0x2e, 0x02, 0xb1, 0x7a, 0x49, 0x03, 0x71, 0x1a, 0x17, 0xcd, 0xc3, 0x98, 0x2e, 0x02,
-	0x43, 0xa2, 0x84, 0xe5, 0x5c, 0xf0, 0x99, 0xa0, 0x32, 0xbe, 0x75, 0xd9, 0x18, 0x81, 0x80, 0x3b,
-	0x9f, 0xa1, 0xf2, 0x34, 0xd5, 0x48, 0x19, 0xe9, 0x4f, 0xcb, 0xd3, 0x54, 0x3f, 0x94, 0x97, 0x8e,
-	0x27, 0xd0, 0x12, 0x9c, 0xe4, 0x13, 0x63, 0xba, 0xd5, 0x32, 0xde, 0x68, 0x20, 0x5d, 0xe5, 0x73,
-	0xbe, 0x13, 0x05, 0xe7, 0x3d, 0x87, 0x9e, 0x83, 0x41, 0xd5, 0xbc, 0x30, 0x27, 0xce, 0x76, 0x94,
-	0x6f, 0x49, 0x75, 0xb3, 0x50, 0xc7, 0x26, 0x1e, 0xfa, 0x00, 0x3c, 0xac, 0xff, 0xf2, 0xc4, 0x3c,
-	0x7e, 0xe0, 0x39, 0x27, 0xea, 0x6b, 0xa9, 0x0b, 0x88, 0xe6, 0x73, 0xd1, 0xea, 0xb8, 0xdb, 0xf3,
-	0x68, 0x1d, 0xce, 0x2a, 0xd0, 0x85, 0x20, 0x61, 0x59, 0x36, 0x31, 0x99, 0x71, 0x62, 0x72, 0x2d,
-	0xf2, 0xc5, 0x45, 0xd6, 0xea, 0x8e, 0xda, 0x79, 0x2f, 0xb9, 0x94, 0x87, 0x89, 0x17, 0xf1, 0x1e,
-	0xbd, 0xa0, 0x29, 0xa8, 0x91, 0xc0, 0x59, 0xf7, 0xc9, 0xf2, 0xec, 0x02, 0xab, 0xd3, 0x65, 0x9c,
-	0xaf, 0x5e, 0x90, 0x00, 0xac, 0x71, 0x54, 0xdc, 0xef, 0x50, 0xd7, 0xfb, 0x92, 0x57, 0xe0, 0x54,
-	0xc3, 0x6d, 0x51, 0x8b, 0xd0, 0x73, 0xc9, 0xb4, 0xcb, 0xc2, 0x1c, 0xe9, 0x87, 0xe1, 0xe5, 0x57,
-	0x55, 0x50, 0xfb, 0xfc, 0xec, 0x4a, 0x07, 0x0e, 0xce, 0x7d, 0x92, 0x85, 0xc3, 0x46, 0xe1, 0xed,
-	0x9d, 0xf1, 0x93, 0x99, 0x70, 0x58, 0xda, 0x88, 0x39, 0x0c, 0x5d, 0x06, 0xc4, 0x32, 0x24, 0x2e,
-	0x25, 0x49, 0x4b, 0x99, 0xa0, 0xe3, 0xa7, 0xd8, 0x2b, 0xa9, 0xe0, 0xbe, 0x8b, 0x1d, 0x18, 0x38,
-	0xe7, 0x29, 0x6a, 0xd1, 0x04, 0x21, 0xeb, 0x7d, 0xfc, 0xe1, 0xb4, 0x45, 0x73, 0x95, 0x37, 0x63,
-	0x09, 0xb7, 0xff, 0x93, 0x05, 0xc3, 0x6a, 0x69, 0x1f, 0x43, 0x3a, 0x91, 0x9f, 0x4e, 0x27, 0x9a,
-	0x3f, 0xbc, 0x70, 0x64, 0x9c, 0x77, 0x89, 0x49, 0xff, 0xe6, 0x20, 0x80, 0x16, 0xa0, 0x4a, 0x77,
-	0x59, 0x5d, 0x75, 0xd7, 0x03, 0x2b, 0xbc, 0xf2, 0x2a, 0xf2, 0x54, 0xee, 0x6f, 0x45, 0x9e, 0x55,
-	0x38, 0x2d, 0x2d, 0x0b, 0x7e, 0xd8, 0x77, 0x29, 0x8c, 0x95, 0x2c, 0xac, 0xce, 0x3c, 0x2a, 0x3a,
-	0x3a, 0xbd, 0x90, 0x87, 0x84, 0xf3, 0x9f, 0x4d, 0x19, 0x34, 0x03, 0xfb, 0x5a, 0x99, 0x6a, 0xf9,
-	0x2f, 0x6e, 0xc8, 0x8b, 0x7c, 0x32, 0xcb, 0x7f, 0xf1, 0xe2, 0x2a, 0xd6, 0x38, 0xf9, 0x3a, 0xa0,
-	0x56, 0x90, 0x0e, 0x80, 0x03, 0xeb, 0x00, 0x29, 0x8d, 0x06, 0xbb, 0x4a, 0x23, 0x79, 0xa8, 0x30,
-	0xd4, 0xf5, 0x50, 0xe1, 0xbd, 0x30, 0xe2, 0x05, 0x9b, 0x24, 0xf2, 0x12, 0x52, 0x67, 0x6b, 0x81,
-	0x49, 0xaa, 0xaa, 0xb6, 0x00, 0x16, 0x52, 0x50, 0x9c, 0xc1, 0x4e, 0x8b, 0xd0, 0x91, 0x1e, 0x44,
-	0x68, 0x17, 0xc5, 0x35, 0x5a, 0x8c, 0xe2, 0x3a, 0x71, 0x78, 0xc5, 0x35, 0x76, 0xa4, 0x8a, 0x0b,
-	0x15, 0xa2, 0xb8, 0x7a, 0xd2, 0x09, 0xc6, 0xce, 0xf4, 0xd4, 0x3e, 0x3b, 0xd3, 0x6e, 0x5a, 0xeb,
-	0xf4, 0x3d, 0x6b, 0xad, 0x7c, 0x85, 0xf4, 0xd0, 0x51, 0x2b, 0xa4, 0xcf, 0x94, 0xe0, 0xb4, 0x16,
-	0xd9, 0x74, 0xa1, 0x78, 0x1b, 0x54, 0x68, 0xb1, 0x6b, 0xe3, 0xf8, 0x19, 0x9d, 0x91, 0x08, 0xa7,
-	0x73, 0xea, 0x14, 0x04, 0x1b, 0x58, 0x2c, 0x9f, 0x8c, 0x44, 0xac, 0x06, 0x75, 0x56, 0x9e, 0xcf,
-	0x8a, 0x76, 0xac, 0x30, 0xe8, 0x54, 0xa4, 0xbf, 0x45, 0x8e, 0x6e, 0xb6, 0xba, 0xe1, 0xac, 0x06,
-	0x61, 0x13, 0x0f, 0x3d, 0xc9, 0x89, 0x30, 0x59, 0x42, 0x65, 0xfa, 0x90, 0xb8, 0x9b, 0x5b, 0x8a,
-	0x0f, 0x05, 0x95, 0xec, 0xb0, 0xc4, 0xc1, 0x4a, 0x27, 0x3b, 0x2c, 0xdc, 0x4d, 0x61, 0xd8, 0xff,
-	0xd3, 0x82, 0x33, 0xb9, 0x43, 0x71, 0x0c, 0x7a, 0xfa, 0x76, 0x5a, 0x4f, 0xaf, 0x16, 0xb5, 0x89,
-	0x31, 0xde, 0xa2, 0x8b, 0xce, 0xfe, 0x0f, 0x16, 0x8c, 0x68, 0xfc, 0x63, 0x78, 0x55, 0x2f, 0xfd,
-	0xaa, 0xc5, 0xed, 0xd7, 0x6a, 0x1d, 0xef, 0xf6, 0xfb, 0x25, 0x50, 0x15, 0x47, 0xa7, 0x5d, 0x59,
-	0xcf, 0x79, 0x9f, 0x53, 0xe3, 0x1d, 0xe8, 0x67, 0x87, 0xde, 0x71, 0x31, 0x01, 0x3d, 0x69, 0xfa,
-	0xec, 0x00, 0x5d, 0x07, 0x14, 0xb0, 0xbf, 0x31, 0x16, 0x04, 0x59, 0x85, 0x74, 0x2f, 0xa6, 0x82,
-	0xbf, 0x2e, 0x52, 0xf0, 0x74, 0x85, 0x74, 0xd1, 0x8e, 0x15, 0x06, 0xd5, 0x24, 0x9e, 0x1b, 0x06,
-	0xb3, 0xbe, 0x13, 0xcb, 0x7b, 0x5f, 0x95, 0x26, 0x59, 0x90, 0x00, 0xac, 0x71, 0xd8, 0x79, 0xb8,
-	0x17, 0xb7, 0x7c, 0x67, 0xc7, 0xd8, 0x95, 0x1b, 0xb5, 0x28, 0x14, 0x08, 0x9b, 0x78, 0x76, 0x13,
-	0xc6, 0xd3, 0x2f, 0x31, 0x47, 0x36, 0x58, 0x30, 0x6a, 0x4f, 0xc3, 0x39, 0x05, 0x35, 0x87, 0x3d,
-	0xb5, 0xd8, 0x76, 0x84, 0x4c, 0xd0, 0x21, 0x99, 0x12, 0x80, 0x35, 0x8e, 0xfd, 0x8f, 0x2c, 0x38,
-	0x99, 0x33, 0x68, 0x05, 0xa6, 0x38, 0x26, 0x5a, 0xda, 0xe4, 0xd9, 0x00, 0x6f, 0x87, 0x81, 0x3a,
-	0xd9, 0x70, 0x64, 0xb8, 0xa3, 0x21, 0x3d, 0xe7, 0x78, 0x33, 0x96, 0x70, 0xfb, 0xb7, 0x4b, 0x30,
-	0x9a, 0xe6, 0x35, 0x66, 0x69, 0x43, 0x7c, 0x98, 0xbc, 0xd8, 0x0d, 0xb7, 0x49, 0xb4, 0x43, 0xdf,
-	0xdc, 0xca, 0xa4, 0x0d, 0x75, 0x60, 0xe0, 0x9c, 0xa7, 0x58, 0xbd, 0xe1, 0xba, 0x1a, 0x6d, 0x39,
-	0x23, 0xaf, 0x17, 0x39, 0x23, 0xf5, 0xc7, 0x34, 0x43, 0x23, 0x14, 0x49, 0x6c, 0xd2, 0xa7, 0xb6,
-	0x08, 0x8b, 0xc3, 0x9e, 0x69, 0x7b, 0x7e, 0xe2, 0x05, 0xe2, 0x95, 0xc5, 0x5c, 0x55, 0xb6, 0xc8,
-	0x52, 0x27, 0x0a, 0xce, 0x7b, 0xce, 0xfe, 0x7e, 0x1f, 0xa8, 0x94, 0x6a, 0x16, 0xba, 0x56, 0x50,
-	0xe0, 0xdf, 0x41, 0x93, 0xcf, 0xd4, 0xdc, 0xea, 0xdb, 0x2b, 0x96, 0x84, 0xbb, 0x72, 0x4c, 0x7f,
-	0xae, 0x1a, 0xb0, 0x35, 0x0d, 0xc2, 0x26, 0x1e, 0xe5, 0xc4, 0xf7, 0xb6, 0x09, 0x7f, 0xa8, 0x3f,
-	0xcd, 0xc9, 0xa2, 0x04, 0x60, 0x8d, 0x43, 0x39, 0xa9, 0x7b, 0x1b, 0x1b, 0xc2, 0x2f, 0xa1, 0x38,
-	0xa1, 0xa3, 0x83, 0x19, 0x84, 0x57, 0xa4, 0x0f, 0xb7, 0x84, 0xfd, 0x6d, 0x54, 0xa4, 0x0f, 0xb7,
-	0x30, 0x83, 0xd0, 0xaf, 0x14, 0x84, 0x51, 0xd3, 0xf1, 0xbd, 0xd7, 0x48, 0x5d, 0x51, 0x11, 0x76,
-	0xb7, 0xfa, 0x4a, 0x57, 0x3b, 0x51, 0x70, 0xde, 0x73, 0x74, 0x42, 0xb7, 0x22, 0x52, 0xf7, 0xdc,
-	0xc4, 0xec, 0x0d, 0xd2, 0x13, 0x7a, 0xa5, 0x03, 0x03, 0xe7, 0x3c, 0x85, 0xa6, 0x61, 0x54, 0xa6,
-	0xc4, 0xcb, 0x82, 0x47, 0x83, 0xe9, 0x02, 0x2b, 0x38, 0x0d, 0xc6, 0x59, 0x7c, 0x2a, 0x24, 0x9b,
-	0xa2, 0x26, 0x1a, 0x33, 0xd3, 0x0d, 0x21, 0x29, 0x6b, 0xa5, 0x61, 0x85, 0x61, 0x7f, 0xa2, 0x4c,
-	0x95, 0x7a, 0x97, 0xd2, 0x83, 0xc7, 0x16, 0x68, 0x9a, 0x9e, 0x91, 0x7d, 0x3d, 0xcc, 0xc8, 0x67,
-	0x61, 0xe8, 0x66, 0x1c, 0x06, 0x2a, 0x88, 0xb3, 0xd2, 0x35, 0x88, 0xd3, 0xc0, 0xca, 0x0f, 0xe2,
-	0xec, 0x2f, 0x2a, 0x88, 0x73, 0xe0, 0x1e, 0x83, 0x38, 0xff, 0xb0, 0x02, 0xea, 0x76, 0x9f, 0xab,
-	0x24, 0xb9, 0x15, 0x46, 0x5b, 0x5e, 0xd0, 0x60, 0xa5, 0x04, 0xbe, 0x66, 0xc1, 0x10, 0x5f, 0x2f,
-	0x8b, 0x66, 0x12, 0xde, 0x46, 0x41, 0xd7, 0xc6, 0xa4, 0x88, 0x4d, 0xae, 0x19, 0x84, 0x32, 0x37,
-	0xff, 0x9a, 0x20, 0x9c, 0xe2, 0x08, 0x7d, 0x14, 0x40, 0x3a, 0x71, 0x37, 0xa4, 0x04, 0x5e, 0x28,
-	0x86, 0x3f, 0x4c, 0x36, 0xb4, 0x49, 0xbd, 0xa6, 0x88, 0x60, 0x83, 0x20, 0xfa, 0x8c, 0x4e, 0x50,
-	0xe4, 0xd9, 0x1e, 0x1f, 0x3e, 0x92, 0xb1, 0xe9, 0x25, 0x3d, 0x11, 0xc3, 0x80, 0x17, 0x34, 0xe8,
-	0x3c, 0x11, 0xc1, 0x6e, 0x6f, 0xcb, 0x2b, 0xc3, 0xb1, 0x18, 0x3a, 0xf5, 0x19, 0xc7, 0x77, 0x02,
-	0x97, 0x44, 0x0b, 0x1c, 0xdd, 0xbc, 0xbb, 0x9f, 0x35, 0x60, 0xd9, 0x51, 0xc7, 0xbd, 0x48, 0x95,
-	0x5e, 0xee, 0x45, 0x3a, 0xfb, 0x3e, 0x18, 0xeb, 0xf8, 0x98, 0x07, 0xca, 0x46, 0xbc, 0xf7, 0x44,
-	0x46, 0xfb, 0x77, 0xfa, 0xb5, 0xd2, 0xba, 0x1a, 0xd6, 0xf9, 0x35, 0x3b, 0x91, 0xfe, 0xa2, 0xc2,
-	0x64, 0x2e, 0x70, 0x8a, 0x18, 0xf7, 0xff, 0xab, 0x46, 0x6c, 0x92, 0xa4, 0x73, 0xb4, 0xe5, 0x44,
-	0x24, 0x38, 0xea, 0x39, 0xba, 0xa2, 0x88, 0x60, 0x83, 0x20, 0xda, 0x4c, 0xa5, 0x23, 0x5d, 0x3c,
-	0x7c, 0x3a, 0x12, 0x2b, 0x50, 0x96, 0x77, 0x1b, 0xc5, 0x17, 0x2d, 0x18, 0x09, 0x52, 0x33, 0xb7,
-	0x98, 0x08, 0xe4, 0xfc, 0x55, 0xc1, 0x2f, 0x87, 0x4b, 0xb7, 0xe1, 0x0c, 0xfd, 0x3c, 0x95, 0x56,
-	0x39, 0xa0, 0x4a, 0xd3, 0xd7, 0x7c, 0xf5, 0x77, 0xbb, 0xe6, 0x0b, 0x05, 0xea, 0x9e, 0xc3, 0x81,
-	0xc2, 0xef, 0x39, 0x84, 0x9c, 0x3b, 0x0e, 0x6f, 0x40, 0xcd, 0x8d, 0x88, 0x93, 0xdc, 0xe3, 0x95,
-	0x77, 0x2c, 0xb6, 0x63, 0x56, 0x76, 0x80, 0x75, 0x5f, 0xf6, 0xff, 0xe9, 0x83, 0x13, 0x72, 0x44,
-	0x64, 0xf6, 0x02, 0xd5, 0x8f, 0x9c, 0xae, 0xb6, 0x95, 0x95, 0x7e, 0xbc, 0x24, 0x01, 0x58, 0xe3,
-	0x50, 0x7b, 0xac, 0x1d, 0x93, 0xe5, 0x16, 0x09, 0x16, 0xbd, 0xf5, 0x58, 0x1c, 0xc6, 0xaa, 0x85,
-	0x72, 0x4d, 0x83, 0xb0, 0x89, 0x47, 0x6d, 0x7b, 0xc7, 0x30, 0x5a, 0x0d, 0xdb, 0x5e, 0x1a, 0xaa,
-	0x12, 0x8e, 0x7e, 0x25, 0xb7, 0x16, 0x72, 0x31, 0x39, 0x7f, 0x1d, 0x49, 0x1b, 0x07, 0xbc, 0x25,
-	0xf5, 0xef, 0x5b, 0x70, 0x9a, 0xb7, 0xca, 0x91, 0xbc, 0xd6, 0xaa, 0x3b, 0x09, 0x89, 0x8b, 0xb9,
-	0xc9, 0x20, 0x87, 0x3f, 0xed, 0x5e, 0xce, 0x23, 0x8b, 0xf3, 0xb9, 0x41, 0xaf, 0x5b, 0x30, 0xba,
-	0x95, 0x2a, 0x17, 0x23, 0x55, 0xc7, 0x61, 0x2b, 0x39, 0xa4, 0x3a, 0xd5, 0x4b, 0x2d, 0xdd, 0x1e,
-	0xe3, 0x2c, 0x75, 0xfb, 0x7f, 0x58, 0x60, 0x8a, 0xd1, 0xe3, 0xaf, 0x32, 0x73, 0x70, 0x53, 0x50,
-	0x5a, 0x97, 0x95, 0xae, 0xd6, 0xe5, 0xa3, 0x50, 0x6e, 0x7b, 0x75, 0xb1, 0xbf, 0xd0, 0x47, 0xc4,
-	0x0b, 0x73, 0x98, 0xb6, 0xdb, 0xff, 0xb2, 0xa2, 0xdd, 0x20, 0x22, 0xa5, 0xee, 0x47, 0xe2, 0xb5,
-	0x37, 0x54, 0x9d, 0x3a, 0xfe, 0xe6, 0x57, 0x3b, 0xea, 0xd4, 0xfd, 0xf4, 0xc1, 0x33, 0x26, 0xf9,
-	0x00, 0x75, 0x2b, 0x53, 0x37, 0xb0, 0x4f, 0xba, 0xe4, 0x4d, 0xa8, 0xd2, 0x2d, 0x18, 0xf3, 0x67,
-	0x56, 0x53, 0x4c, 0x55, 0x2f, 0x89, 0xf6, 0xbb, 0xbb, 0x13, 0xef, 0x3e, 0x38, 0x5b, 0xf2, 0x69,
-	0xac, 0xfa, 0x47, 0x31, 0xd4, 0xe8, 0x6f, 0x96, 0xd9, 0x29, 0x36, 0x77, 0xd7, 0x94, 0xcc, 0x94,
-	0x80, 0x42, 0xd2, 0x46, 0x35, 0x1d, 0x14, 0x40, 0x8d, 0x5d, 0x28, 0xcd, 0x88, 0xf2, 0x3d, 0xe0,
-	0x8a, 0xca, 0xaf, 0x94, 0x80, 0xbb, 0xbb, 0x13, 0x2f, 0x1c, 0x9c, 0xa8, 0x7a, 0x1c, 0x6b, 0x12,
-	0xf6, 0x97, 0xfa, 0xf4, 0xdc, 0x15, 0xe5, 0x09, 0x7f, 0x24, 0xe6, 0xee, 0xf3, 0x99, 0xb9, 0x7b,
-	0xae, 0x63, 0xee, 0x8e, 0xe8, 0x8b, 0x8f, 0x53, 0xb3, 0xf1, 0xb8, 0x0d, 0x81, 0xfd, 0xfd, 0x0d,
-	0xcc, 0x02, 0x7a, 0xb5, 0xed, 0x45, 0x24, 0x5e, 0x89, 0xda, 0x81, 0x17, 0x34, 0xd8, 0x74, 0xac,
-	0x9a, 0x16, 0x50, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0x53, 0x4f, 0xbf, 0xf9, 0x0d, 0x67, 0x9b, 0xcf,
-	0x2a, 0xa3, 0x62, 0xdb, 0xaa, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0x06, 0x3b, 0x45, 0x37, 0x52, 0xca,
-	0xe9, 0x9c, 0xf0, 0xd9, 0x0d, 0xde, 0xbc, 0xdc, 0x9b, 0x9a, 0x13, 0xfc, 0xda, 0x6e, 0x0e, 0x43,
-	0xb7, 0x60, 0x60, 0x9d, 0xdf, 0x45, 0x59, 0x4c, 0xc5, 0x7d, 0x71, 0xb1, 0x25, 0xbb, 0xe5, 0x47,
-	0xde, 0x72, 0x79, 0x57, 0xff, 0xc4, 0x92, 0x9a, 0xfd, 0xed, 0x0a, 0x8c, 0x66, 0xee, 0x78, 0x4e,
-	0x15, 0xda, 0x2d, 0xed, 0x5b, 0x68, 0xf7, 0x43, 0x00, 0x75, 0xd2, 0xf2, 0xc3, 0x1d, 0x66, 0x8e,
-	0xf5, 0x1d, 0xd8, 0x1c, 0x53, 0x16, 0xfc, 0x9c, 0xea, 0x05, 0x1b, 0x3d, 0x8a, 0x1a, 0x77, 0xbc,
-	0x6e, 0x6f, 0xa6, 0xc6, 0x9d, 0x71, 0x2f, 0x47, 0xff, 0xf1, 0xde, 0xcb, 0xe1, 0xc1, 0x28, 0x67,
-	0x51, 0x25, 0x6e, 0xdf, 0x43, 0x7e, 0x36, 0x4b, 0x7d, 0x99, 0x4b, 0x77, 0x83, 0xb3, 0xfd, 0xde,
-	0xcf, 0x2b, 0xdc, 0xd1, 0x3b, 0xa0, 0x26, 0xbf, 0x73, 0x3c, 0x5e, 0xd3, 0xc5, 0x2f, 0xe4, 0x34,
-	0x60, 0x57, 0xab, 0x8b, 0x9f, 0x1d, 0x35, 0x28, 0xe0, 0x7e, 0xd5, 0xa0, 0xb0, 0xbf, 0x50, 0xa2,
-	0x76, 0x3c, 0xe7, 0x4b, 0x95, 0x53, 0x7a, 0x02, 0xfa, 0x9d, 0x76, 0xb2, 0x19, 0x76, 0xdc, 0x66,
-	0x39, 0xcd, 0x5a, 0xb1, 0x80, 0xa2, 0x45, 0xe8, 0xab, 0xeb, 0x12, 0x39, 0x07, 0xf9, 0x9e, 0xda,
-	0x25, 0xea, 0x24, 0x04, 0xb3, 0x5e, 0xd0, 0x23, 0xd0, 0x97, 0x38, 0x0d, 0x99, 0xad, 0xc7, 0x32,
-	0xb4, 0xd7, 0x9c, 0x46, 0x8c, 0x59, 0xab, 0xa9, 0xbe, 0xfb, 0xf6, 0x51, 0xdf, 0x2f, 0xc0, 0x70,
-	0xec, 0x35, 0x02, 0x27, 0x69, 0x47, 0xc4, 0x38, 0x35, 0xd4, 0x31, 0x23, 0x26, 0x10, 0xa7, 0x71,
-	0xed, 0xdf, 0x1d, 0x82, 0x53, 0xab, 0xb3, 0x4b, 0xb2, 0xf0, 0xfb, 0x91, 0x25, 0xdc, 0xe5, 0xd1,
-	0x38, 0xbe, 0x84, 0xbb, 0x2e, 0xd4, 0x7d, 0x23, 0xe1, 0xce, 0x37, 0x12, 0xee, 0xd2, 0xd9, 0x4f,
-	0xe5, 0x22, 0xb2, 0x9f, 0xf2, 0x38, 0xe8, 0x25, 0xfb, 0xe9, 0xc8, 0x32, 0xf0, 0xf6, 0x64, 0xe8,
-	0x40, 0x19, 0x78, 0x2a, 0x3d, 0xb1, 0x90, 0xbc, 0x94, 0x2e, 0x9f, 0x2a, 0x37, 0x3d, 0x51, 0xa5,
-	0x86, 0xf1, 0x9c, 0x2b, 0x21, 0xea, 0x5f, 0x2e, 0x9e, 0x81, 0x1e, 0x52, 0xc3, 0x44, 0xda, 0x97,
-	0x99, 0x8e, 0x38, 0x50, 0x44, 0x3a, 0x62, 0x1e, 0x3b, 0xfb, 0xa6, 0x23, 0xbe, 0x00, 0xc3, 0xae,
-	0x1f, 0x06, 0x64, 0x25, 0x0a, 0x93, 0xd0, 0x0d, 0x7d, 0x61, 0xd6, 0xeb, 0x8b, 0x68, 0x4c, 0x20,
-	0x4e, 0xe3, 0x76, 0xcb, 0x65, 0xac, 0x1d, 0x36, 0x97, 0x11, 0xee, 0x53, 0x2e, 0xe3, 0x2f, 0xea,
-	0xac, 0xfb, 0x41, 0xf6, 0x45, 0x3e, 0x54, 0xfc, 0x17, 0xe9, 0xe9, 0xaa, 0xbe, 0x37, 0xf8, 0x75,
-	0x92, 0xd4, 0x30, 0x9e, 0x0d, 0x9b, 0xd4, 0xf0, 0x1b, 0x62, 0x43, 0xf2, 0xca, 0x11, 0x4c, 0xd8,
-	0x1b, 0xab, 0x9a, 0x8c, 0xba, 0x62, 0x52, 0x37, 0xe1, 0x34, 0x23, 0x87, 0xa9, 0x0a, 0xf0, 0x95,
-	0x12, 0xfc, 0xd8, 0xbe, 0x2c, 0xa0, 0x5b, 0x00, 0x89, 0xd3, 0x10, 0x13, 0x55, 0x1c, 0x98, 0x1c,
-	0x32, 0xb0, 0x73, 0x4d, 0xf6, 0xc7, 0xcb, 0xd9, 0xa8, 0xbf, 0xec, 0x28, 0x42, 0xfe, 0x66, 0xf1,
-	0x9c, 0xa1, 0xdf, 0x51, 0xf5, 0x13, 0x87, 0x3e, 0xc1, 0x0c, 0x42, 0xd5, 0x7f, 0x44, 0x1a, 0xfa,
-	0x2e, 0x76, 0xf5, 0xf9, 0x30, 0x6b, 0xc5, 0x02, 0x8a, 0x9e, 0x83, 0x41, 0xc7, 0xf7, 0x79, 0xd2,
-	0x10, 0x89, 0xc5, 0x0d, 0x51, 0xba, 0xfc, 0xa0, 0x06, 0x61, 0x13, 0xcf, 0xfe, 0xcb, 0x12, 0x4c,
-	0xec, 0x23, 0x53, 0x3a, 0x92, 0x45, 0x2b, 0x3d, 0x27, 0x8b, 0x8a, 0x44, 0x8a, 0xfe, 0x2e, 0x89,
-	0x14, 0xcf, 0xc1, 0x60, 0x42, 0x9c, 0xa6, 0x08, 0x05, 0x13, 0x9e, 0x00, 0x7d, 0x02, 0xac, 0x41,
-	0xd8, 0xc4, 0xa3, 0x52, 0x6c, 0xc4, 0x71, 0x5d, 0x12, 0xc7, 0x32, 0x53, 0x42, 0x78, 0x53, 0x0b,
-	0x4b, 0xc3, 0x60, 0x4e, 0xea, 0xe9, 0x14, 0x09, 0x9c, 0x21, 0x99, 0x1d, 0xf0, 0x5a, 0x8f, 0x03,
-	0xfe, 0xf5, 0x12, 0x3c, 0xba, 0xa7, 0x76, 0xeb, 0x39, 0x89, 0xa5, 0x1d, 0x93, 0x28, 0x3b, 0x71,
-	0xae, 0xc5, 0x24, 0xc2, 0x0c, 0xc2, 0x47, 0xa9, 0xd5, 0x32, 0xee, 0xba, 0x2f, 0x3a, 0xa3, 0x8b,
-	0x8f, 0x52, 0x8a, 0x04, 0xce, 0x90, 0xbc, 0xd7, 0x69, 0xf9, 0xed, 0x3e, 0x78, 0xbc, 0x07, 0x1b,
-	0xa0, 0xc0, 0xcc, 0xb7, 0x74, 0x96, 0x66, 0xf9, 0x3e, 0x65, 0x69, 0xde, 0xdb, 0x70, 0xbd, 0x99,
-	0xdc, 0xd9, 0x53, 0x86, 0xdd, 0x37, 0x4a, 0x70, 0xb6, 0xbb, 0xc1, 0x82, 0xde, 0x03, 0xa3, 0x91,
-	0x0a, 0x7d, 0x33, 0x13, 0x3c, 0x4f, 0x72, 0x7f, 0x4b, 0x0a, 0x84, 0xb3, 0xb8, 0x68, 0x12, 0xa0,
-	0xe5, 0x24, 0x9b, 0xf1, 0x85, 0xdb, 0x5e, 0x9c, 0x88, 0x32, 0x4f, 0x23, 0xfc, 0x84, 0x4f, 0xb6,
-	0x62, 0x03, 0x83, 0x92, 0x63, 0xff, 0xe6, 0xc2, 0xab, 0x61, 0xc2, 0x1f, 0xe2, 0x9b, 0xad, 0x93,
-	0xf2, 0x52, 0x1c, 0x03, 0x84, 0xb3, 0xb8, 0x94, 0x1c, 0x3b, 0x43, 0xe6, 0x8c, 0xf2, 0x5d, 0x18,
-	0x23, 0xb7, 0xa8, 0x5a, 0xb1, 0x81, 0x91, 0x4d, 0x5d, 0xad, 0xec, 0x9f, 0xba, 0x6a, 0xff, 0x8b,
-	0x12, 0x9c, 0xe9, 0x6a, 0xf0, 0xf6, 0x26, 0xa6, 0x1e, 0xbc, 0x74, 0xd3, 0x7b, 0x5c, 0x61, 0x07,
-	0x4b, 0x53, 0xfc, 0xd3, 0x2e, 0x33, 0x4d, 0xa4, 0x29, 0xde, 0x7b, 0xf5, 0x85, 0x07, 0x6f, 0x3c,
-	0x3b, 0x32, 0x13, 0xfb, 0x0e, 0x90, 0x99, 0x98, 0xf9, 0x18, 0x95, 0x1e, 0xb5, 0xc3, 0x9f, 0xf7,
-	0x75, 0x1d, 0x5e, 0xba, 0x41, 0xee, 0xc9, 0x9b, 0x3d, 0x07, 0x27, 0xbc, 0x80, 0x5d, 0x90, 0xb6,
-	0xda, 0x5e, 0x17, 0x95, 0x7f, 0x78, 0x79, 0x4b, 0x95, 0xfe, 0xb0, 0x90, 0x81, 0xe3, 0x8e, 0x27,
-	0x1e, 0xc0, 0x4c, 0xd1, 0x7b, 0x1b, 0xd2, 0x03, 0x4a, 0xee, 0x65, 0x38, 0x2d, 0x87, 0x62, 0xd3,
-	0x89, 0x48, 0x5d, 0x28, 0xdb, 0x58, 0x24, 0xbc, 0x9c, 0xe1, 0x49, 0x33, 0x39, 0x08, 0x38, 0xff,
-	0x39, 0x76, 0x27, 0x55, 0xd8, 0xf2, 0x5c, 0xb1, 0x15, 0xd4, 0x77, 0x52, 0xd1, 0x46, 0xcc, 0x61,
-	0x5a, 0x5f, 0xd4, 0x8e, 0x47, 0x5f, 0x7c, 0x08, 0x6a, 0x6a, 0xbc, 0x79, 0xec, 0xbe, 0x9a, 0xe4,
-	0x1d, 0xb1, 0xfb, 0x6a, 0x86, 0x1b, 0x58, 0xfb, 0x5d, 0x9a, 0xfa, 0x4e, 0x18, 0x52, 0xde, 0xaf,
-	0x5e, 0x6f, 0x06, 0xb3, 0xbf, 0xd4, 0x0f, 0xc3, 0xa9, 0x6a, 0x9f, 0x29, 0xb7, 0xb7, 0xb5, 0xaf,
-	0xdb, 0x9b, 0xa5, 0x6d, 0xb4, 0x03, 0x79, 0x6d, 0xa0, 0x91, 0xb6, 0xd1, 0x0e, 0x08, 0xe6, 0x30,
-	0xba, 0xe9, 0xa8, 0x47, 0x3b, 0xb8, 0x1d, 0x88, 0x38, 0x54, 0xb5, 0xe9, 0x98, 0x63, 0xad, 0x58,
-	0x40, 0xd1, 0xc7, 0x2d, 0x18, 0x8a, 0xd9, 0x99, 0x0a, 0x3f, 0x34, 0x10, 0x93, 0xfc, 0xf2, 0xe1,
-	0x8b, 0x99, 0xaa, 0xca, 0xb6, 0x2c, 0x6e, 0xc9, 0x6c, 0xc1, 0x29, 0x8a, 0xe8, 0x53, 0x16, 0xd4,
-	0xd4, 0xed, 0x46, 0xe2, 0x0e, 0xd0, 0xd5, 0x62, 0x8b, 0xa9, 0x72, 0x6f, 0xb3, 0x3a, 0x9e, 0x52,
-	0x55, 0x2d, 0xb1, 0x26, 0x8c, 0x62, 0xe5, 0xd1, 0x1f, 0x38, 0x1a, 0x8f, 0x3e, 0xe4, 0x78, 0xf3,
-	0xdf, 0x01, 0xb5, 0xa6, 0x13, 0x78, 0x1b, 0x24, 0x4e, 0xb8, 0x93, 0x5d, 0xd6, 0x78, 0x96, 0x8d,
-	0x58, 0xc3, 0xa9, 0x01, 0x10, 0xb3, 0x17, 0x4b, 0x0c, 0xaf, 0x38, 0x33, 0x00, 0x56, 0x75, 0x33,
-	0x36, 0x71, 0x4c, 0x17, 0x3e, 0xdc, 0x57, 0x17, 0xfe, 0xe0, 0xde, 0x2e, 0x7c, 0xfb, 0x9f, 0x5a,
-	0x70, 0x3a, 0xf7, 0xab, 0x3d, 0xb8, 0xe1, 0xa8, 0xf6, 0x97, 0x2b, 0x70, 0x32, 0xa7, 0x6c, 0x2f,
-	0xda, 0x31, 0xe7, 0xb3, 0x55, 0x44, 0x64, 0x47, 0x3a, 0x50, 0x41, 0x0e, 0x63, 0xce, 0x24, 0x3e,
-	0xd8, 0x01, 0x9a, 0x3e, 0xc4, 0x2a, 0x1f, 0xef, 0x21, 0x96, 0x31, 0x2d, 0xfb, 0xee, 0xeb, 0xb4,
-	0xac, 0xec, 0x73, 0xb2, 0xf4, 0x4d, 0x0b, 0xc6, 0x9b, 0x5d, 0xee, 0x8a, 0x10, 0xee, 0xe0, 0xeb,
-	0x47, 0x73, 0x13, 0xc5, 0xcc, 0x23, 0x77, 0x76, 0x27, 0xba, 0x5e, 0xd1, 0x81, 0xbb, 0x72, 0x65,
-	0x7f, 0xbf, 0x0c, 0xac, 0x66, 0x34, 0x2b, 0xcd, 0xb8, 0x83, 0x3e, 0x66, 0x56, 0xff, 0xb6, 0x8a,
-	0xaa, 0x54, 0xcd, 0x3b, 0x57, 0xd5, 0xc3, 0xf9, 0x08, 0xe6, 0x15, 0x13, 0xcf, 0x0a, 0xad, 0x52,
-	0x0f, 0x42, 0xcb, 0x97, 0x65, 0xd6, 0xcb, 0xc5, 0x97, 0x59, 0xaf, 0x65, 0x4b, 0xac, 0xef, 0xfd,
-	0x89, 0xfb, 0x1e, 0xc8, 0x4f, 0xfc, 0xab, 0x16, 0x17, 0x3c, 0x99, 0xaf, 0xa0, 0x2d, 0x03, 0x6b,
-	0x0f, 0xcb, 0xe0, 0x29, 0xa8, 0xc6, 0xc4, 0xdf, 0xb8, 0x44, 0x1c, 0x5f, 0x58, 0x10, 0x3a, 0xaa,
-	0x40, 0xb4, 0x63, 0x85, 0xc1, 0xee, 0x61, 0xf6, 0xfd, 0xf0, 0xd6, 0x85, 0x66, 0x2b, 0xd9, 0x11,
-	0xb6, 0x84, 0xbe, 0x87, 0x59, 0x41, 0xb0, 0x81, 0x65, 0xff, 0xbd, 0x12, 0x9f, 0x81, 0x22, 0x34,
-	0xe5, 0xf9, 0xcc, 0xcd, 0x99, 0xbd, 0x47, 0x75, 0x7c, 0x04, 0xc0, 0x0d, 0x9b, 0x2d, 0x6a, 0x67,
-	0xae, 0x85, 0xe2, 0xa4, 0xee, 0xd2, 0xa1, 0xef, 0xe9, 0x17, 0xfd, 0xe9, 0xd7, 0xd0, 0x6d, 0xd8,
-	0xa0, 0x97, 0x92, 0xa5, 0xe5, 0x7d, 0x65, 0x69, 0x4a, 0xac, 0xf4, 0xed, 0xa3, 0xed, 0xfe, 0xd2,
-	0x82, 0x94, 0x45, 0x84, 0x5a, 0x50, 0xa1, 0xec, 0xee, 0x88, 0x15, 0xba, 0x5c, 0x9c, 0xf9, 0x45,
-	0x45, 0xa3, 0x98, 0xf6, 0xec, 0x27, 0xe6, 0x84, 0x90, 0x2f, 0x22, 0x58, 0xf8, 0xa8, 0x5e, 0x2d,
-	0x8e, 0xe0, 0xa5, 0x30, 0xdc, 0xe2, 0xc7, 0xcd, 0x3a, 0x1a, 0xc6, 0x7e, 0x1e, 0xc6, 0x3a, 0x98,
-	0x62, 0x97, 0xe4, 0x85, 0x54, 0xfb, 0x64, 0xa6, 0x2b, 0x4b, 0xe8, 0xc5, 0x1c, 0x66, 0x7f, 0xc3,
-	0x82, 0x13, 0xd9, 0xee, 0xd1, 0x1b, 0x16, 0x8c, 0xc5, 0xd9, 0xfe, 0x8e, 0x6a, 0xec, 0x54, 0x14,
-	0x6a, 0x07, 0x08, 0x77, 0x32, 0x61, 0xff, 0x5f, 0x31, 0xf9, 0x6f, 0x78, 0x41, 0x3d, 0xbc, 0xa5,
-	0x0c, 0x13, 0xab, 0xab, 0x61, 0x42, 0xd7, 0xa3, 0xbb, 0x49, 0xea, 0x6d, 0xbf, 0x23, 0x3d, 0x78,
-	0x55, 0xb4, 0x63, 0x85, 0xc1, 0xb2, 0x21, 0xdb, 0xe2, 0x1e, 0x86, 0xcc, 0xa4, 0x9c, 0x13, 0xed,
-	0x58, 0x61, 0xa0, 0x67, 0x61, 0xc8, 0x78, 0x49, 0x39, 0x2f, 0x99, 0x41, 0x6e, 0xa8, 0xcc, 0x18,
-	0xa7, 0xb0, 0xd0, 0x24, 0x80, 0x32, 0x72, 0xa4, 0x8a, 0x64, 0x8e, 0x29, 0x25, 0x89, 0x62, 0x6c,
-	0x60, 0xb0, 0xdc, 0x63, 0xbf, 0x1d, 0xb3, 0x93, 0x97, 0x7e, 0x5d, 0x1b, 0x78, 0x56, 0xb4, 0x61,
-	0x05, 0xa5, 0xd2, 0xa4, 0xe9, 0x04, 0x6d, 0xc7, 0xa7, 0x23, 0x24, 0xb6, 0x9a, 0x6a, 0x19, 0x2e,
-	0x29, 0x08, 0x36, 0xb0, 0xe8, 0x1b, 0x27, 0x5e, 0x93, 0xbc, 0x14, 0x06, 0x32, 0x7a, 0x50, 0x1f,
-	0xc6, 0x89, 0x76, 0xac, 0x30, 0xec, 0xbf, 0xb0, 0x60, 0x54, 0x17, 0x3d, 0xe0, 0xd7, 0xe1, 0x9b,
-	0x3b, 0x63, 0x6b, 0xdf, 0x9d, 0x71, 0x3a, 0xc5, 0xbb, 0xd4, 0x53, 0x8a, 0xb7, 0x99, 0x7d, 0x5d,
-	0xde, 0x33, 0xfb, 0xfa, 0x27, 0xf4, 0x55, 0xcb, 0x3c, 0x4d, 0x7b, 0x30, 0xef, 0x9a, 0x65, 0x64,
-	0x43, 0xbf, 0xeb, 0xa8, 0xe2, 0x40, 0x43, 0x7c, 0xef, 0x30, 0x3b, 0xcd, 0x90, 0x04, 0xc4, 0x5e,
-	0x86, 0x9a, 0x3a, 0x93, 0x92, 0x1b, 0x55, 0x2b, 0x7f, 0xa3, 0xda, 0x53, 0x16, 0xe8, 0xcc, 0xfa,
-	0xb7, 0x7e, 0xf0, 0xd8, 0x5b, 0xfe, 0xf8, 0x07, 0x8f, 0xbd, 0xe5, 0x7b, 0x3f, 0x78, 0xec, 0x2d,
-	0x1f, 0xbf, 0xf3, 0x98, 0xf5, 0xad, 0x3b, 0x8f, 0x59, 0x7f, 0x7c, 0xe7, 0x31, 0xeb, 0x7b, 0x77,
-	0x1e, 0xb3, 0xbe, 0x7f, 0xe7, 0x31, 0xeb, 0x8b, 0xff, 0xe5, 0xb1, 0xb7, 0xbc, 0x94, 0x1b, 0x3e,
-	0x4a, 0x7f, 0x3c, 0xed, 0xd6, 0xa7, 0xb6, 0xcf, 0xb3, 0x08, 0x46, 0xba, 0xbc, 0xa6, 0x8c, 0x39,
-	0x35, 0x25, 0x97, 0xd7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x48, 0xca, 0xc0, 0x22, 0xeb,
-	0x00, 0x00,
+	// 12581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x25, 0xd9,
+	0x59, 0x18, 0xee, 0xbe, 0x0f, 0xe9, 0xde, 0x23, 0x8d, 0x66, 0xd4, 0x33, 0xb3, 0x7b, 0x67, 0xf6,
+	0x31, 0x43, 0x2f, 0xac, 0xcd, 0x0f, 0xac, 0xc1, 0x8b, 0x31, 0xfb, 0x03, 0x6c, 0xd0, 0x63, 0x1e,
+	0xda, 0x95, 0x46, 0xf2, 0x27, 0xed, 0x0c, 0xb6, 0xf1, 0xa3, 0x75, 0xef, 0x91, 0xd4, 0xab, 0x7b,
+	0xbb, 0xef, 0x76, 0xf7, 0xd5, 0x8c, 0x16, 0x63, 0x6c, 0xde, 0xe0, 0x17, 0x31, 0x54, 0x62, 0x2a,
+	0x98, 0xf0, 0x4a, 0x2a, 0xa9, 0x14, 0x05, 0x49, 0x2a, 0x15, 0xaa, 0x08, 0x45, 0x05, 0x52, 0x84,
+	0x40, 0x52, 0x50, 0x14, 0x05, 0x84, 0x90, 0x09, 0x1e, 0xf2, 0xa0, 0x52, 0x95, 0x54, 0x91, 0xe4,
+	0x8f, 0xd4, 0x26, 0xa9, 0xa4, 0xbe, 0xf3, 0x3e, 0xdd, 0x7d, 0xa5, 0x2b, 0xa9, 0xa5, 0x19, 0x3b,
+	0xfb, 0x97, 0x74, 0xcf, 0xf7, 0xf5, 0xf9, 0x4e, 0x9f, 0x3e, 0xe7, 0x3b, 0xdf, 0xf9, 0x9e, 0x64,
+	0x69, 0x2b, 0x48, 0xb7, 0x07, 0x1b, 0x33, 0xed, 0xa8, 0x77, 0xcd, 0x8f, 0xb7, 0xa2, 0x7e, 0x1c,
+	0xbd, 0xca, 0xfe, 0x79, 0x7b, 0xbb, 0x73, 0x6d, 0xf7, 0x85, 0x6b, 0xfd, 0x9d, 0xad, 0x6b, 0x7e,
+	0x3f, 0x48, 0xae, 0xf9, 0xfd, 0x7e, 0x37, 0x68, 0xfb, 0x69, 0x10, 0x85, 0xd7, 0x76, 0xdf, 0xe1,
+	0x77, 0xfb, 0xdb, 0xfe, 0x3b, 0xae, 0x6d, 0xd1, 0x90, 0xc6, 0x7e, 0x4a, 0x3b, 0x33, 0xfd, 0x38,
+	0x4a, 0x23, 0xf7, 0x5b, 0x74, 0x6f, 0x33, 0xb2, 0x37, 0xf6, 0xcf, 0x87, 0xdb, 0x9d, 0x99, 0xdd,
+	0x17, 0x66, 0xfa, 0x3b, 0x5b, 0x33, 0xd8, 0xdb, 0x8c, 0xd1, 0xdb, 0x8c, 0xec, 0xed, 0xf2, 0xdb,
+	0x8d, 0xb1, 0x6c, 0x45, 0x5b, 0xd1, 0x35, 0xd6, 0xe9, 0xc6, 0x60, 0x93, 0xfd, 0x62, 0x3f, 0xd8,
+	0x7f, 0x9c, 0xd8, 0x65, 0x6f, 0xe7, 0xc5, 0x64, 0x26, 0x88, 0x70, 0x78, 0xd7, 0xda, 0x51, 0x4c,
+	0xaf, 0xed, 0xe6, 0x06, 0x74, 0xf9, 0x96, 0xc6, 0xa1, 0xf7, 0x53, 0x1a, 0x26, 0x41, 0x14, 0x26,
+	0x6f, 0xc7, 0x21, 0xd0, 0x78, 0x97, 0xc6, 0xe6, 0xeb, 0x19, 0x08, 0x45, 0x3d, 0xbd, 0x53, 0xf7,
+	0xd4, 0xf3, 0xdb, 0xdb, 0x41, 0x48, 0xe3, 0x3d, 0xfd, 0x78, 0x8f, 0xa6, 0x7e, 0xd1, 0x53, 0xd7,
+	0x86, 0x3d, 0x15, 0x0f, 0xc2, 0x34, 0xe8, 0xd1, 0xdc, 0x03, 0xef, 0x3a, 0xe8, 0x81, 0xa4, 0xbd,
+	0x4d, 0x7b, 0x7e, 0xee, 0xb9, 0xaf, 0x1f, 0xf6, 0xdc, 0x20, 0x0d, 0xba, 0xd7, 0x82, 0x30, 0x4d,
+	0xd2, 0x38, 0xfb, 0x90, 0xf7, 0x93, 0x0e, 0x39, 0x33, 0x7b, 0x77, 0x6d, 0x76, 0x90, 0x6e, 0xcf,
+	0x47, 0xe1, 0x66, 0xb0, 0xe5, 0x7e, 0x03, 0x99, 0x68, 0x77, 0x07, 0x49, 0x4a, 0xe3, 0xdb, 0x7e,
+	0x8f, 0xb6, 0x9c, 0xab, 0xce, 0xdb, 0x9a, 0x73, 0xe7, 0x7f, 0xeb, 0xc1, 0x95, 0xb7, 0x3c, 0x7c,
+	0x70, 0x65, 0x62, 0x5e, 0x83, 0xc0, 0xc4, 0x73, 0xbf, 0x9a, 0x8c, 0xc7, 0x51, 0x97, 0xce, 0xc2,
+	0xed, 0x56, 0x85, 0x3d, 0x72, 0x56, 0x3c, 0x32, 0x0e, 0xbc, 0x19, 0x24, 0x1c, 0x51, 0xfb, 0x71,
+	0xb4, 0x19, 0x74, 0x69, 0xab, 0x6a, 0xa3, 0xae, 0xf2, 0x66, 0x90, 0x70, 0xef, 0x0f, 0x2b, 0x84,
+	0xcc, 0xf6, 0xfb, 0xab, 0x71, 0xf4, 0x2a, 0x6d, 0xa7, 0xee, 0x47, 0x48, 0x03, 0xa7, 0xb9, 0xe3,
+	0xa7, 0x3e, 0x1b, 0xd8, 0xc4, 0x0b, 0x5f, 0x37, 0xc3, 0xdf, 0x7a, 0xc6, 0x7c, 0x6b, 0xbd, 0xc8,
+	0x10, 0x7b, 0x66, 0xf7, 0x1d, 0x33, 0x2b, 0x1b, 0xf8, 0xfc, 0x32, 0x4d, 0xfd, 0x39, 0x57, 0x10,
+	0x23, 0xba, 0x0d, 0x54, 0xaf, 0x6e, 0x48, 0x6a, 0x49, 0x9f, 0xb6, 0xd9, 0x3b, 0x4c, 0xbc, 0xb0,
+	0x34, 0x73, 0x9c, 0xd5, 0x3c, 0xa3, 0x47, 0xbe, 0xd6, 0xa7, 0xed, 0xb9, 0x49, 0x41, 0xb9, 0x86,
+	0xbf, 0x80, 0xd1, 0x71, 0x77, 0xc9, 0x58, 0x92, 0xfa, 0xe9, 0x20, 0x61, 0x53, 0x31, 0xf1, 0xc2,
+	0xed, 0xd2, 0x28, 0xb2, 0x5e, 0xe7, 0xa6, 0x04, 0xcd, 0x31, 0xfe, 0x1b, 0x04, 0x35, 0xef, 0xdf,
+	0x38, 0x64, 0x4a, 0x23, 0x2f, 0x05, 0x49, 0xea, 0x7e, 0x47, 0x6e, 0x72, 0x67, 0x46, 0x9b, 0x5c,
+	0x7c, 0x9a, 0x4d, 0xed, 0x39, 0x41, 0xac, 0x21, 0x5b, 0x8c, 0x89, 0xed, 0x91, 0x7a, 0x90, 0xd2,
+	0x5e, 0xd2, 0xaa, 0x5c, 0xad, 0xbe, 0x6d, 0xe2, 0x85, 0x5b, 0x65, 0xbd, 0xe7, 0xdc, 0x19, 0x41,
+	0xb4, 0xbe, 0x88, 0xdd, 0x03, 0xa7, 0xe2, 0x7d, 0x61, 0xda, 0x7c, 0x3f, 0x9c, 0x70, 0xf7, 0x1d,
+	0x64, 0x22, 0x89, 0x06, 0x71, 0x9b, 0x02, 0xed, 0x47, 0x49, 0xcb, 0xb9, 0x5a, 0xc5, 0xa5, 0x87,
+	0x8b, 0x7a, 0x4d, 0x37, 0x83, 0x89, 0xe3, 0x7e, 0xc6, 0x21, 0x93, 0x1d, 0x9a, 0xa4, 0x41, 0xc8,
+	0xe8, 0xcb, 0xc1, 0xaf, 0x1f, 0x7b, 0xf0, 0xb2, 0x71, 0x41, 0x77, 0x3e, 0x77, 0x41, 0xbc, 0xc8,
+	0xa4, 0xd1, 0x98, 0x80, 0x45, 0x1f, 0x37, 0x67, 0x87, 0x26, 0xed, 0x38, 0xe8, 0xe3, 0xef, 0x56,
+	0xd5, 0xde, 0x9c, 0x0b, 0x1a, 0x04, 0x26, 0x9e, 0x1b, 0x92, 0x3a, 0x6e, 0xbe, 0xa4, 0x55, 0x63,
+	0xe3, 0x5f, 0x3c, 0xde, 0xf8, 0xc5, 0xa4, 0xe2, 0xbe, 0xd6, 0xb3, 0x8f, 0xbf, 0x12, 0xe0, 0x64,
+	0xdc, 0x4f, 0x3b, 0xa4, 0x25, 0x98, 0x03, 0x50, 0x3e, 0xa1, 0x77, 0xb7, 0x83, 0x94, 0x76, 0x83,
+	0x24, 0x6d, 0xd5, 0xd9, 0x18, 0xae, 0x8d, 0xb6, 0xb6, 0x6e, 0xc6, 0xd1, 0xa0, 0xff, 0x72, 0x10,
+	0x76, 0xe6, 0xae, 0x0a, 0x4a, 0xad, 0xf9, 0x21, 0x1d, 0xc3, 0x50, 0x92, 0xee, 0x8f, 0x39, 0xe4,
+	0x72, 0xe8, 0xf7, 0x68, 0xd2, 0xf7, 0xdb, 0x54, 0x82, 0xe7, 0xba, 0x7e, 0x7b, 0x87, 0x8d, 0x68,
+	0xec, 0x68, 0x23, 0xf2, 0xc4, 0x88, 0x2e, 0xdf, 0x1e, 0xda, 0x35, 0xec, 0x43, 0xd6, 0xfd, 0x39,
+	0x87, 0x4c, 0x47, 0x71, 0x7f, 0xdb, 0x0f, 0x69, 0x47, 0x42, 0x93, 0xd6, 0x38, 0xdb, 0x7a, 0x1f,
+	0x3a, 0xde, 0x27, 0x5a, 0xc9, 0x76, 0xbb, 0x1c, 0x85, 0x41, 0x1a, 0xc5, 0x6b, 0x34, 0x4d, 0x83,
+	0x70, 0x2b, 0x99, 0xbb, 0xf8, 0xf0, 0xc1, 0x95, 0xe9, 0x1c, 0x16, 0xe4, 0xc7, 0xe3, 0x7e, 0x27,
+	0x99, 0x48, 0xf6, 0xc2, 0xf6, 0xdd, 0x20, 0xec, 0x44, 0xf7, 0x92, 0x56, 0xa3, 0x8c, 0xed, 0xbb,
+	0xa6, 0x3a, 0x14, 0x1b, 0x50, 0x13, 0x00, 0x93, 0x5a, 0xf1, 0x87, 0xd3, 0x4b, 0xa9, 0x59, 0xf6,
+	0x87, 0xd3, 0x8b, 0x69, 0x1f, 0xb2, 0xee, 0x0f, 0x3a, 0xe4, 0x4c, 0x12, 0x6c, 0x85, 0x7e, 0x3a,
+	0x88, 0xe9, 0xcb, 0x74, 0x2f, 0x69, 0x11, 0x36, 0x90, 0x97, 0x8e, 0x39, 0x2b, 0x46, 0x97, 0x73,
+	0x17, 0xc5, 0x18, 0xcf, 0x98, 0xad, 0x09, 0xd8, 0x74, 0x8b, 0x36, 0x9a, 0x5e, 0xd6, 0x13, 0xe5,
+	0x6e, 0x34, 0xbd, 0xa8, 0x87, 0x92, 0x74, 0xbf, 0x8d, 0x9c, 0xe3, 0x4d, 0x6a, 0x66, 0x93, 0xd6,
+	0x24, 0x63, 0xb4, 0x17, 0x1e, 0x3e, 0xb8, 0x72, 0x6e, 0x2d, 0x03, 0x83, 0x1c, 0xb6, 0xfb, 0x1a,
+	0xb9, 0xd2, 0xa7, 0x71, 0x2f, 0x48, 0x57, 0xc2, 0xee, 0x9e, 0x64, 0xdf, 0xed, 0xa8, 0x4f, 0x3b,
+	0x62, 0x38, 0x49, 0xeb, 0xcc, 0x55, 0xe7, 0x6d, 0x8d, 0xb9, 0xb7, 0x8a, 0x61, 0x5e, 0x59, 0xdd,
+	0x1f, 0x1d, 0x0e, 0xea, 0xcf, 0xfd, 0x4d, 0x87, 0x5c, 0x36, 0xb8, 0xec, 0x1a, 0x8d, 0x77, 0x83,
+	0x36, 0x9d, 0x6d, 0xb7, 0xa3, 0x41, 0x98, 0x26, 0xad, 0x29, 0x36, 0x8d, 0x1b, 0x27, 0xc1, 0xf3,
+	0x6d, 0x52, 0x7a, 0x5d, 0x0e, 0x45, 0x49, 0x60, 0x9f, 0x91, 0xba, 0xff, 0xd0, 0x21, 0x4f, 0x6e,
+	0xd3, 0x6e, 0xef, 0x8e, 0xdf, 0x1d, 0xd0, 0xe4, 0x46, 0x1c, 0xf5, 0x66, 0xbb, 0xdd, 0xe8, 0x1e,
+	0x9e, 0xc6, 0xad, 0xb3, 0xec, 0x2d, 0xde, 0x7f, 0xbc, 0xb7, 0xb8, 0x55, 0xdc, 0xf9, 0xf5, 0x30,
+	0x8d, 0xf7, 0xe6, 0xae, 0x88, 0xd1, 0x3f, 0x39, 0x04, 0x0b, 0x86, 0x8d, 0xcd, 0x0d, 0xc9, 0xb3,
+	0xc9, 0x4e, 0xd0, 0x9f, 0xdf, 0xf6, 0xe3, 0x54, 0x2d, 0xf7, 0x3b, 0x34, 0x0e, 0x36, 0xc5, 0x08,
+	0x5a, 0xe7, 0xd8, 0x27, 0x7f, 0x5e, 0x50, 0x78, 0x76, 0x6d, 0x5f, 0x6c, 0x38, 0xa0, 0x37, 0xf7,
+	0xe3, 0x0e, 0x99, 0x44, 0x2e, 0x33, 0xdb, 0xef, 0xc7, 0xd1, 0xae, 0xdf, 0x6d, 0x4d, 0x5f, 0x75,
+	0x4a, 0xd8, 0xbe, 0x46, 0x8f, 0x73, 0xe7, 0xf0, 0x20, 0x37, 0x5b, 0xc0, 0xa2, 0xe8, 0xfd, 0xf3,
+	0x0a, 0x39, 0x97, 0x15, 0xd6, 0xdc, 0xbf, 0xe5, 0x90, 0xb3, 0xaf, 0xde, 0x4b, 0xd7, 0xa3, 0x1d,
+	0x1a, 0x26, 0x73, 0x7b, 0x78, 0xa4, 0x32, 0x31, 0x65, 0xe2, 0x85, 0x76, 0xb9, 0x62, 0xe1, 0xcc,
+	0x4b, 0x36, 0x15, 0xfe, 0x01, 0x9f, 0x14, 0xd3, 0x7b, 0xf6, 0xa5, 0xbb, 0xeb, 0x26, 0x14, 0xb2,
+	0x83, 0xba, 0xfc, 0x49, 0x87, 0x5c, 0x28, 0xea, 0xc2, 0x3d, 0x47, 0xaa, 0x3b, 0x74, 0x8f, 0x5f,
+	0x1a, 0x00, 0xff, 0x75, 0x3f, 0x48, 0xea, 0xbb, 0xf8, 0xc9, 0x85, 0x44, 0x7d, 0xf3, 0x78, 0x2f,
+	0xa2, 0x46, 0x06, 0xbc, 0xd7, 0x6f, 0xaa, 0xbc, 0xe8, 0x78, 0xbf, 0x5b, 0x25, 0x13, 0xc6, 0xfe,
+	0x3a, 0x85, 0x5b, 0x42, 0x64, 0xdd, 0x12, 0x96, 0x4b, 0x63, 0x0d, 0x43, 0xaf, 0x09, 0xf7, 0x32,
+	0xd7, 0x84, 0x95, 0xf2, 0x48, 0xee, 0x7b, 0x4f, 0x70, 0x53, 0xd2, 0x8c, 0xfa, 0x34, 0xe6, 0xbb,
+	0xb0, 0x56, 0xc6, 0x27, 0x5c, 0x91, 0xdd, 0xcd, 0x9d, 0x79, 0xf8, 0xe0, 0x4a, 0x53, 0xfd, 0x04,
+	0x4d, 0xc8, 0xfb, 0x23, 0x87, 0x5c, 0x30, 0xc6, 0x38, 0x1f, 0x85, 0x9d, 0x80, 0x7d, 0xda, 0xab,
+	0xa4, 0x96, 0xee, 0xf5, 0xe5, 0xad, 0x54, 0xcd, 0xd4, 0xfa, 0x5e, 0x9f, 0x02, 0x83, 0xe0, 0xe5,
+	0xb2, 0x47, 0x93, 0xc4, 0xdf, 0xa2, 0xd9, 0x7b, 0xe8, 0x32, 0x6f, 0x06, 0x09, 0x77, 0x63, 0xe2,
+	0x76, 0xfd, 0x24, 0x5d, 0x8f, 0xfd, 0x30, 0x61, 0xdd, 0xaf, 0x07, 0x3d, 0x2a, 0x26, 0xf8, 0xff,
+	0x1b, 0x6d, 0xc5, 0xe0, 0x13, 0x73, 0x4f, 0x3c, 0x7c, 0x70, 0xc5, 0x5d, 0xca, 0xf5, 0x04, 0x05,
+	0xbd, 0x7b, 0x3f, 0xe6, 0x90, 0x27, 0x8a, 0xcf, 0x02, 0xf7, 0x79, 0x32, 0xc6, 0x55, 0x12, 0xe2,
+	0xed, 0xf4, 0x27, 0x61, 0xad, 0x20, 0xa0, 0xee, 0x35, 0xd2, 0x54, 0xb2, 0x89, 0x78, 0xc7, 0x69,
+	0x81, 0xda, 0xd4, 0x02, 0x8d, 0xc6, 0xc1, 0x49, 0x0b, 0x7d, 0xf1, 0x66, 0xc6, 0xa4, 0x21, 0x2e,
+	0x30, 0x88, 0xf7, 0x07, 0x0e, 0xf9, 0xca, 0x51, 0x4e, 0xa8, 0x93, 0x1b, 0xe3, 0x1a, 0xb9, 0xd8,
+	0xa1, 0x9b, 0xfe, 0xa0, 0x9b, 0xda, 0x14, 0xc5, 0xa0, 0x9f, 0x11, 0x0f, 0x5f, 0x5c, 0x28, 0x42,
+	0x82, 0xe2, 0x67, 0xbd, 0x7f, 0xeb, 0x90, 0xb3, 0xc6, 0x6b, 0x9d, 0xc2, 0x2d, 0x37, 0xb4, 0x6f,
+	0xb9, 0x8b, 0xa5, 0x6d, 0xd3, 0x21, 0xd7, 0xdc, 0x4f, 0x3b, 0xe4, 0xb2, 0x81, 0xb5, 0xec, 0xa7,
+	0xed, 0xed, 0xeb, 0xf7, 0xfb, 0x31, 0x4d, 0x12, 0x5c, 0x52, 0xcf, 0x18, 0xec, 0x78, 0x6e, 0x42,
+	0xf4, 0x50, 0x7d, 0x99, 0xee, 0x71, 0xde, 0xfc, 0xb5, 0xa4, 0xc1, 0xf7, 0x5c, 0x14, 0x8b, 0x8f,
+	0xa4, 0xde, 0x6d, 0x45, 0xb4, 0x83, 0xc2, 0x70, 0x3d, 0x32, 0xc6, 0x78, 0x2e, 0xf2, 0x20, 0x94,
+	0xe8, 0x08, 0x7e, 0x77, 0x7e, 0x9c, 0x83, 0x80, 0x78, 0x89, 0x35, 0x9c, 0xd5, 0x98, 0xb2, 0xf5,
+	0xd0, 0xb9, 0x11, 0xd0, 0x6e, 0x27, 0xc1, 0x1b, 0xb8, 0x1f, 0x86, 0x51, 0x2a, 0x2e, 0xd3, 0xc6,
+	0x0d, 0x7c, 0x56, 0x37, 0x83, 0x89, 0x83, 0x44, 0xbb, 0xfe, 0x06, 0xed, 0xf2, 0x19, 0x15, 0x44,
+	0x97, 0x58, 0x0b, 0x08, 0x88, 0xf7, 0xb0, 0x42, 0xa6, 0x0c, 0xaa, 0x6b, 0xf4, 0x34, 0x14, 0x45,
+	0xb1, 0x75, 0x04, 0xac, 0x96, 0xc7, 0x8f, 0xe9, 0x70, 0x65, 0xd1, 0xeb, 0x99, 0x53, 0x00, 0x4a,
+	0xa5, 0xba, 0xbf, 0xc2, 0xe8, 0xe3, 0x55, 0x72, 0xc5, 0x7e, 0x20, 0x77, 0x88, 0xa0, 0x76, 0xc2,
+	0x20, 0x94, 0x55, 0x1d, 0x1a, 0xf8, 0x60, 0xe2, 0x0d, 0xe1, 0xc3, 0x95, 0x93, 0xe4, 0xc3, 0xe6,
+	0x31, 0x51, 0x3d, 0xe0, 0x98, 0x78, 0x5e, 0xcd, 0x7a, 0x2d, 0xc3, 0xf3, 0xec, 0xa3, 0xf2, 0x2a,
+	0xa9, 0x25, 0x29, 0xed, 0xb7, 0xea, 0x36, 0x9b, 0x5d, 0x4b, 0x69, 0x1f, 0x18, 0xc4, 0x7d, 0x37,
+	0x39, 0x9b, 0xfa, 0xf1, 0x16, 0x4d, 0x63, 0xba, 0x1b, 0x30, 0x35, 0x33, 0x53, 0x3d, 0x34, 0xe7,
+	0xce, 0xa3, 0xd4, 0xb5, 0xce, 0x40, 0x20, 0x41, 0x90, 0xc5, 0xf5, 0xfe, 0x53, 0x85, 0x3c, 0x69,
+	0x7f, 0x02, 0x7d, 0x30, 0x7e, 0xab, 0x75, 0x30, 0x7e, 0x8d, 0x79, 0x30, 0xbe, 0xf1, 0xe0, 0xca,
+	0x53, 0x43, 0x1e, 0xfb, 0x92, 0x39, 0x37, 0xdd, 0x9b, 0x99, 0x8f, 0x70, 0xcd, 0xfe, 0x08, 0x6f,
+	0x3c, 0xb8, 0xf2, 0xcc, 0x90, 0x77, 0xcc, 0x7c, 0xa5, 0xe7, 0xc9, 0x58, 0x4c, 0xfd, 0x24, 0x0a,
+	0x5b, 0x75, 0xfb, 0x6b, 0x02, 0x6b, 0x05, 0x01, 0xf5, 0x7e, 0xbf, 0x99, 0x9d, 0xec, 0x9b, 0x5c,
+	0x75, 0x1e, 0xc5, 0x6e, 0x40, 0x6a, 0xec, 0x82, 0xcd, 0x39, 0xcb, 0xcb, 0xc7, 0xdb, 0x85, 0x78,
+	0x8a, 0xa8, 0xae, 0xe7, 0x1a, 0xf8, 0xd5, 0xb0, 0x09, 0x18, 0x09, 0xf7, 0x3e, 0x69, 0xb4, 0xe5,
+	0xbd, 0xb7, 0x52, 0x86, 0x86, 0x58, 0xdc, 0x7a, 0x35, 0xc5, 0x49, 0x64, 0xf7, 0xea, 0xb2, 0xac,
+	0xa8, 0xb9, 0x94, 0x54, 0xb7, 0x82, 0xb4, 0x55, 0x2d, 0xe3, 0x6a, 0x74, 0x33, 0x30, 0x5e, 0x71,
+	0x1c, 0xcf, 0xa0, 0x9b, 0x41, 0x0a, 0xd8, 0xbf, 0xfb, 0xfd, 0x0e, 0x99, 0x48, 0xda, 0xbd, 0xd5,
+	0x38, 0xda, 0x0d, 0x3a, 0x34, 0x6e, 0xd5, 0xca, 0xe0, 0x6c, 0x6b, 0xf3, 0xcb, 0xb2, 0x43, 0x4d,
+	0x97, 0x6b, 0x9a, 0x34, 0x04, 0x4c, 0xba, 0x78, 0xf7, 0x7a, 0x52, 0xbc, 0xfb, 0x02, 0x6d, 0xb3,
+	0x1d, 0x27, 0xd5, 0x1b, 0xad, 0x7a, 0x19, 0x32, 0xf7, 0xc2, 0xa0, 0xbd, 0x83, 0xfb, 0x4d, 0x0f,
+	0xe8, 0x29, 0xbc, 0x2c, 0xcf, 0x17, 0xd3, 0x84, 0x61, 0x83, 0x61, 0x13, 0xd6, 0x1f, 0x74, 0xbb,
+	0x40, 0x5f, 0x1b, 0x50, 0xa6, 0xbc, 0x2c, 0x61, 0xc2, 0x56, 0x75, 0x87, 0x99, 0x09, 0x33, 0x20,
+	0x60, 0xd2, 0x75, 0x5f, 0x23, 0x63, 0x3d, 0x3f, 0x8d, 0x83, 0xfb, 0xad, 0xf1, 0x32, 0x6e, 0x41,
+	0xcb, 0xac, 0x2f, 0x4d, 0x9c, 0x1d, 0xf4, 0xbc, 0x11, 0x04, 0x21, 0xb4, 0x21, 0xf4, 0x68, 0xbc,
+	0x45, 0x5b, 0x8d, 0x32, 0xac, 0x33, 0xcb, 0xd8, 0x95, 0x26, 0xd8, 0x44, 0xe1, 0x8a, 0xb5, 0x01,
+	0xa7, 0xe2, 0x7e, 0x90, 0x34, 0x12, 0xda, 0xa5, 0x6d, 0x14, 0x8f, 0x9a, 0x8c, 0xe2, 0xd7, 0x8f,
+	0x28, 0x2a, 0xa2, 0x5c, 0xb2, 0x26, 0x1e, 0xe5, 0x1b, 0x4c, 0xfe, 0x02, 0xd5, 0x25, 0x4e, 0x60,
+	0xbf, 0x3b, 0xd8, 0x0a, 0xc2, 0x16, 0x29, 0x63, 0x02, 0x57, 0x59, 0x5f, 0x99, 0x09, 0xe4, 0x8d,
+	0x20, 0x08, 0x79, 0xff, 0xde, 0x21, 0xae, 0xcd, 0xd4, 0x4e, 0x41, 0x26, 0x7e, 0xcd, 0x96, 0x89,
+	0x97, 0xca, 0x14, 0x5a, 0x86, 0x88, 0xc5, 0xbf, 0xd2, 0x24, 0x99, 0xe3, 0xe0, 0x36, 0x4d, 0x52,
+	0xda, 0x79, 0x93, 0x85, 0xbf, 0xc9, 0xc2, 0xdf, 0x64, 0xe1, 0xf2, 0x87, 0xbb, 0x91, 0x61, 0xe1,
+	0xef, 0x31, 0x76, 0xbd, 0x76, 0x85, 0xf8, 0xb0, 0xf2, 0x95, 0x30, 0x47, 0x60, 0x20, 0x20, 0x27,
+	0x78, 0x69, 0x6d, 0xe5, 0x76, 0x21, 0xcf, 0xfe, 0xb0, 0xcd, 0xb3, 0x8f, 0x4b, 0xe2, 0xff, 0x05,
+	0x2e, 0xfd, 0x9b, 0x0e, 0x79, 0xab, 0xcd, 0xbd, 0xe4, 0xca, 0x59, 0xdc, 0x0a, 0xa3, 0x98, 0x2e,
+	0x04, 0x9b, 0x9b, 0x34, 0xa6, 0x21, 0x9a, 0x4b, 0xa4, 0x6e, 0xc7, 0x19, 0xa6, 0xdb, 0x71, 0xdf,
+	0x49, 0x26, 0x5f, 0x4d, 0xa2, 0x70, 0x35, 0x0a, 0x42, 0xc1, 0x82, 0xf0, 0xc6, 0xc1, 0xf4, 0xd3,
+	0x38, 0xa3, 0xb2, 0x1d, 0x2c, 0x2c, 0x77, 0x9e, 0x4c, 0xbf, 0xfa, 0xda, 0xaa, 0x9f, 0x1a, 0xda,
+	0x04, 0x79, 0xef, 0x67, 0xa6, 0xc3, 0x97, 0xde, 0x9b, 0x01, 0x42, 0x1e, 0xdf, 0xfb, 0xeb, 0x15,
+	0x72, 0x29, 0xf3, 0x22, 0x51, 0xb7, 0x1b, 0x0d, 0x52, 0xbc, 0x13, 0xb9, 0x3f, 0xe5, 0x90, 0x73,
+	0x3d, 0x5b, 0x61, 0x91, 0x08, 0x75, 0xf7, 0xb7, 0x97, 0x76, 0x46, 0x64, 0x34, 0x22, 0x73, 0x2d,
+	0x31, 0x43, 0xe7, 0x32, 0x80, 0x04, 0x72, 0x63, 0x71, 0x3f, 0x48, 0x9a, 0x3d, 0xff, 0xfe, 0x2b,
+	0xfd, 0x8e, 0x9f, 0xca, 0xeb, 0xe8, 0x70, 0x2d, 0xc2, 0x20, 0x0d, 0xba, 0x33, 0xdc, 0xc9, 0x66,
+	0x66, 0x31, 0x4c, 0x57, 0xe2, 0xb5, 0x34, 0x0e, 0xc2, 0x2d, 0xae, 0xe4, 0x5c, 0x96, 0xdd, 0x80,
+	0xee, 0xd1, 0xfb, 0x82, 0x43, 0x9e, 0x19, 0x32, 0x3b, 0xb1, 0x9f, 0xd2, 0xad, 0x3d, 0xf7, 0xa3,
+	0xa4, 0x8e, 0xf7, 0x46, 0x39, 0x2b, 0x77, 0xcb, 0x3c, 0x39, 0x8d, 0x2f, 0xa1, 0x0f, 0x51, 0xfc,
+	0x95, 0x00, 0x27, 0xea, 0xfd, 0x54, 0x33, 0x2b, 0x2c, 0x30, 0x37, 0x8a, 0x17, 0x08, 0xd9, 0x8a,
+	0xd6, 0x69, 0xaf, 0xdf, 0xf5, 0x53, 0xbe, 0xee, 0x1a, 0x5a, 0x55, 0x72, 0x53, 0x41, 0xc0, 0xc0,
+	0x72, 0x7f, 0xd8, 0x21, 0x64, 0x4b, 0xae, 0x79, 0x29, 0x08, 0xbc, 0x52, 0xe6, 0xeb, 0xe8, 0x1d,
+	0xa5, 0xc7, 0xa2, 0x08, 0x82, 0x41, 0xdc, 0xfd, 0x1e, 0x87, 0x34, 0x52, 0x39, 0x7c, 0x7e, 0x34,
+	0xae, 0x97, 0x39, 0x12, 0xf9, 0xd2, 0x5a, 0x26, 0x52, 0x53, 0xa2, 0xe8, 0xba, 0x3f, 0xe0, 0x10,
+	0x82, 0xf6, 0xa0, 0xd5, 0xa8, 0x1b, 0xb4, 0xf7, 0xc4, 0x89, 0x79, 0xa7, 0x54, 0x75, 0x8e, 0xea,
+	0x7d, 0x6e, 0x0a, 0x67, 0x43, 0xff, 0x06, 0x83, 0xb2, 0xfb, 0x31, 0xd2, 0x48, 0xc4, 0x72, 0x6b,
+	0xd5, 0xcb, 0x9f, 0x0c, 0xb9, 0x94, 0x05, 0x7b, 0x15, 0xbf, 0x40, 0xd1, 0x74, 0xff, 0x9a, 0x43,
+	0xce, 0xf6, 0x6d, 0x35, 0xa1, 0x38, 0x0e, 0xcb, 0xe3, 0x01, 0x19, 0x35, 0x24, 0xd7, 0xb6, 0x64,
+	0x1a, 0x21, 0x3b, 0x0a, 0xe4, 0x80, 0x7a, 0x05, 0xaf, 0xf4, 0xb9, 0xca, 0x72, 0x5c, 0x73, 0xc0,
+	0x9b, 0x59, 0x20, 0xe4, 0xf1, 0xdd, 0x55, 0x72, 0x01, 0x47, 0xb7, 0xc7, 0xc5, 0x4f, 0x79, 0xbc,
+	0x24, 0xec, 0x30, 0x6c, 0xcc, 0x3d, 0x2d, 0x56, 0xc8, 0x85, 0xd9, 0x02, 0x1c, 0x28, 0x7c, 0xd2,
+	0xfd, 0x5d, 0x87, 0x3c, 0x1d, 0xb0, 0x63, 0xc0, 0x54, 0xd8, 0xeb, 0x13, 0x41, 0xf8, 0x44, 0xd0,
+	0x52, 0x79, 0xc5, 0xb0, 0xe3, 0x67, 0xee, 0x2b, 0xc5, 0x1b, 0x3c, 0xbd, 0xb8, 0xcf, 0x90, 0x60,
+	0xdf, 0x01, 0xbb, 0xdf, 0x48, 0xce, 0xc8, 0x7d, 0xb1, 0x8a, 0x2c, 0x98, 0x1d, 0xb4, 0xcd, 0xb9,
+	0x69, 0x74, 0x7e, 0x58, 0x37, 0x01, 0x60, 0xe3, 0x79, 0xbf, 0x5d, 0x25, 0x17, 0xb2, 0xcb, 0x8d,
+	0xe9, 0x78, 0x90, 0xdd, 0xb4, 0xa5, 0xfe, 0x47, 0x72, 0xcf, 0x52, 0xd9, 0x8d, 0xd2, 0x2e, 0x69,
+	0x76, 0xa3, 0x9a, 0x12, 0x30, 0x88, 0xa3, 0x50, 0x3a, 0xed, 0x67, 0x35, 0xa5, 0x82, 0x03, 0x7e,
+	0xb0, 0xcc, 0x21, 0xe5, 0x6d, 0x7a, 0x97, 0xc4, 0xd0, 0xa6, 0x73, 0x20, 0xc8, 0x0f, 0xc9, 0xfd,
+	0x2e, 0xd2, 0x8c, 0x95, 0x13, 0x52, 0xb5, 0x8c, 0xab, 0x9a, 0x5c, 0x36, 0x62, 0x38, 0xca, 0x00,
+	0xa4, 0xdd, 0x8d, 0x34, 0x45, 0xef, 0x77, 0x6c, 0xc3, 0x98, 0xc1, 0x3b, 0x46, 0x30, 0xfa, 0x7d,
+	0xc6, 0x21, 0x13, 0x71, 0xd4, 0xed, 0x06, 0xe1, 0x16, 0xf2, 0x39, 0x71, 0x58, 0x7f, 0xe0, 0x44,
+	0xce, 0x4b, 0xc1, 0xd0, 0x98, 0x64, 0x0d, 0x9a, 0x26, 0x98, 0x03, 0x40, 0xf7, 0xca, 0xd6, 0x30,
+	0x7e, 0xec, 0x52, 0xf2, 0x94, 0x64, 0x36, 0x6a, 0x2a, 0x56, 0xc2, 0x05, 0xda, 0xa5, 0x4a, 0x6d,
+	0xde, 0x98, 0x7b, 0x4e, 0xbc, 0xe6, 0x53, 0xab, 0xc3, 0x51, 0x61, 0xbf, 0x7e, 0xdc, 0xf7, 0x93,
+	0x73, 0xc6, 0x7b, 0x25, 0x6a, 0x62, 0x9a, 0x73, 0x33, 0x28, 0x00, 0xcd, 0x66, 0x60, 0x6f, 0x3c,
+	0xb8, 0xf2, 0x44, 0xb6, 0x4d, 0x1c, 0x18, 0xb9, 0x7e, 0xbc, 0x9f, 0xaf, 0x64, 0xbf, 0x96, 0x3a,
+	0xeb, 0x3f, 0xef, 0xe4, 0xb4, 0x09, 0xdf, 0x7e, 0x12, 0xe7, 0x2b, 0xd3, 0x3b, 0x28, 0x8f, 0x99,
+	0xe1, 0x38, 0x8f, 0xd0, 0x6c, 0xef, 0xfd, 0x8b, 0x1a, 0xd9, 0x67, 0x64, 0x23, 0x08, 0xef, 0x87,
+	0xb6, 0xa3, 0x7e, 0xca, 0x51, 0x06, 0x33, 0xbe, 0x87, 0x3b, 0x27, 0x35, 0xf7, 0xfc, 0xfe, 0x94,
+	0x70, 0xd7, 0x11, 0xa5, 0x45, 0xb7, 0x4d, 0x73, 0xee, 0x4f, 0x3b, 0xb6, 0xc9, 0x8f, 0xfb, 0x9f,
+	0x06, 0x27, 0x36, 0x26, 0xc3, 0x8e, 0xc8, 0x07, 0xa6, 0xad, 0x4f, 0xc3, 0x2c, 0x8c, 0x33, 0x84,
+	0x6c, 0x06, 0xa1, 0xdf, 0x0d, 0x5e, 0xc7, 0xdb, 0x51, 0x9d, 0x1d, 0xf0, 0x4c, 0x62, 0xba, 0xa1,
+	0x5a, 0xc1, 0xc0, 0xb8, 0xfc, 0xff, 0x93, 0x09, 0xe3, 0xcd, 0x0b, 0x3c, 0x5e, 0x2e, 0x98, 0x1e,
+	0x2f, 0x4d, 0xc3, 0x51, 0xe5, 0xf2, 0x7b, 0xc8, 0xb9, 0xec, 0x00, 0x0f, 0xf3, 0xbc, 0xf7, 0x3f,
+	0xc6, 0xb3, 0x36, 0xb8, 0x75, 0x1a, 0xf7, 0x70, 0x68, 0x6f, 0x2a, 0xb6, 0xde, 0x54, 0x6c, 0xbd,
+	0xa9, 0xd8, 0x32, 0x6d, 0x13, 0x42, 0x69, 0x33, 0x7e, 0x4a, 0x4a, 0x1b, 0x4b, 0x0d, 0xd5, 0x28,
+	0x5d, 0x0d, 0xe5, 0x7d, 0x7f, 0x4e, 0x73, 0xbf, 0x1e, 0x53, 0xea, 0x46, 0xa4, 0x1e, 0x46, 0x1d,
+	0x2a, 0x65, 0xdc, 0x97, 0xca, 0x11, 0xd8, 0x6e, 0x47, 0x1d, 0xc3, 0xb3, 0x1f, 0x7f, 0x25, 0xc0,
+	0xe9, 0x78, 0x0f, 0xeb, 0xc4, 0x12, 0x27, 0xf9, 0x77, 0xc7, 0xe0, 0x1f, 0xda, 0x8f, 0x5e, 0x81,
+	0xa5, 0x96, 0x63, 0x1b, 0x8f, 0x81, 0x37, 0x83, 0x84, 0xe3, 0x99, 0xd7, 0xf7, 0xd3, 0xed, 0x56,
+	0xc5, 0x3e, 0xf3, 0x50, 0x75, 0x04, 0x0c, 0xe2, 0xbe, 0x87, 0x4c, 0xa5, 0x96, 0x29, 0x5c, 0x98,
+	0x7c, 0x9f, 0x10, 0xb8, 0x53, 0xb6, 0xa1, 0x1c, 0x32, 0xd8, 0xee, 0x6b, 0xa4, 0x86, 0x8e, 0xa6,
+	0xe2, 0xd3, 0xaf, 0x95, 0x77, 0xd6, 0xb0, 0x77, 0x45, 0xe7, 0x56, 0xce, 0x09, 0xf1, 0x3f, 0x60,
+	0xa4, 0x70, 0xdd, 0x37, 0x77, 0x06, 0x49, 0x1a, 0xf5, 0x82, 0xd7, 0xa5, 0xa6, 0xf3, 0xdb, 0x4b,
+	0x26, 0xfc, 0xb2, 0xec, 0x9f, 0xab, 0x94, 0xd4, 0x4f, 0xd0, 0x94, 0xd9, 0x38, 0x3a, 0x41, 0xcc,
+	0x96, 0xcc, 0x5e, 0x8b, 0x9c, 0xc8, 0x38, 0x16, 0x64, 0xff, 0x7c, 0x1c, 0xea, 0x27, 0x68, 0xca,
+	0xee, 0x9e, 0xda, 0x7f, 0x13, 0x57, 0x9d, 0x72, 0xef, 0x5e, 0x6c, 0x0c, 0x7c, 0xef, 0x15, 0xee,
+	0xc3, 0xe7, 0x48, 0xbd, 0xbd, 0xed, 0xc7, 0x69, 0x6b, 0x92, 0x2d, 0x1a, 0xb5, 0x8a, 0x99, 0x3b,
+	0x30, 0x70, 0x18, 0xfa, 0x45, 0xc5, 0x74, 0xb3, 0x75, 0xc6, 0xf6, 0x8b, 0x02, 0xba, 0x09, 0xd8,
+	0xee, 0xfd, 0x4c, 0x85, 0x5c, 0xce, 0xd1, 0x54, 0x2f, 0xca, 0x57, 0x7b, 0x7b, 0x10, 0x27, 0x52,
+	0xfd, 0x65, 0xac, 0x76, 0xd6, 0x0c, 0x12, 0xee, 0x7e, 0xc2, 0x21, 0xe3, 0xa8, 0x57, 0x0d, 0x69,
+	0xda, 0xaa, 0x94, 0xad, 0xe4, 0x61, 0xc3, 0x7a, 0x89, 0xf7, 0xae, 0xc7, 0x20, 0x1a, 0x40, 0xd2,
+	0xc5, 0xe1, 0xd2, 0xfb, 0xed, 0xee, 0xa0, 0x93, 0x73, 0x75, 0xb9, 0xce, 0x9b, 0x41, 0xc2, 0x11,
+	0x35, 0x08, 0x39, 0x6a, 0xcd, 0x46, 0x5d, 0x0c, 0x05, 0xaa, 0x80, 0x7b, 0x7f, 0xd9, 0x24, 0x17,
+	0x0b, 0x37, 0x07, 0x0a, 0x54, 0x4c, 0x64, 0xb9, 0x11, 0x74, 0xa9, 0x74, 0xf2, 0x62, 0x02, 0xd5,
+	0x1d, 0xd5, 0x0a, 0x06, 0x86, 0xfb, 0xdd, 0x84, 0xf4, 0xfd, 0xd8, 0xef, 0x51, 0xa5, 0x9e, 0x3e,
+	0xb6, 0xdc, 0x82, 0xe3, 0x58, 0x95, 0x7d, 0xea, 0x2b, 0xba, 0x6a, 0x4a, 0xc0, 0x20, 0x89, 0x6e,
+	0x4b, 0x31, 0xed, 0x52, 0x3f, 0x61, 0x71, 0x08, 0xd9, 0xa0, 0x2a, 0xd0, 0x20, 0x30, 0xf1, 0xd0,
+	0x93, 0x44, 0xf8, 0xc3, 0x65, 0xfc, 0x82, 0x6c, 0x9f, 0x38, 0xf7, 0xb3, 0x0e, 0x99, 0xc2, 0x60,
+	0x46, 0x4d, 0x5d, 0x84, 0x40, 0xad, 0x1c, 0xff, 0x25, 0x6f, 0x98, 0xfd, 0x6a, 0x0e, 0x69, 0x35,
+	0x27, 0x90, 0x21, 0x8f, 0x9f, 0x79, 0x97, 0xc6, 0x8c, 0xb5, 0x8e, 0xd9, 0x9f, 0xf9, 0x0e, 0x6f,
+	0x06, 0x09, 0x77, 0x67, 0xc9, 0xd9, 0xbe, 0x9f, 0x24, 0xf3, 0x31, 0xed, 0xd0, 0x30, 0x0d, 0xfc,
+	0x2e, 0x0f, 0x50, 0x6a, 0x68, 0x67, 0xf1, 0x55, 0x1b, 0x0c, 0x59, 0x7c, 0xf7, 0x7d, 0xe4, 0x49,
+	0xae, 0xff, 0x59, 0x0e, 0x92, 0x24, 0x08, 0xb7, 0xf4, 0x32, 0x10, 0x6a, 0x30, 0x15, 0x38, 0xb0,
+	0x58, 0x8c, 0x06, 0xc3, 0x9e, 0x47, 0x07, 0x46, 0xe6, 0xea, 0x1f, 0x77, 0x12, 0x66, 0xfb, 0x69,
+	0x68, 0xa5, 0xeb, 0x9a, 0x68, 0x07, 0x85, 0xe1, 0xb6, 0xc9, 0x24, 0xff, 0x24, 0xdc, 0xa1, 0x4f,
+	0xf0, 0xc7, 0xb7, 0x0f, 0x3d, 0xa6, 0x45, 0xbc, 0xed, 0x0c, 0xf8, 0xf7, 0xae, 0x4b, 0x4b, 0x14,
+	0x37, 0x9c, 0xdc, 0x31, 0xba, 0x01, 0xab, 0x53, 0xfb, 0xc6, 0x36, 0x31, 0xc2, 0x8d, 0xed, 0x1b,
+	0xc8, 0xc4, 0xce, 0x60, 0x83, 0x8a, 0x99, 0x6f, 0x4d, 0xda, 0xab, 0xef, 0x65, 0x0d, 0x02, 0x13,
+	0x8f, 0xf9, 0x52, 0xf6, 0x03, 0xf1, 0x0b, 0x63, 0x62, 0xb4, 0x2f, 0xe5, 0xea, 0xa2, 0x6c, 0x06,
+	0x13, 0x07, 0x87, 0x86, 0x73, 0xb1, 0x4e, 0x13, 0x16, 0xd5, 0x82, 0xd3, 0xa5, 0x86, 0xb6, 0x26,
+	0x01, 0xa0, 0x71, 0xdc, 0xef, 0x73, 0xc8, 0x64, 0x3f, 0x4a, 0x52, 0xa0, 0x61, 0x87, 0xc6, 0x34,
+	0x6e, 0x9d, 0x2d, 0x43, 0xca, 0x67, 0x9b, 0xd3, 0xe8, 0x95, 0x4f, 0xa9, 0xd9, 0x02, 0x16, 0x55,
+	0xa6, 0x2c, 0xdf, 0x55, 0x61, 0x23, 0xad, 0x73, 0x57, 0xab, 0xc7, 0x17, 0x2a, 0xed, 0x18, 0x15,
+	0xce, 0xbd, 0x34, 0xa3, 0xd0, 0x10, 0x30, 0x28, 0x7b, 0x3f, 0x51, 0xb1, 0xb5, 0x3a, 0x26, 0x03,
+	0x76, 0x13, 0x64, 0xb3, 0xe9, 0x1d, 0x3f, 0x96, 0xc2, 0xd8, 0x31, 0x63, 0xe4, 0x44, 0xbf, 0x77,
+	0xfc, 0xd8, 0x64, 0xd8, 0x8c, 0x00, 0x48, 0x4a, 0xee, 0xab, 0xa4, 0x96, 0x76, 0xfd, 0x92, 0x82,
+	0x6a, 0x0d, 0x8a, 0x5a, 0xc9, 0xb6, 0x34, 0x9b, 0x00, 0xa3, 0xe1, 0x3e, 0x8d, 0x37, 0xcb, 0x0d,
+	0x69, 0x05, 0x14, 0x97, 0xc1, 0x8d, 0x04, 0x58, 0xab, 0xf7, 0xef, 0x26, 0x0a, 0xce, 0x4c, 0x25,
+	0xa4, 0xa0, 0xd5, 0x08, 0x97, 0xfc, 0x6a, 0x4c, 0x37, 0x83, 0xfb, 0x42, 0x48, 0x54, 0xd3, 0x7d,
+	0x5b, 0x41, 0xc0, 0xc0, 0x92, 0xcf, 0xac, 0x0d, 0x36, 0xf1, 0x99, 0x4a, 0xfe, 0x19, 0x0e, 0x01,
+	0x03, 0xcb, 0x7d, 0x27, 0x19, 0x0b, 0x7a, 0xfe, 0x96, 0x72, 0x52, 0x7e, 0x1a, 0x19, 0xf2, 0x22,
+	0x6b, 0x79, 0xe3, 0xc1, 0x95, 0x29, 0x35, 0x20, 0xd6, 0x04, 0x02, 0xd7, 0xfd, 0x79, 0x87, 0x4c,
+	0xb6, 0xa3, 0x5e, 0x2f, 0x0a, 0xf9, 0xd5, 0x5e, 0xe8, 0x29, 0x5e, 0x3d, 0x29, 0x11, 0x6e, 0x66,
+	0xde, 0x20, 0xc6, 0x15, 0x15, 0x2a, 0xfa, 0xd7, 0x04, 0x81, 0x35, 0x2a, 0x93, 0x6f, 0xd7, 0x0f,
+	0xe0, 0xdb, 0xbf, 0xec, 0x90, 0x69, 0xfe, 0xac, 0xa1, 0x71, 0x10, 0x81, 0xae, 0xd1, 0x09, 0xbf,
+	0x56, 0x4e, 0x09, 0xa3, 0x14, 0xd1, 0x39, 0x38, 0xe4, 0x07, 0xe9, 0xde, 0x24, 0xd3, 0x9b, 0x51,
+	0xdc, 0xa6, 0xe6, 0x44, 0x88, 0x43, 0x47, 0x75, 0x74, 0x23, 0x8b, 0x00, 0xf9, 0x67, 0xdc, 0x3b,
+	0xe4, 0x09, 0xa3, 0xd1, 0x9c, 0x07, 0x7e, 0xee, 0x3c, 0x2b, 0x7a, 0x7b, 0xe2, 0x46, 0x21, 0x16,
+	0x0c, 0x79, 0xda, 0x66, 0xf1, 0xcd, 0x11, 0x58, 0xfc, 0x87, 0xc9, 0xa5, 0x76, 0x7e, 0x66, 0x76,
+	0x93, 0xc1, 0x46, 0xc2, 0x4f, 0xa1, 0xc6, 0xdc, 0x57, 0x88, 0x0e, 0x2e, 0xcd, 0x0f, 0x43, 0x84,
+	0xe1, 0x7d, 0xb8, 0x1f, 0x25, 0x8d, 0x98, 0xb2, 0xaf, 0x92, 0x88, 0xa8, 0xcf, 0x63, 0xf2, 0x68,
+	0x7d, 0xbb, 0xe0, 0xdd, 0xea, 0x73, 0x55, 0x34, 0x24, 0xa0, 0x28, 0xba, 0xf7, 0xc8, 0x78, 0x1f,
+	0x0d, 0x32, 0x22, 0xd6, 0xf3, 0xd8, 0x76, 0x03, 0x45, 0x9c, 0x99, 0x79, 0x8c, 0xec, 0x10, 0x9c,
+	0x08, 0x48, 0x6a, 0x28, 0x69, 0xb6, 0xa3, 0x5e, 0x3f, 0x0a, 0x69, 0x98, 0xca, 0x23, 0x70, 0x8a,
+	0xdb, 0x62, 0x64, 0x2b, 0x18, 0x18, 0x68, 0x8d, 0x63, 0x7a, 0xc9, 0xbb, 0x41, 0xba, 0x8d, 0xba,
+	0x7c, 0x79, 0x5f, 0x9f, 0xb2, 0xad, 0x71, 0x4b, 0x05, 0x38, 0x50, 0xf8, 0x64, 0xf6, 0xf0, 0x3e,
+	0x7b, 0xb4, 0xc3, 0xfb, 0xdc, 0xc1, 0x87, 0xf7, 0xe5, 0x6f, 0x25, 0xd3, 0x39, 0xa6, 0x71, 0x28,
+	0xe5, 0xe3, 0x02, 0x79, 0xa2, 0x78, 0x7b, 0x1e, 0x4a, 0x05, 0xf9, 0x0f, 0x32, 0x3e, 0xe8, 0xc6,
+	0x75, 0x6c, 0x04, 0x75, 0xb6, 0x4f, 0xaa, 0x34, 0xdc, 0x15, 0xa7, 0xd5, 0x8d, 0xe3, 0xad, 0x92,
+	0xeb, 0xe1, 0x2e, 0xe7, 0x2e, 0x4c, 0x67, 0x77, 0x3d, 0xdc, 0x05, 0xec, 0xdb, 0xfd, 0x9c, 0x63,
+	0x5d, 0x27, 0xb8, 0x12, 0xfc, 0x43, 0x27, 0x72, 0xff, 0x1c, 0xf9, 0x86, 0xe1, 0xfd, 0xcb, 0x0a,
+	0xb9, 0x7a, 0x50, 0x27, 0x23, 0x4c, 0xdf, 0x73, 0xe8, 0x04, 0x8f, 0x5e, 0x25, 0x82, 0xfd, 0x4f,
+	0xe0, 0xae, 0xe0, 0x7e, 0x26, 0x1f, 0x06, 0x01, 0x72, 0xbb, 0xa4, 0xda, 0xf3, 0xfb, 0x42, 0x37,
+	0xba, 0x78, 0xdc, 0x58, 0x3d, 0xfc, 0xed, 0x77, 0x97, 0xfd, 0x3e, 0x5f, 0x9e, 0x46, 0x03, 0x20,
+	0x19, 0x37, 0x25, 0x75, 0x3f, 0x8e, 0x7d, 0xe9, 0xc2, 0xf0, 0x72, 0x39, 0xf4, 0x66, 0xb1, 0x4b,
+	0x6e, 0x01, 0xb6, 0x9a, 0x80, 0x13, 0xf3, 0x3e, 0x35, 0x6e, 0x05, 0x76, 0x31, 0xbf, 0x94, 0x84,
+	0x8c, 0x09, 0x95, 0xa8, 0x53, 0x76, 0x88, 0x24, 0x97, 0x0e, 0x99, 0xb6, 0x81, 0xff, 0x0f, 0x82,
+	0x94, 0xfb, 0x49, 0x87, 0x25, 0xe4, 0x90, 0xd1, 0x72, 0xad, 0x4a, 0xc9, 0x2e, 0x14, 0x66, 0x7e,
+	0x10, 0x33, 0xcd, 0x87, 0x6c, 0x04, 0x93, 0xba, 0x48, 0xac, 0xc3, 0xee, 0x36, 0xf9, 0xc4, 0x3a,
+	0xd8, 0x0c, 0x12, 0xee, 0xde, 0x2f, 0xf0, 0x3f, 0x29, 0x21, 0xa9, 0xc3, 0x08, 0x1e, 0x27, 0x3f,
+	0xed, 0x90, 0xe9, 0x20, 0xeb, 0x48, 0xd0, 0xaa, 0x97, 0xe1, 0xe1, 0x34, 0xdc, 0x4f, 0x41, 0x09,
+	0x0e, 0x39, 0x10, 0xe4, 0x07, 0xe3, 0x76, 0x48, 0x2d, 0x08, 0x37, 0x23, 0x21, 0x2e, 0xcd, 0x1d,
+	0x6f, 0x50, 0x8b, 0xe1, 0x66, 0xa4, 0x77, 0x33, 0xfe, 0x02, 0xd6, 0xbb, 0xbb, 0x44, 0x2e, 0xc8,
+	0xd8, 0x9e, 0x5b, 0x41, 0x82, 0x9a, 0xa5, 0xa5, 0xa0, 0x17, 0xa4, 0x4c, 0xd4, 0xa9, 0xce, 0xb5,
+	0xf0, 0x24, 0x82, 0x02, 0x38, 0x14, 0x3e, 0xe5, 0xbe, 0x4e, 0xc6, 0xa5, 0xf1, 0xbe, 0x51, 0x86,
+	0x76, 0x21, 0xbf, 0xfe, 0xd5, 0x62, 0xe2, 0xbf, 0x13, 0x90, 0x04, 0xbd, 0xcf, 0x4e, 0x90, 0xe9,
+	0xd9, 0xfd, 0x1d, 0x0a, 0x9c, 0xd3, 0x76, 0x28, 0xc0, 0xab, 0x51, 0xa2, 0x7d, 0x01, 0x4a, 0x58,
+	0xdb, 0x82, 0xaa, 0xb6, 0xf3, 0xa2, 0xd5, 0x9f, 0xd1, 0x70, 0x63, 0x32, 0xb6, 0x4d, 0xfd, 0x6e,
+	0xba, 0x5d, 0x8e, 0x49, 0xea, 0x16, 0xeb, 0x2b, 0x1b, 0x90, 0xc7, 0x5b, 0x41, 0x50, 0x72, 0xef,
+	0x93, 0xf1, 0x6d, 0xbe, 0x00, 0xc4, 0x6d, 0x65, 0xf9, 0xb8, 0x93, 0x6b, 0xad, 0x2a, 0xfd, 0xb9,
+	0x45, 0x03, 0x48, 0x72, 0xec, 0x3e, 0x6e, 0xb8, 0xd7, 0xd4, 0xcb, 0xb8, 0x8f, 0x17, 0x45, 0x7b,
+	0x1f, 0xe8, 0x5b, 0xf3, 0x11, 0x32, 0x19, 0xd3, 0x76, 0x14, 0xb6, 0x83, 0x2e, 0xed, 0xcc, 0x4a,
+	0x73, 0xd3, 0x61, 0x42, 0xd0, 0x98, 0xea, 0x01, 0x8c, 0x3e, 0xc0, 0xea, 0xd1, 0xfd, 0x21, 0x87,
+	0x4c, 0xa9, 0xb0, 0x74, 0xfc, 0x20, 0x54, 0x98, 0x15, 0x96, 0x4a, 0x0a, 0x82, 0x67, 0x7d, 0xce,
+	0xb9, 0xa8, 0xb4, 0xb3, 0xdb, 0x20, 0x43, 0xd7, 0x7d, 0x3f, 0x21, 0xd1, 0x06, 0xf7, 0x50, 0x9b,
+	0x4d, 0x5b, 0x8d, 0x43, 0xbf, 0xea, 0x14, 0x0f, 0x65, 0x95, 0x3d, 0x80, 0xd1, 0x9b, 0xfb, 0x32,
+	0x21, 0x7c, 0xdb, 0xa0, 0x11, 0xb0, 0xd5, 0xb4, 0x62, 0x08, 0xc9, 0x9a, 0x82, 0xbc, 0xf1, 0xe0,
+	0x4a, 0x5e, 0xe7, 0x8b, 0x00, 0x30, 0x1e, 0x77, 0xbf, 0x93, 0x8c, 0x27, 0x83, 0x5e, 0xcf, 0x57,
+	0x16, 0x88, 0x12, 0x83, 0x63, 0x79, 0xbf, 0x06, 0x2b, 0xe2, 0x0d, 0x20, 0x29, 0xba, 0xaf, 0x22,
+	0x53, 0x4d, 0x84, 0x32, 0x9a, 0xed, 0x22, 0xf6, 0xbf, 0xd0, 0xc4, 0xbd, 0x4b, 0x8a, 0xf8, 0x50,
+	0x80, 0x83, 0x0e, 0x30, 0x76, 0xfb, 0x52, 0xc4, 0xc9, 0x42, 0x61, 0x9f, 0xee, 0x4b, 0x64, 0x42,
+	0xbf, 0xb6, 0xcc, 0x73, 0xf3, 0x36, 0x9d, 0x50, 0x8c, 0x35, 0x0f, 0x9f, 0x33, 0xf3, 0x61, 0x77,
+	0x99, 0x9c, 0x6f, 0x47, 0x61, 0x1a, 0x47, 0xdd, 0x2e, 0x4f, 0xa8, 0xc7, 0x6f, 0x97, 0xdc, 0x42,
+	0xf1, 0x94, 0x18, 0xf6, 0xf9, 0xf9, 0x3c, 0x0a, 0x14, 0x3d, 0xe7, 0x85, 0xb6, 0xb5, 0x50, 0x4c,
+	0xce, 0x3b, 0xc9, 0x24, 0xba, 0xd4, 0xc7, 0xa1, 0xdf, 0x7d, 0x05, 0x96, 0xa4, 0x6e, 0x9e, 0xed,
+	0x81, 0xeb, 0x46, 0x3b, 0x58, 0x58, 0x18, 0x82, 0x2d, 0x54, 0x2a, 0x46, 0x08, 0x36, 0x57, 0xa9,
+	0x48, 0x05, 0x8a, 0xf7, 0x4b, 0x55, 0x4b, 0x20, 0x7b, 0x24, 0xb6, 0x49, 0x96, 0x96, 0x49, 0xe6,
+	0xaf, 0x62, 0x80, 0x56, 0xa5, 0x74, 0xca, 0x2a, 0x2d, 0xd3, 0x8a, 0x49, 0x08, 0x6c, 0xba, 0xee,
+	0x0e, 0xa9, 0x6f, 0x47, 0x49, 0x2a, 0xaf, 0x1f, 0xc7, 0xbc, 0xe9, 0xdc, 0x8a, 0x92, 0x94, 0x49,
+	0x11, 0xea, 0xb5, 0xb1, 0x25, 0x01, 0x4e, 0x03, 0xef, 0xa0, 0xc9, 0xb6, 0x1f, 0x77, 0x92, 0x79,
+	0x96, 0x30, 0xa1, 0xc6, 0xc4, 0x07, 0x25, 0x2c, 0xae, 0x69, 0x10, 0x98, 0x78, 0xde, 0x7f, 0x74,
+	0x2c, 0x03, 0xce, 0x5d, 0xe6, 0xfd, 0xbe, 0x4b, 0x43, 0xe4, 0x06, 0xa6, 0xbf, 0xdd, 0x37, 0x66,
+	0x62, 0x89, 0xdf, 0x3a, 0x2c, 0xcd, 0xe4, 0x3d, 0xec, 0x61, 0x86, 0x75, 0x61, 0xb8, 0xe6, 0x7d,
+	0xdc, 0xb1, 0x83, 0xc2, 0x2b, 0x65, 0xdc, 0x4b, 0x8c, 0x71, 0x1f, 0x1c, 0x5f, 0xee, 0x7d, 0xce,
+	0x21, 0xe3, 0x73, 0x7e, 0x7b, 0x27, 0xda, 0xdc, 0x44, 0x8b, 0x41, 0x67, 0x10, 0x9b, 0xf1, 0xe9,
+	0x4a, 0xb3, 0xb1, 0x20, 0xda, 0x41, 0x61, 0xe0, 0xd2, 0xdf, 0xf4, 0xdb, 0x32, 0x3d, 0x42, 0x95,
+	0x2f, 0xfd, 0x1b, 0xac, 0x05, 0x04, 0x04, 0xa7, 0xbf, 0xe7, 0xdf, 0x97, 0x0f, 0x67, 0xad, 0x47,
+	0xcb, 0x1a, 0x04, 0x26, 0x9e, 0xf7, 0x4f, 0x1d, 0xd2, 0x9a, 0xf3, 0x93, 0xa0, 0x8d, 0xa9, 0x37,
+	0xe7, 0x82, 0x74, 0x63, 0xd0, 0xde, 0xa1, 0x29, 0x4f, 0xa3, 0x81, 0xa3, 0x1c, 0x24, 0x34, 0x36,
+	0xae, 0x83, 0x6a, 0x94, 0xaf, 0x88, 0x76, 0x50, 0x18, 0xee, 0xeb, 0x64, 0x02, 0x6d, 0x2e, 0xf7,
+	0xa2, 0xb8, 0x03, 0x74, 0xb3, 0x9c, 0x44, 0x3b, 0x6b, 0xb4, 0x1d, 0xd3, 0x14, 0xe8, 0xa6, 0xf0,
+	0xb4, 0xd0, 0xfd, 0x83, 0x49, 0xcc, 0xfb, 0x61, 0x87, 0x5c, 0x98, 0xa3, 0x7e, 0x4c, 0x63, 0x96,
+	0x97, 0x47, 0xbd, 0x88, 0xfb, 0x1a, 0x69, 0xa4, 0xd8, 0x82, 0x23, 0x72, 0xca, 0x1d, 0x11, 0xf3,
+	0x91, 0x58, 0x17, 0x9d, 0x83, 0x22, 0xe3, 0x7d, 0xc6, 0x21, 0x97, 0x8a, 0xc6, 0x32, 0xdf, 0x8d,
+	0x06, 0x9d, 0x47, 0x31, 0xa0, 0x3f, 0x72, 0xc8, 0x24, 0xb3, 0x3b, 0x2f, 0xd0, 0xd4, 0x0f, 0xba,
+	0xb9, 0xf4, 0x8d, 0xce, 0x88, 0xe9, 0x1b, 0xaf, 0x92, 0xda, 0x76, 0xd4, 0xa3, 0x59, 0x9f, 0x89,
+	0x5b, 0x11, 0x6a, 0x06, 0x10, 0x82, 0x0a, 0xa5, 0x9e, 0x1f, 0x84, 0xa9, 0x8f, 0xdb, 0x51, 0xea,
+	0xbe, 0xcf, 0xf2, 0x05, 0xa8, 0x9a, 0xc1, 0xc4, 0x71, 0xbf, 0xd9, 0xc8, 0x61, 0x87, 0xdc, 0x45,
+	0x58, 0x31, 0xf3, 0x79, 0xe7, 0x10, 0x08, 0x36, 0xae, 0xf7, 0x4f, 0x9a, 0x64, 0x5c, 0x78, 0x07,
+	0x8d, 0x9c, 0x13, 0x46, 0xea, 0x37, 0x2a, 0x43, 0xf5, 0x1b, 0x09, 0x19, 0x6b, 0xb3, 0x24, 0xb4,
+	0xad, 0x6a, 0x19, 0xda, 0x04, 0x31, 0x40, 0x9e, 0xd7, 0x56, 0x0f, 0x8b, 0xff, 0x06, 0x41, 0xca,
+	0xfd, 0x51, 0x87, 0x9c, 0x6d, 0x47, 0x61, 0x48, 0xdb, 0x5a, 0xc6, 0xab, 0x95, 0xe1, 0x35, 0x34,
+	0x6f, 0x77, 0xaa, 0x2d, 0xa6, 0x19, 0x00, 0x64, 0xc9, 0xb3, 0x4f, 0xc3, 0xe6, 0xec, 0x8e, 0xa5,
+	0xed, 0xd7, 0x9f, 0xc6, 0x04, 0x82, 0x8d, 0x8b, 0x4a, 0xd1, 0x50, 0x27, 0xdf, 0x1b, 0xd3, 0x4a,
+	0x51, 0x23, 0xed, 0x9e, 0x81, 0x81, 0xd9, 0x1c, 0x62, 0xba, 0x19, 0xd3, 0x64, 0x5b, 0x78, 0x4f,
+	0x31, 0xf9, 0x72, 0xfc, 0x68, 0xd9, 0x1c, 0x20, 0xd7, 0x13, 0x14, 0xf4, 0xee, 0xee, 0x88, 0x0b,
+	0x76, 0xa3, 0x8c, 0xc3, 0x40, 0x7c, 0xe6, 0xa1, 0xf7, 0xec, 0x2b, 0xa4, 0xce, 0xce, 0x3d, 0x26,
+	0xd7, 0x56, 0x79, 0x04, 0x21, 0x3b, 0x15, 0x81, 0xb7, 0xbb, 0x0b, 0xe4, 0x5c, 0x26, 0xa1, 0x61,
+	0x22, 0xb4, 0xf2, 0x2a, 0x5a, 0x2c, 0x93, 0x0a, 0x31, 0x81, 0xdc, 0x13, 0xa6, 0xf2, 0x65, 0xe2,
+	0x00, 0xe5, 0xcb, 0x9e, 0xf2, 0xd1, 0xe5, 0xfa, 0xf2, 0xf7, 0x96, 0x32, 0x01, 0x23, 0x39, 0xe4,
+	0x7e, 0x3a, 0xe3, 0x90, 0x7b, 0xe6, 0x6a, 0xf5, 0xf8, 0x4e, 0x29, 0x72, 0x00, 0x87, 0xf7, 0xbe,
+	0x7d, 0x94, 0xde, 0xb4, 0xff, 0xdd, 0x21, 0xf2, 0xbb, 0xce, 0xfb, 0xed, 0x6d, 0xc6, 0xd8, 0xd0,
+	0xf9, 0x4c, 0xa9, 0x10, 0xb8, 0x3c, 0xe5, 0xb0, 0x55, 0xa3, 0x5c, 0x2b, 0xc0, 0x82, 0x42, 0x06,
+	0x1b, 0x6d, 0x43, 0x38, 0x4f, 0xfc, 0x51, 0x2e, 0x34, 0x28, 0x35, 0xc5, 0xec, 0xea, 0xa2, 0x78,
+	0x4a, 0xe3, 0xb8, 0x11, 0x99, 0xee, 0xfa, 0x49, 0xca, 0x46, 0x80, 0x1a, 0x85, 0x23, 0xe6, 0x52,
+	0x61, 0x21, 0x49, 0x4b, 0xd9, 0x8e, 0x20, 0xdf, 0xb7, 0xf7, 0x47, 0x35, 0x72, 0xc6, 0xe2, 0x8c,
+	0x87, 0x94, 0x36, 0xbe, 0x96, 0x34, 0xa4, 0x00, 0x90, 0x4d, 0x1a, 0xa5, 0xa4, 0x04, 0x85, 0x81,
+	0x27, 0xde, 0x86, 0x3e, 0x92, 0xb3, 0xd2, 0x91, 0x71, 0x5a, 0x83, 0x89, 0xc7, 0x98, 0x72, 0xda,
+	0x4d, 0xe6, 0xbb, 0x01, 0x0d, 0x53, 0x3e, 0xcc, 0x72, 0x98, 0xf2, 0xfa, 0xd2, 0x9a, 0xd9, 0xa9,
+	0x66, 0xca, 0x19, 0x00, 0x64, 0xc9, 0xa3, 0x33, 0xc4, 0x19, 0xff, 0x5e, 0xa2, 0x33, 0xa5, 0xb7,
+	0xea, 0x65, 0x1c, 0x52, 0x56, 0xf2, 0x75, 0xae, 0xf2, 0xb6, 0x9a, 0xc0, 0x26, 0x8a, 0xe1, 0x15,
+	0x2e, 0xbd, 0x4f, 0xdb, 0xd2, 0x39, 0x58, 0x8c, 0x65, 0xac, 0x8c, 0x9b, 0xf6, 0xf5, 0x5c, 0xbf,
+	0x9c, 0xab, 0xe7, 0xdb, 0xa1, 0x60, 0x0c, 0xde, 0x5f, 0x54, 0xd5, 0x86, 0xd2, 0xfe, 0xe8, 0xbe,
+	0xe1, 0x17, 0xeb, 0x1c, 0xdd, 0x2f, 0x56, 0xfb, 0xf5, 0xe4, 0x43, 0xb4, 0xad, 0x88, 0xce, 0xca,
+	0x23, 0x8a, 0xe8, 0xfc, 0x1e, 0xc7, 0x4a, 0x8f, 0x76, 0xec, 0x54, 0xab, 0xd9, 0x89, 0x9c, 0xe1,
+	0x3e, 0x29, 0x19, 0xee, 0x9e, 0x71, 0x35, 0xfb, 0x5a, 0xd2, 0xd8, 0xec, 0xfa, 0x2c, 0xa9, 0x47,
+	0xab, 0x66, 0xfb, 0x43, 0xdd, 0x10, 0xed, 0xa0, 0x30, 0x90, 0xf7, 0x1a, 0x9d, 0x1e, 0x8a, 0x77,
+	0xfe, 0x49, 0x95, 0x4c, 0x18, 0xe7, 0x6e, 0xa1, 0x10, 0xe5, 0x3c, 0x66, 0x42, 0x54, 0xe5, 0x10,
+	0x42, 0xd4, 0x77, 0x93, 0x66, 0x5b, 0x9e, 0x09, 0xe5, 0x64, 0xe6, 0xcf, 0x9e, 0x34, 0xfa, 0x58,
+	0x50, 0x4d, 0xa0, 0x69, 0xa2, 0x13, 0x84, 0xd1, 0x8d, 0x75, 0xb5, 0x2f, 0x0a, 0xeb, 0x13, 0xe7,
+	0x4a, 0xfe, 0x99, 0xac, 0xa9, 0xb9, 0x7e, 0xb0, 0xa9, 0x19, 0xaf, 0x2d, 0xf2, 0xe3, 0x9e, 0x42,
+	0x7a, 0x98, 0x57, 0xed, 0xf4, 0x30, 0xd7, 0x4b, 0x99, 0xe6, 0x21, 0x79, 0x61, 0x6e, 0x93, 0x71,
+	0xb4, 0x81, 0xfb, 0x61, 0xc7, 0xfd, 0x2a, 0x32, 0xde, 0xe6, 0xff, 0x0a, 0x35, 0x18, 0x33, 0xa6,
+	0x0a, 0x28, 0x48, 0x18, 0x3a, 0x3d, 0xf9, 0xf1, 0x96, 0x54, 0x7d, 0x31, 0xa7, 0xa7, 0xd9, 0x78,
+	0x2b, 0x01, 0xd6, 0xea, 0xfd, 0xfd, 0x1a, 0x61, 0xbe, 0x06, 0x7e, 0x4c, 0x3b, 0xeb, 0x11, 0xcb,
+	0xd2, 0x7a, 0xa2, 0x26, 0x48, 0x7d, 0xb5, 0x7a, 0x9c, 0xcd, 0x90, 0x86, 0x29, 0xaa, 0x7a, 0xca,
+	0xa6, 0xa8, 0x21, 0xd6, 0xc5, 0xda, 0x63, 0x64, 0x5d, 0xf4, 0x3e, 0xe5, 0x10, 0x57, 0x39, 0xa8,
+	0x68, 0xf3, 0xff, 0x35, 0xd2, 0x54, 0xae, 0x2a, 0x42, 0x0c, 0xd3, 0x2c, 0x42, 0x02, 0x40, 0xe3,
+	0x8c, 0x70, 0x9f, 0x7e, 0x4e, 0xf2, 0xef, 0xaa, 0xed, 0x0b, 0xcf, 0xb8, 0xbe, 0x60, 0xe7, 0xde,
+	0xaf, 0x57, 0xc8, 0x13, 0xfc, 0x00, 0x5f, 0xf6, 0x43, 0x7f, 0x8b, 0xf6, 0x70, 0x54, 0xa3, 0x3a,
+	0x74, 0xb4, 0xf1, 0x22, 0x17, 0x48, 0xdf, 0xf6, 0xe3, 0xee, 0x5d, 0xbe, 0xe7, 0xf8, 0x2e, 0x5b,
+	0x0c, 0x83, 0x14, 0x58, 0xe7, 0x6e, 0x42, 0x1a, 0xb2, 0x6c, 0x4d, 0xab, 0x5a, 0x26, 0x21, 0xc5,
+	0x96, 0xc4, 0x29, 0x4b, 0x41, 0x11, 0xc2, 0xa3, 0xb4, 0x1b, 0xb5, 0x77, 0x80, 0xf6, 0xa3, 0xec,
+	0x51, 0xba, 0x24, 0xda, 0x41, 0x61, 0x78, 0x3d, 0x72, 0x56, 0xce, 0x61, 0x1f, 0xd3, 0xab, 0xd2,
+	0x4d, 0x3c, 0x7f, 0xda, 0xb2, 0xc9, 0xa8, 0xa4, 0xa3, 0xce, 0x9f, 0x79, 0x13, 0x08, 0x36, 0xae,
+	0x4c, 0xdc, 0x5a, 0x29, 0x4e, 0xdc, 0xea, 0xfd, 0xba, 0x43, 0xb2, 0x07, 0xa0, 0x91, 0xa6, 0xd2,
+	0xd9, 0x37, 0x4d, 0xe5, 0x21, 0x12, 0x3d, 0x7e, 0x07, 0x99, 0xf0, 0x53, 0x94, 0x70, 0xb8, 0x4e,
+	0xa0, 0x7a, 0x34, 0x9b, 0xd3, 0x72, 0xd4, 0x09, 0x36, 0x03, 0xec, 0x01, 0xcc, 0xee, 0xbc, 0x97,
+	0x71, 0x1b, 0xa0, 0x5a, 0xc9, 0xac, 0x70, 0x80, 0x17, 0x86, 0xcd, 0x20, 0xdc, 0xa2, 0x71, 0x3f,
+	0x0e, 0xd4, 0x46, 0x50, 0x3c, 0xe7, 0x86, 0x06, 0x81, 0x89, 0xe7, 0xfd, 0xd7, 0x1a, 0x99, 0xce,
+	0x45, 0xb1, 0xb9, 0x2f, 0x92, 0x49, 0x35, 0xaf, 0x52, 0xef, 0xd7, 0x34, 0x5d, 0x2d, 0x35, 0x0c,
+	0x2c, 0xcc, 0x11, 0x36, 0xd7, 0x22, 0x39, 0x1f, 0xa3, 0x4a, 0x63, 0x40, 0x67, 0x37, 0x53, 0x1a,
+	0xaf, 0x51, 0x34, 0x4c, 0xf2, 0xcc, 0xac, 0xd5, 0xb9, 0x27, 0xd1, 0x5a, 0x03, 0x79, 0x30, 0x14,
+	0x3d, 0xe3, 0xf6, 0xc9, 0x99, 0xae, 0x29, 0xed, 0xb6, 0x6a, 0x47, 0x17, 0x94, 0xd5, 0xfa, 0xb2,
+	0x9a, 0xc1, 0x26, 0x60, 0x8b, 0xcc, 0xf5, 0x47, 0x24, 0x32, 0x7f, 0xaf, 0x16, 0x99, 0xb9, 0xa7,
+	0xc5, 0x07, 0x4a, 0x8e, 0x62, 0x1c, 0x45, 0x66, 0x3e, 0x8e, 0x14, 0xfc, 0x5e, 0xd2, 0x90, 0x5e,
+	0x68, 0x23, 0x79, 0x6f, 0x99, 0xfd, 0x0c, 0xe1, 0xc6, 0xcf, 0x93, 0xaf, 0xbc, 0x1e, 0xc7, 0xc6,
+	0x64, 0xde, 0x8e, 0x52, 0x56, 0x28, 0x01, 0x05, 0x8c, 0x57, 0x12, 0x2a, 0x74, 0x49, 0xde, 0x1b,
+	0x15, 0x52, 0x70, 0x2d, 0xc3, 0xcd, 0xad, 0xa5, 0x1a, 0x6b, 0x73, 0x1f, 0x4e, 0xb2, 0x71, 0xef,
+	0x73, 0x4f, 0x3d, 0x7e, 0x7e, 0xbf, 0xaf, 0xec, 0x6b, 0xa5, 0x76, 0xde, 0x53, 0xbc, 0x4d, 0x39,
+	0xf0, 0xbd, 0x40, 0x88, 0x16, 0x46, 0x85, 0x52, 0x5a, 0x39, 0x02, 0x68, 0x99, 0x15, 0x0c, 0x2c,
+	0x64, 0x1a, 0x41, 0x98, 0xa4, 0x7e, 0xb7, 0x7b, 0x0b, 0x99, 0x46, 0xdd, 0x66, 0x1a, 0x8b, 0x1a,
+	0x04, 0x26, 0xde, 0xe5, 0x77, 0x19, 0xdf, 0xef, 0x30, 0xdf, 0x7d, 0x9b, 0x5c, 0xba, 0x19, 0xa4,
+	0x2a, 0x20, 0x4c, 0xad, 0x37, 0x94, 0x35, 0x55, 0x80, 0xa3, 0x33, 0x34, 0xc0, 0xd1, 0x08, 0xc8,
+	0xaa, 0xd8, 0xf1, 0x63, 0xd9, 0x80, 0x2c, 0xef, 0x45, 0x72, 0xe1, 0x66, 0x90, 0x62, 0xb0, 0xcb,
+	0x21, 0x89, 0x78, 0xbf, 0x36, 0x46, 0x26, 0xcd, 0xd0, 0xe6, 0xc3, 0xc4, 0x68, 0x62, 0x3a, 0x0d,
+	0x19, 0xcc, 0x17, 0x28, 0x33, 0xea, 0xdd, 0x63, 0xc7, 0x59, 0x17, 0xcf, 0x98, 0x21, 0x51, 0x6a,
+	0x9a, 0x60, 0x0e, 0xc0, 0xbd, 0x47, 0xea, 0x9b, 0x2c, 0x60, 0xa8, 0x5a, 0x86, 0xaf, 0x49, 0xd1,
+	0x8c, 0xea, 0xed, 0xc8, 0x43, 0x8e, 0x38, 0x3d, 0x94, 0x02, 0x62, 0x3b, 0x0a, 0xd5, 0x70, 0x84,
+	0xe6, 0xed, 0xa0, 0x30, 0x86, 0x1d, 0x09, 0xf5, 0x23, 0x1c, 0x09, 0x16, 0x83, 0x1e, 0x7b, 0x44,
+	0x0c, 0x9a, 0x05, 0x7f, 0xa5, 0xdb, 0x4c, 0x46, 0x15, 0x91, 0x1b, 0xe3, 0x6c, 0x12, 0x8c, 0xe0,
+	0x2f, 0x0b, 0x0c, 0x59, 0x7c, 0xf7, 0x63, 0x8a, 0xc5, 0x37, 0xca, 0xd0, 0x34, 0x9b, 0x2b, 0xfa,
+	0xa4, 0xb9, 0xfb, 0xa7, 0x2a, 0x64, 0xea, 0x66, 0x38, 0x58, 0xbd, 0xb9, 0x3a, 0xd8, 0xe8, 0x06,
+	0x6d, 0x14, 0x4e, 0x9e, 0x23, 0xf5, 0x1d, 0xba, 0xb7, 0xb8, 0x20, 0x76, 0x90, 0x5a, 0x33, 0x2f,
+	0x63, 0x23, 0x70, 0x58, 0x56, 0x82, 0xa9, 0x8c, 0x26, 0xc1, 0x60, 0xdf, 0xd1, 0xbd, 0x90, 0xc6,
+	0x59, 0x61, 0x7d, 0x05, 0x1b, 0x81, 0xc3, 0x10, 0x29, 0x8d, 0x07, 0x42, 0xbb, 0x63, 0x20, 0xad,
+	0x63, 0x23, 0x70, 0x18, 0xee, 0xf4, 0x64, 0xb0, 0xc1, 0x5c, 0x79, 0x32, 0x61, 0x22, 0x6b, 0xbc,
+	0x19, 0x24, 0x1c, 0x51, 0x77, 0xe8, 0xde, 0x02, 0xde, 0xec, 0x33, 0x91, 0x80, 0x2f, 0xf3, 0x66,
+	0x90, 0x70, 0x96, 0x3b, 0xd6, 0x9e, 0x8e, 0x2f, 0xb9, 0xdc, 0xb1, 0xf6, 0xf0, 0x87, 0xe8, 0x08,
+	0x7e, 0xd6, 0x21, 0x93, 0xa6, 0x03, 0x9e, 0xbb, 0x95, 0x11, 0xac, 0x57, 0x72, 0xa9, 0xc7, 0xdf,
+	0x5d, 0x54, 0x41, 0x75, 0x2b, 0x48, 0xa3, 0x7e, 0xf2, 0x76, 0x1a, 0x6e, 0x05, 0x21, 0x65, 0x0e,
+	0x12, 0xdc, 0x71, 0xcf, 0xf2, 0xee, 0x9b, 0x8f, 0x3a, 0xf4, 0x08, 0x92, 0xb9, 0x77, 0x97, 0x4c,
+	0xe7, 0xc2, 0x3f, 0x47, 0x10, 0x41, 0x0e, 0x0c, 0xbe, 0xf7, 0x80, 0x4c, 0x60, 0xc7, 0x32, 0x7f,
+	0xd9, 0x3c, 0x99, 0x16, 0xf1, 0x6f, 0x41, 0x97, 0xae, 0x61, 0xdd, 0x51, 0x15, 0xd2, 0xcb, 0x2c,
+	0x0e, 0x77, 0xb2, 0x40, 0xc8, 0xe3, 0x63, 0x91, 0x8a, 0x33, 0x56, 0x44, 0x6e, 0x49, 0xc2, 0x12,
+	0xdb, 0x69, 0x11, 0xf3, 0x07, 0x65, 0x4e, 0xf1, 0x55, 0x76, 0x98, 0xea, 0x9d, 0xa6, 0x41, 0x60,
+	0xe2, 0x79, 0x3f, 0x5e, 0x21, 0xe7, 0xb2, 0x41, 0x88, 0xa8, 0xde, 0x37, 0x42, 0xf8, 0xf9, 0x4a,
+	0xbe, 0x5b, 0x6e, 0xa0, 0xe3, 0x28, 0x11, 0xfc, 0x3a, 0x72, 0xbe, 0x72, 0xca, 0x91, 0xf3, 0xde,
+	0xbb, 0xc9, 0xa5, 0xa1, 0x23, 0x1e, 0x41, 0xe0, 0xf8, 0x6d, 0x87, 0x3c, 0xbd, 0x5f, 0x7d, 0x30,
+	0xec, 0x62, 0x27, 0x08, 0x3b, 0xd9, 0x2e, 0xb0, 0xe6, 0x1c, 0x30, 0xc8, 0x09, 0x54, 0xb6, 0x11,
+	0x1e, 0x17, 0x4a, 0x31, 0x56, 0xb3, 0x97, 0xc8, 0x30, 0x15, 0x96, 0xf7, 0xd9, 0x0a, 0xb9, 0x50,
+	0x14, 0x22, 0x3a, 0xc2, 0x4b, 0x1c, 0x7c, 0x73, 0xb4, 0x5e, 0xb3, 0x3a, 0xc2, 0x6b, 0x0a, 0x6d,
+	0x40, 0x6d, 0x48, 0x19, 0x97, 0xcc, 0x3b, 0xd6, 0x47, 0x7b, 0x47, 0x5e, 0xfd, 0x25, 0x65, 0xc1,
+	0x15, 0xad, 0x31, 0x5b, 0xc3, 0x21, 0x83, 0x2e, 0x40, 0x61, 0x78, 0x9f, 0xab, 0x90, 0x86, 0x74,
+	0x44, 0x1b, 0x61, 0xff, 0x7e, 0xd2, 0x21, 0x67, 0x94, 0x69, 0x14, 0x9f, 0x11, 0x1c, 0xfc, 0xf6,
+	0xf1, 0x5d, 0xe1, 0x94, 0x1e, 0x0e, 0xb5, 0xe8, 0xea, 0xba, 0x0b, 0x26, 0x31, 0xb0, 0x69, 0xbb,
+	0x77, 0x30, 0xda, 0x21, 0x49, 0x69, 0xcf, 0xd0, 0xe7, 0x7b, 0xc6, 0x31, 0x35, 0xd3, 0x8e, 0x62,
+	0x8a, 0x87, 0x12, 0xba, 0xef, 0xad, 0x29, 0x4c, 0x7d, 0xef, 0xd0, 0x6d, 0x60, 0xf4, 0xe4, 0xfd,
+	0x22, 0x72, 0x92, 0xcc, 0x90, 0xdc, 0x0f, 0xa0, 0x57, 0xb2, 0xae, 0x6b, 0x98, 0x71, 0xa3, 0x9b,
+	0x04, 0x03, 0xf6, 0xc6, 0x83, 0x2b, 0x57, 0xf2, 0x25, 0xac, 0x67, 0x4c, 0x14, 0xb0, 0x3a, 0xe3,
+	0xf6, 0x69, 0xe1, 0x48, 0x31, 0xb7, 0x37, 0xdb, 0xef, 0xb7, 0x2a, 0x59, 0xfb, 0xb4, 0x09, 0x85,
+	0x0c, 0x36, 0x86, 0xc0, 0x19, 0x2d, 0xb7, 0x69, 0xb0, 0xb5, 0xbd, 0x11, 0xc5, 0x52, 0x6d, 0xf1,
+	0xb4, 0xf6, 0x8f, 0xcd, 0xe3, 0x40, 0xe1, 0x93, 0xb8, 0x8c, 0xda, 0x7e, 0xdf, 0x6f, 0x07, 0xe9,
+	0x9e, 0x30, 0x50, 0xa8, 0x65, 0x34, 0x2f, 0xda, 0x41, 0x61, 0x78, 0xcb, 0xa4, 0x36, 0xe2, 0x0a,
+	0x1a, 0xe9, 0xba, 0xfc, 0x5e, 0xd2, 0xc0, 0xee, 0xe4, 0x9d, 0xa8, 0x8c, 0x2e, 0xff, 0xc4, 0x21,
+	0x0d, 0x59, 0x66, 0xce, 0xf5, 0x48, 0x35, 0xf0, 0xa5, 0x0f, 0x80, 0x7a, 0xaf, 0xc5, 0x24, 0x19,
+	0x30, 0x7d, 0x16, 0x02, 0xdd, 0xe7, 0x48, 0x95, 0xde, 0xef, 0x67, 0x8d, 0xfd, 0xd7, 0xef, 0xf7,
+	0x83, 0x98, 0x26, 0x88, 0x44, 0xef, 0xf7, 0xdd, 0xcb, 0xa4, 0x12, 0x74, 0xc4, 0x66, 0x27, 0x02,
+	0xa7, 0xb2, 0xb8, 0x00, 0x95, 0xa0, 0xe3, 0x06, 0xa4, 0x9e, 0xb4, 0xa3, 0x3e, 0x2d, 0x27, 0x0c,
+	0x87, 0x0d, 0x9c, 0x55, 0xb8, 0x14, 0xae, 0x2e, 0xf8, 0x2f, 0x70, 0x0a, 0xde, 0x7d, 0xd2, 0x94,
+	0xef, 0xc6, 0xbc, 0x54, 0xb9, 0x70, 0xe5, 0x94, 0xe1, 0xa5, 0x2a, 0xfb, 0x1d, 0x22, 0x56, 0x0d,
+	0x08, 0xd1, 0xf1, 0xe5, 0x65, 0x09, 0x00, 0x57, 0x49, 0xad, 0x1d, 0x89, 0xbc, 0x26, 0x0d, 0xdd,
+	0x0d, 0x93, 0xaa, 0x18, 0xc4, 0xbb, 0x4b, 0xa6, 0x5e, 0x0e, 0xa3, 0x7b, 0xac, 0xd2, 0x0d, 0x4b,
+	0xec, 0x8a, 0x1d, 0x6f, 0xe2, 0x3f, 0x59, 0x19, 0x9e, 0x41, 0x81, 0xc3, 0x54, 0xca, 0xc9, 0xca,
+	0xb0, 0x94, 0x93, 0x1e, 0xd6, 0x90, 0x54, 0xc7, 0xe3, 0xcd, 0xdd, 0x1d, 0xec, 0x77, 0x2b, 0x8e,
+	0x06, 0xfd, 0x6c, 0xbf, 0xac, 0xb0, 0x2a, 0x70, 0x98, 0x19, 0xc1, 0x5d, 0x39, 0x20, 0x82, 0x5b,
+	0x9e, 0x34, 0xd5, 0x61, 0x27, 0x0d, 0x0e, 0xe1, 0x9c, 0x1a, 0x82, 0x94, 0xd8, 0x5e, 0x24, 0x93,
+	0x1b, 0x83, 0xa0, 0xdb, 0x11, 0xbf, 0xb3, 0x2a, 0xcf, 0x39, 0x03, 0x06, 0x16, 0x26, 0x2a, 0x5e,
+	0x36, 0x82, 0xd0, 0x8f, 0xf7, 0x56, 0xb5, 0x88, 0xa8, 0x18, 0xe0, 0x9c, 0x82, 0x80, 0x81, 0xe5,
+	0x7d, 0xb6, 0x4a, 0xa6, 0xec, 0x70, 0xdd, 0x11, 0xf4, 0x1f, 0xcf, 0x91, 0x3a, 0x8b, 0xe0, 0xcd,
+	0x7e, 0x5a, 0xf6, 0x3c, 0x70, 0x18, 0xfa, 0x02, 0xf2, 0xbc, 0x4e, 0xe5, 0x54, 0x3c, 0x54, 0x83,
+	0x54, 0x8a, 0x52, 0x26, 0x02, 0x89, 0x54, 0x52, 0x82, 0x14, 0x0a, 0x81, 0xe3, 0x51, 0xdf, 0x4c,
+	0x55, 0xf8, 0xbe, 0x32, 0x43, 0x99, 0x45, 0x7c, 0xa3, 0xb8, 0xb2, 0xaa, 0x4f, 0x2f, 0x3f, 0x87,
+	0x24, 0x7d, 0xf9, 0x9b, 0xc8, 0xa4, 0x89, 0x79, 0xd0, 0xad, 0xb5, 0x61, 0xde, 0x5a, 0x3f, 0x69,
+	0x2e, 0x0a, 0x11, 0xac, 0x3d, 0xc2, 0x76, 0x7b, 0x85, 0xd4, 0xdb, 0xca, 0x67, 0xe9, 0x48, 0x79,
+	0xce, 0x55, 0xa2, 0x25, 0xec, 0x06, 0x78, 0x6f, 0x68, 0x4a, 0x9e, 0x32, 0x46, 0x93, 0x2c, 0x76,
+	0xdc, 0x98, 0x54, 0xb7, 0x76, 0x77, 0x84, 0x84, 0xfd, 0x52, 0x49, 0xd3, 0x7b, 0x73, 0x77, 0x47,
+	0xaf, 0x71, 0xb3, 0x15, 0x90, 0xd8, 0x09, 0xc8, 0x64, 0xde, 0xe7, 0x2b, 0x64, 0x3a, 0xb7, 0xa8,
+	0xdc, 0xd7, 0x49, 0x3d, 0xc6, 0xb7, 0x14, 0xaf, 0xb7, 0x54, 0x5a, 0x14, 0x7e, 0xb2, 0xd8, 0xd1,
+	0x67, 0xbc, 0xdd, 0x0e, 0x9c, 0xa4, 0xfb, 0x12, 0x71, 0xb5, 0x67, 0x9d, 0x32, 0x25, 0xf0, 0x57,
+	0xbe, 0x2c, 0x1e, 0x75, 0x67, 0x73, 0x18, 0x50, 0xf0, 0x14, 0x1a, 0xaf, 0x6c, 0x8b, 0x44, 0xd5,
+	0x36, 0x5e, 0xed, 0x67, 0x5c, 0xf0, 0xfe, 0x71, 0x85, 0x9c, 0xb1, 0x32, 0x47, 0xba, 0x5d, 0xd2,
+	0xa0, 0x5d, 0x66, 0x59, 0x94, 0x87, 0xcd, 0x71, 0xeb, 0x40, 0xa8, 0xb3, 0xf8, 0xba, 0xe8, 0x17,
+	0x14, 0x85, 0xc7, 0xc3, 0x1f, 0xe8, 0x45, 0x32, 0x29, 0x07, 0xf4, 0x3e, 0xbf, 0xd7, 0x15, 0x13,
+	0xa8, 0xd6, 0xe8, 0x75, 0x03, 0x06, 0x16, 0xa6, 0xf7, 0x1b, 0x55, 0xd2, 0xe2, 0xa6, 0xd8, 0x8e,
+	0x5a, 0x79, 0xcb, 0x52, 0x21, 0xf2, 0x23, 0x3a, 0xbf, 0xab, 0x53, 0x46, 0x5d, 0xea, 0x61, 0x84,
+	0x46, 0x72, 0x26, 0xfd, 0xa9, 0x8c, 0x33, 0x29, 0x17, 0xf1, 0xb7, 0x4e, 0x68, 0x44, 0x5f, 0x5a,
+	0xde, 0xa5, 0x7f, 0xbb, 0x42, 0xce, 0x66, 0x6a, 0x5a, 0x61, 0x26, 0x30, 0xb3, 0x0c, 0x82, 0x53,
+	0x86, 0xd1, 0x6b, 0xdf, 0x32, 0x47, 0x87, 0x2b, 0x86, 0xf0, 0x88, 0xb6, 0x8a, 0xf7, 0x07, 0x15,
+	0x32, 0x65, 0x17, 0xe3, 0x7a, 0x0c, 0x67, 0xea, 0x6b, 0x48, 0x93, 0xd5, 0x9b, 0x61, 0xe5, 0xfe,
+	0xb9, 0xcd, 0x8c, 0x97, 0xf6, 0x90, 0x8d, 0xa0, 0xe1, 0x8f, 0x45, 0x8d, 0x09, 0xef, 0xef, 0x3a,
+	0xe4, 0x22, 0x7f, 0xcb, 0xec, 0x3a, 0xfc, 0x2b, 0x45, 0xb3, 0xfb, 0xc1, 0x72, 0x07, 0x98, 0xc9,
+	0x4b, 0x7c, 0xd0, 0xfc, 0xb2, 0x92, 0xcf, 0x62, 0xb4, 0xf6, 0x52, 0x78, 0x0c, 0x07, 0x7b, 0xa8,
+	0xc5, 0xe0, 0xfd, 0x6e, 0x8d, 0xe8, 0x2a, 0xd7, 0x98, 0x9f, 0x99, 0x85, 0xa5, 0x97, 0x92, 0x9f,
+	0x19, 0x9d, 0xba, 0x55, 0xd7, 0xdc, 0x86, 0x6b, 0x44, 0xa5, 0xff, 0xa0, 0x83, 0x66, 0xd1, 0x20,
+	0x0d, 0x7c, 0x76, 0x65, 0x2f, 0xa7, 0x54, 0xad, 0x22, 0xb7, 0xc8, 0x7b, 0x8e, 0x62, 0xd3, 0xd0,
+	0xaa, 0x88, 0x81, 0x49, 0xd9, 0xfd, 0x88, 0x88, 0xf7, 0xa8, 0x96, 0x96, 0x50, 0xa1, 0x91, 0x09,
+	0xf2, 0xe8, 0xa3, 0xe0, 0x95, 0xc6, 0x25, 0xe5, 0x21, 0x01, 0xec, 0x4a, 0xa5, 0xfa, 0x57, 0xa2,
+	0x2d, 0x6b, 0x06, 0x4e, 0xc8, 0xdd, 0x23, 0x0d, 0x5f, 0x54, 0xf3, 0x2f, 0x27, 0x09, 0xb3, 0x9a,
+	0xd9, 0x59, 0xd1, 0x2d, 0x8f, 0x2b, 0x93, 0xbf, 0x40, 0x91, 0xf3, 0x7e, 0xb1, 0x4e, 0xa6, 0x73,
+	0xd8, 0xee, 0xbb, 0x49, 0xbd, 0xbf, 0xed, 0x27, 0x52, 0xca, 0x7f, 0xab, 0xba, 0x56, 0x61, 0x23,
+	0x06, 0x37, 0xe7, 0x1e, 0x61, 0x10, 0xe0, 0x4f, 0xb9, 0x3e, 0x99, 0x50, 0xfa, 0x9d, 0xd9, 0xf4,
+	0x08, 0xe5, 0x77, 0x8d, 0x8c, 0x99, 0xaa, 0x1b, 0x30, 0xfb, 0x74, 0x3f, 0x40, 0x9a, 0x54, 0xaa,
+	0x45, 0x8e, 0xe0, 0x4d, 0x54, 0xa0, 0x5b, 0xd1, 0xfd, 0xa1, 0x8c, 0xdf, 0x09, 0x36, 0x37, 0x5b,
+	0x35, 0x5b, 0xc6, 0x47, 0xcf, 0x3b, 0x60, 0x10, 0xee, 0x59, 0x80, 0x6f, 0xce, 0x76, 0x43, 0x3d,
+	0xe3, 0x59, 0xa0, 0x20, 0x60, 0x60, 0x61, 0xd4, 0xbd, 0xfc, 0x75, 0xa4, 0x04, 0x03, 0x53, 0x66,
+	0xdf, 0x18, 0x75, 0xaf, 0x7b, 0x33, 0xed, 0x3d, 0xe3, 0x07, 0x78, 0x62, 0x7d, 0x83, 0xf1, 0x71,
+	0xe6, 0xf6, 0x5a, 0x0d, 0xdb, 0xa6, 0x68, 0x68, 0xeb, 0xc0, 0xc4, 0x63, 0xf9, 0x31, 0xfb, 0xb4,
+	0x7d, 0xcb, 0x4f, 0xb6, 0x45, 0x54, 0xbf, 0xf6, 0xa3, 0x17, 0xed, 0xa0, 0x30, 0x2c, 0x63, 0x37,
+	0x39, 0xd0, 0xd8, 0xfd, 0x35, 0xa4, 0x29, 0xff, 0xe7, 0x49, 0xc7, 0x04, 0x0f, 0xd4, 0x05, 0x8c,
+	0x35, 0xdc, 0x4b, 0x88, 0x9b, 0x67, 0x1c, 0x87, 0x0c, 0x3c, 0xc1, 0xd0, 0x9a, 0x41, 0x1a, 0xf5,
+	0x90, 0xa7, 0x08, 0xc7, 0x09, 0x1d, 0x5a, 0x23, 0x01, 0xa0, 0x71, 0xbc, 0xff, 0x3d, 0x46, 0x32,
+	0x49, 0x15, 0xdc, 0xfb, 0xa4, 0xa9, 0xd2, 0x2a, 0x94, 0x13, 0x05, 0xaa, 0xd9, 0xaf, 0x1a, 0x8c,
+	0x6a, 0x02, 0x4d, 0xcc, 0xdd, 0x92, 0xbb, 0x93, 0x5f, 0xc8, 0xde, 0x9b, 0xdd, 0x9d, 0xdf, 0x36,
+	0x9a, 0x0d, 0x11, 0x19, 0xfb, 0x35, 0x9e, 0x08, 0x4e, 0x93, 0xb6, 0xf6, 0xf1, 0x21, 0x2a, 0x5b,
+	0x7f, 0x42, 0x54, 0xa1, 0x02, 0x9a, 0x0c, 0xba, 0xa9, 0x60, 0x9d, 0xef, 0x2d, 0xf1, 0x48, 0xe2,
+	0x1d, 0xeb, 0x74, 0x40, 0xfc, 0x37, 0x18, 0x44, 0x91, 0x27, 0x24, 0xa9, 0x1f, 0xa7, 0x47, 0xdc,
+	0x5f, 0x3a, 0x81, 0xa9, 0xec, 0x04, 0x74, 0x7f, 0xb8, 0x7b, 0x37, 0x83, 0x30, 0x48, 0xb6, 0x8f,
+	0x18, 0xd3, 0x28, 0xeb, 0x40, 0x88, 0x1e, 0xc0, 0xe8, 0x0d, 0xb9, 0x09, 0x3b, 0x08, 0xb8, 0x6b,
+	0x7e, 0x83, 0x69, 0x7f, 0x15, 0x37, 0x01, 0x05, 0x01, 0x03, 0xcb, 0xed, 0x92, 0x73, 0xc2, 0x03,
+	0x52, 0x0d, 0xb7, 0xd5, 0x3c, 0xf4, 0xa8, 0x2e, 0xb0, 0xf2, 0x2d, 0x99, 0x7e, 0x20, 0xd7, 0x33,
+	0x4b, 0xa4, 0x1e, 0xf4, 0x68, 0x34, 0x48, 0xc5, 0x97, 0x17, 0xbb, 0x5a, 0x27, 0x52, 0xb7, 0xa0,
+	0x90, 0xc1, 0xe6, 0xb6, 0x86, 0x34, 0xde, 0x5b, 0x09, 0x05, 0x22, 0x0b, 0x6c, 0x6c, 0x98, 0xb6,
+	0x06, 0x13, 0x0a, 0x19, 0x6c, 0xef, 0xeb, 0x88, 0x9d, 0xbd, 0x0b, 0x23, 0x31, 0x79, 0xb2, 0x30,
+	0x6e, 0x41, 0x66, 0xea, 0x69, 0x2b, 0xaf, 0xd7, 0x2f, 0x3b, 0xc4, 0x4c, 0x31, 0xe6, 0xbe, 0xc6,
+	0x73, 0x99, 0x39, 0x65, 0x78, 0xfd, 0x18, 0xfd, 0xce, 0x2c, 0xfb, 0xfd, 0x8c, 0xfb, 0x99, 0x4c,
+	0x68, 0x86, 0x3e, 0x61, 0x12, 0x7a, 0xa8, 0xfb, 0xde, 0xc7, 0xc8, 0x79, 0x99, 0x12, 0x42, 0x9a,
+	0x6f, 0x84, 0xc7, 0xc8, 0xc1, 0x5a, 0x61, 0xa9, 0xea, 0xad, 0x1c, 0x68, 0x54, 0x1c, 0x6a, 0xe8,
+	0xf4, 0x7e, 0xc5, 0x21, 0x57, 0xb3, 0x03, 0x48, 0x96, 0xa3, 0x30, 0x48, 0xa3, 0x78, 0x8d, 0xa6,
+	0x69, 0x10, 0x6e, 0xb1, 0x14, 0xae, 0xf7, 0xfc, 0x58, 0x96, 0x18, 0x62, 0x32, 0xd4, 0x5d, 0x3f,
+	0x0e, 0x81, 0xb5, 0xa2, 0xed, 0x99, 0x7b, 0xab, 0x8b, 0x8b, 0xfc, 0x31, 0x39, 0x41, 0xc1, 0x74,
+	0x68, 0x4d, 0x02, 0xf7, 0x94, 0x07, 0x41, 0xd0, 0xfb, 0x33, 0x87, 0xb8, 0x2b, 0xbb, 0x34, 0x8e,
+	0x83, 0x8e, 0xe1, 0x5f, 0xcf, 0x6a, 0x57, 0x1a, 0x35, 0x2a, 0xcd, 0x84, 0x25, 0x99, 0xda, 0x95,
+	0xc6, 0xaf, 0xe2, 0xda, 0x95, 0x95, 0xc3, 0xd5, 0xae, 0x74, 0x57, 0xc8, 0xc5, 0x1e, 0xd7, 0x44,
+	0xf0, 0x7a, 0x70, 0x5c, 0x2d, 0xa1, 0x62, 0xeb, 0x2f, 0x3d, 0x7c, 0x70, 0xe5, 0xe2, 0x72, 0x11,
+	0x02, 0x14, 0x3f, 0xe7, 0xbd, 0x8b, 0xb8, 0xdc, 0xe0, 0x3e, 0x5f, 0xe4, 0x67, 0x3c, 0x54, 0x33,
+	0xeb, 0x7d, 0xa1, 0x4e, 0xce, 0x66, 0x0a, 0x50, 0xa0, 0x16, 0x28, 0xef, 0xd8, 0x7c, 0x6c, 0xd1,
+	0x3e, 0x3f, 0xbc, 0x91, 0x5c, 0xa5, 0x43, 0x52, 0x0f, 0xc2, 0xfe, 0x20, 0x2d, 0x27, 0xb5, 0x07,
+	0x1f, 0xc4, 0x22, 0x76, 0x68, 0x58, 0x92, 0xf0, 0x27, 0x70, 0x32, 0x65, 0x3a, 0x5e, 0x5b, 0xf7,
+	0xf4, 0xda, 0x23, 0xd2, 0x14, 0x7e, 0x42, 0xbb, 0x41, 0xd7, 0xcb, 0xb0, 0x39, 0x64, 0x16, 0xcb,
+	0x49, 0xbb, 0xc9, 0xfd, 0x52, 0x85, 0x4c, 0x18, 0x1f, 0xcd, 0xfd, 0x19, 0x3b, 0x01, 0xa7, 0x53,
+	0xde, 0x2b, 0xb1, 0xfe, 0x67, 0x74, 0x8a, 0x4d, 0xfe, 0x4a, 0xcf, 0xe7, 0x73, 0x6f, 0xbe, 0xf1,
+	0xe0, 0xca, 0xb9, 0x4c, 0x76, 0x4d, 0x2b, 0x1f, 0xe7, 0xe5, 0xef, 0x22, 0x67, 0x33, 0xdd, 0x14,
+	0xbc, 0xf2, 0xba, 0xf9, 0xca, 0xc7, 0xd6, 0x58, 0x9b, 0x53, 0xf6, 0x0b, 0x38, 0x65, 0x22, 0x29,
+	0x40, 0xd4, 0xa5, 0x23, 0x98, 0x67, 0x32, 0x89, 0x43, 0x2a, 0x23, 0x26, 0x0e, 0x79, 0x1b, 0x69,
+	0xf4, 0xa3, 0x6e, 0xd0, 0x0e, 0x54, 0x3e, 0x6c, 0x76, 0xa5, 0x5c, 0x15, 0x6d, 0xa0, 0xa0, 0xee,
+	0x3d, 0xd2, 0x7c, 0xf5, 0x5e, 0xca, 0x0d, 0xc3, 0xad, 0x5a, 0xa9, 0xf6, 0x60, 0x25, 0xa2, 0xc9,
+	0x96, 0x04, 0x34, 0x2d, 0x4c, 0xb1, 0xc3, 0x0e, 0x41, 0x19, 0x9a, 0xc8, 0xcc, 0x72, 0xec, 0x74,
+	0x4c, 0x40, 0x40, 0xbc, 0x9f, 0x6b, 0x92, 0x0b, 0x45, 0x55, 0x80, 0xdc, 0x8f, 0x92, 0x31, 0x3e,
+	0xc6, 0x72, 0x0a, 0xcd, 0x15, 0xd1, 0xb8, 0xc9, 0x3a, 0x14, 0xc3, 0x62, 0xff, 0x83, 0xa0, 0x29,
+	0xa8, 0x77, 0xfd, 0x8d, 0x56, 0xe5, 0x04, 0xa9, 0x2f, 0xf9, 0x9a, 0xfa, 0x92, 0xcf, 0xa9, 0x77,
+	0xfd, 0x0d, 0xf7, 0x3e, 0xa9, 0x6f, 0x05, 0x29, 0xf5, 0x5b, 0xd5, 0x32, 0x7c, 0xd5, 0x86, 0x10,
+	0xa7, 0x3e, 0x97, 0xd2, 0xd8, 0xbf, 0xc0, 0x09, 0x62, 0x8c, 0xdd, 0xd9, 0x0d, 0x3b, 0x63, 0x91,
+	0x60, 0x9e, 0x7e, 0xf9, 0x83, 0xc8, 0xa4, 0x46, 0xe2, 0xc5, 0x5b, 0x33, 0x8d, 0x90, 0x1d, 0x0e,
+	0x86, 0x96, 0x8c, 0x6f, 0x06, 0x5d, 0xa3, 0xd8, 0xc6, 0x09, 0x7c, 0x9c, 0x1b, 0x8c, 0x80, 0xbe,
+	0x5f, 0xf1, 0xdf, 0x09, 0x48, 0xca, 0xc3, 0x4e, 0xaa, 0xb1, 0xe3, 0x9e, 0x54, 0xe3, 0x8f, 0xe8,
+	0xa4, 0xfa, 0x21, 0x87, 0x34, 0xd5, 0x4c, 0x8b, 0xe4, 0x2d, 0x1f, 0x38, 0xc1, 0x4f, 0xce, 0x15,
+	0x0a, 0xea, 0x27, 0x68, 0xe2, 0x18, 0x70, 0x3e, 0xe1, 0xbf, 0x3e, 0x88, 0x69, 0x87, 0xee, 0x46,
+	0xfd, 0x44, 0xdc, 0xa2, 0x3e, 0x58, 0xfe, 0x60, 0x66, 0x91, 0xc8, 0x02, 0xdd, 0x5d, 0xe9, 0x27,
+	0x22, 0x6c, 0x5a, 0x37, 0x80, 0x39, 0x04, 0xef, 0x41, 0x85, 0x5c, 0x39, 0xa0, 0x07, 0xb4, 0x0a,
+	0x46, 0xf1, 0x96, 0x1f, 0x06, 0xaf, 0x9b, 0x29, 0xc8, 0x94, 0x94, 0xb5, 0x62, 0xc0, 0xc0, 0xc2,
+	0x34, 0xd3, 0xcb, 0x54, 0x0e, 0x48, 0x2f, 0x73, 0x95, 0xd4, 0x62, 0xda, 0x8f, 0xb2, 0x97, 0x05,
+	0x16, 0xb2, 0xc8, 0x20, 0xe8, 0x50, 0xe8, 0xf7, 0x83, 0xac, 0x43, 0xe1, 0xec, 0xea, 0x22, 0x60,
+	0xbb, 0x95, 0x2a, 0xab, 0x7e, 0x2a, 0xa9, 0xb2, 0xf0, 0x18, 0x10, 0x66, 0xcd, 0x31, 0x7d, 0x0c,
+	0xd8, 0xe6, 0x46, 0xef, 0xf3, 0x55, 0xf2, 0xcc, 0xbe, 0xeb, 0x45, 0xfb, 0xd0, 0x3b, 0xfb, 0xf8,
+	0xd0, 0xcb, 0xe9, 0xa9, 0x1c, 0x34, 0x3d, 0xd5, 0x21, 0xd3, 0xf3, 0xbd, 0xb8, 0x0d, 0x64, 0xea,
+	0xb6, 0x72, 0x6a, 0x77, 0x0f, 0xcb, 0x04, 0x27, 0x76, 0x80, 0x84, 0x82, 0xa6, 0x8b, 0x77, 0x00,
+	0x2b, 0xb5, 0x4a, 0xbd, 0x8c, 0x63, 0x60, 0x68, 0xfa, 0x34, 0xbe, 0xf6, 0x87, 0xe5, 0x6b, 0xf1,
+	0x7e, 0xb5, 0x46, 0x9e, 0x1b, 0x81, 0x7b, 0x9b, 0xab, 0xd8, 0x19, 0x71, 0x15, 0x7f, 0x89, 0x7f,
+	0xa6, 0xef, 0x2f, 0xfc, 0x4c, 0x50, 0xfe, 0x67, 0xda, 0xff, 0x0b, 0xa1, 0xae, 0x35, 0x08, 0x13,
+	0xda, 0x1e, 0xc4, 0x34, 0xeb, 0xed, 0xbb, 0x28, 0xda, 0x41, 0x61, 0xe0, 0x9d, 0xae, 0xed, 0xe3,
+	0xf6, 0x1f, 0x2f, 0x29, 0x89, 0x87, 0x19, 0x1a, 0xcd, 0x45, 0x8a, 0xf9, 0x59, 0xe4, 0x00, 0x9c,
+	0x8c, 0xf7, 0xe3, 0x0e, 0xb9, 0x3c, 0xfc, 0x88, 0xc5, 0x24, 0x16, 0x1b, 0xb1, 0x1f, 0xb6, 0xb7,
+	0x97, 0x99, 0xdf, 0x98, 0x58, 0x3a, 0xec, 0x7d, 0x75, 0x33, 0x98, 0x38, 0xa8, 0x04, 0xe0, 0x4e,
+	0x5d, 0x06, 0x86, 0x4c, 0x01, 0x82, 0x4a, 0x80, 0xf5, 0x2c, 0x10, 0xf2, 0xf8, 0xde, 0x17, 0xab,
+	0xc5, 0xc3, 0xe2, 0xa2, 0xd8, 0x61, 0x56, 0xb3, 0x58, 0xab, 0x95, 0x11, 0x38, 0x6e, 0xf5, 0xb4,
+	0x39, 0x6e, 0x6d, 0x18, 0xc7, 0xc5, 0xcc, 0x68, 0x46, 0x59, 0x4d, 0x9e, 0xd6, 0x85, 0xdb, 0x4d,
+	0x54, 0x66, 0xb4, 0xd5, 0x0c, 0x1c, 0x72, 0x4f, 0x3c, 0xe6, 0x4b, 0xef, 0x67, 0x2b, 0xe4, 0xd2,
+	0x50, 0xe9, 0xf7, 0x94, 0x4e, 0x14, 0xf3, 0xf3, 0xd7, 0x4e, 0xe7, 0xf3, 0x9b, 0x1f, 0xa5, 0x7e,
+	0xd0, 0x47, 0xf1, 0xfe, 0xb0, 0x32, 0x74, 0x23, 0xe0, 0x4d, 0xe8, 0xcb, 0x76, 0x96, 0xbe, 0x99,
+	0x9c, 0xf1, 0xfb, 0x7d, 0x8e, 0xc7, 0x9c, 0xf9, 0x33, 0x99, 0x18, 0x67, 0x4d, 0x20, 0xd8, 0xb8,
+	0x23, 0xc9, 0x34, 0x7f, 0xea, 0x90, 0x26, 0xd0, 0x4d, 0xce, 0x8d, 0x30, 0x67, 0x3d, 0x9b, 0x22,
+	0xa7, 0x0c, 0x47, 0x70, 0x9c, 0xd8, 0x24, 0x60, 0xb9, 0xdc, 0x8b, 0x26, 0x3b, 0x5f, 0x66, 0xb5,
+	0x72, 0xa8, 0x32, 0xab, 0xaa, 0xd0, 0x66, 0x75, 0x78, 0xa1, 0x4d, 0xef, 0xc7, 0x09, 0xbe, 0x5e,
+	0x3f, 0xc2, 0x7a, 0x80, 0x09, 0x7e, 0xdf, 0x41, 0xdc, 0x6d, 0x39, 0xf6, 0xf7, 0xc5, 0xd0, 0x63,
+	0x6c, 0xb7, 0xcc, 0x81, 0x95, 0x43, 0xe5, 0xa1, 0xab, 0x1e, 0x98, 0x87, 0x0e, 0xb3, 0x41, 0x25,
+	0xdb, 0xab, 0x71, 0xb0, 0xeb, 0xa7, 0xa8, 0x89, 0xce, 0x65, 0x3b, 0x5d, 0xbb, 0xa5, 0x81, 0x60,
+	0xe3, 0x62, 0x32, 0x26, 0x9d, 0x0d, 0x8e, 0xc6, 0x29, 0x8b, 0x97, 0xe4, 0x2b, 0x41, 0xa5, 0x7e,
+	0xd1, 0xf9, 0xe3, 0x04, 0x02, 0xe4, 0x9f, 0x41, 0x7e, 0x6a, 0x35, 0xe2, 0x40, 0xc6, 0x6c, 0x7e,
+	0x6a, 0xf5, 0x83, 0x63, 0xc9, 0x3d, 0x81, 0xb9, 0xc2, 0xf9, 0xc2, 0x98, 0xed, 0xf7, 0x8d, 0x37,
+	0x1a, 0xb7, 0x73, 0x85, 0xdf, 0xcc, 0xa3, 0x40, 0xd1, 0x73, 0xa8, 0x5b, 0x52, 0xcd, 0x8b, 0x0b,
+	0xc2, 0x92, 0xa5, 0x74, 0x4b, 0xaa, 0x9b, 0xc5, 0x0e, 0x98, 0x78, 0x58, 0xd6, 0x51, 0xff, 0xe4,
+	0x41, 0xf5, 0xdc, 0xbc, 0xbb, 0x20, 0x12, 0x6d, 0xaa, 0xb2, 0x8e, 0x37, 0x0b, 0xd1, 0x3a, 0x30,
+	0xec, 0x79, 0x77, 0x83, 0x5c, 0x56, 0xa0, 0xeb, 0x61, 0xca, 0x22, 0x64, 0x13, 0x3a, 0xe7, 0x27,
+	0xf4, 0x95, 0xb8, 0x2b, 0x8c, 0x58, 0xaa, 0xf2, 0xff, 0xcd, 0x20, 0xbd, 0x55, 0x84, 0x09, 0x4b,
+	0xb0, 0x4f, 0x2f, 0x68, 0x4d, 0xa6, 0xa1, 0xbf, 0xd1, 0xa5, 0x2b, 0xf3, 0x8b, 0xc2, 0xae, 0xa5,
+	0xfd, 0x0b, 0x24, 0x00, 0x34, 0x8e, 0x0a, 0x09, 0x98, 0x1c, 0x16, 0x12, 0x80, 0xb1, 0x35, 0x5b,
+	0xed, 0x3e, 0x4a, 0x84, 0x41, 0x9b, 0xce, 0xb6, 0x99, 0x07, 0x34, 0x7e, 0x18, 0x9e, 0xc4, 0x5d,
+	0xc5, 0xd6, 0xdc, 0x9c, 0x5f, 0xcd, 0xe1, 0x40, 0xe1, 0x93, 0xcc, 0x53, 0x3e, 0x8e, 0xee, 0xef,
+	0xb5, 0xce, 0x67, 0x3c, 0xe5, 0xb1, 0x11, 0x38, 0x0c, 0xfd, 0x7e, 0x59, 0x74, 0xe3, 0xad, 0x34,
+	0xed, 0x2b, 0x11, 0xb4, 0x75, 0x81, 0xbd, 0x92, 0xf2, 0xfb, 0xbd, 0x91, 0xc3, 0x80, 0x82, 0xa7,
+	0x50, 0xa2, 0x09, 0x23, 0xd6, 0x7b, 0xeb, 0x49, 0x5b, 0xa2, 0xb9, 0xcd, 0x9b, 0x41, 0xc2, 0xd9,
+	0x5a, 0x46, 0x36, 0x29, 0x33, 0x3b, 0x60, 0x62, 0x81, 0x56, 0x66, 0x2d, 0x67, 0xe0, 0x90, 0x7b,
+	0xc2, 0x5d, 0x23, 0x17, 0xad, 0x36, 0xbe, 0xd2, 0x17, 0x17, 0x5a, 0x97, 0x58, 0x57, 0xcf, 0x88,
+	0xae, 0x2e, 0xae, 0x17, 0x21, 0x41, 0xf1, 0xb3, 0xb9, 0x4e, 0x67, 0x07, 0x9d, 0x80, 0x86, 0x6d,
+	0xda, 0xba, 0xbc, 0x4f, 0xa7, 0x12, 0x09, 0x8a, 0x9f, 0xc5, 0x69, 0xb6, 0x00, 0x2c, 0xae, 0xa6,
+	0xf5, 0x94, 0xed, 0x5e, 0xbd, 0x9e, 0xc3, 0x80, 0x82, 0xa7, 0xbc, 0x7f, 0xed, 0x90, 0x33, 0x8a,
+	0x2d, 0x9e, 0x42, 0x18, 0x75, 0xd7, 0x0e, 0xa3, 0xbe, 0x79, 0xfc, 0x83, 0x85, 0x8d, 0x7c, 0x48,
+	0xa8, 0xcf, 0x7f, 0x98, 0x22, 0x44, 0x1f, 0x3e, 0xea, 0xdc, 0x77, 0x86, 0x9e, 0xfb, 0x8f, 0x2d,
+	0xe3, 0x2f, 0x4a, 0x6b, 0x58, 0x7f, 0xb4, 0x69, 0x0d, 0xd7, 0xc8, 0x45, 0x29, 0x95, 0x71, 0x43,
+	0x29, 0xc6, 0x1f, 0xca, 0x73, 0xa4, 0xa1, 0xd7, 0xf6, 0x62, 0x11, 0x12, 0x14, 0x3f, 0x6b, 0x09,
+	0x83, 0xe3, 0x07, 0x4a, 0xe8, 0x8a, 0x75, 0x2e, 0x6d, 0xca, 0x52, 0x8a, 0x19, 0xd6, 0xb9, 0x74,
+	0x63, 0x0d, 0x34, 0x4e, 0xf1, 0xf9, 0xd9, 0x2c, 0xe9, 0xfc, 0x24, 0x87, 0x3e, 0x3f, 0x25, 0x27,
+	0x9f, 0x18, 0xca, 0xc9, 0xa5, 0x41, 0x66, 0x72, 0xa8, 0x41, 0xe6, 0x3d, 0x64, 0x2a, 0x08, 0xb7,
+	0x69, 0x1c, 0xa4, 0xb4, 0xc3, 0xf6, 0x42, 0xeb, 0x8c, 0xed, 0x1b, 0xb1, 0x68, 0x41, 0x21, 0x83,
+	0x6d, 0x1f, 0x3f, 0x53, 0x23, 0x1c, 0x3f, 0x43, 0x0e, 0xfd, 0xb3, 0xe5, 0x1c, 0xfa, 0xe7, 0x8e,
+	0x7f, 0xe8, 0x4f, 0x9f, 0xe8, 0xa1, 0xef, 0x96, 0x72, 0xe8, 0x8f, 0x74, 0x9e, 0x1a, 0xb7, 0xfa,
+	0x0b, 0x07, 0xdc, 0xea, 0x87, 0x9d, 0xf8, 0x17, 0x8f, 0x7c, 0xe2, 0x17, 0x1f, 0xe6, 0x4f, 0x9c,
+	0xf4, 0x61, 0xfe, 0x22, 0x99, 0xec, 0xfb, 0x71, 0x1a, 0xf8, 0xdd, 0xf9, 0x6e, 0x14, 0x52, 0x76,
+	0x90, 0x37, 0xb4, 0x5e, 0x7a, 0xd5, 0x80, 0x81, 0x85, 0x89, 0x1b, 0x21, 0xe9, 0xfb, 0x71, 0x42,
+	0xe7, 0xb7, 0x69, 0x7b, 0x07, 0x9d, 0x84, 0x2e, 0xd9, 0x1b, 0x61, 0xcd, 0x82, 0x42, 0x06, 0xbb,
+	0x50, 0x8c, 0xb8, 0x5c, 0x9e, 0x18, 0xf1, 0xd4, 0x49, 0x88, 0x11, 0x4f, 0x97, 0x2e, 0x46, 0x3c,
+	0x73, 0x24, 0x31, 0xe2, 0x87, 0x2a, 0xe4, 0xa2, 0x3e, 0x68, 0x91, 0xbd, 0x05, 0x9b, 0x78, 0xd4,
+	0xb0, 0x72, 0xcb, 0xdc, 0x2a, 0x6d, 0x44, 0xa0, 0xeb, 0x60, 0x76, 0x05, 0x01, 0x03, 0x8b, 0x05,
+	0x72, 0xd3, 0x98, 0xd5, 0x6e, 0xc9, 0x9e, 0xc2, 0xf3, 0xa2, 0x1d, 0x14, 0x06, 0x32, 0x10, 0xfc,
+	0x5f, 0x64, 0x94, 0xc9, 0x26, 0xf6, 0x9e, 0xd7, 0x20, 0x30, 0xf1, 0xd0, 0x22, 0xdd, 0x96, 0x27,
+	0x00, 0x9e, 0xc4, 0x93, 0xfc, 0xea, 0xad, 0x98, 0xbe, 0x82, 0xca, 0xe1, 0xb0, 0x88, 0xfd, 0x7a,
+	0x7e, 0x38, 0xd8, 0x0e, 0x0a, 0xc3, 0xfb, 0x6f, 0x0e, 0xb9, 0x54, 0x38, 0x15, 0xa7, 0x20, 0x5d,
+	0xdd, 0xb7, 0xa5, 0xab, 0xb5, 0xb2, 0xae, 0xed, 0xc6, 0x5b, 0x0c, 0x91, 0xb4, 0xfe, 0x95, 0x43,
+	0xa6, 0x34, 0xfe, 0x29, 0xbc, 0x6a, 0x60, 0xbf, 0x6a, 0x79, 0x1a, 0x8a, 0x66, 0xee, 0xdd, 0x7e,
+	0xa3, 0x42, 0x54, 0xb2, 0xfd, 0xd9, 0xb6, 0xac, 0x83, 0x72, 0x80, 0x9f, 0x04, 0xa6, 0x4f, 0xf1,
+	0x63, 0xbf, 0x97, 0x94, 0xe3, 0xc2, 0x66, 0xd3, 0x67, 0x2e, 0x23, 0xda, 0x85, 0x86, 0xfd, 0x4c,
+	0x40, 0x10, 0x64, 0x95, 0x85, 0x82, 0x04, 0x8f, 0xeb, 0x8e, 0x88, 0x47, 0xd7, 0x95, 0x85, 0x44,
+	0x3b, 0x28, 0x0c, 0x3c, 0xff, 0x83, 0x76, 0x14, 0xce, 0x77, 0xfd, 0x24, 0x11, 0x22, 0xa9, 0x3a,
+	0xff, 0x17, 0x25, 0x00, 0x34, 0x0e, 0xf3, 0x00, 0x09, 0x92, 0x7e, 0xd7, 0xdf, 0x33, 0xf4, 0x50,
+	0x46, 0xe6, 0x34, 0x05, 0x02, 0x13, 0xcf, 0xeb, 0x91, 0x96, 0xfd, 0x12, 0x0b, 0x74, 0x93, 0x45,
+	0x66, 0x8c, 0x34, 0x9d, 0xe8, 0x72, 0xcd, 0x9e, 0x5a, 0x1a, 0xf8, 0xd9, 0x84, 0x2c, 0xb3, 0x12,
+	0x00, 0x1a, 0xc7, 0xfb, 0x3b, 0x0e, 0x39, 0x5f, 0x30, 0x69, 0x25, 0xc6, 0xfb, 0xa7, 0x9a, 0xdb,
+	0x14, 0x49, 0x6e, 0x5f, 0x4d, 0xc6, 0x3b, 0x74, 0xd3, 0x97, 0xee, 0xcc, 0xc6, 0x99, 0xb7, 0xc0,
+	0x9b, 0x41, 0xc2, 0x31, 0x4c, 0xf5, 0xac, 0x3d, 0xd6, 0x84, 0xc5, 0xd0, 0xf2, 0x69, 0x0a, 0x92,
+	0x76, 0xb4, 0x4b, 0xe3, 0x3d, 0x7c, 0x73, 0x27, 0x13, 0x43, 0x9b, 0xc3, 0x80, 0x82, 0xa7, 0x58,
+	0xa9, 0x8d, 0x8e, 0x9a, 0x6d, 0xb9, 0x22, 0xef, 0x94, 0xb9, 0x22, 0xf5, 0xc7, 0x34, 0x96, 0x82,
+	0x26, 0x09, 0x26, 0x7d, 0x94, 0x20, 0x59, 0x50, 0x12, 0xa6, 0x00, 0x48, 0x83, 0x50, 0xbc, 0xb2,
+	0x58, 0xab, 0x4a, 0x82, 0x5c, 0xce, 0xa3, 0x40, 0xd1, 0x73, 0xde, 0x9f, 0xd5, 0x88, 0xca, 0x65,
+	0xc2, 0x9c, 0x35, 0x4b, 0x72, 0x75, 0x3d, 0x74, 0x76, 0x1c, 0xb9, 0xb6, 0x6a, 0xfb, 0x79, 0x4f,
+	0x71, 0xe5, 0xa5, 0x69, 0xc1, 0x50, 0x13, 0xb6, 0xae, 0x41, 0x60, 0xe2, 0xe1, 0x48, 0xba, 0xc1,
+	0x2e, 0xe5, 0x0f, 0x8d, 0xd9, 0x23, 0x59, 0x92, 0x00, 0xd0, 0x38, 0x2a, 0x04, 0x65, 0x7c, 0x68,
+	0x08, 0x0a, 0xab, 0xe4, 0x14, 0xed, 0x88, 0x5b, 0x93, 0x51, 0xc9, 0x29, 0xda, 0x01, 0x06, 0xc1,
+	0xaf, 0x14, 0x46, 0x71, 0xcf, 0xef, 0x06, 0xaf, 0xd3, 0x8e, 0xa2, 0x22, 0x6e, 0x4b, 0xea, 0x2b,
+	0xdd, 0xce, 0xa3, 0x40, 0xd1, 0x73, 0xb8, 0xa0, 0xfb, 0x31, 0xed, 0x04, 0xed, 0xd4, 0xec, 0x8d,
+	0xd8, 0x0b, 0x7a, 0x35, 0x87, 0x01, 0x05, 0x4f, 0x61, 0x3a, 0x40, 0x99, 0x8b, 0x46, 0xa6, 0xe7,
+	0x9c, 0xb0, 0xd3, 0x01, 0x82, 0x0d, 0x86, 0x2c, 0x3e, 0x32, 0xc9, 0x9e, 0x48, 0x07, 0xdc, 0x9a,
+	0xb4, 0x99, 0xa4, 0x4c, 0x13, 0x0c, 0x0a, 0xc3, 0xfb, 0xab, 0x4c, 0x4d, 0x22, 0x96, 0x58, 0x1c,
+	0x6c, 0x66, 0xa2, 0x05, 0x9c, 0x92, 0xa3, 0x05, 0xde, 0x46, 0x1a, 0x3d, 0xe9, 0xe5, 0x5b, 0xd1,
+	0xde, 0x72, 0xca, 0xb1, 0x57, 0x41, 0xbd, 0x4f, 0x54, 0xc9, 0x25, 0x39, 0xb0, 0x5c, 0xce, 0xef,
+	0x53, 0xf3, 0xf9, 0xb6, 0xb7, 0x4a, 0x6d, 0x84, 0xad, 0x82, 0xfe, 0xd4, 0x49, 0x14, 0x2a, 0x7f,
+	0xea, 0xfa, 0x50, 0x7f, 0x6a, 0x03, 0xab, 0xd8, 0x9f, 0x7a, 0xac, 0x2c, 0x7f, 0xea, 0xf1, 0x23,
+	0xfa, 0x53, 0xff, 0x4e, 0x9d, 0xa8, 0x72, 0x9d, 0xb7, 0x69, 0x7a, 0x2f, 0x8a, 0x77, 0x82, 0x70,
+	0x8b, 0x25, 0x17, 0xfa, 0x69, 0x87, 0x4c, 0xf2, 0x8d, 0xbc, 0x64, 0x86, 0xca, 0x6f, 0x96, 0x54,
+	0x07, 0xd2, 0x22, 0x36, 0xb3, 0x6e, 0x10, 0xe2, 0x1e, 0xa9, 0xea, 0xda, 0x64, 0x82, 0xc0, 0x1a,
+	0x91, 0xfb, 0x5d, 0x84, 0x48, 0x7b, 0xca, 0xa6, 0x3c, 0x1a, 0x16, 0xcb, 0x19, 0x1f, 0xda, 0xb3,
+	0x94, 0xac, 0xbf, 0xae, 0x88, 0x80, 0x41, 0x10, 0x3d, 0xb9, 0xa4, 0x6d, 0x8a, 0xc7, 0x64, 0x7e,
+	0xe4, 0x44, 0xe6, 0x66, 0x94, 0x24, 0x02, 0x40, 0xc6, 0x83, 0x70, 0x0b, 0xd7, 0x89, 0xf0, 0x3b,
+	0x7d, 0x6b, 0x51, 0x62, 0xae, 0xa5, 0xc8, 0xef, 0xcc, 0xf9, 0x5d, 0x3f, 0x6c, 0x63, 0xc5, 0x11,
+	0x86, 0xae, 0x8f, 0x76, 0xd1, 0x00, 0xb2, 0xa3, 0x5c, 0xa1, 0xd3, 0xfa, 0x28, 0x85, 0x4e, 0x2f,
+	0x7f, 0x2b, 0x99, 0xce, 0x7d, 0xcc, 0x43, 0xe5, 0x0c, 0x38, 0x7a, 0xba, 0x01, 0xef, 0x57, 0xc7,
+	0xf4, 0x69, 0x8a, 0x49, 0xc8, 0x58, 0xdd, 0xcc, 0x58, 0x7f, 0x51, 0xc1, 0xec, 0x4a, 0x5c, 0x22,
+	0x46, 0xf4, 0xa0, 0x6a, 0x04, 0x93, 0x24, 0xae, 0xd1, 0xbe, 0x1f, 0xd3, 0xf0, 0xa4, 0xd7, 0xe8,
+	0xaa, 0x22, 0x02, 0x06, 0x41, 0x77, 0xdb, 0x0a, 0x1a, 0xbe, 0x71, 0xfc, 0xa0, 0x61, 0x96, 0xe7,
+	0xb7, 0xa8, 0x42, 0xdc, 0x8f, 0x3a, 0x64, 0x2a, 0xb4, 0x56, 0x6e, 0x39, 0xc1, 0x00, 0xc5, 0xbb,
+	0x82, 0x57, 0x7b, 0xb6, 0xdb, 0x20, 0x43, 0xbf, 0xe8, 0xac, 0xad, 0x1f, 0xf2, 0xac, 0xd5, 0x75,
+	0x7b, 0xc7, 0x86, 0xd5, 0xed, 0x75, 0x43, 0x55, 0xb8, 0x7c, 0xbc, 0xf4, 0xc2, 0xe5, 0xa4, 0xa0,
+	0x68, 0xf9, 0x5d, 0xd2, 0x6c, 0xc7, 0xd4, 0x4f, 0x8f, 0x58, 0xc3, 0x9a, 0xb9, 0x59, 0xcd, 0xcb,
+	0x0e, 0x40, 0xf7, 0xe5, 0xfd, 0xcf, 0x1a, 0x39, 0x27, 0x67, 0x44, 0x06, 0x12, 0xe1, 0xf9, 0xc8,
+	0xe9, 0x6a, 0x21, 0x5e, 0x9d, 0x8f, 0xb7, 0x24, 0x00, 0x34, 0x0e, 0x0a, 0x8a, 0x83, 0x84, 0xae,
+	0xf4, 0x69, 0xb8, 0x14, 0x6c, 0x24, 0xd9, 0x4c, 0x8a, 0xaf, 0x68, 0x10, 0x98, 0x78, 0x78, 0xe9,
+	0xf0, 0x0d, 0x69, 0xda, 0xb8, 0x74, 0x48, 0x09, 0x5a, 0xc2, 0xdd, 0x9f, 0x28, 0xac, 0x4f, 0x52,
+	0x4e, 0x64, 0x7e, 0x2e, 0x7e, 0xea, 0x70, 0x85, 0x49, 0xdc, 0xbf, 0xe9, 0x90, 0x8b, 0xbc, 0x55,
+	0xce, 0xe4, 0x2b, 0xfd, 0x8e, 0x9f, 0xd2, 0xa4, 0x35, 0x76, 0x42, 0xe3, 0xd3, 0xd6, 0x8a, 0x22,
+	0xb2, 0x50, 0x3c, 0x1a, 0x4c, 0x0e, 0x72, 0x76, 0xc7, 0x4a, 0xea, 0x26, 0x8f, 0x8e, 0xe3, 0xe6,
+	0x5b, 0xb2, 0x3a, 0xd5, 0x5b, 0xcd, 0x6e, 0x4f, 0x20, 0x4b, 0xdd, 0xfb, 0x4b, 0x87, 0x98, 0x6c,
+	0xf4, 0xf4, 0x73, 0xc1, 0x1d, 0x5e, 0x14, 0x94, 0xd2, 0x65, 0x7d, 0xa8, 0x74, 0x89, 0xde, 0x1a,
+	0x41, 0xa7, 0x35, 0x96, 0xf1, 0xd6, 0x58, 0x5c, 0x00, 0x6c, 0xf7, 0x3e, 0x37, 0xa6, 0xf5, 0x33,
+	0x22, 0x96, 0xf7, 0xcb, 0xe2, 0xb5, 0x37, 0x55, 0xba, 0x67, 0xfe, 0xe6, 0xb7, 0x73, 0xe9, 0x9e,
+	0xbf, 0xe5, 0xf0, 0xa1, 0xda, 0x7c, 0x82, 0x86, 0x65, 0x7b, 0x3e, 0x28, 0xfa, 0xff, 0x55, 0xd2,
+	0xc0, 0xbb, 0x21, 0x53, 0xb4, 0x36, 0xac, 0x41, 0x35, 0x6e, 0x89, 0xf6, 0x37, 0x1e, 0x5c, 0xf9,
+	0xa6, 0xc3, 0x0f, 0x4b, 0x3e, 0x0d, 0xaa, 0x7f, 0x37, 0x21, 0x4d, 0xfc, 0x9f, 0x85, 0x94, 0x8b,
+	0x5b, 0xe7, 0x2b, 0x8a, 0x67, 0x4a, 0x40, 0x29, 0xf1, 0xea, 0x9a, 0x8e, 0x1b, 0x92, 0x26, 0x22,
+	0x72, 0xa2, 0xfc, 0x72, 0xba, 0xaa, 0xae, 0x6a, 0x12, 0xf0, 0xc6, 0x83, 0x2b, 0xdf, 0x7c, 0x78,
+	0xa2, 0xea, 0x71, 0xd0, 0x24, 0xf0, 0x18, 0xd2, 0xd7, 0xc8, 0x89, 0xa3, 0x1d, 0x43, 0x45, 0x57,
+	0x48, 0xef, 0x7b, 0x8d, 0x4d, 0x21, 0xd2, 0x87, 0x7f, 0x59, 0x6c, 0x8a, 0x17, 0x33, 0x9b, 0xe2,
+	0x6a, 0x6e, 0x53, 0x4c, 0xe1, 0x44, 0x17, 0x24, 0x35, 0x3f, 0x6d, 0x09, 0xe3, 0x60, 0x0d, 0x0b,
+	0x13, 0xad, 0x5e, 0x1b, 0x04, 0x31, 0x4d, 0x56, 0xe3, 0x41, 0x88, 0x99, 0xc3, 0x9b, 0x0c, 0xd9,
+	0x10, 0xad, 0x2c, 0x30, 0x64, 0xf1, 0x59, 0x5e, 0x8d, 0xbd, 0xb0, 0x7d, 0xd7, 0xdf, 0xe5, 0xcb,
+	0xd5, 0xc8, 0x0d, 0xbb, 0x26, 0xda, 0x41, 0x61, 0xa0, 0xf7, 0x45, 0x07, 0xb5, 0x17, 0xad, 0x89,
+	0x72, 0x72, 0xd3, 0x18, 0x0a, 0x11, 0xae, 0x37, 0x67, 0xff, 0x02, 0x27, 0x82, 0x17, 0x07, 0x96,
+	0x5f, 0x81, 0x05, 0xf9, 0xed, 0xb5, 0x26, 0xcb, 0x38, 0xba, 0xd5, 0x92, 0x56, 0xfd, 0xea, 0x9c,
+	0x0e, 0xfc, 0x37, 0x18, 0x34, 0xbd, 0x3f, 0x77, 0x88, 0x9b, 0x7f, 0x04, 0x4d, 0x85, 0x3d, 0x3f,
+	0x1c, 0xf8, 0x5d, 0x6c, 0x5b, 0x09, 0xbb, 0x7b, 0x2d, 0xc7, 0x36, 0x15, 0x2e, 0x5b, 0x50, 0xc8,
+	0x60, 0xa3, 0xa9, 0x30, 0xa1, 0xdd, 0x4d, 0xfc, 0xe0, 0x52, 0xa3, 0x2e, 0xf2, 0x80, 0x28, 0x53,
+	0xe1, 0x5a, 0x06, 0x0e, 0xb9, 0x27, 0x58, 0x65, 0xc5, 0x41, 0x1a, 0xe1, 0xa7, 0xa4, 0x0b, 0xb6,
+	0xc2, 0x5e, 0x57, 0x56, 0xcc, 0x22, 0x40, 0xfe, 0x19, 0xef, 0x01, 0xd3, 0x4e, 0x19, 0x89, 0x82,
+	0x70, 0xab, 0x77, 0x83, 0x5e, 0x20, 0xf3, 0x05, 0xab, 0xad, 0xbe, 0x84, 0x8d, 0xc0, 0x61, 0xee,
+	0x3d, 0x32, 0xbe, 0xe1, 0xb7, 0x77, 0xa2, 0xcd, 0xcd, 0x72, 0xaa, 0xa6, 0xcd, 0xf1, 0xce, 0x58,
+	0xa5, 0xd6, 0x71, 0xf1, 0xe3, 0x0d, 0xfd, 0x2f, 0x48, 0x6a, 0xc8, 0x16, 0x22, 0x95, 0xc9, 0xa1,
+	0x6a, 0xbb, 0x1c, 0xe8, 0x24, 0x0e, 0x1a, 0xc7, 0xfb, 0xfd, 0x3a, 0x39, 0x2b, 0xdd, 0x3d, 0x6f,
+	0x05, 0x09, 0x73, 0xe6, 0x31, 0x73, 0xc4, 0x54, 0x0e, 0xcc, 0x11, 0xf3, 0x21, 0x42, 0x3a, 0xb4,
+	0xdf, 0x8d, 0xf6, 0x18, 0xa3, 0xad, 0x1d, 0x9a, 0xd1, 0xaa, 0x2b, 0xe2, 0x82, 0xea, 0x05, 0x8c,
+	0x1e, 0x45, 0x56, 0x65, 0x5e, 0x5f, 0x25, 0x9b, 0x55, 0x59, 0x17, 0x63, 0x1c, 0x3b, 0xdd, 0x62,
+	0x8c, 0x01, 0x39, 0xcb, 0x87, 0xa8, 0x73, 0x7c, 0x1c, 0x3e, 0xf3, 0x08, 0x0b, 0x73, 0x5c, 0xb0,
+	0xbb, 0x81, 0x6c, 0xbf, 0x66, 0xa5, 0xc5, 0xc6, 0x69, 0x57, 0x5a, 0xb4, 0xf2, 0xff, 0x34, 0xf7,
+	0xcf, 0xff, 0x93, 0x4b, 0x45, 0x46, 0x1e, 0x55, 0x2a, 0x32, 0xef, 0x33, 0x15, 0xbc, 0x28, 0xf2,
+	0x71, 0xa9, 0xac, 0x9a, 0xcf, 0x93, 0x31, 0x7f, 0x90, 0x6e, 0x47, 0x71, 0xb6, 0x76, 0xde, 0x2c,
+	0x6b, 0x05, 0x01, 0x75, 0x97, 0x48, 0xad, 0xa3, 0x33, 0x25, 0x1e, 0xe6, 0x7b, 0x6a, 0x63, 0x80,
+	0x9f, 0x52, 0x60, 0xbd, 0x60, 0x36, 0x8e, 0xd4, 0xdf, 0x92, 0x91, 0xd9, 0x2c, 0x1b, 0xc7, 0xba,
+	0x8f, 0x15, 0xb8, 0xb0, 0xd5, 0x94, 0x0f, 0x6b, 0x07, 0xc8, 0x87, 0xe8, 0xe3, 0x26, 0x6b, 0xe8,
+	0x19, 0xf6, 0x72, 0xed, 0xe3, 0x66, 0x02, 0xc1, 0xc6, 0xf5, 0x7e, 0x6d, 0x92, 0x5c, 0x58, 0x9b,
+	0x5f, 0x96, 0x9e, 0x05, 0x27, 0x16, 0x5c, 0x5d, 0x44, 0xe3, 0xf4, 0x82, 0xab, 0x87, 0x50, 0xef,
+	0x1a, 0xc1, 0xd5, 0x5d, 0x23, 0xb8, 0xda, 0x8e, 0x74, 0xad, 0x96, 0x11, 0xe9, 0x5a, 0x34, 0x82,
+	0x51, 0x22, 0x5d, 0x4f, 0x2c, 0xda, 0x7a, 0xdf, 0x01, 0x1d, 0x2a, 0xda, 0x5a, 0x85, 0xa2, 0x97,
+	0x12, 0x83, 0x38, 0xe4, 0x53, 0x15, 0x86, 0xa2, 0xab, 0x30, 0x60, 0x1e, 0x5f, 0xdb, 0x1a, 0x2b,
+	0x23, 0x0c, 0xb8, 0x68, 0x00, 0x23, 0x84, 0x01, 0xf3, 0x1f, 0x56, 0xe8, 0xf9, 0x78, 0x19, 0xa1,
+	0xe7, 0x45, 0xc3, 0x39, 0x30, 0xf4, 0x1c, 0xab, 0x8f, 0x76, 0xa3, 0x10, 0xeb, 0x05, 0xa6, 0x51,
+	0x3b, 0xea, 0xb6, 0x1a, 0x36, 0x4b, 0x98, 0x37, 0x81, 0x60, 0xe3, 0x0e, 0x8b, 0x5b, 0x6f, 0x1e,
+	0x37, 0x6e, 0x9d, 0x3c, 0xa2, 0xb8, 0xf5, 0x1f, 0xd0, 0x19, 0x56, 0x26, 0xd8, 0x17, 0xf9, 0x50,
+	0xf9, 0x5f, 0x64, 0xa4, 0xfa, 0xec, 0x9f, 0x77, 0x08, 0x96, 0xf3, 0xc7, 0x0b, 0x12, 0xd6, 0x63,
+	0x0c, 0x52, 0x21, 0x9e, 0x7f, 0xf8, 0x04, 0x16, 0xec, 0xdd, 0x35, 0x4d, 0x66, 0x6e, 0x9a, 0x05,
+	0x2b, 0x99, 0x4d, 0x60, 0x0f, 0xe4, 0x38, 0x19, 0x60, 0xbe, 0x50, 0x21, 0x5f, 0x71, 0xe0, 0x10,
+	0xdc, 0x7b, 0x68, 0xf1, 0xda, 0x12, 0x0b, 0xb5, 0xe5, 0x94, 0xe1, 0x88, 0xbe, 0x2e, 0xfb, 0xe3,
+	0xb7, 0x11, 0xf5, 0x93, 0xd9, 0xba, 0xe4, 0xff, 0xcc, 0xff, 0x3c, 0xea, 0xe6, 0x92, 0xbf, 0x43,
+	0xd4, 0xa5, 0xc0, 0x20, 0x78, 0xfc, 0xc7, 0x74, 0x0b, 0x45, 0xda, 0xaa, 0x7d, 0xfc, 0x03, 0x6b,
+	0x05, 0x01, 0x45, 0xf5, 0xb0, 0xdf, 0xed, 0xf2, 0x00, 0x51, 0x9a, 0x64, 0x8b, 0x09, 0xcd, 0x6a,
+	0x10, 0x98, 0x78, 0xde, 0x0f, 0xd7, 0xc8, 0x95, 0x03, 0x78, 0x4a, 0x2e, 0x31, 0x40, 0x7d, 0xe4,
+	0xc4, 0x00, 0x22, 0x68, 0x6e, 0x6c, 0x48, 0xd0, 0x1c, 0xfa, 0x3e, 0x50, 0x2c, 0xc7, 0xc7, 0x3d,
+	0x5a, 0xc7, 0x33, 0xbe, 0x0f, 0x1a, 0x04, 0x26, 0x1e, 0x72, 0xb1, 0x29, 0xbf, 0xdd, 0xa6, 0x49,
+	0x22, 0xa3, 0xe2, 0x84, 0xba, 0xbe, 0xb4, 0x90, 0x3b, 0x66, 0x05, 0x99, 0xb5, 0x48, 0x40, 0x86,
+	0x64, 0x76, 0xc2, 0x9b, 0xa3, 0x4d, 0x38, 0xdb, 0x66, 0x96, 0xbb, 0x64, 0x8b, 0x9c, 0xd4, 0x36,
+	0xb3, 0x3c, 0x35, 0xf9, 0x36, 0xb3, 0x9a, 0xc0, 0x1e, 0x88, 0xf7, 0x73, 0x15, 0xf2, 0xcc, 0xbe,
+	0x07, 0xef, 0xc8, 0xb1, 0x94, 0x18, 0x0f, 0x91, 0x5d, 0xd3, 0x18, 0x2d, 0x01, 0x0c, 0xc2, 0x3f,
+	0x60, 0xbf, 0xaf, 0x22, 0x22, 0xca, 0x0f, 0x2c, 0xe6, 0x1f, 0xd0, 0x22, 0x01, 0x19, 0x92, 0x47,
+	0xdd, 0x31, 0xff, 0xb9, 0x4e, 0x9e, 0x1b, 0x41, 0x3c, 0x29, 0x31, 0x00, 0xdb, 0x4e, 0x16, 0x50,
+	0x7d, 0x44, 0xc9, 0x02, 0x8e, 0x36, 0x5d, 0x6f, 0xe6, 0x18, 0x18, 0x25, 0xd0, 0xbb, 0x80, 0x2b,
+	0x34, 0x1e, 0x17, 0xae, 0xf0, 0x0b, 0x15, 0x72, 0x79, 0xb8, 0x98, 0xe7, 0xbe, 0x1b, 0x35, 0x96,
+	0xd2, 0x55, 0xd6, 0x4c, 0x81, 0x70, 0x9e, 0x6b, 0x2b, 0x2d, 0x10, 0x64, 0x71, 0xdd, 0x19, 0xb4,
+	0xe3, 0xa7, 0xdb, 0xc9, 0xf5, 0xfb, 0x41, 0x92, 0x0a, 0xcf, 0xa6, 0x29, 0x6e, 0x78, 0x97, 0xad,
+	0x60, 0x60, 0x20, 0x39, 0xf6, 0x6b, 0x21, 0xba, 0x1d, 0xa5, 0xfc, 0x21, 0x7e, 0x45, 0x3d, 0x2f,
+	0x4b, 0xbe, 0x1a, 0x20, 0xc8, 0xe2, 0x22, 0x39, 0xe6, 0xda, 0xc1, 0x07, 0xca, 0xef, 0xae, 0x8c,
+	0xdc, 0x92, 0x6a, 0x05, 0x03, 0x23, 0x9b, 0xdc, 0xa1, 0x7e, 0x70, 0x72, 0x07, 0xef, 0x1f, 0x55,
+	0xc8, 0xa5, 0xa1, 0xd7, 0x84, 0xd1, 0x38, 0xe8, 0xe3, 0x97, 0x90, 0xe1, 0x88, 0x9b, 0xff, 0x70,
+	0x81, 0xfc, 0x7f, 0x3a, 0x64, 0xa5, 0x89, 0x40, 0xfe, 0xa3, 0xe7, 0x27, 0x7a, 0xfc, 0xe6, 0x33,
+	0x17, 0xbb, 0x5f, 0x3b, 0x44, 0xec, 0x7e, 0xe6, 0x63, 0xd4, 0x47, 0x3c, 0xb8, 0xfe, 0x4f, 0x7d,
+	0xe8, 0xf4, 0xa2, 0x5a, 0x61, 0x24, 0x5b, 0xd0, 0x02, 0x39, 0x17, 0x84, 0xac, 0xfc, 0xf7, 0xda,
+	0x60, 0x43, 0xe4, 0xc6, 0xcb, 0xa8, 0xb9, 0x17, 0x33, 0x70, 0xc8, 0x3d, 0xf1, 0x18, 0xe6, 0x52,
+	0x38, 0xda, 0x94, 0x1e, 0xf2, 0x50, 0x59, 0x21, 0x17, 0xe5, 0x54, 0x6c, 0xfb, 0x31, 0xed, 0x08,
+	0x39, 0x20, 0x11, 0x61, 0x8d, 0x97, 0x78, 0x68, 0x64, 0x01, 0x02, 0x14, 0x3f, 0x87, 0x9f, 0x2c,
+	0x8d, 0xfa, 0x41, 0xbb, 0xd5, 0xb0, 0x3f, 0xd9, 0x3a, 0x36, 0x02, 0x87, 0xe9, 0xa3, 0xac, 0xf9,
+	0xa8, 0x8e, 0xb2, 0xc7, 0x46, 0xc0, 0xfd, 0x0d, 0xa7, 0xf8, 0x32, 0x68, 0x3d, 0x34, 0x42, 0x26,
+	0x88, 0xb6, 0x8c, 0xe0, 0xca, 0x86, 0x22, 0x89, 0x76, 0x50, 0x18, 0x88, 0xed, 0xcb, 0xd0, 0xac,
+	0x4c, 0x40, 0xb0, 0x8a, 0xc6, 0x52, 0x18, 0xf8, 0x41, 0x75, 0xb5, 0x45, 0xe3, 0x83, 0x5a, 0x75,
+	0x12, 0xf7, 0xc8, 0xd9, 0xb5, 0xb5, 0x5b, 0x4a, 0x6f, 0x2a, 0x22, 0x26, 0xcd, 0xb2, 0xde, 0xce,
+	0x88, 0x65, 0xbd, 0xaf, 0x91, 0x26, 0xfe, 0xd3, 0x0e, 0xfa, 0x7e, 0x37, 0x1b, 0x42, 0xb1, 0x2a,
+	0x01, 0xa0, 0x71, 0xbc, 0xdf, 0x73, 0xc8, 0x19, 0x41, 0x3b, 0x08, 0xb7, 0x8e, 0x41, 0x99, 0xd7,
+	0xf6, 0x36, 0xc2, 0xb9, 0xcc, 0xda, 0xde, 0xbc, 0x0c, 0xb8, 0x80, 0x63, 0xb8, 0x98, 0x1a, 0x80,
+	0xf4, 0x61, 0xd2, 0xee, 0x79, 0x0a, 0x02, 0x06, 0x96, 0x59, 0x3a, 0xbc, 0x76, 0x40, 0xe9, 0xf0,
+	0x3f, 0x77, 0xc8, 0xb4, 0xf5, 0x4a, 0xa7, 0x10, 0xa9, 0xd4, 0xb7, 0x23, 0x95, 0x8e, 0x5b, 0x68,
+	0xc5, 0x1c, 0xfd, 0x90, 0x60, 0xac, 0x0f, 0x91, 0xa6, 0x62, 0x82, 0x3c, 0x00, 0x4f, 0x9d, 0x3c,
+	0xb9, 0x00, 0x3c, 0x09, 0x01, 0x03, 0x4b, 0x96, 0xf9, 0xad, 0x14, 0x97, 0xf9, 0xf5, 0x3e, 0x5d,
+	0x25, 0x93, 0xd6, 0x8a, 0x1c, 0xa9, 0x1a, 0xfd, 0x36, 0xa9, 0x26, 0xc9, 0x76, 0xab, 0x52, 0x06,
+	0x63, 0xca, 0x6c, 0x89, 0xb9, 0x71, 0x1c, 0xdf, 0xda, 0xda, 0x2d, 0x40, 0x12, 0xee, 0x7d, 0xd2,
+	0x48, 0x82, 0xad, 0x24, 0x8d, 0x62, 0x59, 0xf3, 0xe8, 0x98, 0xc5, 0x7e, 0xd7, 0x44, 0x6f, 0x8b,
+	0x1d, 0x1a, 0xa6, 0x41, 0xba, 0xc7, 0x4f, 0x16, 0xd9, 0x0a, 0x8a, 0x9a, 0x9b, 0x92, 0xb1, 0x76,
+	0x84, 0x56, 0x8d, 0x56, 0xad, 0x0c, 0xbb, 0xd2, 0x3c, 0xeb, 0xcb, 0x7a, 0x53, 0x66, 0x23, 0xe0,
+	0xed, 0x20, 0x68, 0x79, 0x29, 0x39, 0x97, 0x1d, 0x21, 0x6a, 0x92, 0x82, 0x24, 0x19, 0xd0, 0x9c,
+	0x21, 0x89, 0x95, 0x8c, 0x8d, 0x41, 0x40, 0x51, 0xce, 0x4a, 0x06, 0x1b, 0xec, 0x86, 0x4a, 0xb7,
+	0xe8, 0xfd, 0x56, 0xc5, 0x96, 0xb3, 0xd6, 0x0c, 0x18, 0x58, 0x98, 0xde, 0x3f, 0x73, 0xc8, 0x24,
+	0x5a, 0xc4, 0x55, 0xd9, 0x97, 0xef, 0x71, 0xc8, 0x79, 0xdf, 0xd4, 0x89, 0x8a, 0x8a, 0x7d, 0x7c,
+	0x4b, 0x7d, 0xfd, 0x88, 0x5b, 0xca, 0x2c, 0xe0, 0xa7, 0xc3, 0x4c, 0x66, 0xf3, 0xfd, 0x42, 0x11,
+	0x31, 0x5c, 0xee, 0xac, 0x12, 0x8b, 0x6f, 0xa4, 0x27, 0x56, 0xcb, 0xfd, 0xba, 0x82, 0x80, 0x81,
+	0xe5, 0x7d, 0xdf, 0x38, 0x39, 0x63, 0x55, 0x8b, 0xb0, 0x8c, 0xcb, 0xce, 0x81, 0xc6, 0x65, 0x16,
+	0xcc, 0x3d, 0x08, 0x45, 0x0d, 0x4c, 0x33, 0x98, 0x7b, 0x10, 0x62, 0x35, 0x0c, 0xfc, 0x83, 0x1f,
+	0xa4, 0x13, 0xef, 0xc1, 0x20, 0x14, 0x16, 0x6f, 0xf5, 0x41, 0x16, 0x58, 0x2b, 0x08, 0x28, 0x7a,
+	0x4d, 0x4c, 0x26, 0xcc, 0x83, 0x85, 0xdb, 0xf2, 0x5b, 0xb5, 0x32, 0xbc, 0x55, 0xd6, 0x8c, 0x1e,
+	0xb9, 0xfb, 0xb9, 0xd9, 0x02, 0x16, 0x45, 0x56, 0x81, 0x5e, 0x95, 0xad, 0x6e, 0x8d, 0x95, 0x11,
+	0x4b, 0x9a, 0x2d, 0xc6, 0xc1, 0x6d, 0xba, 0xea, 0xfc, 0x91, 0x2d, 0xcc, 0x54, 0x2b, 0xfe, 0xc5,
+	0xc2, 0xab, 0xfc, 0x5f, 0x71, 0x2f, 0x2f, 0xdd, 0xa4, 0x4c, 0x0a, 0x6c, 0xe6, 0x58, 0x50, 0xcb,
+	0x0f, 0x83, 0x4d, 0x9a, 0xa4, 0xdc, 0x94, 0x2d, 0x0b, 0x6a, 0xc9, 0x46, 0xd0, 0x70, 0xbc, 0x30,
+	0x26, 0xec, 0xc5, 0x52, 0xc3, 0xf6, 0xcc, 0x2e, 0x8c, 0x6b, 0xba, 0x19, 0x4c, 0x1c, 0xd3, 0x50,
+	0x4e, 0x1e, 0xa9, 0xa1, 0xfc, 0x80, 0x42, 0x39, 0x6e, 0x9f, 0x8c, 0x8b, 0x2a, 0x1c, 0xc2, 0x34,
+	0xb0, 0x78, 0xfc, 0x15, 0x20, 0xfc, 0x39, 0xe6, 0x26, 0x70, 0x78, 0xe2, 0x07, 0x48, 0x32, 0xde,
+	0xdf, 0x73, 0xc8, 0xc5, 0xc2, 0x75, 0xf2, 0xf8, 0xc6, 0x31, 0x79, 0x3f, 0x56, 0x27, 0xe7, 0x0b,
+	0x0a, 0xcd, 0xb8, 0x7b, 0xe6, 0x0e, 0x72, 0xca, 0x70, 0x09, 0xb6, 0x3d, 0x5c, 0xe5, 0x87, 0x2b,
+	0xd8, 0x36, 0x87, 0x73, 0x8c, 0xd1, 0xce, 0x29, 0xd5, 0xd3, 0x75, 0x4e, 0x31, 0x36, 0x42, 0xed,
+	0x91, 0x6e, 0x84, 0xfa, 0x01, 0x1b, 0xe1, 0x97, 0x1c, 0xd2, 0xea, 0x0d, 0x29, 0x05, 0xda, 0x1a,
+	0x2b, 0x43, 0x3f, 0x3b, 0xac, 0xd0, 0xe8, 0xdc, 0xd3, 0x0f, 0x1f, 0x5c, 0x19, 0x5a, 0x81, 0x15,
+	0x86, 0x8e, 0xca, 0xfb, 0xfd, 0x1a, 0x31, 0x3c, 0xe2, 0xdc, 0x8f, 0x99, 0xf5, 0xaa, 0x9c, 0xb2,
+	0x6a, 0x2b, 0xf1, 0xce, 0x55, 0xbd, 0x2b, 0x3e, 0x83, 0x45, 0xe5, 0xaf, 0xb2, 0x6c, 0xb2, 0x32,
+	0x02, 0x9b, 0xec, 0xca, 0x2a, 0x7a, 0xd5, 0xf2, 0xab, 0xe8, 0x35, 0x73, 0x15, 0xf4, 0xf6, 0xfd,
+	0xc4, 0xb5, 0xc7, 0xf1, 0x13, 0x9b, 0xec, 0xb9, 0x7e, 0x3a, 0xec, 0xf9, 0x27, 0x1d, 0x72, 0xbe,
+	0xe0, 0xbb, 0x6b, 0xe9, 0xc7, 0xd9, 0x47, 0xfa, 0x41, 0x3f, 0x55, 0xe1, 0xff, 0x28, 0xa4, 0x24,
+	0xed, 0xa7, 0x2a, 0xda, 0x41, 0x61, 0xb0, 0xfa, 0x78, 0xdd, 0x6e, 0x74, 0xef, 0x7a, 0xaf, 0x9f,
+	0xee, 0x09, 0x79, 0x49, 0xd7, 0xc7, 0x53, 0x10, 0x30, 0xb0, 0xbc, 0xbf, 0x51, 0xe1, 0x6b, 0x5e,
+	0x38, 0x3b, 0x6b, 0x3f, 0x61, 0xe7, 0x90, 0x7e, 0xc2, 0x1f, 0x25, 0xa4, 0x1d, 0xf5, 0xfa, 0xa8,
+	0x7b, 0x59, 0x8f, 0xc4, 0x75, 0xe5, 0xd6, 0x71, 0xe5, 0x78, 0xd9, 0x9f, 0x7e, 0x0d, 0xdd, 0x06,
+	0x06, 0x3d, 0x8b, 0x7b, 0x57, 0x0f, 0x57, 0xfa, 0xae, 0x76, 0x40, 0xe9, 0xbb, 0xff, 0x22, 0x04,
+	0x76, 0x25, 0xe7, 0xf5, 0x49, 0x1d, 0x87, 0xbb, 0x27, 0x78, 0xc2, 0x4a, 0x79, 0x22, 0x26, 0x32,
+	0x63, 0xb1, 0xd1, 0xd8, 0xbf, 0xc0, 0x09, 0xb9, 0x5d, 0xe1, 0x13, 0x5d, 0x29, 0xe5, 0x56, 0x66,
+	0x10, 0x44, 0xaf, 0x6a, 0xee, 0xb8, 0xa6, 0xfd, 0xab, 0xbd, 0x17, 0xc9, 0x74, 0x6e, 0x50, 0xb8,
+	0x5c, 0x59, 0x86, 0xa2, 0xec, 0x72, 0x65, 0xa9, 0x8c, 0x80, 0xc3, 0xbc, 0x5f, 0x70, 0xc8, 0xb9,
+	0x6c, 0xf7, 0xa8, 0xeb, 0x9a, 0x4e, 0xb2, 0xfd, 0x9d, 0xd4, 0xdc, 0x29, 0x0f, 0xe0, 0x1c, 0x08,
+	0xf2, 0x83, 0xf0, 0x3e, 0x42, 0x26, 0x8c, 0x0d, 0xcc, 0x1c, 0x6c, 0xad, 0xe2, 0x82, 0xcd, 0x03,
+	0x6a, 0x02, 0x5e, 0x35, 0xbe, 0x4b, 0xb3, 0xc8, 0x57, 0xdd, 0xfb, 0x5f, 0x62, 0x7b, 0xdd, 0x0d,
+	0xc2, 0x4e, 0x74, 0x4f, 0x09, 0x5b, 0xce, 0x50, 0x61, 0x0b, 0x77, 0x7c, 0x7b, 0x9b, 0x76, 0x06,
+	0xdd, 0x5c, 0x12, 0x9f, 0x35, 0xd1, 0x0e, 0x0a, 0x03, 0xb1, 0x3b, 0x03, 0x31, 0xe0, 0xcc, 0xb2,
+	0x5f, 0x10, 0xed, 0xa0, 0x30, 0x30, 0xaa, 0xd6, 0x98, 0x46, 0xb9, 0xf2, 0xd9, 0xb5, 0xc6, 0x10,
+	0x03, 0x12, 0xb0, 0xb0, 0xd0, 0x1c, 0xa4, 0x04, 0x37, 0x79, 0xec, 0x33, 0x73, 0x90, 0xe2, 0xae,
+	0x09, 0x18, 0x18, 0x2c, 0x43, 0x50, 0x77, 0x90, 0x30, 0x2f, 0x91, 0x31, 0x1d, 0x85, 0x3f, 0x2f,
+	0xda, 0x40, 0x41, 0x91, 0x5f, 0x69, 0x0f, 0x71, 0xa1, 0xe0, 0x55, 0x1b, 0x5d, 0xfb, 0x92, 0x83,
+	0x81, 0x85, 0x6f, 0x8c, 0x9c, 0xf5, 0xfd, 0x51, 0x28, 0x43, 0x69, 0xb4, 0xe3, 0x90, 0x68, 0x07,
+	0x85, 0xe1, 0xfd, 0x85, 0x43, 0xce, 0xea, 0x84, 0x72, 0x4c, 0x2d, 0x6b, 0xe9, 0xa3, 0x9d, 0x03,
+	0xf5, 0xd1, 0x76, 0x22, 0xa6, 0xca, 0x48, 0x89, 0x98, 0xcc, 0x1c, 0x49, 0xd5, 0x7d, 0x73, 0x24,
+	0x7d, 0x95, 0xad, 0x83, 0x9b, 0x9c, 0x9b, 0x28, 0xd2, 0xbf, 0x61, 0x24, 0x68, 0xdb, 0x57, 0x49,
+	0x6b, 0x27, 0x85, 0x36, 0x63, 0x96, 0x21, 0x09, 0x88, 0xb7, 0x42, 0x9a, 0xca, 0x7f, 0x46, 0x6a,
+	0xa2, 0x9c, 0x62, 0x4d, 0xd4, 0x48, 0xb9, 0x5a, 0xbc, 0x1f, 0x71, 0x08, 0x61, 0x4a, 0x5f, 0xa6,
+	0x58, 0xc5, 0x97, 0xea, 0x4b, 0x5d, 0xbc, 0x63, 0x94, 0x22, 0x12, 0x6d, 0xa0, 0xa0, 0x9c, 0xbb,
+	0x4a, 0x21, 0xb5, 0x62, 0x72, 0xd7, 0x02, 0xb1, 0xfb, 0xab, 0xcc, 0xd0, 0x4b, 0x44, 0x9d, 0x28,
+	0x0a, 0xbb, 0x9c, 0xdb, 0xf8, 0xad, 0x2f, 0x3e, 0xfb, 0x96, 0xdf, 0xfb, 0xe2, 0xb3, 0x6f, 0xf9,
+	0xe3, 0x2f, 0x3e, 0xfb, 0x96, 0x8f, 0x3f, 0x7c, 0xd6, 0xf9, 0xad, 0x87, 0xcf, 0x3a, 0xbf, 0xf7,
+	0xf0, 0x59, 0xe7, 0x8f, 0x1f, 0x3e, 0xeb, 0xfc, 0xd9, 0xc3, 0x67, 0x9d, 0x1f, 0xfd, 0xf3, 0x67,
+	0xdf, 0xf2, 0xfe, 0xc2, 0xc0, 0x2e, 0xfc, 0xe7, 0xed, 0xed, 0xce, 0xb5, 0xdd, 0x17, 0x58, 0x6c,
+	0x11, 0x72, 0x93, 0x6b, 0xc6, 0x02, 0xbf, 0x26, 0xb9, 0xc9, 0xff, 0x1d, 0x00, 0xd0, 0x01, 0x4c,
+	0xce, 0x48, 0x05, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5467,6 +6005,44 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncApproval != nil {
+		{
+			size, err := m.SyncApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	i--
+	if m.SkipChartSignatureVerification {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	if len(m.HelmValuesFromAllowList) > 0 {
+		for iNdEx := len(m.HelmValuesFromAllowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HelmValuesFromAllowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7372,6 +7948,34 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValuesFrom) > 0 {
+		for iNdEx := len(m.ValuesFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValuesFrom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.PostRenderer != nil {
+		{
+			size, err := m.PostRenderer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	i--
 	if m.SkipTests {
 		dAtA[i] = 1
@@ -8429,6 +9033,11 @@ func (m *ChartDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SignatureInfo)
+	copy(dAtA[i:], m.SignatureInfo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SignatureInfo)))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Maintainers) > 0 {
 		for iNdEx := len(m.Maintainers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Maintainers[iNdEx])
@@ -9187,6 +9796,34 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CosignSignatureKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignSignatureKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignSignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DuckTypeGenerator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9804,6 +10441,186 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmPostRenderer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRenderer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRenderer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kustomize != nil {
+		{
+			size, err := m.Kustomize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmPostRendererKustomize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRendererKustomize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRendererKustomize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmValuesFromAllowListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmValuesFromAllowListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmValuesFromAllowListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Destination {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmValuesFromSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmValuesFromSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmValuesFromSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Optional {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i--
+	if m.Destination {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9979,6 +10796,18 @@ func (m *JWTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.ID)
 	copy(dAtA[i:], m.ID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
@@ -10700,6 +11529,18 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10749,6 +11590,105 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperationApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.SpecHash)
+	copy(dAtA[i:], m.SpecHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SpecHash)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.RequestedBy)
+	copy(dAtA[i:], m.RequestedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequestedBy)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x3a
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Diff)
+	copy(dAtA[i:], m.Diff)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RequestedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10805,6 +11745,31 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RetryOnTimeout {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.TimeoutMessage)
+	copy(dAtA[i:], m.TimeoutMessage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeoutMessage)))
+	i--
+	dAtA[i] = 0x52
+	if m.AttemptStartedAt != nil {
+		{
+			size, err := m.AttemptStartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -11939,6 +12904,34 @@ func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenProviderScope)
+	copy(dAtA[i:], m.TokenProviderScope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderScope)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	i -= len(m.TokenProviderAudience)
+	copy(dAtA[i:], m.TokenProviderAudience)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderAudience)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	i -= len(m.TokenProviderClientID)
+	copy(dAtA[i:], m.TokenProviderClientID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderClientID)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	i -= len(m.TokenProviderURL)
+	copy(dAtA[i:], m.TokenProviderURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderURL)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
 	i -= len(m.NoProxy)
 	copy(dAtA[i:], m.NoProxy)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NoProxy)))
@@ -12097,6 +13090,54 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.TokenProviderScope)
+	copy(dAtA[i:], m.TokenProviderScope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderScope)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	i -= len(m.TokenProviderAudience)
+	copy(dAtA[i:], m.TokenProviderAudience)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderAudience)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	i -= len(m.TokenProviderClientID)
+	copy(dAtA[i:], m.TokenProviderClientID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderClientID)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	i -= len(m.TokenProviderURL)
+	copy(dAtA[i:], m.TokenProviderURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenProviderURL)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	i--
+	if m.SparseCheckout {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc8
+	i--
+	if m.PartialClone {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc0
 	i -= len(m.NoProxy)
 	copy(dAtA[i:], m.NoProxy)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NoProxy)))
@@ -12669,6 +13710,48 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceDrift) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDrift) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDrift) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13106,6 +14189,18 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.SyncPhase)
 	copy(dAtA[i:], m.SyncPhase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
@@ -13179,6 +14274,30 @@ func (m *ResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncPolicy != nil {
+		{
+			size, err := m.SyncPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Drift != nil {
+		{
+			size, err := m.Drift.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
 	i--
 	dAtA[i] = 0x50
@@ -13243,6 +14362,53 @@ func (m *ResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceSyncPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSyncPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceSyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.AutoPruneDisabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i--
+	if m.SelfHealDisabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.ManualSyncOnly {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13263,6 +14429,14 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.OnTimeout {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	if m.Backoff != nil {
 		{
 			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
@@ -13674,6 +14848,18 @@ func (m *SCMProviderGeneratorAzureDevOps) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.TokenProvider != nil {
+		{
+			size, err := m.TokenProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i--
 	if m.AllBranches {
 		dAtA[i] = 1
@@ -13785,6 +14971,18 @@ func (m *SCMProviderGeneratorBitbucketServer) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.TokenProvider != nil {
+		{
+			size, err := m.TokenProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CARef != nil {
 		{
 			size, err := m.CARef.MarshalToSizedBuffer(dAtA[:i])
@@ -14051,6 +15249,18 @@ func (m *SCMProviderGeneratorGitlab) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.TokenProvider != nil {
+		{
+			size, err := m.TokenProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.CARef != nil {
 		{
 			size, err := m.CARef.MarshalToSizedBuffer(dAtA[:i])
@@ -14127,6 +15337,172 @@ func (m *SCMProviderGeneratorGitlab) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SCMProviderGeneratorTokenProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SCMProviderGeneratorTokenProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SCMProviderGeneratorTokenProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Scope)
+	copy(dAtA[i:], m.Scope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Audience)
+	copy(dAtA[i:], m.Audience)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Audience)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ClientID)
+	copy(dAtA[i:], m.ClientID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClientID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSignatureKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSignatureKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Principal)
+	copy(dAtA[i:], m.Principal)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Principal)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.KeyData)
+	copy(dAtA[i:], m.KeyData)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyData)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Principals)
+	copy(dAtA[i:], m.Principals)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Principals)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.KeyType)
+	copy(dAtA[i:], m.KeyType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyType)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKeyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SecretRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14180,6 +15556,42 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cosign != nil {
+		{
+			size, err := m.Cosign.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sigstore != nil {
+		{
+			size, err := m.Sigstore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SSH != nil {
+		{
+			size, err := m.SSH.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.KeyID)
 	copy(dAtA[i:], m.KeyID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyID)))
@@ -14188,6 +15600,77 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigstoreIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigstoreIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigstoreIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SubjectRegex)
+	copy(dAtA[i:], m.SubjectRegex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubjectRegex)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Issuer)
+	copy(dAtA[i:], m.Issuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Issuer)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expiration)
+	copy(dAtA[i:], m.Expiration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expiration)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationSelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14208,6 +15691,18 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.Revisions) > 0 {
 		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Revisions[iNdEx])
@@ -14461,6 +15956,18 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -14719,6 +16226,39 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Hook)
+	copy(dAtA[i:], m.Hook)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hook)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14887,6 +16427,56 @@ func (m *TagFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actions[iNdEx])
+			copy(dAtA[i:], m.Actions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Projects[iNdEx])
+			copy(dAtA[i:], m.Projects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Projects[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -15024,6 +16614,17 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.HelmValuesFromAllowList) > 0 {
+		for _, e := range m.HelmValuesFromAllowList {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 3
+	if m.SyncApproval != nil {
+		l = m.SyncApproval.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15709,6 +17310,16 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 		}
 	}
 	n += 2
+	if m.PostRenderer != nil {
+		l = m.PostRenderer.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ValuesFrom) > 0 {
+		for _, e := range m.ValuesFrom {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16088,6 +17699,8 @@ func (m *ChartDetails) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.SignatureInfo)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16354,6 +17967,17 @@ func (m *ConnectionState) Size() (n int) {
 	return n
 }
 
+func (m *CosignSignatureKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *DuckTypeGenerator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16589,6 +18213,69 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmPostRenderer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HelmPostRendererKustomize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HelmValuesFromAllowListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *HelmValuesFromSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16658,6 +18345,10 @@ func (m *JWTToken) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.ExpiresAt))
 	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16939,6 +18630,47 @@ func (m *Operation) Size() (n int) {
 	}
 	l = m.Retry.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OperationApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RequestedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Diff)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequestedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SpecHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16977,6 +18709,13 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if m.AttemptStartedAt != nil {
+		l = m.AttemptStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.TimeoutMessage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17423,6 +19162,14 @@ func (m *RepoCreds) Size() (n int) {
 	n += 3
 	l = len(m.NoProxy)
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderURL)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderClientID)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderAudience)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderScope)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17487,6 +19234,16 @@ func (m *Repository) Size() (n int) {
 	n += 3
 	l = len(m.NoProxy)
 	n += 2 + l + sovGenerated(uint64(l))
+	n += 3
+	n += 3
+	l = len(m.TokenProviderURL)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderClientID)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderAudience)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TokenProviderScope)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17646,6 +19403,23 @@ func (m *ResourceDiff) Size() (n int) {
 	return n
 }
 
+func (m *ResourceDrift) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResourceIgnoreDifferences) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17838,6 +19612,10 @@ func (m *ResourceResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SyncPhase)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17866,6 +19644,26 @@ func (m *ResourceStatus) Size() (n int) {
 	n += 2
 	n += 2
 	n += 1 + sovGenerated(uint64(m.SyncWave))
+	if m.Drift != nil {
+		l = m.Drift.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SyncPolicy != nil {
+		l = m.SyncPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceSyncPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	n += 2
 	return n
 }
 
@@ -17880,6 +19678,7 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -18035,6 +19834,10 @@ func (m *SCMProviderGeneratorAzureDevOps) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	if m.TokenProvider != nil {
+		l = m.TokenProvider.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18080,6 +19883,10 @@ func (m *SCMProviderGeneratorBitbucketServer) Size() (n int) {
 		l = m.CARef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TokenProvider != nil {
+		l = m.TokenProvider.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18181,6 +19988,74 @@ func (m *SCMProviderGeneratorGitlab) Size() (n int) {
 		l = m.CARef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TokenProvider != nil {
+		l = m.TokenProvider.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SCMProviderGeneratorTokenProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClientID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Audience)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Scope)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SSHSignatureKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Principal)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SSHSigningKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Principals)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyData)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SSHSigningKeyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18205,6 +20080,44 @@ func (m *SignatureKey) Size() (n int) {
 	_ = l
 	l = len(m.KeyID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SSH != nil {
+		l = m.SSH.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Sigstore != nil {
+		l = m.Sigstore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Cosign != nil {
+		l = m.Cosign.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SigstoreIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SubjectRegex)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expiration)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18256,6 +20169,10 @@ func (m *SyncOperation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18335,6 +20252,10 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18409,6 +20330,19 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Hook)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18482,6 +20416,33 @@ func (m *TagFilter) Size() (n int) {
 	return n
 }
 
+func (m *TokenScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Actions) > 0 {
+		for _, s := range m.Actions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -18577,6 +20538,11 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForHelmValuesFromAllowList := "[]HelmValuesFromAllowListEntry{"
+	for _, f := range this.HelmValuesFromAllowList {
+		repeatedStringForHelmValuesFromAllowList += strings.Replace(strings.Replace(f.String(), "HelmValuesFromAllowListEntry", "HelmValuesFromAllowListEntry", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHelmValuesFromAllowList += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -18592,6 +20558,9 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`HelmValuesFromAllowList:` + repeatedStringForHelmValuesFromAllowList + `,`,
+		`SkipChartSignatureVerification:` + fmt.Sprintf("%v", this.SkipChartSignatureVerification) + `,`,
+		`SyncApproval:` + strings.Replace(this.SyncApproval.String(), "SyncApproval", "SyncApproval", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19042,6 +21011,11 @@ func (this *ApplicationSourceHelm) String() string {
 		repeatedStringForFileParameters += strings.Replace(strings.Replace(f.String(), "HelmFileParameter", "HelmFileParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForFileParameters += "}"
+	repeatedStringForValuesFrom := "[]HelmValuesFromSource{"
+	for _, f := range this.ValuesFrom {
+		repeatedStringForValuesFrom += strings.Replace(strings.Replace(f.String(), "HelmValuesFromSource", "HelmValuesFromSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForValuesFrom += "}"
 	s := strings.Join([]string{`&ApplicationSourceHelm{`,
 		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
@@ -19057,6 +21031,8 @@ func (this *ApplicationSourceHelm) String() string {
 		`KubeVersion:` + fmt.Sprintf("%v", this.KubeVersion) + `,`,
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`SkipTests:` + fmt.Sprintf("%v", this.SkipTests) + `,`,
+		`PostRenderer:` + strings.Replace(this.PostRenderer.String(), "HelmPostRenderer", "HelmPostRenderer", 1) + `,`,
+		`ValuesFrom:` + repeatedStringForValuesFrom + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19343,6 +21319,7 @@ func (this *ChartDetails) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Home:` + fmt.Sprintf("%v", this.Home) + `,`,
 		`Maintainers:` + fmt.Sprintf("%v", this.Maintainers) + `,`,
+		`SignatureInfo:` + fmt.Sprintf("%v", this.SignatureInfo) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19551,6 +21528,16 @@ func (this *ConnectionState) String() string {
 	}, "")
 	return s
 }
+func (this *CosignSignatureKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CosignSignatureKey{`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DuckTypeGenerator) String() string {
 	if this == nil {
 		return "nil"
@@ -19753,6 +21740,55 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmPostRenderer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmPostRenderer{`,
+		`Kustomize:` + strings.Replace(this.Kustomize.String(), "HelmPostRendererKustomize", "HelmPostRendererKustomize", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmPostRendererKustomize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmPostRendererKustomize{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmValuesFromAllowListEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmValuesFromAllowListEntry{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmValuesFromSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmValuesFromSource{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -19813,6 +21849,7 @@ func (this *JWTToken) String() string {
 		`IssuedAt:` + fmt.Sprintf("%v", this.IssuedAt) + `,`,
 		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Scope:` + strings.Replace(this.Scope.String(), "TokenScope", "TokenScope", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20062,6 +22099,27 @@ func (this *Operation) String() string {
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`Retry:` + strings.Replace(strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1), `&`, ``, 1) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "OperationApproval", "OperationApproval", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationApproval{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`RequestedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RequestedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`RequestedBy:` + fmt.Sprintf("%v", this.RequestedBy) + `,`,
+		`SpecHash:` + fmt.Sprintf("%v", this.SpecHash) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20089,6 +22147,9 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`AttemptStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.AttemptStartedAt), "Time", "v1.Time", 1) + `,`,
+		`TimeoutMessage:` + fmt.Sprintf("%v", this.TimeoutMessage) + `,`,
+		`RetryOnTimeout:` + fmt.Sprintf("%v", this.RetryOnTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20393,6 +22454,10 @@ func (this *RepoCreds) String() string {
 		`Proxy:` + fmt.Sprintf("%v", this.Proxy) + `,`,
 		`ForceHttpBasicAuth:` + fmt.Sprintf("%v", this.ForceHttpBasicAuth) + `,`,
 		`NoProxy:` + fmt.Sprintf("%v", this.NoProxy) + `,`,
+		`TokenProviderURL:` + fmt.Sprintf("%v", this.TokenProviderURL) + `,`,
+		`TokenProviderClientID:` + fmt.Sprintf("%v", this.TokenProviderClientID) + `,`,
+		`TokenProviderAudience:` + fmt.Sprintf("%v", this.TokenProviderAudience) + `,`,
+		`TokenProviderScope:` + fmt.Sprintf("%v", this.TokenProviderScope) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20441,6 +22506,12 @@ func (this *Repository) String() string {
 		`GCPServiceAccountKey:` + fmt.Sprintf("%v", this.GCPServiceAccountKey) + `,`,
 		`ForceHttpBasicAuth:` + fmt.Sprintf("%v", this.ForceHttpBasicAuth) + `,`,
 		`NoProxy:` + fmt.Sprintf("%v", this.NoProxy) + `,`,
+		`PartialClone:` + fmt.Sprintf("%v", this.PartialClone) + `,`,
+		`SparseCheckout:` + fmt.Sprintf("%v", this.SparseCheckout) + `,`,
+		`TokenProviderURL:` + fmt.Sprintf("%v", this.TokenProviderURL) + `,`,
+		`TokenProviderClientID:` + fmt.Sprintf("%v", this.TokenProviderClientID) + `,`,
+		`TokenProviderAudience:` + fmt.Sprintf("%v", this.TokenProviderAudience) + `,`,
+		`TokenProviderScope:` + fmt.Sprintf("%v", this.TokenProviderScope) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20572,6 +22643,17 @@ func (this *ResourceDiff) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceDrift) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceDrift{`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Managers:` + fmt.Sprintf("%v", this.Managers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceIgnoreDifferences) String() string {
 	if this == nil {
 		return "nil"
@@ -20709,6 +22791,7 @@ func (this *ResourceResult) String() string {
 		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20728,6 +22811,20 @@ func (this *ResourceStatus) String() string {
 		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
 		`RequiresPruning:` + fmt.Sprintf("%v", this.RequiresPruning) + `,`,
 		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
+		`Drift:` + strings.Replace(this.Drift.String(), "ResourceDrift", "ResourceDrift", 1) + `,`,
+		`SyncPolicy:` + strings.Replace(this.SyncPolicy.String(), "ResourceSyncPolicy", "ResourceSyncPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceSyncPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceSyncPolicy{`,
+		`ManualSyncOnly:` + fmt.Sprintf("%v", this.ManualSyncOnly) + `,`,
+		`SelfHealDisabled:` + fmt.Sprintf("%v", this.SelfHealDisabled) + `,`,
+		`AutoPruneDisabled:` + fmt.Sprintf("%v", this.AutoPruneDisabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20739,6 +22836,7 @@ func (this *RetryStrategy) String() string {
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`OnTimeout:` + fmt.Sprintf("%v", this.OnTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20843,6 +22941,7 @@ func (this *SCMProviderGeneratorAzureDevOps) String() string {
 		`TeamProject:` + fmt.Sprintf("%v", this.TeamProject) + `,`,
 		`AccessTokenRef:` + strings.Replace(this.AccessTokenRef.String(), "SecretRef", "SecretRef", 1) + `,`,
 		`AllBranches:` + fmt.Sprintf("%v", this.AllBranches) + `,`,
+		`TokenProvider:` + strings.Replace(this.TokenProvider.String(), "SCMProviderGeneratorTokenProvider", "SCMProviderGeneratorTokenProvider", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20872,6 +22971,7 @@ func (this *SCMProviderGeneratorBitbucketServer) String() string {
 		`BearerToken:` + strings.Replace(this.BearerToken.String(), "BearerTokenBitbucket", "BearerTokenBitbucket", 1) + `,`,
 		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`CARef:` + strings.Replace(this.CARef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
+		`TokenProvider:` + strings.Replace(this.TokenProvider.String(), "SCMProviderGeneratorTokenProvider", "SCMProviderGeneratorTokenProvider", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20932,6 +23032,60 @@ func (this *SCMProviderGeneratorGitlab) String() string {
 		`IncludeSharedProjects:` + valueToStringGenerated(this.IncludeSharedProjects) + `,`,
 		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
 		`CARef:` + strings.Replace(this.CARef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
+		`TokenProvider:` + strings.Replace(this.TokenProvider.String(), "SCMProviderGeneratorTokenProvider", "SCMProviderGeneratorTokenProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SCMProviderGeneratorTokenProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SCMProviderGeneratorTokenProvider{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`Audience:` + fmt.Sprintf("%v", this.Audience) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SSHSignatureKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SSHSignatureKey{`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`Principal:` + fmt.Sprintf("%v", this.Principal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SSHSigningKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SSHSigningKey{`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`KeyType:` + fmt.Sprintf("%v", this.KeyType) + `,`,
+		`Principals:` + fmt.Sprintf("%v", this.Principals) + `,`,
+		`KeyData:` + fmt.Sprintf("%v", this.KeyData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SSHSigningKeyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]SSHSigningKey{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "SSHSigningKey", "SSHSigningKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&SSHSigningKeyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20953,6 +23107,31 @@ func (this *SignatureKey) String() string {
 	}
 	s := strings.Join([]string{`&SignatureKey{`,
 		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
+		`SSH:` + strings.Replace(this.SSH.String(), "SSHSignatureKey", "SSHSignatureKey", 1) + `,`,
+		`Sigstore:` + strings.Replace(this.Sigstore.String(), "SigstoreIdentity", "SigstoreIdentity", 1) + `,`,
+		`Cosign:` + strings.Replace(this.Cosign.String(), "CosignSignatureKey", "CosignSignatureKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SigstoreIdentity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SigstoreIdentity{`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`SubjectRegex:` + fmt.Sprintf("%v", this.SubjectRegex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncApproval{`,
+		`ApplicationSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Expiration:` + fmt.Sprintf("%v", this.Expiration) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20982,6 +23161,7 @@ func (this *SyncOperation) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`Timeout:` + strings.Replace(this.Timeout.String(), "SyncTimeout", "SyncTimeout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21033,6 +23213,7 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Timeout:` + strings.Replace(this.Timeout.String(), "SyncTimeout", "SyncTimeout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21093,6 +23274,17 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncTimeout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncTimeout{`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -21135,6 +23327,18 @@ func (this *TagFilter) String() string {
 	}, "")
 	return s
 }
+func (this *TokenScope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenScope{`,
+		`Projects:` + fmt.Sprintf("%v", this.Projects) + `,`,
+		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -22042,59 +24246,9 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValuesFromAllowList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22121,161 +24275,16 @@ func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JWTTokensByRole == nil {
-				m.JWTTokensByRole = make(map[string]JWTTokens)
-			}
-			var mapkey string
-			mapvalue := &JWTTokens{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &JWTTokens{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.JWTTokensByRole[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.HelmValuesFromAllowList = append(m.HelmValuesFromAllowList, HelmValuesFromAllowListEntry{})
+			if err := m.HelmValuesFromAllowList[len(m.HelmValuesFromAllowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Application) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipChartSignatureVerification", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22285,28 +24294,313 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.SkipChartSignatureVerification = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncApproval == nil {
+				m.SyncApproval = &SyncApproval{}
+			}
+			if err := m.SyncApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JWTTokensByRole == nil {
+				m.JWTTokensByRole = make(map[string]JWTTokens)
+			}
+			var mapkey string
+			mapvalue := &JWTTokens{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JWTTokens{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.JWTTokensByRole[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27775,6 +30069,76 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipTests = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRenderer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostRenderer == nil {
+				m.PostRenderer = &HelmPostRenderer{}
+			}
+			if err := m.PostRenderer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuesFrom = append(m.ValuesFrom, HelmValuesFromSource{})
+			if err := m.ValuesFrom[len(m.ValuesFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30736,6 +33100,38 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33229,6 +35625,88 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CosignSignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignSignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignSignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35406,7 +37884,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmPostRenderer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35429,17 +37907,17 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPostRenderer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPostRenderer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35449,27 +37927,31 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Kustomize == nil {
+				m.Kustomize = &HelmPostRendererKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35496,16 +37978,68 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmPostRendererKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmPostRendererKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmPostRendererKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35515,24 +38049,23 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35555,7 +38088,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmValuesFromAllowListEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35578,15 +38111,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmValuesFromAllowListEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmValuesFromAllowListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35614,13 +38147,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35630,16 +38163,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RequestedByNeighbors = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35649,16 +38195,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			m.Capacity = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35668,11 +38227,12 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Destination = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35694,7 +38254,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HelmValuesFromSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35717,15 +38277,15 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmValuesFromSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmValuesFromSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35753,11 +38313,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35785,61 +38345,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35867,11 +38377,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35899,8 +38409,48 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Destination = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35922,7 +38472,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35945,17 +38495,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35965,35 +38515,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36003,77 +38547,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36100,8 +38596,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36126,7 +38621,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36149,15 +38644,15 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36185,13 +38680,13 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36201,29 +38696,35 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var v int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36233,12 +38734,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Capacity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36260,7 +38760,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36283,15 +38783,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36319,11 +38819,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36351,7 +38851,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36374,7 +38874,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36397,15 +38897,15 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36433,11 +38933,11 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36465,11 +38965,99 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36497,7 +39085,43 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &TokenScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36520,7 +39144,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36543,17 +39167,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36563,55 +39187,25 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36634,7 +39228,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36657,15 +39251,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36693,11 +39287,519 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38392,6 +41494,450 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &OperationApproval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = OperationApprovalPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38765,6 +42311,94 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptStartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttemptStartedAt == nil {
+				m.AttemptStartedAt = &v1.Time{}
+			}
+			if err := m.AttemptStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOnTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryOnTimeout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42774,6 +46408,134 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			}
 			m.NoProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderAudience", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderAudience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43580,6 +47342,174 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			}
 			m.NoProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialClone = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SparseCheckout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SparseCheckout = bool(v != 0)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderAudience", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderAudience = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProviderScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenProviderScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45085,6 +49015,121 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceDrift) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDrift: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDrift: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46911,6 +50956,42 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			}
 			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47248,6 +51329,78 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Drift == nil {
+				m.Drift = &ResourceDrift{}
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ResourceSyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47269,7 +51422,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *ResourceSyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47292,17 +51445,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSyncOnly", wireType)
 			}
-			m.Limit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47312,16 +51465,17 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ManualSyncOnly = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealDisabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47331,28 +51485,157 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			m.SelfHealDisabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPruneDisabled", wireType)
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.AutoPruneDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnTimeout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48757,14 +53040,901 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessTokenRef == nil {
-				m.AccessTokenRef = &SecretRef{}
+			if m.AccessTokenRef == nil {
+				m.AccessTokenRef = &SecretRef{}
+			}
+			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenProvider == nil {
+				m.TokenProvider = &SCMProviderGeneratorTokenProvider{}
+			}
+			if err := m.TokenProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppPasswordRef == nil {
+				m.AppPasswordRef = &SecretRef{}
+			}
+			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucket{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenProvider == nil {
+				m.TokenProvider = &SCMProviderGeneratorTokenProvider{}
+			}
+			if err := m.TokenProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LabelMatch = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -48784,6 +53954,26 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48805,7 +53995,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48828,15 +54018,15 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48864,11 +54054,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48896,11 +54086,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48927,14 +54117,46 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppPasswordRef == nil {
-				m.AppPasswordRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -48975,7 +54197,7 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48998,15 +54220,15 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49034,9 +54256,29 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSubgroups = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -49068,9 +54310,9 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49097,14 +54339,14 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -49124,11 +54366,52 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IncludeSharedProjects = &b
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49138,33 +54421,29 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucket{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49174,15 +54453,31 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49209,10 +54504,10 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
+			if m.TokenProvider == nil {
+				m.TokenProvider = &SCMProviderGeneratorTokenProvider{}
 			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -49237,7 +54532,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorTokenProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49260,15 +54555,15 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorTokenProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorTokenProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49296,12 +54591,43 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RepositoryMatch = &s
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49329,11 +54655,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			m.Audience = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49361,11 +54687,61 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			m.Scope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49393,12 +54769,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.LabelMatch = &s
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49426,8 +54801,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49450,7 +54824,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *SSHSigningKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49473,15 +54847,15 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSHSigningKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSHSigningKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49509,11 +54883,11 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49541,13 +54915,13 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.KeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principals", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49557,33 +54931,29 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Principals = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49593,32 +54963,24 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Insecure = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49640,7 +55002,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *SSHSigningKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49663,17 +55025,17 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSHSigningKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSHSigningKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49683,29 +55045,30 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49715,29 +55078,81 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, SSHSigningKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49747,31 +55162,27 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49799,28 +55210,8 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49842,7 +55233,7 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49865,15 +55256,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49901,63 +55292,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IncludeSubgroups = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSH", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49984,79 +55323,18 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.SSH == nil {
+				m.SSH = &SSHSignatureKey{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SSH.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.IncludeSharedProjects = &b
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigstore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50066,27 +55344,31 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.Sigstore == nil {
+				m.Sigstore = &SigstoreIdentity{}
+			}
+			if err := m.Sigstore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cosign", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50113,10 +55395,10 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
+			if m.Cosign == nil {
+				m.Cosign = &CosignSignatureKey{}
 			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cosign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -50141,7 +55423,7 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRef) Unmarshal(dAtA []byte) error {
+func (m *SigstoreIdentity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50164,15 +55446,15 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigstoreIdentity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigstoreIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50200,11 +55482,11 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectRegex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50232,7 +55514,7 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.SubjectRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50255,7 +55537,7 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+func (m *SyncApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50278,15 +55560,48 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50314,7 +55629,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50674,6 +55989,42 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &SyncTimeout{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51250,16 +56601,52 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51286,10 +56673,10 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			if m.Timeout == nil {
+				m.Timeout = &SyncTimeout{}
 			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51878,6 +57265,120 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52490,6 +57991,152 @@ func (m *TagFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -72,6 +72,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigManagementPlugin":                  schema_pkg_apis_application_v1alpha1_ConfigManagementPlugin(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef":                         schema_pkg_apis_application_v1alpha1_ConfigMapKeyRef(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConnectionState":                         schema_pkg_apis_application_v1alpha1_ConnectionState(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.CosignSignatureKey":                      schema_pkg_apis_application_v1alpha1_CosignSignatureKey(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.DuckTypeGenerator":                       schema_pkg_apis_application_v1alpha1_DuckTypeGenerator(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.EnvEntry":                                schema_pkg_apis_application_v1alpha1_EnvEntry(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ErrApplicationNotAllowedToUseProject":    schema_pkg_apis_application_v1alpha1_ErrApplicationNotAllowedToUseProject(ref),
@@ -85,6 +86,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmFileParameter":                       schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmOptions":                             schema_pkg_apis_application_v1alpha1_HelmOptions(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmParameter":                           schema_pkg_apis_application_v1alpha1_HelmParameter(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRenderer":                        schema_pkg_apis_application_v1alpha1_HelmPostRenderer(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRendererKustomize":               schema_pkg_apis_application_v1alpha1_HelmPostRendererKustomize(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromAllowListEntry":            schema_pkg_apis_application_v1alpha1_HelmValuesFromAllowListEntry(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromSource":                    schema_pkg_apis_application_v1alpha1_HelmValuesFromSource(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HostInfo":                                schema_pkg_apis_application_v1alpha1_HostInfo(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HostResourceInfo":                        schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Info":                                    schema_pkg_apis_application_v1alpha1_Info(ref),
@@ -106,6 +111,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.NestedMatrixGenerator":                   schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationApproval":                       schema_pkg_apis_application_v1alpha1_OperationApproval(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationInitiator":                      schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationState":                          schema_pkg_apis_application_v1alpha1_OperationState(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OptionalArray":                           schema_pkg_apis_application_v1alpha1_OptionalArray(ref),
@@ -137,6 +143,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceActionParam":                     schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceActions":                         schema_pkg_apis_application_v1alpha1_ResourceActions(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceDiff":                            schema_pkg_apis_application_v1alpha1_ResourceDiff(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceDrift":                           schema_pkg_apis_application_v1alpha1_ResourceDrift(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences":               schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceNetworkingInfo":                  schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceNode":                            schema_pkg_apis_application_v1alpha1_ResourceNode(ref),
@@ -144,6 +151,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceRef":                             schema_pkg_apis_application_v1alpha1_ResourceRef(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceResult":                          schema_pkg_apis_application_v1alpha1_ResourceResult(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceStatus":                          schema_pkg_apis_application_v1alpha1_ResourceStatus(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceSyncPolicy":                      schema_pkg_apis_application_v1alpha1_ResourceSyncPolicy(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy":                           schema_pkg_apis_application_v1alpha1_RetryStrategy(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RevisionHistory":                         schema_pkg_apis_application_v1alpha1_RevisionHistory(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RevisionMetadata":                        schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref),
@@ -156,8 +164,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitea":               schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorGithub":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGithub(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitlab":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider":       schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorTokenProvider(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSignatureKey":                         schema_pkg_apis_application_v1alpha1_SSHSignatureKey(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSigningKey":                           schema_pkg_apis_application_v1alpha1_SSHSigningKey(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSigningKeyList":                       schema_pkg_apis_application_v1alpha1_SSHSigningKeyList(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef":                               schema_pkg_apis_application_v1alpha1_SecretRef(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SignatureKey":                            schema_pkg_apis_application_v1alpha1_SignatureKey(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SigstoreIdentity":                        schema_pkg_apis_application_v1alpha1_SigstoreIdentity(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncApproval":                            schema_pkg_apis_application_v1alpha1_SyncApproval(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperation":                           schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResource":                   schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResult":                     schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
@@ -167,9 +181,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncStrategy":                            schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncStrategyApply":                       schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncStrategyHook":                        schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncTimeout":                             schema_pkg_apis_application_v1alpha1_SyncTimeout(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncWindow":                              schema_pkg_apis_application_v1alpha1_SyncWindow(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.TLSClientConfig":                         schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.TagFilter":                               schema_pkg_apis_application_v1alpha1_TagFilter(ref),
+		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.TokenScope":                              schema_pkg_apis_application_v1alpha1_TokenScope(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.objectMeta":                              schema_pkg_apis_application_v1alpha1_objectMeta(ref),
 		"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.rawResourceOverride":                     schema_pkg_apis_application_v1alpha1_rawResourceOverride(ref),
 	}
@@ -426,7 +442,7 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 					},
 					"signatureKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync",
+							Description: "SignatureKeys contains a list of PGP key IDs, SSH keys, Sigstore identities and cosign keys that commits in Git and Helm charts must be signed with in order to be allowed for sync",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -488,11 +504,38 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"helmValuesFromAllowList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmValuesFromAllowList contains the ConfigMaps and Secrets which applications may read Helm values from",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromAllowListEntry"),
+									},
+								},
+							},
+						},
+					},
+					"skipChartSignatureVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipChartSignatureVerification disables the signature verification of Helm charts from Helm and OCI repositories. Unless set, charts must be signed by one of the SignatureKeys if any are configured: the provenance files of charts from Helm repositories are verified against the GnuPG keys, and the cosign signatures of OCI charts against the cosign keys and Sigstore identities.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"syncApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncApproval requires syncs of the selected applications to be approved by a second person before they are run",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncApproval"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromAllowListEntry", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncApproval", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -1913,11 +1956,38 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSourceHelm(ref common.Refer
 							},
 						},
 					},
+					"skipTests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipTests skips test manifest installation step (Helm's --skip-tests).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer post-processes the manifests rendered by Helm before they are returned",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRenderer"),
+						},
+					},
+					"valuesFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesFrom is a list of ConfigMap and Secret keys containing Helm values. They take precedence over the value files, and are overridden by Values and ValuesObject. The referenced resources must be allowed by the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromSource"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmParameter", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmParameter", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRenderer", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmValuesFromSource", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2731,6 +2801,13 @@ func schema_pkg_apis_application_v1alpha1_ChartDetails(ref common.ReferenceCallb
 							},
 						},
 					},
+					"signatureInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureInfo contains a hint on the signer if the chart was signed, and signature verification is required.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3346,6 +3423,28 @@ func schema_pkg_apis_application_v1alpha1_ConnectionState(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_CosignSignatureKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CosignSignatureKey references a cosign public key configured for verifying signatures of OCI Helm charts",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the SHA256 fingerprint of the DER encoded public key, e.g. SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fingerprint"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_DuckTypeGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3882,6 +3981,157 @@ func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_HelmPostRenderer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmPostRenderer is a step which post-processes the manifests rendered by Helm. Exactly one of Kustomize or Plugin must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize applies a Kustomize component to the manifests rendered by Helm",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRendererKustomize"),
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin passes the manifests rendered by Helm to a config management plugin, which must be referenced by name",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationSourcePlugin", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HelmPostRendererKustomize"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmPostRendererKustomize(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmPostRendererKustomize holds the options of a Kustomize post-renderer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of a directory containing a Kustomize component (kind: Component), relative to the path of the source. It may reference the root of another source using the form $ref/path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmValuesFromAllowListEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmValuesFromAllowListEntry allows applications to read Helm values from the matching ConfigMaps and Secrets",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the allowed resources, either ConfigMap or Secret. An empty kind or '*' allows both.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is a glob pattern matching the namespace of the allowed resources",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a glob pattern matching the name of the allowed resources",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination allows resources in the destination cluster instead of the cluster Argo CD is running in",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmValuesFromSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmValuesFromSource references a key of a ConfigMap or Secret containing Helm values",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the referenced resource, either ConfigMap or Secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the referenced resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the referenced resource. Defaults to the namespace of the application, or to the destination namespace if Destination is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key containing the values in YAML format. Defaults to values.yaml.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination reads the resource from the destination cluster instead of the cluster Argo CD is running in",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"optional": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional ignores the reference if the resource or the key does not exist",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_HostInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4038,10 +4288,18 @@ func schema_pkg_apis_application_v1alpha1_JWTToken(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope restricts the token to a subset of the permissions of the role",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.TokenScope"),
+						},
+					},
 				},
 				Required: []string{"iat"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.TokenScope"},
 	}
 }
 
@@ -4641,100 +4899,225 @@ func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy"),
 						},
 					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval holds the approval state of operations which need to be approved before they are run",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationApproval"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationInitiator", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperation"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationApproval", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.OperationInitiator", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperation"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_OperationInitiator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_OperationApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OperationInitiator contains information about the initiator of an operation",
+				Description: "OperationApproval holds the approval state of an operation. The operation is requested by the user in InitiatedBy and needs to be approved by another user.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username contains the name of a user who started operation",
+							Description: "Phase is the approval phase of the operation",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automated": {
+					"requestedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Automated is set to true if operation was initiated automatically by the application controller.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "RequestedAt is the time at which the operation was requested",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OperationState contains information about state of a running operation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"operation": {
+					"expiresAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation is the original requested operation",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Operation"),
+							Description: "ExpiresAt is the time after which the operation can no longer be approved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"phase": {
+					"diff": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the operation",
-							Default:     "",
+							Description: "Diff is the rendered diff between the live and the desired state of the application at the time of the request",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"approvedBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message holds any pertinent messages when attempting to perform operation (typically errors).",
+							Description: "ApprovedBy is the user who approved the operation",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"syncResult": {
+					"approvedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncResult is the result of a Sync operation",
-							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResult"),
+							Description: "ApprovedAt is the time at which the operation was approved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"startedAt": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StartedAt contains time of operation start",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Message is the optional message of the approver",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"finishedAt": {
+					"requestedBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedAt contains time of operation completion",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "RequestedBy identifies the user who requested the operation by the issuer and subject of their token",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"retryCount": {
+					"specHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount contains time of operation retries",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "SpecHash is the hash of the application spec at the time of the request. The operation is not run if the spec changed since.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"operation", "phase", "startedAt"},
-			},
-		},
-		Dependencies: []string{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the resolved revision the operation was requested to sync to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions are the resolved revisions the operation was requested to sync the sources of a multi-source application to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"phase", "requestedAt", "expiresAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OperationInitiator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationInitiator contains information about the initiator of an operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username contains the name of a user who started operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Automated is set to true if operation was initiated automatically by the application controller.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationState contains information about state of a running operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the original requested operation",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Operation"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message holds any pertinent messages when attempting to perform operation (typically errors).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncResult": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncResult is the result of a Sync operation",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResult"),
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt contains time of operation start",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedAt contains time of operation completion",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount contains time of operation retries",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"attemptStartedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttemptStartedAt contains the start time of the current attempt of a retried operation",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"timeoutMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutMessage describes the timeout exceeded by the current attempt, which is terminated because of it",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryOnTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOnTimeout is true if the operation is retried once the attempt terminated because of a timeout is finished",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"operation", "phase", "startedAt"},
+			},
+		},
+		Dependencies: []string{
 			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Operation", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
@@ -5731,6 +6114,34 @@ func schema_pkg_apis_application_v1alpha1_RepoCreds(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"tokenProviderURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderURL is the URL of an OAuth 2.0 token endpoint, at which a projected service account token of the pod is exchanged for short-lived access tokens. It must be one of the URLs allowed by the administrator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderClientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderClientID is the client ID used to request access tokens from the token provider. If set, the service account token is used as a client assertion instead of being exchanged.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderAudience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderAudience is the audience of the access tokens requested from the token provider",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderScope is the space-separated list of scopes of the access tokens requested from the token provider",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
@@ -5942,6 +6353,48 @@ func schema_pkg_apis_application_v1alpha1_Repository(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"partialClone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PartialClone specifies whether the repo is fetched without file contents, which are then fetched on demand. Only valid for Git repositories.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sparseCheckout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SparseCheckout specifies whether only the paths used by applications are checked out. Only valid for Git repositories.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tokenProviderURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderURL is the URL of an OAuth 2.0 token endpoint, at which a projected service account token of the pod is exchanged for short-lived access tokens. It must be one of the URLs allowed by the administrator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderClientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderClientID is the client ID used to request access tokens from the token provider. If set, the service account token is used as a client assertion instead of being exchanged.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderAudience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderAudience is the audience of the access tokens requested from the token provider",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenProviderScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProviderScope is the space-separated list of scopes of the access tokens requested from the token provider",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"repo"},
 			},
@@ -6311,6 +6764,43 @@ func schema_pkg_apis_application_v1alpha1_ResourceDiff(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ResourceDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceDrift describes an ongoing deviation of a live resource from its desired state",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time at which the resource was first observed as OutOfSync",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"managers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Managers is the list of field managers which modified the live resource after it was last synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"startedAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6784,10 +7274,18 @@ func schema_pkg_apis_application_v1alpha1_ResourceResult(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time at which the hook was first observed as running",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"group", "version", "kind", "namespace", "name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6857,11 +7355,57 @@ func schema_pkg_apis_application_v1alpha1_ResourceStatus(ref common.ReferenceCal
 							Format: "int64",
 						},
 					},
+					"drift": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drift holds information about when the live resource started to deviate from the desired state",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceDrift"),
+						},
+					},
+					"syncPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPolicy holds the effective sync policy overrides of the resource",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceSyncPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HealthStatus"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceDrift", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceSyncPolicy"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceSyncPolicy holds the overrides of the application sync policy which apply to a single resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"manualSyncOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManualSyncOnly excludes the resource from automated sync operations",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"selfHealDisabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelfHealDisabled prevents automated sync operations from reverting changes made to the live resource",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"autoPruneDisabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoPruneDisabled prevents automated sync operations from pruning the resource",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -6885,6 +7429,13 @@ func schema_pkg_apis_application_v1alpha1_RetryStrategy(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.Backoff"),
 						},
 					},
+					"onTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnTimeout specifies whether to retry syncs which were terminated because they exceeded their timeout (default: false)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -7219,7 +7770,7 @@ func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorAzureDevOps(ref co
 					},
 					"accessTokenRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Personal Access Token (PAT) to use when connecting. Required.",
+							Description: "The Personal Access Token (PAT) to use when connecting. Required unless TokenProvider is set.",
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef"),
 						},
 					},
@@ -7230,12 +7781,18 @@ func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorAzureDevOps(ref co
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"organization", "teamProject", "accessTokenRef"},
-			},
+					"tokenProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProvider obtains short-lived Microsoft Entra access tokens, which are used instead of the Personal Access Token",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider"),
+						},
+					},
+				},
+				Required: []string{"organization", "teamProject"},
+			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef"},
 	}
 }
 
@@ -7339,12 +7896,18 @@ func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorBitbucketServer(re
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
 						},
 					},
+					"tokenProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProvider obtains short-lived access tokens, which are used as bearer tokens",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider"),
+						},
+					},
 				},
 				Required: []string{"project", "api"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.BearerTokenBitbucket", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.BearerTokenBitbucket", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider"},
 	}
 }
 
@@ -7584,12 +8147,61 @@ func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref common.
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
 						},
 					},
+					"tokenProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenProvider obtains short-lived OAuth access tokens, which are used instead of the token referenced by TokenRef",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider"),
+						},
+					},
 				},
 				Required: []string{"group"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SCMProviderGeneratorTokenProvider", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SecretRef"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorTokenProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SCMProviderGeneratorTokenProvider defines the OAuth 2.0 token endpoint from which an SCM provider obtains short-lived access tokens, in exchange for a projected service account token of the ApplicationSet controller. If a client ID is set, the service account token is used as a client assertion, as done by Microsoft Entra workload identity federation. Otherwise, it is exchanged using an OAuth 2.0 token exchange (RFC 8693).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the token endpoint. Required. It must be one of the URLs allowed by the administrator.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID used to request the access tokens.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audience of the requested access tokens.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Space-separated list of scopes of the requested access tokens.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
 	}
 }
 
@@ -7625,24 +8237,209 @@ func schema_pkg_apis_application_v1alpha1_SignatureKey(ref common.ReferenceCallb
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SignatureKey is the specification of a key required to verify commit signatures with",
+				Description: "SignatureKey is the specification of a key required to verify commit or chart signatures with. Exactly one of KeyID, SSH, Sigstore and Cosign must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"keyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The ID of the key in hexadecimal notation",
+							Description: "The ID of the GnuPG key in hexadecimal notation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ssh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSH is an SSH key configured as signing key, which commits may be signed with",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSignatureKey"),
+						},
+					},
+					"sigstore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sigstore is a Sigstore identity, which commits may be signed with using gitsign",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SigstoreIdentity"),
+						},
+					},
+					"cosign": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cosign is a cosign public key, which OCI Helm charts may be signed with",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.CosignSignatureKey"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.CosignSignatureKey", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSignatureKey", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SigstoreIdentity"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SigstoreIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SigstoreIdentity is the identity of the certificate of a keyless Sigstore signature",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer is the OIDC issuer of the identity, e.g. https://token.actions.githubusercontent.com",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subjectRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubjectRegex is a regular expression the subject of the identity must fully match, e.g. an e-mail address or a workflow URL",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"keyID"},
+				Required: []string{"issuer", "subjectRegex"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SSHSignatureKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHSignatureKey references an SSH key configured as signing key",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the SHA256 fingerprint of the key, e.g. SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"principal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Principal restricts the key to signatures verified for the given principal of the allowed signers entry of the key. Any principal is allowed if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fingerprint"},
 			},
 		},
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SSHSigningKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHSigningKey is a representation of an SSH public key trusted to verify commit signatures",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the SHA256 fingerprint of the key, e.g. SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyType holds the type of the key, e.g. ssh-ed25519",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"principals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Principals holds the comma-separated list of principal patterns the key is allowed to sign for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyData holds the key as an entry of an SSH allowed signers file",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fingerprint"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SSHSigningKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHSigningKeyList is a collection of SSHSigningKey objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSigningKey"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SSHSigningKey", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncApproval selects the applications of a project whose syncs need to be approved by a user other than the one who requested the sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"applicationSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplicationSelector selects the applications requiring approval by their labels. An empty selector selects all applications of the project.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"expiration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiration is the duration after which sync requests expire if they have not been approved, e.g. 12h. Defaults to 24h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"applicationSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7756,11 +8553,17 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 							},
 						},
 					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout overrides the sync timeout defined in the sync policy of the application",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncTimeout"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncStrategy"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncStrategy", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncTimeout"},
 	}
 }
 
@@ -7923,11 +8726,17 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout controls the termination of sync operations which do not complete in time",
+							Ref:         ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncTimeout"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.SyncTimeout"},
 	}
 }
 
@@ -8085,6 +8894,33 @@ func schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncTimeout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncTimeout controls the termination of sync operations which do not complete in time",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the maximum duration of a sync attempt. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hook is the maximum duration of a single sync hook. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8330,3 +9166,60 @@ func schema_pkg_apis_application_v1alpha1_rawResourceOverride(ref common.Referen
 			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.KnownTypeField"},
 	}
 }
+func schema_pkg_apis_application_v1alpha1_TokenScope(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenScope restricts a token to a subset of the permissions of its account or project role. The lists may contain glob patterns; an empty list does not restrict the token.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects are the projects the token is restricted to. Requests for resources which do not belong to a project, e.g. clusters or repositories, are denied if projects are set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are the RBAC resources the token is restricted to, e.g. applications or logs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"actions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actions are the RBAC actions the token is restricted to, e.g. get or sync",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -47,6 +47,14 @@ type RepoCreds struct {
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,20,opt,name=forceHttpBasicAuth"`
 	// NoProxy specifies a list of targets where the proxy isn't used, applies only in cases where the proxy is applied
 	NoProxy string `json:"noProxy,omitempty" protobuf:"bytes,23,opt,name=noProxy"`
+	// TokenProviderURL is the URL of an OAuth 2.0 token endpoint, at which a projected service account token of the pod is exchanged for short-lived access tokens. It must be one of the URLs allowed by the administrator.
+	TokenProviderURL string `json:"tokenProviderURL,omitempty" protobuf:"bytes,24,opt,name=tokenProviderURL"`
+	// TokenProviderClientID is the client ID used to request access tokens from the token provider. If set, the service account token is used as a client assertion instead of being exchanged.
+	TokenProviderClientID string `json:"tokenProviderClientID,omitempty" protobuf:"bytes,25,opt,name=tokenProviderClientID"`
+	// TokenProviderAudience is the audience of the access tokens requested from the token provider
+	TokenProviderAudience string `json:"tokenProviderAudience,omitempty" protobuf:"bytes,26,opt,name=tokenProviderAudience"`
+	// TokenProviderScope is the space-separated list of scopes of the access tokens requested from the token provider
+	TokenProviderScope string `json:"tokenProviderScope,omitempty" protobuf:"bytes,27,opt,name=tokenProviderScope"`
 }
 
 // Repository is a repository holding application configurations
@@ -98,6 +106,18 @@ type Repository struct {
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,22,opt,name=forceHttpBasicAuth"`
 	// NoProxy specifies a list of targets where the proxy isn't used, applies only in cases where the proxy is applied
 	NoProxy string `json:"noProxy,omitempty" protobuf:"bytes,23,opt,name=noProxy"`
+	// PartialClone specifies whether the repo is fetched without file contents, which are then fetched on demand. Only valid for Git repositories.
+	PartialClone bool `json:"partialClone,omitempty" protobuf:"bytes,24,opt,name=partialClone"`
+	// SparseCheckout specifies whether only the paths used by applications are checked out. Only valid for Git repositories.
+	SparseCheckout bool `json:"sparseCheckout,omitempty" protobuf:"bytes,25,opt,name=sparseCheckout"`
+	// TokenProviderURL is the URL of an OAuth 2.0 token endpoint, at which a projected service account token of the pod is exchanged for short-lived access tokens. It must be one of the URLs allowed by the administrator.
+	TokenProviderURL string `json:"tokenProviderURL,omitempty" protobuf:"bytes,26,opt,name=tokenProviderURL"`
+	// TokenProviderClientID is the client ID used to request access tokens from the token provider. If set, the service account token is used as a client assertion instead of being exchanged.
+	TokenProviderClientID string `json:"tokenProviderClientID,omitempty" protobuf:"bytes,27,opt,name=tokenProviderClientID"`
+	// TokenProviderAudience is the audience of the access tokens requested from the token provider
+	TokenProviderAudience string `json:"tokenProviderAudience,omitempty" protobuf:"bytes,28,opt,name=tokenProviderAudience"`
+	// TokenProviderScope is the space-separated list of scopes of the access tokens requested from the token provider
+	TokenProviderScope string `json:"tokenProviderScope,omitempty" protobuf:"bytes,29,opt,name=tokenProviderScope"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification
@@ -112,7 +132,7 @@ func (repo *Repository) IsLFSEnabled() bool {
 
 // HasCredentials returns true when the repository has been configured with any credentials
 func (m *Repository) HasCredentials() bool {
-	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != ""
+	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != "" || m.TokenProviderURL != ""
 }
 
 // CopyCredentialsFromRepo copies all credential information from source repository to receiving repository
@@ -148,6 +168,12 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
+		if repo.TokenProviderURL == "" {
+			repo.TokenProviderURL = source.TokenProviderURL
+			repo.TokenProviderClientID = source.TokenProviderClientID
+			repo.TokenProviderAudience = source.TokenProviderAudience
+			repo.TokenProviderScope = source.TokenProviderScope
+		}
 		repo.ForceHttpBasicAuth = source.ForceHttpBasicAuth
 	}
 }
@@ -185,6 +211,12 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
+		if repo.TokenProviderURL == "" {
+			repo.TokenProviderURL = source.TokenProviderURL
+			repo.TokenProviderClientID = source.TokenProviderClientID
+			repo.TokenProviderAudience = source.TokenProviderAudience
+			repo.TokenProviderScope = source.TokenProviderScope
+		}
 		if repo.Proxy == "" {
 			repo.Proxy = source.Proxy
 		}
@@ -212,9 +244,22 @@ func (repo *Repository) GetGitCreds(store git.CredsStore) git.Creds {
 	if repo.GCPServiceAccountKey != "" {
 		return git.NewGoogleCloudCreds(repo.GCPServiceAccountKey, store)
 	}
+	if repo.TokenProviderURL != "" {
+		return git.NewTokenProviderCreds(repo.GetTokenProvider(), repo.Username, repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure(), repo.Proxy, repo.NoProxy, store)
+	}
 	return git.NopCreds{}
 }
 
+// GetTokenProvider returns the token provider used to obtain access tokens for the repository
+func (repo *Repository) GetTokenProvider() git.TokenProvider {
+	return git.TokenProvider{
+		URL:      repo.TokenProviderURL,
+		ClientID: repo.TokenProviderClientID,
+		Audience: repo.TokenProviderAudience,
+		Scope:    repo.TokenProviderScope,
+	}
+}
+
 // GetHelmCreds returns the credentials from a repository configuration used to authenticate at a Helm repository
 func (repo *Repository) GetHelmCreds() helm.Creds {
 	return helm.Creds{
@@ -352,3 +397,21 @@ type GnuPGPublicKeyList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []GnuPGPublicKey `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// SSHSigningKey is a representation of an SSH public key trusted to verify commit signatures
+type SSHSigningKey struct {
+	// Fingerprint is the SHA256 fingerprint of the key, e.g. SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,1,opt,name=fingerprint"`
+	// KeyType holds the type of the key, e.g. ssh-ed25519
+	KeyType string `json:"keyType,omitempty" protobuf:"bytes,2,opt,name=keyType"`
+	// Principals holds the comma-separated list of principal patterns the key is allowed to sign for
+	Principals string `json:"principals,omitempty" protobuf:"bytes,3,opt,name=principals"`
+	// KeyData holds the key as an entry of an SSH allowed signers file
+	KeyData string `json:"keyData,omitempty" protobuf:"bytes,4,opt,name=keyData"`
+}
+
+// SSHSigningKeyList is a collection of SSHSigningKey objects
+type SSHSigningKeyList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []SSHSigningKey `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -1,7 +1,10 @@
 package v1alpha1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net"
@@ -10,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,6 +42,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v2/util/http"
 	"github.com/argoproj/argo-cd/v2/util/security"
@@ -397,6 +402,62 @@ type ApplicationSourceHelm struct {
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,13,opt,name=apiVersions"`
 	// SkipTests skips test manifest installation step (Helm's --skip-tests).
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
+	// PostRenderer post-processes the manifests rendered by Helm before they are returned
+	PostRenderer *HelmPostRenderer `json:"postRenderer,omitempty" protobuf:"bytes,15,opt,name=postRenderer"`
+	// ValuesFrom is a list of ConfigMap and Secret keys containing Helm values. They take precedence over the value
+	// files, and are overridden by Values and ValuesObject. The referenced resources must be allowed by the project.
+	ValuesFrom []HelmValuesFromSource `json:"valuesFrom,omitempty" protobuf:"bytes,16,rep,name=valuesFrom"`
+}
+
+// HelmValuesFromSource references a key of a ConfigMap or Secret containing Helm values
+type HelmValuesFromSource struct {
+	// Kind is the kind of the referenced resource, either ConfigMap or Secret
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the referenced resource
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Namespace is the namespace of the referenced resource. Defaults to the namespace of the application, or to the
+	// destination namespace if Destination is set.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Key is the key containing the values in YAML format. Defaults to values.yaml.
+	Key string `json:"key,omitempty" protobuf:"bytes,4,opt,name=key"`
+	// Destination reads the resource from the destination cluster instead of the cluster Argo CD is running in
+	Destination bool `json:"destination,omitempty" protobuf:"bytes,5,opt,name=destination"`
+	// Optional ignores the reference if the resource or the key does not exist
+	Optional bool `json:"optional,omitempty" protobuf:"bytes,6,opt,name=optional"`
+}
+
+const (
+	// HelmValuesFromKindConfigMap is the kind of Helm values sources referencing a ConfigMap
+	HelmValuesFromKindConfigMap = "ConfigMap"
+	// HelmValuesFromKindSecret is the kind of Helm values sources referencing a Secret
+	HelmValuesFromKindSecret = "Secret"
+	// HelmValuesFromDefaultKey is the default key of Helm values sources
+	HelmValuesFromDefaultKey = "values.yaml"
+)
+
+// GetKey returns the key containing the values, or the default key if none is set
+func (v HelmValuesFromSource) GetKey() string {
+	if v.Key == "" {
+		return HelmValuesFromDefaultKey
+	}
+	return v.Key
+}
+
+// HelmPostRenderer is a step which post-processes the manifests rendered by Helm. Exactly one of Kustomize or Plugin
+// must be set.
+type HelmPostRenderer struct {
+	// Kustomize applies a Kustomize component to the manifests rendered by Helm
+	Kustomize *HelmPostRendererKustomize `json:"kustomize,omitempty" protobuf:"bytes,1,opt,name=kustomize"`
+	// Plugin passes the manifests rendered by Helm to a config management plugin, which must be referenced by name
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,2,opt,name=plugin"`
+}
+
+// HelmPostRendererKustomize holds the options of a Kustomize post-renderer
+type HelmPostRendererKustomize struct {
+	// Path is the path of a directory containing a Kustomize component (kind: Component), relative to the path of the
+	// source. It may reference the root of another source using the form $ref/path.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -478,7 +539,7 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.ValuesIsEmpty() && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds && !h.SkipTests && h.KubeVersion == "" && len(h.APIVersions) == 0 && h.Namespace == ""
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.ValuesIsEmpty() && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds && !h.SkipTests && h.KubeVersion == "" && len(h.APIVersions) == 0 && h.Namespace == "" && h.PostRenderer == nil && len(h.ValuesFrom) == 0
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -1091,6 +1152,83 @@ type Operation struct {
 	Info []*Info `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls the strategy to apply if a sync fails
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// Approval holds the approval state of operations which need to be approved before they are run
+	Approval *OperationApproval `json:"approval,omitempty" protobuf:"bytes,5,opt,name=approval"`
+}
+
+// OperationApprovalPhase is the approval phase of an operation
+type OperationApprovalPhase string
+
+const (
+	// OperationApprovalPending indicates that the operation waits for approval
+	OperationApprovalPending OperationApprovalPhase = "Pending"
+	// OperationApprovalApproved indicates that the operation was approved and can be run
+	OperationApprovalApproved OperationApprovalPhase = "Approved"
+)
+
+// OperationApproval holds the approval state of an operation. The operation is requested by the user in InitiatedBy
+// and needs to be approved by another user.
+type OperationApproval struct {
+	// Phase is the approval phase of the operation
+	Phase OperationApprovalPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=OperationApprovalPhase"`
+	// RequestedAt is the time at which the operation was requested
+	RequestedAt metav1.Time `json:"requestedAt" protobuf:"bytes,2,opt,name=requestedAt"`
+	// ExpiresAt is the time after which the operation can no longer be approved
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,3,opt,name=expiresAt"`
+	// Diff is the rendered diff between the live and the desired state of the application at the time of the request
+	Diff string `json:"diff,omitempty" protobuf:"bytes,4,opt,name=diff"`
+	// ApprovedBy is the user who approved the operation
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,5,opt,name=approvedBy"`
+	// ApprovedAt is the time at which the operation was approved
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,6,opt,name=approvedAt"`
+	// Message is the optional message of the approver
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+	// RequestedBy identifies the user who requested the operation by the issuer and subject of their token
+	RequestedBy string `json:"requestedBy,omitempty" protobuf:"bytes,8,opt,name=requestedBy"`
+	// SpecHash is the hash of the application spec at the time of the request. The operation is not run if the spec
+	// changed since.
+	SpecHash string `json:"specHash,omitempty" protobuf:"bytes,9,opt,name=specHash"`
+	// Revision is the resolved revision the operation was requested to sync to
+	Revision string `json:"revision,omitempty" protobuf:"bytes,10,opt,name=revision"`
+	// Revisions are the resolved revisions the operation was requested to sync the sources of a multi-source
+	// application to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,rep,name=revisions"`
+}
+
+// IsPending returns whether the operation still waits for approval
+func (a *OperationApproval) IsPending() bool {
+	return a != nil && a.Phase != OperationApprovalApproved
+}
+
+// IsExpired returns whether the operation can no longer be approved at the given time
+func (a *OperationApproval) IsExpired(now time.Time) bool {
+	return a.IsPending() && !now.Before(a.ExpiresAt.Time)
+}
+
+// Verify returns an error if the application spec or the revisions of the sync operation differ from the ones the
+// operation was requested, and therefore approved, with
+func (a *OperationApproval) Verify(spec *ApplicationSpec, op *SyncOperation) error {
+	specHash, err := spec.Hash()
+	if err != nil {
+		return err
+	}
+	if specHash != a.SpecHash {
+		return errors.New("application spec changed since the sync was requested")
+	}
+	if op == nil || op.Revision != a.Revision || !reflect.DeepEqual(op.Revisions, a.Revisions) {
+		return errors.New("revisions of the sync differ from the ones it was requested with")
+	}
+	return nil
+}
+
+// Hash returns the hex encoded SHA-256 hash of the JSON representation of the spec
+func (spec *ApplicationSpec) Hash() (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling application spec: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
 }
 
 // DryRun returns true if an operation was requested to be performed in dry run mode
@@ -1173,6 +1311,8 @@ type SyncOperation struct {
 	// Revisions is the list of revision (Git) or chart version (Helm) which to sync each source in sources field for the application to
 	// If omitted, will use the revision specified in app spec.
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
+	// Timeout overrides the sync timeout defined in the sync policy of the application
+	Timeout *SyncTimeout `json:"timeout,omitempty" protobuf:"bytes,12,opt,name=timeout"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1196,6 +1336,20 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// AttemptStartedAt contains the start time of the current attempt of a retried operation
+	AttemptStartedAt *metav1.Time `json:"attemptStartedAt,omitempty" protobuf:"bytes,9,opt,name=attemptStartedAt"`
+	// TimeoutMessage describes the timeout exceeded by the current attempt, which is terminated because of it
+	TimeoutMessage string `json:"timeoutMessage,omitempty" protobuf:"bytes,10,opt,name=timeoutMessage"`
+	// RetryOnTimeout is true if the operation is retried once the attempt terminated because of a timeout is finished
+	RetryOnTimeout bool `json:"retryOnTimeout,omitempty" protobuf:"varint,11,opt,name=retryOnTimeout"`
+}
+
+// CurrentAttemptStartedAt returns the time at which the current attempt of the operation started
+func (s *OperationState) CurrentAttemptStartedAt() metav1.Time {
+	if s.AttemptStartedAt != nil {
+		return *s.AttemptStartedAt
+	}
+	return s.StartedAt
 }
 
 type Info struct {
@@ -1252,12 +1406,14 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Timeout controls the termination of sync operations which do not complete in time
+	Timeout *SyncTimeout `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
 	// If you add a field here, be sure to update IsZero.
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Timeout == nil)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1266,6 +1422,32 @@ type RetryStrategy struct {
 	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
 	// Backoff controls how to backoff on subsequent retries of failed syncs
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+	// OnTimeout specifies whether to retry syncs which were terminated because they exceeded their timeout (default: false)
+	OnTimeout bool `json:"onTimeout,omitempty" protobuf:"bytes,3,opt,name=onTimeout"`
+}
+
+// SyncTimeout controls the termination of sync operations which do not complete in time
+type SyncTimeout struct {
+	// Operation is the maximum duration of a sync attempt. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Operation string `json:"operation,omitempty" protobuf:"bytes,1,opt,name=operation"`
+	// Hook is the maximum duration of a single sync hook. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Hook string `json:"hook,omitempty" protobuf:"bytes,2,opt,name=hook"`
+}
+
+// OperationTimeout returns the maximum duration of a sync attempt, or zero if it is unlimited
+func (t *SyncTimeout) OperationTimeout() (time.Duration, error) {
+	if t == nil || t.Operation == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(t.Operation)
+}
+
+// HookTimeout returns the maximum duration of a single sync hook, or zero if it is unlimited
+func (t *SyncTimeout) HookTimeout() (time.Duration, error) {
+	if t == nil || t.Hook == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(t.Hook)
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -1393,6 +1575,8 @@ type ChartDetails struct {
 	Home string `json:"home,omitempty" protobuf:"bytes,2,opt,name=home"`
 	// List of maintainer details, name and email, e.g. ["John Doe <john_doe@my-company.com>"]
 	Maintainers []string `json:"maintainers,omitempty" protobuf:"bytes,3,opt,name=maintainers"`
+	// SignatureInfo contains a hint on the signer if the chart was signed, and signature verification is required.
+	SignatureInfo string `json:"signatureInfo,omitempty" protobuf:"bytes,4,opt,name=signatureInfo"`
 }
 
 // SyncOperationResult represent result of sync operation
@@ -1434,6 +1618,8 @@ type ResourceResult struct {
 	HookPhase synccommon.OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
 	// SyncPhase indicates the particular phase of the sync that this result was acquired in
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
+	// StartedAt is the time at which the hook was first observed as running
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,11,opt,name=startedAt"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1818,6 +2004,10 @@ type ResourceStatus struct {
 	Hook            bool           `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
 	RequiresPruning bool           `json:"requiresPruning,omitempty" protobuf:"bytes,9,opt,name=requiresPruning"`
 	SyncWave        int64          `json:"syncWave,omitempty" protobuf:"bytes,10,opt,name=syncWave"`
+	// Drift holds information about when the live resource started to deviate from the desired state
+	Drift *ResourceDrift `json:"drift,omitempty" protobuf:"bytes,11,opt,name=drift"`
+	// SyncPolicy holds the effective sync policy overrides of the resource
+	SyncPolicy *ResourceSyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,12,opt,name=syncPolicy"`
 }
 
 // GroupVersionKind returns the GVK schema type for given resource status
@@ -1825,6 +2015,60 @@ func (r *ResourceStatus) GroupVersionKind() schema.GroupVersionKind {
 	return schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
 }
 
+// ResourceDrift describes an ongoing deviation of a live resource from its desired state
+type ResourceDrift struct {
+	// StartedAt is the time at which the resource was first observed as OutOfSync
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,1,opt,name=startedAt"`
+	// Managers is the list of field managers which modified the live resource after it was last synced
+	Managers []string `json:"managers,omitempty" protobuf:"bytes,2,opt,name=managers"`
+}
+
+// Age returns how long the resource has been drifting relative to the given time
+func (d *ResourceDrift) Age(now time.Time) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return now.Sub(d.StartedAt.Time)
+}
+
+// OldestDrift returns the drift of the resource which has been deviating from its desired state for the longest
+// time, or nil if none of the application's resources is drifting
+func (status *ApplicationStatus) OldestDrift() *ResourceDrift {
+	var oldest *ResourceDrift
+	for i := range status.Resources {
+		drift := status.Resources[i].Drift
+		if drift != nil && (oldest == nil || drift.StartedAt.Before(&oldest.StartedAt)) {
+			oldest = drift
+		}
+	}
+	return oldest
+}
+
+// ResourceSyncPolicy holds the overrides of the application sync policy which apply to a single resource
+type ResourceSyncPolicy struct {
+	// ManualSyncOnly excludes the resource from automated sync operations
+	ManualSyncOnly bool `json:"manualSyncOnly,omitempty" protobuf:"bytes,1,opt,name=manualSyncOnly"`
+	// SelfHealDisabled prevents automated sync operations from reverting changes made to the live resource
+	SelfHealDisabled bool `json:"selfHealDisabled,omitempty" protobuf:"bytes,2,opt,name=selfHealDisabled"`
+	// AutoPruneDisabled prevents automated sync operations from pruning the resource
+	AutoPruneDisabled bool `json:"autoPruneDisabled,omitempty" protobuf:"bytes,3,opt,name=autoPruneDisabled"`
+}
+
+// AllowsAutoSync returns whether the resource may be synced by an automated sync operation
+func (p *ResourceSyncPolicy) AllowsAutoSync() bool {
+	return p == nil || !p.ManualSyncOnly
+}
+
+// AllowsSelfHeal returns whether changes made to the live resource may be reverted by an automated sync operation
+func (p *ResourceSyncPolicy) AllowsSelfHeal() bool {
+	return p.AllowsAutoSync() && (p == nil || !p.SelfHealDisabled)
+}
+
+// AllowsAutoPrune returns whether the resource may be pruned by an automated sync operation
+func (p *ResourceSyncPolicy) AllowsAutoPrune() bool {
+	return p.AllowsAutoSync() && (p == nil || !p.AutoPruneDisabled)
+}
+
 // ResourceDiff holds the diff of a live and target resource object
 // TODO: describe members of this type
 type ResourceDiff struct {
@@ -2314,10 +2558,41 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 	return s.Warn != nil && *s.Warn
 }
 
-// SignatureKey is the specification of a key required to verify commit signatures with
+// SignatureKey is the specification of a key required to verify commit or chart signatures with. Exactly one of KeyID,
+// SSH, Sigstore and Cosign must be set.
 type SignatureKey struct {
-	// The ID of the key in hexadecimal notation
-	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
+	// The ID of the GnuPG key in hexadecimal notation
+	KeyID string `json:"keyID,omitempty" protobuf:"bytes,1,name=keyID"`
+	// SSH is an SSH key configured as signing key, which commits may be signed with
+	SSH *SSHSignatureKey `json:"ssh,omitempty" protobuf:"bytes,2,opt,name=ssh"`
+	// Sigstore is a Sigstore identity, which commits may be signed with using gitsign
+	Sigstore *SigstoreIdentity `json:"sigstore,omitempty" protobuf:"bytes,3,opt,name=sigstore"`
+	// Cosign is a cosign public key, which OCI Helm charts may be signed with
+	Cosign *CosignSignatureKey `json:"cosign,omitempty" protobuf:"bytes,4,opt,name=cosign"`
+}
+
+// SSHSignatureKey references an SSH key configured as signing key
+type SSHSignatureKey struct {
+	// Fingerprint is the SHA256 fingerprint of the key, e.g. SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,1,opt,name=fingerprint"`
+	// Principal restricts the key to signatures verified for the given principal of the allowed signers entry of the
+	// key. Any principal is allowed if empty.
+	Principal string `json:"principal,omitempty" protobuf:"bytes,2,opt,name=principal"`
+}
+
+// SigstoreIdentity is the identity of the certificate of a keyless Sigstore signature
+type SigstoreIdentity struct {
+	// Issuer is the OIDC issuer of the identity, e.g. https://token.actions.githubusercontent.com
+	Issuer string `json:"issuer" protobuf:"bytes,1,opt,name=issuer"`
+	// SubjectRegex is a regular expression the subject of the identity must fully match, e.g. an e-mail address or a
+	// workflow URL
+	SubjectRegex string `json:"subjectRegex" protobuf:"bytes,2,opt,name=subjectRegex"`
+}
+
+// CosignSignatureKey references a cosign public key configured for verifying signatures of OCI Helm charts
+type CosignSignatureKey struct {
+	// Fingerprint is the SHA256 fingerprint of the DER encoded public key, e.g. SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,1,opt,name=fingerprint"`
 }
 
 // AppProjectSpec is the specification of an AppProject
@@ -2340,7 +2615,8 @@ type AppProjectSpec struct {
 	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,opt,name=syncWindows"`
 	// NamespaceResourceWhitelist contains list of whitelisted namespace level resources
 	NamespaceResourceWhitelist []metav1.GroupKind `json:"namespaceResourceWhitelist,omitempty" protobuf:"bytes,9,opt,name=namespaceResourceWhitelist"`
-	// SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync
+	// SignatureKeys contains a list of PGP key IDs, SSH keys, Sigstore identities and cosign keys that commits in Git
+	// and Helm charts must be signed with in order to be allowed for sync
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
@@ -2350,6 +2626,38 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// HelmValuesFromAllowList contains the ConfigMaps and Secrets which applications may read Helm values from
+	HelmValuesFromAllowList []HelmValuesFromAllowListEntry `json:"helmValuesFromAllowList,omitempty" protobuf:"bytes,15,rep,name=helmValuesFromAllowList"`
+	// SkipChartSignatureVerification disables the signature verification of Helm charts from Helm and OCI repositories.
+	// Unless set, charts must be signed by one of the SignatureKeys if any are configured: the provenance files of
+	// charts from Helm repositories are verified against the GnuPG keys, and the cosign signatures of OCI charts against
+	// the cosign keys and Sigstore identities.
+	SkipChartSignatureVerification bool `json:"skipChartSignatureVerification,omitempty" protobuf:"varint,16,opt,name=skipChartSignatureVerification"`
+	// SyncApproval requires syncs of the selected applications to be approved by a second person before they are run
+	SyncApproval *SyncApproval `json:"syncApproval,omitempty" protobuf:"bytes,17,opt,name=syncApproval"`
+}
+
+// SyncApproval selects the applications of a project whose syncs need to be approved by a user other than the one who
+// requested the sync
+type SyncApproval struct {
+	// ApplicationSelector selects the applications requiring approval by their labels. An empty selector selects all
+	// applications of the project.
+	ApplicationSelector metav1.LabelSelector `json:"applicationSelector" protobuf:"bytes,1,opt,name=applicationSelector"`
+	// Expiration is the duration after which sync requests expire if they have not been approved, e.g. 12h. Defaults to
+	// 24h.
+	Expiration string `json:"expiration,omitempty" protobuf:"bytes,2,opt,name=expiration"`
+}
+
+// HelmValuesFromAllowListEntry allows applications to read Helm values from the matching ConfigMaps and Secrets
+type HelmValuesFromAllowListEntry struct {
+	// Kind is the kind of the allowed resources, either ConfigMap or Secret. An empty kind or '*' allows both.
+	Kind string `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind"`
+	// Namespace is a glob pattern matching the namespace of the allowed resources
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// Name is a glob pattern matching the name of the allowed resources
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Destination allows resources in the destination cluster instead of the cluster Argo CD is running in
+	Destination bool `json:"destination,omitempty" protobuf:"bytes,4,opt,name=destination"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2737,6 +3045,60 @@ type JWTToken struct {
 	IssuedAt  int64  `json:"iat" protobuf:"int64,1,opt,name=iat"`
 	ExpiresAt int64  `json:"exp,omitempty" protobuf:"int64,2,opt,name=exp"`
 	ID        string `json:"id,omitempty" protobuf:"bytes,3,opt,name=id"`
+	// Scope restricts the token to a subset of the permissions of the role
+	Scope *TokenScope `json:"scope,omitempty" protobuf:"bytes,4,opt,name=scope"`
+}
+
+// TokenScope restricts a token to a subset of the permissions of its account or project role. The lists may contain
+// glob patterns; an empty list does not restrict the token.
+type TokenScope struct {
+	// Projects are the projects the token is restricted to. Requests for resources which do not belong to a project,
+	// e.g. clusters or repositories, are denied if projects are set.
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,1,rep,name=projects"`
+	// Resources are the RBAC resources the token is restricted to, e.g. applications or logs
+	Resources []string `json:"resources,omitempty" protobuf:"bytes,2,rep,name=resources"`
+	// Actions are the RBAC actions the token is restricted to, e.g. get or sync
+	Actions []string `json:"actions,omitempty" protobuf:"bytes,3,rep,name=actions"`
+}
+
+// IsEmpty returns true if the scope does not restrict the token
+func (s *TokenScope) IsEmpty() bool {
+	return s == nil || (len(s.Projects) == 0 && len(s.Resources) == 0 && len(s.Actions) == 0)
+}
+
+// IsSubsetOf returns true if every request within the scope is also within the other scope. Since an empty scope does
+// not restrict the token, every scope is a subset of it, while it is only a subset of another empty scope.
+func (s *TokenScope) IsSubsetOf(other *TokenScope) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	if s.IsEmpty() {
+		return false
+	}
+	return isPatternSubset(s.Projects, other.Projects) &&
+		isPatternSubset(s.Resources, other.Resources) &&
+		isPatternSubset(s.Actions, other.Actions)
+}
+
+// isPatternSubset returns true if everything matched by the patterns is matched by the other patterns. To keep this
+// decidable, a pattern is only considered to be covered if it is one of the other patterns, or if it does not contain
+// wildcards and matches one of them.
+func isPatternSubset(patterns []string, others []string) bool {
+	if len(others) == 0 {
+		return true
+	}
+	if len(patterns) == 0 {
+		return false
+	}
+	for _, pattern := range patterns {
+		if slices.Contains(others, pattern) {
+			continue
+		}
+		if strings.ContainsAny(pattern, `*?[]{}\!`) || !glob.MatchStringInList(others, pattern, glob.GLOB) {
+			return false
+		}
+	}
+	return true
 }
 
 // Command holds binary path and arguments list
@@ -65,6 +65,30 @@ func TestAppProject_IsSourcePermitted(t *testing.T) {
 	}
 }
 
+func TestAppProject_IsHelmValuesFromPermitted(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{HelmValuesFromAllowList: []HelmValuesFromAllowListEntry{
+		{Kind: HelmValuesFromKindConfigMap, Namespace: "argocd", Name: "env-*"},
+		{Kind: "*", Namespace: "team-*", Name: "helm-values", Destination: true},
+	}}}
+	testData := []struct {
+		src       HelmValuesFromSource
+		namespace string
+		permitted bool
+	}{
+		{HelmValuesFromSource{Kind: HelmValuesFromKindConfigMap, Name: "env-prod"}, "argocd", true},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindSecret, Name: "env-prod"}, "argocd", false},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindConfigMap, Name: "other"}, "argocd", false},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindConfigMap, Name: "env-prod", Destination: true}, "argocd", false},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindSecret, Name: "helm-values", Destination: true}, "team-a", true},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindSecret, Name: "helm-values"}, "team-a", false},
+		{HelmValuesFromSource{Kind: HelmValuesFromKindSecret, Name: "helm-values", Destination: true}, "default", false},
+	}
+	for _, data := range testData {
+		assert.Equal(t, data.permitted, proj.IsHelmValuesFromPermitted(data.src, data.namespace), "%+v in %s", data.src, data.namespace)
+	}
+	assert.False(t, AppProject{}.IsHelmValuesFromPermitted(HelmValuesFromSource{Kind: HelmValuesFromKindConfigMap, Name: "env-prod"}, "argocd"))
+}
+
 func TestAppProject_IsNegatedSourcePermitted(t *testing.T) {
 	testData := []struct {
 		projSources []string
@@ -600,6 +624,92 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateSignatureKeys tests for invalid signature keys
+func TestAppProject_ValidateSignatureKeys(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SignatureKeys = []SignatureKey{{Cosign: &CosignSignatureKey{Fingerprint: "SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"}}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.SignatureKeys = []SignatureKey{{Cosign: &CosignSignatureKey{Fingerprint: "oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"}}}
+	require.Error(t, p.ValidateProject())
+
+	p.Spec.SignatureKeys = []SignatureKey{{KeyID: "4AEE18F83AFDEB23", Cosign: &CosignSignatureKey{Fingerprint: "SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"}}}
+	require.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ValidateSyncApproval(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncApproval = &SyncApproval{ApplicationSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, Expiration: "12h"}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.SyncApproval.Expiration = "tomorrow"
+	require.ErrorContains(t, p.ValidateProject(), "sync approval has an invalid expiration")
+	p.Spec.SyncApproval.Expiration = "-1h"
+	require.ErrorContains(t, p.ValidateProject(), "sync approval has an invalid expiration")
+
+	p.Spec.SyncApproval.Expiration = ""
+	p.Spec.SyncApproval.ApplicationSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Unknown"}}}
+	require.ErrorContains(t, p.ValidateProject(), "sync approval has an invalid application selector")
+}
+
+func TestOperationApproval_Verify(t *testing.T) {
+	spec := ApplicationSpec{Source: &ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "main"}}
+	specHash, err := spec.Hash()
+	require.NoError(t, err)
+	approval := &OperationApproval{Phase: OperationApprovalApproved, SpecHash: specHash, Revision: "abc"}
+
+	require.NoError(t, approval.Verify(&spec, &SyncOperation{Revision: "abc"}))
+	require.ErrorContains(t, approval.Verify(&spec, &SyncOperation{Revision: "def"}), "revisions of the sync differ")
+	require.ErrorContains(t, approval.Verify(&spec, &SyncOperation{Revision: "abc", Revisions: []string{"abc"}}), "revisions of the sync differ")
+	require.Error(t, approval.Verify(&spec, nil))
+
+	spec.Source.TargetRevision = "other"
+	require.ErrorContains(t, approval.Verify(&spec, &SyncOperation{Revision: "abc"}), "application spec changed")
+}
+
+func TestAppProject_RequiresSyncApproval(t *testing.T) {
+	prod := &Application{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "prod"}}}
+	dev := &Application{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "dev"}}}
+
+	proj := AppProject{}
+	requiresApproval, err := proj.RequiresSyncApproval(prod)
+	require.NoError(t, err)
+	assert.False(t, requiresApproval)
+
+	proj.Spec.SyncApproval = &SyncApproval{ApplicationSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}}
+	requiresApproval, err = proj.RequiresSyncApproval(prod)
+	require.NoError(t, err)
+	assert.True(t, requiresApproval)
+	requiresApproval, err = proj.RequiresSyncApproval(dev)
+	require.NoError(t, err)
+	assert.False(t, requiresApproval)
+
+	// an empty selector selects all applications
+	proj.Spec.SyncApproval = &SyncApproval{}
+	requiresApproval, err = proj.RequiresSyncApproval(dev)
+	require.NoError(t, err)
+	assert.True(t, requiresApproval)
+	expiration, err := proj.Spec.SyncApproval.GetExpiration()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSyncApprovalExpiration, expiration)
+}
+
+func TestOperationApproval(t *testing.T) {
+	now := time.Now()
+	var approval *OperationApproval
+	assert.False(t, approval.IsPending())
+	assert.False(t, approval.IsExpired(now))
+
+	approval = &OperationApproval{Phase: OperationApprovalPending, ExpiresAt: metav1.NewTime(now.Add(time.Minute))}
+	assert.True(t, approval.IsPending())
+	assert.False(t, approval.IsExpired(now))
+	assert.True(t, approval.IsExpired(now.Add(time.Minute)))
+
+	approval.Phase = OperationApprovalApproved
+	assert.False(t, approval.IsPending())
+	assert.False(t, approval.IsExpired(now.Add(time.Hour)))
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -1128,6 +1238,11 @@ func TestRepository_HasCredentials(t *testing.T) {
 			repo: Repository{TLSClientCertData: "foo"},
 			want: true,
 		},
+		{
+			name: "TestHasTokenProviderURL",
+			repo: Repository{TokenProviderURL: "foo"},
+			want: true,
+		},
 		{
 			name: "TestHasInsecureHostKey",
 			repo: Repository{InsecureIgnoreHostKey: true},
@@ -1250,6 +1365,8 @@ func TestRepository_CopyCredentialsFromRepo(t *testing.T) {
 		{"SourceEnableLFS", &Repository{}, &Repository{EnableLFS: true}, Repository{EnableLFS: false}},
 		{"SourceTLSClientCertData", &Repository{}, &Repository{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &Repository{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
+		{"SourceTokenProvider", &Repository{}, &Repository{TokenProviderURL: "foo", TokenProviderClientID: "bar"}, Repository{TokenProviderURL: "foo", TokenProviderClientID: "bar"}},
+		{"TokenProvider", &Repository{TokenProviderURL: "foo"}, &Repository{TokenProviderURL: "bar", TokenProviderScope: "baz"}, Repository{TokenProviderURL: "foo"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1283,6 +1400,7 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 		{"SourceTLSClientCertData", &Repository{}, &RepoCreds{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &RepoCreds{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
 		{"SourceContainsProxy", &Repository{}, &RepoCreds{Proxy: "http://proxy.argoproj.io:3128", NoProxy: ".example.com"}, Repository{Proxy: "http://proxy.argoproj.io:3128", NoProxy: ".example.com"}},
+		{"SourceTokenProvider", &Repository{}, &RepoCreds{TokenProviderURL: "foo", TokenProviderAudience: "bar"}, Repository{TokenProviderURL: "foo", TokenProviderAudience: "bar"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2998,6 +3116,7 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{Timeout: &SyncTimeout{}}).IsZero())
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -3155,6 +3274,37 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestSyncTimeout(t *testing.T) {
+	var nilTimeout *SyncTimeout
+	operation, err := nilTimeout.OperationTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), operation)
+	hook, err := nilTimeout.HookTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), hook)
+
+	timeout := &SyncTimeout{Operation: "90", Hook: "2m"}
+	operation, err = timeout.OperationTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, operation)
+	hook, err = timeout.HookTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, hook)
+
+	_, err = (&SyncTimeout{Operation: "invalid"}).OperationTimeout()
+	require.Error(t, err)
+}
+
+func TestOperationState_CurrentAttemptStartedAt(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	state := &OperationState{StartedAt: startedAt}
+	assert.Equal(t, startedAt, state.CurrentAttemptStartedAt())
+
+	attemptStartedAt := metav1.Now()
+	state.AttemptStartedAt = &attemptStartedAt
+	assert.Equal(t, attemptStartedAt, state.CurrentAttemptStartedAt())
+}
+
 func TestSourceAllowsConcurrentProcessing_KustomizeParams(t *testing.T) {
 	t.Run("Has NameSuffix", func(t *testing.T) {
 		src := ApplicationSource{Path: ".", Kustomize: &ApplicationSourceKustomize{
@@ -4128,3 +4278,31 @@ func TestAppProject_ValidateDestinationServiceAccount(t *testing.T) {
 		}
 	}
 }
+
+func TestApplicationStatus_OldestDrift(t *testing.T) {
+	now := time.Now()
+	status := ApplicationStatus{}
+	assert.Nil(t, status.OldestDrift())
+
+	oldest := &ResourceDrift{StartedAt: metav1.NewTime(now.Add(-time.Hour))}
+	status.Resources = []ResourceStatus{
+		{Kind: "Service", Name: "a", Drift: &ResourceDrift{StartedAt: metav1.NewTime(now.Add(-time.Minute))}},
+		{Kind: "Deployment", Name: "b", Drift: oldest},
+		{Kind: "ConfigMap", Name: "c"},
+	}
+	assert.Same(t, oldest, status.OldestDrift())
+	assert.Equal(t, time.Hour, status.OldestDrift().Age(now))
+}
+
+func TestTokenScope_IsSubsetOf(t *testing.T) {
+	scope := &TokenScope{Projects: []string{"team-*"}, Actions: []string{"get", "sync"}}
+
+	assert.True(t, scope.IsSubsetOf(nil))
+	assert.True(t, scope.IsSubsetOf(scope))
+	assert.True(t, (&TokenScope{Projects: []string{"team-a"}, Actions: []string{"get"}}).IsSubsetOf(scope))
+	assert.True(t, (&TokenScope{Projects: []string{"team-a"}, Resources: []string{"logs"}, Actions: []string{"get"}}).IsSubsetOf(scope))
+	assert.False(t, (*TokenScope)(nil).IsSubsetOf(scope))
+	assert.False(t, (&TokenScope{Projects: []string{"team-a"}}).IsSubsetOf(scope))
+	assert.False(t, (&TokenScope{Projects: []string{"*"}, Actions: []string{"get"}}).IsSubsetOf(scope))
+	assert.False(t, (&TokenScope{Projects: []string{"team-a"}, Actions: []string{"delete"}}).IsSubsetOf(scope))
+}
@@ -144,7 +144,9 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	if in.SignatureKeys != nil {
 		in, out := &in.SignatureKeys, &out.SignatureKeys
 		*out = make([]SignatureKey, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ClusterResourceBlacklist != nil {
 		in, out := &in.ClusterResourceBlacklist, &out.ClusterResourceBlacklist
@@ -161,6 +163,16 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.HelmValuesFromAllowList != nil {
+		in, out := &in.HelmValuesFromAllowList, &out.HelmValuesFromAllowList
+		*out = make([]HelmValuesFromAllowListEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncApproval != nil {
+		in, out := &in.SyncApproval, &out.SyncApproval
+		*out = new(SyncApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1086,6 +1098,21 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(HelmPostRenderer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesFromSource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1522,6 +1549,27 @@ func (in *BasicAuthBitbucketServer) DeepCopy() *BasicAuthBitbucketServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BearerTokenBitbucket) DeepCopyInto(out *BearerTokenBitbucket) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BearerTokenBitbucket.
+func (in *BearerTokenBitbucket) DeepCopy() *BearerTokenBitbucket {
+	if in == nil {
+		return nil
+	}
+	out := new(BearerTokenBitbucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BearerTokenBitbucketCloud) DeepCopyInto(out *BearerTokenBitbucketCloud) {
 	*out = *in
@@ -1846,6 +1894,22 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignSignatureKey) DeepCopyInto(out *CosignSignatureKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignSignatureKey.
+func (in *CosignSignatureKey) DeepCopy() *CosignSignatureKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignSignatureKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 	*out = *in
@@ -2140,6 +2204,80 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderer) DeepCopyInto(out *HelmPostRenderer) {
+	*out = *in
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(HelmPostRendererKustomize)
+		**out = **in
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ApplicationSourcePlugin)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderer.
+func (in *HelmPostRenderer) DeepCopy() *HelmPostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRendererKustomize) DeepCopyInto(out *HelmPostRendererKustomize) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRendererKustomize.
+func (in *HelmPostRendererKustomize) DeepCopy() *HelmPostRendererKustomize {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRendererKustomize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesFromAllowListEntry) DeepCopyInto(out *HelmValuesFromAllowListEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFromAllowListEntry.
+func (in *HelmValuesFromAllowListEntry) DeepCopy() *HelmValuesFromAllowListEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesFromAllowListEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesFromSource) DeepCopyInto(out *HelmValuesFromSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFromSource.
+func (in *HelmValuesFromSource) DeepCopy() *HelmValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2235,6 +2373,11 @@ func (in *InfoItem) DeepCopy() *InfoItem {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JWTToken) DeepCopyInto(out *JWTToken) {
 	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(TokenScope)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2254,7 +2397,9 @@ func (in *JWTTokens) DeepCopyInto(out *JWTTokens) {
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]JWTToken, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2653,6 +2798,11 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		}
 	}
 	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2666,6 +2816,33 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationApproval) DeepCopyInto(out *OperationApproval) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationApproval.
+func (in *OperationApproval) DeepCopy() *OperationApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
 	*out = *in
@@ -2696,6 +2873,10 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.AttemptStartedAt != nil {
+		in, out := &in.AttemptStartedAt, &out.AttemptStartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2929,7 +3110,9 @@ func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	if in.JWTTokens != nil {
 		in, out := &in.JWTTokens, &out.JWTTokens
 		*out = make([]JWTToken, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Groups != nil {
 		in, out := &in.Groups, &out.Groups
@@ -3471,6 +3654,28 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.Managers != nil {
+		in, out := &in.Managers, &out.Managers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3637,6 +3842,10 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -3659,7 +3868,7 @@ func (in ResourceResults) DeepCopyInto(out *ResourceResults) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 		return
@@ -3684,6 +3893,16 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 		*out = new(HealthStatus)
 		**out = **in
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(ResourceDrift)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncPolicy != nil {
+		in, out := &in.SyncPolicy, &out.SyncPolicy
+		*out = new(ResourceSyncPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -3697,6 +3916,22 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSyncPolicy) DeepCopyInto(out *ResourceSyncPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSyncPolicy.
+func (in *ResourceSyncPolicy) DeepCopy() *ResourceSyncPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSyncPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -3903,6 +4138,11 @@ func (in *SCMProviderGeneratorAzureDevOps) DeepCopyInto(out *SCMProviderGenerato
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.TokenProvider != nil {
+		in, out := &in.TokenProvider, &out.TokenProvider
+		*out = new(SCMProviderGeneratorTokenProvider)
+		**out = **in
+	}
 	return
 }
 
@@ -3945,6 +4185,21 @@ func (in *SCMProviderGeneratorBitbucketServer) DeepCopyInto(out *SCMProviderGene
 		*out = new(BasicAuthBitbucketServer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(BearerTokenBitbucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.TokenProvider != nil {
+		in, out := &in.TokenProvider, &out.TokenProvider
+		*out = new(SCMProviderGeneratorTokenProvider)
+		**out = **in
+	}
 	return
 }
 
@@ -4054,6 +4309,16 @@ func (in *SCMProviderGeneratorGitlab) DeepCopyInto(out *SCMProviderGeneratorGitl
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.TokenProvider != nil {
+		in, out := &in.TokenProvider, &out.TokenProvider
+		*out = new(SCMProviderGeneratorTokenProvider)
+		**out = **in
+	}
 	return
 }
 
@@ -4067,6 +4332,76 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCMProviderGeneratorTokenProvider) DeepCopyInto(out *SCMProviderGeneratorTokenProvider) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCMProviderGeneratorTokenProvider.
+func (in *SCMProviderGeneratorTokenProvider) DeepCopy() *SCMProviderGeneratorTokenProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SCMProviderGeneratorTokenProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSignatureKey) DeepCopyInto(out *SSHSignatureKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSignatureKey.
+func (in *SSHSignatureKey) DeepCopy() *SSHSignatureKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSignatureKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSigningKey) DeepCopyInto(out *SSHSigningKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSigningKey.
+func (in *SSHSigningKey) DeepCopy() *SSHSigningKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSigningKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSigningKeyList) DeepCopyInto(out *SSHSigningKeyList) {
+	*out = *in
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHSigningKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSigningKeyList.
+func (in *SSHSigningKeyList) DeepCopy() *SSHSigningKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSigningKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4086,6 +4421,21 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureKey) DeepCopyInto(out *SignatureKey) {
 	*out = *in
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(SSHSignatureKey)
+		**out = **in
+	}
+	if in.Sigstore != nil {
+		in, out := &in.Sigstore, &out.Sigstore
+		*out = new(SigstoreIdentity)
+		**out = **in
+	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignSignatureKey)
+		**out = **in
+	}
 	return
 }
 
@@ -4099,6 +4449,39 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SigstoreIdentity) DeepCopyInto(out *SigstoreIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SigstoreIdentity.
+func (in *SigstoreIdentity) DeepCopy() *SigstoreIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(SigstoreIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncApproval) DeepCopyInto(out *SyncApproval) {
+	*out = *in
+	in.ApplicationSelector.DeepCopyInto(&out.ApplicationSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncApproval.
+func (in *SyncApproval) DeepCopy() *SyncApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4139,6 +4522,11 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(SyncTimeout)
+		**out = **in
+	}
 	return
 }
 
@@ -4178,7 +4566,7 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -4256,6 +4644,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(SyncTimeout)
+		**out = **in
+	}
 	return
 }
 
@@ -4366,6 +4759,22 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncTimeout) DeepCopyInto(out *SyncTimeout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncTimeout.
+func (in *SyncTimeout) DeepCopy() *SyncTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -4469,3 +4878,34 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenScope) DeepCopyInto(out *TokenScope) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenScope.
+func (in *TokenScope) DeepCopy() *TokenScope {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenScope)
+	in.DeepCopyInto(out)
+	return out
+}
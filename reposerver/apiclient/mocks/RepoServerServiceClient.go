@@ -58,6 +58,43 @@ func (_m *RepoServerServiceClient) GenerateManifest(ctx context.Context, in *api
 	return r0, r1
 }
 
+// GenerateManifestStream provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GenerateManifestStream(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateManifestStream")
+	}
+
+	var r0 apiclient.RepoServerService_GenerateManifestStreamClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestStreamClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apiclient.RepoServerService_GenerateManifestStreamClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateManifestWithFiles provides a mock function with given fields: ctx, opts
 func (_m *RepoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestWithFilesClient, error) {
 	_va := make([]interface{}, len(opts))
@@ -63,10 +63,12 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// HelmValuesFrom holds the Helm values read from the ConfigMaps and Secrets referenced by the Helm source, in order
+	HelmValuesFrom       []*HelmValues `protobuf:"bytes,28,rep,name=helmValuesFrom,proto3" json:"helmValuesFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -270,6 +272,70 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetHelmValuesFrom() []*HelmValues {
+	if m != nil {
+		return m.HelmValuesFrom
+	}
+	return nil
+}
+
+// HelmValues holds Helm values read from a ConfigMap or Secret
+type HelmValues struct {
+	Values string `protobuf:"bytes,1,opt,name=values,proto3" json:"values,omitempty"`
+	// Sensitive is true if the values were read from a Secret
+	Sensitive            bool     `protobuf:"varint,2,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HelmValues) Reset()         { *m = HelmValues{} }
+func (m *HelmValues) String() string { return proto.CompactTextString(m) }
+func (*HelmValues) ProtoMessage()    {}
+func (*HelmValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{1}
+}
+func (m *HelmValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HelmValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HelmValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValues.Merge(m, src)
+}
+func (m *HelmValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValues proto.InternalMessageInfo
+
+func (m *HelmValues) GetValues() string {
+	if m != nil {
+		return m.Values
+	}
+	return ""
+}
+
+func (m *HelmValues) GetSensitive() bool {
+	if m != nil {
+		return m.Sensitive
+	}
+	return false
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -285,7 +351,7 @@ func (m *ManifestRequestWithFiles) Reset()         { *m = ManifestRequestWithFil
 func (m *ManifestRequestWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequestWithFiles) ProtoMessage()    {}
 func (*ManifestRequestWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{1}
+	return fileDescriptor_dd8723cfcc820480, []int{2}
 }
 func (m *ManifestRequestWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +451,7 @@ func (m *ManifestFileMetadata) Reset()         { *m = ManifestFileMetadata{} }
 func (m *ManifestFileMetadata) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileMetadata) ProtoMessage()    {}
 func (*ManifestFileMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{2}
+	return fileDescriptor_dd8723cfcc820480, []int{3}
 }
 func (m *ManifestFileMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +505,7 @@ func (m *ManifestFileChunk) Reset()         { *m = ManifestFileChunk{} }
 func (m *ManifestFileChunk) String() string { return proto.CompactTextString(m) }
 func (*ManifestFileChunk) ProtoMessage()    {}
 func (*ManifestFileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{3}
+	return fileDescriptor_dd8723cfcc820480, []int{4}
 }
 func (m *ManifestFileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,6 +541,96 @@ func (m *ManifestFileChunk) GetChunk() []byte {
 	return nil
 }
 
+// ManifestResponseChunk is a part of a streamed ManifestResponse. The first part is the response without its manifests,
+// and the following parts are chunks of the gzip-compressed manifests.
+type ManifestResponseChunk struct {
+	// Types that are valid to be assigned to Part:
+	//	*ManifestResponseChunk_Response
+	//	*ManifestResponseChunk_Chunk
+	Part                 isManifestResponseChunk_Part `protobuf_oneof:"part"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ManifestResponseChunk) Reset()         { *m = ManifestResponseChunk{} }
+func (m *ManifestResponseChunk) String() string { return proto.CompactTextString(m) }
+func (*ManifestResponseChunk) ProtoMessage()    {}
+func (*ManifestResponseChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{5}
+}
+func (m *ManifestResponseChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestResponseChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestResponseChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestResponseChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestResponseChunk.Merge(m, src)
+}
+func (m *ManifestResponseChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestResponseChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestResponseChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestResponseChunk proto.InternalMessageInfo
+
+type isManifestResponseChunk_Part interface {
+	isManifestResponseChunk_Part()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ManifestResponseChunk_Response struct {
+	Response *ManifestResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof" json:"response,omitempty"`
+}
+type ManifestResponseChunk_Chunk struct {
+	Chunk *ManifestFileChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof" json:"chunk,omitempty"`
+}
+
+func (*ManifestResponseChunk_Response) isManifestResponseChunk_Part() {}
+func (*ManifestResponseChunk_Chunk) isManifestResponseChunk_Part()    {}
+
+func (m *ManifestResponseChunk) GetPart() isManifestResponseChunk_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (m *ManifestResponseChunk) GetResponse() *ManifestResponse {
+	if x, ok := m.GetPart().(*ManifestResponseChunk_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (m *ManifestResponseChunk) GetChunk() *ManifestFileChunk {
+	if x, ok := m.GetPart().(*ManifestResponseChunk_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ManifestResponseChunk) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ManifestResponseChunk_Response)(nil),
+		(*ManifestResponseChunk_Chunk)(nil),
+	}
+}
+
 // TestRepositoryRequest is a query to test repository is valid or not and has valid access.
 type TestRepositoryRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -487,7 +643,7 @@ func (m *TestRepositoryRequest) Reset()         { *m = TestRepositoryRequest{} }
 func (m *TestRepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryRequest) ProtoMessage()    {}
 func (*TestRepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{4}
+	return fileDescriptor_dd8723cfcc820480, []int{6}
 }
 func (m *TestRepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +692,7 @@ func (m *TestRepositoryResponse) Reset()         { *m = TestRepositoryResponse{}
 func (m *TestRepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryResponse) ProtoMessage()    {}
 func (*TestRepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{5}
+	return fileDescriptor_dd8723cfcc820480, []int{7}
 }
 func (m *TestRepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +743,7 @@ func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{}
 func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionRequest) ProtoMessage()    {}
 func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +814,7 @@ func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse
 func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionResponse) ProtoMessage()    {}
 func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,10 +864,12 @@ type ManifestResponse struct {
 	// resolved revision
 	Revision   string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	SourceType string `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
-	// Raw response of git verify-commit operation (always the empty string for Helm)
+	// Raw response of git verify-commit operation or of the verification of the signature of a Helm chart
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Program which verified the signature of the revision or chart, whose output is the verifyResult
+	SignatureVerifier    string   `protobuf:"bytes,9,opt,name=signatureVerifier,proto3" json:"signatureVerifier,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -721,7 +879,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{10}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,6 +957,13 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetSignatureVerifier() string {
+	if m != nil {
+		return m.SignatureVerifier
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -810,7 +975,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1024,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1081,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1143,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1190,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1238,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1296,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1418,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1498,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1554,9 @@ type RepoServerRevisionChartDetailsRequest struct {
 	// the chart
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// the revision within the chart
-	Revision             string   `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// whether to check the signature of the chart
+	CheckSignature       bool     `protobuf:"varint,4,opt,name=checkSignature,proto3" json:"checkSignature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1399,7 +1566,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,6 +1616,13 @@ func (m *RepoServerRevisionChartDetailsRequest) GetRevision() string {
 	return ""
 }
 
+func (m *RepoServerRevisionChartDetailsRequest) GetCheckSignature() bool {
+	if m != nil {
+		return m.CheckSignature
+	}
+	return false
+}
+
 // HelmAppSpec contains helm app name  in source repo
 type HelmAppSpec struct {
 	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1468,7 +1642,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1719,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1766,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1826,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +1930,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1977,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2025,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2079,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2132,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2222,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2099,7 +2273,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2349,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +2410,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2382,7 +2556,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2429,9 +2603,11 @@ func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*HelmValues)(nil), "repository.HelmValues")
 	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
 	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
 	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
+	proto.RegisterType((*ManifestResponseChunk)(nil), "repository.ManifestResponseChunk")
 	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
 	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
@@ -2475,156 +2651,163 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
-	0x55, 0xfb, 0x25, 0xed, 0x3e, 0x59, 0x5f, 0x6d, 0x5b, 0x1e, 0xaf, 0x6d, 0xa1, 0x0c, 0xd8, 0xe5,
-	0xd8, 0xc9, 0xaa, 0x2c, 0x57, 0x62, 0x70, 0x42, 0x28, 0x45, 0xb6, 0x25, 0xc7, 0x96, 0x2d, 0xc6,
-	0x4e, 0x28, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xed, 0x76, 0x34, 0x1f, 0xed, 0x99, 0x1e, 0x05, 0xb9,
-	0x8a, 0x0b, 0x50, 0x5c, 0xb8, 0x73, 0xe0, 0xca, 0x6f, 0xa0, 0x38, 0x72, 0xa0, 0x28, 0x38, 0x52,
-	0x5c, 0xb8, 0x50, 0x05, 0xe5, 0x5f, 0x42, 0xf5, 0xc7, 0x7c, 0xee, 0xec, 0x4a, 0x61, 0x6d, 0x05,
-	0xb8, 0x48, 0xd3, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0x7d, 0xf5, 0x7b, 0xdd, 0x0b, 0x57, 0x02, 0xc2,
-	0xfc, 0x90, 0x04, 0x07, 0x24, 0x58, 0x93, 0x9f, 0x94, 0xfb, 0xc1, 0x61, 0xe6, 0xb3, 0xc3, 0x02,
-	0x9f, 0xfb, 0x08, 0x52, 0x48, 0xfb, 0x61, 0x9f, 0xf2, 0x41, 0xd4, 0xed, 0xd8, 0xbe, 0xbb, 0x86,
-	0x83, 0xbe, 0xcf, 0x02, 0xff, 0x73, 0xf9, 0xf1, 0xae, 0xdd, 0x5b, 0x3b, 0x58, 0x5f, 0x63, 0xfb,
-	0xfd, 0x35, 0xcc, 0x68, 0xb8, 0x86, 0x19, 0x73, 0xa8, 0x8d, 0x39, 0xf5, 0xbd, 0xb5, 0x83, 0x1b,
-	0xd8, 0x61, 0x03, 0x7c, 0x63, 0xad, 0x4f, 0x3c, 0x12, 0x60, 0x4e, 0x7a, 0x8a, 0x72, 0xfb, 0x42,
-	0xdf, 0xf7, 0xfb, 0x0e, 0x59, 0x93, 0xa3, 0x6e, 0xb4, 0xb7, 0x46, 0x5c, 0xc6, 0x35, 0x5b, 0xf3,
-	0x1f, 0x73, 0xb0, 0xb0, 0x83, 0x3d, 0xba, 0x47, 0x42, 0x6e, 0x91, 0x17, 0x11, 0x09, 0x39, 0x7a,
-	0x0e, 0x75, 0x21, 0x8c, 0x51, 0x59, 0xad, 0x5c, 0x9d, 0x5d, 0xdf, 0xee, 0xa4, 0xd2, 0x74, 0x62,
-	0x69, 0xe4, 0xc7, 0x8f, 0xed, 0x5e, 0xe7, 0x60, 0xbd, 0xc3, 0xf6, 0xfb, 0x1d, 0x21, 0x4d, 0x27,
-	0x23, 0x4d, 0x27, 0x96, 0xa6, 0x63, 0x25, 0xdb, 0xb2, 0x24, 0x55, 0xd4, 0x86, 0x66, 0x40, 0x0e,
-	0x68, 0x48, 0x7d, 0xcf, 0xa8, 0xae, 0x56, 0xae, 0xb6, 0xac, 0x64, 0x8c, 0x0c, 0x98, 0xf1, 0xfc,
-	0x4d, 0x6c, 0x0f, 0x88, 0x51, 0x5b, 0xad, 0x5c, 0x6d, 0x5a, 0xf1, 0x10, 0xad, 0xc2, 0x2c, 0x66,
-	0xec, 0x21, 0xee, 0x12, 0xe7, 0x01, 0x39, 0x34, 0xea, 0x72, 0x61, 0x16, 0x24, 0xd6, 0x62, 0xc6,
-	0x1e, 0x61, 0x97, 0x18, 0x0d, 0x39, 0x1b, 0x0f, 0xd1, 0x45, 0x68, 0x79, 0xd8, 0x25, 0x21, 0xc3,
-	0x36, 0x31, 0x9a, 0x72, 0x2e, 0x05, 0xa0, 0x9f, 0xc2, 0x52, 0x46, 0xf0, 0x27, 0x7e, 0x14, 0xd8,
-	0xc4, 0x00, 0xb9, 0xf5, 0xc7, 0x93, 0x6d, 0x7d, 0xa3, 0x48, 0xd6, 0x1a, 0xe6, 0x84, 0x7e, 0x04,
-	0x0d, 0x69, 0x79, 0x63, 0x76, 0xb5, 0xf6, 0x5a, 0xb5, 0xad, 0xc8, 0x22, 0x0f, 0x66, 0x98, 0x13,
-	0xf5, 0xa9, 0x17, 0x1a, 0xa7, 0x24, 0x87, 0xa7, 0x93, 0x71, 0xd8, 0xf4, 0xbd, 0x3d, 0xda, 0xdf,
-	0xc1, 0x1e, 0xee, 0x13, 0x97, 0x78, 0x7c, 0x57, 0x12, 0xb7, 0x62, 0x26, 0xe8, 0x25, 0x2c, 0xee,
-	0x47, 0x21, 0xf7, 0x5d, 0xfa, 0x92, 0x3c, 0x66, 0x62, 0x6d, 0x68, 0xcc, 0x49, 0x6d, 0x3e, 0x9a,
-	0x8c, 0xf1, 0x83, 0x02, 0x55, 0x6b, 0x88, 0x8f, 0x70, 0x92, 0xfd, 0xa8, 0x4b, 0x3e, 0x23, 0x81,
-	0xf4, 0xae, 0x79, 0xe5, 0x24, 0x19, 0x90, 0x72, 0x23, 0xaa, 0x47, 0xa1, 0xb1, 0xb0, 0x5a, 0x53,
-	0x6e, 0x94, 0x80, 0xd0, 0x55, 0x58, 0x38, 0x20, 0x01, 0xdd, 0x3b, 0x7c, 0x42, 0xfb, 0x1e, 0xe6,
-	0x51, 0x40, 0x8c, 0x45, 0xe9, 0x8a, 0x45, 0x30, 0x72, 0x61, 0x6e, 0x40, 0x1c, 0x57, 0xa8, 0x7c,
-	0x33, 0x20, 0xbd, 0xd0, 0x58, 0x92, 0xfa, 0xdd, 0x9a, 0xdc, 0x82, 0x92, 0x9c, 0x95, 0xa7, 0x2e,
-	0x04, 0xf3, 0x7c, 0x4b, 0x47, 0x8a, 0x8a, 0x11, 0xa4, 0x04, 0x2b, 0x80, 0xd1, 0x15, 0x98, 0xe7,
-	0x01, 0xb6, 0xf7, 0xa9, 0xd7, 0xdf, 0x21, 0x7c, 0xe0, 0xf7, 0x8c, 0xd3, 0x52, 0x13, 0x05, 0x28,
-	0xb2, 0x01, 0x11, 0x0f, 0x77, 0x1d, 0xd2, 0x53, 0xbe, 0xf8, 0xf4, 0x90, 0x91, 0xd0, 0x38, 0x23,
-	0x77, 0x71, 0xb3, 0x93, 0xc9, 0x50, 0x85, 0x04, 0xd1, 0xb9, 0x3b, 0xb4, 0xea, 0xae, 0xc7, 0x83,
-	0x43, 0xab, 0x84, 0x1c, 0xda, 0x87, 0x59, 0xb1, 0x8f, 0xd8, 0x15, 0xce, 0x4a, 0x57, 0xb8, 0x3f,
-	0x99, 0x8e, 0xb6, 0x53, 0x82, 0x56, 0x96, 0x3a, 0xea, 0x00, 0x1a, 0xe0, 0x70, 0x27, 0x72, 0x38,
-	0x65, 0x0e, 0x51, 0x62, 0x84, 0xc6, 0xb2, 0x54, 0x53, 0xc9, 0x0c, 0x7a, 0x00, 0x10, 0x90, 0xbd,
-	0x18, 0xef, 0x9c, 0xdc, 0xf9, 0xf5, 0x71, 0x3b, 0xb7, 0x12, 0x6c, 0xb5, 0xe3, 0xcc, 0x72, 0xc1,
-	0x5c, 0x6c, 0x83, 0xd8, 0x5c, 0x47, 0xbb, 0x0c, 0x6b, 0x43, 0xba, 0x58, 0xc9, 0x8c, 0xf0, 0x45,
-	0x0d, 0x95, 0x49, 0xeb, 0xbc, 0xf2, 0xd6, 0x0c, 0x08, 0x6d, 0xc3, 0xd7, 0xb0, 0xe7, 0xf9, 0x5c,
-	0x6e, 0x3f, 0x16, 0x65, 0x4b, 0xa7, 0xf7, 0x5d, 0xcc, 0x07, 0xa1, 0xd1, 0x96, 0xab, 0x8e, 0x42,
-	0x13, 0x2e, 0x41, 0xbd, 0x90, 0x63, 0xc7, 0x91, 0x48, 0xf7, 0xef, 0x18, 0x17, 0x94, 0x4b, 0xe4,
-	0xa1, 0xed, 0xbb, 0x70, 0x6e, 0x84, 0x71, 0xd1, 0x22, 0xd4, 0xf6, 0xc9, 0xa1, 0x3c, 0x14, 0x5a,
-	0x96, 0xf8, 0x44, 0x67, 0xa0, 0x71, 0x80, 0x9d, 0x88, 0xc8, 0x34, 0xde, 0xb4, 0xd4, 0xe0, 0x76,
-	0xf5, 0x9b, 0x95, 0xf6, 0x2f, 0x2b, 0xb0, 0x50, 0x50, 0x55, 0xc9, 0xfa, 0x1f, 0x66, 0xd7, 0xbf,
-	0x86, 0xc0, 0xd9, 0x7b, 0x8a, 0x83, 0x3e, 0xe1, 0x19, 0x41, 0xcc, 0xbf, 0x55, 0xc0, 0x28, 0xd8,
-	0xf0, 0x7b, 0x94, 0x0f, 0xee, 0x51, 0x87, 0x84, 0xe8, 0x16, 0xcc, 0x04, 0x0a, 0xa6, 0x8f, 0xba,
-	0x0b, 0x63, 0x4c, 0xbf, 0x3d, 0x65, 0xc5, 0xd8, 0xe8, 0x23, 0x68, 0xba, 0x84, 0xe3, 0x1e, 0xe6,
-	0x58, 0xcb, 0xbe, 0x5a, 0xb6, 0x52, 0x70, 0xd9, 0xd1, 0x78, 0xdb, 0x53, 0x56, 0xb2, 0x06, 0xbd,
-	0x07, 0x0d, 0x7b, 0x10, 0x79, 0xfb, 0xf2, 0x90, 0x9b, 0x5d, 0xbf, 0x34, 0x6a, 0xf1, 0xa6, 0x40,
-	0xda, 0x9e, 0xb2, 0x14, 0xf6, 0xc7, 0xd3, 0x50, 0x67, 0x38, 0xe0, 0xe6, 0x3d, 0x38, 0x53, 0xc6,
-	0x42, 0x9c, 0xac, 0xf6, 0x80, 0xd8, 0xfb, 0x61, 0xe4, 0x6a, 0x35, 0x27, 0x63, 0x84, 0xa0, 0x1e,
-	0xd2, 0x97, 0x4a, 0xd5, 0x35, 0x4b, 0x7e, 0x9b, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x61, 0x54, 0x25,
-	0x9b, 0xa0, 0x70, 0x4a, 0xb3, 0x36, 0x23, 0x38, 0xfb, 0x54, 0xea, 0x22, 0x39, 0x5e, 0x4e, 0xa2,
-	0x56, 0x30, 0xb7, 0x61, 0xb9, 0xc8, 0x36, 0x64, 0xbe, 0x17, 0x12, 0x11, 0x6c, 0x32, 0x1f, 0x53,
-	0xd2, 0x4b, 0x67, 0xa5, 0x14, 0x4d, 0xab, 0x64, 0xc6, 0xfc, 0x6d, 0x15, 0x96, 0x2d, 0x12, 0xfa,
-	0xce, 0x01, 0x89, 0x93, 0xe5, 0xc9, 0x94, 0x3b, 0x3f, 0x80, 0x1a, 0x66, 0x4c, 0xbb, 0xc9, 0xfd,
-	0xd7, 0x56, 0x50, 0x58, 0x82, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xa5, 0xfd, 0xc8, 0x8f, 0xc2,
-	0x78, 0x5b, 0xd2, 0xa9, 0x5a, 0xd6, 0xf0, 0x84, 0x48, 0x38, 0xa1, 0x8c, 0xc8, 0xfb, 0x5e, 0x8f,
-	0xfc, 0x44, 0xd6, 0x50, 0x35, 0x2b, 0x0b, 0x32, 0x6d, 0x38, 0x37, 0xa4, 0x24, 0xad, 0xf0, 0x6c,
-	0xd9, 0x56, 0x29, 0x94, 0x6d, 0xa5, 0x62, 0x54, 0x47, 0x88, 0x61, 0xbe, 0xaa, 0xc0, 0x62, 0x1a,
-	0x5c, 0x9a, 0xfc, 0x45, 0x68, 0xb9, 0x1a, 0x16, 0x1a, 0x15, 0x99, 0x33, 0x53, 0x40, 0xbe, 0x82,
-	0xab, 0x16, 0x2b, 0xb8, 0x65, 0x98, 0x56, 0x05, 0xb6, 0xde, 0xba, 0x1e, 0xe5, 0x44, 0xae, 0x17,
-	0x44, 0x5e, 0x01, 0x08, 0x93, 0x0c, 0x67, 0x4c, 0xcb, 0xd9, 0x0c, 0x04, 0x99, 0x70, 0x4a, 0x9d,
-	0xf7, 0x16, 0x09, 0x23, 0x87, 0x1b, 0x33, 0x12, 0x23, 0x07, 0x93, 0xf1, 0xe6, 0xbb, 0x2e, 0xf6,
-	0x7a, 0xa1, 0xd1, 0x94, 0x22, 0x27, 0x63, 0xd3, 0x87, 0x85, 0x87, 0x54, 0xec, 0x6f, 0x2f, 0x3c,
-	0x99, 0x50, 0x79, 0x1f, 0xea, 0x82, 0x99, 0x10, 0xaa, 0x1b, 0x60, 0xcf, 0x1e, 0x90, 0x58, 0x8f,
-	0xc9, 0x58, 0x24, 0x01, 0x8e, 0xfb, 0xa1, 0x51, 0x95, 0x70, 0xf9, 0x6d, 0xfe, 0xbe, 0xaa, 0x24,
-	0xdd, 0x60, 0x2c, 0xfc, 0xea, 0x1b, 0x80, 0xf2, 0x92, 0xa4, 0x36, 0x5c, 0x92, 0x14, 0x44, 0xfe,
-	0x32, 0x25, 0xc9, 0x6b, 0x3a, 0xe4, 0xcc, 0x08, 0x66, 0x36, 0x18, 0x13, 0x82, 0xa0, 0x1b, 0x50,
-	0xc7, 0x8c, 0x29, 0x85, 0x17, 0xf2, 0xb9, 0x46, 0x11, 0xff, 0xb5, 0x48, 0x12, 0xb5, 0x7d, 0x0b,
-	0x5a, 0x09, 0xe8, 0x28, 0xb6, 0xad, 0x2c, 0xdb, 0x55, 0x00, 0x55, 0x73, 0xdf, 0xf7, 0xf6, 0x7c,
-	0x61, 0x52, 0x11, 0x08, 0x7a, 0xa9, 0xfc, 0x36, 0x6f, 0xc7, 0x18, 0x52, 0xb6, 0x77, 0xa0, 0x41,
-	0x39, 0x71, 0x63, 0xe1, 0x96, 0xb3, 0xc2, 0xa5, 0x84, 0x2c, 0x85, 0x64, 0xfe, 0xb9, 0x09, 0xe7,
-	0x85, 0xc5, 0x9e, 0xc8, 0x10, 0xda, 0x60, 0xec, 0x0e, 0xe1, 0x98, 0x3a, 0xe1, 0x77, 0x23, 0x12,
-	0x1c, 0xbe, 0x61, 0xc7, 0xe8, 0xc3, 0xb4, 0x8a, 0x40, 0x9d, 0x2d, 0x5f, 0x7b, 0xfb, 0xa5, 0xc9,
-	0xa7, 0x3d, 0x57, 0xed, 0xcd, 0xf4, 0x5c, 0x65, 0x3d, 0x50, 0xfd, 0x84, 0x7a, 0xa0, 0xd1, 0x6d,
-	0x70, 0xa6, 0xb9, 0x9e, 0xce, 0x37, 0xd7, 0x25, 0xad, 0xc5, 0xcc, 0x71, 0x5b, 0x8b, 0x66, 0x69,
-	0x6b, 0xe1, 0x96, 0xc6, 0x71, 0x4b, 0xaa, 0xfb, 0xdb, 0x59, 0x0f, 0x1c, 0xe9, 0x6b, 0x93, 0x34,
-	0x19, 0xf0, 0x46, 0x9b, 0x8c, 0x4f, 0x73, 0x4d, 0x83, 0x6a, 0xdb, 0xdf, 0x3b, 0xde, 0x9e, 0xc6,
-	0xb4, 0x0f, 0xff, 0x77, 0xa5, 0xf7, 0x2f, 0x64, 0xc5, 0xc5, 0xfc, 0x54, 0x07, 0xc9, 0x61, 0x2f,
-	0xce, 0x21, 0x71, 0xec, 0xea, 0xa4, 0x25, 0xbe, 0xd1, 0x75, 0xa8, 0x0b, 0x25, 0xeb, 0x92, 0xf8,
-	0x5c, 0x56, 0x9f, 0xc2, 0x12, 0x1b, 0x8c, 0x3d, 0x61, 0xc4, 0xb6, 0x24, 0x12, 0xba, 0x0d, 0xad,
-	0xc4, 0xf1, 0x75, 0x64, 0x5d, 0xcc, 0xae, 0x48, 0xe2, 0x24, 0x5e, 0x96, 0xa2, 0x8b, 0xb5, 0x3d,
-	0x1a, 0x10, 0x5b, 0x16, 0x8c, 0x8d, 0xe1, 0xb5, 0x77, 0xe2, 0xc9, 0x64, 0x6d, 0x82, 0x8e, 0x6e,
-	0xc0, 0xb4, 0xba, 0xe7, 0x90, 0x11, 0x34, 0xbb, 0x7e, 0x7e, 0x38, 0x99, 0xc6, 0xab, 0x34, 0xa2,
-	0xf9, 0xa7, 0x0a, 0xbc, 0x95, 0x3a, 0x44, 0x1c, 0x4d, 0x71, 0xcd, 0xfe, 0xd5, 0x9f, 0xb8, 0x57,
-	0x60, 0x5e, 0x36, 0x09, 0xe9, 0x75, 0x87, 0xba, 0x79, 0x2b, 0x40, 0xcd, 0xdf, 0x55, 0xe0, 0xf2,
-	0xf0, 0x3e, 0x36, 0x07, 0x38, 0xe0, 0x89, 0x79, 0x4f, 0x62, 0x2f, 0xf1, 0x81, 0x57, 0x4d, 0x0f,
-	0xbc, 0xdc, 0xfe, 0x6a, 0xf9, 0xfd, 0x99, 0x7f, 0xa8, 0xc2, 0x6c, 0xc6, 0x81, 0xca, 0x0e, 0x4c,
-	0x51, 0x0c, 0x4a, 0xbf, 0x95, 0x6d, 0xa1, 0x3c, 0x14, 0x5a, 0x56, 0x06, 0x82, 0xf6, 0x01, 0x18,
-	0x0e, 0xb0, 0x4b, 0x38, 0x09, 0x44, 0x26, 0x17, 0x11, 0xff, 0x60, 0xf2, 0xec, 0xb2, 0x1b, 0xd3,
-	0xb4, 0x32, 0xe4, 0x45, 0x35, 0x2b, 0x59, 0x87, 0x3a, 0x7f, 0xeb, 0x11, 0xfa, 0x02, 0xe6, 0xf7,
-	0xa8, 0x43, 0x76, 0x53, 0x41, 0xa6, 0xa5, 0x20, 0x8f, 0x27, 0x17, 0xe4, 0x5e, 0x96, 0xae, 0x55,
-	0x60, 0x63, 0x5e, 0x83, 0xc5, 0x62, 0x3c, 0x09, 0x21, 0xa9, 0x8b, 0xfb, 0x89, 0xb6, 0xf4, 0xc8,
-	0x44, 0xb0, 0x58, 0x8c, 0x1f, 0xf3, 0x9f, 0x55, 0x38, 0x9b, 0x90, 0xdb, 0xf0, 0x3c, 0x3f, 0xf2,
-	0x6c, 0x79, 0x75, 0x58, 0x6a, 0x8b, 0x33, 0xd0, 0xe0, 0x94, 0x3b, 0x49, 0xe1, 0x23, 0x07, 0xe2,
-	0xec, 0xe2, 0xbe, 0xef, 0x70, 0xca, 0xb4, 0x81, 0xe3, 0xa1, 0xb2, 0xfd, 0x8b, 0x88, 0x06, 0xa4,
-	0x27, 0x33, 0x41, 0xd3, 0x4a, 0xc6, 0x62, 0x4e, 0x54, 0x35, 0xb2, 0xc4, 0x57, 0xca, 0x4c, 0xc6,
-	0xd2, 0xef, 0x7d, 0xc7, 0x21, 0xb6, 0x50, 0x47, 0xa6, 0x09, 0x28, 0x40, 0x65, 0x73, 0xc1, 0x03,
-	0xea, 0xf5, 0x75, 0x0b, 0xa0, 0x47, 0x42, 0x4e, 0x1c, 0x04, 0xf8, 0x50, 0x57, 0xfe, 0x6a, 0x80,
-	0x3e, 0x84, 0x9a, 0x8b, 0x99, 0x3e, 0xe8, 0xae, 0xe5, 0xb2, 0x43, 0x99, 0x06, 0x3a, 0x3b, 0x98,
-	0xa9, 0x93, 0x40, 0x2c, 0x6b, 0xbf, 0x0f, 0xcd, 0x18, 0xf0, 0xa5, 0x4a, 0xc2, 0xcf, 0x61, 0x2e,
-	0x97, 0x7c, 0xd0, 0x33, 0x58, 0x4e, 0x3d, 0x2a, 0xcb, 0x50, 0x17, 0x81, 0x6f, 0x1d, 0x29, 0x99,
-	0x35, 0x82, 0x80, 0xf9, 0x02, 0x96, 0x84, 0xcb, 0xc8, 0xc0, 0x3f, 0xa1, 0xd6, 0xe6, 0x03, 0x68,
-	0x25, 0x2c, 0x4b, 0x7d, 0xa6, 0x0d, 0xcd, 0x83, 0xf8, 0x4a, 0x57, 0xf5, 0x36, 0xc9, 0xd8, 0xdc,
-	0x00, 0x94, 0x95, 0x57, 0x9f, 0x40, 0xd7, 0xf3, 0x45, 0xf1, 0xd9, 0xe2, 0x71, 0x23, 0xd1, 0xe3,
-	0x9a, 0xf8, 0xef, 0x55, 0x58, 0xd8, 0xa2, 0xf2, 0x8e, 0xe4, 0x84, 0x92, 0xdc, 0x35, 0x58, 0x0c,
-	0xa3, 0xae, 0xeb, 0xf7, 0x22, 0x87, 0xe8, 0xa2, 0x40, 0x9f, 0xf4, 0x43, 0xf0, 0x71, 0xc9, 0x4f,
-	0x28, 0x8b, 0x61, 0x3e, 0xd0, 0xdd, 0xaf, 0xfc, 0x46, 0x1f, 0xc2, 0xf9, 0x47, 0xe4, 0x0b, 0xbd,
-	0x9f, 0x2d, 0xc7, 0xef, 0x76, 0xa9, 0xd7, 0x8f, 0x99, 0x34, 0x24, 0x93, 0xd1, 0x08, 0x65, 0xa5,
-	0xe2, 0x74, 0x79, 0xa9, 0x98, 0x74, 0xd0, 0x9b, 0xbe, 0xeb, 0x52, 0xae, 0x2b, 0xca, 0x1c, 0xcc,
-	0xfc, 0x79, 0x05, 0x16, 0x53, 0xcd, 0x6a, 0xdb, 0xdc, 0x52, 0x31, 0xa4, 0x2c, 0x73, 0x39, 0x6b,
-	0x99, 0x22, 0xea, 0x7f, 0x1e, 0x3e, 0xa7, 0xb2, 0xe1, 0xf3, 0xab, 0x2a, 0x9c, 0xdd, 0xa2, 0x3c,
-	0x4e, 0x5c, 0xf4, 0x7f, 0xcd, 0xca, 0x25, 0x36, 0xa9, 0x1f, 0xcf, 0x26, 0x8d, 0x12, 0x9b, 0x74,
-	0x60, 0xb9, 0xa8, 0x0c, 0x6d, 0x98, 0x33, 0xd0, 0x60, 0xf2, 0xd2, 0x59, 0xdd, 0x2b, 0xa8, 0x81,
-	0xf9, 0xb3, 0x19, 0xb8, 0xf4, 0x29, 0xeb, 0x61, 0x9e, 0xdc, 0x19, 0xdd, 0xf3, 0x03, 0x79, 0xeb,
-	0x7c, 0x32, 0x5a, 0x2c, 0xbc, 0x0c, 0x56, 0xc7, 0xbe, 0x0c, 0xd6, 0xc6, 0xbc, 0x0c, 0xd6, 0x8f,
-	0xf5, 0x32, 0xd8, 0x38, 0xb1, 0x97, 0xc1, 0xe1, 0x5e, 0x6b, 0xba, 0xb4, 0xd7, 0x7a, 0x96, 0xeb,
-	0x47, 0x66, 0x64, 0xd8, 0x7c, 0x2b, 0x1b, 0x36, 0x63, 0xad, 0x33, 0xf6, 0x49, 0xa3, 0xf0, 0xa0,
-	0xd6, 0x3c, 0xf2, 0x41, 0xad, 0x35, 0xfc, 0xa0, 0x56, 0xfe, 0x26, 0x03, 0x23, 0xdf, 0x64, 0xae,
-	0xc0, 0x7c, 0x78, 0xe8, 0xd9, 0xa4, 0x97, 0xdc, 0x24, 0xce, 0xaa, 0x6d, 0xe7, 0xa1, 0xb9, 0x88,
-	0x38, 0x55, 0x88, 0x88, 0xc4, 0x53, 0xe7, 0x32, 0x9e, 0x5a, 0x16, 0x27, 0xf3, 0x23, 0xdb, 0xdc,
-	0xc2, 0x73, 0xc9, 0x42, 0xe9, 0x73, 0xc9, 0x7f, 0x4d, 0xb3, 0xf5, 0x19, 0xac, 0x8c, 0xb2, 0xb2,
-	0x0e, 0x5e, 0x03, 0x66, 0xec, 0x01, 0xf6, 0xfa, 0xf2, 0x5a, 0x50, 0x76, 0xff, 0x7a, 0x38, 0xae,
-	0x3b, 0x58, 0xff, 0x23, 0xc0, 0x52, 0x5a, 0xf5, 0x8b, 0xbf, 0xd4, 0x26, 0xe8, 0x31, 0x2c, 0xc6,
-	0xcf, 0x4b, 0xf1, 0x45, 0x2e, 0x1a, 0xf7, 0x76, 0xd2, 0xbe, 0x58, 0x3e, 0xa9, 0x44, 0x33, 0xa7,
-	0x90, 0x0d, 0xe7, 0x8b, 0x04, 0xd3, 0x67, 0x9a, 0x6f, 0x8c, 0xa1, 0x9c, 0x60, 0x1d, 0xc5, 0xe2,
-	0x6a, 0x05, 0x3d, 0x83, 0xf9, 0xfc, 0x63, 0x02, 0xca, 0x95, 0x41, 0xa5, 0xef, 0x1b, 0x6d, 0x73,
-	0x1c, 0x4a, 0x22, 0xff, 0x73, 0xe1, 0x06, 0xb9, 0x7b, 0x73, 0x64, 0xe6, 0x6f, 0x04, 0xca, 0x5e,
-	0x1e, 0xda, 0x5f, 0x1f, 0x8b, 0x93, 0x50, 0xff, 0x00, 0x9a, 0xf1, 0x5d, 0x72, 0x5e, 0xcd, 0x85,
-	0x1b, 0xe6, 0xf6, 0x62, 0x9e, 0xde, 0x5e, 0x68, 0x4e, 0xa1, 0x8f, 0xd4, 0xe2, 0x0d, 0xc6, 0x4a,
-	0x16, 0x67, 0x6e, 0x50, 0xdb, 0xa7, 0x4b, 0x6e, 0x2d, 0xcd, 0x29, 0xf4, 0x1d, 0x98, 0x15, 0x5f,
-	0xbb, 0xfa, 0x79, 0x7f, 0xb9, 0xa3, 0x7e, 0x4d, 0xd2, 0x89, 0x7f, 0x4d, 0xd2, 0xb9, 0xeb, 0x32,
-	0x7e, 0xd8, 0x2e, 0xb9, 0x56, 0xd4, 0x04, 0x9e, 0xc3, 0xdc, 0x16, 0xe1, 0xe9, 0x2d, 0x00, 0xba,
-	0x7c, 0xac, 0xbb, 0x92, 0xb6, 0x59, 0x44, 0x1b, 0xbe, 0x48, 0x30, 0xa7, 0xd0, 0xaf, 0x2b, 0x70,
-	0x7a, 0x8b, 0xf0, 0x62, 0x5f, 0x8d, 0xde, 0x2d, 0x67, 0x32, 0xa2, 0xff, 0x6e, 0x3f, 0x9a, 0x34,
-	0x26, 0xf3, 0x64, 0xcd, 0x29, 0xf4, 0x9b, 0x0a, 0x9c, 0xcb, 0x08, 0x96, 0x6d, 0x94, 0xd1, 0x8d,
-	0xf1, 0xc2, 0x95, 0x34, 0xd5, 0xed, 0x4f, 0x26, 0xfc, 0xd5, 0x46, 0x86, 0xa4, 0x39, 0x85, 0x76,
-	0xa5, 0x4d, 0xd2, 0xba, 0x18, 0x5d, 0x2a, 0x2d, 0x80, 0x13, 0xee, 0x2b, 0xa3, 0xa6, 0x13, 0x3b,
-	0x7c, 0x02, 0xb3, 0x5b, 0x84, 0xc7, 0x05, 0x5a, 0xde, 0xd3, 0x0a, 0xb5, 0x73, 0x3e, 0x54, 0x8b,
-	0x35, 0x9d, 0xf4, 0x98, 0x25, 0x45, 0x2b, 0x53, 0x84, 0xe4, 0x63, 0xb5, 0xb4, 0x5a, 0xcb, 0x7b,
-	0x4c, 0x79, 0x0d, 0x63, 0x4e, 0xa1, 0x17, 0xb0, 0x5c, 0x9e, 0x2a, 0xd1, 0xdb, 0xc7, 0x3e, 0x34,
-	0xdb, 0xd7, 0x8e, 0x83, 0x1a, 0xb3, 0xfc, 0x78, 0xe3, 0x2f, 0xaf, 0x56, 0x2a, 0x7f, 0x7d, 0xb5,
-	0x52, 0xf9, 0xd7, 0xab, 0x95, 0xca, 0xf7, 0x6f, 0x1e, 0xf1, 0xeb, 0xae, 0xcc, 0x0f, 0xc6, 0x30,
-	0xa3, 0xb6, 0x43, 0x89, 0xc7, 0xbb, 0xd3, 0x32, 0xde, 0x6e, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xa5, 0xe0, 0xaa, 0x70, 0x4f, 0x26, 0x00, 0x00,
+	// 2482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
+	0x51, 0xf3, 0xd4, 0x4c, 0xca, 0xd6, 0xa3, 0xd6, 0x1a, 0xb7, 0xdb, 0xb6, 0x90, 0x1b, 0xec, 0xf0,
+	0xda, 0xbb, 0x23, 0x2c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0x21, 0xcb, 0xb6, 0xe4, 0xb5, 0x65, 0x8b,
+	0xb6, 0xd7, 0x84, 0xc1, 0x40, 0xd4, 0xf4, 0x94, 0x66, 0x7a, 0xd5, 0x2f, 0x77, 0x55, 0x6b, 0x91,
+	0x23, 0xb8, 0x00, 0xc1, 0x85, 0x0b, 0xc1, 0x81, 0x03, 0xc1, 0x85, 0xe0, 0x23, 0x38, 0x72, 0x22,
+	0xe0, 0x48, 0x70, 0xe1, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0xda, 0x95,
+	0xad, 0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x56, 0x66, 0x56, 0x66, 0xe5, 0xa3, 0x06, 0x2e, 0x85,
+	0x24, 0xf0, 0x29, 0x09, 0xf7, 0x48, 0xb8, 0x22, 0x3e, 0x6d, 0xe6, 0x87, 0xfb, 0x99, 0xcf, 0x6e,
+	0x10, 0xfa, 0xcc, 0x47, 0x90, 0x42, 0xf4, 0x07, 0x03, 0x9b, 0x0d, 0xa3, 0x5e, 0xd7, 0xf2, 0xdd,
+	0x15, 0x1c, 0x0e, 0xfc, 0x20, 0xf4, 0x3f, 0x15, 0x1f, 0xef, 0x5a, 0xfd, 0x95, 0xbd, 0xd5, 0x95,
+	0x60, 0x77, 0xb0, 0x82, 0x03, 0x9b, 0xae, 0xe0, 0x20, 0x70, 0x6c, 0x0b, 0x33, 0xdb, 0xf7, 0x56,
+	0xf6, 0xae, 0x61, 0x27, 0x18, 0xe2, 0x6b, 0x2b, 0x03, 0xe2, 0x91, 0x10, 0x33, 0xd2, 0x97, 0x94,
+	0xf5, 0xb3, 0x03, 0xdf, 0x1f, 0x38, 0x64, 0x45, 0x8c, 0x7a, 0xd1, 0xce, 0x0a, 0x71, 0x03, 0xa6,
+	0xb6, 0x35, 0x7e, 0x37, 0x0b, 0x73, 0x5b, 0xd8, 0xb3, 0x77, 0x08, 0x65, 0x26, 0x79, 0x11, 0x11,
+	0xca, 0xd0, 0x73, 0xa8, 0x73, 0x66, 0xb4, 0xca, 0x72, 0xe5, 0xf2, 0xcc, 0xea, 0x66, 0x37, 0xe5,
+	0xa6, 0x1b, 0x73, 0x23, 0x3e, 0x7e, 0x64, 0xf5, 0xbb, 0x7b, 0xab, 0xdd, 0x60, 0x77, 0xd0, 0xe5,
+	0xdc, 0x74, 0x33, 0xdc, 0x74, 0x63, 0x6e, 0xba, 0x66, 0x22, 0x96, 0x29, 0xa8, 0x22, 0x1d, 0x5a,
+	0x21, 0xd9, 0xb3, 0xa9, 0xed, 0x7b, 0x5a, 0x75, 0xb9, 0x72, 0xb9, 0x6d, 0x26, 0x63, 0xa4, 0xc1,
+	0xb4, 0xe7, 0xaf, 0x63, 0x6b, 0x48, 0xb4, 0xda, 0x72, 0xe5, 0x72, 0xcb, 0x8c, 0x87, 0x68, 0x19,
+	0x66, 0x70, 0x10, 0x3c, 0xc0, 0x3d, 0xe2, 0xdc, 0x27, 0xfb, 0x5a, 0x5d, 0x2c, 0xcc, 0x82, 0xf8,
+	0x5a, 0x1c, 0x04, 0x0f, 0xb1, 0x4b, 0xb4, 0x86, 0x98, 0x8d, 0x87, 0xe8, 0x1c, 0xb4, 0x3d, 0xec,
+	0x12, 0x1a, 0x60, 0x8b, 0x68, 0x2d, 0x31, 0x97, 0x02, 0xd0, 0x4f, 0x60, 0x21, 0xc3, 0xf8, 0x63,
+	0x3f, 0x0a, 0x2d, 0xa2, 0x81, 0x10, 0xfd, 0xd1, 0xd1, 0x44, 0x5f, 0x2b, 0x92, 0x35, 0x47, 0x77,
+	0x42, 0x3f, 0x84, 0x86, 0x38, 0x79, 0x6d, 0x66, 0xb9, 0xf6, 0x5a, 0xb5, 0x2d, 0xc9, 0x22, 0x0f,
+	0xa6, 0x03, 0x27, 0x1a, 0xd8, 0x1e, 0xd5, 0x4e, 0x88, 0x1d, 0x9e, 0x1c, 0x6d, 0x87, 0x75, 0xdf,
+	0xdb, 0xb1, 0x07, 0x5b, 0xd8, 0xc3, 0x03, 0xe2, 0x12, 0x8f, 0x6d, 0x0b, 0xe2, 0x66, 0xbc, 0x09,
+	0x7a, 0x09, 0xf3, 0xbb, 0x11, 0x65, 0xbe, 0x6b, 0xbf, 0x24, 0x8f, 0x02, 0xbe, 0x96, 0x6a, 0x27,
+	0x85, 0x36, 0x1f, 0x1e, 0x6d, 0xe3, 0xfb, 0x05, 0xaa, 0xe6, 0xc8, 0x3e, 0xdc, 0x48, 0x76, 0xa3,
+	0x1e, 0x79, 0x4a, 0x42, 0x61, 0x5d, 0xb3, 0xd2, 0x48, 0x32, 0x20, 0x69, 0x46, 0xb6, 0x1a, 0x51,
+	0x6d, 0x6e, 0xb9, 0x26, 0xcd, 0x28, 0x01, 0xa1, 0xcb, 0x30, 0xb7, 0x47, 0x42, 0x7b, 0x67, 0xff,
+	0xb1, 0x3d, 0xf0, 0x30, 0x8b, 0x42, 0xa2, 0xcd, 0x0b, 0x53, 0x2c, 0x82, 0x91, 0x0b, 0x27, 0x87,
+	0xc4, 0x71, 0xb9, 0xca, 0xd7, 0x43, 0xd2, 0xa7, 0xda, 0x82, 0xd0, 0xef, 0xc6, 0xd1, 0x4f, 0x50,
+	0x90, 0x33, 0xf3, 0xd4, 0x39, 0x63, 0x9e, 0x6f, 0x2a, 0x4f, 0x91, 0x3e, 0x82, 0x24, 0x63, 0x05,
+	0x30, 0xba, 0x04, 0xb3, 0x2c, 0xc4, 0xd6, 0xae, 0xed, 0x0d, 0xb6, 0x08, 0x1b, 0xfa, 0x7d, 0xed,
+	0x2d, 0xa1, 0x89, 0x02, 0x14, 0x59, 0x80, 0x88, 0x87, 0x7b, 0x0e, 0xe9, 0x4b, 0x5b, 0x7c, 0xb2,
+	0x1f, 0x10, 0xaa, 0x9d, 0x12, 0x52, 0x5c, 0xef, 0x66, 0x6e, 0xa8, 0xc2, 0x05, 0xd1, 0xbd, 0x33,
+	0xb2, 0xea, 0x8e, 0xc7, 0xc2, 0x7d, 0xb3, 0x84, 0x1c, 0xda, 0x85, 0x19, 0x2e, 0x47, 0x6c, 0x0a,
+	0x8b, 0xc2, 0x14, 0xee, 0x1d, 0x4d, 0x47, 0x9b, 0x29, 0x41, 0x33, 0x4b, 0x1d, 0x75, 0x01, 0x0d,
+	0x31, 0xdd, 0x8a, 0x1c, 0x66, 0x07, 0x0e, 0x91, 0x6c, 0x50, 0xad, 0x23, 0xd4, 0x54, 0x32, 0x83,
+	0xee, 0x03, 0x84, 0x64, 0x27, 0xc6, 0x3b, 0x2d, 0x24, 0xbf, 0x3a, 0x49, 0x72, 0x33, 0xc1, 0x96,
+	0x12, 0x67, 0x96, 0xf3, 0xcd, 0xb9, 0x18, 0xc4, 0x62, 0x12, 0x22, 0x7c, 0x51, 0xd3, 0x84, 0x89,
+	0x95, 0xcc, 0x70, 0x5b, 0x54, 0x50, 0x71, 0x69, 0x9d, 0x91, 0xd6, 0x9a, 0x01, 0xa1, 0x4d, 0xf8,
+	0x0a, 0xf6, 0x3c, 0x9f, 0x09, 0xf1, 0x63, 0x56, 0x36, 0xd4, 0xf5, 0xbe, 0x8d, 0xd9, 0x90, 0x6a,
+	0xba, 0x58, 0x75, 0x10, 0x1a, 0x37, 0x09, 0xdb, 0xa3, 0x0c, 0x3b, 0x8e, 0x40, 0xba, 0x77, 0x5b,
+	0x3b, 0x2b, 0x4d, 0x22, 0x0f, 0x45, 0x1f, 0xc1, 0x2c, 0xd7, 0xe7, 0x53, 0xec, 0x44, 0x84, 0xde,
+	0x0d, 0x7d, 0x57, 0x3b, 0x27, 0x94, 0xd2, 0xc9, 0x2a, 0x65, 0x33, 0xc1, 0x30, 0x0b, 0xd8, 0xfa,
+	0x1d, 0x38, 0x3d, 0xc6, 0x38, 0xd0, 0x3c, 0xd4, 0x76, 0xc9, 0xbe, 0x08, 0x2a, 0x6d, 0x93, 0x7f,
+	0xa2, 0x53, 0xd0, 0xd8, 0xe3, 0x4b, 0x45, 0x18, 0x68, 0x99, 0x72, 0x70, 0xb3, 0xfa, 0x8d, 0x8a,
+	0xfe, 0x8b, 0x0a, 0xcc, 0x15, 0x54, 0x5d, 0xb2, 0xfe, 0x07, 0xd9, 0xf5, 0xaf, 0xc1, 0xf1, 0x76,
+	0x9e, 0xe0, 0x70, 0x40, 0x58, 0x86, 0x11, 0xe3, 0x16, 0x40, 0x2a, 0x2d, 0xea, 0x40, 0x53, 0x4c,
+	0x51, 0xc5, 0x85, 0x1a, 0xf1, 0x00, 0x43, 0x89, 0x47, 0x6d, 0x66, 0xef, 0xc5, 0xc2, 0xa4, 0x00,
+	0xe3, 0xef, 0x15, 0xd0, 0x0a, 0x76, 0xf4, 0x5d, 0x9b, 0x0d, 0xef, 0xda, 0x0e, 0xa1, 0xe8, 0x06,
+	0x4c, 0x87, 0x12, 0xa6, 0xc2, 0xed, 0xd9, 0x09, 0xe6, 0xb7, 0x39, 0x65, 0xc6, 0xd8, 0xe8, 0x23,
+	0x68, 0xb9, 0x84, 0xe1, 0x3e, 0x66, 0x58, 0xc9, 0xbf, 0x5c, 0xb6, 0x92, 0xef, 0xb2, 0xa5, 0xf0,
+	0x36, 0xa7, 0xcc, 0x64, 0x0d, 0x7a, 0x0f, 0x1a, 0xd6, 0x30, 0xf2, 0x76, 0x45, 0xa0, 0x9d, 0x59,
+	0x3d, 0x3f, 0x6e, 0xf1, 0x3a, 0x47, 0xda, 0x9c, 0x32, 0x25, 0xf6, 0xad, 0x26, 0xd4, 0x03, 0x1c,
+	0x32, 0xe3, 0x2e, 0x9c, 0x2a, 0xdb, 0x82, 0x47, 0x77, 0x6b, 0x48, 0xac, 0x5d, 0x1a, 0xb9, 0x4a,
+	0x49, 0xc9, 0x18, 0x21, 0xa8, 0x53, 0xfb, 0xa5, 0xd4, 0x50, 0xcd, 0x14, 0xdf, 0xc6, 0xdb, 0xb0,
+	0x30, 0xb2, 0x1b, 0x37, 0x0c, 0xc9, 0x1b, 0xa7, 0x70, 0x42, 0x6d, 0x6d, 0xfc, 0xba, 0x02, 0x8b,
+	0xa9, 0x42, 0x68, 0xe0, 0x7b, 0x54, 0xe1, 0xdf, 0xe4, 0x29, 0x85, 0x04, 0x28, 0x2d, 0x9e, 0x2b,
+	0xd7, 0xa2, 0xc4, 0xe1, 0x7a, 0x88, 0xf1, 0x53, 0x3d, 0x54, 0xbf, 0x90, 0x1e, 0x22, 0x58, 0x7c,
+	0x22, 0x48, 0xc7, 0x8b, 0x8e, 0x25, 0x89, 0x32, 0x36, 0xa1, 0x53, 0xdc, 0x56, 0xc9, 0xd3, 0x05,
+	0x24, 0x02, 0x95, 0x4d, 0xfa, 0xe9, 0xac, 0xe0, 0xa2, 0x65, 0x96, 0xcc, 0x18, 0x7f, 0xa8, 0x42,
+	0xc7, 0x24, 0xd4, 0x77, 0xf6, 0x48, 0x1c, 0x45, 0x8e, 0x27, 0x0f, 0xfc, 0x3e, 0xd4, 0x70, 0x10,
+	0x68, 0xd5, 0xd7, 0x11, 0x10, 0x32, 0x99, 0x96, 0xc9, 0xa9, 0xa2, 0x77, 0x60, 0x01, 0xbb, 0x3d,
+	0x7b, 0x10, 0xf9, 0x11, 0x8d, 0xc5, 0x12, 0x96, 0xde, 0x36, 0x47, 0x27, 0xf8, 0x4d, 0x4c, 0xc5,
+	0x55, 0x73, 0xcf, 0xeb, 0x93, 0x1f, 0x8b, 0xe4, 0xb2, 0x66, 0x66, 0x41, 0x86, 0x05, 0xa7, 0x47,
+	0x94, 0xa4, 0x14, 0x9e, 0xcd, 0x67, 0x2b, 0x85, 0x7c, 0xb6, 0x94, 0x8d, 0xea, 0x18, 0x36, 0x8c,
+	0x5f, 0x55, 0x61, 0xbe, 0x68, 0xab, 0xfc, 0x6e, 0x71, 0x15, 0x8c, 0x5f, 0x3b, 0x3c, 0x98, 0xa4,
+	0x80, 0x7c, 0x6a, 0x5b, 0x2d, 0xa6, 0xb6, 0x1d, 0x68, 0xca, 0xca, 0x43, 0x89, 0xae, 0x46, 0x39,
+	0x96, 0xeb, 0x05, 0x96, 0x97, 0x00, 0x68, 0x72, 0x75, 0x6b, 0x4d, 0x31, 0x9b, 0x81, 0x20, 0x03,
+	0x4e, 0xc8, 0x44, 0xc8, 0x24, 0x34, 0x72, 0x98, 0x36, 0x2d, 0x30, 0x72, 0x30, 0x71, 0x09, 0xf8,
+	0xae, 0x8b, 0xbd, 0x3e, 0xd5, 0x5a, 0x82, 0xe5, 0x64, 0xcc, 0x55, 0x42, 0xe3, 0x14, 0xea, 0xa9,
+	0x34, 0xc7, 0x50, 0x6b, 0x4b, 0x95, 0x8c, 0x4c, 0x18, 0x3e, 0xcc, 0x3d, 0xb0, 0xb9, 0x36, 0x76,
+	0xe8, 0xf1, 0x38, 0xd6, 0xfb, 0x50, 0xe7, 0x9b, 0x71, 0x11, 0x7a, 0x21, 0xf6, 0xac, 0x21, 0x89,
+	0xb5, 0x9e, 0x8c, 0xf9, 0x3d, 0xc6, 0xf0, 0x80, 0x6a, 0x55, 0x01, 0x17, 0xdf, 0xc6, 0x1f, 0xab,
+	0x92, 0xd3, 0xb5, 0x20, 0xa0, 0x5f, 0x7e, 0x1d, 0x55, 0x9e, 0xd9, 0xd5, 0x46, 0x33, 0xbb, 0x02,
+	0xcb, 0x9f, 0x27, 0xb3, 0x7b, 0x4d, 0xb1, 0xde, 0x88, 0x60, 0x7a, 0x2d, 0x08, 0x38, 0x23, 0xe8,
+	0x1a, 0xd4, 0x71, 0x10, 0x48, 0x85, 0x17, 0xae, 0x62, 0x85, 0xc2, 0xff, 0x2b, 0x96, 0x04, 0xaa,
+	0x7e, 0x03, 0xda, 0x09, 0xe8, 0xa0, 0x6d, 0xdb, 0xd9, 0x6d, 0x97, 0x01, 0x64, 0xe9, 0x72, 0xcf,
+	0xdb, 0xf1, 0xf9, 0x91, 0x72, 0xb7, 0x51, 0x4b, 0xc5, 0xb7, 0x71, 0x33, 0xc6, 0x10, 0xbc, 0xbd,
+	0x03, 0x0d, 0x9b, 0x11, 0x37, 0x66, 0x2e, 0x97, 0x10, 0xa5, 0x84, 0x4c, 0x89, 0x64, 0xfc, 0xa5,
+	0x05, 0x67, 0xf8, 0x89, 0x3d, 0x16, 0x0e, 0xb7, 0x16, 0x04, 0xb7, 0x09, 0xc3, 0xb6, 0x43, 0xbf,
+	0x13, 0x91, 0x70, 0xff, 0x0d, 0x1b, 0xc6, 0x00, 0x9a, 0xd2, 0x5f, 0xb5, 0xea, 0x9b, 0xa9, 0x62,
+	0x9b, 0xb4, 0x50, 0xba, 0xd6, 0xde, 0x4c, 0xe9, 0x5a, 0x56, 0x4a, 0xd6, 0x8f, 0xa9, 0x94, 0x1c,
+	0xdf, 0x4d, 0xc8, 0xf4, 0x28, 0x9a, 0xf9, 0x1e, 0x45, 0x49, 0x85, 0x36, 0x7d, 0xd8, 0x0a, 0xad,
+	0x55, 0x5a, 0xa1, 0xb9, 0xa5, 0x7e, 0xdc, 0x16, 0xea, 0xfe, 0x56, 0xd6, 0x02, 0xc7, 0xda, 0xda,
+	0x51, 0x6a, 0x35, 0x78, 0xa3, 0xb5, 0xda, 0x27, 0xb9, 0xda, 0x4b, 0x76, 0x3f, 0xde, 0x3b, 0x9c,
+	0x4c, 0x13, 0xaa, 0xb0, 0xff, 0xbb, 0x0a, 0xe4, 0xe7, 0x22, 0x3f, 0x0b, 0xfc, 0x54, 0x07, 0x49,
+	0x6a, 0xc0, 0xe3, 0x10, 0x0f, 0xd2, 0xea, 0xd2, 0xe2, 0xdf, 0xe8, 0x2a, 0xd4, 0xb9, 0x92, 0x55,
+	0x56, 0x7f, 0xba, 0x58, 0xb6, 0xad, 0x05, 0xc1, 0xe3, 0x80, 0x58, 0xa6, 0x40, 0x42, 0x37, 0xa1,
+	0x9d, 0x18, 0xbe, 0x56, 0x1f, 0x4d, 0x9c, 0x13, 0x3f, 0x89, 0x97, 0xa5, 0xe8, 0x7c, 0x6d, 0xdf,
+	0x0e, 0x89, 0xc5, 0x11, 0xb5, 0xc6, 0xe8, 0xda, 0xdb, 0xf1, 0x64, 0xb2, 0x36, 0x41, 0x47, 0xd7,
+	0xa0, 0x29, 0xdb, 0x45, 0xc2, 0x83, 0x66, 0x56, 0xcf, 0x8c, 0x5e, 0xa6, 0xf1, 0x2a, 0x85, 0x68,
+	0xfc, 0xb9, 0x02, 0x17, 0x52, 0x83, 0x88, 0xbd, 0x29, 0x2e, 0x3b, 0xbe, 0xfc, 0x88, 0x7b, 0x09,
+	0x66, 0x45, 0x9d, 0x93, 0x76, 0x8d, 0x64, 0x03, 0xb3, 0x00, 0x35, 0xfe, 0x59, 0x81, 0x8b, 0xa3,
+	0x72, 0xac, 0x0f, 0x71, 0xc8, 0x92, 0xe3, 0x3d, 0x0e, 0x59, 0xe2, 0x80, 0x57, 0x4d, 0x03, 0x5e,
+	0x4e, 0xbe, 0xda, 0x81, 0xf2, 0xd5, 0x4b, 0xe5, 0xfb, 0x53, 0x15, 0x66, 0x32, 0x86, 0x56, 0x16,
+	0x58, 0x79, 0x8a, 0x29, 0xec, 0x5b, 0x54, 0xc0, 0x22, 0x78, 0xb4, 0xcd, 0x0c, 0x04, 0xed, 0x02,
+	0x04, 0x38, 0xc4, 0x2e, 0x61, 0x24, 0xe4, 0x37, 0x3e, 0xbf, 0x19, 0xee, 0x1f, 0xfd, 0x16, 0xda,
+	0x8e, 0x69, 0x9a, 0x19, 0xf2, 0x99, 0x9a, 0xbe, 0x91, 0xab, 0xe9, 0x3f, 0x83, 0xd9, 0x1d, 0xdb,
+	0x21, 0xdb, 0x29, 0x23, 0xcd, 0xe5, 0xda, 0xd1, 0xa3, 0x29, 0x67, 0xe4, 0x6e, 0x96, 0xae, 0x59,
+	0xd8, 0xc6, 0xb8, 0x02, 0xf3, 0x45, 0xbf, 0xe3, 0x4c, 0xda, 0x2e, 0x1e, 0x24, 0xda, 0x52, 0x23,
+	0x03, 0xc1, 0x7c, 0xd1, 0xcf, 0x8c, 0x7f, 0x55, 0x61, 0x31, 0x21, 0xb7, 0xe6, 0x79, 0x7e, 0xe4,
+	0x59, 0xa2, 0x53, 0x5b, 0x7a, 0x16, 0xa7, 0xa0, 0xc1, 0x6c, 0xe6, 0x24, 0x09, 0x92, 0x18, 0xf0,
+	0x18, 0xc7, 0x7c, 0x9f, 0xf7, 0xca, 0x94, 0x21, 0xc4, 0x43, 0x69, 0x23, 0x2f, 0x22, 0x3b, 0x24,
+	0x7d, 0x65, 0x01, 0xc9, 0x98, 0xcf, 0xf1, 0xec, 0x47, 0x14, 0x0e, 0x52, 0x99, 0xc9, 0x58, 0xd8,
+	0x8f, 0xef, 0x38, 0xc4, 0xe2, 0xea, 0xc8, 0x94, 0x16, 0x05, 0x28, 0x97, 0x94, 0xb2, 0xd0, 0xf6,
+	0x06, 0xaa, 0xb0, 0x50, 0x23, 0xce, 0x27, 0x0e, 0x43, 0xbc, 0xaf, 0xea, 0x09, 0x39, 0x40, 0x1f,
+	0x42, 0xcd, 0xc5, 0x81, 0x0a, 0x88, 0x57, 0x72, 0xb7, 0x48, 0x99, 0x06, 0xba, 0x5b, 0x38, 0x90,
+	0x11, 0x83, 0x2f, 0xd3, 0xdf, 0x87, 0x56, 0x0c, 0xf8, 0x5c, 0xa9, 0xe3, 0xa7, 0x70, 0x32, 0x77,
+	0x49, 0xa1, 0x67, 0xd0, 0x49, 0x2d, 0x2a, 0xbb, 0xa1, 0x4a, 0x16, 0x2f, 0x1c, 0xc8, 0x99, 0x39,
+	0x86, 0x80, 0xf1, 0x02, 0x16, 0xb8, 0xc9, 0x88, 0x0b, 0xe2, 0x98, 0x4a, 0xa0, 0x0f, 0xa0, 0x9d,
+	0x6c, 0x59, 0x6a, 0x33, 0x3a, 0xb4, 0xf6, 0xe2, 0x0e, 0xba, 0xac, 0x81, 0x92, 0xb1, 0xb1, 0x06,
+	0x28, 0xcb, 0xaf, 0x8a, 0x54, 0x57, 0xf3, 0xc9, 0xf3, 0x62, 0x31, 0x2c, 0x09, 0xf4, 0x38, 0x77,
+	0xfe, 0x47, 0x15, 0xe6, 0x36, 0x6c, 0xd1, 0x74, 0x39, 0xa6, 0xcb, 0xf0, 0x0a, 0xcc, 0xd3, 0xa8,
+	0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x79, 0x50, 0x19, 0xc1, 0x08, 0x7c, 0xe2, 0x25, 0x89, 0x78,
+	0x53, 0x88, 0x0d, 0x55, 0x4d, 0x2d, 0xbe, 0xd1, 0x87, 0x70, 0xe6, 0x21, 0xf9, 0x4c, 0xc9, 0xb3,
+	0xe1, 0xf8, 0xbd, 0x9e, 0xed, 0x0d, 0xe2, 0x4d, 0x1a, 0x62, 0x93, 0xf1, 0x08, 0x65, 0x29, 0x65,
+	0xb3, 0x3c, 0xa5, 0x4c, 0xea, 0xf2, 0x75, 0xdf, 0x75, 0x6d, 0xa6, 0x32, 0xcf, 0x1c, 0xcc, 0xf8,
+	0x59, 0x05, 0xe6, 0x53, 0xcd, 0xaa, 0xb3, 0xb9, 0x21, 0x7d, 0x48, 0x9e, 0xcc, 0xc5, 0xec, 0xc9,
+	0x14, 0x51, 0xbf, 0xb8, 0xfb, 0x9c, 0xc8, 0xba, 0xcf, 0x2f, 0xab, 0xb0, 0xb8, 0x61, 0xb3, 0xf8,
+	0xe2, 0xb2, 0xff, 0xd7, 0x4e, 0xb9, 0xe4, 0x4c, 0xea, 0x87, 0x3b, 0x93, 0x46, 0xc9, 0x99, 0x74,
+	0xa1, 0x53, 0x54, 0x86, 0x3a, 0x98, 0x53, 0xd0, 0x08, 0x44, 0x8f, 0x5f, 0xf6, 0x1f, 0xe4, 0xc0,
+	0xf8, 0xe9, 0x34, 0x9c, 0xff, 0x24, 0xe8, 0x63, 0x96, 0x74, 0xa2, 0xee, 0xfa, 0xa1, 0x68, 0xf2,
+	0x1f, 0x8f, 0x16, 0x0b, 0x0f, 0xb1, 0xd5, 0x89, 0x0f, 0xb1, 0xb5, 0x09, 0x0f, 0xb1, 0xf5, 0x43,
+	0x3d, 0xc4, 0x36, 0x8e, 0xed, 0x21, 0x76, 0xb4, 0x26, 0x6b, 0x96, 0xd6, 0x64, 0xcf, 0x72, 0x75,
+	0xcb, 0xb4, 0x70, 0x9b, 0x6f, 0x66, 0xdd, 0x66, 0xe2, 0xe9, 0x4c, 0x7c, 0x41, 0x2a, 0xbc, 0x5f,
+	0xb6, 0x0e, 0x7c, 0xbf, 0x6c, 0x8f, 0xbe, 0x5f, 0x96, 0x3f, 0x81, 0xc1, 0xd8, 0x27, 0xb0, 0x4b,
+	0x30, 0x4b, 0xf7, 0x3d, 0x8b, 0xf4, 0x63, 0x86, 0xb5, 0x19, 0x29, 0x76, 0x1e, 0x9a, 0xf3, 0x88,
+	0x13, 0x05, 0x8f, 0x48, 0x2c, 0xf5, 0x64, 0xc6, 0x52, 0xcb, 0xfc, 0x64, 0x76, 0x6c, 0x39, 0x5c,
+	0x78, 0x9d, 0x9a, 0x2b, 0x7b, 0x9d, 0xfa, 0xef, 0x29, 0xca, 0x9e, 0xc2, 0xd2, 0xb8, 0x53, 0x56,
+	0xce, 0xab, 0xc1, 0xb4, 0x35, 0xc4, 0xde, 0x40, 0xbd, 0x15, 0xb5, 0xcc, 0x78, 0x38, 0xa9, 0x8a,
+	0x58, 0xfd, 0xfd, 0x0c, 0x2c, 0xa4, 0xd5, 0x01, 0xff, 0x6b, 0x5b, 0x04, 0x3d, 0x82, 0xf9, 0xf8,
+	0x35, 0x2f, 0x6e, 0x0f, 0xa3, 0x49, 0xcf, 0x44, 0xfa, 0xc4, 0xd7, 0x0f, 0x63, 0x0a, 0x3d, 0x87,
+	0x4e, 0x91, 0xe0, 0x63, 0x16, 0x12, 0xec, 0x4e, 0x26, 0x7b, 0x61, 0x12, 0x59, 0xf1, 0x3e, 0x62,
+	0x4c, 0x7d, 0xbd, 0x82, 0x2c, 0x38, 0x53, 0xa4, 0x9e, 0xbe, 0x77, 0x7d, 0x6d, 0xc2, 0x06, 0x09,
+	0xd6, 0x41, 0x02, 0x5c, 0xae, 0xa0, 0x67, 0x30, 0x9b, 0x7f, 0x00, 0x41, 0x39, 0xee, 0x4a, 0xdf,
+	0x64, 0x74, 0x63, 0x12, 0x4a, 0x46, 0x3b, 0x73, 0x85, 0x5e, 0x3f, 0x32, 0xf2, 0x7d, 0x89, 0xb2,
+	0xd7, 0x12, 0xfd, 0xab, 0x13, 0x71, 0x12, 0xea, 0x1f, 0x40, 0x2b, 0xee, 0x68, 0xe7, 0xb5, 0x5d,
+	0xe8, 0x73, 0xeb, 0xf3, 0x79, 0x7a, 0x3b, 0xd4, 0x98, 0xe2, 0x8f, 0x7e, 0x71, 0xc7, 0x76, 0x74,
+	0x71, 0xa6, 0x8f, 0xab, 0xbf, 0x55, 0xd2, 0x3b, 0x35, 0xa6, 0xd0, 0xb7, 0x61, 0x86, 0x7f, 0x6d,
+	0xab, 0xdf, 0x6a, 0x74, 0xba, 0xf2, 0xa7, 0x41, 0xdd, 0xf8, 0xa7, 0x41, 0xdd, 0x3b, 0xfc, 0xa7,
+	0x41, 0x7a, 0x49, 0x73, 0x53, 0x11, 0x78, 0x0e, 0x27, 0x37, 0x08, 0x4b, 0x7b, 0x11, 0xe8, 0xe2,
+	0xa1, 0x3a, 0x36, 0xba, 0x51, 0x44, 0x1b, 0x6d, 0x67, 0x18, 0x53, 0xe8, 0x37, 0x15, 0x78, 0x6b,
+	0x83, 0xb0, 0x62, 0x75, 0x8f, 0xde, 0x2d, 0xdf, 0x64, 0x4c, 0x17, 0x40, 0x7f, 0x78, 0x54, 0x8f,
+	0xcf, 0x93, 0x35, 0xa6, 0xd0, 0x6f, 0x2b, 0x70, 0x3a, 0xc3, 0x58, 0xb6, 0x5c, 0x47, 0xd7, 0x26,
+	0x33, 0x57, 0x52, 0xda, 0xeb, 0x1f, 0x1f, 0xf1, 0x27, 0x38, 0x19, 0x92, 0xc6, 0x14, 0xda, 0x16,
+	0x67, 0x92, 0x66, 0xdd, 0xe8, 0x7c, 0x69, 0x7a, 0x9d, 0xec, 0xbe, 0x34, 0x6e, 0x3a, 0x39, 0x87,
+	0x8f, 0x61, 0x66, 0x83, 0xb0, 0x38, 0xfd, 0xcb, 0x5b, 0x5a, 0x21, 0x33, 0xd7, 0xcf, 0x95, 0x4f,
+	0x66, 0xbc, 0x69, 0x41, 0xd2, 0xca, 0xa4, 0x38, 0x79, 0x5f, 0x2d, 0xcd, 0x05, 0x75, 0x63, 0x12,
+	0x4a, 0x42, 0xfd, 0x05, 0x74, 0xca, 0x2f, 0x62, 0xf4, 0xf6, 0xa1, 0x43, 0xb2, 0x7e, 0xe5, 0x30,
+	0xa8, 0xf1, 0x96, 0xb7, 0xd6, 0xfe, 0xfa, 0x6a, 0xa9, 0xf2, 0xb7, 0x57, 0x4b, 0x95, 0x7f, 0xbf,
+	0x5a, 0xaa, 0x7c, 0xef, 0xfa, 0x01, 0x3f, 0xd5, 0xcb, 0xfc, 0xfa, 0x0f, 0x07, 0xb6, 0xe5, 0xd8,
+	0xc4, 0x63, 0xbd, 0xa6, 0xf0, 0xb7, 0xeb, 0xff, 0x19, 0x00, 0x08, 0xc6, 0x27, 0xdf, 0x1c, 0x28,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2641,6 +2824,9 @@ const _ = grpc.SupportPackageIsVersion4
 type RepoServerServiceClient interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// response in compressed chunks, so that the size of the response is not limited by the maximum gRPC message size
+	GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error)
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error)
 	// Returns a bool val if the repository is valid and has proper access
@@ -2686,8 +2872,40 @@ func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *Mani
 	return out, nil
 }
 
+func (c *repoServerServiceClient) GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoServerServiceGenerateManifestStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepoServerService_GenerateManifestStreamClient interface {
+	Recv() (*ManifestResponseChunk, error)
+	grpc.ClientStream
+}
+
+type repoServerServiceGenerateManifestStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamClient) Recv() (*ManifestResponseChunk, error) {
+	m := new(ManifestResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *repoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestWithFiles", opts...)
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[1], "/repository.RepoServerService/GenerateManifestWithFiles", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2832,6 +3050,9 @@ func (c *repoServerServiceClient) UpdateRevisionForPaths(ctx context.Context, in
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// response in compressed chunks, so that the size of the response is not limited by the maximum gRPC message size
+	GenerateManifestStream(*ManifestRequest, RepoServerService_GenerateManifestStreamServer) error
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(RepoServerService_GenerateManifestWithFilesServer) error
 	// Returns a bool val if the repository is valid and has proper access
@@ -2867,6 +3088,9 @@ type UnimplementedRepoServerServiceServer struct {
 func (*UnimplementedRepoServerServiceServer) GenerateManifest(ctx context.Context, req *ManifestRequest) (*ManifestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateManifest not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GenerateManifestStream(req *ManifestRequest, srv RepoServerService_GenerateManifestStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManifestStream not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) GenerateManifestWithFiles(srv RepoServerService_GenerateManifestWithFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GenerateManifestWithFiles not implemented")
 }
@@ -2929,6 +3153,27 @@ func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GenerateManifestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepoServerServiceServer).GenerateManifestStream(m, &repoServerServiceGenerateManifestStreamServer{stream})
+}
+
+type RepoServerService_GenerateManifestStreamServer interface {
+	Send(*ManifestResponseChunk) error
+	grpc.ServerStream
+}
+
+type repoServerServiceGenerateManifestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamServer) Send(m *ManifestResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RepoServerService_GenerateManifestWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(RepoServerServiceServer).GenerateManifestWithFiles(&repoServerServiceGenerateManifestWithFilesServer{stream})
 }
@@ -3229,6 +3474,11 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateManifestStream",
+			Handler:       _RepoServerService_GenerateManifestStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GenerateManifestWithFiles",
 			Handler:       _RepoServerService_GenerateManifestWithFiles_Handler,
@@ -3262,6 +3512,22 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HelmValuesFrom) > 0 {
+		for iNdEx := len(m.HelmValuesFrom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HelmValuesFrom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -3548,7 +3814,7 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
+func (m *HelmValues) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3558,12 +3824,12 @@ func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ManifestRequestWithFiles) MarshalTo(dAtA []byte) (int, error) {
+func (m *HelmValues) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ManifestRequestWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HelmValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3572,24 +3838,68 @@ func (m *ManifestRequestWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Part != nil {
-		{
-			size := m.Part.Size()
-			i -= size
-			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if m.Sensitive {
+		i--
+		if m.Sensitive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Values) > 0 {
+		i -= len(m.Values)
+		copy(dAtA[i:], m.Values)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Values)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ManifestRequestWithFiles_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestRequestWithFiles) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ManifestRequestWithFiles_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ManifestRequestWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Part != nil {
+		{
+			size := m.Part.Size()
+			i -= size
+			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestRequestWithFiles_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestRequestWithFiles_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Request != nil {
 		{
@@ -3720,6 +4030,84 @@ func (m *ManifestFileChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestResponseChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestResponseChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestResponseChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Part != nil {
+		{
+			size := m.Part.Size()
+			i -= size
+			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestResponseChunk_Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestResponseChunk_Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ManifestResponseChunk_Chunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestResponseChunk_Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TestRepositoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3924,6 +4312,13 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SignatureVerifier) > 0 {
+		i -= len(m.SignatureVerifier)
+		copy(dAtA[i:], m.SignatureVerifier)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SignatureVerifier)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -4586,6 +4981,16 @@ func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CheckSignature {
+		i--
+		if m.CheckSignature {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Revision) > 0 {
 		i -= len(m.Revision)
 		copy(dAtA[i:], m.Revision)
@@ -5627,6 +6032,31 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if len(m.HelmValuesFrom) > 0 {
+		for _, e := range m.HelmValuesFrom {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HelmValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Values)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Sensitive {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5719,6 +6149,45 @@ func (m *ManifestFileChunk) Size() (n int) {
 	return n
 }
 
+func (m *ManifestResponseChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part != nil {
+		n += m.Part.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestResponseChunk_Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
+func (m *ManifestResponseChunk_Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
 func (m *TestRepositoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5835,6 +6304,10 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	l = len(m.SignatureVerifier)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6105,6 +6578,9 @@ func (m *RepoServerRevisionChartDetailsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.CheckSignature {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7472,6 +7948,143 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValuesFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValuesFrom = append(m.HelmValuesFrom, &HelmValues{})
+			if err := m.HelmValuesFrom[len(m.HelmValuesFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sensitive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -7837,6 +8450,127 @@ func (m *ManifestFileChunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ManifestResponseChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponseChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponseChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ManifestResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ManifestResponseChunk_Response{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ManifestFileChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ManifestResponseChunk_Chunk{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TestRepositoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8537,6 +9271,38 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureVerifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10413,6 +11179,26 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckSignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckSignature = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +36,9 @@ type Cache struct {
 	repoCacheExpiration      time.Duration
 	revisionCacheExpiration  time.Duration
 	revisionCacheLockTimeout time.Duration
+	// manifestSegmentSize is the maximum size of the manifests stored in a single cache entry. The manifests of larger
+	// responses are stored in several segments.
+	manifestSegmentSize int64
 }
 
 // ClusterRuntimeInfo holds cluster runtime information
@@ -45,18 +50,24 @@ type ClusterRuntimeInfo interface {
 }
 
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
-	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout}
+	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout, defaultManifestSegmentSize}
 }
 
+// defaultManifestSegmentSize is the default maximum size of the manifests stored in a single cache entry
+const defaultManifestSegmentSize = 10 * 1024 * 1024
+
 func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*Cache, error) {
 	var repoCacheExpiration time.Duration
 	var revisionCacheExpiration time.Duration
 	var revisionCacheLockTimeout time.Duration
+	var manifestSegmentSize int64
 
 	cmd.Flags().DurationVar(&repoCacheExpiration, "repo-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration for repo state, incl. app lists, app details, manifest generation, revision meta-data")
 	cmd.Flags().DurationVar(&revisionCacheExpiration, "revision-cache-expiration", env.ParseDurationFromEnv("ARGOCD_RECONCILIATION_TIMEOUT", 3*time.Minute, 0, math.MaxInt64), "Cache expiration for cached revision")
 	cmd.Flags().DurationVar(&revisionCacheLockTimeout, "revision-cache-lock-timeout", env.ParseDurationFromEnv("ARGOCD_REVISION_CACHE_LOCK_TIMEOUT", 10*time.Second, 0, math.MaxInt64), "Cache TTL for locks to prevent duplicate requests on revisions, set to 0 to disable")
 
+	cmd.Flags().Int64Var(&manifestSegmentSize, "repo-cache-manifest-segment-size", env.ParseInt64FromEnv("ARGOCD_REPO_CACHE_MANIFEST_SEGMENT_SIZE", defaultManifestSegmentSize, 0, math.MaxInt64), "Maximum size in bytes of the manifests stored in a single cache entry, the manifests of larger responses are stored in several entries. Set to 0 to disable")
+
 	repoFactory := cacheutil.AddCacheFlagsToCmd(cmd, opts...)
 
 	return func() (*Cache, error) {
@@ -64,7 +75,9 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*
 		if err != nil {
 			return nil, fmt.Errorf("error adding cache flags to cmd: %w", err)
 		}
-		return NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout), nil
+		repoCache := NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout)
+		repoCache.manifestSegmentSize = manifestSegmentSize
+		return repoCache, nil
 	}
 }
 
@@ -141,6 +154,21 @@ func clusterRuntimeInfoKeyUnhashed(info ClusterRuntimeInfo) string {
 	return info.GetKubeVersion() + "|" + strings.Join(apiVersions, ",")
 }
 
+// helmValuesFromKey gets the hash of the Helm values read from ConfigMaps and Secrets, if the given runtime info is a
+// manifest request. Returns 0 if there are no such values.
+func helmValuesFromKey(info ClusterRuntimeInfo) uint32 {
+	q, ok := info.(*apiclient.ManifestRequest)
+	if !ok || len(q.HelmValuesFrom) == 0 {
+		return 0
+	}
+	values := make([]string, len(q.HelmValuesFrom))
+	for i, v := range q.HelmValuesFrom {
+		values[i] = v.Values
+	}
+	valuesJSON, _ := json.Marshal(values)
+	return hash.FNVa(string(valuesJSON))
+}
+
 func listApps(repoURL, revision string) string {
 	return fmt.Sprintf("ldir|%s|%s", repoURL, revision)
 }
@@ -297,6 +325,9 @@ func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs
 	//       when the _resolved_ revisions are already part of the key.
 	trackingKey := trackingKey(appLabelKey, trackingMethod)
 	key := fmt.Sprintf("mfst|%s|%s|%s|%s|%d", trackingKey, appName, revision, namespace, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs)+clusterRuntimeInfoKey(info))
+	if valuesKey := helmValuesFromKey(info); valuesKey != 0 {
+		key = fmt.Sprintf("%s|%d", key, valuesKey)
+	}
 	if installationID != "" {
 		key = fmt.Sprintf("%s|%s", key, installationID)
 	}
@@ -339,6 +370,21 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, s
 		return err
 	}
 
+	if res.ManifestSegments > 0 {
+		err = c.getManifestSegments(res)
+		if errors.Is(err, ErrCacheMiss) {
+			log.Warnf("Manifest cache segment is missing, treating as a cache miss: %s", appName)
+			err = c.DeleteManifests(revision, appSrc, srcRefs, clusterInfo, namespace, trackingMethod, appLabelKey, appName, refSourceCommitSHAs, installationID)
+			if err != nil {
+				return fmt.Errorf("Unable to delete manifest after missing segment, %w", err)
+			}
+			return ErrCacheMiss
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	hash, err := res.generateCacheEntryHash()
 	if err != nil {
 		return fmt.Errorf("Unable to generate hash value: %w", err)
@@ -379,6 +425,10 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, s
 			return fmt.Errorf("Unable to generate hash value: %w", err)
 		}
 		res.CacheEntryHash = hash
+
+		if err := c.setManifestSegments(res); err != nil {
+			return err
+		}
 	}
 
 	return c.cache.SetItem(
@@ -390,6 +440,77 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, s
 		})
 }
 
+func manifestSegmentKey(segmentsKey string, segment int) string {
+	return fmt.Sprintf("mfstseg|%s|%d", segmentsKey, segment)
+}
+
+// setManifestSegments stores the manifests of the response in segments of at most manifestSegmentSize bytes, if they
+// exceed that size, and removes them from the response. The segments are keyed by the digest of the manifests, so
+// that they remain valid when the response is moved to another revision.
+func (c *Cache) setManifestSegments(res *CachedManifestResponse) error {
+	if c.manifestSegmentSize <= 0 || res.ManifestResponse == nil {
+		return nil
+	}
+	size := int64(0)
+	hasher := sha256.New()
+	for _, manifest := range res.ManifestResponse.Manifests {
+		size += int64(len(manifest))
+		hasher.Write([]byte(manifest))
+		hasher.Write([]byte{0})
+	}
+	if size <= c.manifestSegmentSize {
+		return nil
+	}
+
+	var segments [][]string
+	var segment []string
+	segmentSize := int64(0)
+	for _, manifest := range res.ManifestResponse.Manifests {
+		if len(segment) > 0 && segmentSize+int64(len(manifest)) > c.manifestSegmentSize {
+			segments = append(segments, segment)
+			segment = nil
+			segmentSize = 0
+		}
+		segment = append(segment, manifest)
+		segmentSize += int64(len(manifest))
+	}
+	segments = append(segments, segment)
+
+	segmentsKey := hex.EncodeToString(hasher.Sum(nil))
+	for i, segment := range segments {
+		err := c.cache.SetItem(manifestSegmentKey(segmentsKey, i), segment, &cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+		if err != nil {
+			return fmt.Errorf("error setting manifest cache segment %d of %d: %w", i+1, len(segments), err)
+		}
+	}
+
+	manifestResponse := *res.ManifestResponse
+	manifestResponse.Manifests = nil
+	res.ManifestResponse = &manifestResponse
+	res.ManifestSegments = len(segments)
+	res.ManifestSegmentsKey = segmentsKey
+	return nil
+}
+
+// getManifestSegments restores the manifests of a response which have been stored in segments
+func (c *Cache) getManifestSegments(res *CachedManifestResponse) error {
+	if res.ManifestResponse == nil {
+		return fmt.Errorf("manifest cache entry with %d segments has no manifest response", res.ManifestSegments)
+	}
+	var manifests []string
+	for i := 0; i < res.ManifestSegments; i++ {
+		var segment []string
+		if err := c.cache.GetItem(manifestSegmentKey(res.ManifestSegmentsKey, i), &segment); err != nil {
+			return err
+		}
+		manifests = append(manifests, segment...)
+	}
+	res.ManifestResponse.Manifests = manifests
+	res.ManifestSegments = 0
+	res.ManifestSegmentsKey = ""
+	return nil
+}
+
 func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace, trackingMethod, appLabelKey, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string) error {
 	return c.cache.SetItem(
 		manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID),
@@ -494,6 +615,8 @@ func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 		MostRecentError:                 cmr.MostRecentError,
 		NumberOfCachedResponsesReturned: cmr.NumberOfCachedResponsesReturned,
 		NumberOfConsecutiveFailures:     cmr.NumberOfConsecutiveFailures,
+		ManifestSegments:                cmr.ManifestSegments,
+		ManifestSegmentsKey:             cmr.ManifestSegmentsKey,
 	}
 }
 
@@ -527,4 +650,9 @@ type CachedManifestResponse struct {
 	FirstFailureTimestamp           int64                       `json:"firstFailureTimestamp"`
 	NumberOfConsecutiveFailures     int                         `json:"numberOfConsecutiveFailures"`
 	NumberOfCachedResponsesReturned int                         `json:"numberOfCachedResponsesReturned"`
+	// ManifestSegments is the number of cache entries the manifests of the response are stored in, if they are not
+	// stored in the response itself
+	ManifestSegments int `json:"manifestSegments,omitempty"`
+	// ManifestSegmentsKey identifies the cache entries the manifests of the response are stored in
+	ManifestSegmentsKey string `json:"manifestSegmentsKey,omitempty"`
 }
@@ -124,6 +124,11 @@ func TestCache_GetManifests(t *testing.T) {
 		err = cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, map[string]string{"my-referenced-source": "my-referenced-revision"}, "")
 		assert.Equal(t, ErrCacheMiss, err)
 	})
+	t.Run("expect cache miss because of changed helm values from config maps and secrets", func(t *testing.T) {
+		q := &apiclient.ManifestRequest{HelmValuesFrom: []*apiclient.HelmValues{{Values: "foo: bar"}}}
+		err = cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		assert.Equal(t, ErrCacheMiss, err)
+	})
 	t.Run("expect cache hit", func(t *testing.T) {
 		err = cache.SetManifests(
 			"my-revision1", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value",
@@ -136,7 +141,48 @@ func TestCache_GetManifests(t *testing.T) {
 		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
 		assert.Equal(t, "my-revision1", value.ManifestResponse.Revision)
 	})
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 9})
+}
+
+func TestCache_GetManifests_Segments(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+	cache.manifestSegmentSize = 10
+	q := &apiclient.ManifestRequest{}
+	manifests := []string{"0123456789", "abc", "def", "0123456789abcdef", "ghi"}
+	err := cache.SetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value",
+		&CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{Manifests: manifests, SourceType: "my-source-type"}}, nil, "")
+	require.NoError(t, err)
+
+	t.Run("manifests are stored in segments", func(t *testing.T) {
+		var stored CachedManifestResponse
+		err := cache.cache.GetItem(manifestCacheKey("my-revision", &ApplicationSource{}, q.RefSources, "my-namespace", "", "my-app-label-key", "my-app-label-value", q, nil, ""), &stored)
+		require.NoError(t, err)
+		assert.Empty(t, stored.ManifestResponse.Manifests)
+		assert.Equal(t, 4, stored.ManifestSegments)
+		var segment []string
+		require.NoError(t, cache.cache.GetItem(manifestSegmentKey(stored.ManifestSegmentsKey, 1), &segment))
+		assert.Equal(t, []string{"abc", "def"}, segment)
+	})
+	t.Run("expect cache hit", func(t *testing.T) {
+		value := &CachedManifestResponse{}
+		err := cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, manifests, value.ManifestResponse.Manifests)
+		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
+		assert.Zero(t, value.ManifestSegments)
+	})
+	t.Run("expect cache miss because of missing segment", func(t *testing.T) {
+		var stored CachedManifestResponse
+		err := cache.cache.GetItem(manifestCacheKey("my-revision", &ApplicationSource{}, q.RefSources, "my-namespace", "", "my-app-label-key", "my-app-label-value", q, nil, ""), &stored)
+		require.NoError(t, err)
+		require.NoError(t, cache.cache.SetItem(manifestSegmentKey(stored.ManifestSegmentsKey, 2), "", &cacheutil.CacheActionOpts{Delete: true}))
+
+		value := &CachedManifestResponse{}
+		err = cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		assert.Equal(t, ErrCacheMiss, err)
+	})
 }
 
 func TestCache_GetAppDetails(t *testing.T) {
@@ -289,6 +335,8 @@ func TestCachedManifestResponse_ShallowCopy(t *testing.T) {
 		MostRecentError:                 "error",
 		NumberOfCachedResponsesReturned: 2,
 		NumberOfConsecutiveFailures:     3,
+		ManifestSegments:                4,
+		ManifestSegmentsKey:             "key",
 	}
 
 	post := pre.shallowCopy()
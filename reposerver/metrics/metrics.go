@@ -17,6 +17,10 @@ type MetricsServer struct {
 	gitRequestCounter        *prometheus.CounterVec
 	gitRequestHistogram      *prometheus.HistogramVec
 	repoPendingRequestsGauge *prometheus.GaugeVec
+	repoWorktreesGauge       *prometheus.GaugeVec
+	repoWorktreesSizeGauge   *prometheus.GaugeVec
+	repoWorktreeEvictCounter *prometheus.CounterVec
+	manifestGenLimitCounter  *prometheus.CounterVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
 }
@@ -80,6 +84,42 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(repoPendingRequestsGauge)
 
+	repoWorktreesGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_worktrees",
+			Help: "Number of Git working trees of revisions",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(repoWorktreesGauge)
+
+	repoWorktreesSizeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_worktrees_size_bytes",
+			Help: "Size of the files checked out in Git working trees of revisions",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(repoWorktreesSizeGauge)
+
+	repoWorktreeEvictCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_worktree_evictions_total",
+			Help: "Number of Git working trees of revisions evicted by repo server",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(repoWorktreeEvictCounter)
+
+	manifestGenLimitCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_generation_limit_exceeded_total",
+			Help: "Number of manifest generations which exceeded a resource limit",
+		},
+		[]string{"repo", "limit"},
+	)
+	registry.MustRegister(manifestGenLimitCounter)
+
 	redisRequestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_redis_request_total",
@@ -106,6 +146,10 @@ func NewMetricsServer() *MetricsServer {
 		gitRequestCounter:        gitRequestCounter,
 		gitRequestHistogram:      gitRequestHistogram,
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
+		repoWorktreesGauge:       repoWorktreesGauge,
+		repoWorktreesSizeGauge:   repoWorktreesSizeGauge,
+		repoWorktreeEvictCounter: repoWorktreeEvictCounter,
+		manifestGenLimitCounter:  manifestGenLimitCounter,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
 	}
@@ -140,6 +184,22 @@ func (m *MetricsServer) DecPendingRepoRequest(repo string) {
 	m.repoPendingRequestsGauge.WithLabelValues(repo).Dec()
 }
 
+// SetRepoWorktrees sets the number and the total size of the working trees of the given repo
+func (m *MetricsServer) SetRepoWorktrees(repo string, count int, size int64) {
+	m.repoWorktreesGauge.WithLabelValues(repo).Set(float64(count))
+	m.repoWorktreesSizeGauge.WithLabelValues(repo).Set(float64(size))
+}
+
+func (m *MetricsServer) IncRepoWorktreeEvictions(repo string) {
+	m.repoWorktreeEvictCounter.WithLabelValues(repo).Inc()
+}
+
+// IncManifestGenerationLimitExceeded increments the counter of manifest generations of the given repo which exceeded
+// the given kind of resource limit
+func (m *MetricsServer) IncManifestGenerationLimitExceeded(repo string, limit string) {
+	m.manifestGenLimitCounter.WithLabelValues(repo, limit).Inc()
+}
+
 func (m *MetricsServer) IncRedisRequest(failed bool) {
 	m.redisRequestCounter.WithLabelValues("argocd-repo-server", strconv.FormatBool(failed)).Inc()
 }
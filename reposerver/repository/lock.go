@@ -25,7 +25,13 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 		state = &repositoryState{cond: &sync.Cond{L: &sync.Mutex{}}}
 		r.stateByKey[path] = state
 	}
+	state.references++
 	r.lock.Unlock()
+	release := func() {
+		r.lock.Lock()
+		state.references--
+		r.lock.Unlock()
+	}
 
 	closer := ioutil.NewCloser(func() error {
 		state.cond.L.Lock()
@@ -42,6 +48,7 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 		if notify {
 			state.cond.Broadcast()
 		}
+		release()
 		if err != nil {
 			return fmt.Errorf("init closer failed: %w", err)
 		}
@@ -55,6 +62,7 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 			initCloser, err := init()
 			if err != nil {
 				state.cond.L.Unlock()
+				release()
 				return nil, fmt.Errorf("failed to initialize repository resources: %w", err)
 			}
 			state.initCloser = initCloser
@@ -76,10 +84,37 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 	}
 }
 
+// RunExclusive runs the given function while no other process runs an exclusive function for the repository at the
+// given path. This is used for changes of the repository, such as fetching or widening a sparse checkout, which don't
+// affect the working trees used by other processes holding the lock. The caller must hold the repository lock.
+func (r *repositoryLock) RunExclusive(path string, fn func() error) error {
+	r.lock.Lock()
+	state, ok := r.stateByKey[path]
+	r.lock.Unlock()
+	if !ok {
+		return fmt.Errorf("repository %s is not locked", path)
+	}
+	state.exclusiveLock.Lock()
+	defer state.exclusiveLock.Unlock()
+	return fn()
+}
+
+// Forget removes the state of the lock of the given path, unless the lock is held or waited for
+func (r *repositoryLock) Forget(path string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if state, ok := r.stateByKey[path]; ok && state.references == 0 {
+		delete(r.stateByKey, path)
+	}
+}
+
 type repositoryState struct {
 	cond            *sync.Cond
 	revision        string
 	initCloser      io.Closer
 	processCount    int
 	allowConcurrent bool
+	exclusiveLock   sync.Mutex
+	// references is the number of processes holding or waiting for the lock
+	references int
 }
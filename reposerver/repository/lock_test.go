@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	util "github.com/argoproj/argo-cd/v2/util/io"
 )
@@ -168,3 +169,36 @@ func TestLock_SameRevisionFirstNotConcurrent(t *testing.T) {
 
 	util.Close(closer1)
 }
+
+func TestLock_RunExclusive(t *testing.T) {
+	lock := NewRepositoryLock()
+	require.Error(t, lock.RunExclusive("myRepo", func() error { return nil }))
+
+	closer, err := lock.Lock("myRepo", "1", true, func() (io.Closer, error) {
+		return util.NopCloser, nil
+	})
+	require.NoError(t, err)
+	defer util.Close(closer)
+
+	ran := false
+	require.NoError(t, lock.RunExclusive("myRepo", func() error {
+		ran = true
+		return nil
+	}))
+	assert.True(t, ran)
+}
+
+func TestLock_Forget(t *testing.T) {
+	lock := NewRepositoryLock()
+	closer, err := lock.Lock("myRepo", "1", true, func() (io.Closer, error) {
+		return util.NopCloser, nil
+	})
+	require.NoError(t, err)
+
+	lock.Forget("myRepo")
+	assert.Contains(t, lock.stateByKey, "myRepo")
+
+	util.Close(closer)
+	lock.Forget("myRepo")
+	assert.NotContains(t, lock.stateByKey, "myRepo")
+}
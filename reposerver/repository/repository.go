@@ -13,6 +13,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -24,7 +25,6 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/golang/protobuf/ptypes/empty"
-	"github.com/google/go-jsonnet"
 	"github.com/google/uuid"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	log "github.com/sirupsen/logrus"
@@ -50,6 +50,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/cmp"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
@@ -58,8 +59,10 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/io/files"
 	pathutil "github.com/argoproj/argo-cd/v2/util/io/path"
+	jsonnetutil "github.com/argoproj/argo-cd/v2/util/jsonnet"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
 	"github.com/argoproj/argo-cd/v2/util/manifeststream"
+	"github.com/argoproj/argo-cd/v2/util/signing"
 	"github.com/argoproj/argo-cd/v2/util/text"
 )
 
@@ -70,6 +73,9 @@ const (
 	repoSourceFile                 = ".argocd-source.yaml"
 	appSourceFile                  = ".argocd-source-%s.yaml"
 	ociPrefix                      = "oci://"
+	// helmPostRendererManifestsFile is the name of the file containing the manifests rendered by Helm, which are passed
+	// to the post-renderer
+	helmPostRendererManifestsFile = "helm-rendered.yaml"
 )
 
 var (
@@ -94,6 +100,8 @@ type Service struct {
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
 	initConstants             RepoServerInitConstants
+	// worktrees is nil unless revisions are checked out in separate working trees
+	worktrees *worktreeCache
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
 }
@@ -114,6 +122,15 @@ type RepoServerInitConstants struct {
 	DisableHelmManifestMaxExtractedSize          bool
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
+	// GitWorktreesMax is the maximum number of Git working trees of revisions. Revisions are checked out in separate
+	// working trees if it is greater than zero.
+	GitWorktreesMax int
+	// GitWorktreesMaxSize is the maximum total size of the Git working trees of revisions. Zero means no limit.
+	GitWorktreesMaxSize int64
+	// ManifestGenerationLimits are the limits of the resources consumed by the processes of each manifest generation
+	ManifestGenerationLimits executil.ResourceLimits
+	// JsonnetMaxStack is the maximum stack depth of Jsonnet evaluations. Zero means the default of go-jsonnet.
+	JsonnetMaxStack int
 }
 
 // NewService returns a new instance of the Manifest service
@@ -125,6 +142,10 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	repoLock := NewRepositoryLock()
 	gitRandomizedPaths := io.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := io.NewRandomizedTempPaths(rootDir)
+	var worktrees *worktreeCache
+	if initConstants.GitWorktreesMax > 0 {
+		worktrees = newWorktreeCache(initConstants.GitWorktreesMax, initConstants.GitWorktreesMaxSize, metricsServer)
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -142,6 +163,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		chartPaths:         helmRandomizedPaths,
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
+		worktrees:          worktrees,
 	}
 }
 
@@ -173,6 +195,10 @@ func (s *Service) Init() error {
 			if remotes, err := repo.Remotes(); err == nil && len(remotes) > 0 && len(remotes[0].Config().URLs) > 0 {
 				s.gitRepoPaths.Add(git.NormalizeGitURL(remotes[0].Config().URLs[0]), fullPath)
 			}
+			// working trees of revisions are not tracked across restarts
+			if err := os.RemoveAll(filepath.Join(fullPath, worktreesDir)); err != nil {
+				log.Warnf("Failed to remove working trees of %s: %v", fullPath, err)
+			}
 		}
 		io.Close(closer)
 	}
@@ -262,6 +288,11 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// sparsePaths are the repository paths which must be checked out if the repository uses sparse checkout
+	sparsePaths []string
+	// resourceLimits are the limits of the resources consumed by the commands of the operation, including the
+	// checkout of the repository or the chart
+	resourceLimits executil.ResourceLimits
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -270,8 +301,16 @@ type operationContext struct {
 	// application path or helm chart path
 	appPath string
 
-	// output of 'git verify-(tag/commit)', if signature verification is enabled (otherwise "")
+	// output of 'git verify-(tag/commit)' or of the verification of the chart signature, if signature verification is
+	// enabled (otherwise "")
 	verificationResult string
+
+	// program which verified the signature, one of the common.SignatureVerifier* values, if the revision or chart is
+	// signed and signature verification is enabled (otherwise "")
+	signatureVerifier string
+
+	// limiter limits the resources consumed by the commands of the operation, unless it is nil
+	limiter *executil.Limiter
 }
 
 // The 'operation' function parameter of 'runRepoOperation' may call this function to retrieve
@@ -289,7 +328,7 @@ func (s *Service) runRepoOperation(
 	revision string,
 	repo *v1alpha1.Repository,
 	source *v1alpha1.ApplicationSource,
-	verifyCommit bool,
+	verifySignature bool,
 	cacheFn func(cacheKey string, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, error),
 	operation func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) error,
 	settings operationSettings,
@@ -319,7 +358,7 @@ func (s *Service) runRepoOperation(
 		}
 	}
 
-	repoRefs, err := resolveReferencedSources(hasMultipleSources, source.Helm, refSources, s.newClientResolveRevision, gitClientOpts)
+	repoRefs, err := resolveReferencedSources(hasMultipleSources, source, refSources, s.newClientResolveRevision, gitClientOpts)
 	if err != nil {
 		return err
 	}
@@ -341,6 +380,28 @@ func (s *Service) runRepoOperation(
 		defer settings.sem.Release(1)
 	}
 
+	// The limiter is created once the operation is no longer queued, so that waiting does not count towards its timeout
+	limiter, err := executil.NewLimiter(settings.resourceLimits)
+	if err != nil {
+		return fmt.Errorf("error applying resource limits: %w", err)
+	}
+	defer func() {
+		if err := limiter.Close(); err != nil {
+			log.Warnf("Failed to clean up resource limits of repository operation: %v", err)
+		}
+	}()
+	if limiter != nil {
+		// The clients are recreated, so that the repository or the chart is checked out with the resource limits
+		if source.IsHelm() {
+			helmClient = s.newHelmClientForRepo(repo, helm.WithLimiter(limiter))
+		} else {
+			gitClient, err = s.newClient(repo, gitClientOpts, git.WithLimiter(limiter))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	if source.IsHelm() {
 		if settings.noCache {
 			err = helmClient.CleanChartCache(source.Chart, revision, repo.Project)
@@ -354,6 +415,7 @@ func (s *Service) runRepoOperation(
 		}
 		chartPath, closer, err := helmClient.ExtractChart(source.Chart, revision, repo.Project, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
 		if err != nil {
+			s.incLimitExceeded(repo.Repo, limiter)
 			return err
 		}
 		defer io.Close(closer)
@@ -375,18 +437,42 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, ""}, nil
+			var verifier, signature string
+			if verifySignature {
+				verifier, signature, err = helmClient.VerifyChart(source.Chart, revision, repo.Project, helmPassCredentials)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return &operationContext{chartPath, signature, verifier, limiter}, nil
 		})
 	} else {
-		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
-		})
+		var closer goio.Closer
+		if s.worktrees != nil && !repo.SparseCheckout && git.IsCommitSHA(revision) {
+			// the operation uses the client of the working tree of the revision
+			gitClient, closer, err = s.checkoutWorktree(gitClient, repo, revision, settings.allowConcurrent, gitClientOpts, git.WithLimiter(limiter))
+		} else {
+			closer, err = s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
+				return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+			})
+		}
 		if err != nil {
+			s.incLimitExceeded(repo.Repo, limiter)
 			return err
 		}
 
 		defer io.Close(closer)
 
+		if repo.SparseCheckout {
+			err = s.repoLock.RunExclusive(gitClient.Root(), func() error {
+				return gitClient.SparseCheckoutAdd(settings.sparsePaths)
+			})
+			if err != nil {
+				s.incLimitExceeded(repo.Repo, limiter)
+				return fmt.Errorf("failed to widen sparse checkout: %w", err)
+			}
+		}
+
 		if !s.initConstants.AllowOutOfBoundsSymlinks {
 			err := argopath.CheckOutOfBoundsSymlinks(gitClient.Root())
 			if err != nil {
@@ -426,8 +512,8 @@ func (s *Service) runRepoOperation(
 		// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
 		// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
 		return operation(gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
-			var signature string
-			if verifyCommit {
+			var verifier, signature string
+			if verifySignature {
 				// When the revision is an annotated tag, we need to pass the unresolved revision (i.e. the tag name)
 				// to the verification routine. For everything else, we work with the SHA that the target revision is
 				// pointing to (i.e. the resolved revision).
@@ -437,7 +523,7 @@ func (s *Service) runRepoOperation(
 				} else {
 					rev = revision
 				}
-				signature, err = gitClient.VerifyCommitSignature(rev)
+				verifier, signature, err = gitClient.VerifyCommitSignature(rev)
 				if err != nil {
 					return nil, err
 				}
@@ -446,11 +532,20 @@ func (s *Service) runRepoOperation(
 			if err != nil {
 				return nil, err
 			}
-			return &operationContext{appPath, signature}, nil
+			return &operationContext{appPath, signature, verifier, limiter}, nil
 		})
 	}
 }
 
+// incLimitExceeded increments the counter of manifest generations of the given repo which exceeded a resource limit, if
+// a command run with the given limiter exceeded one
+func (s *Service) incLimitExceeded(repoURL string, limiter *executil.Limiter) {
+	if limitErr := limiter.Exceeded(); limitErr != nil {
+		log.WithField("repo", repoURL).Warnf("repository operation exceeded resource limit: %v", limitErr)
+		s.metricsServer.IncManifestGenerationLimitExceeded(repoURL, limitErr.Limit)
+	}
+}
+
 func getRepoSanitizerRegex(rootDir string) *regexp.Regexp {
 	// This regex assumes that the sensitive part of the path (the component immediately after "rootDir") contains no
 	// spaces. This assumption allows us to avoid sanitizing "more info" in "/tmp/_argocd-repo/SENSITIVE more info".
@@ -460,6 +555,85 @@ func getRepoSanitizerRegex(rootDir string) *regexp.Regexp {
 	return regexp.MustCompile(regexp.QuoteMeta(rootDir) + `/[^ /]*`)
 }
 
+// sparseCheckoutPaths returns the repository paths which must be checked out to generate the manifests of the given
+// source: its path, its local Helm value files and post-renderer, and the paths listed in the manifest-generate-paths
+// annotation. Directories outside of the source path, e.g. Kustomize bases, have to be listed in the annotation.
+func sparseCheckoutPaths(source *v1alpha1.ApplicationSource, manifestGeneratePaths string) []string {
+	paths := []string{source.Path}
+	if source.Helm != nil {
+		for _, valueFile := range source.Helm.ValueFiles {
+			if strings.HasPrefix(valueFile, "$") || strings.Contains(valueFile, "://") {
+				continue
+			}
+			if p, ok := repoRelativePath(source.Path, valueFile); ok {
+				paths = append(paths, path.Dir(p))
+			}
+		}
+		if postRenderer := source.Helm.PostRenderer; postRenderer != nil && postRenderer.Kustomize != nil && !strings.HasPrefix(postRenderer.Kustomize.Path, "$") {
+			if p, ok := repoRelativePath(source.Path, postRenderer.Kustomize.Path); ok {
+				paths = append(paths, p)
+			}
+		}
+	}
+	for _, item := range strings.Split(manifestGeneratePaths, ";") {
+		if item == "" {
+			continue
+		}
+		if p, ok := repoRelativePath(source.Path, item); ok {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// refSourceSparseCheckoutPaths returns the referenced directories, and the directories of the referenced files, which
+// are located in the repository with the given URL
+func refSourceSparseCheckoutPaths(refs []sourceRef, refSources map[string]*v1alpha1.RefTarget, normalizedRepoURL string) []string {
+	var paths []string
+	for _, ref := range refs {
+		refVar, ok := ref.refVar(refSources)
+		if !ok {
+			continue
+		}
+		refSource, ok := refSources[refVar]
+		if !ok || git.NormalizeGitURL(refSource.Repo.Repo) != normalizedRepoURL {
+			continue
+		}
+		_, file, _ := strings.Cut(ref.path, "/")
+		if p, ok := repoRelativePath("", file); ok {
+			if !ref.dir {
+				p = path.Dir(p)
+			}
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// repoRelativePath resolves the given path, which is either relative to the application path or absolute to the
+// repository root, to a path relative to the repository root. Returns false if the path points outside the repository.
+func repoRelativePath(appPath string, p string) (string, bool) {
+	if path.IsAbs(p) {
+		p = path.Clean(p)[1:]
+	} else {
+		p = path.Join(appPath, p)
+	}
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+	return p, true
+}
+
+// isHiddenPath returns true if any element of the given path is hidden
+func isHiddenPath(p string) bool {
+	for _, element := range strings.Split(p, "/") {
+		if strings.HasPrefix(element, ".") {
+			return true
+		}
+	}
+	return false
+}
+
 type gitClientGetter func(repo *v1alpha1.Repository, revision string, opts ...git.ClientOpts) (git.Client, string, error)
 
 // resolveReferencedSources resolves the revisions for the given referenced sources. This lets us invalidate the cached
@@ -467,50 +641,104 @@ type gitClientGetter func(repo *v1alpha1.Repository, revision string, opts ...gi
 //
 // Much of this logic is duplicated in runManifestGenAsync. If making changes here, check whether runManifestGenAsync
 // should be updated.
-func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.ApplicationSourceHelm, refSources map[string]*v1alpha1.RefTarget, newClientResolveRevision gitClientGetter, gitClientOpts git.ClientOpts) (map[string]string, error) {
+func resolveReferencedSources(hasMultipleSources bool, source *v1alpha1.ApplicationSource, refSources map[string]*v1alpha1.RefTarget, newClientResolveRevision gitClientGetter, gitClientOpts git.ClientOpts) (map[string]string, error) {
 	repoRefs := make(map[string]string)
 	if !hasMultipleSources || source == nil {
 		return repoRefs, nil
 	}
 
-	refFileParams := make([]string, 0)
-	for _, fileParam := range source.FileParameters {
-		refFileParams = append(refFileParams, fileParam.Path)
+	for _, ref := range getSourceRefs(source) {
+		refVar, ok := ref.refVar(refSources)
+		if !ok {
+			continue
+		}
+		refSourceMapping, ok := refSources[refVar]
+		if !ok {
+			if len(refSources) == 0 {
+				return nil, fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
+			}
+			refKeys := make([]string, 0)
+			for refKey := range refSources {
+				refKeys = append(refKeys, refKey)
+			}
+			return nil, fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
+		}
+		if refSourceMapping.Chart != "" {
+			return nil, fmt.Errorf("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
+		}
+		normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
+		_, ok = repoRefs[normalizedRepoURL]
+		if !ok {
+			_, referencedCommitSHA, err := newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, gitClientOpts)
+			if err != nil {
+				log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
+				return nil, fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
+			}
+
+			repoRefs[normalizedRepoURL] = referencedCommitSHA
+		}
 	}
-	refCandidates := append(source.ValueFiles, refFileParams...)
+	return repoRefs, nil
+}
 
-	for _, valueFile := range refCandidates {
-		if strings.HasPrefix(valueFile, "$") {
-			refVar := strings.Split(valueFile, "/")[0]
+// sourceRef is a path of a source, which references a file or directory of another source if it has the form $ref/path
+type sourceRef struct {
+	path string
+	// dir is true if the path refers to a directory or is a glob pattern
+	dir bool
+	// optional is true if the path is only a reference if the referenced source exists, since the value might
+	// legitimately start with a '$', e.g. a Jsonnet TLA referencing an environment variable
+	optional bool
+}
 
-			refSourceMapping, ok := refSources[refVar]
-			if !ok {
-				if len(refSources) == 0 {
-					return nil, fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
-				}
-				refKeys := make([]string, 0)
-				for refKey := range refSources {
-					refKeys = append(refKeys, refKey)
-				}
-				return nil, fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
-			}
-			if refSourceMapping.Chart != "" {
-				return nil, fmt.Errorf("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
-			}
-			normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
-			_, ok = repoRefs[normalizedRepoURL]
-			if !ok {
-				_, referencedCommitSHA, err := newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, gitClientOpts)
-				if err != nil {
-					log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
-					return nil, fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
-				}
+// refVar returns the variable of the referenced source, e.g. '$values', and true if the path is a reference
+func (r sourceRef) refVar(refSources map[string]*v1alpha1.RefTarget) (string, bool) {
+	if !strings.HasPrefix(r.path, "$") {
+		return "", false
+	}
+	refVar := strings.Split(r.path, "/")[0]
+	if _, ok := refSources[refVar]; r.optional && (!ok || !strings.Contains(r.path, "/")) {
+		return "", false
+	}
+	return refVar, true
+}
 
-				repoRefs[normalizedRepoURL] = referencedCommitSHA
+// getSourceRefs returns the paths of the given source which may reference files or directories of other sources
+func getSourceRefs(source *v1alpha1.ApplicationSource) []sourceRef {
+	var refs []sourceRef
+	if source.Helm != nil {
+		for _, valueFile := range source.Helm.ValueFiles {
+			refs = append(refs, sourceRef{path: valueFile})
+		}
+		for _, fileParam := range source.Helm.FileParameters {
+			refs = append(refs, sourceRef{path: fileParam.Path})
+		}
+		if postRenderer := source.Helm.PostRenderer; postRenderer != nil && postRenderer.Kustomize != nil {
+			refs = append(refs, sourceRef{path: postRenderer.Kustomize.Path, dir: true})
+		}
+	}
+	if source.Kustomize != nil {
+		for _, component := range source.Kustomize.Components {
+			refs = append(refs, sourceRef{path: component, dir: true})
+		}
+		for _, patch := range source.Kustomize.Patches {
+			if patch.Path != "" {
+				refs = append(refs, sourceRef{path: patch.Path})
 			}
 		}
 	}
-	return repoRefs, nil
+	if source.Directory != nil {
+		if source.Directory.Include != "" {
+			refs = append(refs, sourceRef{path: source.Directory.Include, dir: true})
+		}
+		for _, lib := range source.Directory.Jsonnet.Libs {
+			refs = append(refs, sourceRef{path: lib, dir: true})
+		}
+		for _, tla := range source.Directory.Jsonnet.TLAs {
+			refs = append(refs, sourceRef{path: tla.Value, optional: true})
+		}
+	}
+	return refs
 }
 
 func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
@@ -565,7 +793,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), sparsePaths: sparseCheckoutPaths(q.ApplicationSource, q.AnnotationManifestGeneratePaths), resourceLimits: s.initConstants.ManifestGenerationLimits}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -583,6 +811,15 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	return res, err
 }
 
+// GenerateManifestStream generates the manifests like GenerateManifest, and streams them in compressed chunks
+func (s *Service) GenerateManifestStream(q *apiclient.ManifestRequest, stream apiclient.RepoServerService_GenerateManifestStreamServer) error {
+	res, err := s.GenerateManifest(stream.Context(), q)
+	if err != nil {
+		return err
+	}
+	return manifeststream.SendManifestResponse(stream.Context(), stream, res, manifeststream.DefaultManifestChunkSize)
+}
+
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
 	workDir, err := files.CreateTempDir("")
 	if err != nil {
@@ -622,7 +859,7 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 		if err != nil {
 			return nil, fmt.Errorf("failed to get app path: %w", err)
 		}
-		return &operationContext{appPath, ""}, nil
+		return &operationContext{appPath, "", "", nil}, nil
 	}, req)
 
 	var res *apiclient.ManifestResponse
@@ -716,97 +953,102 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		// Much of the multi-source handling logic is duplicated in resolveReferencedSources. If making changes here,
 		// check whether they should be replicated in resolveReferencedSources.
 		if q.HasMultipleSources {
-			if q.ApplicationSource.Helm != nil {
-				refFileParams := make([]string, 0)
-				for _, fileParam := range q.ApplicationSource.Helm.FileParameters {
-					refFileParams = append(refFileParams, fileParam.Path)
+			refs := getSourceRefs(q.ApplicationSource)
+
+			// Checkout every one of the referenced sources to the target revision before generating Manifests
+			for _, ref := range refs {
+				refVar, ok := ref.refVar(q.RefSources)
+				if !ok {
+					continue
 				}
-				refCandidates := append(q.ApplicationSource.Helm.ValueFiles, refFileParams...)
 
-				// Checkout every one of the referenced sources to the target revision before generating Manifests
-				for _, valueFile := range refCandidates {
-					if strings.HasPrefix(valueFile, "$") {
-						refVar := strings.Split(valueFile, "/")[0]
+				refSourceMapping, ok := q.RefSources[refVar]
+				if !ok {
+					if len(q.RefSources) == 0 {
+						ch.errCh <- fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
+					}
+					refKeys := make([]string, 0)
+					for refKey := range q.RefSources {
+						refKeys = append(refKeys, refKey)
+					}
+					ch.errCh <- fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
+					return
+				}
+				if refSourceMapping.Chart != "" {
+					ch.errCh <- fmt.Errorf("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
+					return
+				}
+				normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
+				closer, ok := repoRefs[normalizedRepoURL]
+				if ok {
+					if closer.revision != refSourceMapping.TargetRevision {
+						ch.errCh <- fmt.Errorf("cannot reference multiple revisions for the same repository (%s references %q while %s references %q)", refVar, refSourceMapping.TargetRevision, closer.key, closer.revision)
+						return
+					}
+				} else {
+					gitClient, referencedCommitSHA, err := s.newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache), git.WithLimiter(opContext.limiter))
+					if err != nil {
+						log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
+						ch.errCh <- fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
+						return
+					}
 
-						refSourceMapping, ok := q.RefSources[refVar]
-						if !ok {
-							if len(q.RefSources) == 0 {
-								ch.errCh <- fmt.Errorf("source referenced %q, but no source has a 'ref' field defined", refVar)
-							}
-							refKeys := make([]string, 0)
-							for refKey := range q.RefSources {
-								refKeys = append(refKeys, refKey)
-							}
-							ch.errCh <- fmt.Errorf("source referenced %q, which is not one of the available sources (%s)", refVar, strings.Join(refKeys, ", "))
-							return
+					if git.NormalizeGitURL(q.ApplicationSource.RepoURL) == normalizedRepoURL && commitSHA != referencedCommitSHA {
+						ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
+						return
+					}
+					closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
+						return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
+					})
+					if err != nil {
+						log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
+						ch.errCh <- err
+						return
+					}
+					defer func(closer goio.Closer) {
+						err := closer.Close()
+						if err != nil {
+							log.Errorf("Failed to release repo lock: %v", err)
 						}
-						if refSourceMapping.Chart != "" {
-							ch.errCh <- fmt.Errorf("source has a 'chart' field defined, but Helm charts are not yet not supported for 'ref' sources")
+					}(closer)
+
+					if refSourceMapping.Repo.SparseCheckout {
+						err = s.repoLock.RunExclusive(gitClient.Root(), func() error {
+							return gitClient.SparseCheckoutAdd(refSourceSparseCheckoutPaths(refs, q.RefSources, normalizedRepoURL))
+						})
+						if err != nil {
+							ch.errCh <- fmt.Errorf("failed to widen sparse checkout of referenced source %s: %w", normalizedRepoURL, err)
 							return
 						}
-						normalizedRepoURL := git.NormalizeGitURL(refSourceMapping.Repo.Repo)
-						closer, ok := repoRefs[normalizedRepoURL]
-						if ok {
-							if closer.revision != refSourceMapping.TargetRevision {
-								ch.errCh <- fmt.Errorf("cannot reference multiple revisions for the same repository (%s references %q while %s references %q)", refVar, refSourceMapping.TargetRevision, closer.key, closer.revision)
-								return
-							}
-						} else {
-							gitClient, referencedCommitSHA, err := s.newClientResolveRevision(&refSourceMapping.Repo, refSourceMapping.TargetRevision, git.WithCache(s.cache, !q.NoRevisionCache && !q.NoCache))
-							if err != nil {
-								log.Errorf("Failed to get git client for repo %s: %v", refSourceMapping.Repo.Repo, err)
-								ch.errCh <- fmt.Errorf("failed to get git client for repo %s", refSourceMapping.Repo.Repo)
-								return
-							}
+					}
 
-							if git.NormalizeGitURL(q.ApplicationSource.RepoURL) == normalizedRepoURL && commitSHA != referencedCommitSHA {
-								ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
+					// Symlink check must happen after acquiring lock.
+					if !s.initConstants.AllowOutOfBoundsSymlinks {
+						err := argopath.CheckOutOfBoundsSymlinks(gitClient.Root())
+						if err != nil {
+							oobError := &argopath.OutOfBoundsSymlinkError{}
+							if errors.As(err, &oobError) {
+								log.WithFields(log.Fields{
+									common.SecurityField: common.SecurityHigh,
+									"repo":               refSourceMapping.Repo,
+									"revision":           refSourceMapping.TargetRevision,
+									"file":               oobError.File,
+								}).Warn("repository contains out-of-bounds symlink")
+								ch.errCh <- fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
 								return
-							}
-							closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-								return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
-							})
-							if err != nil {
-								log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
+							} else {
 								ch.errCh <- err
 								return
 							}
-							defer func(closer goio.Closer) {
-								err := closer.Close()
-								if err != nil {
-									log.Errorf("Failed to release repo lock: %v", err)
-								}
-							}(closer)
-
-							// Symlink check must happen after acquiring lock.
-							if !s.initConstants.AllowOutOfBoundsSymlinks {
-								err := argopath.CheckOutOfBoundsSymlinks(gitClient.Root())
-								if err != nil {
-									oobError := &argopath.OutOfBoundsSymlinkError{}
-									if errors.As(err, &oobError) {
-										log.WithFields(log.Fields{
-											common.SecurityField: common.SecurityHigh,
-											"repo":               refSourceMapping.Repo,
-											"revision":           refSourceMapping.TargetRevision,
-											"file":               oobError.File,
-										}).Warn("repository contains out-of-bounds symlink")
-										ch.errCh <- fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
-										return
-									} else {
-										ch.errCh <- err
-										return
-									}
-								}
-							}
-
-							repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar}
 						}
 					}
+
+					repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar}
 				}
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithResourceLimits(s.initConstants.ManifestGenerationLimits), WithLimiter(opContext.limiter), WithJsonnetMaxStack(s.initConstants.JsonnetMaxStack))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -820,6 +1062,16 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			"appNamespace": q.Namespace,
 		})
 
+		var limitErr *executil.ResourceLimitExceededError
+		if errors.As(err, &limitErr) {
+			logCtx.Warnf("manifest generation exceeded resource limit: %v", limitErr)
+			repoURL := ""
+			if q.Repo != nil {
+				repoURL = q.Repo.Repo
+			}
+			s.metricsServer.IncManifestGenerationLimitExceeded(repoURL, limitErr.Limit)
+		}
+
 		// If manifest generation error caching is enabled
 		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
 			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
@@ -869,6 +1121,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
+	manifestGenResult.SignatureVerifier = opContext.signatureVerifier
 	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
@@ -1076,7 +1329,7 @@ var manifestGenerateLock = sync.NewKeyLock()
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+func runHelmBuild(appPath string, h helm.Helm, limiter *executil.Limiter) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -1091,7 +1344,7 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 		return err
 	}
 
-	err = h.DependencyBuild()
+	err = h.DependencyBuild(limiter)
 	if err != nil {
 		return fmt.Errorf("error building helm chart dependencies: %w", err)
 	}
@@ -1103,7 +1356,7 @@ func isSourcePermitted(url string, repos []string) bool {
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths io.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths io.TempPaths, limiter *executil.Limiter) ([]*unstructured.Unstructured, string, error) {
 	concurrencyAllowed := helmConcurrencyDefault || isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
@@ -1127,6 +1380,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	}
 
 	appHelm := q.ApplicationSource.Helm
+	var valuesFromPaths []string
 	var version string
 	var passCredentials bool
 	if appHelm != nil {
@@ -1144,6 +1398,22 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		templateOpts.Values = resolvedValueFiles
 
+		for _, v := range q.HelmValuesFrom {
+			rand, err := uuid.NewRandom()
+			if err != nil {
+				return nil, "", fmt.Errorf("error generating random filename for Helm values file: %w", err)
+			}
+			p := path.Join(os.TempDir(), rand.String())
+			defer func() {
+				_ = os.RemoveAll(p)
+			}()
+			if err = os.WriteFile(p, []byte(v.Values), 0o600); err != nil {
+				return nil, "", fmt.Errorf("error writing helm values file: %w", err)
+			}
+			valuesFromPaths = append(valuesFromPaths, p)
+			templateOpts.Values = append(templateOpts.Values, pathutil.ResolvedFilePath(p))
+		}
+
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
 			if err != nil {
@@ -1194,6 +1464,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	if templateOpts.Name == "" {
 		templateOpts.Name = q.AppName
 	}
+	templateOpts.Limiter = limiter
 	for i, j := range templateOpts.Set {
 		templateOpts.Set[i] = env.Envsubst(j)
 	}
@@ -1225,9 +1496,9 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		}
 
 		if concurrencyAllowed {
-			err = runHelmBuild(appPath, h)
+			err = runHelmBuild(appPath, h, limiter)
 		} else {
-			err = h.DependencyBuild()
+			err = h.DependencyBuild(limiter)
 		}
 
 		if err != nil {
@@ -1260,6 +1531,9 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	objs, err := kube.SplitYAML([]byte(out))
 
 	redactedCommand := redactPaths(command, gitRepoPaths, templateOpts.ExtraValues)
+	for _, p := range valuesFromPaths {
+		redactedCommand = strings.ReplaceAll(redactedCommand, p, "<temp file with values from source.helm.valuesFrom>")
+	}
 
 	return objs, redactedCommand, err
 }
@@ -1281,6 +1555,134 @@ func redactPaths(s string, paths io.TempPaths, extraValuesPath pathutil.Resolved
 	return s
 }
 
+// helmPostRender post-processes the manifests rendered by Helm using the post-renderer of the Helm source. Returns the
+// post-processed manifests and the commands run by the post-renderer.
+func helmPostRender(ctx context.Context, objs []*unstructured.Unstructured, appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitCredsStore git.CredsStore, refs *sourceRefResolver, tarExcludedGlobs []string, limiter *executil.Limiter) ([]*unstructured.Unstructured, []string, error) {
+	postRenderer := q.ApplicationSource.Helm.PostRenderer
+	if (postRenderer.Kustomize == nil) == (postRenderer.Plugin == nil) {
+		return nil, nil, errors.New("helm post-renderer must specify exactly one of kustomize or plugin")
+	}
+
+	dir, err := os.MkdirTemp("", "helm-post-renderer")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating helm post-renderer directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	var manifests bytes.Buffer
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshaling rendered manifest: %w", err)
+		}
+		manifests.WriteString("---\n")
+		manifests.Write(data)
+	}
+	if err := os.WriteFile(filepath.Join(dir, helmPostRendererManifestsFile), manifests.Bytes(), 0o644); err != nil {
+		return nil, nil, fmt.Errorf("error writing rendered manifests: %w", err)
+	}
+
+	if postRenderer.Plugin != nil {
+		objs, err := helmPostRenderPlugin(ctx, dir, postRenderer.Plugin, env, q, tarExcludedGlobs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("helm post-renderer plugin failed: %w", err)
+		}
+		return objs, nil, nil
+	}
+
+	componentPath, err := resolveHelmPostRendererPath(appPath, repoRoot, postRenderer.Kustomize.Path, refs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := writeHelmPostRendererKustomization(dir, componentPath); err != nil {
+		return nil, nil, err
+	}
+	repoURL := ""
+	kustomizeBinary := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	if q.KustomizeOptions != nil {
+		kustomizeBinary = q.KustomizeOptions.BinaryPath
+	}
+	k := kustomize.NewKustomizeApp(repoRoot, dir, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, q.Repo.Proxy, q.Repo.NoProxy)
+	objs, _, commands, err := k.Build(nil, q.KustomizeOptions, env, &kustomize.BuildOpts{
+		KubeVersion: text.SemVer(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion)),
+		APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+		Limiter:     limiter,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("helm post-renderer kustomize build failed: %w", err)
+	}
+	for i, command := range commands {
+		commands[i] = strings.ReplaceAll(command, dir, ".")
+	}
+	return objs, commands, nil
+}
+
+// resolveHelmPostRendererPath resolves the path of a Kustomize post-renderer, which is either relative to the
+// application path or references another source
+func resolveHelmPostRendererPath(appPath, repoRoot, p string, refs *sourceRefResolver) (string, error) {
+	_, resolvedPath, ok, err := refs.resolve(sourceRef{path: p, dir: true})
+	if err != nil {
+		return "", fmt.Errorf("error resolving helm post-renderer path: %w", err)
+	}
+	if ok {
+		return resolvedPath, nil
+	}
+	if strings.HasPrefix(p, "$") {
+		return "", fmt.Errorf("helm post-renderer path %q references an unknown source", p)
+	}
+	dir, err := pathutil.ResolveFileOrDirectoryPath(appPath, repoRoot, p)
+	if err != nil {
+		return "", fmt.Errorf("error resolving helm post-renderer path: %w", err)
+	}
+	return string(dir), nil
+}
+
+// writeHelmPostRendererKustomization writes a kustomization to the given directory, which applies the Kustomize
+// component at the given path to the manifests rendered by Helm
+func writeHelmPostRendererKustomization(dir, componentPath string) error {
+	component, err := filepath.Rel(dir, componentPath)
+	if err != nil {
+		return fmt.Errorf("error resolving helm post-renderer component: %w", err)
+	}
+	data, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{helmPostRendererManifestsFile},
+		"components": []string{component},
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "kustomization.yaml"), data, 0o644)
+}
+
+// helmPostRenderPlugin sends the manifests rendered by Helm in the given directory to the given config management
+// plugin. The plugin must read the manifests from the file helm-rendered.yaml in its working directory.
+func helmPostRenderPlugin(ctx context.Context, dir string, plugin *v1alpha1.ApplicationSourcePlugin, envVars *v1alpha1.Env, q *apiclient.ManifestRequest, tarExcludedGlobs []string) ([]*unstructured.Unstructured, error) {
+	if plugin.Name == "" {
+		return nil, errors.New("helm post-renderer plugin name is required")
+	}
+	env, err := getPluginParamEnvs(append(getPluginBaseEnvs(envVars, q), "ARGOCD_HELM_RENDERED_MANIFESTS="+helmPostRendererManifestsFile), plugin)
+	if err != nil {
+		return nil, err
+	}
+	conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, dir, dir, plugin.Name, env, tarExcludedGlobs)
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(conn)
+
+	cmpManifests, err := generateManifestsCMP(ctx, dir, dir, env, cmpClient, nil, tarExcludedGlobs)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests in cmp: %w", err)
+	}
+	return cmpManifestsToUnstructured(cmpManifests.Manifests)
+}
+
 func getResolvedValueFiles(
 	appPath string,
 	repoRoot string,
@@ -1337,14 +1739,10 @@ func getResolvedRefValueFile(
 ) (pathutil.ResolvedFilePath, error) {
 	pathStrings := strings.Split(rawValueFile, "/")
 
-	keyData, err := json.Marshal(map[string]string{"url": git.NormalizeGitURL(refSourceRepo), "project": project})
+	repoPath, err := getReferencedRepoPath(refSourceRepo, project, gitRepoPaths)
 	if err != nil {
 		return "", err
 	}
-	repoPath := gitRepoPaths.GetPathIfExists(string(keyData))
-	if repoPath == "" {
-		return "", fmt.Errorf("failed to find repo %q", refSourceRepo)
-	}
 	pathStrings[0] = "" // Remove first segment. It will be inserted by pathutil.ResolveValueFilePathOrUrl.
 	substitutedPath := strings.Join(pathStrings, "/")
 
@@ -1356,6 +1754,137 @@ func getResolvedRefValueFile(
 	return resolvedPath, nil
 }
 
+// getReferencedRepoPath returns the path of the checked out repository of a referenced source
+func getReferencedRepoPath(refSourceRepo string, project string, gitRepoPaths io.TempPaths) (string, error) {
+	keyData, err := json.Marshal(map[string]string{"url": git.NormalizeGitURL(refSourceRepo), "project": project})
+	if err != nil {
+		return "", err
+	}
+	repoPath := gitRepoPaths.GetPathIfExists(string(keyData))
+	if repoPath == "" {
+		return "", fmt.Errorf("failed to find repo %q", refSourceRepo)
+	}
+	return repoPath, nil
+}
+
+// sourceRefResolver resolves paths of the form $ref/path to the files and directories of the checked out referenced
+// sources. A nil resolver does not resolve any path.
+type sourceRefResolver struct {
+	refSources   map[string]*v1alpha1.RefTarget
+	gitRepoPaths io.TempPaths
+}
+
+// resolve returns the path of the repository of the referenced source and the absolute path of the referenced file or
+// directory. Returns false if the path does not reference a source.
+func (r *sourceRefResolver) resolve(ref sourceRef) (string, string, bool, error) {
+	if r == nil {
+		return "", "", false, nil
+	}
+	refVar, ok := ref.refVar(r.refSources)
+	if !ok {
+		return "", "", false, nil
+	}
+	refSource, ok := r.refSources[refVar]
+	if !ok {
+		return "", "", false, nil
+	}
+	repoPath, err := getReferencedRepoPath(refSource.Repo.Repo, refSource.Repo.Project, r.gitRepoPaths)
+	if err != nil {
+		return "", "", false, err
+	}
+	_, p, _ := strings.Cut(ref.path, "/")
+	// Resolve the path relative to the referenced repo and block any attempt at traversal.
+	resolvedPath, err := pathutil.ResolveFileOrDirectoryPath(repoPath, repoPath, p)
+	if err != nil {
+		return "", "", false, fmt.Errorf("error resolving path %q: %w", ref.path, err)
+	}
+	return repoPath, string(resolvedPath), true, nil
+}
+
+// resolveInclude resolves an include pattern of a directory source, which references another source. Returns the
+// directory of the referenced source to search for manifests, the path of the repository of the referenced source and
+// the pattern relative to the directory. Returns empty paths if the pattern does not reference a source.
+func (r *sourceRefResolver) resolveInclude(include string) (string, string, string, error) {
+	refVar, p, found := strings.Cut(include, "/")
+	if !found {
+		return "", "", include, nil
+	}
+	// the directory preceding the first wildcard is searched
+	dir, pattern := path.Split(p)
+	if i := strings.IndexAny(p, "*?[{"); i >= 0 {
+		dir = p[:strings.LastIndex(p[:i], "/")+1]
+		pattern = p[len(dir):]
+	}
+	repoPath, searchPath, ok, err := r.resolve(sourceRef{path: refVar + "/" + dir, dir: true})
+	if err != nil || !ok {
+		return "", "", include, err
+	}
+	return searchPath, repoPath, pattern, nil
+}
+
+// jsonnetImport returns the Jsonnet code importing the file at the given path as code or as a string
+func jsonnetImport(path string, code bool) string {
+	quotedPath := "@'" + strings.ReplaceAll(path, "'", "''") + "'"
+	if code {
+		return "import " + quotedPath
+	}
+	return "importstr " + quotedPath
+}
+
+// resolveKustomizeRefs returns a copy of the given Kustomize options, whose components and patches referencing other
+// sources are resolved. Components are replaced by their path relative to the application path, patch files are
+// inlined, since Kustomize doesn't load files outside the kustomization root.
+func resolveKustomizeRefs(appPath string, source *v1alpha1.ApplicationSourceKustomize, refs *sourceRefResolver) (*v1alpha1.ApplicationSourceKustomize, error) {
+	if source == nil {
+		return nil, nil
+	}
+	source = source.DeepCopy()
+	for i, component := range source.Components {
+		_, refPath, ok, err := refs.resolve(sourceRef{path: component, dir: true})
+		if err != nil {
+			return nil, fmt.Errorf("error resolving component: %w", err)
+		}
+		if ok {
+			if source.Components[i], err = filepath.Rel(appPath, refPath); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i, patch := range source.Patches {
+		_, refPath, ok, err := refs.resolve(sourceRef{path: patch.Path})
+		if err != nil {
+			return nil, fmt.Errorf("error resolving patch: %w", err)
+		}
+		if ok {
+			data, err := os.ReadFile(refPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read patch %q: %w", patch.Path, err)
+			}
+			source.Patches[i].Path = ""
+			source.Patches[i].Patch = string(data)
+		}
+	}
+	return source, nil
+}
+
+// kustomizeWithoutRefs returns a copy of the given Kustomize options without the components and patches referencing
+// other sources
+func kustomizeWithoutRefs(source *v1alpha1.ApplicationSourceKustomize, refSources map[string]*v1alpha1.RefTarget) *v1alpha1.ApplicationSourceKustomize {
+	if source == nil {
+		return nil
+	}
+	source = source.DeepCopy()
+	source.Components = slices.DeleteFunc(source.Components, func(component string) bool {
+		_, ok := sourceRef{path: component, dir: true}.refVar(refSources)
+		return ok
+	})
+	source.Patches = slices.DeleteFunc(source.Patches, func(patch v1alpha1.KustomizePatch) bool {
+		_, ok := sourceRef{path: patch.Path}.refVar(refSources)
+		return ok
+	})
+	return source
+}
+
 func getReferencedSource(rawValueFile string, refSources map[string]*v1alpha1.RefTarget) *v1alpha1.RefTarget {
 	if !strings.HasPrefix(rawValueFile, "$") {
 		return nil
@@ -1381,6 +1910,9 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		resourceLimits              executil.ResourceLimits
+		limiter                     *executil.Limiter
+		jsonnetMaxStack             int
 	}
 )
 
@@ -1417,14 +1949,57 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithResourceLimits limits the resources consumed by the processes run to generate the manifests.
+func WithResourceLimits(limits executil.ResourceLimits) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.resourceLimits = limits
+	}
+}
+
+// WithLimiter limits the resources consumed by the processes run to generate the manifests with the given limiter,
+// which is shared with the other commands of the operation, instead of a limiter created for the manifest generation.
+func WithLimiter(limiter *executil.Limiter) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.limiter = limiter
+	}
+}
+
+// WithJsonnetMaxStack limits the stack depth of Jsonnet evaluations.
+func WithJsonnetMaxStack(maxStack int) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetMaxStack = maxStack
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths io.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
 	var targetObjs []*unstructured.Unstructured
 
+	limiter := opt.limiter
+	if limiter == nil {
+		var err error
+		limiter, err = executil.NewLimiter(opt.resourceLimits)
+		if err != nil {
+			return nil, fmt.Errorf("error applying resource limits: %w", err)
+		}
+		defer func() {
+			if err := limiter.Close(); err != nil {
+				log.Warnf("Failed to clean up resource limits of manifest generation: %v", err)
+			}
+		}()
+	}
+	// The deadline of the limiter applies to config management plugins as well
+	if deadline, ok := limiter.Deadline(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
 	resourceTracking := argo.NewResourceTracking()
 
 	env := newEnv(q, revision)
+	refs := &sourceRefResolver{refSources: q.RefSources, gitRepoPaths: gitRepoPaths}
 
 	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, repoRoot, q.AppName, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs, env.Environ())
 	if err != nil {
@@ -1440,17 +2015,27 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths, limiter)
 		commands = append(commands, command)
+		if err == nil && q.ApplicationSource.Helm != nil && q.ApplicationSource.Helm.PostRenderer != nil {
+			var postRendererCommands []string
+			targetObjs, postRendererCommands, err = helmPostRender(ctx, targetObjs, appPath, repoRoot, env, q, gitCredsStore, refs, opt.cmpTarExcludedGlobs, limiter)
+			commands = append(commands, postRendererCommands...)
+		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		kustomizeBinary := ""
 		if q.KustomizeOptions != nil {
 			kustomizeBinary = q.KustomizeOptions.BinaryPath
 		}
+		kustomizeSource, err := resolveKustomizeRefs(appPath, q.ApplicationSource.Kustomize, refs)
+		if err != nil {
+			return nil, err
+		}
 		k := kustomize.NewKustomizeApp(repoRoot, appPath, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, q.Repo.Proxy, q.Repo.NoProxy)
-		targetObjs, _, commands, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions, env, &kustomize.BuildOpts{
+		targetObjs, _, commands, err = k.Build(kustomizeSource, q.KustomizeOptions, env, &kustomize.BuildOpts{
 			KubeVersion: text.SemVer(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion)),
 			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			Limiter:     limiter,
 		})
 	case v1alpha1.ApplicationSourceTypePlugin:
 		pluginName := ""
@@ -1460,7 +2045,11 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		// if pluginName is provided it has to be `<metadata.name>-<spec.version>` or just `<metadata.name>` if plugin version is empty
 		targetObjs, err = runConfigManagementPluginSidecars(ctx, appPath, repoRoot, pluginName, env, q, opt.cmpTarDoneCh, opt.cmpTarExcludedGlobs, opt.cmpUseManifestGeneratePaths)
 		if err != nil {
-			err = fmt.Errorf("plugin sidecar failed. %s", err.Error())
+			if timeoutErr := limiter.CheckTimeout("plugin " + pluginName); timeoutErr != nil {
+				err = timeoutErr
+			} else {
+				err = fmt.Errorf("plugin sidecar failed. %s", err.Error())
+			}
 		}
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
@@ -1468,7 +2057,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity, refs, limiter, opt.jsonnetMaxStack)
 	}
 	if err != nil {
 		return nil, err
@@ -1659,9 +2248,18 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity, refs *sourceRefResolver, limiter *executil.Limiter, jsonnetMaxStack int) ([]*unstructured.Unstructured, error) {
+	// If the include pattern references another source, the manifests are loaded from the referenced source instead.
+	searchPath, searchRoot, include, err := refs.resolveInclude(directory.Include)
+	if err != nil {
+		return nil, err
+	}
+	if searchPath == "" {
+		searchPath, searchRoot = appPath, repoRoot
+	}
+
 	// Validate the directory before loading any manifests to save memory.
-	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
+	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, searchPath, searchRoot, directory.Recurse, include, directory.Exclude, maxCombinedManifestQuantity)
 	if err != nil {
 		logCtx.Errorf("failed to get potentially valid manifests: %s", err)
 		return nil, fmt.Errorf("failed to get potentially valid manifests: %w", err)
@@ -1676,12 +2274,17 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 			if !discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, enabledManifestGeneration) {
 				continue
 			}
-			vm, err := makeJsonnetVm(appPath, repoRoot, directory.Jsonnet, env)
+			evaluation, err := makeJsonnetEvaluation(manifestPath, appPath, repoRoot, directory.Jsonnet, env, refs, jsonnetMaxStack)
 			if err != nil {
 				return nil, err
 			}
-			jsonStr, err := vm.EvaluateFile(manifestPath)
+			// Jsonnet is evaluated in a child process if resource limits are set, so that it can be stopped
+			jsonStr, err := evaluation.EvaluateWithLimiter(limiter)
 			if err != nil {
+				var limitErr *executil.ResourceLimitExceededError
+				if errors.As(err, &limitErr) {
+					return nil, err
+				}
 				return nil, status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", manifestFileInfo.Name(), err)
 			}
 
@@ -1897,32 +2500,43 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 	return potentiallyValidManifests, nil
 }
 
-func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
-	vm := jsonnet.MakeVM()
+// makeJsonnetEvaluation returns the evaluation of the given Jsonnet file of the application
+func makeJsonnetEvaluation(file string, appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env, refs *sourceRefResolver, maxStack int) (*jsonnetutil.Evaluation, error) {
+	evaluation := &jsonnetutil.Evaluation{File: file, MaxStack: maxStack}
 	for i, j := range sourceJsonnet.TLAs {
-		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
+		// a TLA referencing a file of another source is set to the contents of the file
+		_, refPath, ok, err := refs.resolve(sourceRef{path: j.Value, optional: true})
+		if err != nil {
+			return nil, fmt.Errorf("error resolving TLA %q: %w", j.Name, err)
+		}
+		if ok {
+			sourceJsonnet.TLAs[i].Value = jsonnetImport(refPath, j.Code)
+			sourceJsonnet.TLAs[i].Code = true
+		} else {
+			sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
+		}
 	}
 	for i, j := range sourceJsonnet.ExtVars {
 		sourceJsonnet.ExtVars[i].Value = env.Envsubst(j.Value)
 	}
 	for _, arg := range sourceJsonnet.TLAs {
-		if arg.Code {
-			vm.TLACode(arg.Name, arg.Value)
-		} else {
-			vm.TLAVar(arg.Name, arg.Value)
-		}
+		evaluation.TLAs = append(evaluation.TLAs, jsonnetutil.Var{Name: arg.Name, Value: arg.Value, Code: arg.Code})
 	}
 	for _, extVar := range sourceJsonnet.ExtVars {
-		if extVar.Code {
-			vm.ExtCode(extVar.Name, extVar.Value)
-		} else {
-			vm.ExtVar(extVar.Name, extVar.Value)
-		}
+		evaluation.ExtVars = append(evaluation.ExtVars, jsonnetutil.Var{Name: extVar.Name, Value: extVar.Value, Code: extVar.Code})
 	}
 
 	// Jsonnet Imports relative to the repository path
 	jpaths := []string{appPath}
 	for _, p := range sourceJsonnet.Libs {
+		_, refPath, ok, err := refs.resolve(sourceRef{path: p, dir: true})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			jpaths = append(jpaths, refPath)
+			continue
+		}
 		// the jsonnet library path is relative to the repository root, not application path
 		jpath, err := pathutil.ResolveFileOrDirectoryPath(repoRoot, repoRoot, p)
 		if err != nil {
@@ -1930,20 +2544,21 @@ func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 		}
 		jpaths = append(jpaths, string(jpath))
 	}
+	evaluation.JPaths = jpaths
 
-	vm.Importer(&jsonnet.FileImporter{
-		JPaths: jpaths,
-	})
-
-	return vm, nil
+	return evaluation, nil
 }
 
 func getPluginEnvs(env *v1alpha1.Env, q *apiclient.ManifestRequest) ([]string, error) {
+	return getPluginParamEnvs(getPluginBaseEnvs(env, q), q.ApplicationSource.Plugin)
+}
+
+// getPluginBaseEnvs gets the build environment variables and the Kubernetes version variables passed to plugins
+func getPluginBaseEnvs(env *v1alpha1.Env, q *apiclient.ManifestRequest) []string {
 	envVars := env.Environ()
 	envVars = append(envVars, "KUBE_VERSION="+text.SemVer(q.KubeVersion))
 	envVars = append(envVars, "KUBE_API_VERSIONS="+strings.Join(q.ApiVersions, ","))
-
-	return getPluginParamEnvs(envVars, q.ApplicationSource.Plugin)
+	return envVars
 }
 
 // getPluginParamEnvs gets environment variables for plugin parameter announcement generation.
@@ -2001,8 +2616,13 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 	if err != nil {
 		return nil, fmt.Errorf("error generating manifests in cmp: %w", err)
 	}
+	return cmpManifestsToUnstructured(cmpManifests.Manifests)
+}
+
+// cmpManifestsToUnstructured converts the manifests generated by a config management plugin to unstructured objects
+func cmpManifestsToUnstructured(cmpManifests []string) ([]*unstructured.Unstructured, error) {
 	var manifests []*unstructured.Unstructured
-	for _, manifestString := range cmpManifests.Manifests {
+	for _, manifestString := range cmpManifests {
 		manifestObjs, err := kube.SplitYAML([]byte(manifestString))
 		if err != nil {
 			sanitizedManifestString := manifestString
@@ -2074,7 +2694,7 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 		return nil
 	}
 
-	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache}
+	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache, sparsePaths: sparseCheckoutPaths(q.Source, "")}
 	err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
 
 	return res, err
@@ -2212,7 +2832,8 @@ func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apicl
 		ApplicationSource: q.Source,
 	}
 	env := newEnv(&fakeManifestRequest, reversion)
-	_, images, _, err := k.Build(q.Source.Kustomize, q.KustomizeOptions, env, nil)
+	// referenced sources are not checked out when getting the application details
+	_, images, _, err := k.Build(kustomizeWithoutRefs(q.Source.Kustomize, q.RefSources), q.KustomizeOptions, env, nil)
 	if err != nil {
 		return err
 	}
@@ -2319,20 +2940,15 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 
 	// Run gpg verify-commit on the revision
 	signatureInfo := ""
-	if gpg.IsGPGEnabled() && q.CheckSignature {
-		cs, err := gitClient.VerifyCommitSignature(q.Revision)
+	if q.CheckSignature {
+		verifier, cs, err := gitClient.VerifyCommitSignature(q.Revision)
 		if err != nil {
 			log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", q.Revision, q.Repo.Repo, err)
 			return nil, err
 		}
 
 		if cs != "" {
-			vr := gpg.ParseGitCommitVerification(cs)
-			if vr.Result == gpg.VerifyResultUnknown {
-				signatureInfo = fmt.Sprintf("UNKNOWN signature: %s", vr.Message)
-			} else {
-				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
-			}
+			signatureInfo = signing.ParseVerification(verifier, cs).String()
 		} else {
 			signatureInfo = "Revision is not signed."
 		}
@@ -2347,8 +2963,17 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 func (s *Service) GetRevisionChartDetails(ctx context.Context, q *apiclient.RepoServerRevisionChartDetailsRequest) (*v1alpha1.ChartDetails, error) {
 	details, err := s.cache.GetRevisionChartDetails(q.Repo.Repo, q.Name, q.Revision)
 	if err == nil {
-		log.Infof("revision chart details cache hit: %s/%s/%s", q.Repo.Repo, q.Name, q.Revision)
-		return details, nil
+		// As for revision metadata, a cache hit without signature info is handled as a cache miss if a signature check
+		// is requested
+		if q.CheckSignature && details.SignatureInfo == "" {
+			log.Infof("revision chart details cache hit, but need to regenerate due to missing signature info: %s/%s/%s", q.Repo.Repo, q.Name, q.Revision)
+		} else {
+			log.Infof("revision chart details cache hit: %s/%s/%s", q.Repo.Repo, q.Name, q.Revision)
+			if !q.CheckSignature {
+				details.SignatureInfo = ""
+			}
+			return details, nil
+		}
 	} else {
 		if errors.Is(err, cache.ErrCacheMiss) {
 			log.Infof("revision metadata cache miss: %s/%s/%s", q.Repo.Repo, q.Name, q.Revision)
@@ -2378,6 +3003,18 @@ func (s *Service) GetRevisionChartDetails(ctx context.Context, q *apiclient.Repo
 	if err != nil {
 		return nil, fmt.Errorf("error getting chart details: %w", err)
 	}
+	if q.CheckSignature {
+		verifier, cs, err := helmClient.VerifyChart(q.Name, revision, q.Repo.Project, false)
+		if err != nil {
+			log.Errorf("error verifying signature of chart '%s:%s' in repo '%s': %v", q.Name, revision, q.Repo.Repo, err)
+			return nil, fmt.Errorf("error verifying signature of chart: %w", err)
+		}
+		if cs != "" {
+			details.SignatureInfo = signing.ParseVerification(verifier, cs).String()
+		} else {
+			details.SignatureInfo = "Chart is not signed."
+		}
+	}
 	_ = s.cache.SetRevisionChartDetails(q.Repo.Repo, q.Name, q.Revision, details)
 	return details, nil
 }
@@ -2398,7 +3035,12 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	if err != nil {
 		return nil, err
 	}
-	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	return s.newClientAt(repo, repoPath, opts...)
+}
+
+// newClientAt creates a git client for the repository, or a working tree of it, at the given path
+func (s *Service) newClientAt(repo *v1alpha1.Repository, repoPath string, opts ...git.ClientOpts) (git.Client, error) {
+	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)), git.WithPartialClone(repo.PartialClone), git.WithSparseCheckout(repo.SparseCheckout))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2417,9 +3059,16 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 	return gitClient, commitSHA, nil
 }
 
+// newHelmClientForRepo creates a Helm client for the repository
+func (s *Service) newHelmClientForRepo(repo *v1alpha1.Repository, opts ...helm.ClientOpts) helm.Client {
+	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
+	opts = append([]helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}, opts...)
+	return s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, opts...)
+}
+
 func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, repo.NoProxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
+	helmClient := s.newHelmClientForRepo(repo)
 	if helm.IsVersion(revision) {
 		return helmClient, revision, nil
 	}
@@ -2483,6 +3132,10 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) (goio.Closer, error) {
 	closer := s.gitRepoInitializer(gitClient.Root())
 	err := checkoutRevision(gitClient, revision, submoduleEnabled)
+	if s.worktrees != nil {
+		// the working trees of the repository are removed if its directory has been wiped by the initialization
+		s.worktrees.purge(gitClient.Root())
+	}
 	if err != nil {
 		s.metricsServer.IncGitFetchFail(gitClient.Root(), revision)
 	}
@@ -2657,6 +3310,15 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 	}
 	defer io.Close(closer)
 
+	if repo.SparseCheckout {
+		err = s.repoLock.RunExclusive(gitClient.Root(), func() error {
+			return gitClient.SparseCheckoutAdd([]string{gitPath})
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to widen sparse checkout of git repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
+		}
+	}
+
 	gitFiles, err := gitClient.LsFiles(gitPath, enableNewGitFileGlobbing)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to list files. repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
@@ -2683,8 +3345,8 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 }
 
 func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision string, repo *v1alpha1.Repository) error {
-	if gpg.IsGPGEnabled() && verifyCommit {
-		cs, err := gitClient.VerifyCommitSignature(revision)
+	if verifyCommit {
+		verifier, cs, err := gitClient.VerifyCommitSignature(revision)
 		if err != nil {
 			log.Errorf("error verifying signature of commit '%s' in repo '%s': %v", revision, repo.Repo, err)
 			return err
@@ -2693,11 +3355,11 @@ func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision str
 		if cs == "" {
 			return fmt.Errorf("revision %s is not signed", revision)
 		} else {
-			vr := gpg.ParseGitCommitVerification(cs)
+			vr := signing.ParseVerification(verifier, cs)
 			if vr.Result == gpg.VerifyResultUnknown {
 				return fmt.Errorf("UNKNOWN signature: %s", vr.Message)
 			} else {
-				log.Debugf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
+				log.Debug(vr.String())
 			}
 		}
 	}
@@ -2743,7 +3405,20 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 
 	repoRoot := gitClient.Root()
 	var paths []string
-	if err := filepath.WalkDir(repoRoot, func(path string, entry fs.DirEntry, fnErr error) error {
+	if repo.SparseCheckout {
+		// the working tree only contains the directories used by applications, so list the directories of the revision
+		// from git instead
+		dirs, err := gitClient.LsDirectories()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to list directories of git repo %s with revision %s: %v", repo.Repo, revision, err)
+		}
+		for _, dir := range dirs {
+			if !s.initConstants.IncludeHiddenDirectories && isHiddenPath(dir) {
+				continue
+			}
+			paths = append(paths, dir)
+		}
+	} else if err := filepath.WalkDir(repoRoot, func(path string, entry fs.DirEntry, fnErr error) error {
 		if fnErr != nil {
 			return fmt.Errorf("error walking the file tree: %w", fnErr)
 		}
@@ -2869,9 +3544,9 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 
 func (s *Service) updateCachedRevision(logCtx *log.Entry, oldRev string, newRev string, request *apiclient.UpdateRevisionForPathsRequest, gitClientOpts git.ClientOpts) error {
 	repoRefs := make(map[string]string)
-	if request.HasMultipleSources && request.ApplicationSource.Helm != nil {
+	if request.HasMultipleSources {
 		var err error
-		repoRefs, err = resolveReferencedSources(true, request.ApplicationSource.Helm, request.RefSources, s.newClientResolveRevision, gitClientOpts)
+		repoRefs, err = resolveReferencedSources(true, request.ApplicationSource, request.RefSources, s.newClientResolveRevision, gitClientOpts)
 		if err != nil {
 			return fmt.Errorf("failed to get repo refs for application %s in repo %s from revision %s: %w", request.AppName, request.GetRepo().Repo, request.Revision, err)
 		}
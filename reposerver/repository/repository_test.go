@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,14 +44,25 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
 	fileutil "github.com/argoproj/argo-cd/v2/test/fixture/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v2/util/git/mocks"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	helmmocks "github.com/argoproj/argo-cd/v2/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	iomocks "github.com/argoproj/argo-cd/v2/util/io/mocks"
+	jsonnetutil "github.com/argoproj/argo-cd/v2/util/jsonnet"
+	"github.com/argoproj/argo-cd/v2/util/manifeststream"
 )
 
+func TestMain(m *testing.M) {
+	// The test binary evaluates Jsonnet files with resource limits in place of the argocd binary
+	if jsonnetutil.IsChildProcess() {
+		jsonnetutil.RunChildProcess()
+	}
+	os.Exit(m.Run())
+}
+
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
 gpg:                using RSA key 4AEE18F83AFDEB23
 gpg: Good signature from "GitHub (web-flow commit signing) <noreply@github.com>" [ultimate]
@@ -114,9 +126,9 @@ func newServiceWithMocks(t *testing.T, root string, signed bool) (*Service, *git
 		gitClient.On("Root").Return(root)
 		gitClient.On("IsAnnotatedTag").Return(false)
 		if signed {
-			gitClient.On("VerifyCommitSignature", mock.Anything).Return(testSignature, nil)
+			gitClient.On("VerifyCommitSignature", mock.Anything).Return(common.SignatureVerifierGPG, testSignature, nil)
 		} else {
-			gitClient.On("VerifyCommitSignature", mock.Anything).Return("", nil)
+			gitClient.On("VerifyCommitSignature", mock.Anything).Return("", "", nil)
 		}
 
 		chart := "my-chart"
@@ -223,6 +235,50 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+type manifestResponseStream struct {
+	grpc.ServerStream
+	chunks []*apiclient.ManifestResponseChunk
+}
+
+func (s *manifestResponseStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *manifestResponseStream) Send(chunk *apiclient.ManifestResponseChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *manifestResponseStream) Recv() (*apiclient.ManifestResponseChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, goio.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func TestGenerateManifestStream(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	q := apiclient.ManifestRequest{
+		Repo:               &argoappv1.Repository{},
+		ApplicationSource:  &argoappv1.ApplicationSource{Path: "."},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	stream := &manifestResponseStream{}
+	err := service.GenerateManifestStream(&q, stream)
+	require.NoError(t, err)
+	assert.Empty(t, stream.chunks[0].GetResponse().GetManifests())
+
+	res, err := manifeststream.ReceiveManifestResponse(stream)
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, "Directory", res.SourceType)
+}
+
 func Test_GenerateManifests_NoOutOfBoundsAccess(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -1759,6 +1815,7 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 		res, err := service.GenerateManifest(context.Background(), &q)
 		require.NoError(t, err)
 		assert.Equal(t, testSignature, res.VerifyResult)
+		assert.Equal(t, common.SignatureVerifierGPG, res.SignatureVerifier)
 	}
 	// Commit with signature and verification not requested
 	{
@@ -1944,8 +2001,11 @@ func mkTempParameters(source string) string {
 // the test would modify the data when run.
 func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, path string)) {
 	tempDir := mkTempParameters("./testdata/app-parameters")
+	// the copy is removed even if the runner fails the test
+	t.Cleanup(func() {
+		os.RemoveAll(tempDir)
+	})
 	runner(t, filepath.Join(tempDir, "app-parameters", path))
-	os.RemoveAll(tempDir)
 }
 
 func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
@@ -2334,7 +2394,7 @@ func TestFindResources(t *testing.T) {
 				Recurse: true,
 				Include: tc.include,
 				Exclude: tc.exclude,
-			}, map[string]bool{}, resource.MustParse("0"))
+			}, map[string]bool{}, resource.MustParse("0"), nil, nil, 0)
 			require.NoError(t, err)
 			var names []string
 			for i := range objs {
@@ -2349,7 +2409,7 @@ func TestFindManifests_Exclude(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "subdir/deploymentSub.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil, nil, 0)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 1)
@@ -2361,7 +2421,7 @@ func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "nothing.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil, nil, 0)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 2)
@@ -2696,7 +2756,7 @@ func Test_findManifests(t *testing.T) {
 		err = os.Chmod(appDir, 0o000)
 		require.NoError(t, err)
 
-		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 
@@ -2708,20 +2768,20 @@ func Test_findManifests(t *testing.T) {
 	})
 
 	t.Run("no recursion when recursion is disabled", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 	})
 
 	t.Run("recursion when recursion is enabled", func(t *testing.T) {
 		recurse := argoappv1.ApplicationSourceDirectory{Recurse: true}
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 4)
 		require.NoError(t, err)
 	})
 
 	t.Run("non-JSON/YAML is skipped", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2733,14 +2793,14 @@ func Test_findManifests(t *testing.T) {
 		defer os.Remove(path.Join(testDir, "a.json"))
 		require.NoError(t, fileutil.CreateSymlink(t, testDir, "b.json", "a.json"))
 		defer os.Remove(path.Join(testDir, "b.json"))
-		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("out-of-bounds symlink should throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/out-of-bounds-link")
-		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
@@ -2750,13 +2810,13 @@ func Test_findManifests(t *testing.T) {
 		require.NoError(t, err)
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("symlink to nowhere should be ignored", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2767,75 +2827,90 @@ func Test_findManifests(t *testing.T) {
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
 		// The file is 35 bytes.
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("group of files should be limited at precisely the sum of their size", func(t *testing.T) {
 		// There is a total of 10 files, each file being 10 bytes.
-		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"))
+		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"), nil, nil, 0)
 		assert.Len(t, manifests, 10)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"))
+		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("jsonnet isn't counted against size limit", func(t *testing.T) {
 		// Each file is 36 bytes. Only the 36-byte json file should be counted against the limit.
-		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"))
+		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"), nil, nil, 0)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"))
+		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
+	t.Run("jsonnet output exceeds the output size limit", func(t *testing.T) {
+		limiter, err := executil.NewLimiter(executil.ResourceLimits{OutputSize: 10})
+		require.NoError(t, err)
+		_, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("0"), nil, limiter, 0)
+		var limitErr *executil.ResourceLimitExceededError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, executil.ResourceLimitOutputSize, limitErr.Limit)
+		assert.Equal(t, "`jsonnet test.jsonnet` exceeded the output size limit of 10 B", limitErr.Error())
+	})
+
+	t.Run("jsonnet exceeds the max stack", func(t *testing.T) {
+		_, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 1)
+		assert.ErrorContains(t, err, "max stack frames exceeded")
+	})
+
 	t.Run("partially valid YAML file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/partially-valid-yaml")
-		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("irrelevant YAML gets skipped, relevant YAML gets parsed", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("multiple JSON objects in one file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/json-list")
-		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid JSON throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-json")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("valid JSON returns manifest and no error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("YAML with an empty document doesn't throw an error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"), nil, nil, 0)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
@@ -3405,7 +3480,7 @@ func TestErrorGetGitDirectories(t *testing.T) {
 			s, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, helmClient *helmmocks.Client, paths *iomocks.TempPaths) {
 				gitClient.On("Checkout", mock.Anything, mock.Anything).Return(nil)
 				gitClient.On("LsRemote", mock.Anything).Return("", fmt.Errorf("ah error"))
-				gitClient.On("VerifyCommitSignature", mock.Anything).Return("", fmt.Errorf("revision %s is not signed", "sadfsadf"))
+				gitClient.On("VerifyCommitSignature", mock.Anything).Return("", "", fmt.Errorf("revision %s is not signed", "sadfsadf"))
 				gitClient.On("Root").Return(root)
 				paths.On("GetPath", mock.Anything).Return(".", nil)
 				paths.On("GetPathIfExists", mock.Anything).Return(".", nil)
@@ -4075,7 +4150,7 @@ func TestVerifyCommitSignature(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return(testSignature, nil)
+			Return(common.SignatureVerifierGPG, testSignature, nil)
 
 		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
 		require.NoError(t, err)
@@ -4085,7 +4160,7 @@ func TestVerifyCommitSignature(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return("", nil)
+			Return("", "", nil)
 
 		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
 		require.Error(t, err)
@@ -4096,7 +4171,7 @@ func TestVerifyCommitSignature(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return("", fmt.Errorf("UNKNOWN signature: gpg: Unknown signature from ABCDEFGH"))
+			Return("", "", fmt.Errorf("UNKNOWN signature: gpg: Unknown signature from ABCDEFGH"))
 
 		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
 		require.Error(t, err)
@@ -4107,13 +4182,24 @@ func TestVerifyCommitSignature(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "true")
 		mockGitClient := &gitmocks.Client{}
 		mockGitClient.On("VerifyCommitSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-			Return("", fmt.Errorf("error verifying signature of commit 'abcd1234' in repo 'https://github.com/example/repo.git': failed to verify signature"))
+			Return("", "", fmt.Errorf("error verifying signature of commit 'abcd1234' in repo 'https://github.com/example/repo.git': failed to verify signature"))
 
 		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
 		require.Error(t, err)
 		assert.Equal(t, "error verifying signature of commit 'abcd1234' in repo 'https://github.com/example/repo.git': failed to verify signature", err.Error())
 	})
 
+	t.Run("VerifyCommitSignature with GPG disabled", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		mockGitClient := &gitmocks.Client{}
+		mockGitClient.On("VerifyCommitSignature", mock.Anything).
+			Return(common.SignatureVerifierSSH, "Signature verification failed: incorrect signature\nCould not verify signature.\n", nil)
+
+		err := verifyCommitSignature(true, mockGitClient, "abcd1234", repo)
+		require.NoError(t, err)
+		mockGitClient.AssertCalled(t, "VerifyCommitSignature", "abcd1234")
+	})
+
 	t.Run("VerifyCommitSignature with signature verification disabled", func(t *testing.T) {
 		t.Setenv("ARGOCD_GPG_ENABLED", "false")
 		mockGitClient := &gitmocks.Client{}
@@ -4302,3 +4388,259 @@ images:
 		}, res.Commands)
 	})
 }
+
+func Test_sparseCheckoutPaths(t *testing.T) {
+	source := &argoappv1.ApplicationSource{
+		Path: "apps/guestbook",
+		Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml", "../../envs/prod/values.yaml", "$values/values.yaml", "https://example.com/values.yaml", "../../../outside.yaml"}},
+	}
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook", "envs/prod", "lib", "apps/guestbook"}, sparseCheckoutPaths(source, "/lib;;."))
+
+	source.Helm.PostRenderer = &argoappv1.HelmPostRenderer{Kustomize: &argoappv1.HelmPostRendererKustomize{Path: "../post-render"}}
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook", "envs/prod", "apps/post-render"}, sparseCheckoutPaths(source, ""))
+	source.Helm.PostRenderer.Kustomize.Path = "$values/post-render"
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook", "envs/prod"}, sparseCheckoutPaths(source, ""))
+}
+
+func Test_refSourceSparseCheckoutPaths(t *testing.T) {
+	refSources := map[string]*argoappv1.RefTarget{
+		"$values": {Repo: argoappv1.Repository{Repo: "https://github.com/org/values.git"}},
+		"$other":  {Repo: argoappv1.Repository{Repo: "https://github.com/org/other.git"}},
+	}
+	refs := []sourceRef{
+		{path: "$values/envs/prod/values.yaml"},
+		{path: "$values/components/replicas", dir: true},
+		{path: "$values/manifests/*.yaml", dir: true},
+		{path: "$other/values.yaml"},
+		{path: "$missing/values.yaml"},
+		{path: "$HOME", optional: true},
+		{path: "values.yaml"},
+	}
+	paths := refSourceSparseCheckoutPaths(refs, refSources, git.NormalizeGitURL("https://github.com/org/values"))
+	assert.Equal(t, []string{"envs/prod", "components/replicas", "manifests/*.yaml"}, paths)
+}
+
+func Test_getSourceRefs(t *testing.T) {
+	source := &argoappv1.ApplicationSource{
+		Kustomize: &argoappv1.ApplicationSourceKustomize{
+			Components: []string{"$shared/components/replicas"},
+			Patches:    argoappv1.KustomizePatches{{Path: "$shared/patch.yaml"}, {Patch: "inline"}},
+		},
+		Directory: &argoappv1.ApplicationSourceDirectory{
+			Include: "$shared/manifests/*.yaml",
+			Jsonnet: argoappv1.ApplicationSourceJsonnet{
+				Libs: []string{"$shared/lib"},
+				TLAs: []argoappv1.JsonnetVar{{Name: "config", Value: "$shared/config.json"}},
+			},
+		},
+	}
+	assert.Equal(t, []sourceRef{
+		{path: "$shared/components/replicas", dir: true},
+		{path: "$shared/patch.yaml"},
+		{path: "$shared/manifests/*.yaml", dir: true},
+		{path: "$shared/lib", dir: true},
+		{path: "$shared/config.json", optional: true},
+	}, getSourceRefs(source))
+
+	refSources := map[string]*argoappv1.RefTarget{"$shared": {}}
+	for _, tc := range []struct {
+		ref    sourceRef
+		refVar string
+		ok     bool
+	}{
+		{sourceRef{path: "$shared/lib"}, "$shared", true},
+		{sourceRef{path: "$missing/lib"}, "$missing", true},
+		{sourceRef{path: "lib"}, "", false},
+		{sourceRef{path: "$shared/config.json", optional: true}, "$shared", true},
+		{sourceRef{path: "$ARGOCD_APP_NAME", optional: true}, "", false},
+		{sourceRef{path: "$shared", optional: true}, "", false},
+	} {
+		refVar, ok := tc.ref.refVar(refSources)
+		assert.Equal(t, tc.refVar, refVar, tc.ref.path)
+		assert.Equal(t, tc.ok, ok, tc.ref.path)
+	}
+}
+
+// newSourceRefResolver creates a resolver for the source referenced by $shared, which is checked out with the given files
+func newSourceRefResolver(t *testing.T, files map[string]string) (*sourceRefResolver, string) {
+	t.Helper()
+	repoPath := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(repoPath, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0o644))
+	}
+	repoURL := "https://github.com/org/shared.git"
+	keyData, err := json.Marshal(map[string]string{"url": git.NormalizeGitURL(repoURL), "project": ""})
+	require.NoError(t, err)
+	gitRepoPaths := io.NewRandomizedTempPaths(t.TempDir())
+	gitRepoPaths.Add(string(keyData), repoPath)
+	return &sourceRefResolver{
+		refSources:   map[string]*argoappv1.RefTarget{"$shared": {Repo: argoappv1.Repository{Repo: repoURL}}},
+		gitRepoPaths: gitRepoPaths,
+	}, repoPath
+}
+
+func Test_findManifests_SourceRefs(t *testing.T) {
+	refs, _ := newSourceRefResolver(t, map[string]string{
+		"lib/util.libsonnet":     `{ name: 'from-lib' }`,
+		"config.json":            `{ "replicas": 3 }`,
+		"label.txt":              `from-file`,
+		"manifests/cm.yaml":      "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: shared\n",
+		"manifests/sub/cm.yaml":  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: shared-sub\n",
+		"manifests/ignored.json": "{}",
+	})
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`
+local util = import 'util.libsonnet';
+function(config, label, name) {
+  apiVersion: 'v1',
+  kind: 'ConfigMap',
+  metadata: { name: util.name, labels: { label: label, name: name } },
+  data: { replicas: std.toString(config.replicas) },
+}`), 0o644))
+	env := &argoappv1.Env{{Name: "ARGOCD_APP_NAME", Value: "my-app"}}
+	logCtx := log.WithField("test", t.Name())
+
+	t.Run("Jsonnet", func(t *testing.T) {
+		objs, err := findManifests(logCtx, appPath, appPath, env, argoappv1.ApplicationSourceDirectory{
+			Jsonnet: argoappv1.ApplicationSourceJsonnet{
+				Libs: []string{"$shared/lib"},
+				TLAs: []argoappv1.JsonnetVar{
+					{Name: "config", Value: "$shared/config.json", Code: true},
+					{Name: "label", Value: "$shared/label.txt"},
+					{Name: "name", Value: "$ARGOCD_APP_NAME"},
+				},
+			},
+		}, nil, resource.MustParse("0"), refs, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "from-lib", objs[0].GetName())
+		assert.Equal(t, map[string]string{"label": "from-file", "name": "my-app"}, objs[0].GetLabels())
+		replicas, _, _ := unstructured.NestedString(objs[0].Object, "data", "replicas")
+		assert.Equal(t, "3", replicas)
+	})
+
+	t.Run("Include", func(t *testing.T) {
+		objs, err := findManifests(logCtx, appPath, appPath, env, argoappv1.ApplicationSourceDirectory{Include: "$shared/manifests/*.yaml"}, nil, resource.MustParse("0"), refs, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "shared", objs[0].GetName())
+
+		objs, err = findManifests(logCtx, appPath, appPath, env, argoappv1.ApplicationSourceDirectory{Include: "$shared/manifests/*.yaml", Recurse: true}, nil, resource.MustParse("0"), refs, nil, 0)
+		require.NoError(t, err)
+		assert.Len(t, objs, 2)
+
+		objs, err = findManifests(logCtx, appPath, appPath, env, argoappv1.ApplicationSourceDirectory{Include: "$shared/manifests/cm.yaml"}, nil, resource.MustParse("0"), refs, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "shared", objs[0].GetName())
+
+		_, err = findManifests(logCtx, appPath, appPath, env, argoappv1.ApplicationSourceDirectory{Include: "$shared/../*.yaml"}, nil, resource.MustParse("0"), refs, nil, 0)
+		require.Error(t, err)
+	})
+}
+
+func Test_resolveKustomizeRefs(t *testing.T) {
+	refs, repoPath := newSourceRefResolver(t, map[string]string{
+		"components/replicas/kustomization.yaml": "kind: Component\n",
+		"patches/patch.yaml":                     "- op: add\n",
+	})
+	appPath := t.TempDir()
+	source := &argoappv1.ApplicationSourceKustomize{
+		Components: []string{"../local", "$shared/components/replicas"},
+		Patches:    argoappv1.KustomizePatches{{Path: "local.yaml"}, {Path: "$shared/patches/patch.yaml", Target: &argoappv1.KustomizeSelector{KustomizeResId: argoappv1.KustomizeResId{KustomizeGvk: argoappv1.KustomizeGvk{Kind: "Deployment"}}}}},
+	}
+
+	resolved, err := resolveKustomizeRefs(appPath, source, refs)
+	require.NoError(t, err)
+	component, err := filepath.Rel(appPath, filepath.Join(repoPath, "components/replicas"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"../local", component}, resolved.Components)
+	assert.Equal(t, argoappv1.KustomizePatches{{Path: "local.yaml"}, {Patch: "- op: add\n", Target: &argoappv1.KustomizeSelector{KustomizeResId: argoappv1.KustomizeResId{KustomizeGvk: argoappv1.KustomizeGvk{Kind: "Deployment"}}}}}, resolved.Patches)
+	// the source is not modified
+	assert.Equal(t, "$shared/components/replicas", source.Components[1])
+
+	_, err = resolveKustomizeRefs(appPath, &argoappv1.ApplicationSourceKustomize{Components: []string{"$shared/../outside"}}, refs)
+	require.Error(t, err)
+
+	withoutRefs := kustomizeWithoutRefs(source, refs.refSources)
+	assert.Equal(t, []string{"../local"}, withoutRefs.Components)
+	assert.Equal(t, argoappv1.KustomizePatches{{Path: "local.yaml"}}, withoutRefs.Patches)
+}
+
+func Test_repoRelativePath(t *testing.T) {
+	for _, tc := range []struct {
+		appPath  string
+		path     string
+		expected string
+		ok       bool
+	}{
+		{"apps/a", "values.yaml", "apps/a/values.yaml", true},
+		{"apps/a", "../b", "apps/b", true},
+		{"apps/a", "/base", "base", true},
+		{"apps/a", "/", "", true},
+		{"apps/a", "../../..", "", false},
+		{"", "../outside", "", false},
+	} {
+		p, ok := repoRelativePath(tc.appPath, tc.path)
+		assert.Equal(t, tc.ok, ok, tc.path)
+		assert.Equal(t, tc.expected, p, tc.path)
+	}
+}
+
+func Test_helmPostRender(t *testing.T) {
+	refs, repoPath := newSourceRefResolver(t, map[string]string{
+		"post-render/kustomization.yaml": "kind: Component\n",
+	})
+	appPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "post-render"), 0o755))
+
+	t.Run("ResolvePath", func(t *testing.T) {
+		p, err := resolveHelmPostRendererPath(appPath, appPath, "post-render", refs)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(appPath, "post-render"), p)
+
+		p, err = resolveHelmPostRendererPath(appPath, appPath, "$shared/post-render", refs)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(repoPath, "post-render"), p)
+
+		_, err = resolveHelmPostRendererPath(appPath, appPath, "$missing/post-render", refs)
+		require.ErrorContains(t, err, "unknown source")
+
+		_, err = resolveHelmPostRendererPath(appPath, appPath, "$shared/../post-render", refs)
+		require.Error(t, err)
+	})
+
+	t.Run("Kustomization", func(t *testing.T) {
+		dir := t.TempDir()
+		componentPath := filepath.Join(repoPath, "post-render")
+		require.NoError(t, writeHelmPostRendererKustomization(dir, componentPath))
+		data, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+		require.NoError(t, err)
+		var kustomization struct {
+			Kind       string   `json:"kind"`
+			Resources  []string `json:"resources"`
+			Components []string `json:"components"`
+		}
+		require.NoError(t, yaml.Unmarshal(data, &kustomization))
+		assert.Equal(t, "Kustomization", kustomization.Kind)
+		assert.Equal(t, []string{helmPostRendererManifestsFile}, kustomization.Resources)
+		require.Len(t, kustomization.Components, 1)
+		assert.Equal(t, componentPath, filepath.Join(dir, kustomization.Components[0]))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, postRenderer := range []*argoappv1.HelmPostRenderer{
+			{},
+			{Kustomize: &argoappv1.HelmPostRendererKustomize{Path: "post-render"}, Plugin: &argoappv1.ApplicationSourcePlugin{Name: "plugin"}},
+			{Plugin: &argoappv1.ApplicationSourcePlugin{}},
+		} {
+			q := &apiclient.ManifestRequest{
+				Repo:              &argoappv1.Repository{},
+				ApplicationSource: &argoappv1.ApplicationSource{Helm: &argoappv1.ApplicationSourceHelm{PostRenderer: postRenderer}},
+			}
+			_, _, err := helmPostRender(context.Background(), nil, appPath, appPath, &argoappv1.Env{}, q, &git.NoopCredsStore{}, refs, nil, nil)
+			require.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	goio "io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+const (
+	// worktreesDir is the directory, relative to the root of a repository, containing the working trees of revisions
+	worktreesDir = ".git/argocd-worktrees"
+	// worktreeLockRevision is the revision used to lock a repository whose revisions are checked out in separate
+	// working trees. Working trees of different revisions can be used concurrently.
+	worktreeLockRevision = "worktrees"
+)
+
+// worktree is the working tree of a single revision of a repository
+type worktree struct {
+	repoURL string
+	// repoClient is the client of the repository the working tree belongs to
+	repoClient git.Client
+	path       string
+	// users is the number of processes using the working tree
+	users    int
+	lastUsed time.Time
+	// size is the size of the checked out files
+	size int64
+}
+
+// worktreeCache keeps track of the working trees of revisions and evicts the least recently used idle working trees
+// once the number or the total size of the working trees exceeds the configured limits
+type worktreeCache struct {
+	lock          sync.Mutex
+	maxCount      int
+	maxSize       int64
+	worktrees     map[string]*worktree
+	metricsServer *metrics.MetricsServer
+	now           func() time.Time
+}
+
+func newWorktreeCache(maxCount int, maxSize int64, metricsServer *metrics.MetricsServer) *worktreeCache {
+	return &worktreeCache{
+		maxCount:      maxCount,
+		maxSize:       maxSize,
+		worktrees:     map[string]*worktree{},
+		metricsServer: metricsServer,
+		now:           time.Now,
+	}
+}
+
+// acquire marks the working tree at the given path as used, which prevents its eviction
+func (c *worktreeCache) acquire(repoURL string, repoClient git.Client, path string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	wt, ok := c.worktrees[path]
+	if !ok {
+		wt = &worktree{repoURL: repoURL, repoClient: repoClient, path: path}
+		c.worktrees[path] = wt
+	}
+	wt.users++
+	wt.lastUsed = c.now()
+	c.updateMetrics(repoURL)
+}
+
+// release marks the working tree at the given path as unused. The size of the working tree is updated unless it is
+// negative.
+func (c *worktreeCache) release(path string, size int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	wt, ok := c.worktrees[path]
+	if !ok {
+		return
+	}
+	wt.users--
+	wt.lastUsed = c.now()
+	if size >= 0 {
+		wt.size = size
+	}
+	c.updateMetrics(wt.repoURL)
+}
+
+// contains returns true if the working tree at the given path is tracked by the cache
+func (c *worktreeCache) contains(path string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.worktrees[path]
+	return ok
+}
+
+// purge stops tracking the working trees of the repository at the given root which no longer exist, since
+// initializing the repository removes its directory, working trees included, if it is corrupted or its clone options
+// have changed
+func (c *worktreeCache) purge(repoRoot string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	dir := filepath.Join(repoRoot, worktreesDir) + string(filepath.Separator)
+	for path, wt := range c.worktrees {
+		if !strings.HasPrefix(path, dir) {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil || !os.IsNotExist(err) {
+			continue
+		}
+		delete(c.worktrees, path)
+		c.updateMetrics(wt.repoURL)
+	}
+}
+
+// evict removes the least recently used idle working trees from the cache until the limits are met, and returns them
+func (c *worktreeCache) evict() []*worktree {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	count := len(c.worktrees)
+	var size int64
+	var idle []*worktree
+	for _, wt := range c.worktrees {
+		size += wt.size
+		if wt.users == 0 {
+			idle = append(idle, wt)
+		}
+	}
+	sort.Slice(idle, func(i, j int) bool {
+		return idle[i].lastUsed.Before(idle[j].lastUsed)
+	})
+	var evicted []*worktree
+	for _, wt := range idle {
+		if (c.maxCount <= 0 || count <= c.maxCount) && (c.maxSize <= 0 || size <= c.maxSize) {
+			break
+		}
+		delete(c.worktrees, wt.path)
+		count--
+		size -= wt.size
+		evicted = append(evicted, wt)
+		c.metricsServer.IncRepoWorktreeEvictions(wt.repoURL)
+		c.updateMetrics(wt.repoURL)
+	}
+	return evicted
+}
+
+// updateMetrics updates the metrics of the working trees of the given repository. The caller must hold the lock.
+func (c *worktreeCache) updateMetrics(repoURL string) {
+	count := 0
+	var size int64
+	for _, wt := range c.worktrees {
+		if wt.repoURL == repoURL {
+			count++
+			size += wt.size
+		}
+	}
+	c.metricsServer.SetRepoWorktrees(repoURL, count, size)
+}
+
+// checkoutWorktree checks out the given revision in a separate working tree of the repository, so that different
+// revisions of the repository can be used concurrently. Returns the client of the working tree and a closer, which
+// releases the working tree.
+func (s *Service) checkoutWorktree(gitClient git.Client, repo *v1alpha1.Repository, revision string, allowConcurrent bool, opts ...git.ClientOpts) (git.Client, goio.Closer, error) {
+	repoRoot := gitClient.Root()
+	repoCloser, err := s.repoLock.Lock(repoRoot, worktreeLockRevision, true, func() (goio.Closer, error) {
+		closer := s.gitRepoInitializer(repoRoot)
+		err := gitClient.Init()
+		s.worktrees.purge(repoRoot)
+		if err != nil {
+			return closer, status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+		}
+		return closer, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := filepath.Join(repoRoot, worktreesDir, revision)
+	worktreeClient, err := s.newClientAt(repo, path, opts...)
+	if err != nil {
+		io.Close(repoCloser)
+		return nil, nil, err
+	}
+	s.worktrees.acquire(repo.Repo, gitClient, path)
+
+	size := int64(-1)
+	worktreeCloser, err := s.repoLock.Lock(path, revision, allowConcurrent, func() (goio.Closer, error) {
+		err := s.repoLock.RunExclusive(repoRoot, func() error {
+			return addWorktree(gitClient, path, revision)
+		})
+		if err == nil {
+			// the working tree is reset in case files have been modified by a previous manifest generation
+			err = worktreeClient.Checkout(revision, s.initConstants.SubmoduleEnabled)
+		}
+		if err != nil {
+			s.metricsServer.IncGitFetchFail(repoRoot, revision)
+			return nil, status.Errorf(codes.Internal, "Failed to checkout revision %s: %v", revision, err)
+		}
+		if size, err = directorySize(path); err != nil {
+			log.Warnf("Failed to get size of working tree %s: %v", path, err)
+		}
+		return io.NopCloser, nil
+	})
+
+	closer := io.NewCloser(func() error {
+		if worktreeCloser != nil {
+			io.Close(worktreeCloser)
+		}
+		io.Close(repoCloser)
+		s.worktrees.release(path, size)
+		s.evictWorktrees()
+		return nil
+	})
+	if err != nil {
+		io.Close(closer)
+		return nil, nil, err
+	}
+	return worktreeClient, closer, nil
+}
+
+// addWorktree fetches the given revision, unless it is present, and adds a working tree for it at the given path,
+// unless the working tree exists
+func addWorktree(gitClient git.Client, path string, revision string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if !gitClient.IsRevisionPresent(revision) {
+		// Fetching with no revision first. Fetching with an explicit version can cause repo bloat. https://github.com/argoproj/argo-cd/issues/8845
+		if err := gitClient.Fetch(""); err != nil {
+			return err
+		}
+		if !gitClient.IsRevisionPresent(revision) {
+			if err := gitClient.Fetch(revision); err != nil {
+				return err
+			}
+		}
+	}
+	return gitClient.WorktreeAdd(path, revision)
+}
+
+// evictWorktrees removes the working trees evicted from the working tree cache
+func (s *Service) evictWorktrees() {
+	for _, wt := range s.worktrees.evict() {
+		if err := s.removeWorktree(wt); err != nil {
+			log.Warnf("Failed to remove working tree %s: %v", wt.path, err)
+		}
+	}
+}
+
+func (s *Service) removeWorktree(wt *worktree) error {
+	repoRoot := wt.repoClient.Root()
+	repoCloser, err := s.repoLock.Lock(repoRoot, worktreeLockRevision, true, func() (goio.Closer, error) {
+		return s.gitRepoInitializer(repoRoot), nil
+	})
+	if err != nil {
+		return err
+	}
+	defer io.Close(repoCloser)
+
+	// wait until processes which started using the working tree after its eviction are done
+	worktreeCloser, err := s.repoLock.Lock(wt.path, worktreeLockRevision, false, func() (goio.Closer, error) {
+		return io.NopCloser, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		io.Close(worktreeCloser)
+		s.repoLock.Forget(wt.path)
+	}()
+	if s.worktrees.contains(wt.path) {
+		// the working tree has been used again after its eviction
+		return nil
+	}
+	return s.repoLock.RunExclusive(repoRoot, func() error {
+		return wt.repoClient.WorktreeRemove(wt.path)
+	})
+}
+
+// directorySize returns the total size of the regular files in the given directory
+func directorySize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
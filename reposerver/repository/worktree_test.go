@@ -0,0 +1,167 @@
+package repository
+
+import (
+	goio "io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v2/util/git/mocks"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func TestWorktreeCache_Evict(t *testing.T) {
+	now := time.Now()
+	newCache := func(maxCount int, maxSize int64) *worktreeCache {
+		c := newWorktreeCache(maxCount, maxSize, metrics.NewMetricsServer())
+		c.now = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+		return c
+	}
+	repoClient := &gitmocks.Client{}
+	paths := func(worktrees []*worktree) []string {
+		var res []string
+		for _, wt := range worktrees {
+			res = append(res, wt.path)
+		}
+		return res
+	}
+
+	t.Run("LeastRecentlyUsed", func(t *testing.T) {
+		c := newCache(2, 0)
+		for _, path := range []string{"a", "b", "c"} {
+			c.acquire("repo", repoClient, path)
+		}
+		c.release("b", 1)
+		c.release("a", 1)
+		assert.Equal(t, []string{"b"}, paths(c.evict()))
+		assert.Nil(t, c.evict())
+		assert.True(t, c.contains("a"))
+		assert.False(t, c.contains("b"))
+	})
+
+	t.Run("InUse", func(t *testing.T) {
+		c := newCache(1, 0)
+		c.acquire("repo", repoClient, "a")
+		c.acquire("repo", repoClient, "b")
+		assert.Nil(t, c.evict())
+		c.release("b", 1)
+		assert.Equal(t, []string{"b"}, paths(c.evict()))
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		c := newCache(10, 100)
+		for _, path := range []string{"a", "b", "c"} {
+			c.acquire("repo", repoClient, path)
+			c.release(path, 40)
+		}
+		assert.Equal(t, []string{"a"}, paths(c.evict()))
+		c.acquire("repo", repoClient, "b")
+		c.release("b", -1)
+		c.acquire("repo", repoClient, "d")
+		c.release("d", 40)
+		assert.Equal(t, []string{"c"}, paths(c.evict()))
+	})
+}
+
+func TestCheckoutWorktree(t *testing.T) {
+	sourceRepoPath := t.TempDir()
+	runGit(t, sourceRepoPath, "init", "-b", "main")
+	var revisions []string
+	for _, content := range []string{"1", "2"} {
+		require.NoError(t, os.WriteFile(filepath.Join(sourceRepoPath, "file"), []byte(content), 0o644))
+		runGit(t, sourceRepoPath, "add", ".")
+		runGit(t, sourceRepoPath, "commit", "-m", content)
+		revisions = append(revisions, strings.TrimSpace(runGit(t, sourceRepoPath, "rev-parse", "HEAD")))
+	}
+
+	service := NewService(metrics.NewMetricsServer(), nil, RepoServerInitConstants{GitWorktreesMax: 1}, argo.NewResourceTracking(), &git.NoopCredsStore{}, t.TempDir())
+	service.gitRepoInitializer = func(rootPath string) goio.Closer {
+		return io.NopCloser
+	}
+	repo := &v1alpha1.Repository{Repo: sourceRepoPath}
+	gitClient, err := service.newClient(repo)
+	require.NoError(t, err)
+
+	// working trees of different revisions can be used at the same time
+	var closers []goio.Closer
+	var roots []string
+	for i, revision := range revisions {
+		worktreeClient, closer, err := service.checkoutWorktree(gitClient, repo, revision, false)
+		require.NoError(t, err)
+		closers = append(closers, closer)
+		roots = append(roots, worktreeClient.Root())
+
+		content, err := os.ReadFile(filepath.Join(worktreeClient.Root(), "file"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}[i], string(content))
+		commitSHA, err := worktreeClient.CommitSHA()
+		require.NoError(t, err)
+		assert.Equal(t, revision, commitSHA)
+	}
+	assert.NotEqual(t, roots[0], roots[1])
+
+	// the least recently used working tree is removed once it is released
+	require.NoError(t, closers[0].Close())
+	assert.NoDirExists(t, roots[0])
+	assert.DirExists(t, roots[1])
+	require.NoError(t, closers[1].Close())
+	assert.DirExists(t, roots[1])
+
+	// modified files are restored when the working tree is used again
+	require.NoError(t, os.WriteFile(filepath.Join(roots[1], "file"), []byte("modified"), 0o644))
+	worktreeClient, closer, err := service.checkoutWorktree(gitClient, repo, revisions[1], false)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	content, err := os.ReadFile(filepath.Join(worktreeClient.Root(), "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(content))
+}
+
+func TestCheckoutWorktree_RepoWiped(t *testing.T) {
+	sourceRepoPath := t.TempDir()
+	runGit(t, sourceRepoPath, "init", "-b", "main")
+	var revisions []string
+	for _, content := range []string{"1", "2"} {
+		require.NoError(t, os.WriteFile(filepath.Join(sourceRepoPath, "file"), []byte(content), 0o644))
+		runGit(t, sourceRepoPath, "add", ".")
+		runGit(t, sourceRepoPath, "commit", "-m", content)
+		revisions = append(revisions, strings.TrimSpace(runGit(t, sourceRepoPath, "rev-parse", "HEAD")))
+	}
+
+	service := NewService(metrics.NewMetricsServer(), nil, RepoServerInitConstants{GitWorktreesMax: 2}, argo.NewResourceTracking(), &git.NoopCredsStore{}, t.TempDir())
+	service.gitRepoInitializer = func(rootPath string) goio.Closer {
+		return io.NopCloser
+	}
+	repo := &v1alpha1.Repository{Repo: sourceRepoPath}
+	gitClient, err := service.newClient(repo)
+	require.NoError(t, err)
+
+	worktreeClient, closer, err := service.checkoutWorktree(gitClient, repo, revisions[0], false)
+	require.NoError(t, err)
+	wipedRoot := worktreeClient.Root()
+	require.NoError(t, closer.Close())
+	assert.True(t, service.worktrees.contains(wipedRoot))
+
+	// the repository is initialized again, which removes its working trees
+	require.NoError(t, os.RemoveAll(gitClient.Root()))
+	worktreeClient, closer, err = service.checkoutWorktree(gitClient, repo, revisions[1], false)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	assert.False(t, service.worktrees.contains(wipedRoot))
+	assert.True(t, service.worktrees.contains(worktreeClient.Root()))
+	content, err := os.ReadFile(filepath.Join(worktreeClient.Root(), "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(content))
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -141,21 +143,51 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 	}
 
 	ok := s.enf.Enforce(ctx.Value("claims"), r.Resource, r.Action, r.Subresource)
+	res := &account.CanIResponse{Value: "no"}
 	if ok {
-		return &account.CanIResponse{Value: "yes"}, nil
-	} else {
-		return &account.CanIResponse{Value: "no"}, nil
+		res.Value = "yes"
+	}
+	if r.Explain {
+		res.Explanation = toApiExplanation(s.enf.Explain(ctx.Value("claims"), r.Resource, r.Action, r.Subresource))
+	}
+	return res, nil
+}
+
+func toApiPolicyLines(lines []rbac.PolicyLine) []*account.CanIPolicyLine {
+	var res []*account.CanIPolicyLine
+	for _, line := range lines {
+		res = append(res, &account.CanIPolicyLine{Source: line.Source, Line: line.Line})
+	}
+	return res
+}
+
+func toApiExplanation(exp *rbac.Explanation) *account.CanIExplanation {
+	res := &account.CanIExplanation{Allowed: exp.Allowed}
+	for _, subject := range exp.Subjects {
+		res.Subjects = append(res.Subjects, &account.CanISubjectExplanation{
+			Subject:   subject.Subject,
+			Origin:    subject.Origin,
+			Allowed:   subject.Allowed,
+			Groupings: toApiPolicyLines(subject.Groupings),
+			Policies:  toApiPolicyLines(subject.Policies),
+		})
 	}
+	return res
 }
 
-func toApiAccount(name string, a settings.Account) *account.Account {
+// toApiAccount converts the account, whose tokens were last used at the given times, to its API representation
+func toApiAccount(name string, a settings.Account, tokensLastUsed map[string]time.Time) *account.Account {
 	var capabilities []string
 	for _, c := range a.Capabilities {
 		capabilities = append(capabilities, string(c))
 	}
 	var tokens []*account.Token
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		token := &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, Scope: t.Scope}
+		if lastUsed, ok := tokensLastUsed[t.ID]; ok {
+			token.LastUsedAt = lastUsed.Unix()
+		}
+		tokens = append(tokens, token)
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
@@ -169,8 +201,8 @@ func toApiAccount(name string, a settings.Account) *account.Account {
 }
 
 func (s *Server) ensureHasAccountPermission(ctx context.Context, action string, account string) error {
-	// account has always has access to itself
-	if session.Sub(ctx) == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+	// account has always has access to itself, unless the token is restricted to a subset of its permissions
+	if session.Sub(ctx) == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer && !isScopedToken(ctx) {
 		return nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, action, account); err != nil {
@@ -179,6 +211,27 @@ func (s *Server) ensureHasAccountPermission(ctx context.Context, action string,
 	return nil
 }
 
+// ensureWithinTokenScope returns an error if the caller authenticated with a scoped token, and the scope of the token to
+// be created is not within it
+func ensureWithinTokenScope(ctx context.Context, scope *v1alpha1.TokenScope) error {
+	claims, _ := ctx.Value("claims").(jwt.Claims)
+	if err := rbacpolicy.EnforceTokenScopeSubset(claims, scope); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// isScopedToken returns true if the caller authenticated with a token which is restricted to a subset of the
+// permissions of its subject
+func isScopedToken(ctx context.Context) bool {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return false
+	}
+	scope, err := rbacpolicy.TokenScopeFromClaims(claims)
+	return err != nil || scope != nil
+}
+
 // ListAccounts returns the list of accounts
 func (s *Server) ListAccounts(ctx context.Context, r *account.ListAccountRequest) (*account.AccountsList, error) {
 	resp := account.AccountsList{}
@@ -188,7 +241,7 @@ func (s *Server) ListAccounts(ctx context.Context, r *account.ListAccountRequest
 	}
 	for name, a := range accounts {
 		if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionGet, name); err == nil {
-			resp.Items = append(resp.Items, toApiAccount(name, a))
+			resp.Items = append(resp.Items, toApiAccount(name, a, s.getTokensLastUsed(ctx, name, a)))
 		}
 	}
 	sort.Slice(resp.Items, func(i, j int) bool {
@@ -206,7 +259,21 @@ func (s *Server) GetAccount(ctx context.Context, r *account.GetAccountRequest) (
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
 	}
-	return toApiAccount(r.Name, *a), nil
+	return toApiAccount(r.Name, *a, s.getTokensLastUsed(ctx, r.Name, *a)), nil
+}
+
+// getTokensLastUsed returns the last use of the tokens of the account. Failures are only logged, since the last use is
+// informational.
+func (s *Server) getTokensLastUsed(ctx context.Context, name string, a settings.Account) map[string]time.Time {
+	ids := make([]string, len(a.Tokens))
+	for i, t := range a.Tokens {
+		ids[i] = t.ID
+	}
+	tokensLastUsed, err := s.sessionMgr.GetTokensLastUsed(ctx, ids)
+	if err != nil {
+		log.Warnf("Failed to get last use of tokens of account '%s': %v", name, err)
+	}
+	return tokensLastUsed
 }
 
 // CreateToken creates a token
@@ -214,6 +281,18 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
 		return nil, fmt.Errorf("permission denied to create token for account %s: %w", r.Name, err)
 	}
+	if err := ensureWithinTokenScope(ctx, r.Scope); err != nil {
+		return nil, err
+	}
+
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	expiresIn, err := argoCDSettings.TokenExpiresIn(r.ExpiresIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	id := r.Id
 	if id == "" {
@@ -225,34 +304,103 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	}
 
 	var tokenString string
-	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
-		if account.TokenIndex(id) > -1 {
-			return fmt.Errorf("account already has token with id '%s'", id)
-		}
-		if !account.HasCapability(settings.AccountCapabilityApiKey) {
-			return fmt.Errorf("account '%s' does not have %s capability", r.Name, settings.AccountCapabilityApiKey)
-		}
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+		var err error
+		tokenString, err = s.addToken(r.Name, account, id, expiresIn, r.Scope)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account with new token: %w", err)
+	}
+	return &account.CreateTokenResponse{Token: tokenString}, nil
+}
+
+// addToken creates a token for the account and adds it to the tokens of the account
+func (s *Server) addToken(name string, account *settings.Account, id string, expiresIn int64, scope *v1alpha1.TokenScope) (string, error) {
+	if account.TokenIndex(id) > -1 {
+		return "", fmt.Errorf("account already has token with id '%s'", id)
+	}
+	if !account.HasCapability(settings.AccountCapabilityApiKey) {
+		return "", fmt.Errorf("account '%s' does not have %s capability", name, settings.AccountCapabilityApiKey)
+	}
+	if scope.IsEmpty() {
+		scope = nil
+	}
+
+	now := time.Now()
+	tokenString, err := s.sessionMgr.CreateWithScope(fmt.Sprintf("%s:%s", name, settings.AccountCapabilityApiKey), expiresIn, id, scope)
+	if err != nil {
+		return "", err
+	}
+
+	var expiresAt int64
+	if expiresIn > 0 {
+		expiresAt = now.Add(time.Duration(expiresIn) * time.Second).Unix()
+	}
+	account.Tokens = append(account.Tokens, settings.Token{
+		ID:        id,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt,
+		Scope:     scope,
+	})
+	return tokenString, nil
+}
+
+// RotateToken creates a token with the scope of an existing token, which is revoked after a grace period
+func (s *Server) RotateToken(ctx context.Context, r *account.RotateTokenRequest) (*account.CreateTokenResponse, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to rotate token of account %s: %w", r.Name, err)
+	}
+	if r.GracePeriod < 0 {
+		return nil, status.Error(codes.InvalidArgument, "grace period must not be negative")
+	}
 
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unique ID: %w", err)
+	}
+
+	var tokenString string
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+		index := account.TokenIndex(r.Id)
+		if index == -1 {
+			return status.Errorf(codes.NotFound, "token with id '%s' does not exist", r.Id)
+		}
 		now := time.Now()
-		var err error
-		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id)
+		replaced := account.Tokens[index]
+		if replaced.IsExpired(now) {
+			return status.Errorf(codes.FailedPrecondition, "token with id '%s' has expired", r.Id)
+		}
+		if err := ensureWithinTokenScope(ctx, replaced.Scope); err != nil {
+			return err
+		}
+		// the replacement lives as long as the replaced token did, unless requested otherwise
+		expiresIn := r.ExpiresIn
+		if expiresIn == 0 && replaced.ExpiresAt > 0 {
+			expiresIn = replaced.ExpiresAt - replaced.IssuedAt
+		}
+		expiresIn, err := argoCDSettings.TokenExpiresIn(expiresIn)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		tokenString, err = s.addToken(r.Name, account, uniqueId.String(), expiresIn, replaced.Scope)
 		if err != nil {
 			return err
 		}
 
-		var expiresAt int64
-		if r.ExpiresIn > 0 {
-			expiresAt = now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix()
+		if r.GracePeriod == 0 {
+			account.Tokens = append(account.Tokens[:index], account.Tokens[index+1:]...)
+		} else if revokedAt := now.Add(time.Duration(r.GracePeriod) * time.Second).Unix(); replaced.ExpiresAt == 0 || revokedAt < replaced.ExpiresAt {
+			account.Tokens[index].ExpiresAt = revokedAt
 		}
-		account.Tokens = append(account.Tokens, settings.Token{
-			ID:        id,
-			IssuedAt:  now.Unix(),
-			ExpiresAt: expiresAt,
-		})
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update account with new token: %w", err)
+		return nil, fmt.Errorf("failed to rotate token of account %s: %w", r.Name, err)
 	}
 	return &account.CreateTokenResponse{Token: tokenString}, nil
 }
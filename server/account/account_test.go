@@ -2,6 +2,7 @@ package account
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/errors"
@@ -69,7 +72,7 @@ func newTestAccountServerExt(ctx context.Context, enforceFn rbac.ClaimsEnforcerF
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil, nil)
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -292,6 +295,162 @@ func TestCreateToken_UserSpecifiedID(t *testing.T) {
 	assert.ErrorContains(t, err, "account already has token with id 'test'")
 }
 
+func TestCreateToken_MaxLifetime(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["users.tokens.maxLifetime"] = "24h"
+	})
+
+	t.Run("DefaultsToMaxLifetime", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "default"})
+		require.NoError(t, err)
+		acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+		require.NoError(t, err)
+		require.Len(t, acc.Tokens, 1)
+		assert.Equal(t, int64(24*60*60), acc.Tokens[0].ExpiresAt-acc.Tokens[0].IssuedAt)
+	})
+	t.Run("ExceedsMaxLifetime", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: 48 * 60 * 60})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCreateToken_Scoped(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	scope := &v1alpha1.TokenScope{Projects: []string{"demo"}, Actions: []string{"get"}}
+	res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scope: scope})
+	require.NoError(t, err)
+
+	claims, _, err := accountServer.sessionMgr.Parse(res.Token)
+	require.NoError(t, err)
+	tokenScope, err := rbacpolicy.TokenScopeFromClaims(claims)
+	require.NoError(t, err)
+	assert.Equal(t, scope, tokenScope)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.Equal(t, scope, acc.Tokens[0].Scope)
+}
+
+func TestCreateToken_ScopedTokenOfSameAccount(t *testing.T) {
+	ctx := context.Background()
+	accountServer, _ := newTestAccountServerExt(ctx, func(claims jwt.Claims, rvals ...interface{}) bool {
+		return false
+	}, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	// nolint:staticcheck
+	unscopedCtx := context.WithValue(ctx, "claims", jwt.MapClaims{"sub": "account1", "iss": sessionutil.SessionManagerClaimsIssuer})
+	_, err := accountServer.CreateToken(unscopedCtx, &account.CreateTokenRequest{Name: "account1"})
+	require.NoError(t, err)
+
+	// nolint:staticcheck
+	scopedCtx := context.WithValue(ctx, "claims", jwt.MapClaims{
+		"sub":                      "account1",
+		"iss":                      sessionutil.SessionManagerClaimsIssuer,
+		rbacpolicy.TokenScopeClaim: map[string]interface{}{"projects": []interface{}{"demo"}},
+	})
+	_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1"})
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestCreateToken_WithinScopeOfCaller(t *testing.T) {
+	ctx := context.Background()
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	// nolint:staticcheck
+	scopedCtx := context.WithValue(ctx, "claims", jwt.MapClaims{
+		"sub":                      "admin",
+		"iss":                      sessionutil.SessionManagerClaimsIssuer,
+		rbacpolicy.TokenScopeClaim: map[string]interface{}{"projects": []interface{}{"team-*"}, "actions": []interface{}{"get", "sync"}},
+	})
+
+	_, err := accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1", Scope: &v1alpha1.TokenScope{Projects: []string{"team-a"}, Actions: []string{"get"}}})
+	require.NoError(t, err)
+
+	_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1", Scope: &v1alpha1.TokenScope{Projects: []string{"team-a"}}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1", Scope: &v1alpha1.TokenScope{Projects: []string{"*"}, Actions: []string{"get"}}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRotateToken(t *testing.T) {
+	ctx := adminContext(context.Background())
+	issuedAt := time.Now().Add(-time.Hour).Unix()
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		secret.Data["accounts.account1.tokens"] = []byte(fmt.Sprintf(`[{"id":"old","iat":%d,"exp":%d,"scope":{"resources":["applications"]}},{"id":"other","iat":%d}]`,
+			issuedAt, issuedAt+7*24*60*60, issuedAt))
+	})
+
+	getTokens := func() map[string]*account.Token {
+		acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+		require.NoError(t, err)
+		tokens := map[string]*account.Token{}
+		for _, token := range acc.Tokens {
+			tokens[token.Id] = token
+		}
+		return tokens
+	}
+
+	t.Run("GracePeriod", func(t *testing.T) {
+		res, err := accountServer.RotateToken(ctx, &account.RotateTokenRequest{Name: "account1", Id: "old", GracePeriod: 60 * 60})
+		require.NoError(t, err)
+		claims, _, err := accountServer.sessionMgr.Parse(res.Token)
+		require.NoError(t, err)
+		id := (*claims.(*jwt.MapClaims))["jti"].(string)
+
+		tokens := getTokens()
+		require.Len(t, tokens, 3)
+		assert.InDelta(t, time.Now().Add(time.Hour).Unix(), tokens["old"].ExpiresAt, 5)
+		assert.Equal(t, &v1alpha1.TokenScope{Resources: []string{"applications"}}, tokens[id].Scope)
+		assert.Equal(t, int64(7*24*60*60), tokens[id].ExpiresAt-tokens[id].IssuedAt)
+	})
+	t.Run("RevokeImmediately", func(t *testing.T) {
+		_, err := accountServer.RotateToken(ctx, &account.RotateTokenRequest{Name: "account1", Id: "other"})
+		require.NoError(t, err)
+
+		tokens := getTokens()
+		require.Len(t, tokens, 3)
+		assert.NotContains(t, tokens, "other")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := accountServer.RotateToken(ctx, &account.RotateTokenRequest{Name: "account1", Id: "unknown"})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("OutsideScopeOfCaller", func(t *testing.T) {
+		// nolint:staticcheck
+		scopedCtx := context.WithValue(ctx, "claims", jwt.MapClaims{
+			"sub":                      "admin",
+			"iss":                      sessionutil.SessionManagerClaimsIssuer,
+			rbacpolicy.TokenScopeClaim: map[string]interface{}{"resources": []interface{}{"logs"}},
+		})
+		id := ""
+		for tokenID := range getTokens() {
+			if tokenID != "old" {
+				id = tokenID
+			}
+		}
+		_, err := accountServer.RotateToken(scopedCtx, &account.RotateTokenRequest{Name: "account1", Id: id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	ctx := adminContext(context.Background())
 	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
@@ -354,3 +513,31 @@ func TestCanI_GetLogsAllowSwitchOff(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualValues(t, "yes", resp.Value)
 }
+
+func TestCanI_Explain(t *testing.T) {
+	accountServer, _ := newTestAccountServer(context.Background())
+	accountServer.enf.SetClaimsExplainerFunc(func(claims jwt.Claims, rvals ...interface{}) []rbac.SubjectExplanation {
+		return []rbac.SubjectExplanation{{
+			Subject:   "admin",
+			Origin:    rbac.ExplainOriginSubject,
+			Allowed:   true,
+			Groupings: []rbac.PolicyLine{{Source: rbac.PolicySourceBuiltin, Line: "g, admin, role:admin"}},
+			Policies:  []rbac.PolicyLine{{Source: rbac.PolicySourceBuiltin, Line: "p, role:admin, applications, sync, */*, allow"}},
+		}}
+	})
+
+	ctx := adminContext(context.Background())
+	resp, err := accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications", Action: "sync", Subresource: "*/*"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Explanation)
+
+	resp, err = accountServer.CanI(ctx, &account.CanIRequest{Resource: "applications", Action: "sync", Subresource: "*/*", Explain: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, "yes", resp.Value)
+	require.NotNil(t, resp.Explanation)
+	assert.True(t, resp.Explanation.Allowed)
+	require.Len(t, resp.Explanation.Subjects, 1)
+	assert.Equal(t, "admin", resp.Explanation.Subjects[0].Subject)
+	assert.Equal(t, []*account.CanIPolicyLine{{Source: "builtin", Line: "g, admin, role:admin"}}, resp.Explanation.Subjects[0].Groupings)
+	assert.Equal(t, []*account.CanIPolicyLine{{Source: "builtin", Line: "p, role:admin, applications, sync, */*, allow"}}, resp.Explanation.Subjects[0].Policies)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/argoproj/pkg/sync"
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pmezard/go-difflib/difflib"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	argocommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
@@ -46,6 +48,7 @@ import (
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/deeplinks"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/collections"
@@ -93,6 +96,8 @@ type Server struct {
 	cache             *servercache.Cache
 	projInformer      cache.SharedIndexInformer
 	enabledNamespaces []string
+	recordingStore    recording.Store
+	sessionMgr        *session.SessionManager
 }
 
 // NewServer returns a new instance of the Application service
@@ -113,6 +118,8 @@ func NewServer(
 	projInformer cache.SharedIndexInformer,
 	enabledNamespaces []string,
 	enableK8sEvent []string,
+	recordingStore recording.Store,
+	sessionMgr *session.SessionManager,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	if appBroadcaster == nil {
 		appBroadcaster = &broadcasterHandler{}
@@ -138,6 +145,8 @@ func NewServer(
 		settingsMgr:       settingsMgr,
 		projInformer:      projInformer,
 		enabledNamespaces: enabledNamespaces,
+		recordingStore:    recordingStore,
+		sessionMgr:        sessionMgr,
 	}
 	return s, s.getAppResources
 }
@@ -317,7 +326,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	}
 	a := q.GetApplication()
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, a.RBACName(s.ns)); err != nil {
+	if err := s.enforceNewApp(ctx, rbacpolicy.ActionCreate, a); err != nil {
 		return nil, err
 	}
 
@@ -380,7 +389,11 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if q.Upsert == nil || !*q.Upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, a.RBACName(s.ns)); err != nil {
+	// the update needs to be permitted for both the existing and the new application
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, existing.RBACName(s.ns), rbacpolicy.ApplicationAttributes(existing)); err != nil {
+		return nil, err
+	}
+	if err := s.enforceNewApp(ctx, rbacpolicy.ActionUpdate, a); err != nil {
 		return nil, err
 	}
 	updated, err := s.updateApp(existing, a, ctx, true)
@@ -447,6 +460,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	}
 
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
+	var sensitiveValues []argo.SensitiveHelmValue
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
@@ -514,6 +528,15 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			if err != nil {
 				return fmt.Errorf("error getting installation ID: %w", err)
 			}
+			helmValuesFrom, err := s.getHelmValuesFrom(ctx, a, &source, proj, config)
+			if err != nil {
+				return err
+			}
+			sourceSensitiveValues, err := argo.GetSensitiveHelmValues(helmValuesFrom)
+			if err != nil {
+				return err
+			}
+			sensitiveValues = append(sensitiveValues, sourceSensitiveValues...)
 
 			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
 				Repo:                            repo,
@@ -536,6 +559,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
+				HelmValuesFrom:                  helmValuesFrom,
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -550,28 +574,52 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 
 	manifests := &apiclient.ManifestResponse{}
 	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
-			obj := &unstructured.Unstructured{}
-			err = json.Unmarshal([]byte(manifest), obj)
+		if err := redactManifests(manifestInfo.Manifests, sensitiveValues); err != nil {
+			return nil, err
+		}
+		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+	}
+
+	return manifests, nil
+}
+
+// redactManifests hides the data of Secrets, and the values read from Secrets for Helm, in the given manifests
+func redactManifests(manifests []string, sensitiveValues []argo.SensitiveHelmValue) error {
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(manifest), obj)
+		if err != nil {
+			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		modified := len(argo.RedactSensitiveHelmValues(obj.Object, sensitiveValues)) > 0
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			obj, _, err = diff.HideSecretData(obj, nil)
 			if err != nil {
-				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+				return fmt.Errorf("error hiding secret data: %w", err)
 			}
-			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-				obj, _, err = diff.HideSecretData(obj, nil)
-				if err != nil {
-					return nil, fmt.Errorf("error hiding secret data: %w", err)
-				}
-				data, err := json.Marshal(obj)
-				if err != nil {
-					return nil, fmt.Errorf("error marshaling manifest: %w", err)
-				}
-				manifestInfo.Manifests[i] = string(data)
+			modified = true
+		}
+		if modified {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return fmt.Errorf("error marshaling manifest: %w", err)
 			}
+			manifests[i] = string(data)
 		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
 	}
+	return nil
+}
 
-	return manifests, nil
+// getHelmValuesFrom reads the Helm values of the given source from ConfigMaps and Secrets. Resources in the destination
+// cluster are read using the given cluster config.
+func (s *Server) getHelmValuesFrom(ctx context.Context, a *appv1.Application, source *appv1.ApplicationSource, proj *appv1.AppProject, config *rest.Config) ([]*apiclient.HelmValues, error) {
+	helmValuesFrom, err := argo.GetHelmValuesFrom(ctx, a, source, proj, s.kubeclientset, func() (kubernetes.Interface, error) {
+		return kubernetes.NewForConfig(config)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting helm values: %w", err)
+	}
+	return helmValuesFrom, nil
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -591,6 +639,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 	}
 
 	var manifestInfo *apiclient.ManifestResponse
+	var sensitiveValues []argo.SensitiveHelmValue
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
@@ -634,6 +683,13 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		if err != nil {
 			return fmt.Errorf("error getting kustomize settings options: %w", err)
 		}
+		helmValuesFrom, err := s.getHelmValuesFrom(ctx, a, &source, proj, config)
+		if err != nil {
+			return err
+		}
+		if sensitiveValues, err = argo.GetSensitiveHelmValues(helmValuesFrom); err != nil {
+			return err
+		}
 
 		req := &apiclient.ManifestRequest{
 			Repo:                            repo,
@@ -653,6 +709,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+			HelmValuesFrom:                  helmValuesFrom,
 		}
 
 		repoStreamClient, err := client.GenerateManifestWithFiles(stream.Context())
@@ -677,23 +734,8 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		return err
 	}
 
-	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
-		if err != nil {
-			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
-		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil)
-			if err != nil {
-				return fmt.Errorf("error hiding secret data: %w", err)
-			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return fmt.Errorf("error marshaling manifest: %w", err)
-			}
-			manifestInfo.Manifests[i] = string(data)
-		}
+	if err := redactManifests(manifestInfo.Manifests, sensitiveValues); err != nil {
+		return err
 	}
 
 	stream.SendAndClose(manifestInfo)
@@ -881,6 +923,10 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *appv1.Applica
 	if err != nil {
 		return nil, err
 	}
+	// the action was permitted for the current application, and also needs to be permitted for the new one
+	if err := s.enforceNewApp(ctx, action, newApp); err != nil {
+		return nil, err
+	}
 
 	err = s.validateAndNormalizeApp(ctx, newApp, proj, validate)
 	if err != nil {
@@ -894,6 +940,13 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *appv1.Applica
 	return a, nil
 }
 
+// enforceNewApp enforces the RBAC policy for the action on an application which is about to be created or updated.
+// Policy conditions are evaluated against the given application rather than the stored one, so that they also restrict
+// the new spec.
+func (s *Server) enforceNewApp(ctx context.Context, action string, a *appv1.Application) error {
+	return s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, a.RBACName(s.ns), rbacpolicy.ApplicationAttributes(a))
+}
+
 var informerSyncTimeout = 2 * time.Second
 
 // waitSync is a helper to wait until the application informer cache is synced after create/update.
@@ -1242,7 +1295,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	if currApp != nil && currApp.Spec.GetProject() != app.Spec.GetProject() {
 		// When changing projects, caller must have application create & update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, app.RBACName(s.ns)); err != nil {
+		if err := s.enforceNewApp(ctx, rbacpolicy.ActionCreate, app); err != nil {
 			return err
 		}
 		// They also need 'update' privileges in the old project
@@ -1530,7 +1583,7 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 
 // RevisionChartDetails returns the helm chart metadata, as fetched from the reposerver
 func (s *Server) RevisionChartDetails(ctx context.Context, q *application.RevisionMetadataQuery) (*appv1.ChartDetails, error) {
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
@@ -1553,9 +1606,10 @@ func (s *Server) RevisionChartDetails(ctx context.Context, q *application.Revisi
 	}
 	defer ioutil.Close(conn)
 	return repoClient.GetRevisionChartDetails(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
-		Repo:     repo,
-		Name:     source.Chart,
-		Revision: q.GetRevision(),
+		Repo:           repo,
+		Name:           source.Chart,
+		Revision:       q.GetRevision(),
+		CheckSignature: len(proj.Spec.SignatureKeys) > 0 && !proj.Spec.SkipChartSignatureVerification,
 	})
 }
 
@@ -1660,6 +1714,23 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+// ResourceDrift returns the list of application resources which are currently drifting from the desired state
+func (s *Server) ResourceDrift(ctx context.Context, q *application.ResourcesQuery) (*application.ResourceDriftResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.ResourceDriftResponse{}
+	for i := range a.Status.Resources {
+		item := a.Status.Resources[i]
+		if item.Drift != nil && isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
+			res.Items = append(res.Items, &item)
+		}
+	}
+	return res, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -1917,6 +1988,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if _, err := syncReq.Timeout.OperationTimeout(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync timeout: %v", err)
+	}
+	if _, err := syncReq.Timeout.HookTimeout(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync hook timeout: %v", err)
+	}
 
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
@@ -1960,6 +2037,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Manifests:    syncReq.Manifests,
 			Sources:      a.Spec.Sources,
 			Revisions:    sourceRevisions,
+			Timeout:      syncReq.Timeout,
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -1968,6 +2046,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		op.Retry = *retry
 	}
 
+	op.Approval, err = s.newOperationApproval(ctx, a, proj, &op, true)
+	if err != nil {
+		return nil, err
+	}
+
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
@@ -1975,6 +2058,10 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
+	if op.Approval != nil {
+		s.logAppEvent(a, ctx, argo.EventReasonSyncApprovalRequested, fmt.Sprintf("requested approval of sync, expiring at %s", op.Approval.ExpiresAt.Format(time.RFC3339)))
+		return a, nil
+	}
 	partial := ""
 	if len(syncReq.Resources) > 0 {
 		partial = "partial "
@@ -1992,6 +2079,179 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	return a, nil
 }
 
+// newOperationApproval returns the approval state of a sync operation for the application, which is nil if the
+// project does not require syncs of the application to be approved. Dry runs do not change anything and therefore do
+// not need to be approved. The approval is bound to the current spec of the application and the resolved revisions of
+// the operation, so that the controller does not run it if either changes. The diff of the application is only
+// rendered if the operation syncs to its target state.
+func (s *Server) newOperationApproval(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, op *appv1.Operation, renderDiff bool) (*appv1.OperationApproval, error) {
+	requiresApproval, err := proj.RequiresSyncApproval(a)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid sync approval of project %s: %v", proj.Name, err)
+	}
+	if !requiresApproval || op.DryRun() {
+		return nil, nil
+	}
+	// local manifests are not stored in a reviewable way and could be anything
+	if op.Sync.Manifests != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when syncs need to be approved.")
+	}
+	expiration, err := proj.Spec.SyncApproval.GetExpiration()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid sync approval of project %s: %v", proj.Name, err)
+	}
+	specHash, err := a.Spec.Hash()
+	if err != nil {
+		return nil, err
+	}
+	now := metav1.Now()
+	approval := &appv1.OperationApproval{
+		Phase:       appv1.OperationApprovalPending,
+		RequestedAt: now,
+		ExpiresAt:   metav1.NewTime(now.Add(expiration)),
+		RequestedBy: session.UserID(ctx),
+		SpecHash:    specHash,
+		Revision:    op.Sync.Revision,
+		Revisions:   op.Sync.Revisions,
+	}
+	if renderDiff {
+		approval.Diff = s.renderSyncDiff(ctx, a)
+	}
+	return approval, nil
+}
+
+// maxSyncDiffSize limits the size of the diff recorded in sync requests, since it is stored in the Application
+const maxSyncDiffSize = 64 * 1024
+
+// renderSyncDiff renders the diff between the live and the desired state of the modified resources of an application,
+// as computed by its latest comparison
+func (s *Server) renderSyncDiff(ctx context.Context, a *appv1.Application) string {
+	items := make([]*appv1.ResourceDiff, 0)
+	err := s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
+	})
+	if err != nil {
+		log.WithField("application", a.QualifiedName()).Warnf("Failed to render diff of sync request: %v", err)
+		return ""
+	}
+	return renderResourceDiffs(items, maxSyncDiffSize)
+}
+
+// renderResourceDiffs renders the unified diffs of the YAML of the modified resources, truncated to the given size
+func renderResourceDiffs(items []*appv1.ResourceDiff, maxSize int) string {
+	var res strings.Builder
+	for _, item := range items {
+		if item.Hook || !item.Modified {
+			continue
+		}
+		live, err := jsonToYAML(item.NormalizedLiveState)
+		if err != nil {
+			continue
+		}
+		desired, err := jsonToYAML(item.PredictedLiveState)
+		if err != nil {
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(live),
+			B:        splitLines(desired),
+			FromFile: "live/" + item.FullName(),
+			ToFile:   "desired/" + item.FullName(),
+			Context:  3,
+		})
+		if err != nil {
+			continue
+		}
+		res.WriteString(diff)
+	}
+	if res.Len() > maxSize {
+		return res.String()[:maxSize] + "\n... diff truncated\n"
+	}
+	return res.String()
+}
+
+// splitLines splits text into lines which keep their line breaks
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// jsonToYAML converts a JSON serialized resource state to YAML, which is empty if the resource does not exist
+func jsonToYAML(state string) (string, error) {
+	if state == "" || state == "null" {
+		return "", nil
+	}
+	data, err := yaml.JSONToYAML([]byte(state))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ReviewSync approves or rejects a sync which is pending approval. Syncs cannot be approved by the user who requested
+// them, but may be rejected, i.e. withdrawn, by them.
+func (s *Server) ReviewSync(ctx context.Context, q *application.ApplicationSyncReviewRequest) (*appv1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionSync, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	user := session.Username(ctx)
+	userID := session.UserID(ctx)
+
+	for i := 0; i < 10; i++ {
+		if a.Operation == nil || !a.Operation.Approval.IsPending() {
+			return nil, status.Errorf(codes.FailedPrecondition, "no sync of application %s is pending approval", appName)
+		}
+		if a.Operation.Approval.IsExpired(time.Now()) {
+			return nil, status.Errorf(codes.FailedPrecondition, "sync request of application %s expired at %s", appName, a.Operation.Approval.ExpiresAt.Format(time.RFC3339))
+		}
+		if q.GetApprove() {
+			if userID == "" {
+				return nil, status.Errorf(codes.PermissionDenied, "syncs can only be approved by identified users")
+			}
+			if userID == a.Operation.Approval.RequestedBy || (user != "" && user == a.Operation.InitiatedBy.Username) {
+				return nil, status.Errorf(codes.PermissionDenied, "syncs cannot be approved by the user who requested them")
+			}
+			now := metav1.Now()
+			a.Operation.Approval.Phase = appv1.OperationApprovalApproved
+			a.Operation.Approval.ApprovedBy = user
+			a.Operation.Approval.ApprovedAt = &now
+			a.Operation.Approval.Message = q.GetMessage()
+		} else {
+			a.Operation = nil
+		}
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			action := "approved sync"
+			reason := argo.EventReasonSyncApproved
+			if !q.GetApprove() {
+				action = "rejected sync"
+				reason = argo.EventReasonSyncRejected
+			}
+			if q.GetMessage() != "" {
+				action = fmt.Sprintf("%s: %s", action, q.GetMessage())
+			}
+			s.logAppEvent(updated, ctx, reason, action)
+			return updated, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to review sync of app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to review sync. Too many conflicts")
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *appv1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	if a.Spec.HasMultipleSources() {
 		numOfSources := int64(len(a.Spec.GetSources()))
@@ -2035,7 +2295,7 @@ func (s *Server) resolveSourceRevisions(ctx context.Context, a *appv1.Applicatio
 }
 
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*appv1.Application, error) {
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -2085,6 +2345,11 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
+	// the diff is rendered against the target state, which is not the state the application is rolled back to
+	op.Approval, err = s.newOperationApproval(ctx, a, proj, &op, false)
+	if err != nil {
+		return nil, err
+	}
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
@@ -2092,6 +2357,10 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
+	if op.Approval != nil {
+		s.logAppEvent(a, ctx, argo.EventReasonSyncApprovalRequested, fmt.Sprintf("requested approval of rollback to %d, expiring at %s", rollbackReq.GetId(), op.Approval.ExpiresAt.Format(time.RFC3339)))
+		return a, nil
+	}
 	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
 	return a, nil
 }
@@ -2286,6 +2555,8 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
 		a.Status.OperationState.Phase = common.OperationTerminating
+		// operations terminated by users are not retried, even if they are already terminating because of a timeout
+		a.Status.OperationState.RetryOnTimeout = false
 		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
 		if err == nil {
 			s.waitSync(updated)
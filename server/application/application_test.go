@@ -61,6 +61,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/grpc"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
+	sessionutil "github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -309,6 +310,8 @@ func newTestAppServerWithEnforcerConfigure(f func(*rbac.Enforcer), t *testing.T,
 		projInformer,
 		[]string{},
 		testEnableEventList,
+		nil,
+		sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil)),
 	)
 	return server.(*Server)
 }
@@ -490,6 +493,8 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(f func(*rbac.Enforcer),
 		projInformer,
 		[]string{},
 		testEnableEventList,
+		nil,
+		sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil)),
 	)
 	return server.(*Server)
 }
@@ -950,6 +955,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ResourceDrift", func(t *testing.T) {
+		_, err := appServer.ResourceDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.ResourceDrift(noRoleCtx, &application.ResourcesQuery{ApplicationName: ptr.To("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ResourceDrift(adminCtx, &application.ResourcesQuery{ApplicationName: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.Equal(t, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", err.Error(), "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("Sync", func(t *testing.T) {
 		_, err := appServer.Sync(adminCtx, &application.ApplicationSyncRequest{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -1489,6 +1505,32 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateAppWithRBACConditions(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy(`p, alice, applications, *, default/*, allow, destination.namespace == dev-*`)
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(f, t, map[string]string{})
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "alice"})
+
+	// conditions are evaluated against the application to be created
+	_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newTestApp(func(app *appsv1.Application) {
+		app.Spec.Destination.Namespace = "prod-payments"
+	})})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newTestApp(func(app *appsv1.Application) {
+		app.Spec.Destination.Namespace = "dev-payments"
+	})})
+	require.NoError(t, err)
+
+	// and against the new spec of an upserted application
+	app.Spec.Destination.Namespace = "prod-payments"
+	_, err = appServer.Create(ctx, &application.ApplicationCreateRequest{Application: app, Upsert: ptr.To(true)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -1804,6 +1846,24 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncTimeout(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, Timeout: &appsv1.SyncTimeout{Hook: "invalid"}})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, Timeout: &appsv1.SyncTimeout{Operation: "10m", Hook: "1m"}})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, &appsv1.SyncTimeout{Operation: "10m", Hook: "1m"}, app.Operation.Sync.Timeout)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer(t)
@@ -1878,6 +1938,183 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestSyncApproval(t *testing.T) {
+	userContext := func(user string) context.Context {
+		// nolint:staticcheck
+		return context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: user, Issuer: sessionutil.SessionManagerClaimsIssuer})
+	}
+	approvalProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "approval", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApproval: &appsv1.SyncApproval{
+				ApplicationSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				Expiration:          "1h",
+			},
+		},
+	}
+	newApprovalTestApp := func(opts ...func(app *appsv1.Application)) *appsv1.Application {
+		return newTestApp(append([]func(app *appsv1.Application){func(app *appsv1.Application) {
+			app.Spec.Project = "approval"
+			app.Labels = map[string]string{"env": "prod"}
+		}}, opts...)...)
+	}
+
+	t.Run("unselected application is synced immediately", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newTestApp(func(app *appsv1.Application) {
+			app.Spec.Project = "approval"
+		}))
+		app, err := appServer.Sync(userContext("alice"), &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Nil(t, app.Operation.Approval)
+	})
+
+	t.Run("dry run is not pending approval", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp())
+		app, err := appServer.Sync(userContext("alice"), &application.ApplicationSyncRequest{Name: ptr.To("test-app"), DryRun: ptr.To(true)})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation.Approval)
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp())
+		app, err := appServer.Sync(userContext("alice"), &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		require.NotNil(t, app.Operation.Approval)
+		assert.Equal(t, appsv1.OperationApprovalPending, app.Operation.Approval.Phase)
+		assert.Equal(t, "alice", app.Operation.InitiatedBy.Username)
+		assert.Equal(t, "argocd|alice", app.Operation.Approval.RequestedBy)
+		assert.Equal(t, time.Hour, app.Operation.Approval.ExpiresAt.Sub(app.Operation.Approval.RequestedAt.Time))
+		assert.Equal(t, app.Operation.Sync.Revision, app.Operation.Approval.Revision)
+		require.NoError(t, app.Operation.Approval.Verify(&app.Spec, app.Operation.Sync))
+
+		// another sync cannot be requested while one is pending
+		_, err = appServer.Sync(userContext("bob"), &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.Error(t, err)
+
+		_, err = appServer.ReviewSync(userContext("alice"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = appServer.ReviewSync(context.Background(), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		app, err = appServer.ReviewSync(userContext("bob"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(true), Message: ptr.To("lgtm")})
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.OperationApprovalApproved, app.Operation.Approval.Phase)
+		assert.Equal(t, "bob", app.Operation.Approval.ApprovedBy)
+		assert.Equal(t, "lgtm", app.Operation.Approval.Message)
+		assert.NotNil(t, app.Operation.Approval.ApprovedAt)
+
+		// the approved sync can no longer be reviewed
+		_, err = appServer.ReviewSync(userContext("carol"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(false)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var reasons []string
+		for _, event := range events.Items {
+			reasons = append(reasons, event.Reason)
+		}
+		assert.Contains(t, reasons, argo.EventReasonSyncApprovalRequested)
+		assert.Contains(t, reasons, argo.EventReasonSyncApproved)
+	})
+
+	t.Run("requester cannot approve with another username", func(t *testing.T) {
+		ssoContext := func(email string) context.Context {
+			// nolint:staticcheck
+			return context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": "https://idp.example.com", "sub": "1234", "email": email})
+		}
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp())
+		_, err := appServer.Sync(ssoContext("alice@example.com"), &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		_, err = appServer.ReviewSync(ssoContext("bob@example.com"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("local sync is refused", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp())
+		_, err := appServer.Sync(userContext("alice"), &application.ApplicationSyncRequest{Name: ptr.To("test-app"), Manifests: []string{"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test"}})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp())
+		_, err := appServer.Sync(userContext("alice"), &application.ApplicationSyncRequest{Name: ptr.To("test-app")})
+		require.NoError(t, err)
+		// requesters may withdraw their own requests
+		app, err := appServer.ReviewSync(userContext("alice"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(false)})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp(func(app *appsv1.Application) {
+			app.Operation = &appsv1.Operation{
+				Sync:        &appsv1.SyncOperation{},
+				InitiatedBy: appsv1.OperationInitiator{Username: "alice"},
+				Approval: &appsv1.OperationApproval{
+					Phase:       appsv1.OperationApprovalPending,
+					RequestedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+					ExpiresAt:   metav1.NewTime(time.Now().Add(-time.Hour)),
+				},
+			}
+		}))
+		_, err := appServer.ReviewSync(userContext("bob"), &application.ApplicationSyncReviewRequest{Name: ptr.To("test-app"), Approve: ptr.To(true)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		appServer := newTestAppServer(t, approvalProj, newApprovalTestApp(func(app *appsv1.Application) {
+			app.Status.History = []appsv1.RevisionHistory{{ID: 1, Revision: "abc", Source: *app.Spec.Source.DeepCopy()}}
+		}))
+		app, err := appServer.Rollback(userContext("alice"), &application.ApplicationRollbackRequest{Name: ptr.To("test-app"), Id: ptr.To(int64(1))})
+		require.NoError(t, err)
+		assert.True(t, app.Operation.Approval.IsPending())
+	})
+}
+
+func TestRenderResourceDiffs(t *testing.T) {
+	items := []*appsv1.ResourceDiff{{
+		Kind:                "ConfigMap",
+		Namespace:           "default",
+		Name:                "modified",
+		Modified:            true,
+		NormalizedLiveState: `{"apiVersion":"v1","kind":"ConfigMap","data":{"key":"old"}}`,
+		PredictedLiveState:  `{"apiVersion":"v1","kind":"ConfigMap","data":{"key":"new"}}`,
+	}, {
+		Kind:                "ConfigMap",
+		Namespace:           "default",
+		Name:                "unmodified",
+		NormalizedLiveState: `{"apiVersion":"v1","kind":"ConfigMap"}`,
+		PredictedLiveState:  `{"apiVersion":"v1","kind":"ConfigMap"}`,
+	}, {
+		Kind:               "ConfigMap",
+		Namespace:          "default",
+		Name:               "missing",
+		Modified:           true,
+		PredictedLiveState: `{"apiVersion":"v1","kind":"ConfigMap"}`,
+	}}
+
+	assert.Equal(t, `--- live//ConfigMap/default/modified
++++ desired//ConfigMap/default/modified
+@@ -1,4 +1,4 @@
+ apiVersion: v1
+ data:
+-  key: old
++  key: new
+ kind: ConfigMap
+--- live//ConfigMap/default/missing
++++ desired//ConfigMap/default/missing
+@@ -0,0 +1,2 @@
++apiVersion: v1
++kind: ConfigMap
+`, renderResourceDiffs(items, 1024))
+
+	assert.Equal(t, "--- live\n... diff truncated\n", renderResourceDiffs(items, 8))
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -1967,6 +2204,33 @@ func TestAppJsonPatch(t *testing.T) {
 	assert.NotContains(t, app.Annotations, "test.annotation")
 }
 
+func TestResourceDrift(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Status.Resources = []appsv1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: appsv1.SyncStatusCodeOutOfSync, Drift: &appsv1.ResourceDrift{StartedAt: startedAt, Managers: []string{"kubectl-edit"}}},
+			{Kind: "Service", Namespace: "default", Name: "guestbook", Status: appsv1.SyncStatusCodeOutOfSync, Drift: &appsv1.ResourceDrift{StartedAt: startedAt}},
+			{Kind: "ConfigMap", Namespace: "default", Name: "guestbook", Status: appsv1.SyncStatusCodeSynced},
+		}
+	})
+	ctx := context.Background()
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+
+	res, err := appServer.ResourceDrift(ctx, &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 2)
+	assert.Equal(t, "Deployment", res.Items[0].Kind)
+	assert.Equal(t, []string{"kubectl-edit"}, res.Items[0].Drift.Managers)
+	assert.Equal(t, "Service", res.Items[1].Kind)
+
+	res, err = appServer.ResourceDrift(ctx, &application.ResourcesQuery{ApplicationName: &testApp.Name, Kind: ptr.To("Service")})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "Service", res.Items[0].Kind)
+}
+
 func TestAppMergePatch(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -3311,3 +3575,17 @@ func Test_RevisionMetadata(t *testing.T) {
 		})
 	}
 }
+
+func Test_redactManifests(t *testing.T) {
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"},"data":{"url":"postgres://admin:s3cret@db"}}`,
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds"},"data":{"password":"czNjcmV0"}}`,
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"svc"}}`,
+	}
+	require.NoError(t, redactManifests(manifests, []argo.SensitiveHelmValue{{Path: "password", Value: "s3cret"}}))
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"},"data":{"url":"postgres://admin:++++++++@db"}}`, manifests[0])
+	assert.NotContains(t, manifests[1], "czNjcmV0")
+	assert.Equal(t, `{"apiVersion":"v1","kind":"Service","metadata":{"name":"svc"}}`, manifests[2])
+
+	require.Error(t, redactManifests([]string{"invalid"}, nil))
+}
@@ -0,0 +1,401 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/rand"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+const (
+	bulkOperationRefresh       = "refresh"
+	bulkOperationHardRefresh   = "hard-refresh"
+	bulkOperationSync          = "sync"
+	bulkOperationTerminateOp   = "terminate-op"
+	bulkOperationSetParameters = "set-parameters"
+
+	bulkOperationPhasePending   = "Pending"
+	bulkOperationPhaseRunning   = "Running"
+	bulkOperationPhaseSucceeded = "Succeeded"
+	bulkOperationPhaseFailed    = "Failed"
+
+	defaultBulkOperationConcurrency = 10
+	maxBulkOperationConcurrency     = 50
+)
+
+var (
+	bulkOperations = []string{bulkOperationRefresh, bulkOperationHardRefresh, bulkOperationSync, bulkOperationTerminateOp, bulkOperationSetParameters}
+	// bulkOperationFlushInterval is the interval in which the progress of running bulk operations is saved
+	bulkOperationFlushInterval = time.Second
+	// bulkOperationPollInterval is the interval in which the progress of watched bulk operations is polled
+	bulkOperationPollInterval = time.Second
+	// bulkOperationHeartbeatInterval is the interval in which the API server running a bulk operation reports that it
+	// still runs it. Running operations whose heartbeat is missed three times are considered interrupted.
+	bulkOperationHeartbeatInterval = 10 * time.Second
+)
+
+// bulkOperationReplica returns the name of this API server, which is the name of its pod
+func bulkOperationReplica() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return hostname
+}
+
+// bulkOperationJob is a bulk operation run by this API server. Its progress is saved in the cache, so that it can be
+// tracked through any API server.
+type bulkOperationJob struct {
+	lock gosync.Mutex
+	// owner identifies the user who started the operation, see session.UserID
+	owner string
+	op    *application.ApplicationBulkOperation
+	dirty bool
+}
+
+// snapshot returns a copy of the current progress of the operation, and whether it changed since the last snapshot
+func (j *bulkOperationJob) snapshot() (*application.ApplicationBulkOperation, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	res := *j.op
+	res.Items = make([]*application.ApplicationBulkOperationItem, len(j.op.Items))
+	for i := range j.op.Items {
+		item := *j.op.Items[i]
+		res.Items[i] = &item
+	}
+	dirty := j.dirty
+	j.dirty = false
+	return &res, dirty
+}
+
+// heartbeat records that the operation is still run by this API server, at most once per heartbeat interval
+func (j *bulkOperationJob) heartbeat(now time.Time) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.op.HeartbeatAt != nil && now.Sub(j.op.HeartbeatAt.Time) < bulkOperationHeartbeatInterval {
+		return
+	}
+	j.op.HeartbeatAt = &metav1.Time{Time: now}
+	j.dirty = true
+}
+
+func (j *bulkOperationJob) update(i int, phase string, message string) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.op.Items[i].Phase = &phase
+	j.op.Items[i].Message = &message
+	j.dirty = true
+}
+
+func (j *bulkOperationJob) complete() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.op.Phase = ptr.To(bulkOperationPhaseSucceeded)
+	for _, item := range j.op.Items {
+		if item.GetPhase() == bulkOperationPhaseFailed {
+			j.op.Phase = ptr.To(bulkOperationPhaseFailed)
+		}
+	}
+	j.op.FinishedAt = &metav1.Time{Time: time.Now()}
+	j.dirty = true
+}
+
+// bulkOperationUser returns the name of the user shown in the progress of bulk operations. Since it may be changed by
+// the user, it does not identify the owner of the operation.
+func bulkOperationUser(ctx context.Context) string {
+	if user := session.Username(ctx); user != "" {
+		return user
+	}
+	return session.Sub(ctx)
+}
+
+// StartBulkOperation starts an operation on all applications matching the selectors and returns the job tracking it.
+// The operation runs for the applications the caller may get, and fails for those the caller may not run it for.
+func (s *Server) StartBulkOperation(ctx context.Context, q *application.ApplicationBulkOperationRequest) (*application.ApplicationBulkOperation, error) {
+	validOperation := false
+	for _, operation := range bulkOperations {
+		validOperation = validOperation || operation == q.GetOperation()
+	}
+	if !validOperation {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operation '%s', must be one of %s", q.GetOperation(), strings.Join(bulkOperations, ", "))
+	}
+	if q.GetOperation() == bulkOperationSetParameters && len(q.Parameters) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s operations require parameters", bulkOperationSetParameters)
+	}
+	if q.GetSelector() == "" && len(q.Projects) == 0 && len(q.Names) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one of selector, projects or names is required")
+	}
+	concurrency := int(q.GetConcurrency())
+	if concurrency <= 0 {
+		concurrency = defaultBulkOperationConcurrency
+	} else if concurrency > maxBulkOperationConcurrency {
+		return nil, status.Errorf(codes.InvalidArgument, "concurrency must not be greater than %d", maxBulkOperationConcurrency)
+	}
+
+	apps, err := s.List(ctx, &application.ApplicationQuery{Selector: q.Selector, Projects: q.Projects, AppNamespace: q.AppNamespace})
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, name := range q.Names {
+		names[name] = true
+	}
+
+	id, err := rand.String(16)
+	if err != nil {
+		return nil, fmt.Errorf("error generating bulk operation id: %w", err)
+	}
+	now := time.Now()
+	op := &application.ApplicationBulkOperation{
+		Id:          &id,
+		Operation:   q.Operation,
+		User:        ptr.To(bulkOperationUser(ctx)),
+		Phase:       ptr.To(bulkOperationPhaseRunning),
+		StartedAt:   &metav1.Time{Time: now},
+		Replica:     ptr.To(bulkOperationReplica()),
+		HeartbeatAt: &metav1.Time{Time: now},
+	}
+	for i := range apps.Items {
+		a := apps.Items[i]
+		if len(names) > 0 && !names[a.Name] {
+			continue
+		}
+		op.Items = append(op.Items, &application.ApplicationBulkOperationItem{
+			Name:         ptr.To(a.Name),
+			AppNamespace: ptr.To(a.Namespace),
+			Project:      ptr.To(a.Spec.GetProject()),
+			Phase:        ptr.To(bulkOperationPhasePending),
+		})
+	}
+	job := &bulkOperationJob{owner: session.UserID(ctx), op: op}
+	if len(op.Items) == 0 {
+		job.complete()
+	}
+	res, _ := job.snapshot()
+	if err := s.cache.SetBulkOperation(job.owner, res); err != nil {
+		return nil, fmt.Errorf("error saving bulk operation: %w", err)
+	}
+	if len(op.Items) > 0 {
+		// The operation continues after the call returned, so it runs with a context which only carries the claims of
+		// the caller. They are verified again for each application, see runBulkOperationItem.
+		runCtx := context.WithValue(context.Background(), "claims", ctx.Value("claims"))
+		go s.runBulkOperation(runCtx, job, q, concurrency)
+	}
+	return res, nil
+}
+
+func (s *Server) saveBulkOperation(job *bulkOperationJob) {
+	job.heartbeat(time.Now())
+	op, dirty := job.snapshot()
+	if !dirty {
+		return
+	}
+	if err := s.cache.SetBulkOperation(job.owner, op); err != nil {
+		log.Warnf("Failed to save progress of bulk operation %s: %v", op.GetId(), err)
+	}
+}
+
+func (s *Server) runBulkOperation(ctx context.Context, job *bulkOperationJob, q *application.ApplicationBulkOperationRequest, concurrency int) {
+	done := make(chan struct{})
+	ticker := time.NewTicker(bulkOperationFlushInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				s.saveBulkOperation(job)
+			}
+		}
+	}()
+
+	items, _ := job.snapshot()
+	var group errgroup.Group
+	group.SetLimit(concurrency)
+	for i, item := range items.Items {
+		group.Go(func() error {
+			job.update(i, bulkOperationPhaseRunning, "")
+			message, err := s.runBulkOperationItem(ctx, q, item)
+			if err != nil {
+				job.update(i, bulkOperationPhaseFailed, status.Convert(err).Message())
+			} else {
+				job.update(i, bulkOperationPhaseSucceeded, message)
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+	close(done)
+	job.complete()
+	s.saveBulkOperation(job)
+	log.Infof("Bulk operation %s of %s finished for %d applications", items.GetId(), items.GetUser(), len(items.Items))
+}
+
+// runBulkOperationItem runs the operation for a single application. Since the operation may run long after it was
+// started, the token of the caller is verified again, so that the operation stops once it expired or was revoked. The
+// RBAC checks are those of the calls which run the operation for a single application, so they are evaluated against
+// the current policy of every application.
+func (s *Server) runBulkOperationItem(ctx context.Context, q *application.ApplicationBulkOperationRequest, item *application.ApplicationBulkOperationItem) (string, error) {
+	if claims, ok := ctx.Value("claims").(jwt.Claims); ok && claims != nil {
+		if err := s.sessionMgr.VerifyClaims(claims); err != nil {
+			return "", status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+		}
+	}
+	switch q.GetOperation() {
+	case bulkOperationRefresh, bulkOperationHardRefresh:
+		refreshType := appv1.RefreshTypeNormal
+		if q.GetOperation() == bulkOperationHardRefresh {
+			refreshType = appv1.RefreshTypeHard
+		}
+		a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionGet, item.GetProject(), item.GetAppNamespace(), item.GetName(), "")
+		if err != nil {
+			return "", err
+		}
+		if _, err := argoutil.RefreshApp(s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace), a.Name, refreshType); err != nil {
+			return "", fmt.Errorf("error refreshing the app: %w", err)
+		}
+		return fmt.Sprintf("requested %s refresh", refreshType), nil
+	case bulkOperationSync:
+		a, err := s.Sync(ctx, &application.ApplicationSyncRequest{Name: item.Name, AppNamespace: item.AppNamespace, Project: item.Project, Prune: q.Prune, DryRun: q.DryRun})
+		if err != nil {
+			return "", err
+		}
+		if a.Operation != nil && a.Operation.Approval != nil {
+			return "sync awaiting approval", nil
+		}
+		return "sync initiated", nil
+	case bulkOperationTerminateOp:
+		if _, err := s.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: item.Name, AppNamespace: item.AppNamespace, Project: item.Project}); err != nil {
+			return "", err
+		}
+		return "operation terminated", nil
+	case bulkOperationSetParameters:
+		a, _, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionUpdate, item.GetProject(), item.GetAppNamespace(), item.GetName(), "")
+		if err != nil {
+			return "", err
+		}
+		if a.Spec.HasMultipleSources() || a.Spec.Source == nil {
+			return "", status.Errorf(codes.FailedPrecondition, "parameters can only be set for applications with a single source")
+		}
+		sourceType, err := a.Spec.Source.ExplicitType()
+		if err != nil {
+			return "", status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if sourceType == nil && a.Status.SourceType != "" {
+			sourceType = &a.Status.SourceType
+		}
+		if !a.Spec.Source.IsHelm() && (sourceType == nil || *sourceType != appv1.ApplicationSourceTypeHelm) {
+			return "", status.Errorf(codes.FailedPrecondition, "parameters can only be set for Helm applications")
+		}
+		if a.Spec.Source.Helm == nil {
+			a.Spec.Source.Helm = &appv1.ApplicationSourceHelm{}
+		}
+		for _, p := range q.Parameters {
+			if p != nil {
+				a.Spec.Source.Helm.AddParameter(*p)
+			}
+		}
+		if _, err := s.validateAndUpdateApp(ctx, a, false, true, rbacpolicy.ActionUpdate, item.GetProject()); err != nil {
+			return "", err
+		}
+		return "parameters set", nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "invalid operation '%s'", q.GetOperation())
+}
+
+// getBulkOperation returns the progress of the bulk operation with the given id. Bulk operations can only be tracked
+// by the user who started them. Running operations whose heartbeat is stale are failed, since bulk operations do not
+// survive a restart of the API server running them.
+func (s *Server) getBulkOperation(ctx context.Context, id string) (*application.ApplicationBulkOperation, error) {
+	owner := session.UserID(ctx)
+	op, err := s.cache.GetBulkOperation(owner, id)
+	if err != nil {
+		if errors.Is(err, servercache.ErrCacheMiss) {
+			return nil, status.Errorf(codes.NotFound, "bulk operation %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting bulk operation: %w", err)
+	}
+	if op.GetPhase() == bulkOperationPhaseRunning && op.HeartbeatAt != nil && time.Since(op.HeartbeatAt.Time) > 3*bulkOperationHeartbeatInterval {
+		failInterruptedBulkOperation(op)
+		if err := s.cache.SetBulkOperation(owner, op); err != nil {
+			log.Warnf("Failed to save interrupted bulk operation %s: %v", op.GetId(), err)
+		}
+	}
+	return op, nil
+}
+
+// failInterruptedBulkOperation fails the operation and the applications it did not finish for, since the API server
+// running it stopped reporting its progress
+func failInterruptedBulkOperation(op *application.ApplicationBulkOperation) {
+	message := fmt.Sprintf("interrupted, since API server %s stopped running the operation, e.g. because it was restarted", op.GetReplica())
+	op.Phase = ptr.To(bulkOperationPhaseFailed)
+	op.Message = &message
+	op.FinishedAt = &metav1.Time{Time: time.Now()}
+	for _, item := range op.Items {
+		if item.GetPhase() == bulkOperationPhasePending || item.GetPhase() == bulkOperationPhaseRunning {
+			item.Phase = ptr.To(bulkOperationPhaseFailed)
+			item.Message = ptr.To("interrupted")
+		}
+	}
+}
+
+// GetBulkOperation returns the progress of a bulk operation
+func (s *Server) GetBulkOperation(ctx context.Context, q *application.ApplicationBulkOperationQuery) (*application.ApplicationBulkOperation, error) {
+	return s.getBulkOperation(ctx, q.GetId())
+}
+
+// sameBulkOperationProgress returns whether the progress of the operations is the same, regardless of their heartbeats
+func sameBulkOperationProgress(a *application.ApplicationBulkOperation, b *application.ApplicationBulkOperation) bool {
+	aCopy, bCopy := *a, *b
+	aCopy.HeartbeatAt, bCopy.HeartbeatAt = nil, nil
+	return reflect.DeepEqual(&aCopy, &bCopy)
+}
+
+// WatchBulkOperation returns a stream of the progress of a bulk operation, which ends once the operation finished
+func (s *Server) WatchBulkOperation(q *application.ApplicationBulkOperationQuery, ws application.ApplicationService_WatchBulkOperationServer) error {
+	ctx := ws.Context()
+	ticker := time.NewTicker(bulkOperationPollInterval)
+	defer ticker.Stop()
+	var last *application.ApplicationBulkOperation
+	for {
+		op, err := s.getBulkOperation(ctx, q.GetId())
+		if err != nil {
+			return err
+		}
+		if last == nil || !sameBulkOperationProgress(op, last) {
+			if err := ws.Send(op); err != nil {
+				return err
+			}
+			last = op
+		}
+		if op.GetPhase() != bulkOperationPhaseRunning {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
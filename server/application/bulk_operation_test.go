@@ -0,0 +1,235 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+type fakeBulkOperationWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*application.ApplicationBulkOperation
+}
+
+func (s *fakeBulkOperationWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeBulkOperationWatchStream) Send(op *application.ApplicationBulkOperation) error {
+	s.sent = append(s.sent, op)
+	return nil
+}
+
+func newTestAppServerWithBulkOperations(t *testing.T) *Server {
+	t.Helper()
+	flushInterval, pollInterval := bulkOperationFlushInterval, bulkOperationPollInterval
+	bulkOperationFlushInterval, bulkOperationPollInterval = 10*time.Millisecond, 10*time.Millisecond
+	t.Cleanup(func() {
+		bulkOperationFlushInterval, bulkOperationPollInterval = flushInterval, pollInterval
+	})
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy("g, admin, role:admin\ng, viewers, role:readonly")
+	}
+	var objects []runtime.Object
+	for _, app := range []struct{ name, team string }{{"app-1", "a"}, {"app-2", "a"}, {"app-3", "b"}} {
+		objects = append(objects, newTestApp(func(a *appsv1.Application) {
+			a.Name = app.name
+			a.Labels = map[string]string{"team": app.team}
+		}))
+	}
+	objects = append(objects, newTestApp(func(a *appsv1.Application) {
+		a.Name = "chart"
+		a.Labels = map[string]string{"team": "helm"}
+		a.Spec.Source = &appsv1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "chart", TargetRevision: "1.0.0"}
+	}))
+	return newTestAppServerWithEnforcerConfigure(f, t, map[string]string{}, objects...)
+}
+
+func waitForBulkOperation(t *testing.T, appServer *Server, ctx context.Context, id string) *application.ApplicationBulkOperation {
+	t.Helper()
+	var op *application.ApplicationBulkOperation
+	require.Eventually(t, func() bool {
+		var err error
+		op, err = appServer.GetBulkOperation(ctx, &application.ApplicationBulkOperationQuery{Id: &id})
+		require.NoError(t, err)
+		return op.GetPhase() != bulkOperationPhaseRunning
+	}, 10*time.Second, 10*time.Millisecond)
+	return op
+}
+
+func TestStartBulkOperation(t *testing.T) {
+	appServer := newTestAppServerWithBulkOperations(t)
+	adminCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "alice", "groups": []string{"admin"}})
+	viewerCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "bob", "groups": []string{"viewers"}})
+
+	t.Run("refresh", func(t *testing.T) {
+		op, err := appServer.StartBulkOperation(adminCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationHardRefresh), Selector: ptr.To("team=a")})
+		require.NoError(t, err)
+		assert.Equal(t, "alice", op.GetUser())
+		require.Len(t, op.Items, 2)
+		assert.Equal(t, "app-1", op.Items[0].GetName())
+		assert.Equal(t, "default", op.Items[0].GetProject())
+
+		op = waitForBulkOperation(t, appServer, adminCtx, op.GetId())
+		assert.Equal(t, bulkOperationPhaseSucceeded, op.GetPhase())
+		assert.NotNil(t, op.FinishedAt)
+		for _, item := range op.Items {
+			assert.Equal(t, bulkOperationPhaseSucceeded, item.GetPhase())
+			assert.Equal(t, "requested hard refresh", item.GetMessage())
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), item.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, string(appsv1.RefreshTypeHard), app.Annotations[appsv1.AnnotationKeyRefresh])
+		}
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), "app-3", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, appsv1.AnnotationKeyRefresh)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		op, err := appServer.StartBulkOperation(viewerCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationSync), Names: []string{"app-1", "app-3"}})
+		require.NoError(t, err)
+		require.Len(t, op.Items, 2)
+		op = waitForBulkOperation(t, appServer, viewerCtx, op.GetId())
+		assert.Equal(t, bulkOperationPhaseFailed, op.GetPhase())
+		for _, item := range op.Items {
+			assert.Equal(t, bulkOperationPhaseFailed, item.GetPhase())
+			assert.Contains(t, item.GetMessage(), "permission denied")
+		}
+
+		_, err = appServer.GetBulkOperation(adminCtx, &application.ApplicationBulkOperationQuery{Id: op.Id})
+		assert.Equal(t, codes.NotFound, status.Code(err), "bulk operations can only be tracked by the user who started them")
+	})
+
+	t.Run("set parameters", func(t *testing.T) {
+		params := []*appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}
+		op, err := appServer.StartBulkOperation(adminCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationSetParameters), Names: []string{"app-1", "chart"}, Parameters: params})
+		require.NoError(t, err)
+		op = waitForBulkOperation(t, appServer, adminCtx, op.GetId())
+		items := map[string]*application.ApplicationBulkOperationItem{}
+		for _, item := range op.Items {
+			items[item.GetName()] = item
+		}
+		assert.Equal(t, bulkOperationPhaseSucceeded, items["chart"].GetPhase())
+		assert.Equal(t, bulkOperationPhaseFailed, items["app-1"].GetPhase())
+		assert.Equal(t, "parameters can only be set for Helm applications", items["app-1"].GetMessage())
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), "app-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Spec.Source.Helm)
+		app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), "chart", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.Source.Helm)
+		assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}, app.Spec.Source.Helm.Parameters)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		revokedCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "alice", "jti": "revoked", "groups": []string{"admin"}})
+		require.NoError(t, appServer.sessionMgr.RevokeToken(context.Background(), "revoked", time.Hour))
+
+		op, err := appServer.StartBulkOperation(revokedCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationRefresh), Names: []string{"app-1"}})
+		require.NoError(t, err)
+		op = waitForBulkOperation(t, appServer, revokedCtx, op.GetId())
+		assert.Equal(t, bulkOperationPhaseFailed, op.GetPhase())
+		assert.Contains(t, op.Items[0].GetMessage(), "invalid session")
+	})
+
+	t.Run("owned by issuer and subject", func(t *testing.T) {
+		op, err := appServer.StartBulkOperation(adminCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationRefresh), Names: []string{"app-1"}})
+		require.NoError(t, err)
+		otherIssuerCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "alice", "iss": "https://other.example.com", "groups": []string{"admin"}})
+		_, err = appServer.GetBulkOperation(otherIssuerCtx, &application.ApplicationBulkOperationQuery{Id: op.Id})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("no matching applications", func(t *testing.T) {
+		op, err := appServer.StartBulkOperation(adminCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationRefresh), Selector: ptr.To("team=c")})
+		require.NoError(t, err)
+		assert.Empty(t, op.Items)
+		assert.Equal(t, bulkOperationPhaseSucceeded, op.GetPhase())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, req := range []*application.ApplicationBulkOperationRequest{
+			{Operation: ptr.To("delete"), Selector: ptr.To("team=a")},
+			{Operation: ptr.To(bulkOperationSync)},
+			{Operation: ptr.To(bulkOperationSetParameters), Selector: ptr.To("team=a")},
+			{Operation: ptr.To(bulkOperationSync), Selector: ptr.To("team=a"), Concurrency: ptr.To(int32(maxBulkOperationConcurrency + 1))},
+		} {
+			_, err := appServer.StartBulkOperation(adminCtx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+}
+
+func TestWatchBulkOperation(t *testing.T) {
+	appServer := newTestAppServerWithBulkOperations(t)
+	adminCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "alice", "groups": []string{"admin"}})
+
+	op, err := appServer.StartBulkOperation(adminCtx, &application.ApplicationBulkOperationRequest{Operation: ptr.To(bulkOperationRefresh), Projects: []string{"default"}})
+	require.NoError(t, err)
+	assert.NotEmpty(t, op.GetReplica())
+	assert.NotNil(t, op.HeartbeatAt)
+	stream := &fakeBulkOperationWatchStream{ctx: adminCtx}
+	require.NoError(t, appServer.WatchBulkOperation(&application.ApplicationBulkOperationQuery{Id: op.Id}, stream))
+	require.NotEmpty(t, stream.sent)
+	last := stream.sent[len(stream.sent)-1]
+	assert.Equal(t, bulkOperationPhaseSucceeded, last.GetPhase())
+	assert.Len(t, last.Items, 4)
+
+	err = appServer.WatchBulkOperation(&application.ApplicationBulkOperationQuery{Id: ptr.To("missing")}, &fakeBulkOperationWatchStream{ctx: adminCtx})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchBulkOperation_Interrupted(t *testing.T) {
+	appServer := newTestAppServerWithBulkOperations(t)
+	adminCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": "alice", "groups": []string{"admin"}})
+
+	// the operation is left running by an API server which was restarted
+	heartbeatAt := time.Now().Add(-4 * bulkOperationHeartbeatInterval)
+	op := &application.ApplicationBulkOperation{
+		Id:          ptr.To("interrupted"),
+		Operation:   ptr.To(bulkOperationRefresh),
+		User:        ptr.To("alice"),
+		Phase:       ptr.To(bulkOperationPhaseRunning),
+		StartedAt:   &metav1.Time{Time: heartbeatAt},
+		Replica:     ptr.To("argocd-server-0"),
+		HeartbeatAt: &metav1.Time{Time: heartbeatAt},
+		Items: []*application.ApplicationBulkOperationItem{
+			{Name: ptr.To("app-1"), Phase: ptr.To(bulkOperationPhaseSucceeded)},
+			{Name: ptr.To("app-2"), Phase: ptr.To(bulkOperationPhaseRunning)},
+		},
+	}
+	require.NoError(t, appServer.cache.SetBulkOperation(session.UserID(adminCtx), op))
+
+	stream := &fakeBulkOperationWatchStream{ctx: adminCtx}
+	require.NoError(t, appServer.WatchBulkOperation(&application.ApplicationBulkOperationQuery{Id: op.Id}, stream))
+	require.Len(t, stream.sent, 1)
+	interrupted := stream.sent[0]
+	assert.Equal(t, bulkOperationPhaseFailed, interrupted.GetPhase())
+	assert.Contains(t, interrupted.GetMessage(), "API server argocd-server-0 stopped running the operation")
+	assert.NotNil(t, interrupted.FinishedAt)
+	assert.Equal(t, bulkOperationPhaseSucceeded, interrupted.Items[0].GetPhase())
+	assert.Equal(t, bulkOperationPhaseFailed, interrupted.Items[1].GetPhase())
+
+	saved, err := appServer.GetBulkOperation(adminCtx, &application.ApplicationBulkOperationQuery{Id: op.Id})
+	require.NoError(t, err)
+	assert.Equal(t, bulkOperationPhaseFailed, saved.GetPhase())
+}
@@ -20,8 +20,10 @@ import (
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/audit"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -45,6 +47,12 @@ type terminalHandler struct {
 type TerminalOptions struct {
 	DisableAuth bool
 	Enf         *rbac.Enforcer
+	// RecordingStore stores the recordings of terminal sessions. Sessions are not recorded if it is nil.
+	RecordingStore recording.Store
+	// Auditor records the start of recorded sessions in the audit log, if it is not nil
+	Auditor *audit.Auditor
+	// GetScopes returns the scopes of the groups of users in the audit log
+	GetScopes func() []string
 }
 
 // NewHandler returns a new terminal handler.
@@ -236,11 +244,37 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	fieldLog.Info("terminal session starting")
 
+	var recorder *recording.Recorder
+	if s.terminalOptions.RecordingStore != nil {
+		recorder, err = s.startRecording(r, recording.Metadata{
+			User:         sessionmgr.Username(ctx),
+			Application:  app,
+			AppNamespace: ns,
+			Project:      project,
+			Namespace:    namespace,
+			Pod:          podName,
+			Container:    container,
+			Shell:        shell,
+		})
+		if err != nil {
+			fieldLog.Errorf("error starting terminal recording: %s", err)
+			http.Error(w, "Failed to start terminal recording", http.StatusInternalServerError)
+			return
+		}
+		fieldLog = fieldLog.WithField("recording", recorder.Metadata().ID)
+		defer func() {
+			if err := recorder.Close(context.Background()); err != nil {
+				fieldLog.Errorf("error saving terminal recording: %s", err)
+			}
+		}()
+	}
+
 	session, err := newTerminalSession(ctx, w, r, nil, s.sessionManager, appRBACName, s.terminalOptions)
 	if err != nil {
 		http.Error(w, "Failed to start terminal session", http.StatusBadRequest)
 		return
 	}
+	session.recorder = recorder
 	defer session.Done()
 
 	// send pings across the WebSocket channel at regular intervals to keep it alive through
@@ -269,6 +303,30 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	session.Close()
 }
 
+// startRecording starts recording a terminal session and records the start in the audit log
+func (s *terminalHandler) startRecording(r *http.Request, meta recording.Metadata) (*recording.Recorder, error) {
+	if meta.User == "" {
+		meta.User = sessionmgr.Sub(r.Context())
+	}
+	recorder, err := recording.NewRecorder(s.terminalOptions.RecordingStore, meta)
+	if err != nil {
+		return nil, err
+	}
+	if s.terminalOptions.Auditor != nil {
+		meta = recorder.Metadata()
+		record := audit.NewHTTPRecord(r, terminalRecordingAuditMethod, s.terminalOptions.GetScopes)
+		record.Target = audit.Target{Service: "terminal", Name: meta.Application, Namespace: meta.AppNamespace, Project: meta.Project}
+		record.Request = map[string]interface{}{
+			"recording": meta.ID,
+			"namespace": meta.Namespace,
+			"pod":       meta.Pod,
+			"container": meta.Container,
+		}
+		s.terminalOptions.Auditor.Record(record)
+	}
+	return recorder, nil
+}
+
 func podExists(treeNodes []appv1.ResourceNode, podName, namespace string) bool {
 	for _, treeNode := range treeNodes {
 		if treeNode.Kind == kube.PodKind && treeNode.Group == "" && treeNode.UID != "" &&
@@ -279,7 +337,12 @@ func podExists(treeNodes []appv1.ResourceNode, podName, namespace string) bool {
 	return false
 }
 
-const EndOfTransmission = "\u0004"
+const (
+	EndOfTransmission = "\u0004"
+
+	// terminalRecordingAuditMethod is the method of the audit records of started terminal recordings
+	terminalRecordingAuditMethod = "/terminal/recording/Start"
+)
 
 // PtyHandler is what remotecommand expects from a pty
 type PtyHandler interface {
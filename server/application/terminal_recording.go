@@ -0,0 +1,128 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/server/recording"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+// terminalRecordingChunkSize is the size of the chunks recordings are streamed in
+const terminalRecordingChunkSize = 64 * 1024
+
+var errTerminalRecordingDisabled = status.Error(codes.Unimplemented, "terminal sessions are not recorded")
+
+// recordingRBACName returns the RBAC name of the application of a recording
+func (s *Server) recordingRBACName(meta recording.Metadata) string {
+	return security.RBACName(s.ns, meta.Project, meta.AppNamespace, meta.Application)
+}
+
+// isRecordingOfApp returns whether the recording is of the application with the given name and namespace. The
+// recordings of an application remain available after it was deleted.
+func (s *Server) isRecordingOfApp(meta recording.Metadata, name string, appNs string) bool {
+	return meta.Application == name && s.appNamespaceOrDefault(meta.AppNamespace) == s.appNamespaceOrDefault(appNs)
+}
+
+// mayReplayRecordingsOfApp returns whether the user may replay the recordings of the existing application with the
+// given name and namespace
+func (s *Server) mayReplayRecordingsOfApp(ctx context.Context, name string, appNs string) bool {
+	a, err := s.appLister.Applications(s.appNamespaceOrDefault(appNs)).Get(name)
+	if err != nil {
+		return false
+	}
+	return s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceExec, rbacpolicy.ActionReplay, a.RBACName(s.ns))
+}
+
+func toTerminalRecording(meta recording.Metadata) *application.TerminalRecording {
+	res := &application.TerminalRecording{
+		Id:          ptr.To(meta.ID),
+		User:        ptr.To(meta.User),
+		Application: ptr.To(meta.Application),
+		Project:     ptr.To(meta.Project),
+		Namespace:   ptr.To(meta.Namespace),
+		Pod:         ptr.To(meta.Pod),
+		Container:   ptr.To(meta.Container),
+		StartedAt:   &metav1.Time{Time: meta.StartedAt},
+		Size_:       ptr.To(meta.Size),
+	}
+	if meta.AppNamespace != "" {
+		res.AppNamespace = ptr.To(meta.AppNamespace)
+	}
+	if meta.Shell != "" {
+		res.Shell = ptr.To(meta.Shell)
+	}
+	if !meta.EndedAt.IsZero() {
+		res.EndedAt = &metav1.Time{Time: meta.EndedAt}
+	}
+	return res
+}
+
+// ListTerminalRecordings returns the recorded terminal sessions of an application which the user may replay
+func (s *Server) ListTerminalRecordings(ctx context.Context, q *application.ApplicationTerminalRecordingsQuery) (*application.TerminalRecordingList, error) {
+	if s.recordingStore == nil {
+		return nil, errTerminalRecordingDisabled
+	}
+	items, err := s.recordingStore.List(ctx, s.appNamespaceOrDefault(q.GetAppNamespace()), q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error listing terminal recordings: %w", err)
+	}
+	res := &application.TerminalRecordingList{Items: []*application.TerminalRecording{}}
+	for _, meta := range items {
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceExec, rbacpolicy.ActionReplay, s.recordingRBACName(meta)) {
+			continue
+		}
+		res.Items = append(res.Items, toTerminalRecording(meta))
+	}
+	return res, nil
+}
+
+// GetTerminalRecording streams a recorded terminal session in the asciicast v2 format
+func (s *Server) GetTerminalRecording(q *application.ApplicationTerminalRecordingQuery, ws application.ApplicationService_GetTerminalRecordingServer) error {
+	if s.recordingStore == nil {
+		return errTerminalRecordingDisabled
+	}
+	ctx := ws.Context()
+	meta, err := s.recordingStore.Get(ctx, q.GetId())
+	if err != nil && !errors.Is(err, recording.ErrNotFound) {
+		return fmt.Errorf("error getting terminal recording: %w", err)
+	}
+	if err != nil || !s.isRecordingOfApp(*meta, q.GetName(), q.GetAppNamespace()) {
+		// Users who may not replay the recordings of the application must not learn which recordings exist
+		if !s.mayReplayRecordingsOfApp(ctx, q.GetName(), q.GetAppNamespace()) {
+			return permissionDeniedErr
+		}
+		return status.Errorf(codes.NotFound, "terminal recording %s of application %s not found", q.GetId(), q.GetName())
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceExec, rbacpolicy.ActionReplay, s.recordingRBACName(*meta)); err != nil {
+		return err
+	}
+	reader, err := s.recordingStore.Open(ctx, meta.ID)
+	if err != nil {
+		return fmt.Errorf("error opening terminal recording: %w", err)
+	}
+	defer reader.Close()
+	buf := make([]byte, terminalRecordingChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := ws.Send(&application.TerminalRecordingChunk{Data: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error reading terminal recording: %w", err)
+		}
+	}
+}
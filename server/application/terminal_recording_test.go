@@ -0,0 +1,134 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/recording"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+type fakeTerminalRecordingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (s *fakeTerminalRecordingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeTerminalRecordingStream) Send(chunk *application.TerminalRecordingChunk) error {
+	_, err := s.data.Write(chunk.Data)
+	return err
+}
+
+func newTestAppServerWithRecordings(t *testing.T) (*Server, map[string]recording.Metadata) {
+	t.Helper()
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy("p, role:auditor, exec, replay, default/*, allow\ng, auditors, role:auditor\ng, admin, role:admin")
+	}
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "test"
+	})
+	appServer := newTestAppServerWithEnforcerConfigure(f, t, map[string]string{}, testApp)
+	store, err := recording.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	appServer.recordingStore = store
+
+	recordings := map[string]recording.Metadata{}
+	for i, meta := range []recording.Metadata{
+		{User: "alice", Application: "test", Project: "default", Namespace: "default", Pod: "test-pod", Container: "main"},
+		{User: "bob", Application: "test", Project: "default", Namespace: "default", Pod: "test-pod", Container: "main", Shell: "sh"},
+		{User: "bob", Application: "test", Project: "my-proj", Namespace: "default", Pod: "test-pod", Container: "main"},
+		{User: "bob", Application: "other", Project: "default", Namespace: "default", Pod: "other-pod", Container: "main"},
+	} {
+		meta.AppNamespace = testNamespace
+		meta.StartedAt = time.Now().Add(time.Duration(i) * time.Minute)
+		meta.EndedAt = meta.StartedAt.Add(time.Minute)
+		id, err := recording.NewID(meta.StartedAt)
+		require.NoError(t, err)
+		meta.ID = id
+		require.NoError(t, store.Save(context.Background(), meta, strings.NewReader(`{"version":2}`+"\n")))
+		recordings[meta.User+"/"+meta.Project+"/"+meta.Application] = meta
+	}
+	return appServer, recordings
+}
+
+func TestListTerminalRecordings(t *testing.T) {
+	appServer, recordings := newTestAppServerWithRecordings(t)
+	adminCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"admin"}})
+	auditorCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"auditors"}})
+	noRoleCtx := context.Background()
+
+	list, err := appServer.ListTerminalRecordings(adminCtx, &application.ApplicationTerminalRecordingsQuery{Name: ptr.To("test")})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 3)
+	assert.Equal(t, recordings["bob/my-proj/test"].ID, list.Items[0].GetId(), "recordings are sorted newest first")
+	assert.Equal(t, "sh", list.Items[1].GetShell())
+	assert.Equal(t, int64(60), list.Items[1].EndedAt.Unix()-list.Items[1].StartedAt.Unix())
+
+	list, err = appServer.ListTerminalRecordings(auditorCtx, &application.ApplicationTerminalRecordingsQuery{Name: ptr.To("test")})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2, "only the recordings of the projects the user may replay are listed")
+	for _, item := range list.Items {
+		assert.Equal(t, "default", item.GetProject())
+	}
+
+	list, err = appServer.ListTerminalRecordings(noRoleCtx, &application.ApplicationTerminalRecordingsQuery{Name: ptr.To("test")})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	list, err = appServer.ListTerminalRecordings(adminCtx, &application.ApplicationTerminalRecordingsQuery{Name: ptr.To("test"), AppNamespace: ptr.To("other-ns")})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	t.Run("recording disabled", func(t *testing.T) {
+		appServer.recordingStore = nil
+		_, err := appServer.ListTerminalRecordings(adminCtx, &application.ApplicationTerminalRecordingsQuery{Name: ptr.To("test")})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestGetTerminalRecording(t *testing.T) {
+	appServer, recordings := newTestAppServerWithRecordings(t)
+	auditorCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"auditors"}})
+
+	stream := &fakeTerminalRecordingStream{ctx: auditorCtx}
+	err := appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("test"), Id: ptr.To(recordings["alice/default/test"].ID)}, stream)
+	require.NoError(t, err)
+	assert.Equal(t, `{"version":2}`+"\n", stream.data.String())
+
+	err = appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("test"), Id: ptr.To(recordings["bob/my-proj/test"].ID)}, &fakeTerminalRecordingStream{ctx: auditorCtx})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("test"), Id: ptr.To(recordings["bob/default/other"].ID)}, &fakeTerminalRecordingStream{ctx: auditorCtx})
+	assert.Equal(t, codes.NotFound, status.Code(err), "the recording must be of the requested application")
+
+	err = appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("test"), Id: ptr.To("../../etc/passwd")}, &fakeTerminalRecordingStream{ctx: auditorCtx})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	missingID, err := recording.NewID(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	noRoleCtx := context.Background()
+	for _, id := range []string{recordings["alice/default/test"].ID, recordings["bob/default/other"].ID, missingID} {
+		err = appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("test"), Id: ptr.To(id)}, &fakeTerminalRecordingStream{ctx: noRoleCtx})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "users who may not replay recordings must not learn whether they exist")
+	}
+	err = appServer.GetTerminalRecording(&application.ApplicationTerminalRecordingQuery{Name: ptr.To("deleted"), Id: ptr.To(missingID)}, &fakeTerminalRecordingStream{ctx: auditorCtx})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
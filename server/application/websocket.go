@@ -10,6 +10,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	httputil "github.com/argoproj/argo-cd/v2/util/http"
 	util_session "github.com/argoproj/argo-cd/v2/util/session"
 
@@ -45,6 +46,7 @@ type terminalSession struct {
 	token          *string
 	appRBACName    string
 	terminalOpts   *TerminalOptions
+	recorder       *recording.Recorder
 }
 
 // getToken get auth token from web socket request
@@ -199,8 +201,15 @@ func (t *terminalSession) Read(p []byte) (int, error) {
 	}
 	switch msg.Operation {
 	case "stdin":
-		return copy(p, msg.Data), nil
+		n := copy(p, msg.Data)
+		if t.recorder != nil {
+			t.recorder.Input(msg.Data[:n])
+		}
+		return n, nil
 	case "resize":
+		if t.recorder != nil {
+			t.recorder.Resize(msg.Cols, msg.Rows)
+		}
 		t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
 		return 0, nil
 	default:
@@ -236,6 +245,9 @@ func (t *terminalSession) Write(p []byte) (int, error) {
 		log.Errorf("write message err: %v", err)
 		return 0, err
 	}
+	if t.recorder != nil {
+		t.recorder.Output(p)
+	}
 	return len(p), nil
 }
 
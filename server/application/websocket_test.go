@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 
@@ -164,3 +166,42 @@ func TestValidateWithoutPermissions(t *testing.T) {
 
 	testServerConnection(t, validate, true)
 }
+
+func TestTerminalSessionRecording(t *testing.T) {
+	store, err := recording.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	recorder, err := recording.NewRecorder(store, recording.Metadata{User: "alice", Application: "test", AppNamespace: testNamespace, Project: "default"})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+		ts := newTestTerminalSession(w, r)
+		ts.terminalOpts = &TerminalOptions{DisableAuth: true}
+		ts.recorder = recorder
+		p := make([]byte, 16)
+		n, err := ts.Read(p)
+		assert.NoError(t, err)
+		assert.Equal(t, "ls\r", string(p[:n]))
+		_, err = ts.Write([]byte("file.txt\r\n"))
+		assert.NoError(t, err)
+	}))
+	defer s.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(s.URL, "http"), nil)
+	require.NoError(t, err)
+	defer ws.Close()
+	require.NoError(t, ws.WriteJSON(TerminalMessage{Operation: "stdin", Data: "ls\r"}))
+	<-done
+	require.NoError(t, recorder.Close(context.Background()))
+
+	reader, err := store.Open(context.Background(), recorder.Metadata().ID)
+	require.NoError(t, err)
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[1], `"i","ls\r"`)
+	assert.Contains(t, lines[2], `"o","file.txt\r\n"`)
+}
@@ -0,0 +1,214 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RecordResultWritten is the result of records which were written to a sink
+	RecordResultWritten = "written"
+	// RecordResultFailed is the result of records which could not be written to a sink
+	RecordResultFailed = "failed"
+	// RecordResultDropped is the result of records which were dropped because the buffer of a sink was full
+	RecordResultDropped = "dropped"
+
+	// DefaultBufferSize is the default number of records buffered per sink
+	DefaultBufferSize = 1000
+	// maxBatchSize is the maximum number of records written to a sink at once
+	maxBatchSize = 100
+	// closeTimeout is the time sinks get to write the buffered records when the server stops
+	closeTimeout = 10 * time.Second
+)
+
+// Config configures the sinks of the audit log. The audit log is disabled if no sink is configured.
+type Config struct {
+	// File is the path of a file the records are appended to as JSON lines
+	File string
+	// SyslogAddress is the address of the syslog server the records are sent to, e.g. udp://syslog:514, or "local"
+	// for the local syslog daemon
+	SyslogAddress string
+	// WebhookURL is the URL the records are posted to as JSON arrays
+	WebhookURL string
+	// WebhookHeaders are additional headers of the webhook requests, e.g. for authentication
+	WebhookHeaders map[string]string
+	// BufferSize is the number of records buffered per sink. Records are dropped if the buffer is full.
+	BufferSize int
+}
+
+// Enabled returns whether any sink is configured
+func (c Config) Enabled() bool {
+	return c.File != "" || c.SyslogAddress != "" || c.WebhookURL != ""
+}
+
+// Record is the audit record of an API call
+type Record struct {
+	// Time is the time the call was received
+	Time time.Time `json:"time"`
+	// User is the name of the user who made the call
+	User string `json:"user,omitempty"`
+	// Groups are the groups of the user
+	Groups []string `json:"groups,omitempty"`
+	// SourceIP is the IP address the call was received from
+	SourceIP string `json:"sourceIP,omitempty"`
+	// ForwardedFor is the X-Forwarded-For header of the call, which contains the client IP address of calls received
+	// through the HTTP API or a proxy
+	ForwardedFor string `json:"forwardedFor,omitempty"`
+	// UserAgent is the user agent of the client
+	UserAgent string `json:"userAgent,omitempty"`
+	// Method is the full gRPC method name of the call
+	Method string `json:"method"`
+	// Target is the object the call was made for
+	Target Target `json:"target"`
+	// Result is the result of the call
+	Result Result `json:"result"`
+	// DurationMs is the time it took to handle the call in milliseconds
+	DurationMs int64 `json:"durationMs"`
+	// Request is the request of the call with sensitive fields redacted and long values truncated
+	Request map[string]interface{} `json:"request,omitempty"`
+}
+
+// Target is the object an API call was made for
+type Target struct {
+	// Service is the gRPC service which was called, e.g. application.ApplicationService
+	Service string `json:"service"`
+	// Name is the name of the object, e.g. the name of an application or the URL of a repository
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the object
+	Namespace string `json:"namespace,omitempty"`
+	// Project is the project of the object
+	Project string `json:"project,omitempty"`
+}
+
+// Result is the result of an API call
+type Result struct {
+	// Code is the gRPC status code of the call
+	Code string `json:"code"`
+	// Message is the error message of failed calls
+	Message string `json:"message,omitempty"`
+}
+
+// MetricsRegistry records the metrics of the audit log
+type MetricsRegistry interface {
+	// IncAuditRecords increases the number of records with the given result for the sink
+	IncAuditRecords(sink string, result string)
+	// SetAuditQueueLength sets the number of buffered records of the sink
+	SetAuditQueueLength(sink string, length int)
+}
+
+type noopMetricsRegistry struct{}
+
+func (noopMetricsRegistry) IncAuditRecords(string, string)  {}
+func (noopMetricsRegistry) SetAuditQueueLength(string, int) {}
+
+// Auditor buffers audit records and writes them to its sinks. Every sink has its own buffer and is written to
+// independently, so that a slow or failing sink does not delay the others.
+type Auditor struct {
+	workers []*sinkWorker
+	metrics MetricsRegistry
+}
+
+type sinkWorker struct {
+	sink    Sink
+	queue   chan Record
+	metrics MetricsRegistry
+}
+
+// NewAuditor returns an auditor which writes records to the given sinks
+func NewAuditor(sinks []Sink, bufferSize int, metrics MetricsRegistry) *Auditor {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	if metrics == nil {
+		metrics = noopMetricsRegistry{}
+	}
+	a := &Auditor{metrics: metrics}
+	for _, sink := range sinks {
+		a.workers = append(a.workers, &sinkWorker{sink: sink, queue: make(chan Record, bufferSize), metrics: metrics})
+	}
+	return a
+}
+
+// NewAuditorFromConfig returns an auditor which writes records to the sinks of the given config
+func NewAuditorFromConfig(config Config, metrics MetricsRegistry) (*Auditor, error) {
+	sinks, err := NewSinks(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuditor(sinks, config.BufferSize, metrics), nil
+}
+
+// Start starts writing records to the sinks until the context is done. The buffered records are written and the
+// sinks are closed afterwards.
+func (a *Auditor) Start(ctx context.Context) {
+	for _, w := range a.workers {
+		go w.run(ctx)
+	}
+}
+
+// Record buffers the record for all sinks. It never blocks: the record is dropped for sinks whose buffer is full.
+func (a *Auditor) Record(record Record) {
+	for _, w := range a.workers {
+		select {
+		case w.queue <- record:
+			a.metrics.SetAuditQueueLength(w.sink.Name(), len(w.queue))
+		default:
+			a.metrics.IncAuditRecords(w.sink.Name(), RecordResultDropped)
+			log.Warnf("Dropped audit record of %s, the buffer of audit sink %s is full", record.Method, w.sink.Name())
+		}
+	}
+}
+
+func (w *sinkWorker) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			w.close()
+			return
+		case record := <-w.queue:
+			w.write(ctx, w.batch(record))
+		}
+	}
+}
+
+// batch returns the given record along with the buffered records, up to the maximum batch size
+func (w *sinkWorker) batch(record Record) []Record {
+	batch := []Record{record}
+	for len(batch) < maxBatchSize {
+		select {
+		case record := <-w.queue:
+			batch = append(batch, record)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (w *sinkWorker) write(ctx context.Context, batch []Record) {
+	name := w.sink.Name()
+	result := RecordResultWritten
+	if err := w.sink.Write(ctx, batch); err != nil {
+		log.Warnf("Failed to write %d records to audit sink %s: %v", len(batch), name, err)
+		result = RecordResultFailed
+	}
+	for range batch {
+		w.metrics.IncAuditRecords(name, result)
+	}
+	w.metrics.SetAuditQueueLength(name, len(w.queue))
+}
+
+// close writes the buffered records and closes the sink
+func (w *sinkWorker) close() {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	// the worker is the only consumer of the queue, so receiving from a non-empty queue never blocks
+	for len(w.queue) > 0 {
+		w.write(ctx, w.batch(<-w.queue))
+	}
+	if err := w.sink.Close(); err != nil {
+		log.Warnf("Failed to close audit sink %s: %v", w.sink.Name(), err)
+	}
+}
@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	lock    sync.Mutex
+	batches [][]Record
+	err     error
+	block   chan struct{}
+	closed  bool
+}
+
+func (s *fakeSink) Name() string {
+	return "fake"
+}
+
+func (s *fakeSink) Write(_ context.Context, records []Record) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.batches = append(s.batches, records)
+	return s.err
+}
+
+func (s *fakeSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeSink) records() []Record {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var res []Record
+	for _, batch := range s.batches {
+		res = append(res, batch...)
+	}
+	return res
+}
+
+type fakeMetrics struct {
+	lock    sync.Mutex
+	records map[string]int
+}
+
+func (m *fakeMetrics) IncAuditRecords(sink string, result string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.records[sink+"/"+result]++
+}
+
+func (m *fakeMetrics) SetAuditQueueLength(string, int) {}
+
+func (m *fakeMetrics) get(key string) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.records[key]
+}
+
+func TestAuditor(t *testing.T) {
+	t.Run("records are written and sinks closed", func(t *testing.T) {
+		sink := &fakeSink{}
+		metrics := &fakeMetrics{records: map[string]int{}}
+		auditor := NewAuditor([]Sink{sink}, 10, metrics)
+		ctx, cancel := context.WithCancel(context.Background())
+		auditor.Start(ctx)
+
+		auditor.Record(Record{Method: "/application.ApplicationService/Sync"})
+		auditor.Record(Record{Method: "/application.ApplicationService/Delete"})
+		assert.Eventually(t, func() bool { return len(sink.records()) == 2 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, 2, metrics.get("fake/written"))
+
+		cancel()
+		assert.Eventually(t, func() bool {
+			sink.lock.Lock()
+			defer sink.lock.Unlock()
+			return sink.closed
+		}, time.Second, 10*time.Millisecond)
+	})
+	t.Run("records are dropped if the buffer is full", func(t *testing.T) {
+		sink := &fakeSink{block: make(chan struct{})}
+		metrics := &fakeMetrics{records: map[string]int{}}
+		auditor := NewAuditor([]Sink{sink}, 1, metrics)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		auditor.Start(ctx)
+
+		// the first record is taken by the blocked worker, the second one is buffered
+		auditor.Record(Record{Method: "1"})
+		assert.Eventually(t, func() bool { return len(auditor.workers[0].queue) == 0 }, time.Second, 10*time.Millisecond)
+		auditor.Record(Record{Method: "2"})
+		auditor.Record(Record{Method: "3"})
+		assert.Equal(t, 1, metrics.get("fake/dropped"))
+
+		close(sink.block)
+		assert.Eventually(t, func() bool { return len(sink.records()) == 2 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "1", sink.records()[0].Method)
+		assert.Equal(t, "2", sink.records()[1].Method)
+	})
+	t.Run("failed writes are counted", func(t *testing.T) {
+		sink := &fakeSink{err: errors.New("unavailable")}
+		metrics := &fakeMetrics{records: map[string]int{}}
+		auditor := NewAuditor([]Sink{sink}, 10, metrics)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		auditor.Start(ctx)
+
+		auditor.Record(Record{Method: "1"})
+		assert.Eventually(t, func() bool { return metrics.get("fake/failed") == 1 }, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), []Record{{Method: "1"}, {Method: "2"}}))
+	require.NoError(t, sink.Write(context.Background(), []Record{{Method: "3"}}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var methods []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		methods = append(methods, record.Method)
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, methods)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []Record
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var records []Record
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&records))
+		received = append(received, records...)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, map[string]string{"Authorization": "Bearer abc"})
+	require.NoError(t, sink.Write(context.Background(), []Record{{Method: "1"}, {Method: "2"}}))
+	require.Len(t, received, 2)
+	assert.Equal(t, "2", received[1].Method)
+
+	status = http.StatusBadRequest
+	received = nil
+	assert.EqualError(t, sink.Write(context.Background(), []Record{{Method: "3"}}), "webhook responded with status 400")
+	assert.Len(t, received, 1, "client errors are not retried")
+}
+
+func TestWebhookSink_Retry(t *testing.T) {
+	var attempts []int
+	statuses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts = append(attempts, len(attempts))
+		w.WriteHeader(statuses[(len(attempts)-1)%len(statuses)])
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, nil).(*webhookSink)
+	sink.retryInterval = time.Millisecond
+	require.NoError(t, sink.Write(context.Background(), []Record{{Method: "1"}}))
+	assert.Len(t, attempts, 3)
+
+	statuses = []int{http.StatusInternalServerError}
+	attempts = nil
+	assert.EqualError(t, sink.Write(context.Background(), []Record{{Method: "2"}}), "webhook responded with status 500")
+	assert.Len(t, attempts, webhookMaxAttempts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts = nil
+	sink.retryInterval = time.Hour
+	assert.Error(t, sink.Write(ctx, []Record{{Method: "3"}}))
+	assert.Empty(t, attempts, "requests of cancelled writes are not sent")
+}
+
+func TestRecord_JSON(t *testing.T) {
+	data, err := json.Marshal(Record{Method: "1", DurationMs: (1500 * time.Millisecond).Milliseconds()})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"durationMs":1500`)
+}
+
+func TestNewSinks(t *testing.T) {
+	sinks, err := NewSinks(Config{})
+	require.NoError(t, err)
+	assert.Empty(t, sinks)
+
+	sinks, err = NewSinks(Config{File: filepath.Join(t.TempDir(), "audit.log"), WebhookURL: "http://localhost"})
+	require.NoError(t, err)
+	require.Len(t, sinks, 2)
+	assert.Equal(t, "file", sinks[0].Name())
+	assert.Equal(t, "webhook", sinks[1].Name())
+
+	_, err = NewSinks(Config{SyslogAddress: "syslog:514"})
+	assert.Error(t, err)
+}
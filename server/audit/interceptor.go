@@ -0,0 +1,407 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+)
+
+const (
+	// RedactedValue replaces the values of sensitive request fields
+	RedactedValue = "++++++++"
+	// maxRequestValueLength is the maximum length of string values in request summaries
+	maxRequestValueLength = 256
+	// manifestsField is the request field which holds the manifests of syncs with local manifests
+	manifestsField = "manifests"
+	// lastAppliedConfigAnnotation is the annotation kubectl stores the applied manifest of an object in
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var (
+	// readOnlyMethodPrefixes are the prefixes of the names of methods which do not mutate anything. All other methods
+	// are recorded, so that new methods are audited by default.
+	readOnlyMethodPrefixes = []string{
+		"Get", "List", "Watch", "CanI", "Version", "ManagedResources", "ResourceTree", "ResourceDrift", "PodLogs",
+		"Revision", "ValidateAccess", "Generate",
+	}
+	// sensitiveFields are substrings of the lower-cased names of request fields whose values are redacted
+	sensitiveFields = []string{"password", "token", "secret", "privatekey", "keydata", "certkey", "bearer", "apikey", "serviceaccountkey"}
+	// targetObjectFields are the request fields which hold the object a call is made for
+	targetObjectFields = []string{"application", "applicationset", "project", "cluster", "repo", "creds"}
+	// targetNameFields are the fields which hold the name of the object a call is made for, in order of precedence
+	targetNameFields = []string{"name", "repo", "server", "url", "keyID"}
+	// secretDataFields are the fields of Secrets whose values are redacted in manifests
+	secretDataFields = []string{"data", "stringData"}
+	// configValueFields are the request fields which hold configuration values that routinely contain credentials, i.e.
+	// Helm values and parameters, the parameters of plugins and actions, and environment variables. Only the names of
+	// the values are recorded.
+	configValueFields = map[string]bool{"values": true, "valuesObject": true, "parameters": true, "env": true}
+)
+
+// IsMutatingMethod returns whether the gRPC method with the given full name mutates anything
+func IsMutatingMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+type auditedCallKey struct{}
+
+// auditedCall holds the context of an audited call, which is replaced by the context of the authenticated call once
+// the call has been authenticated
+type auditedCall struct {
+	ctx context.Context
+}
+
+// RecordAuthentication wraps the function authenticating the calls of the server, so that the records of calls hold
+// the user the call has been authenticated as. The interceptors of the audit log run before the authentication, so
+// that calls which are rejected by the authentication or the rate limiter are recorded as well.
+func RecordAuthentication(authenticate func(ctx context.Context) (context.Context, error)) func(ctx context.Context) (context.Context, error) {
+	return func(ctx context.Context) (context.Context, error) {
+		ctx, err := authenticate(ctx)
+		if call, ok := ctx.Value(auditedCallKey{}).(*auditedCall); ok {
+			call.ctx = ctx
+		}
+		return ctx, err
+	}
+}
+
+// UnaryServerInterceptor returns a server interceptor which records mutating unary calls. Calls are passed through if
+// the auditor is nil. The groups of users are determined using the scopes returned by getScopes.
+func UnaryServerInterceptor(auditor *Auditor, getScopes func() []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if auditor == nil || !IsMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		call := &auditedCall{ctx: ctx}
+		resp, err := handler(context.WithValue(ctx, auditedCallKey{}, call), req)
+		auditor.Record(newRecord(call.ctx, info.FullMethod, req, err, start, getScopes))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a server interceptor which records mutating streaming calls along with their first
+// request. Calls are passed through if the auditor is nil.
+func StreamServerInterceptor(auditor *Auditor, getScopes func() []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if auditor == nil || !IsMutatingMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		start := time.Now()
+		call := &auditedCall{ctx: stream.Context()}
+		auditStream := &auditServerStream{ServerStream: stream, ctx: context.WithValue(stream.Context(), auditedCallKey{}, call)}
+		err := handler(srv, auditStream)
+		auditor.Record(newRecord(call.ctx, info.FullMethod, auditStream.req, err, start, getScopes))
+		return err
+	}
+}
+
+type auditServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req interface{}
+}
+
+func (s *auditServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+func newRecord(ctx context.Context, fullMethod string, req interface{}, err error, start time.Time, getScopes func() []string) Record {
+	record := Record{
+		Time:       start,
+		Method:     fullMethod,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	setUser(ctx, &record, getScopes)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.SourceIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(record.SourceIP); err == nil {
+			record.SourceIP = host
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		record.ForwardedFor = strings.Join(md.Get("x-forwarded-for"), ", ")
+		// calls of the HTTP API carry the user agent of the HTTP client in a separate header
+		for _, header := range []string{"grpcgateway-user-agent", "user-agent"} {
+			if userAgent := md.Get(header); len(userAgent) > 0 {
+				record.UserAgent = userAgent[0]
+				break
+			}
+		}
+	}
+	st := status.Convert(err)
+	record.Result = Result{Code: st.Code().String(), Message: st.Message()}
+
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	request := requestToMap(req)
+	record.Target = requestTarget(service, request)
+	record.Request = summarizeRequest(request)
+	return record
+}
+
+// NewHTTPRecord returns a record of an action taken through a plain HTTP request, rather than a gRPC call, e.g. the
+// start of a terminal session. The user is taken from the claims in the context of the request. The caller sets the
+// target, result and request summary.
+func NewHTTPRecord(r *http.Request, method string, getScopes func() []string) Record {
+	record := Record{
+		Time:         time.Now(),
+		Method:       method,
+		ForwardedFor: r.Header.Get("X-Forwarded-For"),
+		UserAgent:    r.UserAgent(),
+		Result:       Result{Code: codes.OK.String()},
+	}
+	setUser(r.Context(), &record, getScopes)
+	record.SourceIP = r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		record.SourceIP = host
+	}
+	return record
+}
+
+func setUser(ctx context.Context, record *Record, getScopes func() []string) {
+	record.User = sessionmgr.Username(ctx)
+	if record.User == "" {
+		record.User = sessionmgr.Sub(ctx)
+	}
+	if getScopes != nil {
+		record.Groups = sessionmgr.Groups(ctx, getScopes())
+	}
+}
+
+// requestToMap converts a request into its JSON representation
+func requestToMap(req interface{}) map[string]interface{} {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil
+	}
+	var res map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &res); err != nil {
+		return nil
+	}
+	return res
+}
+
+func stringField(obj map[string]interface{}, path ...string) string {
+	for i, key := range path {
+		if i == len(path)-1 {
+			val, _ := obj[key].(string)
+			return val
+		}
+		obj, _ = obj[key].(map[string]interface{})
+	}
+	return ""
+}
+
+// requestTarget determines the object a call is made for from the fields of its request
+func requestTarget(service string, req map[string]interface{}) Target {
+	target := Target{
+		Service:   service,
+		Namespace: stringField(req, "appNamespace"),
+		Project:   stringField(req, "project"),
+	}
+	for _, field := range targetNameFields {
+		if target.Name = stringField(req, field); target.Name != "" {
+			break
+		}
+	}
+	for _, field := range targetObjectFields {
+		obj, ok := req[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if target.Name == "" {
+			target.Name = stringField(obj, "metadata", "name")
+		}
+		for _, nameField := range targetNameFields[1:] {
+			if target.Name != "" {
+				break
+			}
+			target.Name = stringField(obj, nameField)
+		}
+		if target.Namespace == "" {
+			target.Namespace = stringField(obj, "metadata", "namespace")
+		}
+		if target.Project == "" {
+			target.Project = stringField(obj, "spec", "project")
+		}
+		if target.Project == "" {
+			target.Project = stringField(obj, "project")
+		}
+		break
+	}
+	return target
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range sensitiveFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeRequest returns a copy of the request with the values of sensitive fields redacted and long values truncated
+func summarizeRequest(req map[string]interface{}) map[string]interface{} {
+	if req == nil {
+		return nil
+	}
+	res, _ := summarizeValue(req).(map[string]interface{})
+	return res
+}
+
+func summarizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			switch {
+			case isSensitiveField(key):
+				res[key] = RedactedValue
+			case key == manifestsField:
+				res[key] = summarizeManifests(val)
+			case configValueFields[key]:
+				res[key] = redactConfigValues(val)
+			default:
+				res[key] = summarizeValue(val)
+			}
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i := range v {
+			res[i] = summarizeValue(v[i])
+		}
+		return res
+	case string:
+		if len(v) > maxRequestValueLength {
+			return v[:maxRequestValueLength] + "...(truncated)"
+		}
+		return v
+	}
+	return value
+}
+
+// redactConfigValues returns a copy of the configuration values with all values redacted, keeping the keys of objects
+// and the names of list items, e.g. of Helm parameters or environment variables. Values given as YAML, e.g. Helm
+// values, are parsed to keep their keys, and redacted completely if they cannot be parsed.
+func redactConfigValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			res[key] = redactConfigValues(val)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i := range v {
+			item, ok := v[i].(map[string]interface{})
+			if !ok {
+				res[i] = redactConfigValues(v[i])
+				continue
+			}
+			redacted := redactConfigValues(item).(map[string]interface{})
+			if name, ok := item["name"].(string); ok {
+				redacted["name"] = summarizeValue(name)
+			}
+			res[i] = redacted
+		}
+		return res
+	case string:
+		var parsed map[string]interface{}
+		if err := yaml.Unmarshal([]byte(v), &parsed); err == nil && len(parsed) > 0 {
+			return redactConfigValues(parsed)
+		}
+		return RedactedValue
+	case nil:
+		return nil
+	}
+	return RedactedValue
+}
+
+// summarizeManifests returns the summary of the given manifests with the data of Secrets redacted, which cannot be
+// redacted by the names of their fields as long as the manifests are strings. Manifests which cannot be parsed are
+// redacted completely.
+func summarizeManifests(value interface{}) interface{} {
+	manifests, ok := value.([]interface{})
+	if !ok {
+		return RedactedValue
+	}
+	res := make([]interface{}, len(manifests))
+	for i := range manifests {
+		manifest, _ := manifests[i].(string)
+		var obj map[string]interface{}
+		if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil || obj == nil {
+			res[i] = RedactedValue
+			continue
+		}
+		redactSecretData(obj)
+		res[i] = summarizeValue(obj)
+	}
+	return res
+}
+
+// redactSecretData redacts the values of the data of the given object if it is a Secret, or of the Secrets in the
+// given object if it is a list
+func redactSecretData(obj map[string]interface{}) {
+	kind, _ := obj["kind"].(string)
+	if kind == "Secret" {
+		for _, field := range secretDataFields {
+			if data, ok := obj[field].(map[string]interface{}); ok {
+				for key := range data {
+					data[key] = RedactedValue
+				}
+			}
+		}
+		// the applied manifest contains the data as well
+		if stringField(obj, "metadata", "annotations", lastAppliedConfigAnnotation) != "" {
+			annotations := obj["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+			annotations[lastAppliedConfigAnnotation] = RedactedValue
+		}
+		return
+	}
+	if items, ok := obj["items"].([]interface{}); ok && strings.HasSuffix(kind, "List") {
+		for _, item := range items {
+			if itemObj, ok := item.(map[string]interface{}); ok {
+				redactSecretData(itemObj)
+			}
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestIsMutatingMethod(t *testing.T) {
+	assert.True(t, IsMutatingMethod("/application.ApplicationService/Sync"))
+	assert.True(t, IsMutatingMethod("/cluster.ClusterService/Update"))
+	assert.True(t, IsMutatingMethod("/account.AccountService/CreateToken"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/Get"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/ResourceTree"))
+	assert.False(t, IsMutatingMethod("/account.AccountService/CanI"))
+}
+
+func TestRequestTargetAndSummary(t *testing.T) {
+	t.Run("application", func(t *testing.T) {
+		name, ns, proj := "guestbook", "apps", "default"
+		req := requestToMap(&application.ApplicationSyncRequest{Name: &name, AppNamespace: &ns, Project: &proj})
+		assert.Equal(t, Target{Service: "application.ApplicationService", Name: "guestbook", Namespace: "apps", Project: "default"}, requestTarget("application.ApplicationService", req))
+	})
+	t.Run("application object", func(t *testing.T) {
+		req := requestToMap(&application.ApplicationCreateRequest{Application: &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+		}})
+		assert.Equal(t, Target{Service: "application.ApplicationService", Name: "guestbook", Namespace: "argocd", Project: "default"}, requestTarget("application.ApplicationService", req))
+	})
+	t.Run("cluster credentials are redacted", func(t *testing.T) {
+		req := requestToMap(&cluster.ClusterUpdateRequest{Cluster: &v1alpha1.Cluster{
+			Server: "https://kubernetes.default.svc",
+			Config: v1alpha1.ClusterConfig{BearerToken: "secret-token", TLSClientConfig: v1alpha1.TLSClientConfig{KeyData: []byte("key")}},
+		}})
+		assert.Equal(t, "https://kubernetes.default.svc", requestTarget("cluster.ClusterService", req).Name)
+		summary := summarizeRequest(req)
+		config := summary["cluster"].(map[string]interface{})["config"].(map[string]interface{})
+		assert.Equal(t, RedactedValue, config["bearerToken"])
+		assert.Equal(t, RedactedValue, config["tlsClientConfig"].(map[string]interface{})["keyData"])
+		assert.Equal(t, "https://kubernetes.default.svc", summary["cluster"].(map[string]interface{})["server"])
+	})
+	t.Run("repository credentials are redacted", func(t *testing.T) {
+		req := requestToMap(&repository.RepoCreateRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps", Password: "pass", SSHPrivateKey: "key", Project: "default"}})
+		assert.Equal(t, Target{Service: "repository.RepositoryService", Name: "https://github.com/argoproj/argocd-example-apps", Project: "default"}, requestTarget("repository.RepositoryService", req))
+		repo := summarizeRequest(req)["repo"].(map[string]interface{})
+		assert.Equal(t, RedactedValue, repo["password"])
+		assert.Equal(t, RedactedValue, repo["sshPrivateKey"])
+	})
+	t.Run("data of secrets in manifests is redacted", func(t *testing.T) {
+		name := "guestbook"
+		req := requestToMap(&application.ApplicationSyncRequest{Name: &name, Manifests: []string{
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"data\":{\"password\":\"cGFzcw==\"}}"}},"data":{"password":"cGFzcw=="},"stringData":{"user":"admin"}}`,
+			"apiVersion: v1\nkind: List\nitems:\n- apiVersion: v1\n  kind: Secret\n  metadata:\n    name: other\n  data:\n    key: dmFsdWU=\n",
+			"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  key: value\n",
+			"{not yaml: [",
+		}})
+		manifests := summarizeRequest(req)["manifests"].([]interface{})
+		require.Len(t, manifests, 4)
+		secret := manifests[0].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"password": RedactedValue}, secret["data"])
+		assert.Equal(t, map[string]interface{}{"user": RedactedValue}, secret["stringData"])
+		assert.Equal(t, map[string]interface{}{"kubectl.kubernetes.io/last-applied-configuration": RedactedValue}, secret["metadata"].(map[string]interface{})["annotations"])
+		item := manifests[1].(map[string]interface{})["items"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"key": RedactedValue}, item["data"])
+		assert.Equal(t, map[string]interface{}{"key": "value"}, manifests[2].(map[string]interface{})["data"])
+		assert.Equal(t, RedactedValue, manifests[3])
+	})
+	t.Run("helm values, parameters and environment variables are redacted", func(t *testing.T) {
+		req := requestToMap(&application.ApplicationCreateRequest{Application: &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{Project: "default", Sources: v1alpha1.ApplicationSources{
+				{
+					RepoURL: "https://charts.example.com",
+					Chart:   "guestbook",
+					Helm: &v1alpha1.ApplicationSourceHelm{
+						Values:       "db:\n  password: hunter2\n",
+						ValuesObject: &runtime.RawExtension{Raw: []byte(`{"api":{"key":"abc"}}`)},
+						Parameters:   []v1alpha1.HelmParameter{{Name: "db.password", Value: "hunter2"}},
+					},
+				},
+				{
+					RepoURL: "https://github.com/argoproj/argocd-example-apps",
+					Helm:    &v1alpha1.ApplicationSourceHelm{Values: "hunter2"},
+					Plugin: &v1alpha1.ApplicationSourcePlugin{
+						Env:        v1alpha1.Env{{Name: "DB_PASSWORD", Value: "hunter2"}},
+						Parameters: v1alpha1.ApplicationSourcePluginParameters{{Name: "token", OptionalMap: &v1alpha1.OptionalMap{Map: map[string]string{"key": "hunter2"}}}},
+					},
+				},
+			}},
+		}})
+		summary := summarizeRequest(req)
+		assert.NotContains(t, fmt.Sprintf("%v", summary), "hunter2")
+		assert.NotContains(t, fmt.Sprintf("%v", summary), "abc")
+		sources := summary["application"].(map[string]interface{})["spec"].(map[string]interface{})["sources"].([]interface{})
+		helm := sources[0].(map[string]interface{})["helm"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"db": map[string]interface{}{"password": RedactedValue}}, helm["values"])
+		assert.Equal(t, map[string]interface{}{"api": map[string]interface{}{"key": RedactedValue}}, helm["valuesObject"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "db.password", "value": RedactedValue}}, helm["parameters"])
+		assert.Equal(t, RedactedValue, sources[1].(map[string]interface{})["helm"].(map[string]interface{})["values"])
+		plugin := sources[1].(map[string]interface{})["plugin"].(map[string]interface{})
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "DB_PASSWORD", "value": RedactedValue}}, plugin["env"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "token", "map": map[string]interface{}{"key": RedactedValue}}}, plugin["parameters"])
+		assert.Equal(t, "https://charts.example.com", sources[0].(map[string]interface{})["repoURL"])
+	})
+	t.Run("long values are truncated", func(t *testing.T) {
+		summary := summarizeRequest(map[string]interface{}{"patch": strings.Repeat("a", 1000), "items": []interface{}{"a"}})
+		assert.Equal(t, strings.Repeat("a", maxRequestValueLength)+"...(truncated)", summary["patch"])
+		assert.Equal(t, []interface{}{"a"}, summary["items"])
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &fakeSink{}
+	auditor := NewAuditor([]Sink{sink}, 10, nil)
+	interceptor := UnaryServerInterceptor(auditor, func() []string { return []string{"groups"} })
+
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": "alice", "iss": "argocd", "groups": []string{"admins"}})
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "192.168.0.1", "user-agent", "argocd-client/v2"))
+
+	_, err := interceptor(ctx, &session.SessionCreateRequest{Username: "alice", Password: "pass"}, &grpc.UnaryServerInfo{FullMethod: "/session.SessionService/Create"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	})
+	require.Error(t, err)
+	_, err = interceptor(ctx, &session.GetUserInfoRequest{}, &grpc.UnaryServerInfo{FullMethod: "/session.SessionService/GetUserInfo"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, auditor.workers[0].queue, 1)
+	record := <-auditor.workers[0].queue
+	assert.Equal(t, "alice", record.User)
+	assert.Equal(t, []string{"admins"}, record.Groups)
+	assert.Equal(t, "10.0.0.1", record.SourceIP)
+	assert.Equal(t, "192.168.0.1", record.ForwardedFor)
+	assert.Equal(t, "argocd-client/v2", record.UserAgent)
+	assert.Equal(t, "/session.SessionService/Create", record.Method)
+	assert.Equal(t, Target{Service: "session.SessionService"}, record.Target)
+	assert.Equal(t, Result{Code: "Unauthenticated", Message: "invalid credentials"}, record.Result)
+	assert.Equal(t, map[string]interface{}{"username": "alice", "password": RedactedValue}, record.Request)
+
+	t.Run("auditing disabled", func(t *testing.T) {
+		called := false
+		_, err := UnaryServerInterceptor(nil, nil)(ctx, &session.SessionCreateRequest{}, &grpc.UnaryServerInfo{FullMethod: "/session.SessionService/Create"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+func TestUnaryServerInterceptor_Authentication(t *testing.T) {
+	sink := &fakeSink{}
+	auditor := NewAuditor([]Sink{sink}, 10, nil)
+	interceptor := UnaryServerInterceptor(auditor, func() []string { return []string{"groups"} })
+	authenticated := false
+	authenticate := RecordAuthentication(func(ctx context.Context) (context.Context, error) {
+		if !authenticated {
+			return ctx, status.Error(codes.Unauthenticated, "no session information")
+		}
+		// nolint:staticcheck
+		return context.WithValue(ctx, "claims", jwt.MapClaims{"sub": "alice", "groups": []string{"admins"}}), nil
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		// the authentication runs after the interceptor of the audit log
+		if _, err := authenticate(ctx); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}
+
+	name := "guestbook"
+	_, err := interceptor(context.Background(), &application.ApplicationSyncRequest{Name: &name}, info, handler)
+	require.Error(t, err)
+	authenticated = true
+	_, err = interceptor(context.Background(), &application.ApplicationSyncRequest{Name: &name}, info, handler)
+	require.NoError(t, err)
+
+	require.Len(t, auditor.workers[0].queue, 2)
+	record := <-auditor.workers[0].queue
+	assert.Empty(t, record.User)
+	assert.Equal(t, Result{Code: "Unauthenticated", Message: "no session information"}, record.Result)
+	record = <-auditor.workers[0].queue
+	assert.Equal(t, "alice", record.User)
+	assert.Equal(t, []string{"admins"}, record.Groups)
+	assert.Equal(t, Result{Code: "OK"}, record.Result)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	name := "guestbook"
+	m.(*application.ApplicationSyncRequest).Name = &name
+	return nil
+}
+
+func TestStreamServerInterceptor_Authentication(t *testing.T) {
+	sink := &fakeSink{}
+	auditor := NewAuditor([]Sink{sink}, 10, nil)
+	interceptor := StreamServerInterceptor(auditor, func() []string { return []string{"groups"} })
+	authenticate := RecordAuthentication(func(ctx context.Context) (context.Context, error) {
+		// nolint:staticcheck
+		return context.WithValue(ctx, "claims", jwt.MapClaims{"sub": "alice"}), nil
+	})
+
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Sync"}, func(srv interface{}, stream grpc.ServerStream) error {
+		if _, err := authenticate(stream.Context()); err != nil {
+			return err
+		}
+		return stream.RecvMsg(&application.ApplicationSyncRequest{})
+	})
+	require.NoError(t, err)
+
+	require.Len(t, auditor.workers[0].queue, 1)
+	record := <-auditor.workers[0].queue
+	assert.Equal(t, "alice", record.User)
+	assert.Equal(t, "guestbook", record.Target.Name)
+}
+
+func TestNewHTTPRecord(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/terminal", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "192.168.0.1")
+	r.Header.Set("User-Agent", "Mozilla/5.0")
+	// nolint:staticcheck
+	r = r.WithContext(context.WithValue(r.Context(), "claims", jwt.MapClaims{"sub": "alice", "groups": []string{"admins"}}))
+
+	record := NewHTTPRecord(r, "/terminal", func() []string { return []string{"groups"} })
+	assert.Equal(t, "alice", record.User)
+	assert.Equal(t, []string{"admins"}, record.Groups)
+	assert.Equal(t, "10.0.0.1", record.SourceIP)
+	assert.Equal(t, "192.168.0.1", record.ForwardedFor)
+	assert.Equal(t, "Mozilla/5.0", record.UserAgent)
+	assert.Equal(t, "/terminal", record.Method)
+	assert.Equal(t, Result{Code: "OK"}, record.Result)
+	assert.False(t, record.Time.IsZero())
+}
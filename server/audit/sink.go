@@ -0,0 +1,161 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// webhookTimeout is the timeout of requests to the webhook sink
+	webhookTimeout = 10 * time.Second
+	// webhookMaxAttempts is the number of times a batch of records is posted to the webhook before it is given up
+	webhookMaxAttempts = 3
+	// webhookRetryInterval is the time to wait before the first retry of a failed request, which doubles with every
+	// further retry
+	webhookRetryInterval = time.Second
+)
+
+// Sink writes audit records to a destination
+type Sink interface {
+	// Name returns the name of the sink, which is used in logs and metrics
+	Name() string
+	// Write writes the records to the destination
+	Write(ctx context.Context, records []Record) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// NewSinks returns the sinks of the given config
+func NewSinks(config Config) ([]Sink, error) {
+	var sinks []Sink
+	if config.File != "" {
+		sink, err := NewFileSink(config.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.SyslogAddress != "" {
+		sink, err := NewSyslogSink(config.SyslogAddress)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.WebhookURL != "" {
+		sinks = append(sinks, NewWebhookSink(config.WebhookURL, config.WebhookHeaders))
+	}
+	return sinks, nil
+}
+
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+// NewFileSink returns a sink which appends records as JSON lines to the file at the given path
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file %s: %w", path, err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Name() string {
+	return "file"
+}
+
+func (s *fileSink) Write(_ context.Context, records []Record) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.file.Write(buf.Bytes())
+	return err
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+type webhookSink struct {
+	url           string
+	headers       map[string]string
+	client        *http.Client
+	retryInterval time.Duration
+}
+
+// NewWebhookSink returns a sink which posts records as JSON arrays to the given URL. Requests which fail because the
+// webhook is unavailable are retried a few times with exponential backoff.
+func NewWebhookSink(url string, headers map[string]string) Sink {
+	return &webhookSink{url: url, headers: headers, client: &http.Client{Timeout: webhookTimeout}, retryInterval: webhookRetryInterval}
+}
+
+func (s *webhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Write(ctx context.Context, records []Record) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	interval := s.retryInterval
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(ctx, data)
+		if err == nil || !retryable || attempt == webhookMaxAttempts {
+			return err
+		}
+		log.Debugf("Retrying to write %d records to audit sink %s in %v: %v", len(records), s.Name(), interval, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// post posts the data to the webhook. It returns whether the request may succeed if it is retried along with the
+// error of failed requests.
+func (s *webhookSink) post(ctx context.Context, data []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retryable, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return false, nil
+}
+
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
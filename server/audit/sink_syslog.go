@@ -0,0 +1,56 @@
+//go:build !windows
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/url"
+)
+
+const syslogTag = "argocd-server"
+
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink returns a sink which sends records as JSON messages to the syslog server at the given address, e.g.
+// udp://syslog:514, or to the local syslog daemon if the address is "local"
+func NewSyslogSink(address string) (Sink, error) {
+	network, raddr := "", ""
+	if address != "local" {
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid syslog address %s: must be of the form <network>://<host>:<port> or local", address)
+		}
+		network, raddr = u.Scheme, u.Host
+	}
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_AUTH, syslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog at %s: %w", address, err)
+	}
+	return &syslogSink{writer: writer}, nil
+}
+
+func (s *syslogSink) Name() string {
+	return "syslog"
+}
+
+func (s *syslogSink) Write(_ context.Context, records []Record) error {
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		if err := s.writer.Info(string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,12 @@
+//go:build windows
+
+package audit
+
+import (
+	"fmt"
+)
+
+// NewSyslogSink is not supported on Windows
+func NewSyslogSink(address string) (Sink, error) {
+	return nil, fmt.Errorf("syslog audit sink is not supported on windows")
+}
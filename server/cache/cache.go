@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
@@ -87,6 +88,24 @@ func (c *Cache) SetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	return c.cache.SetClusterInfo(server, res)
 }
 
+// bulkOperationExpiration is how long the progress of bulk operations is kept after their last update
+const bulkOperationExpiration = 24 * time.Hour
+
+func bulkOperationKey(owner string, id string) string {
+	return fmt.Sprintf("bulk-operation|%s|%s", id, owner)
+}
+
+// SetBulkOperation saves the progress of a bulk operation. It can only be retrieved by the given owner.
+func (c *Cache) SetBulkOperation(owner string, op *application.ApplicationBulkOperation) error {
+	return c.cache.SetItem(bulkOperationKey(owner, op.GetId()), op, bulkOperationExpiration, false)
+}
+
+func (c *Cache) GetBulkOperation(owner string, id string) (*application.ApplicationBulkOperation, error) {
+	res := &application.ApplicationBulkOperation{}
+	err := c.cache.GetItem(bulkOperationKey(owner, id), res)
+	return res, err
+}
+
 func (c *Cache) GetCache() *cacheutil.Cache {
 	return c.cache.Cache
 }
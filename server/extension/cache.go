@@ -0,0 +1,117 @@
+package extension
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/felixge/httpsnoop"
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// cachedResponse is a response of an extension backend kept in the
+// response cache.
+type cachedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// responseCache caches the responses of GET requests sent to an extension.
+type responseCache struct {
+	cache             *gocache.Cache
+	maxResponseSize   int
+	sharedAcrossUsers bool
+}
+
+func newResponseCache(config CacheConfig) *responseCache {
+	if config.MaxResponseSize == 0 {
+		config.MaxResponseSize = DefaultCacheMaxResponseSize
+	}
+	return &responseCache{
+		cache:             gocache.New(config.TTL, 2*config.TTL),
+		maxResponseSize:   config.MaxResponseSize,
+		sharedAcrossUsers: config.SharedAcrossUsers,
+	}
+}
+
+// key returns the key of the response to the given request. Responses are
+// cached per application, user and set of user groups, unless they are
+// shared across users, in which case they are cached per application and
+// set of user groups only.
+func (c *responseCache) key(r *http.Request, app *RequestResources, subject string, groups []string) string {
+	if c.sharedAcrossUsers {
+		subject = ""
+	}
+	sorted := append([]string{}, groups...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("%s/%s|%q|%s|%s", app.ApplicationNamespace, app.ApplicationName, subject, strings.Join(sorted, ","), r.URL.RequestURI())
+}
+
+func (c *responseCache) get(key string) (*cachedResponse, bool) {
+	res, found := c.cache.Get(key)
+	if !found {
+		return nil, false
+	}
+	return res.(*cachedResponse), true
+}
+
+// write writes the cached response to w.
+func (res *cachedResponse) write(w http.ResponseWriter) {
+	for k, v := range res.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(res.status)
+	_, _ = w.Write(res.body)
+}
+
+// recordingWriter wraps w so that the response written to it is recorded
+// until it exceeds the given size. The returned function stores the
+// recorded response in the cache if it can be cached.
+func (c *responseCache) recordingWriter(w http.ResponseWriter, key string) (http.ResponseWriter, func()) {
+	res := &cachedResponse{status: http.StatusOK}
+	var body bytes.Buffer
+	tooLarge := false
+	wrapped := httpsnoop.Wrap(w, httpsnoop.Hooks{
+		WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+			return func(code int) {
+				res.status = code
+				next(code)
+			}
+		},
+		Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+			return func(b []byte) (int, error) {
+				if !tooLarge {
+					if body.Len()+len(b) > c.maxResponseSize {
+						tooLarge = true
+						body.Reset()
+					} else {
+						body.Write(b)
+					}
+				}
+				return next(b)
+			}
+		},
+	})
+	store := func() {
+		if tooLarge || res.status != http.StatusOK || !isCacheable(w.Header()) {
+			return
+		}
+		res.header = w.Header().Clone()
+		res.body = body.Bytes()
+		c.cache.SetDefault(key, res)
+	}
+	return wrapped, store
+}
+
+// isCacheable returns whether a response with the given headers may be
+// shared with other users.
+func isCacheable(header http.Header) bool {
+	if header.Get("Set-Cookie") != "" {
+		return false
+	}
+	cacheControl := strings.ToLower(header.Get("Cache-Control"))
+	return !strings.Contains(cacheControl, "no-store") && !strings.Contains(cacheControl, "private")
+}
@@ -0,0 +1,90 @@
+package extension
+
+import (
+	"sync"
+	"time"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker tracks the consecutive failures of a backend service. Once
+// the failure threshold is reached the circuit opens and requests are
+// rejected until the open duration has passed. Afterwards the circuit is
+// half-open: a single request is let through to probe the backend, which
+// closes the circuit if it succeeds and opens it again otherwise.
+type circuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	openDuration     time.Duration
+	state            circuitState
+	failures         int
+	openedAt         time.Time
+	now              func() time.Time
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig) *circuitBreaker {
+	if config.FailureThreshold == 0 {
+		config.FailureThreshold = DefaultFailureThreshold
+	}
+	if config.OpenDuration == 0 {
+		config.OpenDuration = DefaultCircuitOpenDuration
+	}
+	return &circuitBreaker{
+		failureThreshold: config.FailureThreshold,
+		openDuration:     config.OpenDuration,
+		now:              time.Now,
+	}
+}
+
+// allow returns whether a request may be forwarded to the backend service.
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.openDuration {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// the probing request is still in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// done records the result of a request which was forwarded to the
+// backend service.
+func (cb *circuitBreaker) done(failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !failed {
+		cb.state = circuitClosed
+		cb.failures = 0
+		return
+	}
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.failureThreshold {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+}
+
+// abandon records that a request which was forwarded to the backend
+// service has been canceled by the client. It neither counts as a failure
+// nor as a success, but another request may probe a half-open circuit.
+func (cb *circuitBreaker) abandon() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+	}
+}
@@ -0,0 +1,52 @@
+package extension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	newBreaker := func() (*circuitBreaker, *time.Time) {
+		cb := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Minute})
+		now := time.Now()
+		cb.now = func() time.Time {
+			return now
+		}
+		return cb, &now
+	}
+	t.Run("will open the circuit after consecutive failures", func(t *testing.T) {
+		cb, now := newBreaker()
+		cb.done(true)
+		cb.done(false)
+		cb.done(true)
+		assert.True(t, cb.allow())
+		cb.done(true)
+		assert.False(t, cb.allow())
+
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.allow())
+		assert.False(t, cb.allow())
+		cb.done(false)
+		assert.True(t, cb.allow())
+	})
+	t.Run("will not count canceled requests as failures", func(t *testing.T) {
+		cb, _ := newBreaker()
+		cb.done(true)
+		cb.abandon()
+		cb.abandon()
+		assert.True(t, cb.allow())
+		assert.Equal(t, 1, cb.failures)
+	})
+	t.Run("will probe again if the probing request is canceled", func(t *testing.T) {
+		cb, now := newBreaker()
+		cb.done(true)
+		cb.done(true)
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.allow())
+		cb.abandon()
+		assert.True(t, cb.allow())
+		assert.False(t, cb.allow())
+	})
+}
@@ -4,20 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/felixge/httpsnoop"
+	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 	"gopkg.in/yaml.v3"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/audit"
+	"github.com/argoproj/argo-cd/v2/server/ratelimit"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
@@ -32,6 +38,9 @@ const (
 	DefaultKeepAlive             = 15 * time.Second
 	DefaultIdleConnectionTimeout = 60 * time.Second
 	DefaultMaxIdleConnections    = 30
+	DefaultCacheMaxResponseSize  = 1024 * 1024
+	DefaultFailureThreshold      = 5
+	DefaultCircuitOpenDuration   = 30 * time.Second
 
 	// HeaderArgoCDNamespace defines the namespace of the
 	// argo control plane to be passed to the extension handler.
@@ -150,6 +159,72 @@ type ExtensionConfig struct {
 	// the extension route. Mandatory field.
 	Name    string        `yaml:"name"`
 	Backend BackendConfig `yaml:"backend"`
+
+	// Audit if true, will record every call proxied to the extension
+	// backend in the audit log of the API server with the user, the
+	// application, the path and the response status.
+	Audit bool `yaml:"audit,omitempty"`
+
+	// RateLimit if provided, will limit the number of requests each
+	// user can send to the extension.
+	RateLimit *RateLimitConfig `yaml:"rateLimit,omitempty"`
+
+	// Cache if provided, will cache the responses of GET requests.
+	Cache *CacheConfig `yaml:"cache,omitempty"`
+
+	// CircuitBreaker if provided, will stop forwarding requests to a
+	// backend service which keeps failing for some time.
+	CircuitBreaker *CircuitBreakerConfig `yaml:"circuitBreaker,omitempty"`
+}
+
+// RateLimitConfig defines the rate limit of requests sent by each user
+// to an extension. Requests exceeding the limit are rejected with status
+// 429 (Too Many Requests).
+type RateLimitConfig struct {
+	// RequestsPerSecond is the number of requests per second each user
+	// is allowed to send. Mandatory field.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+
+	// Burst is the number of requests each user is allowed to send at
+	// once.
+	// Default: RequestsPerSecond rounded up
+	Burst int `yaml:"burst"`
+}
+
+// CacheConfig defines how responses of an extension backend are cached.
+// Only successful responses of GET requests are cached. Responses are
+// cached per application, user and set of user groups, unless they are
+// shared across users.
+type CacheConfig struct {
+	// TTL is the amount of time responses are cached for. Mandatory field.
+	TTL time.Duration `yaml:"ttl"`
+
+	// MaxResponseSize is the maximum size in bytes of the body of
+	// responses to be cached. Larger responses are not cached.
+	// Default: 1MiB
+	MaxResponseSize int `yaml:"maxResponseSize"`
+
+	// SharedAcrossUsers if true, will serve cached responses to all users
+	// with the same groups, so the extension backend must not return
+	// different responses to users with the same groups.
+	// Default: false
+	SharedAcrossUsers bool `yaml:"sharedAcrossUsers"`
+}
+
+// CircuitBreakerConfig defines when requests to a failing backend service
+// are rejected with status 503 (Service Unavailable) instead of being
+// forwarded. Responses with a 5xx status count as failures.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures after which
+	// the circuit opens and requests are rejected.
+	// Default: 5
+	FailureThreshold int `yaml:"failureThreshold"`
+
+	// OpenDuration is the amount of time requests are rejected for once
+	// the circuit opens. Afterwards a single request is forwarded to the
+	// backend service, which closes the circuit again if it succeeds.
+	// Default: 30 seconds
+	OpenDuration time.Duration `yaml:"openDuration"`
 }
 
 // BackendConfig defines the backend service configurations that will
@@ -345,8 +420,21 @@ type Manager struct {
 	project     ProjectGetter
 	rbac        RbacEnforcer
 	registry    ExtensionRegistry
+	controls    map[string]*extensionControls
 	metricsReg  ExtensionMetricsRegistry
 	userGetter  UserGetter
+	auditor     *audit.Auditor
+	getScopes   func() []string
+	redisClient *redis.Client
+}
+
+// extensionControls holds the state used to audit, rate limit, cache and
+// circuit break the requests of one extension.
+type extensionControls struct {
+	audit    bool
+	limiter  *ratelimit.Limiter
+	cache    *responseCache
+	breakers map[*httputil.ReverseProxy]*circuitBreaker
 }
 
 // ExtensionMetricsRegistry exposes operations to update http metrics in the Argo CD
@@ -359,6 +447,16 @@ type ExtensionMetricsRegistry interface {
 	// between Argo CD API Server and the extension backend service for the given
 	// extension.
 	ObserveExtensionRequestDuration(extension string, duration time.Duration)
+	// IncExtensionRateLimitedRequests will increase the counter of requests
+	// to the given extension rejected because of its rate limit.
+	IncExtensionRateLimitedRequests(extension string)
+	// IncExtensionCacheRequests will increase the counter of GET requests to
+	// the given extension with the given cache result (hit or miss).
+	IncExtensionCacheRequests(extension string, result string)
+	// IncExtensionCircuitBreakerRejectedRequests will increase the counter of
+	// requests to the given extension rejected because the circuit of the
+	// backend service is open.
+	IncExtensionCircuitBreakerRejectedRequests(extension string)
 }
 
 // NewManager will initialize a new manager.
@@ -452,6 +550,30 @@ func validateConfigs(configs *ExtensionConfigs) error {
 			return fmt.Errorf("duplicated extension found in the configs for %q", ext.Name)
 		}
 		exts[ext.Name] = struct{}{}
+		if ext.RateLimit != nil {
+			if ext.RateLimit.RequestsPerSecond <= 0 {
+				return fmt.Errorf("extensions.rateLimit.requestsPerSecond must be a positive number for extension %s", ext.Name)
+			}
+			if ext.RateLimit.Burst < 0 {
+				return fmt.Errorf("extensions.rateLimit.burst must not be negative for extension %s", ext.Name)
+			}
+		}
+		if ext.Cache != nil {
+			if ext.Cache.TTL <= 0 {
+				return fmt.Errorf("extensions.cache.ttl must be a positive duration for extension %s", ext.Name)
+			}
+			if ext.Cache.MaxResponseSize < 0 {
+				return fmt.Errorf("extensions.cache.maxResponseSize must not be negative for extension %s", ext.Name)
+			}
+		}
+		if ext.CircuitBreaker != nil {
+			if ext.CircuitBreaker.FailureThreshold < 0 {
+				return fmt.Errorf("extensions.circuitBreaker.failureThreshold must not be negative for extension %s", ext.Name)
+			}
+			if ext.CircuitBreaker.OpenDuration < 0 {
+				return fmt.Errorf("extensions.circuitBreaker.openDuration must not be negative for extension %s", ext.Name)
+			}
+		}
 		svcTotal := len(ext.Backend.Services)
 		if svcTotal == 0 {
 			return fmt.Errorf("no backend service configured for extension %s", ext.Name)
@@ -567,25 +689,59 @@ func (m *Manager) UpdateExtensionRegistry(s *settings.ArgoCDSettings) error {
 		return fmt.Errorf("error parsing extension config: %w", err)
 	}
 	extReg := make(map[string]ProxyRegistry)
+	extControls := make(map[string]*extensionControls)
 	for _, ext := range extConfigs.Extensions {
 		proxyReg := NewProxyRegistry()
+		controls := m.newExtensionControls(ext)
 		singleBackend := len(ext.Backend.Services) == 1
 		for _, service := range ext.Backend.Services {
 			proxy, err := NewProxy(service.URL, service.Headers, ext.Backend.ProxyConfig)
 			if err != nil {
 				return fmt.Errorf("error creating proxy: %w", err)
 			}
+			if ext.CircuitBreaker != nil {
+				controls.breakers[proxy] = newCircuitBreaker(*ext.CircuitBreaker)
+			}
 			err = appendProxy(proxyReg, ext.Name, service, proxy, singleBackend)
 			if err != nil {
 				return fmt.Errorf("error appending proxy: %w", err)
 			}
 		}
 		extReg[ext.Name] = proxyReg
+		extControls[ext.Name] = controls
 	}
 	m.registry = extReg
+	m.controls = extControls
 	return nil
 }
 
+// newExtensionControls will build the state required to enforce the audit,
+// rate limit, cache and circuit breaker configurations of the given extension.
+func (m *Manager) newExtensionControls(ext ExtensionConfig) *extensionControls {
+	controls := &extensionControls{
+		audit:    ext.Audit,
+		breakers: make(map[*httputil.ReverseProxy]*circuitBreaker),
+	}
+	if ext.RateLimit != nil {
+		limit := ratelimit.Limit{Rate: ext.RateLimit.RequestsPerSecond, Burst: ext.RateLimit.Burst}
+		if limit.Burst == 0 {
+			limit.Burst = int(math.Ceil(limit.Rate))
+		}
+		limits := map[string]ratelimit.Limit{rateLimitGroup(ext.Name): limit}
+		controls.limiter = ratelimit.NewLimiter(limits, m.redisClient, nil)
+	}
+	if ext.Cache != nil {
+		controls.cache = newResponseCache(*ext.Cache)
+	}
+	return controls
+}
+
+// rateLimitGroup returns the group of the rate limit of the given extension.
+// The prefix avoids sharing buckets with the API rate limits.
+func rateLimitGroup(extName string) string {
+	return fmt.Sprintf("extension:%s", extName)
+}
+
 // appendProxy will append the given proxy in the given registry. Will use
 // the provided extName and service to determine the map key. The key must
 // be unique in the map. If the map already has the key and error is returned.
@@ -746,20 +902,131 @@ func (m *Manager) CallExtension() func(http.ResponseWriter, *http.Request) {
 			return
 		}
 
+		controls, ok := m.controls[extName]
+		if !ok {
+			controls = &extensionControls{}
+		}
+		var record *audit.Record
+		if controls.audit && m.auditor != nil {
+			rec := audit.NewHTTPRecord(r, fmt.Sprintf("%s/%s", URLPrefix, extName), m.getScopes)
+			record = &rec
+		}
+
 		user := m.userGetter.GetUser(r.Context())
 		groups := m.userGetter.GetGroups(r.Context())
 		prepareRequest(r, m.namespace, extName, app, user, groups)
 		m.log.Debugf("proxing request for extension %q", extName)
-		// httpsnoop package is used to properly wrap the responseWriter
-		// and avoid optional intefaces issue:
-		// https://github.com/felixge/httpsnoop#why-this-package-exists
-		// CaptureMetrics will call the proxy and return the metrics from it.
-		metrics := httpsnoop.CaptureMetrics(proxy, w, r)
+		status := m.proxyRequest(w, r, extName, controls, proxy, reqResources, user, groups)
+
+		if record != nil {
+			record.Target = audit.Target{Service: "extensions", Name: reqResources.ApplicationName, Namespace: reqResources.ApplicationNamespace, Project: reqResources.ProjectName}
+			record.Request = map[string]interface{}{
+				"extension": extName,
+				"method":    r.Method,
+				"path":      r.URL.Path,
+				"status":    status,
+			}
+			record.Result = auditResult(status)
+			record.DurationMs = time.Since(record.Time).Milliseconds()
+			m.auditor.Record(*record)
+		}
+	}
+}
+
+// proxyRequest will forward the request to the given proxy unless it is
+// served from the response cache or rejected by the rate limit or the
+// circuit breaker of the extension. It returns the status of the response.
+func (m *Manager) proxyRequest(w http.ResponseWriter, r *http.Request, extName string, controls *extensionControls, proxy *httputil.ReverseProxy, rr *RequestResources, user string, groups []string) int {
+	subject := session.Sub(r.Context())
+	if subject == "" {
+		subject = user
+	}
+	useCache := controls.cache != nil && r.Method == http.MethodGet
+	var key string
+	if useCache {
+		key = controls.cache.key(r, rr, subject, groups)
+		if res, found := controls.cache.get(key); found {
+			m.incCacheRequests(extName, "hit")
+			res.write(w)
+			return res.status
+		}
+		m.incCacheRequests(extName, "miss")
+	}
+
+	if controls.limiter != nil {
+		allowed, retryAfter := controls.limiter.Allow(r.Context(), rateLimitGroup(extName), URLPrefix, subject, "")
+		if !allowed {
+			if m.metricsReg != nil {
+				m.metricsReg.IncExtensionRateLimitedRequests(extName)
+			}
+			w.Header().Set(ratelimit.RetryAfterHeader, strconv.Itoa(int(math.Max(1, math.Ceil(retryAfter.Seconds())))))
+			http.Error(w, "Extension rate limit exceeded", http.StatusTooManyRequests)
+			return http.StatusTooManyRequests
+		}
+	}
 
-		go registerMetrics(extName, metrics, m.metricsReg)
+	breaker := controls.breakers[proxy]
+	if breaker != nil && !breaker.allow() {
+		if m.metricsReg != nil {
+			m.metricsReg.IncExtensionCircuitBreakerRejectedRequests(extName)
+		}
+		http.Error(w, "Extension backend unavailable", http.StatusServiceUnavailable)
+		return http.StatusServiceUnavailable
+	}
+
+	writer := w
+	storeResponse := func() {}
+	if useCache {
+		writer, storeResponse = controls.cache.recordingWriter(w, key)
+	}
+	// httpsnoop package is used to properly wrap the responseWriter
+	// and avoid optional intefaces issue:
+	// https://github.com/felixge/httpsnoop#why-this-package-exists
+	// CaptureMetrics will call the proxy and return the metrics from it.
+	metrics := httpsnoop.CaptureMetrics(proxy, writer, r)
+	if breaker != nil {
+		if errors.Is(r.Context().Err(), context.Canceled) {
+			// the client went away, which says nothing about the backend
+			breaker.abandon()
+		} else {
+			breaker.done(metrics.Code >= http.StatusInternalServerError)
+		}
+	}
+	storeResponse()
+
+	go registerMetrics(extName, metrics, m.metricsReg)
+	return metrics.Code
+}
+
+func (m *Manager) incCacheRequests(extName string, result string) {
+	if m.metricsReg != nil {
+		m.metricsReg.IncExtensionCacheRequests(extName, result)
 	}
 }
 
+// auditResult will convert the status of a proxied request into the
+// result of its audit record.
+func auditResult(status int) audit.Result {
+	code := codes.Unknown
+	switch {
+	case status < http.StatusBadRequest:
+		return audit.Result{Code: codes.OK.String()}
+	case status == http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case status == http.StatusForbidden:
+		code = codes.PermissionDenied
+	case status == http.StatusNotFound:
+		code = codes.NotFound
+	case status == http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	case status == http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case status >= http.StatusInternalServerError:
+		code = codes.Internal
+	}
+	return audit.Result{Code: code.String(), Message: http.StatusText(status)}
+}
+
 func registerMetrics(extName string, metrics httpsnoop.Metrics, extensionMetricsRegistry ExtensionMetricsRegistry) {
 	if extensionMetricsRegistry != nil {
 		extensionMetricsRegistry.IncExtensionRequestCounter(extName, metrics.Code)
@@ -796,3 +1063,18 @@ func prepareRequest(r *http.Request, namespace string, extName string, app *v1al
 func (m *Manager) AddMetricsRegistry(metricsReg ExtensionMetricsRegistry) {
 	m.metricsReg = metricsReg
 }
+
+// AddAuditor will associate the given auditor in the Manager. Requests to
+// extensions configured with audit enabled are recorded by it. The getScopes
+// func returns the OIDC scopes the groups of the users are read from.
+func (m *Manager) AddAuditor(auditor *audit.Auditor, getScopes func() []string) {
+	m.auditor = auditor
+	m.getScopes = getScopes
+}
+
+// AddRedisClient will associate the given Redis client in the Manager. The
+// rate limits of extensions are shared by all API server replicas through
+// Redis. Without a client, every replica enforces them independently.
+func (m *Manager) AddRedisClient(client *redis.Client) {
+	m.redisClient = client
+}
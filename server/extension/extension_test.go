@@ -9,7 +9,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +20,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/audit"
 	"github.com/argoproj/argo-cd/v2/server/extension"
 	"github.com/argoproj/argo-cd/v2/server/extension/mocks"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
@@ -213,6 +216,18 @@ func TestRegisterExtensions(t *testing.T) {
 				name:       "no header value",
 				configYaml: getExtensionConfigNoHeaderValue(),
 			},
+			{
+				name:       "invalid rate limit",
+				configYaml: getExtensionConfigWithControls("some-extension", "https://httpbin.org", "rateLimit:\n    requestsPerSecond: 0"),
+			},
+			{
+				name:       "no cache ttl",
+				configYaml: getExtensionConfigWithControls("some-extension", "https://httpbin.org", "cache:\n    maxResponseSize: 1024"),
+			},
+			{
+				name:       "negative failure threshold",
+				configYaml: getExtensionConfigWithControls("some-extension", "https://httpbin.org", "circuitBreaker:\n    failureThreshold: -1"),
+			},
 		}
 
 		// when
@@ -734,6 +749,248 @@ func TestCallExtension(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
+	withBackendCalls := func(t *testing.T, f *fixture, extName, controls string, status int) *int32 {
+		t.Helper()
+		var calls int32
+		backendSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&calls, 1)
+			w.WriteHeader(status)
+			fmt.Fprintf(w, "call %d", n)
+		}))
+		t.Cleanup(backendSrv.Close)
+		withExtensionConfig(getExtensionConfigWithControls(extName, backendSrv.URL, controls), f)
+		f.appGetterMock.On("Get", mock.Anything, mock.Anything).Return(getApp("", "clusterURL", defaultProjectName), nil)
+		withProject(getProjectWithDestinations(defaultProjectName, nil, []string{"clusterURL"}), f)
+		return &calls
+	}
+	callExtension := func(t *testing.T, ts *httptest.Server, extName string) (int, string, http.Header) {
+		t.Helper()
+		r := newExtensionRequest(t, http.MethodGet, fmt.Sprintf("%s/extensions/%s/some/path", ts.URL, extName))
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, strings.TrimSuffix(string(body), "\n"), resp.Header
+	}
+	t.Run("will reject requests exceeding the rate limit of the extension", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "limited"
+		withRbac(f, true, true)
+		withMetrics(f)
+		withUser(f, "some-user", []string{"group1"})
+		f.metricsMock.On("IncExtensionRateLimitedRequests", extName)
+		calls := withBackendCalls(t, f, extName, "rateLimit:\n    requestsPerSecond: 0.01\n    burst: 1", http.StatusOK)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		status1, _, _ := callExtension(t, ts, extName)
+		status2, _, header2 := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, http.StatusOK, status1)
+		assert.Equal(t, http.StatusTooManyRequests, status2)
+		assert.NotEmpty(t, header2.Get("Retry-After"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		f.metricsMock.AssertCalled(t, "IncExtensionRateLimitedRequests", extName)
+	})
+	t.Run("will serve GET requests from the response cache", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "cached"
+		withRbac(f, true, true)
+		withMetrics(f)
+		withUser(f, "some-user", []string{"group1"})
+		f.metricsMock.On("IncExtensionCacheRequests", extName, mock.Anything)
+		calls := withBackendCalls(t, f, extName, "cache:\n    ttl: 1m", http.StatusOK)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		status1, body1, _ := callExtension(t, ts, extName)
+		status2, body2, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, http.StatusOK, status1)
+		assert.Equal(t, http.StatusOK, status2)
+		assert.Equal(t, "call 1", body1)
+		assert.Equal(t, "call 1", body2)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		f.metricsMock.AssertCalled(t, "IncExtensionCacheRequests", extName, "miss")
+		f.metricsMock.AssertCalled(t, "IncExtensionCacheRequests", extName, "hit")
+	})
+	t.Run("will not cache responses for users with different groups", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "cached"
+		withRbac(f, true, true)
+		withMetrics(f)
+		f.userMock.On("GetUser", mock.Anything).Return("some-user")
+		f.userMock.On("GetGroups", mock.Anything).Return([]string{"group1"}).Once()
+		f.userMock.On("GetGroups", mock.Anything).Return([]string{"group2"}).Once()
+		f.metricsMock.On("IncExtensionCacheRequests", extName, mock.Anything)
+		calls := withBackendCalls(t, f, extName, "cache:\n    ttl: 1m", http.StatusOK)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		_, body1, _ := callExtension(t, ts, extName)
+		_, body2, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, "call 1", body1)
+		assert.Equal(t, "call 2", body2)
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+	})
+	t.Run("will not share cached responses between users with the same groups", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "cached"
+		withRbac(f, true, true)
+		withMetrics(f)
+		f.userMock.On("GetUser", mock.Anything).Return("some-user").Once()
+		f.userMock.On("GetUser", mock.Anything).Return("another-user").Once()
+		f.userMock.On("GetGroups", mock.Anything).Return([]string{"group1"})
+		f.metricsMock.On("IncExtensionCacheRequests", extName, mock.Anything)
+		calls := withBackendCalls(t, f, extName, "cache:\n    ttl: 1m", http.StatusOK)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		_, body1, _ := callExtension(t, ts, extName)
+		_, body2, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, "call 1", body1)
+		assert.Equal(t, "call 2", body2)
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+	})
+	t.Run("will share cached responses between users with the same groups if configured", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "cached"
+		withRbac(f, true, true)
+		withMetrics(f)
+		f.userMock.On("GetUser", mock.Anything).Return("some-user").Once()
+		f.userMock.On("GetUser", mock.Anything).Return("another-user").Once()
+		f.userMock.On("GetGroups", mock.Anything).Return([]string{"group1"})
+		f.metricsMock.On("IncExtensionCacheRequests", extName, mock.Anything)
+		calls := withBackendCalls(t, f, extName, "cache:\n    ttl: 1m\n    sharedAcrossUsers: true", http.StatusOK)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		_, body1, _ := callExtension(t, ts, extName)
+		_, body2, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, "call 1", body1)
+		assert.Equal(t, "call 1", body2)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	})
+	t.Run("will open the circuit of a failing backend", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "failing"
+		withRbac(f, true, true)
+		withMetrics(f)
+		withUser(f, "some-user", []string{"group1"})
+		f.metricsMock.On("IncExtensionCircuitBreakerRejectedRequests", extName)
+		calls := withBackendCalls(t, f, extName, "circuitBreaker:\n    failureThreshold: 2\n    openDuration: 100ms", http.StatusInternalServerError)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		status1, _, _ := callExtension(t, ts, extName)
+		status2, _, _ := callExtension(t, ts, extName)
+		status3, _, _ := callExtension(t, ts, extName)
+		time.Sleep(200 * time.Millisecond)
+		status4, _, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, status1)
+		assert.Equal(t, http.StatusInternalServerError, status2)
+		assert.Equal(t, http.StatusServiceUnavailable, status3)
+		assert.Equal(t, http.StatusInternalServerError, status4)
+		assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+		f.metricsMock.AssertCalled(t, "IncExtensionCircuitBreakerRejectedRequests", extName)
+	})
+	t.Run("will record proxied requests in the audit log", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "audited"
+		withRbac(f, true, true)
+		withMetrics(f)
+		withUser(f, "some-user", []string{"group1"})
+		withBackendCalls(t, f, extName, "audit: true", http.StatusOK)
+		sink := &recordingSink{records: make(chan audit.Record, 1)}
+		auditor := audit.NewAuditor([]audit.Sink{sink}, 10, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		auditor.Start(ctx)
+		f.manager.AddAuditor(auditor, nil)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		status, _, _ := callExtension(t, ts, extName)
+
+		// then
+		assert.Equal(t, http.StatusOK, status)
+		select {
+		case record := <-sink.records:
+			assert.Equal(t, "/extensions/audited", record.Method)
+			assert.Equal(t, audit.Target{Service: "extensions", Name: "app-name", Namespace: "namespace", Project: defaultProjectName}, record.Target)
+			assert.Equal(t, "OK", record.Result.Code)
+			assert.Equal(t, "/some/path", record.Request["path"])
+			assert.Equal(t, http.StatusOK, record.Request["status"])
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the audit record")
+		}
+	})
+}
+
+// recordingSink is an audit sink which sends the written records to a channel
+type recordingSink struct {
+	records chan audit.Record
+}
+
+func (s *recordingSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingSink) Write(_ context.Context, records []audit.Record) error {
+	for _, record := range records {
+		s.records <- record
+	}
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	return nil
+}
+
+// getExtensionConfigWithControls returns the config of an extension with the
+// given audit, rate limit, cache or circuit breaker settings.
+func getExtensionConfigWithControls(name, url, controls string) string {
+	cfg := `
+extensions:
+- name: %s
+  %s
+  backend:
+    services:
+    - url: %s
+`
+	return fmt.Sprintf(cfg, name, controls, url)
 }
 
 func getExtensionConfig(name, url string) string {
@@ -13,6 +13,21 @@ type ExtensionMetricsRegistry struct {
 	mock.Mock
 }
 
+// IncExtensionCacheRequests provides a mock function with given fields: _a0, result
+func (_m *ExtensionMetricsRegistry) IncExtensionCacheRequests(_a0 string, result string) {
+	_m.Called(_a0, result)
+}
+
+// IncExtensionCircuitBreakerRejectedRequests provides a mock function with given fields: _a0
+func (_m *ExtensionMetricsRegistry) IncExtensionCircuitBreakerRejectedRequests(_a0 string) {
+	_m.Called(_a0)
+}
+
+// IncExtensionRateLimitedRequests provides a mock function with given fields: _a0
+func (_m *ExtensionMetricsRegistry) IncExtensionRateLimitedRequests(_a0 string) {
+	_m.Called(_a0)
+}
+
 // IncExtensionRequestCounter provides a mock function with given fields: _a0, status
 func (_m *ExtensionMetricsRegistry) IncExtensionRequestCounter(_a0 string, status int) {
 	_m.Called(_a0, status)
@@ -19,7 +19,13 @@ type MetricsServer struct {
 	redisRequestHistogram    *prometheus.HistogramVec
 	extensionRequestCounter  *prometheus.CounterVec
 	extensionRequestDuration *prometheus.HistogramVec
+	extensionRateLimited     *prometheus.CounterVec
+	extensionCacheRequests   *prometheus.CounterVec
+	extensionCircuitRejected *prometheus.CounterVec
 	argoVersion              *prometheus.GaugeVec
+	auditRecordCounter       *prometheus.CounterVec
+	auditQueueLength         *prometheus.GaugeVec
+	rateLimitedCounter       *prometheus.CounterVec
 }
 
 var (
@@ -53,6 +59,48 @@ var (
 		},
 		[]string{"extension"},
 	)
+	extensionRateLimited = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_proxy_extension_rate_limited_requests_total",
+			Help: "Number of requests to proxy extensions rejected because the user exceeded the rate limit of the extension.",
+		},
+		[]string{"extension"},
+	)
+	extensionCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_proxy_extension_cache_requests_total",
+			Help: "Number of GET requests to proxy extensions with response caching by cache result, i.e. hit or miss.",
+		},
+		[]string{"extension", "result"},
+	)
+	extensionCircuitRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_proxy_extension_circuit_breaker_rejected_requests_total",
+			Help: "Number of requests to proxy extensions rejected because the circuit of the backend service was open.",
+		},
+		[]string{"extension"},
+	)
+	auditRecordCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_audit_records_total",
+			Help: "Number of audit records by sink and result, i.e. written, failed or dropped because the buffer was full.",
+		},
+		[]string{"sink", "result"},
+	)
+	auditQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_audit_queue_length",
+			Help: "Number of audit records buffered for a sink.",
+		},
+		[]string{"sink"},
+	)
+	rateLimitedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_rate_limited_requests_total",
+			Help: "Number of API requests rejected because the caller exceeded the rate limit of the method group.",
+		},
+		[]string{"group", "method"},
+	)
 	argoVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_info",
@@ -78,7 +126,13 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(extensionRequestCounter)
 	registry.MustRegister(extensionRequestDuration)
+	registry.MustRegister(extensionRateLimited)
+	registry.MustRegister(extensionCacheRequests)
+	registry.MustRegister(extensionCircuitRejected)
 	registry.MustRegister(argoVersion)
+	registry.MustRegister(auditRecordCounter)
+	registry.MustRegister(auditQueueLength)
+	registry.MustRegister(rateLimitedCounter)
 
 	return &MetricsServer{
 		Server: &http.Server{
@@ -89,7 +143,13 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 		redisRequestHistogram:    redisRequestHistogram,
 		extensionRequestCounter:  extensionRequestCounter,
 		extensionRequestDuration: extensionRequestDuration,
+		extensionRateLimited:     extensionRateLimited,
+		extensionCacheRequests:   extensionCacheRequests,
+		extensionCircuitRejected: extensionCircuitRejected,
 		argoVersion:              argoVersion,
+		auditRecordCounter:       auditRecordCounter,
+		auditQueueLength:         auditQueueLength,
+		rateLimitedCounter:       rateLimitedCounter,
 	}
 }
 
@@ -109,3 +169,33 @@ func (m *MetricsServer) IncExtensionRequestCounter(extension string, status int)
 func (m *MetricsServer) ObserveExtensionRequestDuration(extension string, duration time.Duration) {
 	m.extensionRequestDuration.WithLabelValues(extension).Observe(duration.Seconds())
 }
+
+// IncExtensionRateLimitedRequests increases the number of requests to the extension rejected by its rate limit
+func (m *MetricsServer) IncExtensionRateLimitedRequests(extension string) {
+	m.extensionRateLimited.WithLabelValues(extension).Inc()
+}
+
+// IncExtensionCacheRequests increases the number of GET requests to the extension with the given cache result
+func (m *MetricsServer) IncExtensionCacheRequests(extension string, result string) {
+	m.extensionCacheRequests.WithLabelValues(extension, result).Inc()
+}
+
+// IncExtensionCircuitBreakerRejectedRequests increases the number of requests to the extension rejected by an open circuit
+func (m *MetricsServer) IncExtensionCircuitBreakerRejectedRequests(extension string) {
+	m.extensionCircuitRejected.WithLabelValues(extension).Inc()
+}
+
+// IncAuditRecords increases the number of audit records with the given result for the sink
+func (m *MetricsServer) IncAuditRecords(sink string, result string) {
+	m.auditRecordCounter.WithLabelValues(sink, result).Inc()
+}
+
+// SetAuditQueueLength sets the number of audit records buffered for the sink
+func (m *MetricsServer) SetAuditQueueLength(sink string, length int) {
+	m.auditQueueLength.WithLabelValues(sink).Set(float64(length))
+}
+
+// IncRateLimitedRequests increases the number of requests of the method which were rejected by the rate limit of the group
+func (m *MetricsServer) IncRateLimitedRequests(group string, method string) {
+	m.rateLimitedCounter.WithLabelValues(group, method).Inc()
+}
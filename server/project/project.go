@@ -112,6 +112,9 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 			return nil, err
 		}
 	}
+	if err := rbacpolicy.EnforceTokenScopeSubset(jwtutil.Claims(ctx.Value("claims")), q.Scope); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	id := q.Id
 	if err := prj.ValidateJWTTokenID(q.Role, q.Id); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -120,8 +123,20 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 		uniqueId, _ := uuid.NewRandom()
 		id = uniqueId.String()
 	}
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	expiresIn, err := argoCDSettings.TokenExpiresIn(q.ExpiresIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scope := q.Scope
+	if scope.IsEmpty() {
+		scope = nil
+	}
 	subject := fmt.Sprintf(JWTTokenSubFormat, q.Project, q.Role)
-	jwtToken, err := s.sessionMgr.Create(subject, q.ExpiresIn, id)
+	jwtToken, err := s.sessionMgr.CreateWithScope(subject, expiresIn, id, scope)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -142,7 +157,7 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 
 	prj.NormalizeJWTTokens()
 
-	items := append(prj.Status.JWTTokensByRole[q.Role].Items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: id})
+	items := append(prj.Status.JWTTokensByRole[q.Role].Items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: id, Scope: scope})
 	if _, found := prj.Status.JWTTokensByRole[q.Role]; found {
 		prj.Status.JWTTokensByRole[q.Role] = v1alpha1.JWTTokens{Items: items}
 	} else {
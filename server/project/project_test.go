@@ -353,6 +353,25 @@ func TestProjectServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("TestCreateTokenOutsideScopeOfCallerDenied", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, Groups: []string{"my-group"}}}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithRole), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		// nolint:staticcheck
+		scopedCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{
+			"groups":                   []string{"my-group"},
+			rbacpolicy.TokenScopeClaim: map[string]interface{}{"resources": []interface{}{"logs"}},
+		})
+
+		_, err := projectServer.CreateToken(scopedCtx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = projectServer.CreateToken(scopedCtx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 1, Scope: &v1alpha1.TokenScope{Resources: []string{"logs"}}})
+		require.NoError(t, err)
+	})
+
 	_ = enforcer.SetBuiltinPolicy(`p, role:admin, projects, update, *, allow`)
 
 	t.Run("TestCreateTokenSuccessfully", func(t *testing.T) {
@@ -376,6 +395,29 @@ func TestProjectServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("TestCreateScopedTokenSuccessfully", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		clientset := apps.NewSimpleClientset(projectWithRole)
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		scope := &v1alpha1.TokenScope{Resources: []string{"logs"}, Actions: []string{"get"}}
+		tokenResponse, err := projectServer.CreateToken(context.Background(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Scope: scope})
+		require.NoError(t, err)
+		claims, _, err := sessionMgr.Parse(tokenResponse.Token)
+		require.NoError(t, err)
+		tokenScope, err := rbacpolicy.TokenScopeFromClaims(claims)
+		require.NoError(t, err)
+		assert.Equal(t, scope, tokenScope)
+
+		proj, err := clientset.ArgoprojV1alpha1().AppProjects("default").Get(context.Background(), projectWithRole.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, proj.Status.JWTTokensByRole[tokenName].Items, 1)
+		assert.Equal(t, scope, proj.Status.JWTTokensByRole[tokenName].Items[0].Scope)
+	})
+
 	t.Run("TestCreateTokenWithIDSuccessfully", func(t *testing.T) {
 		projectWithRole := existingProj.DeepCopy()
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
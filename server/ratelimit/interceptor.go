@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/server/audit"
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+)
+
+// RetryAfterHeader is the header which tells rejected callers how many seconds to wait before retrying
+const RetryAfterHeader = "Retry-After"
+
+// refreshMethods are the methods which are served by the repo-server and therefore count as refreshes
+var refreshMethods = map[string]bool{
+	"/application.ApplicationService/GetManifests":          true,
+	"/application.ApplicationService/GetManifestsWithFiles": true,
+	"/application.ApplicationService/RevisionMetadata":      true,
+	"/application.ApplicationService/RevisionChartDetails":  true,
+	"/repository.RepositoryService/ListRefs":                true,
+	"/repository.RepositoryService/ListApps":                true,
+	"/repository.RepositoryService/GetAppDetails":           true,
+	"/repository.RepositoryService/GetHelmCharts":           true,
+	"/repository.RepositoryService/ValidateAccess":          true,
+	"/applicationset.ApplicationSetService/Generate":        true,
+}
+
+// MethodGroup returns the group of the gRPC method with the given full name. Calls which request a refresh of an
+// application are in the refresh group, regardless of their method.
+func MethodGroup(fullMethod string, req interface{}) string {
+	if r, ok := req.(interface{ GetRefresh() string }); ok && r.GetRefresh() != "" {
+		return GroupRefresh
+	}
+	if refreshMethods[fullMethod] {
+		return GroupRefresh
+	}
+	if audit.IsMutatingMethod(fullMethod) {
+		return GroupWrite
+	}
+	return GroupRead
+}
+
+func retryAfterSeconds(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Max(1, math.Ceil(retryAfter.Seconds()))))
+}
+
+func rejectedErr(group string, retryAfter time.Duration) error {
+	return status.Errorf(codes.ResourceExhausted, "rate limit of %s calls exceeded, retry after %s seconds", group, retryAfterSeconds(retryAfter))
+}
+
+// UnaryServerInterceptor returns a server interceptor which rejects unary calls exceeding the rate limits of the
+// caller. The number of seconds to wait before retrying is sent in the retry-after header. Calls are passed through
+// if no limits are configured.
+func UnaryServerInterceptor(limiter *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.Enabled() {
+			return handler(ctx, req)
+		}
+		group := MethodGroup(info.FullMethod, req)
+		if allowed, retryAfter := limiter.Allow(ctx, group, info.FullMethod, sessionmgr.Sub(ctx), sessionmgr.Jti(ctx)); !allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, retryAfterSeconds(retryAfter)))
+			return nil, rejectedErr(group, retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a server interceptor which rejects streaming calls exceeding the rate limits of the
+// caller. Streaming calls are grouped by their method only.
+func StreamServerInterceptor(limiter *Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.Enabled() {
+			return handler(srv, stream)
+		}
+		ctx := stream.Context()
+		group := MethodGroup(info.FullMethod, nil)
+		if allowed, retryAfter := limiter.Allow(ctx, group, info.FullMethod, sessionmgr.Sub(ctx), sessionmgr.Jti(ctx)); !allowed {
+			_ = stream.SetHeader(metadata.Pairs(RetryAfterHeader, retryAfterSeconds(retryAfter)))
+			return rejectedErr(group, retryAfter)
+		}
+		return handler(srv, stream)
+	}
+}
+
+// HTTPMiddleware returns a handler which rejects plain HTTP requests exceeding the rate limits of the caller with
+// status 429. GET and HEAD requests count as reads, all other requests as writes. It must be wrapped by a handler
+// which puts the claims of the caller into the request context.
+func HTTPMiddleware(limiter *Limiter, method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !limiter.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		group := GroupWrite
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			group = GroupRead
+		}
+		ctx := r.Context()
+		if allowed, retryAfter := limiter.Allow(ctx, group, method, sessionmgr.Sub(ctx), sessionmgr.Jti(ctx)); !allowed {
+			w.Header().Set(RetryAfterHeader, retryAfterSeconds(retryAfter))
+			http.Error(w, status.Convert(rejectedErr(group, retryAfter)).Message(), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
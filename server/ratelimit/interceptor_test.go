@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+)
+
+func TestMethodGroup(t *testing.T) {
+	assert.Equal(t, GroupRead, MethodGroup("/application.ApplicationService/Get", &application.ApplicationQuery{}))
+	assert.Equal(t, GroupRefresh, MethodGroup("/application.ApplicationService/Get", &application.ApplicationQuery{Refresh: ptr.To("normal")}))
+	assert.Equal(t, GroupRefresh, MethodGroup("/application.ApplicationService/List", &application.ApplicationQuery{Refresh: ptr.To("hard")}))
+	assert.Equal(t, GroupRefresh, MethodGroup("/application.ApplicationService/GetManifests", &application.ApplicationManifestQuery{}))
+	assert.Equal(t, GroupRefresh, MethodGroup("/repository.RepositoryService/ListRefs", nil))
+	assert.Equal(t, GroupWrite, MethodGroup("/application.ApplicationService/Sync", &application.ApplicationSyncRequest{}))
+	assert.Equal(t, GroupRead, MethodGroup("/application.ApplicationService/Watch", nil))
+}
+
+// fakeTransportStream captures the headers set by interceptors
+type fakeTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *fakeTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func claimsContext(sub string, jti string) context.Context {
+	return context.WithValue(context.Background(), "claims", &jwt.MapClaims{"sub": sub, "jti": jti})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	limiter := NewLimiter(map[string]Limit{GroupWrite: {Rate: 0.5, Burst: 1}}, nil, nil)
+	interceptor := UnaryServerInterceptor(limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	stream := &fakeTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(claimsContext("alice", "token-1"), stream)
+
+	resp, err := interceptor(ctx, &application.ApplicationSyncRequest{}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	resp, err = interceptor(ctx, &application.ApplicationSyncRequest{}, info, handler)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"2"}, stream.header.Get(RetryAfterHeader))
+
+	resp, err = interceptor(ctx, &application.ApplicationQuery{}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}, handler)
+	require.NoError(t, err, "reads are not limited")
+	assert.Equal(t, "ok", resp)
+
+	resp, err = UnaryServerInterceptor(NewLimiter(nil, nil, nil))(ctx, &application.ApplicationSyncRequest{}, info, handler)
+	require.NoError(t, err, "calls are passed through without limits")
+	assert.Equal(t, "ok", resp)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	limiter := NewLimiter(map[string]Limit{GroupRefresh: {Rate: 1, Burst: 1}}, nil, nil)
+	interceptor := StreamServerInterceptor(limiter)
+	info := &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/GetManifestsWithFiles"}
+	calls := 0
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		calls++
+		return nil
+	}
+	stream := &fakeServerStream{ctx: claimsContext("alice", "token-1")}
+	require.NoError(t, interceptor(nil, stream, info, handler))
+	err := interceptor(nil, stream, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1"}, stream.header.Get(RetryAfterHeader))
+	assert.Equal(t, 1, calls)
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	limiter := NewLimiter(map[string]Limit{GroupRead: {Rate: 1, Burst: 1}}, nil, nil)
+	handler := HTTPMiddleware(limiter, "/terminal", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	request := func(method string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/terminal", nil).WithContext(claimsContext("alice", ""))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, request(http.MethodGet).Code)
+	w := request(http.MethodGet)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get(RetryAfterHeader))
+	assert.Equal(t, http.StatusOK, request(http.MethodPost).Code, "writes are not limited")
+}
@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// GroupRead is the group of calls which do not mutate anything
+	GroupRead = "read"
+	// GroupWrite is the group of calls which mutate something
+	GroupWrite = "write"
+	// GroupRefresh is the group of calls which refresh applications or are served by the repo-server, and therefore
+	// are much more expensive than other calls
+	GroupRefresh = "refresh"
+)
+
+// Groups are the method groups rate limits can be configured for
+var Groups = []string{GroupRead, GroupWrite, GroupRefresh}
+
+// Limit is the rate limit of a method group, enforced by a token bucket per subject and token
+type Limit struct {
+	// Rate is the number of calls per second the bucket is refilled with
+	Rate float64
+	// Burst is the size of the bucket, i.e. the number of calls which can be made at once
+	Burst int
+}
+
+// ParseLimits parses rate limits of method groups given as <rate>[:<burst>], where the rate is the number of calls per
+// second. The burst defaults to the rate, rounded up.
+func ParseLimits(limits map[string]string) (map[string]Limit, error) {
+	res := map[string]Limit{}
+	for group, str := range limits {
+		if !isValidGroup(group) {
+			return nil, fmt.Errorf("invalid rate limit group '%s', must be one of %s", group, strings.Join(Groups, ", "))
+		}
+		rateStr, burstStr, hasBurst := strings.Cut(str, ":")
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rateStr), 64)
+		if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+			return nil, fmt.Errorf("invalid rate limit '%s' of group '%s': rate must be a positive number", str, group)
+		}
+		limit := Limit{Rate: rate, Burst: int(math.Ceil(rate))}
+		if hasBurst {
+			limit.Burst, err = strconv.Atoi(strings.TrimSpace(burstStr))
+			if err != nil || limit.Burst <= 0 {
+				return nil, fmt.Errorf("invalid rate limit '%s' of group '%s': burst must be a positive integer", str, group)
+			}
+		}
+		res[group] = limit
+	}
+	return res, nil
+}
+
+func isValidGroup(group string) bool {
+	for _, g := range Groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricsRegistry records calls which were rejected by rate limits
+type MetricsRegistry interface {
+	IncRateLimitedRequests(group string, method string)
+}
+
+type noopMetricsRegistry struct{}
+
+func (noopMetricsRegistry) IncRateLimitedRequests(string, string) {}
+
+// bucketStore takes tokens from token buckets
+type bucketStore interface {
+	// take takes a token from the bucket with the given key. It returns whether a token was available and otherwise
+	// how long it takes until the next token is available.
+	take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Limiter enforces the rate limits of method groups. Limits are enforced per subject and token, so that every token
+// of a user has its own buckets.
+type Limiter struct {
+	limits  map[string]Limit
+	store   bucketStore
+	metrics MetricsRegistry
+}
+
+// NewLimiter returns a limiter which enforces the given limits. The buckets are shared by all API server replicas
+// through Redis, or kept in memory if the Redis client is nil.
+func NewLimiter(limits map[string]Limit, redisClient *redis.Client, metrics MetricsRegistry) *Limiter {
+	var store bucketStore
+	if redisClient != nil {
+		store = newRedisBucketStore(redisClient)
+	} else {
+		store = newMemoryBucketStore()
+	}
+	if metrics == nil {
+		metrics = noopMetricsRegistry{}
+	}
+	return &Limiter{limits: limits, store: store, metrics: metrics}
+}
+
+// Enabled returns whether any limit is configured
+func (l *Limiter) Enabled() bool {
+	return l != nil && len(l.limits) > 0
+}
+
+// Limits returns the configured limits, sorted by group
+func (l *Limiter) Limits() []string {
+	var res []string
+	for group, limit := range l.limits {
+		res = append(res, fmt.Sprintf("%s=%g:%d", group, limit.Rate, limit.Burst))
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Allow returns whether a call of the given method group made by the subject with the given token may proceed. If
+// not, it returns how long the caller should wait before retrying. Calls are allowed if the bucket store fails, so
+// that an unavailable Redis does not make the API unavailable.
+func (l *Limiter) Allow(ctx context.Context, group string, method string, subject string, tokenID string) (bool, time.Duration) {
+	limit, ok := l.limits[group]
+	if !ok || subject == "" {
+		return true, 0
+	}
+	allowed, retryAfter, err := l.store.take(ctx, bucketKey(group, subject, tokenID), limit)
+	if err != nil {
+		log.Warnf("Failed to enforce rate limit of group %s for %s: %v", group, subject, err)
+		return true, 0
+	}
+	if !allowed {
+		l.metrics.IncRateLimitedRequests(group, method)
+	}
+	return allowed, retryAfter
+}
+
+func bucketKey(group string, subject string, tokenID string) string {
+	return fmt.Sprintf("ratelimit|%s|%s|%s", group, subject, tokenID)
+}
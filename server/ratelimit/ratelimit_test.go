@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits(map[string]string{"read": "20:40", "write": "2.5", "refresh": " 0.5 : 3 "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Limit{
+		GroupRead:    {Rate: 20, Burst: 40},
+		GroupWrite:   {Rate: 2.5, Burst: 3},
+		GroupRefresh: {Rate: 0.5, Burst: 3},
+	}, limits)
+
+	limits, err = ParseLimits(nil)
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	for _, tc := range []map[string]string{
+		{"delete": "1"},
+		{"read": ""},
+		{"read": "0"},
+		{"read": "-1:5"},
+		{"read": "fast"},
+		{"read": "1:0"},
+		{"read": "1:1.5"},
+	} {
+		_, err := ParseLimits(tc)
+		assert.Error(t, err, tc)
+	}
+}
+
+type fakeMetrics struct {
+	rejected []string
+}
+
+func (m *fakeMetrics) IncRateLimitedRequests(group string, method string) {
+	m.rejected = append(m.rejected, group+" "+method)
+}
+
+func testLimiter(t *testing.T, limiter *Limiter, metrics *fakeMetrics) {
+	t.Helper()
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		allowed, _ := limiter.Allow(ctx, GroupWrite, "/application.ApplicationService/Sync", "alice", "token-1")
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter := limiter.Allow(ctx, GroupWrite, "/application.ApplicationService/Sync", "alice", "token-1")
+	assert.False(t, allowed, "the burst is exhausted")
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, time.Second)
+	assert.Equal(t, []string{"write /application.ApplicationService/Sync"}, metrics.rejected)
+
+	allowed, _ = limiter.Allow(ctx, GroupWrite, "/application.ApplicationService/Sync", "alice", "token-2")
+	assert.True(t, allowed, "every token has its own bucket")
+	allowed, _ = limiter.Allow(ctx, GroupWrite, "/application.ApplicationService/Sync", "bob", "")
+	assert.True(t, allowed, "every subject has its own bucket")
+	allowed, _ = limiter.Allow(ctx, GroupRefresh, "/application.ApplicationService/Get", "alice", "token-1")
+	assert.True(t, allowed, "every group has its own bucket")
+	for i := 0; i < 10; i++ {
+		allowed, _ = limiter.Allow(ctx, GroupRead, "/application.ApplicationService/List", "alice", "token-1")
+		assert.True(t, allowed, "groups without a limit are not limited")
+		allowed, _ = limiter.Allow(ctx, GroupWrite, "/session.SessionService/Create", "", "")
+		assert.True(t, allowed, "anonymous calls are not limited")
+	}
+}
+
+func TestLimiter_Memory(t *testing.T) {
+	metrics := &fakeMetrics{}
+	limiter := NewLimiter(map[string]Limit{GroupWrite: {Rate: 1, Burst: 2}, GroupRefresh: {Rate: 0.1, Burst: 1}}, nil, metrics)
+	assert.True(t, limiter.Enabled())
+	assert.Equal(t, []string{"refresh=0.1:1", "write=1:2"}, limiter.Limits())
+	testLimiter(t, limiter, metrics)
+}
+
+func TestLimiter_Redis(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	now := time.Now()
+	mr.SetTime(now)
+	metrics := &fakeMetrics{}
+	limiter := NewLimiter(map[string]Limit{GroupWrite: {Rate: 1, Burst: 2}, GroupRefresh: {Rate: 0.1, Burst: 1}}, redis.NewClient(&redis.Options{Addr: mr.Addr()}), metrics)
+	testLimiter(t, limiter, metrics)
+	assert.True(t, mr.Exists(bucketKey(GroupWrite, "alice", "token-1")))
+	assert.Positive(t, mr.TTL(bucketKey(GroupWrite, "alice", "token-1")))
+
+	t.Run("refill", func(t *testing.T) {
+		mr.SetTime(now.Add(time.Second))
+		allowed, _ := limiter.Allow(context.Background(), GroupWrite, "/application.ApplicationService/Sync", "alice", "token-1")
+		assert.True(t, allowed, "the bucket is refilled over time")
+		allowed, retryAfter := limiter.Allow(context.Background(), GroupWrite, "/application.ApplicationService/Sync", "alice", "token-1")
+		assert.False(t, allowed)
+		assert.Equal(t, time.Second, retryAfter)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		mr.Close()
+		allowed, _ := limiter.Allow(context.Background(), GroupWrite, "/application.ApplicationService/Sync", "alice", "token-1")
+		assert.True(t, allowed, "calls are allowed if Redis is unavailable")
+	})
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	var limiter *Limiter
+	assert.False(t, limiter.Enabled())
+	assert.False(t, NewLimiter(nil, nil, nil).Enabled())
+}
@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/time/rate"
+)
+
+// takeTokenScript atomically refills a token bucket based on the time elapsed since the last call and takes a token
+// from it. It uses the time of the Redis server, so that the clocks of the API server replicas do not matter. The
+// bucket expires once it would be full again. It returns whether a token was taken and otherwise the number of
+// milliseconds until the next token is available.
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * 1000 / rate) + 1000)
+return {allowed, retry}
+`)
+
+type redisBucketStore struct {
+	client *redis.Client
+}
+
+func newRedisBucketStore(client *redis.Client) *redisBucketStore {
+	return &redisBucketStore{client: client}
+}
+
+func (s *redisBucketStore) take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	res, err := takeTokenScript.Run(ctx, s.client, []string{key}, limit.Rate, limit.Burst).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("unexpected result of rate limit script: %v", res)
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// memoryPruneInterval is the interval in which buckets which are full again are removed from the memory store
+const memoryPruneInterval = time.Minute
+
+type memoryBucketStore struct {
+	lock       sync.Mutex
+	buckets    map[string]*rate.Limiter
+	lastPruned time.Time
+}
+
+func newMemoryBucketStore() *memoryBucketStore {
+	return &memoryBucketStore{buckets: map[string]*rate.Limiter{}, lastPruned: time.Now()}
+}
+
+func (s *memoryBucketStore) take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	if now.Sub(s.lastPruned) > memoryPruneInterval {
+		for k, bucket := range s.buckets {
+			if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastPruned = now
+	}
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		s.buckets[key] = bucket
+	}
+	if bucket.AllowN(now, 1) {
+		return true, 0, nil
+	}
+	missing := 1 - bucket.TokensAt(now)
+	return false, time.Duration(math.Ceil(missing / limit.Rate * float64(time.Second))), nil
+}
@@ -1,6 +1,8 @@
 package rbacpolicy
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
@@ -35,6 +38,13 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	ActionReplay   = "replay"
+
+	// ExplainOriginProjectToken is the origin of subjects of project tokens in RBAC explanations
+	ExplainOriginProjectToken = "project token"
+
+	// TokenScopeClaim is the claim of tokens which are restricted to a subset of the permissions of their subject
+	TokenScopeClaim = "token_scope"
 )
 
 var (
@@ -56,6 +66,7 @@ var (
 		ActionDelete,
 		ActionSync,
 		ActionOverride,
+		ActionReplay,
 	}
 )
 
@@ -65,6 +76,8 @@ var (
 type RBACPolicyEnforcer struct {
 	enf        *rbac.Enforcer
 	projLister applister.AppProjectNamespaceLister
+	appLister  applister.ApplicationLister
+	namespace  string
 	scopes     []string
 }
 
@@ -81,6 +94,14 @@ func (p *RBACPolicyEnforcer) SetScopes(scopes []string) {
 	p.scopes = scopes
 }
 
+// SetApplicationLister sets the lister used to resolve the Applications of requests for applications, logs and exec,
+// whose attributes are evaluated by policy conditions. Applications referenced without namespace are looked up in the
+// given namespace.
+func (p *RBACPolicyEnforcer) SetApplicationLister(appLister applister.ApplicationLister, namespace string) {
+	p.appLister = appLister
+	p.namespace = namespace
+}
+
 func (p *RBACPolicyEnforcer) GetScopes() []string {
 	scopes := p.scopes
 	if scopes == nil {
@@ -102,6 +123,92 @@ func GetProjectRoleFromSubject(subject string) (string, string, bool) {
 	return "", "", false
 }
 
+// TokenScopeFromClaims returns the scope of the token the claims were issued for, or nil if the token is not scoped
+func TokenScopeFromClaims(claims jwt.Claims) (*v1alpha1.TokenScope, error) {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := mapClaims[TokenScopeClaim]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var scope v1alpha1.TokenScope
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return nil, fmt.Errorf("invalid %s claim: %w", TokenScopeClaim, err)
+	}
+	if scope.IsEmpty() {
+		return nil, nil
+	}
+	return &scope, nil
+}
+
+// getProjectFromObject returns the name of the project the object of an RBAC request for the resource belongs to.
+// Returns false if the resource does not belong to projects.
+func getProjectFromObject(resource string, obj string) (string, bool) {
+	switch resource {
+	case ResourceApplications, ResourceApplicationSets, ResourceLogs, ResourceExec:
+		if objSplit := strings.SplitN(obj, "/", 2); len(objSplit) == 2 {
+			return objSplit[0], true
+		}
+	case ResourceProjects:
+		return obj, true
+	}
+	return "", false
+}
+
+// EnforceTokenScopeSubset returns an error if the claims are of a scoped token, and the given scope of a token to be
+// created is not within it. Otherwise, scoped tokens could be used to create tokens with more permissions.
+func EnforceTokenScopeSubset(claims jwt.Claims, scope *v1alpha1.TokenScope) error {
+	if claims == nil {
+		return nil
+	}
+	callerScope, err := TokenScopeFromClaims(claims)
+	if err != nil {
+		return fmt.Errorf("failed to get token scope: %w", err)
+	}
+	if !scope.IsSubsetOf(callerScope) {
+		return fmt.Errorf("the scope of the new token must be within the scope of the token the request was made with")
+	}
+	return nil
+}
+
+// EnforceTokenScope returns whether the request is within the scope of the token the claims were issued for. Requests
+// of tokens without scope are always within scope.
+func (p *RBACPolicyEnforcer) EnforceTokenScope(claims jwt.Claims, rvals ...interface{}) bool {
+	scope, err := TokenScopeFromClaims(claims)
+	if err != nil {
+		log.WithError(err).Warn("failed to get token scope")
+		return false
+	}
+	if scope == nil {
+		return true
+	}
+	if len(rvals) < 4 {
+		return false
+	}
+	resource, _ := rvals[1].(string)
+	action, _ := rvals[2].(string)
+	obj, _ := rvals[3].(string)
+	if len(scope.Resources) > 0 && !glob.MatchStringInList(scope.Resources, resource, glob.GLOB) {
+		return false
+	}
+	if len(scope.Actions) > 0 && !glob.MatchStringInList(scope.Actions, action, glob.GLOB) {
+		return false
+	}
+	if len(scope.Projects) > 0 {
+		projName, ok := getProjectFromObject(resource, obj)
+		if !ok || !glob.MatchStringInList(scope.Projects, projName, glob.GLOB) {
+			return false
+		}
+	}
+	return true
+}
+
 // EnforceClaims is an RBAC claims enforcer specific to the Argo CD API server
 func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface{}) bool {
 	mapClaims, err := jwtutil.MapClaims(claims)
@@ -115,6 +222,10 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	var runtimePolicy string
 	var projName string
 	proj := p.getProjectFromRequest(rvals...)
+	// Add the attributes of the requested application, which are evaluated by policy conditions
+	if attrs := p.getAttributesFromRequest(rvals...); attrs != nil {
+		rvals = append(rvals[:4:4], attrs)
+	}
 	if proj != nil {
 		if IsProjectSubject(subject) {
 			return p.enforceProjectToken(subject, proj, rvals...)
@@ -163,10 +274,61 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	return false
 }
 
+// ExplainClaims explains the enforcement of a request for JWT claims, by evaluating the same subjects as EnforceClaims:
+// either the project token, or the subject and the groups of the claims.
+func (p *RBACPolicyEnforcer) ExplainClaims(claims jwt.Claims, rvals ...interface{}) []rbac.SubjectExplanation {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil || len(rvals) < 4 {
+		return nil
+	}
+
+	subject := jwtutil.StringField(mapClaims, "sub")
+	var runtimePolicy string
+	var projName string
+	proj := p.getProjectFromRequest(rvals...)
+	if attrs := p.getAttributesFromRequest(rvals...); attrs != nil {
+		rvals = append(rvals[:4:4], attrs)
+	}
+	if proj != nil {
+		runtimePolicy = proj.ProjectPoliciesString()
+		projName = proj.Name
+		if IsProjectSubject(subject) {
+			if tokenProjName, _, _ := GetProjectRoleFromSubject(subject); tokenProjName != proj.Name {
+				return []rbac.SubjectExplanation{{Subject: subject, Origin: ExplainOriginProjectToken}}
+			}
+			return []rbac.SubjectExplanation{p.enf.ExplainSubject(projName, runtimePolicy, subject, ExplainOriginProjectToken, rvals[1:]...)}
+		}
+	}
+
+	res := []rbac.SubjectExplanation{p.enf.ExplainSubject(projName, runtimePolicy, subject, rbac.ExplainOriginSubject, rvals[1:]...)}
+	groupingPolicies, err := p.enf.CreateEnforcerWithRuntimePolicy(projName, runtimePolicy).GetGroupingPolicy()
+	if err != nil {
+		log.WithError(err).Error("failed to get grouping policy")
+		return res
+	}
+	seen := map[string]bool{}
+	for _, scope := range p.GetScopes() {
+		for _, group := range jwtutil.GetScopeValues(mapClaims, []string{scope}) {
+			if seen[group] {
+				continue
+			}
+			seen[group] = true
+			// only groups with role assignments are evaluated
+			for _, groupingPolicy := range groupingPolicies {
+				if groupingPolicy[0] == group {
+					res = append(res, p.enf.ExplainSubject(projName, runtimePolicy, group, fmt.Sprintf("group from scope '%s'", scope), rvals[1:]...))
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
 // project (if it exists)
 func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alpha1.AppProject {
-	if len(rvals) != 4 {
+	if len(rvals) < 4 {
 		return nil
 	}
 	getProjectByName := func(projName string) *v1alpha1.AppProject {
@@ -192,6 +354,51 @@ func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alph
 	return nil
 }
 
+// getAttributesFromRequest returns the attributes of the application an RBAC request for applications, logs or exec is
+// made for. Returns nil if the application cannot be resolved, e.g. because it does not exist (yet) or the request
+// contains a pattern, or if the request already carries the attributes to evaluate.
+func (p *RBACPolicyEnforcer) getAttributesFromRequest(rvals ...interface{}) *rbac.Attributes {
+	if p.appLister == nil || len(rvals) != 4 {
+		return nil
+	}
+	res, ok := rvals[1].(string)
+	if !ok || (res != ResourceApplications && res != ResourceLogs && res != ResourceExec) {
+		return nil
+	}
+	obj, ok := rvals[3].(string)
+	if !ok {
+		return nil
+	}
+	var projName, namespace, name string
+	switch objSplit := strings.Split(obj, "/"); len(objSplit) {
+	case 2:
+		projName, namespace, name = objSplit[0], p.namespace, objSplit[1]
+	case 3:
+		projName, namespace, name = objSplit[0], objSplit[1], objSplit[2]
+	default:
+		return nil
+	}
+	app, err := p.appLister.Applications(namespace).Get(name)
+	if err != nil || app.Spec.GetProject() != projName {
+		return nil
+	}
+	return ApplicationAttributes(app)
+}
+
+// ApplicationAttributes returns the attributes of the application which are evaluated by policy conditions
+func ApplicationAttributes(app *v1alpha1.Application) *rbac.Attributes {
+	attrs := &rbac.Attributes{
+		Labels:               app.Labels,
+		DestinationServer:    app.Spec.Destination.Server,
+		DestinationName:      app.Spec.Destination.Name,
+		DestinationNamespace: app.Spec.Destination.Namespace,
+	}
+	for _, source := range app.Spec.GetSources() {
+		attrs.SourceRepoURLs = append(attrs.SourceRepoURLs, source.RepoURL)
+	}
+	return attrs
+}
+
 // enforceProjectToken will check to see the valid token has not yet been revoked in the project
 func (p *RBACPolicyEnforcer) enforceProjectToken(subject string, proj *v1alpha1.AppProject, rvals ...interface{}) bool {
 	subjectSplit := strings.Split(subject, ":")
@@ -6,11 +6,14 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
@@ -89,6 +92,163 @@ func TestEnforceAllPolicies(t *testing.T) {
 	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
 }
 
+func newFakeAppLister(t *testing.T, apps ...*argoappv1.Application) applister.ApplicationLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		assert.NoError(t, indexer.Add(app))
+	}
+	return applister.NewApplicationLister(indexer)
+}
+
+func TestEnforceConditions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetUserPolicy(`p, role:payments, applications, *, my-proj/*, allow, labels.team == payments` + "\n" +
+		`p, role:payments, applications, delete, my-proj/*, deny, destination.namespace == prod-*` + "\n" +
+		`p, role:payments, logs, get, my-proj/*, allow, source.repoURL == https://github.com/payments/*` + "\n" +
+		`g, alice, role:payments`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	rbacEnf.SetApplicationLister(newFakeAppLister(t,
+		&argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments-dev", Namespace: test.FakeArgoCDNamespace, Labels: map[string]string{"team": "payments"}},
+			Spec: argoappv1.ApplicationSpec{
+				Project:     "my-proj",
+				Source:      &argoappv1.ApplicationSource{RepoURL: "https://github.com/payments/manifests"},
+				Destination: argoappv1.ApplicationDestination{Namespace: "dev-payments"},
+			},
+		},
+		&argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments-prod", Namespace: "apps", Labels: map[string]string{"team": "payments"}},
+			Spec: argoappv1.ApplicationSpec{
+				Project:     "my-proj",
+				Source:      &argoappv1.ApplicationSource{RepoURL: "https://github.com/other/manifests"},
+				Destination: argoappv1.ApplicationDestination{Namespace: "prod-payments"},
+			},
+		},
+		&argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "billing", Namespace: test.FakeArgoCDNamespace, Labels: map[string]string{"team": "billing"}},
+			Spec:       argoappv1.ApplicationSpec{Project: "my-proj"},
+		},
+	), test.FakeArgoCDNamespace)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	claims := jwt.MapClaims{"sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/payments-dev"))
+	assert.True(t, enf.Enforce(claims, "applications", "delete", "my-proj/payments-dev"))
+	assert.True(t, enf.Enforce(claims, "logs", "get", "my-proj/payments-dev"))
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/apps/payments-prod"))
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "my-proj/apps/payments-prod"))
+	assert.False(t, enf.Enforce(claims, "logs", "get", "my-proj/apps/payments-prod"))
+	assert.False(t, enf.Enforce(claims, "applications", "sync", "my-proj/billing"))
+	// the project of the request needs to match the one of the application
+	assert.False(t, enf.Enforce(claims, "applications", "sync", "other-proj/payments-dev"))
+	// conditions of allow policies do not match if the application cannot be resolved
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/new-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/*"))
+	// the attributes of the request take precedence over the ones of the stored application
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/new-app", &rbac.Attributes{Labels: map[string]string{"team": "payments"}}))
+	assert.False(t, enf.Enforce(claims, "applications", "update", "my-proj/payments-dev", &rbac.Attributes{Labels: map[string]string{"team": "billing"}}))
+}
+
+func TestApplicationAttributes(t *testing.T) {
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Labels: map[string]string{"team": "payments"}},
+		Spec: argoappv1.ApplicationSpec{
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+				{RepoURL: "https://charts.example.com", Chart: "chart"},
+			},
+			Destination: argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Name: "in-cluster", Namespace: "payments"},
+		},
+	}
+	assert.Equal(t, &rbac.Attributes{
+		Labels:               map[string]string{"team": "payments"},
+		DestinationServer:    "https://kubernetes.default.svc",
+		DestinationName:      "in-cluster",
+		DestinationNamespace: "payments",
+		SourceRepoURLs:       []string{"https://github.com/argoproj/argocd-example-apps", "https://charts.example.com"},
+	}, ApplicationAttributes(app))
+}
+
+func TestExplainClaims(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetUserPolicy(`p, bob, applications, create, my-proj/*, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainerFunc(rbacEnf.ExplainClaims)
+
+	exp := enf.Explain(jwt.MapClaims{"sub": "bob", "groups": []string{"my-org:my-team", "my-org:other-team"}}, "applications", "create", "my-proj/my-app")
+	assert.True(t, exp.Allowed)
+	// groups without role assignments are not evaluated
+	require.Len(t, exp.Subjects, 2)
+	assert.Equal(t, "bob", exp.Subjects[0].Subject)
+	assert.Equal(t, rbac.ExplainOriginSubject, exp.Subjects[0].Origin)
+	assert.Equal(t, []rbac.PolicyLine{{Source: rbac.PolicySourceUser, Line: "p, bob, applications, create, my-proj/*, allow"}}, exp.Subjects[0].Policies)
+	assert.Equal(t, "my-org:my-team", exp.Subjects[1].Subject)
+	assert.Equal(t, "group from scope 'groups'", exp.Subjects[1].Origin)
+	assert.True(t, exp.Subjects[1].Allowed)
+	assert.Equal(t, []rbac.PolicyLine{{Source: "project:my-proj", Line: "g, my-org:my-team, proj:my-proj:my-role"}}, exp.Subjects[1].Groupings)
+	assert.Equal(t, []rbac.PolicyLine{{Source: "project:my-proj", Line: "p, proj:my-proj:my-role, applications, create, my-proj/*, allow"}}, exp.Subjects[1].Policies)
+
+	exp = enf.Explain(jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234}, "applications", "create", "my-proj/my-app")
+	assert.True(t, exp.Allowed)
+	require.Len(t, exp.Subjects, 1)
+	assert.Equal(t, ExplainOriginProjectToken, exp.Subjects[0].Origin)
+
+	exp = enf.Explain(jwt.MapClaims{"sub": "proj:other-proj:my-role", "iat": 1234}, "applications", "create", "my-proj/my-app")
+	assert.False(t, exp.Allowed)
+}
+
+func TestEnforceTokenScope(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, alice, *, *, *, allow` + "\n" + `p, role:readonly, logs, get, */*, allow`)
+	enf.SetDefaultRole("role:readonly")
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainerFunc(rbacEnf.ExplainClaims)
+	enf.SetClaimsScopeFunc(rbacEnf.EnforceTokenScope)
+
+	t.Run("Unscoped", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "alice"}
+		assert.True(t, enf.Enforce(claims, "clusters", "delete", "https://kubernetes.default.svc"))
+		assert.True(t, enf.Enforce(claims, "applications", "sync", "other-proj/my-app"))
+	})
+	t.Run("Scoped", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "alice", TokenScopeClaim: map[string]interface{}{
+			"projects":  []interface{}{"my-*"},
+			"resources": []interface{}{"applications", "projects"},
+			"actions":   []interface{}{"get", "sync", "action/*"},
+		}}
+		assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/my-app"))
+		assert.True(t, enf.Enforce(claims, "applications", "action/apps/Deployment/restart", "my-proj/ns/my-app"))
+		assert.True(t, enf.Enforce(claims, "projects", "get", "my-proj"))
+		// outside of the projects
+		assert.False(t, enf.Enforce(claims, "applications", "sync", "other-proj/my-app"))
+		// outside of the actions
+		assert.False(t, enf.Enforce(claims, "applications", "delete", "my-proj/my-app"))
+		// outside of the resources, even if allowed by the default role
+		assert.False(t, enf.Enforce(claims, "logs", "get", "my-proj/my-app"))
+		// resources which do not belong to projects
+		assert.False(t, enf.Enforce(claims, "clusters", "get", "https://kubernetes.default.svc"))
+
+		exp := enf.Explain(claims, "applications", "delete", "my-proj/my-app")
+		assert.False(t, exp.Allowed)
+		require.Len(t, exp.Subjects, 1)
+		assert.Equal(t, rbac.ExplainOriginTokenScope, exp.Subjects[0].Origin)
+	})
+	t.Run("ScopedProjectToken", func(t *testing.T) {
+		claims := jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234, TokenScopeClaim: map[string]interface{}{"resources": []interface{}{"logs"}}}
+		assert.True(t, enf.Enforce(claims, "logs", "get", "my-proj/my-app"))
+		assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	})
+}
+
 func TestEnforceActionActions(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
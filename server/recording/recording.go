@@ -0,0 +1,240 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/rand"
+)
+
+const (
+	// asciicastVersion is the version of the asciicast format recordings are written in
+	asciicastVersion = 2
+	// defaultWidth and defaultHeight are the terminal size recorded in the header, until the terminal is resized
+	defaultWidth  = 80
+	defaultHeight = 24
+
+	eventOutput = "o"
+	eventInput  = "i"
+	eventResize = "r"
+
+	idTimeFormat = "20060102T150405Z"
+)
+
+var idRegexp = regexp.MustCompile(`^[0-9]{8}T[0-9]{6}Z-[a-zA-Z0-9]+$`)
+
+// Metadata describes a recorded terminal session
+type Metadata struct {
+	// ID is the unique ID of the recording. IDs of recordings sort by their start time.
+	ID string `json:"id"`
+	// User is the user who started the terminal session
+	User string `json:"user"`
+	// Application is the name of the Application of the pod
+	Application string `json:"application"`
+	// AppNamespace is the namespace of the Application
+	AppNamespace string `json:"appNamespace,omitempty"`
+	// Project is the project of the Application
+	Project string `json:"project"`
+	// Namespace is the namespace of the pod
+	Namespace string `json:"namespace"`
+	// Pod is the name of the pod
+	Pod string `json:"pod"`
+	// Container is the name of the container
+	Container string `json:"container"`
+	// Shell is the shell the session was started with, if it was requested explicitly
+	Shell string `json:"shell,omitempty"`
+	// StartedAt is when the session started
+	StartedAt time.Time `json:"startedAt"`
+	// EndedAt is when the session ended
+	EndedAt time.Time `json:"endedAt"`
+	// Size is the size of the recording in bytes
+	Size int64 `json:"size,omitempty"`
+}
+
+// NewID returns a new recording ID for a session started at the given time
+func NewID(startedAt time.Time) (string, error) {
+	suffix, err := rand.StringFromCharset(8, "abcdefghijklmnopqrstuvwxyz0123456789")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s", startedAt.UTC().Format(idTimeFormat), suffix), nil
+}
+
+// IsValidID returns whether the given string is a valid recording ID. Only valid IDs are used to access stores.
+func IsValidID(id string) bool {
+	return idRegexp.MatchString(id)
+}
+
+// header is the first line of an asciicast v2 file. Players ignore unknown keys, so the metadata of the session is
+// stored along with the standard keys.
+type header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	ArgoCD    *Metadata         `json:"argocd,omitempty"`
+}
+
+// Recorder records a terminal session in the asciicast v2 format. Events are written to a temporary file, which is
+// saved to the store when the recorder is closed. Failures to record are logged and never interrupt the session.
+type Recorder struct {
+	lock    sync.Mutex
+	store   Store
+	meta    Metadata
+	file    *os.File
+	err     error
+	closed  bool
+	pending []byte
+}
+
+// NewRecorder starts the recording of a terminal session. The ID and start time of the metadata are set if missing.
+func NewRecorder(store Store, meta Metadata) (*Recorder, error) {
+	if meta.StartedAt.IsZero() {
+		meta.StartedAt = time.Now()
+	}
+	if meta.ID == "" {
+		id, err := NewID(meta.StartedAt)
+		if err != nil {
+			return nil, err
+		}
+		meta.ID = id
+	}
+	file, err := os.CreateTemp("", "terminal-recording-*.cast")
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+	r := &Recorder{store: store, meta: meta, file: file}
+	headerMeta := meta
+	env := map[string]string{"TERM": "xterm"}
+	if meta.Shell != "" {
+		env["SHELL"] = meta.Shell
+	}
+	r.writeLine(header{
+		Version:   asciicastVersion,
+		Width:     defaultWidth,
+		Height:    defaultHeight,
+		Timestamp: meta.StartedAt.Unix(),
+		Title:     fmt.Sprintf("%s@%s/%s/%s", meta.User, meta.Namespace, meta.Pod, meta.Container),
+		Env:       env,
+		ArgoCD:    &headerMeta,
+	})
+	if r.err != nil {
+		r.discard()
+		return nil, r.err
+	}
+	return r, nil
+}
+
+// Metadata returns the metadata of the recording
+func (r *Recorder) Metadata() Metadata {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.meta
+}
+
+// Output records output of the session
+func (r *Recorder) Output(p []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	// output may be split in the middle of a multi-byte character, which is completed by the next chunk
+	data := append(r.pending, p...)
+	data, r.pending = splitIncompleteRune(data)
+	if len(data) > 0 {
+		r.writeEvent(eventOutput, string(data))
+	}
+}
+
+// Input records input of the session
+func (r *Recorder) Input(data string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.writeEvent(eventInput, data)
+}
+
+// Resize records a change of the terminal size
+func (r *Recorder) Resize(cols uint16, rows uint16) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.writeEvent(eventResize, fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Close ends the recording and saves it to the store
+func (r *Recorder) Close(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return nil
+	}
+	if len(r.pending) > 0 {
+		r.writeEvent(eventOutput, string(r.pending))
+	}
+	r.closed = true
+	defer r.discard()
+	if r.err != nil {
+		return r.err
+	}
+	r.meta.EndedAt = time.Now()
+	info, err := r.file.Stat()
+	if err != nil {
+		return err
+	}
+	r.meta.Size = info.Size()
+	if _, err := r.file.Seek(0, 0); err != nil {
+		return err
+	}
+	if err := r.store.Save(ctx, r.meta, r.file); err != nil {
+		return fmt.Errorf("error saving recording %s: %w", r.meta.ID, err)
+	}
+	return nil
+}
+
+func (r *Recorder) writeEvent(code string, data string) {
+	if r.closed {
+		return
+	}
+	r.writeLine([]interface{}{time.Since(r.meta.StartedAt).Seconds(), code, data})
+}
+
+func (r *Recorder) writeLine(v interface{}) {
+	if r.err != nil {
+		return
+	}
+	line, err := json.Marshal(v)
+	if err == nil {
+		_, err = r.file.Write(append(line, '\n'))
+	}
+	if err != nil {
+		r.err = fmt.Errorf("error writing recording %s: %w", r.meta.ID, err)
+		log.Warnf("Stopped recording terminal session: %v", r.err)
+	}
+}
+
+func (r *Recorder) discard() {
+	_ = r.file.Close()
+	if err := os.Remove(r.file.Name()); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Failed to remove recording file %s: %v", r.file.Name(), err)
+	}
+}
+
+// splitIncompleteRune splits an incomplete UTF-8 encoded character off the end of the given bytes
+func splitIncompleteRune(p []byte) ([]byte, []byte) {
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if !utf8.FullRune(p[i:]) {
+				return p[:i], append([]byte(nil), p[i:]...)
+			}
+			break
+		}
+	}
+	return p, nil
+}
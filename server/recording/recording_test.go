@@ -0,0 +1,128 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewID(t *testing.T) {
+	startedAt := time.Date(2024, 5, 1, 12, 30, 45, 0, time.UTC)
+	id, err := NewID(startedAt)
+	require.NoError(t, err)
+	assert.Regexp(t, `^20240501T123045Z-[a-z0-9]{8}$`, id)
+	assert.True(t, IsValidID(id))
+
+	assert.False(t, IsValidID(""))
+	assert.False(t, IsValidID("../20240501T123045Z-abc"))
+	assert.False(t, IsValidID("20240501T123045Z-abc/../x"))
+}
+
+func readRecording(t *testing.T, store Store, id string) (header, [][]interface{}) {
+	t.Helper()
+	reader, err := store.Open(context.Background(), id)
+	require.NoError(t, err)
+	defer reader.Close()
+	scanner := bufio.NewScanner(reader)
+	require.True(t, scanner.Scan())
+	var h header
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &h))
+	var events [][]interface{}
+	for scanner.Scan() {
+		var event []interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	return h, events
+}
+
+func TestRecorder(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	recorder, err := NewRecorder(store, Metadata{User: "alice", Application: "guestbook", AppNamespace: "argocd", Project: "default", Namespace: "guestbook", Pod: "guestbook-ui", Container: "main", Shell: "bash"})
+	require.NoError(t, err)
+	meta := recorder.Metadata()
+	assert.True(t, IsValidID(meta.ID))
+	assert.False(t, meta.StartedAt.IsZero())
+
+	recorder.Resize(120, 40)
+	recorder.Input("ls\r")
+	// "ü" is split across two chunks of output
+	recorder.Output([]byte{'a', 0xc3})
+	recorder.Output([]byte{0xbc, '\n'})
+	recorder.Output([]byte{0xe2, 0x82})
+	require.NoError(t, recorder.Close(context.Background()))
+	require.NoError(t, recorder.Close(context.Background()), "closing twice is a no-op")
+	_, err = os.Stat(recorder.file.Name())
+	assert.True(t, os.IsNotExist(err), "the temporary file is removed")
+
+	saved, err := store.Get(context.Background(), meta.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", saved.User)
+	assert.False(t, saved.EndedAt.Before(saved.StartedAt))
+	assert.Positive(t, saved.Size)
+
+	h, events := readRecording(t, store, meta.ID)
+	assert.Equal(t, 2, h.Version)
+	assert.Equal(t, 80, h.Width)
+	assert.Equal(t, 24, h.Height)
+	assert.Equal(t, meta.StartedAt.Unix(), h.Timestamp)
+	assert.Equal(t, "alice@guestbook/guestbook-ui/main", h.Title)
+	assert.Equal(t, "bash", h.Env["SHELL"])
+	require.NotNil(t, h.ArgoCD)
+	assert.Equal(t, "guestbook", h.ArgoCD.Application)
+
+	require.Len(t, events, 5)
+	var codes, data []string
+	for _, event := range events {
+		require.Len(t, event, 3)
+		assert.IsType(t, float64(0), event[0])
+		codes = append(codes, event[1].(string))
+		data = append(data, event[2].(string))
+	}
+	assert.Equal(t, []string{"r", "i", "o", "o", "o"}, codes)
+	assert.Equal(t, []string{"120x40", "ls\r", "a", "ü\n", "\ufffd\ufffd"}, data)
+}
+
+type failingStore struct {
+	Store
+}
+
+func (failingStore) Save(context.Context, Metadata, io.ReadSeeker) error {
+	return assert.AnError
+}
+
+func TestRecorder_SaveError(t *testing.T) {
+	recorder, err := NewRecorder(failingStore{}, Metadata{User: "alice"})
+	require.NoError(t, err)
+	recorder.Output([]byte("hello"))
+	err = recorder.Close(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+	_, err = os.Stat(recorder.file.Name())
+	assert.True(t, os.IsNotExist(err), "the temporary file is removed")
+}
+
+func TestSplitIncompleteRune(t *testing.T) {
+	for _, tc := range []struct {
+		in       []byte
+		complete []byte
+		rest     []byte
+	}{
+		{in: []byte("abc"), complete: []byte("abc")},
+		{in: []byte("ü"), complete: []byte("ü")},
+		{in: []byte{'a', 0xc3}, complete: []byte("a"), rest: []byte{0xc3}},
+		{in: []byte{0xe2, 0x82}, complete: []byte{}, rest: []byte{0xe2, 0x82}},
+		{in: []byte{'a', 0xff}, complete: []byte{'a', 0xff}},
+	} {
+		complete, rest := splitIncompleteRune(tc.in)
+		assert.Equal(t, tc.complete, complete)
+		assert.Equal(t, tc.rest, rest)
+	}
+}
@@ -0,0 +1,207 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	castSuffix     = ".cast"
+	metadataSuffix = ".json"
+	// appsDir is the directory indexing the metadata of recordings by application
+	appsDir = "apps"
+)
+
+// ErrNotFound is returned if a recording does not exist
+var ErrNotFound = errors.New("recording not found")
+
+// Config is the configuration of terminal session recording
+type Config struct {
+	// Location is where recordings are stored. It is either a local directory, optionally given as file:// URL, or
+	// an S3 bucket with an optional key prefix, given as s3://<bucket>/<prefix>. Recording is disabled if it is empty.
+	Location string
+	// S3Endpoint is the endpoint of S3-compatible object stores. The AWS endpoint of the region is used if it is empty.
+	S3Endpoint string
+	// S3Region is the region of the S3 bucket
+	S3Region string
+}
+
+// Enabled returns whether terminal sessions are recorded
+func (c Config) Enabled() bool {
+	return c.Location != ""
+}
+
+// Store stores recordings of terminal sessions along with their metadata
+type Store interface {
+	// Save stores the recording in the asciicast format read from cast, along with its metadata
+	Save(ctx context.Context, meta Metadata, cast io.ReadSeeker) error
+	// List returns the metadata of the recordings of the application with the given namespace and name, newest first
+	List(ctx context.Context, appNamespace string, app string) ([]Metadata, error)
+	// Get returns the metadata of the recording with the given ID
+	Get(ctx context.Context, id string) (*Metadata, error)
+	// Open returns a reader of the recording with the given ID in the asciicast format
+	Open(ctx context.Context, id string) (io.ReadCloser, error)
+}
+
+// NewStore returns the store of the given config
+func NewStore(config Config) (Store, error) {
+	u, err := url.Parse(config.Location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recording location %q: %w", config.Location, err)
+	}
+	switch u.Scheme {
+	case "s3":
+		return NewS3Store(u.Host, u.Path, config.S3Region, config.S3Endpoint)
+	case "file":
+		return NewLocalStore(u.Path)
+	case "":
+		return NewLocalStore(config.Location)
+	}
+	return nil, fmt.Errorf("invalid recording location %q: unsupported scheme %q", config.Location, u.Scheme)
+}
+
+func sortNewestFirst(items []Metadata) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ID > items[j].ID
+	})
+}
+
+// appIndex returns the path, relative to the root of a store, of the directory indexing the recordings of the
+// application with the given namespace and name
+func appIndex(appNamespace string, app string) (string, error) {
+	for _, name := range []string{appNamespace, app} {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return "", fmt.Errorf("invalid application %s/%s", appNamespace, app)
+		}
+	}
+	return path.Join(appsDir, appNamespace, app), nil
+}
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore returns a store which keeps recordings in the given directory, which is created if needed
+func NewLocalStore(dir string) (Store, error) {
+	if dir == "" {
+		return nil, errors.New("recording directory must not be empty")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating recording directory: %w", err)
+	}
+	return &localStore{dir: dir}, nil
+}
+
+func (s *localStore) path(id string, suffix string) (string, error) {
+	if !IsValidID(id) {
+		return "", ErrNotFound
+	}
+	return filepath.Join(s.dir, id+suffix), nil
+}
+
+func (s *localStore) Save(_ context.Context, meta Metadata, cast io.ReadSeeker) error {
+	castPath, err := s.path(meta.ID, castSuffix)
+	if err != nil {
+		return fmt.Errorf("invalid recording ID %q", meta.ID)
+	}
+	index, err := appIndex(meta.AppNamespace, meta.Application)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(castPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, cast)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	// the metadata is written last, so that only complete recordings are listed
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	metaPath, _ := s.path(meta.ID, metadataSuffix)
+	if err := os.WriteFile(metaPath, data, 0o600); err != nil {
+		return err
+	}
+	indexDir := filepath.Join(s.dir, filepath.FromSlash(index))
+	if err := os.MkdirAll(indexDir, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(indexDir, meta.ID+metadataSuffix), data, 0o600)
+}
+
+func (s *localStore) List(_ context.Context, appNamespace string, app string) ([]Metadata, error) {
+	index, err := appIndex(appNamespace, app)
+	if err != nil {
+		return nil, err
+	}
+	indexDir := filepath.Join(s.dir, filepath.FromSlash(index))
+	entries, err := os.ReadDir(indexDir)
+	if os.IsNotExist(err) {
+		return []Metadata{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	items := []Metadata{}
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), metadataSuffix)
+		if !ok || entry.IsDir() || !IsValidID(id) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(indexDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var meta Metadata
+		if err := json.Unmarshal(data, &meta); err != nil {
+			return nil, fmt.Errorf("error parsing metadata of recording %s: %w", id, err)
+		}
+		items = append(items, meta)
+	}
+	sortNewestFirst(items)
+	return items, nil
+}
+
+func (s *localStore) Get(_ context.Context, id string) (*Metadata, error) {
+	metaPath, err := s.path(id, metadataSuffix)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(metaPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("error parsing metadata of recording %s: %w", id, err)
+	}
+	return &meta, nil
+}
+
+func (s *localStore) Open(_ context.Context, id string) (io.ReadCloser, error) {
+	castPath, err := s.path(id, castSuffix)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(castPath)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
@@ -0,0 +1,176 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+type s3Store struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Store returns a store which keeps recordings in an S3 bucket, below the given key prefix. The endpoint allows
+// to use S3-compatible object stores, e.g. MinIO. Credentials are looked up in the default AWS credential chain, e.g.
+// the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or the role of the service account.
+func NewS3Store(bucket string, prefix string, region string, endpoint string) (Store, error) {
+	if bucket == "" {
+		return nil, errors.New("recording bucket must not be empty")
+	}
+	config := aws.Config{}
+	if region != "" {
+		config.Region = aws.String(region)
+	}
+	if endpoint != "" {
+		config.Endpoint = aws.String(endpoint)
+		// S3-compatible stores usually don't support virtual-hosted-style requests
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: config, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("error creating S3 session: %w", err)
+	}
+	return newS3Store(s3.New(sess), bucket, prefix), nil
+}
+
+func newS3Store(client s3iface.S3API, bucket string, prefix string) *s3Store {
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &s3Store{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *s3Store) key(id string, suffix string) string {
+	return s.prefix + id + suffix
+}
+
+func isNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && (awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound")
+}
+
+func (s *s3Store) Save(ctx context.Context, meta Metadata, cast io.ReadSeeker) error {
+	if !IsValidID(meta.ID) {
+		return fmt.Errorf("invalid recording ID %q", meta.ID)
+	}
+	index, err := appIndex(meta.AppNamespace, meta.Application)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.key(meta.ID, castSuffix)),
+		Body:        cast,
+		ContentType: aws.String("application/x-asciicast"),
+	})
+	if err != nil {
+		return err
+	}
+	// the metadata is written last, so that only complete recordings are listed
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{s.key(meta.ID, metadataSuffix), s.key(index+"/"+meta.ID, metadataSuffix)} {
+		_, err = s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket:      aws.String(s.bucket),
+			Key:         aws.String(key),
+			Body:        strings.NewReader(string(data)),
+			ContentType: aws.String("application/json"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *s3Store) List(ctx context.Context, appNamespace string, app string) ([]Metadata, error) {
+	index, err := appIndex(appNamespace, app)
+	if err != nil {
+		return nil, err
+	}
+	indexPrefix := s.prefix + index + "/"
+	var keys []string
+	err = s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(indexPrefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			key := aws.StringValue(obj.Key)
+			if id, ok := strings.CutSuffix(strings.TrimPrefix(key, indexPrefix), metadataSuffix); ok && IsValidID(id) {
+				keys = append(keys, key)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := []Metadata{}
+	for _, key := range keys {
+		meta, err := s.getMetadata(ctx, key)
+		if errors.Is(err, ErrNotFound) {
+			// deleted since it was listed
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		items = append(items, *meta)
+	}
+	sortNewestFirst(items)
+	return items, nil
+}
+
+func (s *s3Store) Get(ctx context.Context, id string) (*Metadata, error) {
+	if !IsValidID(id) {
+		return nil, ErrNotFound
+	}
+	return s.getMetadata(ctx, s.key(id, metadataSuffix))
+}
+
+// getMetadata returns the metadata stored in the object with the given key
+func (s *s3Store) getMetadata(ctx context.Context, key string) (*Metadata, error) {
+	body, err := s.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	var meta Metadata
+	if err := json.NewDecoder(body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("error parsing metadata of recording %s: %w", key, err)
+	}
+	return &meta, nil
+}
+
+func (s *s3Store) Open(ctx context.Context, id string) (io.ReadCloser, error) {
+	if !IsValidID(id) {
+		return nil, ErrNotFound
+	}
+	return s.get(ctx, s.key(id, castSuffix))
+}
+
+func (s *s3Store) get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if isNotFound(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
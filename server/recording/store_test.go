@@ -0,0 +1,172 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(Config{Location: dir})
+	require.NoError(t, err)
+	assert.Equal(t, &localStore{dir: dir}, store)
+
+	store, err = NewStore(Config{Location: "file://" + dir})
+	require.NoError(t, err)
+	assert.Equal(t, &localStore{dir: dir}, store)
+
+	store, err = NewStore(Config{Location: "s3://recordings/argocd/terminal", S3Region: "eu-west-1", S3Endpoint: "http://minio:9000"})
+	require.NoError(t, err)
+	assert.Equal(t, "recordings", store.(*s3Store).bucket)
+	assert.Equal(t, "argocd/terminal/", store.(*s3Store).prefix)
+
+	_, err = NewStore(Config{Location: "gs://recordings"})
+	require.ErrorContains(t, err, "unsupported scheme")
+	_, err = NewStore(Config{Location: "s3:///prefix"})
+	require.ErrorContains(t, err, "bucket must not be empty")
+}
+
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+	ctx := context.Background()
+	items, err := store.List(ctx, "argocd", "guestbook")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	startedAt := time.Now().UTC().Truncate(time.Second)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		id, err := NewID(startedAt.Add(time.Duration(i) * time.Second))
+		require.NoError(t, err)
+		ids = append(ids, id)
+		meta := Metadata{ID: id, User: "alice", Application: "guestbook", AppNamespace: "argocd", StartedAt: startedAt, EndedAt: startedAt.Add(time.Minute), Size: 14}
+		require.NoError(t, store.Save(ctx, meta, strings.NewReader(`{"version":2}`+"\n")))
+	}
+	otherID, err := NewID(startedAt)
+	require.NoError(t, err)
+	require.NoError(t, store.Save(ctx, Metadata{ID: otherID, User: "bob", Application: "guestbook", AppNamespace: "other"}, strings.NewReader(`{"version":2}`+"\n")))
+
+	items, err = store.List(ctx, "argocd", "guestbook")
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, []string{ids[2], ids[1], ids[0]}, []string{items[0].ID, items[1].ID, items[2].ID})
+	assert.Equal(t, "alice", items[0].User)
+	assert.Equal(t, startedAt, items[0].StartedAt.UTC())
+
+	meta, err := store.Get(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, "guestbook", meta.Application)
+	assert.Equal(t, int64(14), meta.Size)
+
+	reader, err := store.Open(ctx, ids[1])
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, `{"version":2}`+"\n", string(data))
+
+	missing, err := NewID(startedAt)
+	require.NoError(t, err)
+	for _, id := range []string{missing, "../secret", ""} {
+		_, err = store.Get(ctx, id)
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = store.Open(ctx, id)
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	require.Error(t, store.Save(ctx, Metadata{ID: "../secret", Application: "guestbook", AppNamespace: "argocd"}, strings.NewReader("")))
+	require.Error(t, store.Save(ctx, Metadata{ID: missing, Application: "guestbook"}, strings.NewReader("")))
+	require.Error(t, store.Save(ctx, Metadata{ID: missing, Application: "../guestbook", AppNamespace: "argocd"}, strings.NewReader("")))
+	_, err = store.List(ctx, "argocd", "..")
+	require.Error(t, err)
+
+	items, err = store.List(ctx, "other", "guestbook")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, otherID, items[0].ID)
+}
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir() + "/recordings")
+	require.NoError(t, err)
+	testStore(t, store)
+}
+
+// fakeS3 keeps objects in memory and implements the S3 API calls used by the store
+type fakeS3 struct {
+	s3iface.S3API
+	lock    sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	data, ok := f.objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "The specified key does not exist.", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (f *fakeS3) ListObjectsV2PagesWithContext(_ aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	f.lock.Lock()
+	var keys []string
+	for key := range f.objects {
+		bucket, name, _ := strings.Cut(key, "/")
+		if bucket == aws.StringValue(input.Bucket) && strings.HasPrefix(name, aws.StringValue(input.Prefix)) {
+			keys = append(keys, name)
+		}
+	}
+	f.lock.Unlock()
+	sort.Strings(keys)
+	// return one object per page to exercise pagination
+	for i, key := range keys {
+		if !fn(&s3.ListObjectsV2Output{Contents: []*s3.Object{{Key: aws.String(key)}}}, i == len(keys)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func TestS3Store(t *testing.T) {
+	client := &fakeS3{objects: map[string][]byte{
+		"recordings/other/20240501T123045Z-abcdefgh.json": []byte(`{}`),
+	}}
+	store := newS3Store(client, "recordings", "/argocd/terminal/")
+	testStore(t, store)
+	var keys []string
+	for key := range client.objects {
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "recordings/other/") {
+			assert.Regexp(t, `^recordings/argocd/terminal/(apps/[a-z]+/guestbook/)?[0-9]{8}T[0-9]{6}Z-[a-z0-9]+\.(cast|json)$`, key)
+		}
+	}
+	assert.Len(t, keys, 13)
+}
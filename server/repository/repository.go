@@ -159,12 +159,18 @@ func (s *Server) Get(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.R
 		Username:                   repo.Username,
 		Insecure:                   repo.IsInsecure(),
 		EnableLFS:                  repo.EnableLFS,
+		PartialClone:               repo.PartialClone,
+		SparseCheckout:             repo.SparseCheckout,
 		GithubAppId:                repo.GithubAppId,
 		GithubAppInstallationId:    repo.GithubAppInstallationId,
 		GitHubAppEnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
 		Proxy:                      repo.Proxy,
 		Project:                    repo.Project,
 		InheritedCreds:             repo.InheritedCreds,
+		TokenProviderURL:           repo.TokenProviderURL,
+		TokenProviderClientID:      repo.TokenProviderClientID,
+		TokenProviderAudience:      repo.TokenProviderAudience,
+		TokenProviderScope:         repo.TokenProviderScope,
 	}
 
 	item.ConnectionState = s.getConnectionState(ctx, item.Repo, item.Project, q.ForceRefresh)
@@ -195,6 +201,8 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 				Insecure:           repo.IsInsecure(),
 				EnableLFS:          repo.EnableLFS,
 				EnableOCI:          repo.EnableOCI,
+				PartialClone:       repo.PartialClone,
+				SparseCheckout:     repo.SparseCheckout,
 				Proxy:              repo.Proxy,
 				NoProxy:            repo.NoProxy,
 				Project:            repo.Project,
@@ -570,6 +578,10 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		GitHubAppEnterpriseBaseURL: q.GithubAppEnterpriseBaseUrl,
 		Proxy:                      q.Proxy,
 		GCPServiceAccountKey:       q.GcpServiceAccountKey,
+		TokenProviderURL:           q.TokenProviderURL,
+		TokenProviderClientID:      q.TokenProviderClientID,
+		TokenProviderAudience:      q.TokenProviderAudience,
+		TokenProviderScope:         q.TokenProviderScope,
 	}
 
 	// If repo does not have credentials, check if there are credentials stored
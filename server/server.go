@@ -73,6 +73,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	signingkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/signingkey"
 	versionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -83,6 +84,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/account"
 	"github.com/argoproj/argo-cd/v2/server/application"
 	"github.com/argoproj/argo-cd/v2/server/applicationset"
+	"github.com/argoproj/argo-cd/v2/server/audit"
 	"github.com/argoproj/argo-cd/v2/server/badge"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/certificate"
@@ -93,11 +95,14 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/metrics"
 	"github.com/argoproj/argo-cd/v2/server/notification"
 	"github.com/argoproj/argo-cd/v2/server/project"
+	"github.com/argoproj/argo-cd/v2/server/ratelimit"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/server/recording"
 	"github.com/argoproj/argo-cd/v2/server/repocreds"
 	"github.com/argoproj/argo-cd/v2/server/repository"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/server/settings"
+	"github.com/argoproj/argo-cd/v2/server/signingkey"
 	"github.com/argoproj/argo-cd/v2/server/version"
 	"github.com/argoproj/argo-cd/v2/ui"
 	"github.com/argoproj/argo-cd/v2/util/assets"
@@ -198,6 +203,9 @@ type ArgoCDServer struct {
 	configMapInformer cache.SharedIndexInformer
 	serviceSet        *ArgoCDServiceSet
 	extensionManager  *extension.Manager
+	auditor           *audit.Auditor
+	recordingStore    recording.Store
+	rateLimiter       *ratelimit.Limiter
 }
 
 type ArgoCDServerOpts struct {
@@ -230,6 +238,9 @@ type ArgoCDServerOpts struct {
 	EnableProxyExtension    bool
 	WebhookParallelism      int
 	EnableK8sEvent          []string
+	AuditLog                audit.Config
+	TerminalRecording       recording.Config
+	RateLimits              map[string]ratelimit.Limit
 }
 
 type ApplicationSetOpts struct {
@@ -250,6 +261,16 @@ type HTTPMetricsRegistry interface {
 	// between Argo CD API Server and the extension backend service for the given
 	// extension.
 	ObserveExtensionRequestDuration(extension string, duration time.Duration)
+	// IncExtensionRateLimitedRequests will increase the counter of requests
+	// to the given extension rejected because of its rate limit.
+	IncExtensionRateLimitedRequests(extension string)
+	// IncExtensionCacheRequests will increase the counter of GET requests to
+	// the given extension with the given cache result (hit or miss).
+	IncExtensionCacheRequests(extension string, result string)
+	// IncExtensionCircuitBreakerRejectedRequests will increase the counter of
+	// requests to the given extension rejected because the circuit of the
+	// backend service is open.
+	IncExtensionCircuitBreakerRejectedRequests(extension string)
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -306,7 +327,10 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
+	policyEnf.SetApplicationLister(appLister, opts.Namespace)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	enf.SetClaimsExplainerFunc(policyEnf.ExplainClaims)
+	enf.SetClaimsScopeFunc(policyEnf.EnforceTokenScope)
 
 	var staticFS fs.FS = io.NewSubDirFS("dist/app", ui.Embedded)
 	if opts.StaticAssetsDir != "" {
@@ -521,6 +545,21 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	if a.RedisClient != nil {
 		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
 	}
+	if a.AuditLog.Enabled() {
+		auditor, err := audit.NewAuditorFromConfig(a.AuditLog, metricsServ)
+		errorsutil.CheckError(err)
+		auditor.Start(ctx)
+		a.auditor = auditor
+	}
+	if a.TerminalRecording.Enabled() {
+		store, err := recording.NewStore(a.TerminalRecording)
+		errorsutil.CheckError(err)
+		a.recordingStore = store
+	}
+	a.rateLimiter = ratelimit.NewLimiter(a.RateLimits, a.RedisClient, metricsServ)
+	if a.rateLimiter.Enabled() {
+		log.Infof("Enforcing API rate limits: %s", strings.Join(a.rateLimiter.Limits(), ", "))
+	}
 
 	svcSet := newArgoCDServiceSet(a)
 	a.serviceSet = svcSet
@@ -798,8 +837,11 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		otelgrpc.StreamServerInterceptor(), //nolint:staticcheck // TODO: ignore SA1019 for depreciation: see https://github.com/argoproj/argo-cd/issues/18258
 		grpc_logrus.StreamServerInterceptor(a.log),
 		grpc_prometheus.StreamServerInterceptor,
-		grpc_auth.StreamServerInterceptor(a.Authenticate),
+		// the audit log records calls rejected by the authentication and the rate limiter as well
+		audit.StreamServerInterceptor(a.auditor, a.policyEnforcer.GetScopes),
+		grpc_auth.StreamServerInterceptor(audit.RecordAuthentication(a.Authenticate)),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
+		ratelimit.StreamServerInterceptor(a.rateLimiter),
 		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx context.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
@@ -812,8 +854,11 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		otelgrpc.UnaryServerInterceptor(), //nolint:staticcheck // TODO: ignore SA1019 for depreciation: see https://github.com/argoproj/argo-cd/issues/18258
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_prometheus.UnaryServerInterceptor,
-		grpc_auth.UnaryServerInterceptor(a.Authenticate),
+		// the audit log records calls rejected by the authentication and the rate limiter as well
+		audit.UnaryServerInterceptor(a.auditor, a.policyEnforcer.GetScopes),
+		grpc_auth.UnaryServerInterceptor(audit.RecordAuthentication(a.Authenticate)),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
+		ratelimit.UnaryServerInterceptor(a.rateLimiter),
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx context.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
@@ -836,6 +881,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 	accountpkg.RegisterAccountServiceServer(grpcS, a.serviceSet.AccountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, a.serviceSet.CertificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, a.serviceSet.GpgkeyService)
+	signingkeypkg.RegisterSigningKeyServiceServer(grpcS, a.serviceSet.SigningkeyService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	grpc_prometheus.Register(grpcS)
@@ -857,6 +903,7 @@ type ArgoCDServiceSet struct {
 	NotificationService   notificationpkg.NotificationServiceServer
 	CertificateService    *certificate.Server
 	GpgkeyService         *gpgkey.Server
+	SigningkeyService     *signingkey.Server
 	VersionService        *version.Server
 }
 
@@ -869,7 +916,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, a.enf, loginRateLimiter)
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -888,6 +935,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.projInformer,
 		a.ApplicationNamespaces,
 		a.EnableK8sEvent,
+		a.recordingStore,
+		a.sessionMgr,
 	)
 
 	applicationSetService := applicationset.NewServer(
@@ -921,6 +970,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	notificationService := notification.NewServer(a.apiFactory)
 	certificateService := certificate.NewServer(a.RepoClientset, a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.RepoClientset, a.db, a.enf)
+	signingkeyService := signingkey.NewServer(a.db, a.enf)
 	versionService := version.NewServer(a, func() (bool, error) {
 		if a.DisableAuth {
 			return true, nil
@@ -946,6 +996,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		NotificationService:   notificationService,
 		CertificateService:    certificateService,
 		GpgkeyService:         gpgkeyService,
+		SigningkeyService:     signingkeyService,
 		VersionService:        versionService,
 	}
 }
@@ -1034,7 +1085,8 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	gwHeaderOpts := runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher)
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwHeaderOpts)
 
 	var handler http.Handler = gwmux
 	if a.EnableGZip {
@@ -1047,11 +1099,17 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	}
 	mux.Handle("/api/", handler)
 
-	terminalOpts := application.TerminalOptions{DisableAuth: a.ArgoCDServerOpts.DisableAuth, Enf: a.enf}
+	terminalOpts := application.TerminalOptions{
+		DisableAuth:    a.ArgoCDServerOpts.DisableAuth,
+		Enf:            a.enf,
+		RecordingStore: a.recordingStore,
+		Auditor:        a.auditor,
+		GetScopes:      a.policyEnforcer.GetScopes,
+	}
 
 	terminal := application.NewHandler(a.appLister, a.Namespace, a.ApplicationNamespaces, a.db, a.Cache, appResourceTreeFn, a.settings.ExecShells, a.sessionMgr, &terminalOpts).
 		WithFeatureFlagMiddleware(a.settingsMgr.GetSettings)
-	th := util_session.WithAuthMiddleware(a.DisableAuth, a.sessionMgr, terminal)
+	th := util_session.WithAuthMiddleware(a.DisableAuth, a.sessionMgr, ratelimit.HTTPMiddleware(a.rateLimiter, "/terminal", terminal))
 	mux.Handle("/terminal", th)
 
 	// Proxy extension is currently an alpha feature and is disabled
@@ -1076,6 +1134,7 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	mustRegisterGWHandler(accountpkg.RegisterAccountServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(certificatepkg.RegisterCertificateServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(gpgkeypkg.RegisterGPGKeyServiceHandler, ctx, gwmux, conn)
+	mustRegisterGWHandler(signingkeypkg.RegisterSigningKeyServiceHandler, ctx, gwmux, conn)
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", a.RootPath)
@@ -1127,6 +1186,15 @@ func enforceContentTypes(handler http.Handler, types []string) http.Handler {
 	})
 }
 
+// outgoingHeaderMatcher forwards the retry-after header of calls rejected by rate limits as the standard HTTP header,
+// and all other gRPC headers with the default prefix of grpc-gateway
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, ratelimit.RetryAfterHeader) {
+		return ratelimit.RetryAfterHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 // registerExtensions will try to register all configured extensions
 // in the given mux. If any error is returned while registering
 // extensions handlers, no route will be added in the given mux.
@@ -1135,9 +1203,11 @@ func registerExtensions(mux *http.ServeMux, a *ArgoCDServer, metricsReg HTTPMetr
 	extHandler := http.HandlerFunc(a.extensionManager.CallExtension())
 	authMiddleware := a.sessionMgr.AuthMiddlewareFunc(a.DisableAuth)
 	// auth middleware ensures that requests to all extensions are authenticated first
-	mux.Handle(fmt.Sprintf("%s/", extension.URLPrefix), authMiddleware(extHandler))
+	mux.Handle(fmt.Sprintf("%s/", extension.URLPrefix), authMiddleware(ratelimit.HTTPMiddleware(a.rateLimiter, extension.URLPrefix, extHandler)))
 
 	a.extensionManager.AddMetricsRegistry(metricsReg)
+	a.extensionManager.AddAuditor(a.auditor, a.policyEnforcer.GetScopes)
+	a.extensionManager.AddRedisClient(a.RedisClient)
 
 	err := a.extensionManager.RegisterExtensions()
 	if err != nil {
@@ -3,16 +3,21 @@ package session
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	util "github.com/argoproj/argo-cd/v2/util/io"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
 )
 
@@ -22,6 +27,7 @@ type Server struct {
 	settingsMgr        *settings.SettingsManager
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
+	enf                *rbac.Enforcer
 	limitLoginAttempts func() (util.Closer, error)
 }
 
@@ -30,8 +36,8 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, enf *rbac.Enforcer, rateLimiter func() (util.Closer, error)) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, enf, rateLimiter}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
@@ -70,6 +76,15 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if err != nil {
 		return nil, err
 	}
+	issuedAt := time.Now().Truncate(time.Second)
+	s.mgr.TrackSession(context.Background(), sessionmgr.SessionInfo{
+		ID:        uniqueId.String(),
+		Subject:   q.Username,
+		Username:  q.Username,
+		Issuer:    sessionmgr.SessionManagerClaimsIssuer,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(argoCDSettings.UserSessionDuration),
+	})
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
@@ -96,3 +111,106 @@ func (s *Server) GetUserInfo(ctx context.Context, q *session.GetUserInfoRequest)
 		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes()),
 	}, nil
 }
+
+// sessionsSubject returns the subject whose sessions are requested, which defaults to the current user. Users always
+// have access to their own sessions, while access to the sessions of other users requires the permission to perform
+// the given action on accounts.
+func (s *Server) sessionsSubject(ctx context.Context, subject string, action string) (string, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return "", status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	current := sessionmgr.Sub(ctx)
+	if subject == "" || subject == current {
+		if _, _, ok := rbacpolicy.GetProjectRoleFromSubject(current); ok {
+			return "", status.Errorf(codes.InvalidArgument, "project tokens do not have sessions")
+		}
+		return current, nil
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, action, subject); err != nil {
+		return "", fmt.Errorf("permission denied for sessions of %s: %w", subject, err)
+	}
+	return subject, nil
+}
+
+func toApiSessions(ctx context.Context, sessions []sessionmgr.SessionInfo) *session.UserSessionList {
+	currentID := ""
+	if claims, ok := ctx.Value("claims").(jwt.Claims); ok {
+		if mapClaims, err := jwtutil.MapClaims(claims); err == nil {
+			currentID = jwtutil.StringField(mapClaims, "jti")
+		}
+	}
+	res := &session.UserSessionList{Items: make([]*session.UserSession, 0, len(sessions))}
+	for _, s := range sessions {
+		res.Items = append(res.Items, &session.UserSession{
+			Id:        s.ID,
+			Subject:   s.Subject,
+			Username:  s.Username,
+			Issuer:    s.Issuer,
+			IssuedAt:  s.IssuedAt.Unix(),
+			ExpiresAt: s.ExpiresAt.Unix(),
+			Current:   currentID != "" && s.ID == currentID,
+		})
+	}
+	return res
+}
+
+// ListSessions returns the sessions of a user
+func (s *Server) ListSessions(ctx context.Context, q *session.SessionListRequest) (*session.UserSessionList, error) {
+	// sessions are credentials, so listing the sessions of other users requires the same permission as revoking them
+	subject, err := s.sessionsSubject(ctx, q.Subject, rbacpolicy.ActionUpdate)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.mgr.GetSessions(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions of %s: %w", subject, err)
+	}
+	return toApiSessions(ctx, sessions), nil
+}
+
+// RevokeSessions revokes either a single session or all sessions of a user issued before a given time
+func (s *Server) RevokeSessions(ctx context.Context, q *session.SessionRevokeRequest) (*session.UserSessionList, error) {
+	subject, err := s.sessionsSubject(ctx, q.Subject, rbacpolicy.ActionUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if q.Id != "" && q.IssuedBefore != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "either the session id or the issue time must be specified, not both")
+	}
+
+	if q.Id != "" {
+		sessions, err := s.mgr.GetSessions(ctx, subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sessions of %s: %w", subject, err)
+		}
+		for _, userSession := range sessions {
+			if userSession.ID != q.Id {
+				continue
+			}
+			if err := s.mgr.RevokeToken(ctx, userSession.ID, time.Until(userSession.ExpiresAt)); err != nil {
+				return nil, fmt.Errorf("failed to revoke session %s: %w", userSession.ID, err)
+			}
+			log.Infof("Session %s of %s revoked by %s", userSession.ID, subject, sessionmgr.Username(ctx))
+			return toApiSessions(ctx, []sessionmgr.SessionInfo{userSession}), nil
+		}
+		return nil, status.Errorf(codes.NotFound, "session %s of %s not found", q.Id, subject)
+	}
+
+	issuedBefore := time.Now()
+	if q.IssuedBefore != 0 {
+		if q.IssuedBefore > issuedBefore.Unix() {
+			return nil, status.Errorf(codes.InvalidArgument, "sessions issued in the future cannot be revoked")
+		}
+		issuedBefore = time.Unix(q.IssuedBefore, 0)
+	}
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.mgr.RevokeSessions(ctx, subject, issuedBefore, argoCDSettings.UserSessionDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions of %s: %w", subject, err)
+	}
+	log.Infof("%d sessions of %s issued before %s revoked by %s", len(revoked), subject, issuedBefore.Format(time.RFC3339), sessionmgr.Username(ctx))
+	return toApiSessions(ctx, revoked), nil
+}
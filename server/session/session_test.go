@@ -0,0 +1,146 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "argocd"
+
+func newTestSessionServer(t *testing.T) *Server {
+	t.Helper()
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"accounts.alice": "login"},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	sessionMgr := sessionmgr.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionmgr.NewUserStateStorage(nil))
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	require.NoError(t, enf.SetUserPolicy("g, admin, role:admin\ng, reader, role:readonly"))
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, test.NewFakeProjLister())
+	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+
+	now := time.Now()
+	for _, s := range []sessionmgr.SessionInfo{
+		{ID: "alice-1", Subject: "alice", IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(time.Hour)},
+		{ID: "alice-2", Subject: "alice", IssuedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+		{ID: "admin-1", Subject: "admin", IssuedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+	} {
+		sessionMgr.TrackSession(context.Background(), s)
+	}
+	return NewServer(sessionMgr, settingsMgr, nil, policyEnf, enf, nil)
+}
+
+func userContext(subject string, id string) context.Context {
+	// nolint:staticcheck
+	return context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: subject, ID: id, Issuer: sessionmgr.SessionManagerClaimsIssuer})
+}
+
+func sessionIDs(list *session.UserSessionList) []string {
+	var ids []string
+	for _, s := range list.Items {
+		ids = append(ids, s.Id)
+	}
+	return ids
+}
+
+func TestListSessions(t *testing.T) {
+	s := newTestSessionServer(t)
+
+	t.Run("own sessions", func(t *testing.T) {
+		list, err := s.ListSessions(userContext("alice", "alice-2"), &session.SessionListRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-2", "alice-1"}, sessionIDs(list))
+		assert.True(t, list.Items[0].Current)
+		assert.False(t, list.Items[1].Current)
+	})
+	t.Run("sessions of other users require permission", func(t *testing.T) {
+		_, err := s.ListSessions(userContext("alice", ""), &session.SessionListRequest{Subject: "admin"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// read-only users may get accounts, but must not see the sessions of other users
+		_, err = s.ListSessions(userContext("reader", ""), &session.SessionListRequest{Subject: "alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		list, err := s.ListSessions(userContext("admin", ""), &session.SessionListRequest{Subject: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-2", "alice-1"}, sessionIDs(list))
+	})
+	t.Run("not logged in", func(t *testing.T) {
+		_, err := s.ListSessions(context.Background(), &session.SessionListRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("project token", func(t *testing.T) {
+		_, err := s.ListSessions(userContext("proj:default:ci", ""), &session.SessionListRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRevokeSessions(t *testing.T) {
+	t.Run("single session", func(t *testing.T) {
+		s := newTestSessionServer(t)
+		ctx := userContext("alice", "alice-2")
+		revoked, err := s.RevokeSessions(ctx, &session.SessionRevokeRequest{Id: "alice-1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-1"}, sessionIDs(revoked))
+		list, err := s.ListSessions(ctx, &session.SessionListRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-2"}, sessionIDs(list))
+
+		_, err = s.RevokeSessions(ctx, &session.SessionRevokeRequest{Id: "admin-1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("issued before", func(t *testing.T) {
+		s := newTestSessionServer(t)
+		ctx := userContext("admin", "admin-1")
+		revoked, err := s.RevokeSessions(ctx, &session.SessionRevokeRequest{Subject: "alice", IssuedBefore: time.Now().Add(-90 * time.Minute).Unix()})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-1"}, sessionIDs(revoked))
+
+		_, err = s.RevokeSessions(ctx, &session.SessionRevokeRequest{Subject: "alice", IssuedBefore: time.Now().Add(time.Hour).Unix()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("all sessions of other users require permission", func(t *testing.T) {
+		s := newTestSessionServer(t)
+		_, err := s.RevokeSessions(userContext("alice", ""), &session.SessionRevokeRequest{Subject: "admin"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		revoked, err := s.RevokeSessions(userContext("admin", ""), &session.SessionRevokeRequest{Subject: "alice"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice-2", "alice-1"}, sessionIDs(revoked))
+		list, err := s.ListSessions(userContext("alice", ""), &session.SessionListRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+}
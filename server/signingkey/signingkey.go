@@ -0,0 +1,110 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	signingkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/signingkey"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+// Server provides a service of type SigningKeyService. Access to SSH signing keys is controlled by the RBAC
+// permissions for GnuPG keys, since both are used to verify commit signatures.
+type Server struct {
+	db  db.ArgoDB
+	enf *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the service with type SigningKeyService
+func NewServer(
+	db db.ArgoDB,
+	enf *rbac.Enforcer,
+) *Server {
+	return &Server{
+		db:  db,
+		enf: enf,
+	}
+}
+
+// List returns a list of SSH signing keys in the configuration
+func (s *Server) List(ctx context.Context, q *signingkeypkg.SSHSigningKeyQuery) (*appsv1.SSHSigningKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	keys, err := s.db.ListConfiguredSSHSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyList := &appsv1.SSHSigningKeyList{}
+	for _, v := range keys {
+		// Remove key's data from list result to save some bytes
+		v.KeyData = ""
+		keyList.Items = append(keyList.Items, *v)
+	}
+	return keyList, nil
+}
+
+// Get retrieves a single SSH signing key from the configuration
+func (s *Server) Get(ctx context.Context, q *signingkeypkg.SSHSigningKeyQuery) (*appsv1.SSHSigningKey, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(q.Fingerprint, "SHA256:") {
+		return nil, fmt.Errorf("Fingerprint is malformed or empty")
+	}
+
+	keys, err := s.db.ListConfiguredSSHSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := keys[q.Fingerprint]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("No such key: %s", q.Fingerprint)
+}
+
+// Create adds one or more SSH signing keys to the server's configuration
+func (s *Server) Create(ctx context.Context, q *signingkeypkg.SSHSigningKeyCreateRequest) (*signingkeypkg.SSHSigningKeyCreateResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+
+	if q.Signingkey == nil || strings.TrimSpace(q.Signingkey.KeyData) == "" {
+		return nil, fmt.Errorf("Submitted key data is empty")
+	}
+
+	added, skipped, err := s.db.AddSSHSigningKeys(ctx, q.Signingkey.KeyData, q.Upsert)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]appsv1.SSHSigningKey, 0)
+	for _, k := range added {
+		items = append(items, *k)
+	}
+
+	return &signingkeypkg.SSHSigningKeyCreateResponse{
+		Created: &appsv1.SSHSigningKeyList{Items: items},
+		Skipped: skipped,
+	}, nil
+}
+
+// Delete removes a single SSH signing key from the server's configuration
+func (s *Server) Delete(ctx context.Context, q *signingkeypkg.SSHSigningKeyQuery) (*signingkeypkg.SSHSigningKeyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteSSHSigningKey(ctx, q.Fingerprint); err != nil {
+		return nil, err
+	}
+
+	return &signingkeypkg.SSHSigningKeyResponse{}, nil
+}
@@ -41,6 +41,7 @@ export interface ResourceTreeNode extends models.ResourceNode {
     hook?: boolean;
     root?: ResourceTreeNode;
     requiresPruning?: boolean;
+    syncPolicy?: models.ResourceSyncPolicy;
     orphaned?: boolean;
     podGroup?: PodGroup;
     isExpanded?: boolean;
@@ -478,6 +479,7 @@ function renderPodGroup(props: ApplicationResourceTreeProps, id: string, node: R
                             'application-resource-tree__node-status-icon--offset': rootNode
                         })}>
                         {node.hook && <i title='Resource lifecycle hook' className='fa fa-anchor' />}
+                        {node.syncPolicy && <i title={syncPolicyTitle(node.syncPolicy)} className='fa fa-hand-paper' />}
                         {healthState != null && <HealthStatusIcon state={healthState} />}
                         {comparisonStatus != null && <ComparisonStatusIcon status={comparisonStatus} resource={!rootNode && node} />}
                         {appNode && !rootNode && (
@@ -648,6 +650,20 @@ function renderPodGroupByStatus(props: ApplicationResourceTreeProps, node: any,
     );
 }
 
+function syncPolicyTitle(policy: models.ResourceSyncPolicy) {
+    if (policy.manualSyncOnly) {
+        return 'Sync policy: manual sync only';
+    }
+    const overrides: string[] = [];
+    if (policy.selfHealDisabled) {
+        overrides.push('no self-heal');
+    }
+    if (policy.autoPruneDisabled) {
+        overrides.push('no auto-prune');
+    }
+    return `Sync policy: ${overrides.join(', ')}`;
+}
+
 function expandCollapse(node: ResourceTreeNode, props: ApplicationResourceTreeProps) {
     const isExpanded = !props.getNodeExpansion(node.uid);
     node.isExpanded = isExpanded;
@@ -757,6 +773,7 @@ function renderResourceNode(props: ApplicationResourceTreeProps, id: string, nod
                         'application-resource-tree__node-status-icon--offset': rootNode
                     })}>
                     {node.hook && <i title='Resource lifecycle hook' className='fa fa-anchor' />}
+                    {node.syncPolicy && <i title={syncPolicyTitle(node.syncPolicy)} className='fa fa-hand-paper' />}
                     {healthState != null && <HealthStatusIcon state={healthState} />}
                     {comparisonStatus != null && <ComparisonStatusIcon status={comparisonStatus} resource={!rootNode && node} />}
                     {appNode && !rootNode && (
@@ -888,6 +905,7 @@ export const ApplicationResourceTree = (props: ApplicationResourceTreeProps) =>
                 resourceNode.status = status.status;
                 resourceNode.hook = status.hook;
                 resourceNode.requiresPruning = status.requiresPruning;
+                resourceNode.syncPolicy = status.syncPolicy;
             }
             nodeByKey.set(treeNodeKey(node), resourceNode);
         });
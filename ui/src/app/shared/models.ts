@@ -44,6 +44,12 @@ export interface RetryBackoff {
 export interface RetryStrategy {
     limit: number;
     backoff: RetryBackoff;
+    onTimeout?: boolean;
+}
+
+export interface SyncTimeout {
+    operation?: string;
+    hook?: string;
 }
 
 export interface RollbackOperation {
@@ -82,6 +88,7 @@ export interface OperationState {
     syncResult: SyncOperationResult;
     startedAt: models.Time;
     finishedAt: models.Time;
+    attemptStartedAt?: models.Time;
 }
 
 export type HookType = 'PreSync' | 'Sync' | 'PostSync' | 'SyncFail' | 'Skip';
@@ -272,6 +279,7 @@ export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    timeout?: SyncTimeout;
 }
 
 export interface Info {
@@ -356,6 +364,19 @@ export interface ResourceStatus {
     requiresPruning?: boolean;
     syncWave?: number;
     orphaned?: boolean;
+    drift?: ResourceDrift;
+    syncPolicy?: ResourceSyncPolicy;
+}
+
+export interface ResourceSyncPolicy {
+    manualSyncOnly?: boolean;
+    selfHealDisabled?: boolean;
+    autoPruneDisabled?: boolean;
+}
+
+export interface ResourceDrift {
+    startedAt: models.Time;
+    managers?: string[];
 }
 
 export interface ResourceRef {
@@ -551,6 +572,8 @@ export interface Repository {
     githubAppId?: string;
     forceHttpBasicAuth?: boolean;
     enableOCI: boolean;
+    partialClone?: boolean;
+    sparseCheckout?: boolean;
 }
 
 export interface RepositoryList extends ItemsList<Repository> {}
@@ -44,6 +44,11 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	// Sync approval events record the lifecycle of syncs which need to be approved by a second person
+	EventReasonSyncApprovalRequested = "SyncApprovalRequested"
+	EventReasonSyncApproved          = "SyncApproved"
+	EventReasonSyncRejected          = "SyncRejected"
+	EventReasonSyncApprovalExpired   = "SyncApprovalExpired"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -0,0 +1,281 @@
+package argo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+// RedactedHelmValue replaces the values read from Secrets in manifests returned to users
+const RedactedHelmValue = "++++++++"
+
+// GetHelmValuesFrom reads the Helm values from the ConfigMaps and Secrets referenced by the given source of the given
+// application. Resources in the cluster Argo CD is running in are read using kubeClient, by default from the namespace
+// of the application. Resources in the destination cluster are read using the client returned by
+// getDestinationClient, which is only called if needed, by default from the destination namespace. References whose
+// namespace cannot be resolved this way are an error rather than being read from any other namespace.
+func GetHelmValuesFrom(ctx context.Context, app *argoappv1.Application, source *argoappv1.ApplicationSource, proj *argoappv1.AppProject, kubeClient kubernetes.Interface, getDestinationClient func() (kubernetes.Interface, error)) ([]*apiclient.HelmValues, error) {
+	if source.Helm == nil || len(source.Helm.ValuesFrom) == 0 {
+		return nil, nil
+	}
+	var destinationClient kubernetes.Interface
+	var res []*apiclient.HelmValues
+	for _, ref := range source.Helm.ValuesFrom {
+		client := kubeClient
+		namespace := ref.Namespace
+		if ref.Destination {
+			if destinationClient == nil {
+				var err error
+				if destinationClient, err = getDestinationClient(); err != nil {
+					return nil, fmt.Errorf("error getting client of destination cluster: %w", err)
+				}
+			}
+			client = destinationClient
+			if namespace == "" {
+				namespace = app.Spec.Destination.Namespace
+			}
+		} else if namespace == "" {
+			namespace = app.Namespace
+		}
+		if namespace == "" {
+			return nil, fmt.Errorf("namespace of helm values from %s %s cannot be resolved: it must be set if the application has no namespace or destination namespace", ref.Kind, ref.Name)
+		}
+		if !proj.IsHelmValuesFromPermitted(ref, namespace) {
+			return nil, fmt.Errorf("helm values from %s %s/%s are not permitted in project '%s'", ref.Kind, namespace, ref.Name, proj.Name)
+		}
+		values, found, err := readHelmValues(ctx, client, ref, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			if ref.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("key %q of %s %s/%s not found", ref.GetKey(), ref.Kind, namespace, ref.Name)
+		}
+		res = append(res, &apiclient.HelmValues{Values: values, Sensitive: ref.Kind == argoappv1.HelmValuesFromKindSecret})
+	}
+	return res, nil
+}
+
+// readHelmValues reads the key of the referenced ConfigMap or Secret. Returns false if the resource or the key does
+// not exist.
+func readHelmValues(ctx context.Context, client kubernetes.Interface, ref argoappv1.HelmValuesFromSource, namespace string) (string, bool, error) {
+	switch ref.Kind {
+	case argoappv1.HelmValuesFromKindConfigMap:
+		cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return "", false, nil
+		} else if err != nil {
+			return "", false, fmt.Errorf("error getting ConfigMap %s/%s: %w", namespace, ref.Name, err)
+		}
+		values, ok := cm.Data[ref.GetKey()]
+		return values, ok, nil
+	case argoappv1.HelmValuesFromKindSecret:
+		secret, err := client.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return "", false, nil
+		} else if err != nil {
+			return "", false, fmt.Errorf("error getting Secret %s/%s: %w", namespace, ref.Name, err)
+		}
+		values, ok := secret.Data[ref.GetKey()]
+		return string(values), ok, nil
+	default:
+		return "", false, fmt.Errorf("unsupported kind %q of helm values", ref.Kind)
+	}
+}
+
+const (
+	// minRedactedSubstringLength is the length from which sensitive strings are redacted wherever they occur in a
+	// string. Shorter strings are only redacted where they are delimited by other characters than letters and digits,
+	// so that e.g. a password "admin" does not redact every occurrence of "administrator".
+	minRedactedSubstringLength = 8
+	// minRedactedNumberLength is the number of characters from which sensitive numbers are redacted. Shorter numbers,
+	// like replica counts or ports, are too common to be redacted without hiding unrelated values.
+	minRedactedNumberLength = 4
+)
+
+// SensitiveHelmValue is a value of Helm values read from a Secret
+type SensitiveHelmValue struct {
+	// Path is the path of the value in the Helm values, e.g. db.password or hosts[0]
+	Path string
+	// Value is the value, formatted the way Helm renders it
+	Value string
+	// Number is true if the value is a number rather than a string
+	Number bool
+}
+
+// GetSensitiveHelmValues returns the string and number values contained in the given Helm values which were read from
+// Secrets, longest first. Booleans and short numbers are not considered to be sensitive.
+func GetSensitiveHelmValues(helmValues []*apiclient.HelmValues) ([]SensitiveHelmValue, error) {
+	var res []SensitiveHelmValue
+	for _, v := range helmValues {
+		if !v.Sensitive {
+			continue
+		}
+		var values interface{}
+		if err := yaml.Unmarshal([]byte(v.Values), &values); err != nil {
+			return nil, fmt.Errorf("error parsing helm values: %w", err)
+		}
+		res = appendSensitiveValues(res, "", values)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return len(res[i].Value) > len(res[j].Value)
+	})
+	return res, nil
+}
+
+func appendSensitiveValues(res []SensitiveHelmValue, path string, value interface{}) []SensitiveHelmValue {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			res = append(res, SensitiveHelmValue{Path: path, Value: v})
+		}
+	case float64, int64:
+		if formatted, _ := formatNumber(v); len(formatted) >= minRedactedNumberLength {
+			res = append(res, SensitiveHelmValue{Path: path, Value: formatted, Number: true})
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			itemPath := k
+			if path != "" {
+				itemPath = path + "." + k
+			}
+			res = appendSensitiveValues(res, itemPath, v[k])
+		}
+	case []interface{}:
+		for i, item := range v {
+			res = appendSensitiveValues(res, fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+	return res
+}
+
+// formatNumber formats the number the way Helm renders it. Returns false if the value is not a number.
+func formatNumber(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case int:
+		return strconv.Itoa(v), true
+	}
+	return "", false
+}
+
+// RedactSensitiveHelmValues replaces the given sensitive values in the string and number fields of the given object.
+// Fields which are equal to a sensitive value are replaced entirely, while sensitive strings are also replaced where
+// they occur in a string, see minRedactedSubstringLength. Returns the paths of the values which have been redacted.
+func RedactSensitiveHelmValues(obj map[string]interface{}, sensitiveValues []SensitiveHelmValue) []string {
+	if len(sensitiveValues) == 0 {
+		return nil
+	}
+	redacted := map[string]bool{}
+	redactValue(obj, sensitiveValues, redacted)
+	paths := make([]string, 0, len(redacted))
+	for path := range redacted {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func redactValue(value interface{}, sensitiveValues []SensitiveHelmValue, redacted map[string]bool) (interface{}, bool) {
+	modified := false
+	switch v := value.(type) {
+	case string:
+		for _, sensitive := range sensitiveValues {
+			if v == sensitive.Value {
+				redacted[sensitive.Path] = true
+				return RedactedHelmValue, true
+			}
+		}
+		for _, sensitive := range sensitiveValues {
+			var replaced bool
+			if !sensitive.Number && len(sensitive.Value) >= minRedactedSubstringLength {
+				replaced = strings.Contains(v, sensitive.Value)
+				v = strings.ReplaceAll(v, sensitive.Value, RedactedHelmValue)
+			} else {
+				v, replaced = replaceDelimited(v, sensitive.Value)
+			}
+			if replaced {
+				redacted[sensitive.Path] = true
+				modified = true
+			}
+		}
+		return v, modified
+	case map[string]interface{}:
+		for k, item := range v {
+			if res, ok := redactValue(item, sensitiveValues, redacted); ok {
+				v[k] = res
+				modified = true
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if res, ok := redactValue(item, sensitiveValues, redacted); ok {
+				v[i] = res
+				modified = true
+			}
+		}
+	default:
+		if formatted, ok := formatNumber(v); ok {
+			for _, sensitive := range sensitiveValues {
+				if sensitive.Number && formatted == sensitive.Value {
+					redacted[sensitive.Path] = true
+					return RedactedHelmValue, true
+				}
+			}
+		}
+	}
+	return value, modified
+}
+
+// replaceDelimited replaces the occurrences of the value in the string which are not preceded or followed by a letter
+// or digit. Returns true if an occurrence has been replaced.
+func replaceDelimited(s string, value string) (string, bool) {
+	var res strings.Builder
+	replaced := false
+	written, from := 0, 0
+	for {
+		i := strings.Index(s[from:], value)
+		if i < 0 {
+			break
+		}
+		start, end := from+i, from+i+len(value)
+		before, _ := utf8.DecodeLastRuneInString(s[:start])
+		after, _ := utf8.DecodeRuneInString(s[end:])
+		if isAlphanumeric(before) || isAlphanumeric(after) {
+			from = start + 1
+			continue
+		}
+		res.WriteString(s[written:start])
+		res.WriteString(RedactedHelmValue)
+		written, from = end, end
+		replaced = true
+	}
+	res.WriteString(s[written:])
+	return res.String(), replaced
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -91,6 +91,13 @@ type ArgoDB interface {
 	// DeleteGPGPublicKey removes a GPG public key from the configuration
 	DeleteGPGPublicKey(ctx context.Context, keyID string) error
 
+	// ListConfiguredSSHSigningKeys returns all SSH signing keys that are configured, by fingerprint
+	ListConfiguredSSHSigningKeys(ctx context.Context) (map[string]*appv1.SSHSigningKey, error)
+	// AddSSHSigningKeys adds one or more SSH signing keys to the configuration
+	AddSSHSigningKeys(ctx context.Context, keyData string, upsert bool) (map[string]*appv1.SSHSigningKey, []string, error)
+	// DeleteSSHSigningKey removes an SSH signing key from the configuration
+	DeleteSSHSigningKey(ctx context.Context, fingerprint string) error
+
 	// GetApplicationControllerReplicas gets the replicas of application controller
 	GetApplicationControllerReplicas() int
 }
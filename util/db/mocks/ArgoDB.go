@@ -55,6 +55,45 @@ func (_m *ArgoDB) AddGPGPublicKey(ctx context.Context, keyData string) (map[stri
 	return r0, r1, r2
 }
 
+// AddSSHSigningKeys provides a mock function with given fields: ctx, keyData, upsert
+func (_m *ArgoDB) AddSSHSigningKeys(ctx context.Context, keyData string, upsert bool) (map[string]*v1alpha1.SSHSigningKey, []string, error) {
+	ret := _m.Called(ctx, keyData, upsert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSSHSigningKeys")
+	}
+
+	var r0 map[string]*v1alpha1.SSHSigningKey
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (map[string]*v1alpha1.SSHSigningKey, []string, error)); ok {
+		return rf(ctx, keyData, upsert)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) map[string]*v1alpha1.SSHSigningKey); ok {
+		r0 = rf(ctx, keyData, upsert)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.SSHSigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) []string); ok {
+		r1 = rf(ctx, keyData, upsert)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, bool) error); ok {
+		r2 = rf(ctx, keyData, upsert)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateCluster provides a mock function with given fields: ctx, c
 func (_m *ArgoDB) CreateCluster(ctx context.Context, c *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 	ret := _m.Called(ctx, c)
@@ -247,6 +286,24 @@ func (_m *ArgoDB) DeleteRepositoryCredentials(ctx context.Context, name string)
 	return r0
 }
 
+// DeleteSSHSigningKey provides a mock function with given fields: ctx, fingerprint
+func (_m *ArgoDB) DeleteSSHSigningKey(ctx context.Context, fingerprint string) error {
+	ret := _m.Called(ctx, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSSHSigningKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllHelmRepositoryCredentials provides a mock function with given fields: ctx
 func (_m *ArgoDB) GetAllHelmRepositoryCredentials(ctx context.Context) ([]*v1alpha1.RepoCreds, error) {
 	ret := _m.Called(ctx)
@@ -535,6 +592,36 @@ func (_m *ArgoDB) ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*
 	return r0, r1
 }
 
+// ListConfiguredSSHSigningKeys provides a mock function with given fields: ctx
+func (_m *ArgoDB) ListConfiguredSSHSigningKeys(ctx context.Context) (map[string]*v1alpha1.SSHSigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfiguredSSHSigningKeys")
+	}
+
+	var r0 map[string]*v1alpha1.SSHSigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]*v1alpha1.SSHSigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]*v1alpha1.SSHSigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.SSHSigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListHelmRepositories provides a mock function with given fields: ctx
 func (_m *ArgoDB) ListHelmRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
 	ret := _m.Called(ctx)
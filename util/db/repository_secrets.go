@@ -315,6 +315,10 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 		NoProxy:                    string(secret.Data["noProxy"]),
 		Project:                    string(secret.Data["project"]),
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
+		TokenProviderURL:           string(secret.Data["tokenProviderURL"]),
+		TokenProviderClientID:      string(secret.Data["tokenProviderClientID"]),
+		TokenProviderAudience:      string(secret.Data["tokenProviderAudience"]),
+		TokenProviderScope:         string(secret.Data["tokenProviderScope"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secret, "insecureIgnoreHostKey")
@@ -359,6 +363,18 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.ForceHttpBasicAuth = forceBasicAuth
 
+	partialClone, err := boolOrFalse(secret, "partialClone")
+	if err != nil {
+		return repository, err
+	}
+	repository.PartialClone = partialClone
+
+	sparseCheckout, err := boolOrFalse(secret, "sparseCheckout")
+	if err != nil {
+		return repository, err
+	}
+	repository.SparseCheckout = sparseCheckout
+
 	return repository, nil
 }
 
@@ -387,7 +403,13 @@ func repositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) {
 	updateSecretString(secret, "proxy", repository.Proxy)
 	updateSecretString(secret, "noProxy", repository.NoProxy)
 	updateSecretString(secret, "gcpServiceAccountKey", repository.GCPServiceAccountKey)
+	updateSecretString(secret, "tokenProviderURL", repository.TokenProviderURL)
+	updateSecretString(secret, "tokenProviderClientID", repository.TokenProviderClientID)
+	updateSecretString(secret, "tokenProviderAudience", repository.TokenProviderAudience)
+	updateSecretString(secret, "tokenProviderScope", repository.TokenProviderScope)
 	updateSecretBool(secret, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
+	updateSecretBool(secret, "partialClone", repository.PartialClone)
+	updateSecretBool(secret, "sparseCheckout", repository.SparseCheckout)
 	addSecretMetadata(secret, common.LabelValueSecretTypeRepository)
 }
 
@@ -405,6 +427,10 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
 		Proxy:                      string(secret.Data["proxy"]),
 		NoProxy:                    string(secret.Data["noProxy"]),
+		TokenProviderURL:           string(secret.Data["tokenProviderURL"]),
+		TokenProviderClientID:      string(secret.Data["tokenProviderClientID"]),
+		TokenProviderAudience:      string(secret.Data["tokenProviderAudience"]),
+		TokenProviderScope:         string(secret.Data["tokenProviderScope"]),
 	}
 
 	enableOCI, err := boolOrFalse(secret, "enableOCI")
@@ -452,6 +478,10 @@ func repoCredsToSecret(repoCreds *appsv1.RepoCreds, secret *corev1.Secret) {
 	updateSecretInt(secret, "githubAppInstallationID", repoCreds.GithubAppInstallationId)
 	updateSecretString(secret, "githubAppEnterpriseBaseUrl", repoCreds.GitHubAppEnterpriseBaseURL)
 	updateSecretString(secret, "gcpServiceAccountKey", repoCreds.GCPServiceAccountKey)
+	updateSecretString(secret, "tokenProviderURL", repoCreds.TokenProviderURL)
+	updateSecretString(secret, "tokenProviderClientID", repoCreds.TokenProviderClientID)
+	updateSecretString(secret, "tokenProviderAudience", repoCreds.TokenProviderAudience)
+	updateSecretString(secret, "tokenProviderScope", repoCreds.TokenProviderScope)
 	updateSecretString(secret, "proxy", repoCreds.Proxy)
 	updateSecretString(secret, "noProxy", repoCreds.NoProxy)
 	updateSecretBool(secret, "forceHttpBasicAuth", repoCreds.ForceHttpBasicAuth)
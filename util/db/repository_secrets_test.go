@@ -958,3 +958,56 @@ func TestRepoCredsToSecret(t *testing.T) {
 	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, s.Annotations)
 	assert.Equal(t, map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds}, s.Labels)
 }
+
+func TestRepositoryToSecret_CloneOptions(t *testing.T) {
+	s := &corev1.Secret{}
+	repo := &appsv1.Repository{
+		Repo:           "https://github.com/argoproj/argo-cd.git",
+		PartialClone:   true,
+		SparseCheckout: true,
+	}
+	repositoryToSecret(repo, s)
+	assert.Equal(t, []byte("true"), s.Data["partialClone"])
+	assert.Equal(t, []byte("true"), s.Data["sparseCheckout"])
+
+	output, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.True(t, output.PartialClone)
+	assert.True(t, output.SparseCheckout)
+}
+
+func TestRepositoryToSecret_TokenProvider(t *testing.T) {
+	s := &corev1.Secret{}
+	repo := &appsv1.Repository{
+		Repo:                  "https://dev.azure.com/org/project/_git/repo",
+		TokenProviderURL:      "https://login.microsoftonline.com/tenant/oauth2/v2.0/token",
+		TokenProviderClientID: "client-id",
+		TokenProviderAudience: "audience",
+		TokenProviderScope:    "499b84ac-1321-427f-aa17-267ca6975798/.default",
+	}
+	repositoryToSecret(repo, s)
+	assert.Equal(t, []byte(repo.TokenProviderURL), s.Data["tokenProviderURL"])
+	assert.Equal(t, []byte(repo.TokenProviderClientID), s.Data["tokenProviderClientID"])
+	assert.Equal(t, []byte(repo.TokenProviderAudience), s.Data["tokenProviderAudience"])
+	assert.Equal(t, []byte(repo.TokenProviderScope), s.Data["tokenProviderScope"])
+
+	output, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.Equal(t, repo.TokenProviderURL, output.TokenProviderURL)
+	assert.Equal(t, repo.TokenProviderClientID, output.TokenProviderClientID)
+	assert.Equal(t, repo.TokenProviderAudience, output.TokenProviderAudience)
+	assert.Equal(t, repo.TokenProviderScope, output.TokenProviderScope)
+
+	creds := &appsv1.RepoCreds{
+		URL:                   "https://dev.azure.com/org",
+		TokenProviderURL:      repo.TokenProviderURL,
+		TokenProviderClientID: repo.TokenProviderClientID,
+	}
+	s = &corev1.Secret{}
+	repoCredsToSecret(creds, s)
+	outputCreds, err := (&secretsRepositoryBackend{}).secretToRepoCred(s)
+	require.NoError(t, err)
+	assert.Equal(t, creds.TokenProviderURL, outputCreds.TokenProviderURL)
+	assert.Equal(t, creds.TokenProviderClientID, outputCreds.TokenProviderClientID)
+	assert.Empty(t, outputCreds.TokenProviderScope)
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/signing"
+)
+
+// ListConfiguredSSHSigningKeys returns a list of all configured SSH signing keys from the ConfigMap
+func (db *db) ListConfiguredSSHSigningKeys(ctx context.Context) (map[string]*appsv1.SSHSigningKey, error) {
+	log.Debugf("Loading SSH signing keys from config map")
+	result := make(map[string]*appsv1.SSHSigningKey)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSigningKeysConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each key/value pair in the config map must contain exactly one allowed signers entry, with the name derived
+	// from the key's fingerprint as key
+	for k, entry := range keysCM.Data {
+		keys, err := signing.ParseSSHSigningKeys(entry)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse SSH signing key for entry '%s': %w", k, err)
+		}
+		if len(keys) != 1 {
+			return nil, fmt.Errorf("Entry '%s' must contain exactly one SSH signing key, found %d", k, len(keys))
+		}
+		if expected := signing.SSHSigningKeyFingerprint(k); expected != keys[0].Fingerprint {
+			return nil, fmt.Errorf("Key parsed for entry with fingerprint '%s' had different fingerprint '%s'", expected, keys[0].Fingerprint)
+		}
+		result[keys[0].Fingerprint] = keys[0]
+	}
+
+	return result, nil
+}
+
+// AddSSHSigningKeys adds one or more SSH signing keys to the configuration
+func (db *db) AddSSHSigningKeys(ctx context.Context, keyData string, upsert bool) (map[string]*appsv1.SSHSigningKey, []string, error) {
+	result := make(map[string]*appsv1.SSHSigningKey)
+	skipped := make([]string, 0)
+
+	keys, err := signing.ParseSSHSigningKeys(keyData)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil, fmt.Errorf("No SSH signing key found in input data")
+	}
+
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSigningKeysConfigMapName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if keysCM.Data == nil {
+		keysCM.Data = make(map[string]string)
+	}
+
+	for _, key := range keys {
+		name := signing.SSHSigningKeyName(key.Fingerprint)
+		if existing, ok := keysCM.Data[name]; ok && (!upsert || existing == key.KeyData) {
+			skipped = append(skipped, key.Fingerprint)
+			log.Debugf("Not adding incoming SSH signing key %s because it is configured already", key.Fingerprint)
+		} else {
+			result[key.Fingerprint] = key
+			keysCM.Data[name] = key.KeyData
+			log.Debugf("Adding incoming SSH signing key %s to database", key.Fingerprint)
+		}
+	}
+
+	err = db.settingsMgr.SaveSSHSigningKeyData(ctx, keysCM.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, skipped, nil
+}
+
+// DeleteSSHSigningKey deletes an SSH signing key from the configuration
+func (db *db) DeleteSSHSigningKey(ctx context.Context, fingerprint string) error {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSigningKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	name := signing.SSHSigningKeyName(fingerprint)
+	if _, ok := keysCM.Data[name]; !ok {
+		return fmt.Errorf("No such key configured: %s", fingerprint)
+	}
+
+	delete(keysCM.Data, name)
+
+	return db.settingsMgr.SaveSSHSigningKeyData(ctx, keysCM.Data)
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	aliceSigningKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINqdiVOvGAKmjvKo1pggr/avARgEMl4LhHTCvgRWfN49 alice"
+	aliceSigningFingerprint = "SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s"
+	bobSigningKey           = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBPpagSUWO1/A58tkBsQCLRHIV3qmtsRvVoeRZqiVySRrR/kNCdks/X91gUmscKUy3/eTngXJP0WOM+X/b6oJRZA= bob"
+	bobSigningFingerprint   = "SHA256:C4U93tAg/FKuOHXgckjCTjhcbCsmsKNahlhFu8o1y50"
+)
+
+func signingKeysCM(data map[string]string) v1.ConfigMap {
+	return v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSigningKeysConfigMapName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+}
+
+func Test_ListConfiguredSSHSigningKeys(t *testing.T) {
+	t.Run("good case", func(t *testing.T) {
+		clientset := getGPGKeysClientset(signingKeysCM(map[string]string{
+			"SHA256.qvIK3CoIXYte9e2oPn8W39_WgCKQAb8CvQSfnMf0j6s": "alice@example.com " + aliceSigningKey,
+		}))
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		keys, err := db.ListConfiguredSSHSigningKeys(context.Background())
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "alice@example.com", keys[aliceSigningFingerprint].Principals)
+	})
+
+	t.Run("bad case - wrong fingerprint", func(t *testing.T) {
+		clientset := getGPGKeysClientset(signingKeysCM(map[string]string{
+			"SHA256.C4U93tAg_FKuOHXgckjCTjhcbCsmsKNahlhFu8o1y50": "alice@example.com " + aliceSigningKey,
+		}))
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		_, err := db.ListConfiguredSSHSigningKeys(context.Background())
+		require.ErrorContains(t, err, "had different fingerprint")
+	})
+}
+
+func Test_AddAndDeleteSSHSigningKeys(t *testing.T) {
+	clientset := getGPGKeysClientset(signingKeysCM(nil))
+	settingsMgr := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	db := NewDB(testNamespace, settingsMgr, clientset)
+
+	added, skipped, err := db.AddSSHSigningKeys(context.Background(), aliceSigningKey+"\nbob@example.com "+bobSigningKey, false)
+	require.NoError(t, err)
+	assert.Len(t, added, 2)
+	assert.Empty(t, skipped)
+
+	// Existing keys are skipped unless upserted
+	added, skipped, err = db.AddSSHSigningKeys(context.Background(), "alice@example.com "+aliceSigningKey, false)
+	require.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Equal(t, []string{aliceSigningFingerprint}, skipped)
+	added, _, err = db.AddSSHSigningKeys(context.Background(), "alice@example.com "+aliceSigningKey, true)
+	require.NoError(t, err)
+	assert.Len(t, added, 1)
+
+	keys, err := db.ListConfiguredSSHSigningKeys(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "alice@example.com", keys[aliceSigningFingerprint].Principals)
+	assert.Equal(t, "bob@example.com", keys[bobSigningFingerprint].Principals)
+
+	_, _, err = db.AddSSHSigningKeys(context.Background(), "garbage", false)
+	require.Error(t, err)
+
+	require.NoError(t, db.DeleteSSHSigningKey(context.Background(), bobSigningFingerprint))
+	require.ErrorContains(t, db.DeleteSSHSigningKey(context.Background(), bobSigningFingerprint), "No such key")
+	cm, err := settingsMgr.GetConfigMapByName(common.ArgoCDSigningKeysConfigMapName)
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+}
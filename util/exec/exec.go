@@ -26,6 +26,8 @@ type ExecRunOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr determines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// Limiter applies resource limits to the command, unless it is nil
+	Limiter *Limiter
 }
 
 func init() {
@@ -59,6 +61,9 @@ func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
 		span.SetBaggageItem("args", fmt.Sprintf("%v", cmd.Args))
 	}
 	defer span.Finish()
+	if opts.Limiter != nil {
+		return opts.Limiter.run(cmd, opts)
+	}
 	return argoexec.RunCommandExt(cmd, cmdOpts)
 }
 
@@ -0,0 +1,301 @@
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/dustin/go-humanize"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/rand"
+)
+
+// maxStderrSize is the maximum size of the standard error of a command which is kept for error messages
+const maxStderrSize = 1024 * 1024
+
+// The kinds of resource limits reported by ResourceLimitExceededError
+const (
+	ResourceLimitTimeout    = "timeout"
+	ResourceLimitCPUTime    = "cpu"
+	ResourceLimitMemory     = "memory"
+	ResourceLimitOutputSize = "output"
+)
+
+var resourceLimitNames = map[string]string{
+	ResourceLimitTimeout:    "time",
+	ResourceLimitCPUTime:    "CPU time",
+	ResourceLimitMemory:     "memory",
+	ResourceLimitOutputSize: "output size",
+}
+
+// ResourceLimits are the limits of the resources which commands and their child processes may consume. A zero value
+// means no limit.
+type ResourceLimits struct {
+	// Timeout is the maximum wall-clock time of all commands run with the same Limiter
+	Timeout time.Duration
+	// CPUTime is the maximum CPU time of each process
+	CPUTime time.Duration
+	// Memory is the maximum address space of each process, or the maximum memory of all processes run with the same
+	// Limiter if they are run in a cgroup
+	Memory int64
+	// OutputSize is the maximum size of the standard output of each command
+	OutputSize int64
+	// Cgroup is a cgroup v2 directory delegated to the process. If set, the commands run with a Limiter are run in a
+	// child cgroup of it, which is removed once the Limiter is closed.
+	Cgroup string
+}
+
+// IsZero returns true if no limits are set
+func (l ResourceLimits) IsZero() bool {
+	return l == ResourceLimits{}
+}
+
+// ResourceLimitExceededError is returned if a command or an in-process operation exceeded one of its resource limits
+type ResourceLimitExceededError struct {
+	// Command is the redacted command which exceeded the limit
+	Command string
+	// Limit is the kind of the limit which has been exceeded, e.g. ResourceLimitCPUTime
+	Limit string
+	// Value is the value of the limit
+	Value string
+}
+
+func (e *ResourceLimitExceededError) Error() string {
+	return fmt.Sprintf("`%s` exceeded the %s limit of %s", e.Command, resourceLimitNames[e.Limit], e.Value)
+}
+
+// Limiter applies ResourceLimits to the commands of a single operation, such as a manifest generation. The wall-clock
+// timeout is shared by all commands, whereas the other limits are applied to each command. A nil Limiter applies no
+// limits.
+type Limiter struct {
+	limits   ResourceLimits
+	deadline time.Time
+	cgroup   *cgroup
+
+	mu sync.Mutex
+	// exceededErr is the first error returned because a command or an in-process operation exceeded a limit
+	exceededErr *ResourceLimitExceededError
+}
+
+// NewLimiter returns a Limiter which applies the given limits, or nil if no limits are set. The Limiter must be closed
+// once the operation is done.
+func NewLimiter(limits ResourceLimits) (*Limiter, error) {
+	if limits.IsZero() {
+		return nil, nil
+	}
+	l := &Limiter{limits: limits}
+	if limits.Timeout > 0 {
+		l.deadline = time.Now().Add(limits.Timeout)
+	}
+	if limits.Cgroup != "" {
+		cg, err := newCgroup(limits.Cgroup, limits.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cgroup in %s: %w", limits.Cgroup, err)
+		}
+		l.cgroup = cg
+	}
+	return l, nil
+}
+
+// Deadline returns the time by which all commands run with the Limiter must have exited
+func (l *Limiter) Deadline() (time.Time, bool) {
+	if l == nil || l.deadline.IsZero() {
+		return time.Time{}, false
+	}
+	return l.deadline, true
+}
+
+// CheckTimeout returns an error if the wall-clock timeout of the Limiter has been exceeded. It is used to limit
+// operations which are not run with the Limiter, such as config management plugins.
+func (l *Limiter) CheckTimeout(command string) error {
+	if deadline, ok := l.Deadline(); ok && time.Now().After(deadline) {
+		return l.exceeded(command, ResourceLimitTimeout)
+	}
+	return nil
+}
+
+// Exceeded returns the error of the first command or in-process operation which exceeded a limit of the Limiter, or
+// nil if none did
+func (l *Limiter) Exceeded() *ResourceLimitExceededError {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.exceededErr
+}
+
+// Close removes the cgroup of the Limiter, killing any processes left in it
+func (l *Limiter) Close() error {
+	if l == nil || l.cgroup == nil {
+		return nil
+	}
+	return l.cgroup.remove()
+}
+
+func (l *Limiter) exceeded(command string, limit string) error {
+	var value string
+	switch limit {
+	case ResourceLimitTimeout:
+		value = l.limits.Timeout.String()
+	case ResourceLimitCPUTime:
+		value = l.limits.CPUTime.String()
+	case ResourceLimitMemory:
+		value = humanize.IBytes(uint64(l.limits.Memory))
+	case ResourceLimitOutputSize:
+		value = humanize.IBytes(uint64(l.limits.OutputSize))
+	}
+	err := &ResourceLimitExceededError{Command: command, Limit: limit, Value: value}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.exceededErr == nil {
+		l.exceededErr = err
+	}
+	return err
+}
+
+// exceededLimit returns the limit which caused a command to fail, if any
+func (l *Limiter) exceededLimit(cmd *exec.Cmd, stderr string) string {
+	if l.limits.CPUTime > 0 && cmd.ProcessState != nil && l.cpuTimeExceeded(cmd.ProcessState) {
+		return ResourceLimitCPUTime
+	}
+	if l.limits.Memory > 0 {
+		if l.cgroup != nil {
+			if l.cgroup.oomKilled() {
+				return ResourceLimitMemory
+			}
+		} else if strings.Contains(stderr, "out of memory") || strings.Contains(stderr, "cannot allocate memory") {
+			// Allocations fail once a process exceeds its address space limit
+			return ResourceLimitMemory
+		}
+	}
+	return ""
+}
+
+// run is the equivalent of argoexec.RunCommandExt, which applies the limits of the Limiter to the command
+func (l *Limiter) run(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
+	execId, err := rand.String(5)
+	if err != nil {
+		return "", err
+	}
+	logCtx := log.WithFields(log.Fields{"execID": execId})
+
+	redactor := opts.Redactor
+	if redactor == nil {
+		redactor = argoexec.Unredacted
+	}
+	args := redactor(strings.Join(cmd.Args, " "))
+	logCtx.WithFields(log.Fields{"dir": cmd.Dir}).Info(args)
+
+	cmdTimeout := timeout
+	deadlineTimeout := false
+	if deadline, ok := l.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return "", l.exceeded(args, ResourceLimitTimeout)
+		}
+		if cmdTimeout == 0 || remaining < cmdTimeout {
+			cmdTimeout = remaining
+			deadlineTimeout = true
+		}
+	}
+
+	stdout := &limitedBuffer{limit: l.limits.OutputSize, exceeded: make(chan struct{})}
+	// Only the beginning of the standard error is kept, but the command is not killed if it writes more
+	stderr := &limitedBuffer{limit: maxStderrSize, exceeded: make(chan struct{})}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	l.prepare(cmd)
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	var timeoutCh <-chan time.Time
+	if cmdTimeout > 0 {
+		timer := time.NewTimer(cmdTimeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	select {
+	case <-timeoutCh:
+		l.kill(cmd)
+		<-done
+		if deadlineTimeout {
+			err = l.exceeded(args, ResourceLimitTimeout)
+		} else {
+			err = &argoexec.CmdError{Args: args, Cause: fmt.Errorf("timeout after %v", cmdTimeout)}
+		}
+	case <-stdout.exceeded:
+		l.kill(cmd)
+		<-done
+	case err = <-done:
+		if err != nil {
+			if limit := l.exceededLimit(cmd, stderr.String()); limit != "" {
+				err = l.exceeded(args, limit)
+			} else {
+				err = &argoexec.CmdError{Args: args, Cause: errors.New(redactor(err.Error())), Stderr: strings.TrimSpace(redactor(stderr.String()))}
+			}
+		}
+	}
+	if stdout.isExceeded() {
+		err = l.exceeded(args, ResourceLimitOutputSize)
+	}
+
+	output := stdout.String()
+	if opts.CaptureStderr {
+		output += stderr.String()
+	}
+	logCtx.WithFields(log.Fields{"duration": time.Since(start)}).Debug(redactor(output))
+	if err != nil && !opts.SkipErrorLogging {
+		logCtx.Error(err.Error())
+	}
+	return strings.TrimSuffix(output, "\n"), err
+}
+
+// limitedBuffer is a buffer which discards any data exceeding its limit, and closes its exceeded channel once that
+// happens
+type limitedBuffer struct {
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	limit    int64
+	exceeded chan struct{}
+	once     sync.Once
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.limit > 0 && int64(b.buf.Len()+len(p)) > b.limit {
+		b.buf.Write(p[:b.limit-int64(b.buf.Len())])
+		b.once.Do(func() { close(b.exceeded) })
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func (b *limitedBuffer) isExceeded() bool {
+	select {
+	case <-b.exceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/argoproj/argo-cd/v2/util/rand"
+)
+
+// prepare runs the command in its own process group, so that its child processes can be killed along with it, and in
+// the cgroup of the Limiter if there is one. If the command has to be run with resource limits, it is run by a shell
+// which sets them before executing the command, so that the command never runs without them. Child processes inherit
+// the limits.
+func (l *Limiter) prepare(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	if l.cgroup != nil {
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = l.cgroup.fd
+	}
+	// cmd.Err is set if the command could not be found, which cmd.Start reports
+	if script := l.rlimitScript(); script != "" && cmd.Err == nil {
+		cmd.Args = append([]string{"sh", "-c", script, cmd.Path}, cmd.Args[1:]...)
+		cmd.Path = "/bin/sh"
+	}
+}
+
+// rlimitScript returns the shell script which sets the resource limits of a process and then executes the command
+// given as its arguments, or "" if no resource limits have to be set
+func (l *Limiter) rlimitScript() string {
+	var limits []string
+	if l.limits.CPUTime > 0 {
+		seconds := uint64(math.Ceil(l.limits.CPUTime.Seconds()))
+		// The process is sent SIGXCPU once it exceeds the soft limit, and SIGKILL once it exceeds the hard limit. The
+		// soft limit is set first, since it must not exceed the hard limit.
+		limits = append(limits, fmt.Sprintf("ulimit -S -t %d", seconds), fmt.Sprintf("ulimit -H -t %d", seconds+1))
+	}
+	// The memory of processes in a cgroup is limited by the cgroup
+	if l.limits.Memory > 0 && l.cgroup == nil {
+		limits = append(limits, fmt.Sprintf("ulimit -v %d", max(l.limits.Memory/1024, 1)))
+	}
+	if len(limits) == 0 {
+		return ""
+	}
+	return strings.Join(append(limits, `exec "$0" "$@"`), " && ")
+}
+
+// cpuTimeExceeded returns true if the process has been killed because it exceeded its CPU time limit
+func (l *Limiter) cpuTimeExceeded(state *os.ProcessState) bool {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() && status.Signal() == syscall.SIGXCPU {
+		return true
+	}
+	return state.UserTime()+state.SystemTime() >= l.limits.CPUTime
+}
+
+// kill kills the command along with its child processes
+func (l *Limiter) kill(cmd *exec.Cmd) {
+	if l.cgroup != nil && l.cgroup.kill() == nil {
+		return
+	}
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+type cgroup struct {
+	path string
+	fd   int
+	// oomKills is the number of processes in the cgroup which have been killed because the cgroup ran out of memory
+	oomKills int64
+}
+
+func newCgroup(parent string, memory int64) (*cgroup, error) {
+	name, err := rand.String(10)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(parent, "argocd-"+name)
+	if err := os.Mkdir(path, 0o755); err != nil {
+		return nil, err
+	}
+	cg := &cgroup{path: path, fd: -1}
+	if memory > 0 {
+		if err := os.WriteFile(filepath.Join(path, "memory.max"), []byte(strconv.FormatInt(memory, 10)), 0o644); err != nil {
+			_ = cg.remove()
+			return nil, fmt.Errorf("failed to set memory limit: %w", err)
+		}
+	}
+	cg.fd, err = unix.Open(path, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		_ = cg.remove()
+		return nil, err
+	}
+	return cg, nil
+}
+
+// oomKilled returns true if a process in the cgroup has been killed because the cgroup ran out of memory since the
+// last call
+func (c *cgroup) oomKilled() bool {
+	data, err := os.ReadFile(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "oom_kill" {
+			continue
+		}
+		oomKills, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || oomKills <= c.oomKills {
+			return false
+		}
+		c.oomKills = oomKills
+		return true
+	}
+	return false
+}
+
+// kill kills all processes in the cgroup
+func (c *cgroup) kill() error {
+	return os.WriteFile(filepath.Join(c.path, "cgroup.kill"), []byte("1"), 0o644)
+}
+
+func (c *cgroup) remove() error {
+	if c.fd >= 0 {
+		_ = unix.Close(c.fd)
+		c.fd = -1
+	}
+	_ = c.kill()
+	// Killed processes leave the cgroup asynchronously
+	var err error
+	for i := 0; i < 10; i++ {
+		if err = os.Remove(c.path); err == nil || os.IsNotExist(err) {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+//go:build !linux
+// +build !linux
+
+package exec
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// Resource limits other than the timeout and the output size are only applied on Linux
+func (l *Limiter) prepare(cmd *exec.Cmd) {}
+
+func (l *Limiter) cpuTimeExceeded(state *os.ProcessState) bool {
+	return false
+}
+
+func (l *Limiter) kill(cmd *exec.Cmd) {
+	_ = cmd.Process.Kill()
+}
+
+type cgroup struct{}
+
+func newCgroup(parent string, memory int64) (*cgroup, error) {
+	return nil, errors.New("cgroups are only supported on Linux")
+}
+
+func (c *cgroup) oomKilled() bool {
+	return false
+}
+
+func (c *cgroup) remove() error {
+	return nil
+}
@@ -0,0 +1,100 @@
+package exec
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runLimited(t *testing.T, limits ResourceLimits, name string, args ...string) (string, error) {
+	t.Helper()
+	limiter, err := NewLimiter(limits)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, limiter.Close()) }()
+	return RunWithExecRunOpts(exec.Command(name, args...), ExecRunOpts{Limiter: limiter})
+}
+
+func requireLimitExceeded(t *testing.T, err error, limit string) *ResourceLimitExceededError {
+	t.Helper()
+	var limitErr *ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, limit, limitErr.Limit)
+	return limitErr
+}
+
+func TestNewLimiter(t *testing.T) {
+	limiter, err := NewLimiter(ResourceLimits{})
+	require.NoError(t, err)
+	assert.Nil(t, limiter)
+	require.NoError(t, limiter.Close())
+	require.NoError(t, limiter.CheckTimeout("jsonnet"))
+	_, ok := limiter.Deadline()
+	assert.False(t, ok)
+}
+
+func TestLimiter_Success(t *testing.T) {
+	out, err := runLimited(t, ResourceLimits{Timeout: time.Minute, CPUTime: time.Minute, OutputSize: 1024}, "sh", "-c", "echo hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", out)
+}
+
+func TestLimiter_CommandError(t *testing.T) {
+	_, err := runLimited(t, ResourceLimits{Timeout: time.Minute}, "sh", "-c", "echo oops >&2; exit 1")
+	var cmdErr *argoexec.CmdError
+	require.ErrorAs(t, err, &cmdErr)
+	assert.Equal(t, "oops", cmdErr.Stderr)
+	assert.False(t, errors.As(err, new(*ResourceLimitExceededError)))
+}
+
+func TestLimiter_StderrSize(t *testing.T) {
+	_, err := runLimited(t, ResourceLimits{Timeout: time.Minute}, "sh", "-c", "head -c 2000000 /dev/zero | tr '\\0' x >&2; exit 1")
+	var cmdErr *argoexec.CmdError
+	require.ErrorAs(t, err, &cmdErr)
+	assert.Len(t, cmdErr.Stderr, maxStderrSize)
+}
+
+func TestLimiter_Timeout(t *testing.T) {
+	limiter, err := NewLimiter(ResourceLimits{Timeout: 200 * time.Millisecond})
+	require.NoError(t, err)
+	defer limiter.Close()
+
+	_, err = RunWithExecRunOpts(exec.Command("sleep", "5"), ExecRunOpts{Limiter: limiter})
+	limitErr := requireLimitExceeded(t, err, ResourceLimitTimeout)
+	assert.Equal(t, "`sleep 5` exceeded the time limit of 200ms", limitErr.Error())
+	assert.Same(t, limitErr, limiter.Exceeded())
+
+	// The timeout is shared by all commands run with the limiter
+	_, err = RunWithExecRunOpts(exec.Command("true"), ExecRunOpts{Limiter: limiter})
+	requireLimitExceeded(t, err, ResourceLimitTimeout)
+	requireLimitExceeded(t, limiter.CheckTimeout("jsonnet main.jsonnet"), ResourceLimitTimeout)
+}
+
+func TestLimiter_OutputSize(t *testing.T) {
+	_, err := runLimited(t, ResourceLimits{OutputSize: 1024}, "yes")
+	limitErr := requireLimitExceeded(t, err, ResourceLimitOutputSize)
+	assert.Equal(t, "`yes` exceeded the output size limit of 1.0 KiB", limitErr.Error())
+}
+
+func TestLimiter_CPUTime(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("CPU time limits are only applied on Linux")
+	}
+	initTimeout()
+	_, err := runLimited(t, ResourceLimits{Timeout: time.Minute, CPUTime: time.Second}, "sh", "-c", "while :; do :; done")
+	requireLimitExceeded(t, err, ResourceLimitCPUTime)
+}
+
+func TestLimiter_RlimitsSetBeforeExec(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("CPU time and memory limits are only applied on Linux")
+	}
+	out, err := runLimited(t, ResourceLimits{CPUTime: 30 * time.Second, Memory: 4 << 30}, "sh", "-c", "ulimit -S -t; ulimit -H -t; ulimit -v; echo \"$0 $1\"", "arg0", "arg1")
+	require.NoError(t, err)
+	assert.Equal(t, "30\n31\n4194304\narg0 arg1", out)
+}
@@ -9,8 +9,10 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -73,13 +75,17 @@ type Client interface {
 	LsRefs() (*Refs, error)
 	LsRemote(revision string) (string, error)
 	LsFiles(path string, enableNewGitFileGlobbing bool) ([]string, error)
+	LsDirectories() ([]string, error)
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
-	VerifyCommitSignature(string) (string, error)
+	VerifyCommitSignature(string) (string, string, error)
 	IsAnnotatedTag(string) bool
 	ChangedFiles(revision string, targetRevision string) ([]string, error)
 	IsRevisionPresent(revision string) bool
+	SparseCheckoutAdd(paths []string) error
+	WorktreeAdd(path string, revision string) error
+	WorktreeRemove(path string) error
 }
 
 type EventHandlers struct {
@@ -109,8 +115,17 @@ type nativeGitClient struct {
 	proxy string
 	// list of targets that shouldn't use the proxy, applies only if the proxy is set
 	noProxy string
+	// Whether to fetch the repository without file contents, which are then fetched on demand during checkout
+	partialClone bool
+	// Whether to check out only the paths explicitly added using SparseCheckoutAdd
+	sparseCheckout bool
+	// limiter limits the resources consumed by the git commands, unless it is nil
+	limiter *executil.Limiter
 }
 
+// partialCloneFilter is the object filter used to fetch partial clones, which omits all file contents
+const partialCloneFilter = "blob:none"
+
 type runOpts struct {
 	SkipErrorLogging bool
 	CaptureStderr    bool
@@ -147,6 +162,13 @@ func WithCache(cache gitRefCache, loadRefFromCache bool) ClientOpts {
 	}
 }
 
+// WithLimiter limits the resources consumed by the git commands run by the client
+func WithLimiter(limiter *executil.Limiter) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.limiter = limiter
+	}
+}
+
 // WithEventHandlers sets the git client event handlers
 func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	return func(c *nativeGitClient) {
@@ -154,6 +176,20 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithPartialClone sets whether the repository should be fetched as a partial clone, omitting file contents
+func WithPartialClone(enabled bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = enabled
+	}
+}
+
+// WithSparseCheckout sets whether the working tree should only contain the paths added using SparseCheckoutAdd
+func WithSparseCheckout(enabled bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.sparseCheckout = enabled
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile("(/|:)")
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -307,6 +343,16 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 
 		auth := githttp.BasicAuth{Username: username, Password: token}
 		return &auth, nil
+	case TokenProviderCreds:
+		token, err := creds.getAccessToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token from token provider: %w", err)
+		}
+		if creds.username == "" {
+			return &githttp.TokenAuth{Token: token}, nil
+		}
+		auth := githttp.BasicAuth{Username: creds.username, Password: token}
+		return &auth, nil
 	}
 	return nil, nil
 }
@@ -317,11 +363,13 @@ func (m *nativeGitClient) Root() string {
 
 // Init initializes a local git repository and sets the remote origin
 func (m *nativeGitClient) Init() error {
-	_, err := git.PlainOpen(m.root)
+	existing, err := git.PlainOpen(m.root)
 	if err == nil {
-		return nil
-	}
-	if !errors.Is(err, git.ErrRepositoryNotExists) {
+		if m.cloneOptionsApplied(existing) {
+			return nil
+		}
+		log.Infof("Clone options of %s changed, re-initializing %s", m.repoURL, m.root)
+	} else if !errors.Is(err, git.ErrRepositoryNotExists) {
 		return err
 	}
 	log.Infof("Initializing %s to %s", m.repoURL, m.root)
@@ -341,7 +389,45 @@ func (m *nativeGitClient) Init() error {
 		Name: git.DefaultRemoteName,
 		URLs: []string{m.repoURL},
 	})
-	return err
+	if err != nil || (!m.partialClone && !m.sparseCheckout) {
+		return err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+	if m.partialClone {
+		remote := cfg.Raw.Section("remote").Subsection(git.DefaultRemoteName)
+		remote.SetOption("promisor", "true")
+		remote.SetOption("partialclonefilter", partialCloneFilter)
+	}
+	if m.sparseCheckout {
+		// `git sparse-checkout init` would store this in a per-worktree config, which requires a repository format
+		// version that go-git does not create, so sparse checkout is configured in the repository config instead
+		cfg.Raw.Section("core").SetOption("sparseCheckout", "true")
+		cfg.Raw.Section("core").SetOption("sparseCheckoutCone", "true")
+		infoDir := filepath.Join(m.root, ".git", "info")
+		if err = os.MkdirAll(infoDir, 0o755); err != nil {
+			return err
+		}
+		// in cone mode, these patterns only include the files at the root of the repository
+		if err = os.WriteFile(filepath.Join(infoDir, "sparse-checkout"), []byte("/*\n!/*/\n"), 0o644); err != nil {
+			return err
+		}
+	}
+	return repo.SetConfig(cfg)
+}
+
+// cloneOptionsApplied returns false if the existing repository uses clone options which this client did not ask for
+// and therefore has to be re-initialized. A full clone is always compatible with partial or sparse clones.
+func (m *nativeGitClient) cloneOptionsApplied(repo *git.Repository) bool {
+	cfg, err := repo.Config()
+	if err != nil {
+		return false
+	}
+	promisor := cfg.Raw.Section("remote").Subsection(git.DefaultRemoteName).Option("promisor") == "true"
+	sparse := cfg.Raw.Section("core").Option("sparseCheckout") == "true"
+	return promisor == m.partialClone && (!sparse || m.sparseCheckout)
 }
 
 // Returns true if the repository is LFS enabled
@@ -350,13 +436,15 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 }
 
 func (m *nativeGitClient) fetch(revision string) error {
-	var err error
+	args := []string{"fetch", "origin"}
 	if revision != "" {
-		err = m.runCredentialedCmd("fetch", "origin", revision, "--tags", "--force", "--prune")
-	} else {
-		err = m.runCredentialedCmd("fetch", "origin", "--tags", "--force", "--prune")
+		args = append(args, revision)
 	}
-	return err
+	args = append(args, "--tags", "--force", "--prune")
+	if m.partialClone {
+		args = append(args, "--filter="+partialCloneFilter)
+	}
+	return m.runCredentialedCmd(args...)
 }
 
 // IsRevisionPresent checks to see if the given revision already exists locally.
@@ -438,6 +526,18 @@ func (m *nativeGitClient) LsFiles(path string, enableNewGitFileGlobbing bool) ([
 	}
 }
 
+// LsDirectories lists the directories of the checked out revision, including the ones which are not present in the
+// working tree because of a sparse checkout
+func (m *nativeGitClient) LsDirectories() ([]string, error) {
+	out, err := m.runCmd("ls-tree", "-r", "-d", "-z", "--name-only", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	// remove last element, which is blank regardless of whether we're using nullbyte or newline
+	ss := strings.Split(out, "\000")
+	return ss[:len(ss)-1], nil
+}
+
 // LsLargeFiles lists all files that have references to LFS storage
 func (m *nativeGitClient) LsLargeFiles() ([]string, error) {
 	out, err := m.runCmd("lfs", "ls-files", "-n")
@@ -464,7 +564,12 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) error
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("checkout", "--force", revision); err != nil {
+	if m.partialClone {
+		// file contents missing from a partial clone are fetched from the remote during checkout
+		if err := m.runCredentialedCmd("checkout", "--force", revision); err != nil {
+			return err
+		}
+	} else if _, err := m.runCmd("checkout", "--force", revision); err != nil {
 		return err
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -767,14 +872,34 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
-// VerifyCommitSignature Runs verify-commit on a given revision and returns the output
-func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error) {
-	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision)
+// VerifyCommitSignature Runs verify-commit on a given revision and returns the program which verified the signature
+// along with its output. Besides GnuPG signatures, SSH signatures made with the configured signing keys and keyless
+// Sigstore signatures made with gitsign are verified. Both values are empty if the revision is not signed.
+func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, string, error) {
+	objectType, err := m.runCmd("cat-file", "-t", revision)
+	if err != nil {
+		return "", "", fmt.Errorf("error getting type of revision %s: %w", revision, err)
+	}
+	objectType = strings.TrimSpace(objectType)
+	object, err := m.runCmd("cat-file", objectType, revision)
+	if err != nil {
+		return "", "", fmt.Errorf("error reading revision %s: %w", revision, err)
+	}
+	verifier := signatureVerifier(objectType, object)
+	if verifier == "" {
+		return "", "", nil
+	}
+	allowedSignersFile, err := writeAllowedSignersFile(common.GetSSHSigningKeysPath())
+	if err != nil {
+		return "", "", err
+	}
+	defer func() { _ = os.Remove(allowedSignersFile) }()
+	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", signatureVerificationEnviron(allowedSignersFile), revision)
 	if err != nil {
 		log.Errorf("error verifying commit signature: %v", err)
-		return "", fmt.Errorf("permission denied")
+		return "", "", fmt.Errorf("permission denied")
 	}
-	return out, nil
+	return verifier, out, nil
 }
 
 // IsAnnotatedTag returns true if the revision points to an annotated tag
@@ -798,7 +923,13 @@ func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) (
 		return []string{}, fmt.Errorf("invalid revision provided, must be SHA")
 	}
 
-	out, err := m.runCmd("diff", "--name-only", fmt.Sprintf("%s..%s", revision, targetRevision))
+	args := []string{"diff", "--name-only"}
+	if m.partialClone {
+		// rename detection requires the file contents, which are not available in a partial clone
+		args = append(args, "--no-renames")
+	}
+	args = append(args, fmt.Sprintf("%s..%s", revision, targetRevision))
+	out, err := m.runCmd(args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to diff %s..%s: %w", revision, targetRevision, err)
 	}
@@ -811,10 +942,91 @@ func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) (
 	return files, nil
 }
 
+// SparseCheckoutAdd widens the sparse checkout of the working tree to include the given directories. Glob patterns are
+// widened to the directory preceding the first wildcard. Adding the repository root disables sparse checkout. This is
+// a no-op unless sparse checkout is enabled.
+func (m *nativeGitClient) SparseCheckoutAdd(paths []string) error {
+	if !m.sparseCheckout || len(paths) == 0 {
+		return nil
+	}
+	enabled, err := m.runCmd("config", "--bool", "core.sparseCheckout")
+	if err != nil || enabled != "true" {
+		// the whole working tree has been checked out already
+		return nil
+	}
+	var dirs []string
+	for _, p := range paths {
+		dir := sparseCheckoutDir(p)
+		if dir == "" {
+			if err := m.sparseCheckoutCmd("disable"); err != nil {
+				return err
+			}
+			// disable stores the setting in the per-worktree config, which is ignored by this repository
+			_, err := m.runCmd("config", "core.sparseCheckout", "false")
+			return err
+		}
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return m.sparseCheckoutCmd(append([]string{"add"}, dirs...)...)
+}
+
+func (m *nativeGitClient) sparseCheckoutCmd(args ...string) error {
+	args = append([]string{"sparse-checkout"}, args...)
+	if m.partialClone {
+		return m.runCredentialedCmd(args...)
+	}
+	_, err := m.runCmd(args...)
+	return err
+}
+
+// sparseCheckoutDir returns the repository relative directory to add to the sparse checkout for the given path, or an
+// empty string if the whole repository is required.
+func sparseCheckoutDir(p string) string {
+	if i := strings.IndexAny(p, "*?[{"); i >= 0 {
+		p = p[:i]
+		if j := strings.LastIndex(p, "/"); j >= 0 {
+			p = p[:j]
+		} else {
+			p = ""
+		}
+	}
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return ""
+	}
+	return p[1:]
+}
+
+// WorktreeAdd creates a working tree at the given path, which shares the objects of the repository, for the given
+// revision. The files of the revision are not checked out. Working trees whose directories have been deleted are pruned.
+func (m *nativeGitClient) WorktreeAdd(path string, revision string) error {
+	if _, err := m.runCmd("worktree", "prune"); err != nil {
+		return err
+	}
+	_, err := m.runCmd("worktree", "add", "--force", "--detach", "--no-checkout", path, revision)
+	return err
+}
+
+// WorktreeRemove removes the working tree at the given path
+func (m *nativeGitClient) WorktreeRemove(path string) error {
+	if _, err := m.runCmd("worktree", "remove", "--force", path); err != nil {
+		// the working tree might not be known to the repository anymore, e.g. if the repository was re-initialized
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		_, err = m.runCmd("worktree", "prune")
+		return err
+	}
+	return nil
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
-func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
+func (m *nativeGitClient) runGnuPGWrapper(wrapper string, env []string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GNUPGHOME=%s", common.GetGnuPGHomePath()), "LANG=C")
+	cmd.Env = append(cmd.Env, env...)
 	return m.runCmdOutput(cmd, runOpts{})
 }
 
@@ -886,6 +1098,7 @@ func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd, ropts runOpts) (string, er
 		},
 		SkipErrorLogging: ropts.SkipErrorLogging,
 		CaptureStderr:    ropts.CaptureStderr,
+		Limiter:          m.limiter,
 	}
 	return executil.RunWithExecRunOpts(cmd, opts)
 }
@@ -7,9 +7,13 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 )
 
 func runCmd(workingDir string, name string, args ...string) error {
@@ -49,6 +53,25 @@ func Test_nativeGitClient_Fetch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_nativeGitClient_Fetch_Limiter(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	limiter, err := executil.NewLimiter(executil.ResourceLimits{Timeout: time.Nanosecond})
+	require.NoError(t, err)
+	defer limiter.Close()
+	client, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), t.TempDir(), NopCreds{}, true, false, "", "", WithLimiter(limiter))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch("")
+	var limitErr *executil.ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, executil.ResourceLimitTimeout, limitErr.Limit)
+}
+
 func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	tempDir, err := _createEmptyGitRepo()
 	require.NoError(t, err)
@@ -438,3 +461,122 @@ func Test_IsRevisionPresent(t *testing.T) {
 	revisionPresent = client.IsRevisionPresent("invalid-revision")
 	assert.False(t, revisionPresent)
 }
+
+func Test_nativeGitClient_SparseCheckout(t *testing.T) {
+	tempDir := t.TempDir()
+	for _, file := range []string{"README", "apps/a/app.yaml", "apps/b/app.yaml", "base/base.yaml"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tempDir, file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(file), 0o644))
+	}
+	require.NoError(t, runCmd(tempDir, "git", "init"))
+	require.NoError(t, runCmd(tempDir, "git", "config", "uploadpack.allowFilter", "true"))
+	require.NoError(t, runCmd(tempDir, "git", "add", "."))
+	require.NoError(t, runCmd(tempDir, "git", "commit", "-m", "Initial commit"))
+
+	client, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), t.TempDir(), NopCreds{}, true, false, "", "", WithPartialClone(true), WithSparseCheckout(true))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(""))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	require.NoError(t, client.Checkout(commitSHA, false))
+
+	assert.FileExists(t, filepath.Join(client.Root(), "README"))
+	assert.NoDirExists(t, filepath.Join(client.Root(), "apps"))
+
+	require.NoError(t, client.SparseCheckoutAdd([]string{"apps/a/*.yaml"}))
+	assert.FileExists(t, filepath.Join(client.Root(), "apps/a/app.yaml"))
+	assert.NoDirExists(t, filepath.Join(client.Root(), "apps/b"))
+
+	// the index still contains the whole revision
+	files, err := client.LsFiles("*", false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"README", "apps/a/app.yaml", "apps/b/app.yaml", "base/base.yaml"}, files)
+	dirs, err := client.LsDirectories()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"apps", "apps/a", "apps/b", "base"}, dirs)
+
+	require.NoError(t, client.SparseCheckoutAdd([]string{"."}))
+	assert.FileExists(t, filepath.Join(client.Root(), "apps/b/app.yaml"))
+	assert.FileExists(t, filepath.Join(client.Root(), "base/base.yaml"))
+	require.NoError(t, client.SparseCheckoutAdd([]string{"base"}))
+
+	// a client which does not use a partial clone re-initializes the repository
+	fullClient, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), client.Root(), NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	require.NoError(t, fullClient.Init())
+	assert.False(t, fullClient.IsRevisionPresent(commitSHA))
+}
+
+func Test_sparseCheckoutDir(t *testing.T) {
+	assert.Equal(t, "", sparseCheckoutDir(""))
+	assert.Equal(t, "", sparseCheckoutDir("."))
+	assert.Equal(t, "", sparseCheckoutDir("/"))
+	assert.Equal(t, "", sparseCheckoutDir("*.yaml"))
+	assert.Equal(t, "apps", sparseCheckoutDir("apps"))
+	assert.Equal(t, "apps/a", sparseCheckoutDir("/apps/a/"))
+	assert.Equal(t, "apps", sparseCheckoutDir("apps/*/config.json"))
+	assert.Equal(t, "apps", sparseCheckoutDir("apps/**"))
+	assert.Equal(t, "apps/a", sparseCheckoutDir("apps/a/[ab].yaml"))
+}
+
+func Test_nativeGitClient_VerifyCommitSignature_SSH(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not installed")
+	}
+	hackDir, err := filepath.Abs("../../hack")
+	require.NoError(t, err)
+	t.Setenv("PATH", hackDir+":"+os.Getenv("PATH"))
+
+	tempDir := t.TempDir()
+	keyFile := filepath.Join(tempDir, "id_ed25519")
+	require.NoError(t, runCmd(tempDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyFile))
+	pubKey, err := os.ReadFile(keyFile + ".pub")
+	require.NoError(t, err)
+
+	repoDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(repoDir) })
+	require.NoError(t, runCmd(repoDir, "git", "-c", "gpg.format=ssh", "-c", "user.signingkey="+keyFile, "commit", "-S", "-m", "Signed commit", "--allow-empty"))
+
+	client, err := NewClientExt(fmt.Sprintf("file://%s", repoDir), repoDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	keysDir := t.TempDir()
+	t.Setenv("ARGOCD_SSH_SIGNING_KEYS_PATH", keysDir)
+
+	// The key is not a configured signing key
+	verifier, out, err := client.VerifyCommitSignature("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, common.SignatureVerifierSSH, verifier)
+	assert.Contains(t, out, `Good "git" signature with ED25519 key SHA256:`)
+	assert.Contains(t, out, "No principal matched")
+
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "key"), []byte("alice@example.com "+string(pubKey)), 0o600))
+	verifier, out, err = client.VerifyCommitSignature("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, common.SignatureVerifierSSH, verifier)
+	assert.Contains(t, out, `Good "git" signature for alice@example.com with ED25519 key SHA256:`)
+
+	// Unsigned commits result in an empty output
+	verifier, out, err = client.VerifyCommitSignature("HEAD~1")
+	require.NoError(t, err)
+	assert.Empty(t, verifier)
+	assert.Empty(t, out)
+}
+
+func Test_signatureVerifier(t *testing.T) {
+	commit := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor A <a@example.com> 1700000000 +0000\n" +
+		"committer A <a@example.com> 1700000000 +0000\ngpgsig %s\n \n abc\n %s\n\nMessage\n"
+	assert.Equal(t, common.SignatureVerifierGPG, signatureVerifier("commit", fmt.Sprintf(commit, "-----BEGIN PGP SIGNATURE-----", "-----END PGP SIGNATURE-----")))
+	assert.Equal(t, common.SignatureVerifierSSH, signatureVerifier("commit", fmt.Sprintf(commit, "-----BEGIN SSH SIGNATURE-----", "-----END SSH SIGNATURE-----")))
+	assert.Equal(t, common.SignatureVerifierGitsign, signatureVerifier("commit", fmt.Sprintf(commit, "-----BEGIN SIGNED MESSAGE-----", "-----END SIGNED MESSAGE-----")))
+
+	// The message of a commit does not select the verifier
+	assert.Empty(t, signatureVerifier("commit", "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\n-----BEGIN SSH SIGNATURE-----\n"))
+
+	tag := "object 4b825dc642cb6eb9a060e54bf8d69288fbee4904\ntype commit\ntag v1.0.0\ntagger A <a@example.com> 1700000000 +0000\n\n" +
+		"Release\n-----BEGIN SSH SIGNATURE-----\nabc\n-----END SSH SIGNATURE-----\n"
+	assert.Equal(t, common.SignatureVerifierSSH, signatureVerifier("tag", tag))
+	assert.Empty(t, signatureVerifier("tag", "object 4b825dc642cb6eb9a060e54bf8d69288fbee4904\ntype commit\n\nRelease\n"))
+}
@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -22,10 +24,12 @@ import (
 	argoio "github.com/argoproj/gitops-engine/pkg/utils/io"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/golang-jwt/jwt/v4"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	certutil "github.com/argoproj/argo-cd/v2/util/cert"
+	"github.com/argoproj/argo-cd/v2/util/env"
 	argoioutils "github.com/argoproj/argo-cd/v2/util/io"
 )
 
@@ -34,12 +38,21 @@ var (
 	githubAppTokenCache *gocache.Cache
 	// In memory cache for storing oauth2.TokenSource used to generate Google Cloud OAuth tokens
 	googleCloudTokenSource *gocache.Cache
+	// In memory cache for storing the access tokens obtained from token providers until they expire
+	tokenProviderTokenCache *gocache.Cache
+	// tokenProviderHTTPClient is the HTTP client used to request access tokens from token providers
+	tokenProviderHTTPClient = &http.Client{Timeout: 15 * time.Second}
 )
 
 const (
 	// githubAccessTokenUsername is a username that is used to with the github access token
 	githubAccessTokenUsername = "x-access-token"
 	forceBasicAuthHeaderEnv   = "ARGOCD_GIT_AUTH_HEADER"
+	// serviceAccountTokenPath is the path of the service account token of the pod, which is accepted by the Kubernetes
+	// API server and therefore must never be sent to token providers
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// tokenProviderExpiryMargin is the time before their expiry at which access tokens are no longer used
+	tokenProviderExpiryMargin = time.Minute
 )
 
 func init() {
@@ -53,6 +66,7 @@ func init() {
 	githubAppTokenCache = gocache.New(githubAppCredsExp, 1*time.Minute)
 	// oauth2.TokenSource handles fetching new Tokens once they are expired. The oauth2.TokenSource itself does not expire.
 	googleCloudTokenSource = gocache.New(gocache.NoExpiration, 0)
+	tokenProviderTokenCache = gocache.New(gocache.NoExpiration, 1*time.Minute)
 }
 
 type NoopCredsStore struct{}
@@ -568,3 +582,190 @@ func (c GoogleCloudCreds) getAccessToken() (string, error) {
 
 	return token.AccessToken, nil
 }
+
+// TokenProvider obtains short-lived access tokens from an OAuth 2.0 token endpoint, in exchange for the projected
+// service account token of the pod. If a client ID is set, the service account token is used as the client assertion
+// of a client credentials grant, as done by Microsoft Entra workload identity federation. Otherwise, the service
+// account token is exchanged using an OAuth 2.0 token exchange (RFC 8693).
+type TokenProvider struct {
+	// URL is the URL of the token endpoint
+	URL string
+	// ClientID is the ID of the client the token is requested for
+	ClientID string
+	// Audience is the audience of the requested token
+	Audience string
+	// Scope is the space-separated list of scopes of the requested token
+	Scope string
+}
+
+type tokenProviderResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// tokenProviderURLAllowed returns an error unless the given URL is in the list of token provider URLs allowed by the
+// administrator. Token providers are disabled if no URLs are allowed.
+func tokenProviderURLAllowed(tokenURL string) error {
+	allowed := env.StringsFromEnv(common.EnvTokenProviderAllowedURLs, []string{}, ",")
+	for _, allowedURL := range allowed {
+		if strings.TrimSpace(allowedURL) == tokenURL {
+			return nil
+		}
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("token provider URL %q is not allowed: token providers are disabled unless their URLs are allowed using %s", tokenURL, common.EnvTokenProviderAllowedURLs)
+	}
+	return fmt.Errorf("token provider URL %q is not allowed, must use one of the following: %s", tokenURL, strings.Join(allowed, ", "))
+}
+
+// readSubjectToken reads the projected service account token which is exchanged for access tokens. The token must be
+// explicitly configured and only be valid for its dedicated audience, so that it cannot be used to access the
+// Kubernetes API.
+func readSubjectToken() (string, error) {
+	tokenPath := os.Getenv(common.EnvTokenProviderSubjectTokenPath)
+	audience := os.Getenv(common.EnvTokenProviderSubjectTokenAudience)
+	if tokenPath == "" || audience == "" {
+		return "", fmt.Errorf("token providers require a projected service account token with a dedicated audience, configured using %s and %s", common.EnvTokenProviderSubjectTokenPath, common.EnvTokenProviderSubjectTokenAudience)
+	}
+	if filepath.Clean(tokenPath) == serviceAccountTokenPath {
+		return "", fmt.Errorf("the service account token at %s cannot be used for token providers, use a projected service account token with a dedicated audience instead", tokenPath)
+	}
+	data, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read service account token: %w", err)
+	}
+	subjectToken := strings.TrimSpace(string(data))
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(subjectToken, &claims); err != nil {
+		return "", fmt.Errorf("failed to parse service account token: %w", err)
+	}
+	if len(claims.Audience) != 1 || claims.Audience[0] != audience {
+		return "", fmt.Errorf("the service account token at %s must only be valid for the audience %q, but is valid for %q", tokenPath, audience, strings.Join(claims.Audience, ", "))
+	}
+	return subjectToken, nil
+}
+
+// Token returns an access token of the token provider. The token is cached until shortly before it expires.
+func (p TokenProvider) Token(ctx context.Context) (string, error) {
+	if p.URL == "" {
+		return "", errors.New("token provider URL is not set")
+	}
+	if err := tokenProviderURLAllowed(p.URL); err != nil {
+		return "", err
+	}
+	key := fmt.Sprintf("%s %s %s %s", p.URL, p.ClientID, p.Audience, p.Scope)
+	if token, found := tokenProviderTokenCache.Get(key); found {
+		return token.(string), nil
+	}
+
+	subjectToken, err := readSubjectToken()
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	if p.ClientID != "" {
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", p.ClientID)
+		form.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+		form.Set("client_assertion", subjectToken)
+	} else {
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+		form.Set("subject_token", subjectToken)
+		form.Set("subject_token_type", "urn:ietf:params:oauth:token-type:jwt")
+		form.Set("requested_token_type", "urn:ietf:params:oauth:token-type:access_token")
+	}
+	if p.Audience != "" {
+		form.Set("audience", p.Audience)
+	}
+	if p.Scope != "" {
+		form.Set("scope", p.Scope)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := tokenProviderHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token from %s: %w", p.URL, err)
+	}
+	defer argoioutils.Close(resp.Body)
+
+	var res tokenProviderResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&res); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("failed to decode token response of %s: %w", p.URL, err)
+	}
+	if resp.StatusCode != http.StatusOK || res.AccessToken == "" {
+		message := text.FirstNonEmpty(res.ErrorDescription, res.Error, resp.Status)
+		return "", fmt.Errorf("failed to request token from %s: %s", p.URL, message)
+	}
+
+	if expiresIn := time.Duration(res.ExpiresIn) * time.Second; expiresIn > tokenProviderExpiryMargin {
+		tokenProviderTokenCache.Set(key, res.AccessToken, expiresIn-tokenProviderExpiryMargin)
+	}
+	return res.AccessToken, nil
+}
+
+// TokenProviderCreds to authenticate using short-lived access tokens obtained from a token provider. The token is used
+// as the password of the given username, or as a bearer token if no username is given.
+type TokenProviderCreds struct {
+	provider       TokenProvider
+	username       string
+	clientCertData string
+	clientCertKey  string
+	insecure       bool
+	proxy          string
+	noProxy        string
+	store          CredsStore
+}
+
+func NewTokenProviderCreds(provider TokenProvider, username string, clientCertData string, clientCertKey string, insecure bool, proxy string, noProxy string, store CredsStore) GenericHTTPSCreds {
+	return TokenProviderCreds{
+		provider:       provider,
+		username:       username,
+		clientCertData: clientCertData,
+		clientCertKey:  clientCertKey,
+		insecure:       insecure,
+		proxy:          proxy,
+		noProxy:        noProxy,
+		store:          store,
+	}
+}
+
+func (c TokenProviderCreds) Environ() (io.Closer, []string, error) {
+	token, err := c.getAccessToken()
+	if err != nil {
+		return NopCloser{}, nil, fmt.Errorf("failed to get access token from token provider: %w", err)
+	}
+	closer, env, err := NewHTTPSCreds(c.username, token, c.clientCertData, c.clientCertKey, c.insecure, c.proxy, c.noProxy, c.store, false).Environ()
+	if err != nil {
+		return closer, env, err
+	}
+	if c.username == "" {
+		env = append(env, fmt.Sprintf("%s=Authorization: Bearer %s", forceBasicAuthHeaderEnv, token))
+	}
+	return closer, env, nil
+}
+
+func (c TokenProviderCreds) getAccessToken() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	return c.provider.Token(ctx)
+}
+
+func (c TokenProviderCreds) HasClientCert() bool {
+	return c.clientCertData != "" && c.clientCertKey != ""
+}
+
+func (c TokenProviderCreds) GetClientCertData() string {
+	return c.clientCertData
+}
+
+func (c TokenProviderCreds) GetClientCertKey() string {
+	return c.clientCertKey
+}
@@ -1,10 +1,15 @@
 package git
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -16,7 +21,9 @@ import (
 	"golang.org/x/oauth2/google"
 
 	argoio "github.com/argoproj/gitops-engine/pkg/utils/io"
+	"github.com/golang-jwt/jwt/v4"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/cert"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
@@ -388,3 +395,233 @@ func TestGoogleCloudCreds_Environ_cleanup(t *testing.T) {
 	io.Close(closer)
 	assert.Len(t, store.creds, credsLenBefore-1)
 }
+
+func newTokenProviderServer(t *testing.T, requests *[]map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form := map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		*requests = append(*requests, form)
+		w.Header().Set("Content-Type", "application/json")
+		if form["scope"] == "denied" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_scope", "error_description": "scope is not allowed"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": fmt.Sprintf("token-%d", len(*requests)), "expires_in": 3600})
+	}))
+	t.Cleanup(server.Close)
+	// Use the client of the test server so that the proxy settings of the environment are not evaluated and cached
+	httpClient := tokenProviderHTTPClient
+	tokenProviderHTTPClient = server.Client()
+	t.Cleanup(func() { tokenProviderHTTPClient = httpClient })
+	return server
+}
+
+const testSubjectTokenAudience = "argocd-token-provider"
+
+func newSubjectToken(t *testing.T, audience ...string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "system:serviceaccount:argocd:argocd-repo-server", Audience: audience}).SignedString([]byte("test"))
+	require.NoError(t, err)
+	return token
+}
+
+// setTokenProviderSubjectToken configures a projected service account token for the token providers at the given URLs
+// and returns it
+func setTokenProviderSubjectToken(t *testing.T, allowedURLs ...string) string {
+	t.Helper()
+	token := newSubjectToken(t, testSubjectTokenAudience)
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte(token+"\n"), 0o600))
+	t.Setenv(common.EnvTokenProviderSubjectTokenPath, tokenPath)
+	t.Setenv(common.EnvTokenProviderSubjectTokenAudience, testSubjectTokenAudience)
+	t.Setenv(common.EnvTokenProviderAllowedURLs, strings.Join(allowedURLs, ","))
+	tokenProviderTokenCache.Flush()
+	t.Cleanup(tokenProviderTokenCache.Flush)
+	return token
+}
+
+func TestTokenProvider_Token(t *testing.T) {
+	t.Run("token exchange", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		subjectToken := setTokenProviderSubjectToken(t, server.URL)
+
+		token, err := TokenProvider{URL: server.URL, Audience: "gitlab", Scope: "read_repository"}.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", token)
+		require.Len(t, requests, 1)
+		assert.Equal(t, map[string]string{
+			"grant_type":           "urn:ietf:params:oauth:grant-type:token-exchange",
+			"subject_token":        subjectToken,
+			"subject_token_type":   "urn:ietf:params:oauth:token-type:jwt",
+			"requested_token_type": "urn:ietf:params:oauth:token-type:access_token",
+			"audience":             "gitlab",
+			"scope":                "read_repository",
+		}, requests[0])
+	})
+
+	t.Run("client credentials with client assertion", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		subjectToken := setTokenProviderSubjectToken(t, server.URL)
+
+		token, err := TokenProvider{URL: server.URL, ClientID: "my-client", Scope: "499b84ac-1321-427f-aa17-267ca6975798/.default"}.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", token)
+		require.Len(t, requests, 1)
+		assert.Equal(t, map[string]string{
+			"grant_type":            "client_credentials",
+			"client_id":             "my-client",
+			"client_assertion_type": "urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+			"client_assertion":      subjectToken,
+			"scope":                 "499b84ac-1321-427f-aa17-267ca6975798/.default",
+		}, requests[0])
+	})
+
+	t.Run("token is cached", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		provider := TokenProvider{URL: server.URL}
+
+		token, err := provider.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", token)
+		token, err = provider.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", token)
+		assert.Len(t, requests, 1)
+
+		token, err = TokenProvider{URL: server.URL, Audience: "other"}.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "token-2", token)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+
+		_, err := TokenProvider{URL: server.URL, Scope: "denied"}.Token(context.Background())
+		require.ErrorContains(t, err, "scope is not allowed")
+	})
+
+	t.Run("missing service account token", func(t *testing.T) {
+		setTokenProviderSubjectToken(t, "https://login.example.com/token")
+		t.Setenv(common.EnvTokenProviderSubjectTokenPath, filepath.Join(t.TempDir(), "missing"))
+		_, err := TokenProvider{URL: "https://login.example.com/token"}.Token(context.Background())
+		require.ErrorContains(t, err, "failed to read service account token")
+	})
+
+	t.Run("URL not allowed", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, "https://login.example.com/token")
+
+		_, err := TokenProvider{URL: server.URL}.Token(context.Background())
+		require.ErrorContains(t, err, "is not allowed, must use one of the following: https://login.example.com/token")
+		assert.Empty(t, requests)
+	})
+
+	t.Run("no URLs allowed", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t)
+
+		_, err := TokenProvider{URL: server.URL}.Token(context.Background())
+		require.ErrorContains(t, err, "token providers are disabled")
+		assert.Empty(t, requests)
+	})
+
+	t.Run("service account token not configured", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		t.Setenv(common.EnvTokenProviderSubjectTokenPath, "")
+
+		_, err := TokenProvider{URL: server.URL}.Token(context.Background())
+		require.ErrorContains(t, err, "require a projected service account token with a dedicated audience")
+		assert.Empty(t, requests)
+	})
+
+	t.Run("pod service account token", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		t.Setenv(common.EnvTokenProviderSubjectTokenPath, "/var/run/secrets/kubernetes.io/serviceaccount/../serviceaccount/token")
+
+		_, err := TokenProvider{URL: server.URL}.Token(context.Background())
+		require.ErrorContains(t, err, "cannot be used for token providers")
+		assert.Empty(t, requests)
+	})
+
+	t.Run("service account token of other audiences", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		tokenPath := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenPath, []byte(newSubjectToken(t, testSubjectTokenAudience, "https://kubernetes.default.svc")), 0o600))
+		t.Setenv(common.EnvTokenProviderSubjectTokenPath, tokenPath)
+
+		_, err := TokenProvider{URL: server.URL}.Token(context.Background())
+		require.ErrorContains(t, err, "must only be valid for the audience")
+		assert.Empty(t, requests)
+	})
+}
+
+func TestTokenProviderCreds_Environ(t *testing.T) {
+	getAuthHeader := func(env []string) string {
+		for _, envVar := range env {
+			if strings.HasPrefix(envVar, fmt.Sprintf("%s=", forceBasicAuthHeaderEnv)) {
+				return envVar[len(forceBasicAuthHeaderEnv)+1:]
+			}
+		}
+		return ""
+	}
+
+	t.Run("bearer token", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		store := &memoryCredsStore{creds: make(map[string]cred)}
+
+		creds := NewTokenProviderCreds(TokenProvider{URL: server.URL}, "", "", "", false, "", "", store)
+		closer, env, err := creds.Environ()
+		require.NoError(t, err)
+		defer io.Close(closer)
+		assert.Equal(t, "Authorization: Bearer token-1", getAuthHeader(env))
+	})
+
+	t.Run("token as password", func(t *testing.T) {
+		var requests []map[string]string
+		server := newTokenProviderServer(t, &requests)
+		setTokenProviderSubjectToken(t, server.URL)
+		store := &memoryCredsStore{creds: make(map[string]cred)}
+
+		creds := NewTokenProviderCreds(TokenProvider{URL: server.URL}, "oauth2", "", "", false, "", "", store)
+		closer, env, err := creds.Environ()
+		require.NoError(t, err)
+		assert.Empty(t, getAuthHeader(env))
+		require.Len(t, store.creds, 1)
+		for _, c := range store.creds {
+			assert.Equal(t, cred{username: "oauth2", password: "token-1"}, c)
+		}
+		io.Close(closer)
+		assert.Empty(t, store.creds)
+	})
+
+	t.Run("token provider error", func(t *testing.T) {
+		setTokenProviderSubjectToken(t, "https://login.example.com/token")
+		t.Setenv(common.EnvTokenProviderSubjectTokenPath, filepath.Join(t.TempDir(), "missing"))
+		creds := NewTokenProviderCreds(TokenProvider{URL: "https://login.example.com/token"}, "", "", "", false, "", "", NoopCredsStore{})
+		closer, env, err := creds.Environ()
+		assert.Equal(t, NopCloser{}, closer)
+		assert.Nil(t, env)
+		require.ErrorContains(t, err, "failed to get access token from token provider")
+	})
+}
@@ -160,6 +160,36 @@ func (_m *Client) IsRevisionPresent(revision string) bool {
 	return r0
 }
 
+// LsDirectories provides a mock function with given fields:
+func (_m *Client) LsDirectories() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LsDirectories")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LsFiles provides a mock function with given fields: path, enableNewGitFileGlobbing
 func (_m *Client) LsFiles(path string, enableNewGitFileGlobbing bool) ([]string, error) {
 	ret := _m.Called(path, enableNewGitFileGlobbing)
@@ -326,6 +356,24 @@ func (_m *Client) Root() string {
 	return r0
 }
 
+// SparseCheckoutAdd provides a mock function with given fields: paths
+func (_m *Client) SparseCheckoutAdd(paths []string) error {
+	ret := _m.Called(paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SparseCheckoutAdd")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(paths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Submodule provides a mock function with given fields:
 func (_m *Client) Submodule() error {
 	ret := _m.Called()
@@ -345,7 +393,7 @@ func (_m *Client) Submodule() error {
 }
 
 // VerifyCommitSignature provides a mock function with given fields: _a0
-func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
+func (_m *Client) VerifyCommitSignature(_a0 string) (string, string, error) {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
@@ -353,8 +401,9 @@ func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 	}
 
 	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (string, string, error)); ok {
 		return rf(_a0)
 	}
 	if rf, ok := ret.Get(0).(func(string) string); ok {
@@ -363,13 +412,55 @@ func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(string) string); ok {
 		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// WorktreeAdd provides a mock function with given fields: path, revision
+func (_m *Client) WorktreeAdd(path string, revision string) error {
+	ret := _m.Called(path, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorktreeAdd")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorktreeRemove provides a mock function with given fields: path
+func (_m *Client) WorktreeRemove(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorktreeRemove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -0,0 +1,98 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+// gitsignProgram is the program Git runs to verify keyless Sigstore signatures
+const gitsignProgram = "gitsign"
+
+// signatureVerifiers maps the first line of the signatures Git supports to the programs Git verifies them with
+var signatureVerifiers = map[string]string{
+	"-----BEGIN PGP SIGNATURE-----":  common.SignatureVerifierGPG,
+	"-----BEGIN PGP MESSAGE-----":    common.SignatureVerifierGPG,
+	"-----BEGIN SSH SIGNATURE-----":  common.SignatureVerifierSSH,
+	"-----BEGIN SIGNED MESSAGE-----": common.SignatureVerifierGitsign,
+}
+
+// signatureVerifier returns the program Git verifies the signature of the given commit or tag object with, which is
+// selected by the format of the signature. It returns an empty string if the object is not signed.
+func signatureVerifier(objectType string, object string) string {
+	for _, line := range strings.Split(object, "\n") {
+		if objectType == "commit" {
+			if line == "" {
+				// The signature of a commit is one of its headers, which end with the first empty line
+				break
+			}
+			header, value, _ := strings.Cut(line, " ")
+			if header != "gpgsig" && header != "gpgsig-sha256" {
+				continue
+			}
+			line = value
+		}
+		if verifier, ok := signatureVerifiers[strings.TrimSpace(line)]; ok {
+			return verifier
+		}
+	}
+	return ""
+}
+
+// writeAllowedSignersFile concatenates the SSH allowed signers entries stored in the files of the given directory into
+// a new temporary file and returns its path. Hidden files, like the ones Kubernetes uses to manage ConfigMap volumes,
+// are ignored. The file is empty if the directory does not exist.
+func writeAllowedSignersFile(keysPath string) (string, error) {
+	var entries []string
+	files, err := os.ReadDir(keysPath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("error reading SSH signing keys from %s: %w", keysPath, err)
+	}
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), ".") || f.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(keysPath, f.Name()))
+		if err != nil {
+			return "", fmt.Errorf("error reading SSH signing key %s: %w", f.Name(), err)
+		}
+		entries = append(entries, strings.TrimSpace(string(data)))
+	}
+	file, err := os.CreateTemp("", "allowed-signers")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Errorf("error closing file %q: %v", file.Name(), err)
+		}
+	}()
+	if len(entries) > 0 {
+		if _, err := file.WriteString(strings.Join(entries, "\n") + "\n"); err != nil {
+			_ = os.Remove(file.Name())
+			return "", err
+		}
+	}
+	return file.Name(), nil
+}
+
+// signatureVerificationEnviron returns the environment for verifying SSH signatures against the given allowed signers
+// file and keyless Sigstore signatures using gitsign, against the configured trust root if any
+func signatureVerificationEnviron(allowedSignersFile string) []string {
+	env := []string{
+		"GIT_CONFIG_COUNT=2",
+		"GIT_CONFIG_KEY_0=gpg.ssh.allowedSignersFile",
+		"GIT_CONFIG_VALUE_0=" + allowedSignersFile,
+		"GIT_CONFIG_KEY_1=gpg.x509.program",
+		"GIT_CONFIG_VALUE_1=" + gitsignProgram,
+	}
+	if trustRoot := os.Getenv(common.EnvSigstoreTrustRoot); trustRoot != "" {
+		env = append(env, "SIGSTORE_ROOT_FILE="+trustRoot)
+	}
+	return env
+}
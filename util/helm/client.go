@@ -60,6 +60,7 @@ type Client interface {
 	GetIndex(noCache bool, maxIndexSize int64) (*Index, error)
 	GetTags(chart string, noCache bool) (*TagsList, error)
 	TestHelmOCI() (bool, error)
+	VerifyChart(chart string, version string, project string, passCredentials bool) (string, string, error)
 }
 
 type ClientOpts func(c *nativeHelmChart)
@@ -76,6 +77,13 @@ func WithChartPaths(chartPaths argoio.TempPaths) ClientOpts {
 	}
 }
 
+// WithLimiter limits the resources consumed by the commands run to pull charts
+func WithLimiter(limiter *executil.Limiter) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.limiter = limiter
+	}
+}
+
 func NewClient(repoURL string, creds Creds, enableOci bool, proxy string, noProxy string, opts ...ClientOpts) Client {
 	return NewClientWithLock(repoURL, creds, globalLock, enableOci, proxy, noProxy, opts...)
 }
@@ -107,6 +115,7 @@ type nativeHelmChart struct {
 	indexCache      indexCache
 	proxy           string
 	noProxy         string
+	limiter         *executil.Limiter
 }
 
 func fileExist(filePath string) (bool, error) {
@@ -125,8 +134,10 @@ func (c *nativeHelmChart) CleanChartCache(chart string, version string, project
 	if err != nil {
 		return fmt.Errorf("error getting cached chart path: %w", err)
 	}
-	if err := os.RemoveAll(cachePath); err != nil {
-		return fmt.Errorf("error removing chart cache at %s: %w", cachePath, err)
+	for _, p := range []string{cachePath, provenancePath(cachePath), digestPath(cachePath)} {
+		if err := os.RemoveAll(p); err != nil {
+			return fmt.Errorf("error removing chart cache at %s: %w", p, err)
+		}
 	}
 	return nil
 }
@@ -155,6 +166,7 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		return "", nil, fmt.Errorf("error creating Helm command: %w", err)
 	}
 	defer helmCmd.Close()
+	helmCmd.limiter = c.limiter
 
 	// throw away temp directory that stores extracted chart and should be deleted as soon as no longer needed by returned closer
 	tempDir, err := files.CreateTempDir(os.TempDir())
@@ -201,11 +213,18 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 			}
 
 			// 'helm pull' ensures that chart is downloaded into temp directory
-			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds)
+			out, err := helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds)
 			if err != nil {
 				_ = os.RemoveAll(tempDir)
 				return "", nil, fmt.Errorf("error pulling OCI chart: %w", err)
 			}
+			// remember the digest of the pulled chart, so that its signature can be verified later on
+			if m := ociDigestMatch.FindStringSubmatch(out); m != nil {
+				if err := os.WriteFile(digestPath(cachedChartPath), []byte(m[1]), 0o644); err != nil {
+					_ = os.RemoveAll(tempDir)
+					return "", nil, fmt.Errorf("error writing digest of OCI chart: %w", err)
+				}
+			}
 		} else {
 			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, c.creds, passCredentials)
 			if err != nil {
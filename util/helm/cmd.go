@@ -27,6 +27,8 @@ type Cmd struct {
 	IsHelmOci bool
 	proxy     string
 	noProxy   string
+	// limiter limits the resources consumed by the commands, unless it is nil
+	limiter *executil.Limiter
 }
 
 func NewCmd(workDir string, version string, proxy string, noProxy string) (*Cmd, error) {
@@ -51,6 +53,11 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(args ...string) (string, string, error) {
+	return c.runWithLimiter(c.limiter, args...)
+}
+
+// runWithLimiter runs helm with the resource limits of the given limiter
+func (c Cmd) runWithLimiter(limiter *executil.Limiter, args ...string) (string, string, error) {
 	cmd := exec.Command("helm", args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -68,7 +75,7 @@ func (c Cmd) run(args ...string) (string, string, error) {
 
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy, c.noProxy)
 
-	out, err := executil.RunWithRedactor(cmd, redactor)
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Redactor: redactor, Limiter: limiter})
 	fullCommand := executil.GetCommandArgsToLog(cmd)
 	if err != nil {
 		return out, fullCommand, fmt.Errorf("failed to get command args to log: %w", err)
@@ -218,6 +225,15 @@ func writeToTmp(data []byte) (string, argoio.Closer, error) {
 }
 
 func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, passCredentials bool) (string, error) {
+	return c.fetch(repo, chartName, version, destination, creds, passCredentials, false)
+}
+
+// FetchWithProvenance fetches the chart along with its provenance file
+func (c *Cmd) FetchWithProvenance(repo, chartName, version, destination string, creds Creds, passCredentials bool) (string, error) {
+	return c.fetch(repo, chartName, version, destination, creds, passCredentials, true)
+}
+
+func (c *Cmd) fetch(repo, chartName, version, destination string, creds Creds, passCredentials bool, provenance bool) (string, error) {
 	args := []string{"pull", "--destination", destination}
 	if version != "" {
 		args = append(args, "--version", version)
@@ -256,6 +272,9 @@ func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, p
 	if passCredentials {
 		args = append(args, "--pass-credentials")
 	}
+	if provenance {
+		args = append(args, "--prov")
+	}
 
 	out, _, err := c.run(args...)
 	if err != nil {
@@ -341,6 +360,8 @@ type TemplateOpts struct {
 	ExtraValues pathutil.ResolvedFilePath
 	SkipCrds    bool
 	SkipTests   bool
+	// Limiter limits the resources consumed by `helm template`, unless it is nil
+	Limiter *executil.Limiter
 }
 
 func cleanSetParameters(val string) string {
@@ -413,8 +434,13 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 		args = append(args, "--skip-tests")
 	}
 
-	out, command, err := c.run(args...)
+	out, command, err := c.runWithLimiter(opts.Limiter, args...)
 	if err != nil {
+		var limitErr *executil.ResourceLimitExceededError
+		if errors.As(err, &limitErr) {
+			limitErr.Command = apiVersionsRemover.ReplaceAllString(limitErr.Command, "<api versions removed> ")
+			return "", command, limitErr
+		}
 		msg := err.Error()
 		if strings.Contains(msg, "--api-versions") {
 			log.Debug(msg)
@@ -35,8 +35,9 @@ type Helm interface {
 	Template(opts *TemplateOpts) (string, string, error)
 	// GetParameters returns a list of chart parameters taking into account values in provided YAML files.
 	GetParameters(valuesFiles []pathutil.ResolvedFilePath, appPath, repoRoot string) (map[string]string, error)
-	// DependencyBuild runs `helm dependency build` to download a chart's dependencies
-	DependencyBuild() error
+	// DependencyBuild runs `helm dependency build` to download a chart's dependencies. The commands are run with the
+	// resource limits of the given limiter, unless it is nil.
+	DependencyBuild(limiter *executil.Limiter) error
 	// Dispose deletes temp resources
 	Dispose()
 }
@@ -74,10 +75,12 @@ func (h *helm) Template(templateOpts *TemplateOpts) (string, string, error) {
 	return out, command, nil
 }
 
-func (h *helm) DependencyBuild() error {
+func (h *helm) DependencyBuild(limiter *executil.Limiter) error {
 	isHelmOci := h.cmd.IsHelmOci
+	h.cmd.limiter = limiter
 	defer func() {
 		h.cmd.IsHelmOci = isHelmOci
+		h.cmd.limiter = nil
 	}()
 
 	for i := range h.repos {
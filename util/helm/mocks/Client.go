@@ -157,6 +157,41 @@ func (_m *Client) TestHelmOCI() (bool, error) {
 	return r0, r1
 }
 
+// VerifyChart provides a mock function with given fields: chart, version, project, passCredentials
+func (_m *Client) VerifyChart(chart string, version string, project string, passCredentials bool) (string, string, error) {
+	ret := _m.Called(chart, version, project, passCredentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyChart")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) (string, string, error)); ok {
+		return rf(chart, version, project, passCredentials)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) string); ok {
+		r0 = rf(chart, version, project, passCredentials)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, bool) string); ok {
+		r1 = rf(chart, version, project, passCredentials)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, string, bool) error); ok {
+		r2 = rf(chart, version, project, passCredentials)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClient(t interface {
@@ -0,0 +1,364 @@
+package helm
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	argoexec "github.com/argoproj/pkg/exec"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+)
+
+// The verification result of cosign signatures is reported in the same manner as gitsign reports the verification
+// result of commit signatures, so that it can be parsed along with the output of GnuPG.
+const (
+	cosignGoodKeySignature      = "cosign: Good signature with key %s"
+	cosignGoodIdentitySignature = "cosign: Good signature from [%s](%s)"
+)
+
+// Regular expression to match the digest of a file in the files section of a provenance file
+var provenanceFileMatch = regexp.MustCompile(`^\s+(\S+):\s+(sha256:[a-f0-9]{64})\s*$`)
+
+// Regular expression to match the digest of an OCI chart in the output of 'helm pull'
+var ociDigestMatch = regexp.MustCompile(`(?m)^Digest:\s+(sha256:[a-f0-9]{64})\s*$`)
+
+var cosignRedactor = func(text string) string {
+	return regexp.MustCompile("(--registry-username|--registry-password) [^ ]*").ReplaceAllString(text, "$1 ******")
+}
+
+// provenancePath returns the path of the provenance file of the cached chart
+func provenancePath(cachedChartPath string) string {
+	return cachedChartPath + ".prov"
+}
+
+// digestPath returns the path of the file storing the digest of the cached OCI chart
+func digestPath(cachedChartPath string) string {
+	return cachedChartPath + ".digest"
+}
+
+// VerifyChart verifies the signature of a chart, which must have been extracted before. The provenance file of charts
+// from Helm repositories is verified using GnuPG, and the cosign signature of OCI charts is verified against the
+// configured cosign public keys or, if none of them matches, as keyless signature. The program which verified the
+// signature is returned along with the output of the verification, which is empty if the chart is not signed.
+func (c *nativeHelmChart) VerifyChart(chart string, version string, project string, passCredentials bool) (string, string, error) {
+	cachedChartPath, err := c.getCachedChartPath(chart, version, project)
+	if err != nil {
+		return "", "", fmt.Errorf("error getting cached chart path: %w", err)
+	}
+
+	c.repoLock.Lock(cachedChartPath)
+	defer c.repoLock.Unlock(cachedChartPath)
+
+	exists, err := fileExist(cachedChartPath)
+	if err != nil {
+		return "", "", fmt.Errorf("error checking existence of cached chart path: %w", err)
+	}
+	if !exists {
+		return "", "", fmt.Errorf("chart %s:%s has not been fetched", chart, version)
+	}
+
+	if c.enableOci {
+		out, err := c.verifyCosignSignature(chart, version, cachedChartPath)
+		return common.SignatureVerifierCosign, out, err
+	}
+	out, err := c.verifyProvenance(chart, version, cachedChartPath, passCredentials)
+	return common.SignatureVerifierGPG, out, err
+}
+
+func (c *nativeHelmChart) verifyProvenance(chart string, version string, cachedChartPath string, passCredentials bool) (string, error) {
+	provPath := provenancePath(cachedChartPath)
+	exists, err := fileExist(provPath)
+	if err != nil {
+		return "", fmt.Errorf("error checking existence of provenance file: %w", err)
+	}
+	if !exists {
+		signed, err := c.fetchProvenance(chart, version, provPath, passCredentials)
+		if err != nil {
+			return "", err
+		}
+		if !signed {
+			return "", nil
+		}
+	}
+
+	provData, err := os.ReadFile(provPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading provenance file: %w", err)
+	}
+	digests, err := provenanceDigests(provData)
+	if err != nil {
+		return "", err
+	}
+	digest, err := fileDigest(cachedChartPath)
+	if err != nil {
+		return "", err
+	}
+	if !digests[digest] {
+		return "", fmt.Errorf("digest %s of chart %s:%s does not match its provenance file", digest, chart, version)
+	}
+
+	// The provenance file is a clear-signed document, which embeds the digest of the chart
+	cmd := exec.Command("gpg-wrapper.sh", "--no-permission-warning", "--verify", provPath)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("GNUPGHOME=%s", common.GetGnuPGHomePath()), "LANG=C")
+	out, err := executil.Run(cmd)
+	if err != nil {
+		return "", fmt.Errorf("error verifying provenance file of chart %s:%s: %w", chart, version, err)
+	}
+	return out, nil
+}
+
+// fetchProvenance downloads the provenance file of a chart to the given path. It returns false if the chart does not
+// have a provenance file.
+func (c *nativeHelmChart) fetchProvenance(chart string, version string, provPath string, passCredentials bool) (bool, error) {
+	helmCmd, err := NewCmdWithVersion("", false, c.proxy, c.noProxy)
+	if err != nil {
+		return false, fmt.Errorf("error creating Helm command: %w", err)
+	}
+	defer helmCmd.Close()
+
+	tempDest, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return false, fmt.Errorf("error creating temporary destination directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDest) }()
+
+	_, err = helmCmd.FetchWithProvenance(c.repoURL, chart, version, tempDest, c.creds, passCredentials)
+	if err != nil {
+		if strings.Contains(err.Error(), "failed to fetch provenance") {
+			return false, nil
+		}
+		return false, fmt.Errorf("error fetching provenance file: %w", err)
+	}
+
+	provFiles, err := filepath.Glob(filepath.Join(tempDest, "*.prov"))
+	if err != nil {
+		return false, fmt.Errorf("error reading directory %s: %w", tempDest, err)
+	}
+	if len(provFiles) != 1 {
+		return false, fmt.Errorf("expected 1 provenance file, found %v", len(provFiles))
+	}
+	if err := os.Rename(provFiles[0], provPath); err != nil {
+		return false, fmt.Errorf("error renaming file from %s to %s: %w", provFiles[0], provPath, err)
+	}
+	return true, nil
+}
+
+// provenanceDigests returns the digests of the files section of the signed message of a provenance file
+func provenanceDigests(data []byte) (map[string]bool, error) {
+	digests := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	inMessage := false
+	inFiles := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "-----BEGIN PGP SIGNED MESSAGE-----":
+			inMessage = true
+		case line == "-----BEGIN PGP SIGNATURE-----":
+			inMessage = false
+		case !inMessage:
+		case line == "files:":
+			inFiles = true
+		case inFiles:
+			m := provenanceFileMatch.FindStringSubmatch(strings.TrimPrefix(line, "- "))
+			if m == nil {
+				inFiles = false
+				continue
+			}
+			digests[m[2]] = true
+		}
+	}
+	if len(digests) == 0 {
+		return nil, errors.New("provenance file does not contain any file digests")
+	}
+	return digests, nil
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer argoio.Close(f)
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error reading %s: %w", path, err)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type cosignKey struct {
+	fingerprint string
+	path        string
+}
+
+// CosignKeyFingerprint returns the SHA256 fingerprint of the DER encoded PEM public key, in the same format as the
+// fingerprints of SSH keys
+func CosignKeyFingerprint(pemData []byte) (string, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return "", errors.New("no PEM encoded public key found")
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// readCosignKeys reads the cosign public keys from the given directory, sorted by their fingerprints
+func readCosignKeys(keysPath string) ([]cosignKey, error) {
+	var keys []cosignKey
+	entries, err := os.ReadDir(keysPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading cosign keys from %s: %w", keysPath, err)
+	}
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") || e.IsDir() {
+			continue
+		}
+		path := filepath.Join(keysPath, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading cosign key %s: %w", e.Name(), err)
+		}
+		fingerprint, err := CosignKeyFingerprint(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign key %s: %w", e.Name(), err)
+		}
+		keys = append(keys, cosignKey{fingerprint: fingerprint, path: path})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].fingerprint < keys[j].fingerprint
+	})
+	return keys, nil
+}
+
+func (c *nativeHelmChart) verifyCosignSignature(chart string, version string, cachedChartPath string) (string, error) {
+	ref := fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(c.repoURL, "/"), chart, version)
+	if digest, err := os.ReadFile(digestPath(cachedChartPath)); err == nil && len(digest) > 0 {
+		// Verify the digest of the chart which has been pulled, rather than the digest the tag points to now
+		ref = fmt.Sprintf("%s/%s@%s", strings.TrimSuffix(c.repoURL, "/"), chart, strings.TrimSpace(string(digest)))
+	}
+
+	keys, err := readCosignKeys(common.GetCosignKeysPath())
+	if err != nil {
+		return "", err
+	}
+
+	signed := false
+	lastError := ""
+	handleError := func(err error) error {
+		var execErr *exec.Error
+		if errors.As(err, &execErr) {
+			return fmt.Errorf("error running cosign: %w", err)
+		}
+		msg := err.Error()
+		var cmdErr *argoexec.CmdError
+		if errors.As(err, &cmdErr) && cmdErr.Stderr != "" {
+			msg = cmdErr.Stderr
+		}
+		if !strings.Contains(msg, "no signatures found") {
+			signed = true
+			lastError = lastLine(msg)
+		}
+		return nil
+	}
+
+	for _, k := range keys {
+		_, err := c.runCosign("verify", "--key", k.path, ref)
+		if err == nil {
+			return fmt.Sprintf(cosignGoodKeySignature, k.fingerprint), nil
+		}
+		if err := handleError(err); err != nil {
+			return "", err
+		}
+	}
+
+	// The identity of keyless signatures is verified against the Sigstore identities of the project by the caller
+	out, err := c.runCosign("verify", "--certificate-identity-regexp", ".*", "--certificate-oidc-issuer-regexp", ".*", ref)
+	if err == nil {
+		subject, issuer, err := parseCosignIdentity(out)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(cosignGoodIdentitySignature, subject, issuer), nil
+	}
+	if err := handleError(err); err != nil {
+		return "", err
+	}
+
+	if !signed {
+		return "", nil
+	}
+	return "cosign: " + lastError, nil
+}
+
+func (c *nativeHelmChart) runCosign(args ...string) (string, error) {
+	if c.creds.Username != "" && c.creds.Password != "" {
+		args = append(args, "--registry-username", c.creds.Username, "--registry-password", c.creds.Password)
+	}
+	if c.creds.CAPath != "" {
+		args = append(args, "--registry-cacert", c.creds.CAPath)
+	}
+	if len(c.creds.CertData) > 0 && len(c.creds.KeyData) > 0 {
+		certPath, certCloser, err := writeToTmp(c.creds.CertData)
+		if err != nil {
+			return "", fmt.Errorf("failed to write certificate data to temporary file: %w", err)
+		}
+		defer argoio.Close(certCloser)
+		keyPath, keyCloser, err := writeToTmp(c.creds.KeyData)
+		if err != nil {
+			return "", fmt.Errorf("failed to write key data to temporary file: %w", err)
+		}
+		defer argoio.Close(keyCloser)
+		args = append(args, "--registry-client-cert", certPath, "--registry-client-key", keyPath)
+	}
+	if c.creds.InsecureSkipVerify {
+		args = append(args, "--allow-insecure-registry")
+	}
+	cmd := exec.Command("cosign", args...)
+	cmd.Env = os.Environ()
+	if trustRoot := os.Getenv(common.EnvSigstoreTrustRoot); trustRoot != "" {
+		cmd.Env = append(cmd.Env, "SIGSTORE_ROOT_FILE="+trustRoot)
+	}
+	return executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Redactor: cosignRedactor, SkipErrorLogging: true})
+}
+
+// parseCosignIdentity returns the subject and issuer of the certificate of the first keyless signature in the output
+// of 'cosign verify'
+func parseCosignIdentity(out string) (string, string, error) {
+	var payloads []struct {
+		Optional map[string]interface{} `json:"optional"`
+	}
+	if err := json.Unmarshal([]byte(out), &payloads); err != nil {
+		return "", "", fmt.Errorf("error parsing output of cosign: %w", err)
+	}
+	for _, p := range payloads {
+		subject, _ := p.Optional["Subject"].(string)
+		issuer, _ := p.Optional["Issuer"].(string)
+		if subject != "" && issuer != "" {
+			return subject, issuer, nil
+		}
+	}
+	return "", "", errors.New("no certificate identity found in output of cosign")
+}
+
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,131 @@
+package jsonnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	gojsonnet "github.com/google/go-jsonnet"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+const (
+	// BinaryName is the name of the command of the argocd binary which evaluates a Jsonnet file in a child process
+	BinaryName = "argocd-jsonnet"
+	// binaryNameEnv is the environment variable which selects the command run by the argocd binary
+	binaryNameEnv = "ARGOCD_BINARY_NAME"
+)
+
+// Var is a top-level argument or an external variable of a Jsonnet evaluation
+type Var struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Code is true if the value is Jsonnet code rather than a string
+	Code bool `json:"code,omitempty"`
+}
+
+// Evaluation describes the evaluation of a Jsonnet file
+type Evaluation struct {
+	// File is the path of the evaluated file
+	File string `json:"file"`
+	// JPaths are the directories in which imported files are searched
+	JPaths  []string `json:"jpaths,omitempty"`
+	TLAs    []Var    `json:"tlas,omitempty"`
+	ExtVars []Var    `json:"extVars,omitempty"`
+	// MaxStack is the maximum stack depth. Zero means the default of go-jsonnet.
+	MaxStack int `json:"maxStack,omitempty"`
+}
+
+// Evaluate evaluates the file in-process and returns its JSON output
+func (e *Evaluation) Evaluate() (string, error) {
+	vm := gojsonnet.MakeVM()
+	if e.MaxStack > 0 {
+		vm.MaxStack = e.MaxStack
+	}
+	for _, arg := range e.TLAs {
+		if arg.Code {
+			vm.TLACode(arg.Name, arg.Value)
+		} else {
+			vm.TLAVar(arg.Name, arg.Value)
+		}
+	}
+	for _, extVar := range e.ExtVars {
+		if extVar.Code {
+			vm.ExtCode(extVar.Name, extVar.Value)
+		} else {
+			vm.ExtVar(extVar.Name, extVar.Value)
+		}
+	}
+	vm.Importer(&gojsonnet.FileImporter{JPaths: e.JPaths})
+	return vm.EvaluateFile(e.File)
+}
+
+// EvaluateWithLimiter evaluates the file with the resource limits of the given limiter. Since an in-process evaluation
+// cannot be interrupted, the file is evaluated by a child process, which is killed once it exceeds a limit. The file
+// is evaluated in-process if the limiter is nil.
+func (e *Evaluation) EvaluateWithLimiter(limiter *executil.Limiter) (string, error) {
+	if limiter == nil {
+		return e.Evaluate()
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to get path of the argocd binary: %w", err)
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Jsonnet evaluation: %w", err)
+	}
+	cmd := exec.Command(binary)
+	// The arguments are only used to identify the evaluation in logs and errors, since the command is selected by the
+	// environment
+	cmd.Args = []string{"jsonnet", filepath.Base(e.File)}
+	cmd.Env = append(os.Environ(), binaryNameEnv+"="+BinaryName)
+	cmd.Stdin = bytes.NewReader(data)
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Limiter: limiter, SkipErrorLogging: true})
+	if err != nil {
+		// Evaluation errors are written to the standard output, see RunChildProcess
+		var cmdErr *argoexec.CmdError
+		if errors.As(err, &cmdErr) && out != "" {
+			return "", errors.New(out)
+		}
+		return "", err
+	}
+	return out, nil
+}
+
+// IsChildProcess returns true if the process has been started by EvaluateWithLimiter to evaluate a file
+func IsChildProcess() bool {
+	return os.Getenv(binaryNameEnv) == BinaryName
+}
+
+// RunChildProcess evaluates the Evaluation read from the standard input, writes its output to the standard output and
+// exits. It is run by the child processes of EvaluateWithLimiter. If the evaluation fails, the error is written to the
+// standard output as well, since the standard error may contain unrelated messages logged while the binary is
+// initialized.
+func RunChildProcess() {
+	if err := serveEvaluation(os.Stdin, os.Stdout); err != nil {
+		fmt.Print(err.Error())
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func serveEvaluation(in io.Reader, out io.Writer) error {
+	var e Evaluation
+	if err := json.NewDecoder(in).Decode(&e); err != nil {
+		return fmt.Errorf("failed to decode Jsonnet evaluation: %w", err)
+	}
+	output, err := e.Evaluate()
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(out, output)
+	return err
+}
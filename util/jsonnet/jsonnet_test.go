@@ -0,0 +1,96 @@
+package jsonnet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+func TestMain(m *testing.M) {
+	// The test binary evaluates the files in place of the argocd binary
+	if IsChildProcess() {
+		RunChildProcess()
+	}
+	os.Exit(m.Run())
+}
+
+func writeJsonnet(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func newLimiter(t *testing.T, limits executil.ResourceLimits) *executil.Limiter {
+	t.Helper()
+	limiter, err := executil.NewLimiter(limits)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, limiter.Close()) })
+	return limiter
+}
+
+func TestEvaluation(t *testing.T) {
+	dir := writeJsonnet(t, map[string]string{
+		"main.jsonnet":  `local lib = import 'lib.libsonnet'; function(name, replicas) { name: name, replicas: replicas, env: std.extVar('env'), lib: lib }`,
+		"lib.libsonnet": `{ version: std.extVar('version') }`,
+	})
+	evaluation := &Evaluation{
+		File:    filepath.Join(dir, "main.jsonnet"),
+		JPaths:  []string{dir},
+		TLAs:    []Var{{Name: "name", Value: "guestbook"}, {Name: "replicas", Value: "1 + 2", Code: true}},
+		ExtVars: []Var{{Name: "env", Value: "prod"}, {Name: "version", Value: "{ major: 1 }", Code: true}},
+	}
+	expected := `{"env":"prod","lib":{"version":{"major":1}},"name":"guestbook","replicas":3}`
+
+	out, err := evaluation.Evaluate()
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, out)
+
+	out, err = evaluation.EvaluateWithLimiter(nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, out)
+
+	out, err = evaluation.EvaluateWithLimiter(newLimiter(t, executil.ResourceLimits{Timeout: time.Minute}))
+	require.NoError(t, err)
+	assert.JSONEq(t, expected, out)
+}
+
+func TestEvaluateWithLimiter_Error(t *testing.T) {
+	dir := writeJsonnet(t, map[string]string{"main.jsonnet": `error 'invalid configuration'`})
+	evaluation := &Evaluation{File: filepath.Join(dir, "main.jsonnet")}
+
+	_, err := evaluation.EvaluateWithLimiter(newLimiter(t, executil.ResourceLimits{Timeout: time.Minute}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "RUNTIME ERROR: invalid configuration")
+}
+
+func TestEvaluateWithLimiter_Timeout(t *testing.T) {
+	dir := writeJsonnet(t, map[string]string{"main.jsonnet": `local loop(n) = if n == 0 then 0 else loop(n - 1) + 0 * loop(n - 1); loop(100)`})
+	evaluation := &Evaluation{File: filepath.Join(dir, "main.jsonnet"), MaxStack: 1000}
+
+	start := time.Now()
+	_, err := evaluation.EvaluateWithLimiter(newLimiter(t, executil.ResourceLimits{Timeout: 500 * time.Millisecond}))
+	var limitErr *executil.ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, executil.ResourceLimitTimeout, limitErr.Limit)
+	assert.Equal(t, "jsonnet main.jsonnet", limitErr.Command)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestEvaluateWithLimiter_OutputSize(t *testing.T) {
+	dir := writeJsonnet(t, map[string]string{"main.jsonnet": `std.makeArray(1000, function(i) 'item-%d' % i)`})
+	evaluation := &Evaluation{File: filepath.Join(dir, "main.jsonnet")}
+
+	_, err := evaluation.EvaluateWithLimiter(newLimiter(t, executil.ResourceLimits{OutputSize: 1024}))
+	var limitErr *executil.ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, executil.ResourceLimitOutputSize, limitErr.Limit)
+}
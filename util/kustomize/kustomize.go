@@ -31,6 +31,8 @@ type Image = string
 type BuildOpts struct {
 	KubeVersion string
 	APIVersions []string
+	// Limiter limits the resources consumed by `kustomize build`, unless it is nil
+	Limiter *executil.Limiter
 }
 
 // Kustomize provides wrapper functionality around the `kustomize` command.
@@ -332,7 +334,11 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	cmd.Env = proxy.UpsertEnv(cmd, k.proxy, k.noProxy)
 	cmd.Dir = k.repoRoot
 	commands = append(commands, executil.GetCommandArgsToLog(cmd))
-	out, err := executil.Run(cmd)
+	var limiter *executil.Limiter
+	if buildOpts != nil {
+		limiter = buildOpts.Limiter
+	}
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Limiter: limiter})
 	if err != nil {
 		return nil, nil, nil, err
 	}
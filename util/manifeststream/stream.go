@@ -2,9 +2,12 @@ package manifeststream
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -38,6 +41,20 @@ type RepoStreamReceiver interface {
 	Recv() (*apiclient.ManifestRequestWithFiles, error)
 }
 
+// Defines the contract for the manifest response sender, i.e. the repo server
+type ManifestResponseStreamSender interface {
+	Send(*apiclient.ManifestResponseChunk) error
+}
+
+// Defines the contract for the manifest response receiver, i.e. the application controller
+type ManifestResponseStreamReceiver interface {
+	Recv() (*apiclient.ManifestResponseChunk, error)
+}
+
+// DefaultManifestChunkSize is the maximum size of the compressed manifests sent in a single chunk of a manifest response
+// stream
+const DefaultManifestChunkSize = 1024 * 1024
+
 // SendApplicationManifestQueryWithFiles compresses a folder and sends it over the stream
 func SendApplicationManifestQueryWithFiles(ctx context.Context, stream ApplicationStreamSender, appName string, appNs string, dir string, inclusions []string) error {
 	f, filesWritten, checksum, err := tgzstream.CompressFiles(dir, inclusions, nil)
@@ -250,3 +267,99 @@ func receiveFile(ctx context.Context, receiver RepoStreamReceiver, checksum stri
 	}
 	return file, nil
 }
+
+// SendManifestResponse sends the response without its manifests, followed by the gzip-compressed manifests in chunks
+// of at most chunkSize bytes
+func SendManifestResponse(ctx context.Context, sender ManifestResponseStreamSender, res *apiclient.ManifestResponse, chunkSize int) error {
+	header := *res
+	header.Manifests = nil
+	err := sender.Send(&apiclient.ManifestResponseChunk{
+		Part: &apiclient.ManifestResponseChunk_Response{
+			Response: &header,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send manifest response header: %w", err)
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(gzipWriter)
+	for _, manifest := range res.Manifests {
+		if err := encoder.Encode(manifest); err != nil {
+			return fmt.Errorf("failed to compress manifests: %w", err)
+		}
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to compress manifests: %w", err)
+	}
+
+	data := buf.Bytes()
+	for len(data) > 0 {
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("manifest response stream context error: %w", err)
+			}
+		}
+		n := min(chunkSize, len(data))
+		err := sender.Send(&apiclient.ManifestResponseChunk{
+			Part: &apiclient.ManifestResponseChunk_Chunk{
+				Chunk: &apiclient.ManifestFileChunk{
+					Chunk: data[:n],
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send manifest response chunk: %w", err)
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// ReceiveManifestResponse receives a response sent by SendManifestResponse, and decompresses its manifests
+func ReceiveManifestResponse(receiver ManifestResponseStreamReceiver) (*apiclient.ManifestResponse, error) {
+	header, err := receiver.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive manifest response header: %w", err)
+	}
+	if header == nil || header.GetResponse() == nil {
+		return nil, fmt.Errorf("error getting manifest response: response is nil")
+	}
+	res := header.GetResponse()
+
+	var buf bytes.Buffer
+	for {
+		part, err := receiver.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("stream Recv error: %w", err)
+		}
+		if part == nil || part.GetChunk() == nil {
+			return nil, fmt.Errorf("error getting manifest response chunk: chunk is nil")
+		}
+		buf.Write(part.GetChunk().GetChunk())
+	}
+	if buf.Len() == 0 {
+		return nil, fmt.Errorf("manifest response stream ended without manifests")
+	}
+
+	gzipReader, err := gzip.NewReader(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress manifests: %w", err)
+	}
+	decoder := json.NewDecoder(gzipReader)
+	for {
+		var manifest string
+		if err := decoder.Decode(&manifest); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decompress manifests: %w", err)
+		}
+		res.Manifests = append(res.Manifests, manifest)
+	}
+	return res, nil
+}
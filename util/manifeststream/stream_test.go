@@ -121,6 +121,62 @@ func TestManifestStream(t *testing.T) {
 	assert.Contains(t, names, "DUMMY.md")
 }
 
+type manifestResponseStreamMock struct {
+	chunks []*apiclient.ManifestResponseChunk
+}
+
+func (m *manifestResponseStreamMock) Send(chunk *apiclient.ManifestResponseChunk) error {
+	m.chunks = append(m.chunks, chunk)
+	return nil
+}
+
+func (m *manifestResponseStreamMock) Recv() (*apiclient.ManifestResponseChunk, error) {
+	if len(m.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := m.chunks[0]
+	m.chunks = m.chunks[1:]
+	return chunk, nil
+}
+
+func TestManifestResponseStream(t *testing.T) {
+	var manifests []string
+	for i := 0; i < 100; i++ {
+		manifests = append(manifests, fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm-%d"},"data":{"key":"line\nbreak"}}`, i))
+	}
+	res := &apiclient.ManifestResponse{Manifests: manifests, Revision: "abc", SourceType: "Directory", Commands: []string{"helm template ."}}
+	stream := &manifestResponseStreamMock{}
+
+	err := manifeststream.SendManifestResponse(context.Background(), stream, res, 64)
+	require.NoError(t, err)
+	require.Greater(t, len(stream.chunks), 2)
+	assert.Empty(t, stream.chunks[0].GetResponse().Manifests)
+	for _, chunk := range stream.chunks[1:] {
+		assert.LessOrEqual(t, len(chunk.GetChunk().GetChunk()), 64)
+	}
+	assert.Len(t, res.Manifests, 100, "the response must not be modified")
+
+	received, err := manifeststream.ReceiveManifestResponse(stream)
+	require.NoError(t, err)
+	assert.Equal(t, res, received)
+
+	t.Run("no manifests", func(t *testing.T) {
+		err := manifeststream.SendManifestResponse(context.Background(), stream, &apiclient.ManifestResponse{Revision: "abc"}, 64)
+		require.NoError(t, err)
+		received, err := manifeststream.ReceiveManifestResponse(stream)
+		require.NoError(t, err)
+		assert.Equal(t, &apiclient.ManifestResponse{Revision: "abc"}, received)
+	})
+
+	t.Run("missing manifests", func(t *testing.T) {
+		stream := &manifestResponseStreamMock{chunks: []*apiclient.ManifestResponseChunk{
+			{Part: &apiclient.ManifestResponseChunk_Response{Response: &apiclient.ManifestResponse{}}},
+		}}
+		_, err := manifeststream.ReceiveManifestResponse(stream)
+		assert.ErrorContains(t, err, "without manifests")
+	})
+}
+
 func getTestDataDir(t *testing.T) string {
 	return filepath.Join(test.GetTestDir(t), "testdata")
 }
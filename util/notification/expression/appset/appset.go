@@ -0,0 +1,136 @@
+package appset
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+var now = time.Now
+
+// getApplicationSet converts the given object into an ApplicationSet. Objects
+// of other kinds result in an empty ApplicationSet, so that the helpers can
+// safely be used in triggers which are also evaluated for other resources.
+func getApplicationSet(obj *unstructured.Unstructured) *v1alpha1.ApplicationSet {
+	appSet := &v1alpha1.ApplicationSet{}
+	if obj == nil || obj.GetKind() != application.ApplicationSetKind {
+		return appSet
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, appSet); err != nil {
+		panic(err)
+	}
+	return appSet
+}
+
+// hasCondition returns whether the ApplicationSet has a condition of the given type with status True
+func hasCondition(appSet *v1alpha1.ApplicationSet, conditionType string) bool {
+	for _, c := range appSet.Status.Conditions {
+		if string(c.Type) == conditionType {
+			return c.Status == v1alpha1.ApplicationSetConditionStatusTrue
+		}
+	}
+	return false
+}
+
+// sortedSteps returns the RollingSync steps of the ApplicationSet in their order, along with the statuses of the
+// applications of every step
+func sortedSteps(appSet *v1alpha1.ApplicationSet) ([]string, map[string][]v1alpha1.ApplicationSetApplicationStatus) {
+	statusesByStep := map[string][]v1alpha1.ApplicationSetApplicationStatus{}
+	for _, status := range appSet.Status.ApplicationStatus {
+		statusesByStep[status.Step] = append(statusesByStep[status.Step], status)
+	}
+	steps := make([]string, 0, len(statusesByStep))
+	for step := range statusesByStep {
+		steps = append(steps, step)
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		a, errA := strconv.Atoi(steps[i])
+		b, errB := strconv.Atoi(steps[j])
+		if errA != nil || errB != nil {
+			return steps[i] < steps[j]
+		}
+		return a < b
+	})
+	return steps, statusesByStep
+}
+
+// completedSteps returns the RollingSync steps whose applications are all healthy
+func completedSteps(appSet *v1alpha1.ApplicationSet) []string {
+	steps, statusesByStep := sortedSteps(appSet)
+	res := []string{}
+	for _, step := range steps {
+		completed := true
+		for _, status := range statusesByStep[step] {
+			if status.Status != "Healthy" {
+				completed = false
+				break
+			}
+		}
+		if completed {
+			res = append(res, step)
+		}
+	}
+	return res
+}
+
+// lastCompletedStep returns the last step of the RollingSync steps which have all completed, starting from the first
+// step. It returns an empty string if the first step has not completed.
+func lastCompletedStep(appSet *v1alpha1.ApplicationSet) string {
+	steps, _ := sortedSteps(appSet)
+	completed := completedSteps(appSet)
+	last := ""
+	for i := range steps {
+		if i >= len(completed) || completed[i] != steps[i] {
+			break
+		}
+		last = steps[i]
+	}
+	return last
+}
+
+// stalledApplications returns the names of the applications which have been pending or progressing in a RollingSync
+// for longer than the given duration
+func stalledApplications(appSet *v1alpha1.ApplicationSet, timeout string) []string {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(err)
+	}
+	res := []string{}
+	for _, status := range appSet.Status.ApplicationStatus {
+		if status.Status != "Pending" && status.Status != "Progressing" {
+			continue
+		}
+		if status.LastTransitionTime != nil && now().Sub(status.LastTransitionTime.Time) > duration {
+			res = append(res, status.Application)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func NewExprs(obj *unstructured.Unstructured) map[string]interface{} {
+	return map[string]interface{}{
+		"HasCondition": func(conditionType string) bool {
+			return hasCondition(getApplicationSet(obj), conditionType)
+		},
+		"CompletedSteps": func() []string {
+			return completedSteps(getApplicationSet(obj))
+		},
+		"LastCompletedStep": func() string {
+			return lastCompletedStep(getApplicationSet(obj))
+		},
+		"StalledApplications": func(timeout string) []string {
+			return stalledApplications(getApplicationSet(obj), timeout)
+		},
+	}
+}
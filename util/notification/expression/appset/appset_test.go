@@ -0,0 +1,120 @@
+package appset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newAppSet(t *testing.T, status v1alpha1.ApplicationSetStatus) *unstructured.Unstructured {
+	t.Helper()
+	appSet := &v1alpha1.ApplicationSet{
+		TypeMeta:   metav1.TypeMeta{Kind: "ApplicationSet", APIVersion: "argoproj.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"},
+		Status:     status,
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(appSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestNewExprs(t *testing.T) {
+	funcs := []string{
+		"HasCondition",
+		"CompletedSteps",
+		"LastCompletedStep",
+		"StalledApplications",
+	}
+
+	for _, fn := range funcs {
+		appSetExprs := NewExprs(nil)
+		_, hasFunc := appSetExprs[fn]
+		assert.True(t, hasFunc)
+	}
+}
+
+func TestHasCondition(t *testing.T) {
+	obj := newAppSet(t, v1alpha1.ApplicationSetStatus{
+		Conditions: []v1alpha1.ApplicationSetCondition{
+			{Type: v1alpha1.ApplicationSetConditionErrorOccurred, Status: v1alpha1.ApplicationSetConditionStatusTrue},
+			{Type: v1alpha1.ApplicationSetConditionResourcesUpToDate, Status: v1alpha1.ApplicationSetConditionStatusFalse},
+		},
+	})
+	exprs := NewExprs(obj)
+	hasCondition := exprs["HasCondition"].(func(string) bool)
+
+	assert.True(t, hasCondition("ErrorOccurred"))
+	assert.False(t, hasCondition("ResourcesUpToDate"))
+	assert.False(t, hasCondition("RolloutProgressing"))
+}
+
+func TestHasCondition_OtherKind(t *testing.T) {
+	obj := newAppSet(t, v1alpha1.ApplicationSetStatus{
+		Conditions: []v1alpha1.ApplicationSetCondition{
+			{Type: v1alpha1.ApplicationSetConditionErrorOccurred, Status: v1alpha1.ApplicationSetConditionStatusTrue},
+		},
+	})
+	obj.SetKind("Application")
+	exprs := NewExprs(obj)
+	hasCondition := exprs["HasCondition"].(func(string) bool)
+
+	assert.False(t, hasCondition("ErrorOccurred"))
+}
+
+func TestRollingSyncSteps(t *testing.T) {
+	obj := newAppSet(t, v1alpha1.ApplicationSetStatus{
+		ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app-1", Step: "1", Status: "Healthy"},
+			{Application: "app-2", Step: "1", Status: "Healthy"},
+			{Application: "app-3", Step: "2", Status: "Progressing"},
+			{Application: "app-4", Step: "10", Status: "Healthy"},
+		},
+	})
+	exprs := NewExprs(obj)
+
+	assert.Equal(t, []string{"1", "10"}, exprs["CompletedSteps"].(func() []string)())
+	assert.Equal(t, "1", exprs["LastCompletedStep"].(func() string)())
+}
+
+func TestLastCompletedStep_NoneCompleted(t *testing.T) {
+	obj := newAppSet(t, v1alpha1.ApplicationSetStatus{
+		ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app-1", Step: "1", Status: "Waiting"},
+			{Application: "app-2", Step: "2", Status: "Healthy"},
+		},
+	})
+	exprs := NewExprs(obj)
+
+	assert.Equal(t, "", exprs["LastCompletedStep"].(func() string)())
+}
+
+func TestStalledApplications(t *testing.T) {
+	now = func() time.Time {
+		return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	}
+	defer func() { now = time.Now }()
+	longAgo := metav1.NewTime(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC))
+	recently := metav1.NewTime(time.Date(2024, 1, 1, 11, 55, 0, 0, time.UTC))
+	obj := newAppSet(t, v1alpha1.ApplicationSetStatus{
+		ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app-1", Step: "1", Status: "Progressing", LastTransitionTime: &longAgo},
+			{Application: "app-2", Step: "1", Status: "Progressing", LastTransitionTime: &recently},
+			{Application: "app-3", Step: "1", Status: "Healthy", LastTransitionTime: &longAgo},
+			{Application: "app-0", Step: "2", Status: "Pending", LastTransitionTime: &longAgo},
+		},
+	})
+	exprs := NewExprs(obj)
+	stalled := exprs["StalledApplications"].(func(string) []string)
+
+	assert.Equal(t, []string{"app-0", "app-1"}, stalled("30m"))
+	assert.Equal(t, []string{}, stalled("2h"))
+	assert.Panics(t, func() { stalled("invalid") })
+}
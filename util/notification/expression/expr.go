@@ -5,6 +5,8 @@ import (
 
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
 
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/appset"
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/project"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/repo"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/strings"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/time"
@@ -31,6 +33,8 @@ func Spawn(app *unstructured.Unstructured, argocdService service.Service, vars m
 		clone[namespace] = helper
 	}
 	clone["repo"] = repo.NewExprs(argocdService, app)
+	clone["appsets"] = appset.NewExprs(app)
+	clone["projects"] = project.NewExprs(app)
 
 	return clone
 }
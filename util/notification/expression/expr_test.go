@@ -11,6 +11,8 @@ func TestExpr(t *testing.T) {
 		"time",
 		"repo",
 		"strings",
+		"appsets",
+		"projects",
 	}
 
 	for _, ns := range namespaces {
@@ -0,0 +1,68 @@
+package project
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// OrphanedResourceWarningsField is the field of the project status which the notifications controller fills with
+// the orphaned resource warnings of the applications of the project before evaluating triggers
+const OrphanedResourceWarningsField = "orphanedResourceWarnings"
+
+// getProject converts the given object into an AppProject. Objects of other kinds result in an empty project, so
+// that the helpers can safely be used in triggers which are also evaluated for other resources.
+func getProject(obj *unstructured.Unstructured) *v1alpha1.AppProject {
+	proj := &v1alpha1.AppProject{}
+	if obj == nil || obj.GetKind() != application.AppProjectKind {
+		return proj
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, proj); err != nil {
+		panic(err)
+	}
+	return proj
+}
+
+// activeSyncWindows returns the sync windows of the project which are currently open
+func activeSyncWindows(proj *v1alpha1.AppProject) []v1alpha1.SyncWindow {
+	res := []v1alpha1.SyncWindow{}
+	if active := proj.Spec.SyncWindows.Active(); active != nil {
+		for _, w := range *active {
+			res = append(res, *w)
+		}
+	}
+	return res
+}
+
+// orphanedResourceWarnings returns the orphaned resource warnings of the applications of the project
+func orphanedResourceWarnings(obj *unstructured.Unstructured) []interface{} {
+	if obj == nil || obj.GetKind() != application.AppProjectKind {
+		return []interface{}{}
+	}
+	warnings, ok, err := unstructured.NestedSlice(obj.Object, "status", OrphanedResourceWarningsField)
+	if !ok || err != nil {
+		return []interface{}{}
+	}
+	return warnings
+}
+
+func NewExprs(obj *unstructured.Unstructured) map[string]interface{} {
+	return map[string]interface{}{
+		"ActiveSyncWindows": func() []v1alpha1.SyncWindow {
+			return activeSyncWindows(getProject(obj))
+		},
+		"HasActiveSyncWindows": func() bool {
+			return len(activeSyncWindows(getProject(obj))) > 0
+		},
+		"OrphanedResourceWarnings": func() []interface{} {
+			return orphanedResourceWarnings(obj)
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newProject(t *testing.T, windows v1alpha1.SyncWindows) *unstructured.Unstructured {
+	t.Helper()
+	proj := &v1alpha1.AppProject{
+		TypeMeta:   metav1.TypeMeta{Kind: "AppProject", APIVersion: "argoproj.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SyncWindows: windows},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(proj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestNewExprs(t *testing.T) {
+	funcs := []string{
+		"ActiveSyncWindows",
+		"HasActiveSyncWindows",
+		"OrphanedResourceWarnings",
+	}
+
+	for _, fn := range funcs {
+		projExprs := NewExprs(nil)
+		_, hasFunc := projExprs[fn]
+		assert.True(t, hasFunc)
+	}
+}
+
+func TestActiveSyncWindows(t *testing.T) {
+	obj := newProject(t, v1alpha1.SyncWindows{
+		{Kind: "deny", Schedule: "* * * * *", Duration: "1h"},
+		{Kind: "allow", Schedule: "0 0 1 1 *", Duration: "1m", TimeZone: "UTC"},
+	})
+	exprs := NewExprs(obj)
+
+	active := exprs["ActiveSyncWindows"].(func() []v1alpha1.SyncWindow)()
+	assert.Len(t, active, 1)
+	assert.Equal(t, "deny", active[0].Kind)
+	assert.True(t, exprs["HasActiveSyncWindows"].(func() bool)())
+}
+
+func TestActiveSyncWindows_NoWindows(t *testing.T) {
+	exprs := NewExprs(newProject(t, nil))
+
+	assert.Empty(t, exprs["ActiveSyncWindows"].(func() []v1alpha1.SyncWindow)())
+	assert.False(t, exprs["HasActiveSyncWindows"].(func() bool)())
+}
+
+func TestOrphanedResourceWarnings(t *testing.T) {
+	obj := newProject(t, nil)
+	warnings := []interface{}{
+		map[string]interface{}{"application": "app", "namespace": "argocd", "message": "Application has 1 orphaned resources"},
+	}
+	err := unstructured.SetNestedSlice(obj.Object, warnings, "status", OrphanedResourceWarningsField)
+	assert.NoError(t, err)
+
+	assert.Equal(t, warnings, NewExprs(obj)["OrphanedResourceWarnings"].(func() []interface{})())
+
+	obj.SetKind("Application")
+	assert.Empty(t, NewExprs(obj)["OrphanedResourceWarnings"].(func() []interface{})())
+}
@@ -0,0 +1,113 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DigestKind is the kind of the object digest templates are rendered for
+	DigestKind = "NotificationDigest"
+	// DigestsKey is the key of the notifications ConfigMap holding the digest subscriptions
+	DigestsKey = "digests"
+
+	DigestGroupByTrigger = "trigger"
+	DigestGroupByProject = "project"
+)
+
+// Digest configures how the notifications of the matching triggers and recipients are delivered: they are buffered
+// for the given window and sent as a single summary, and repeated notifications about the same application, trigger
+// and revision are suppressed for the given cooldown.
+type Digest struct {
+	// Name identifies the digest in logs and in the summary template
+	Name string `json:"name,omitempty"`
+	// Recipients are the destinations of the digest in the <service>:<recipient> format. A recipient only consisting
+	// of the service name matches all recipients of the service.
+	Recipients []string `json:"recipients"`
+	// Triggers are the triggers whose notifications are digested. All triggers are digested if empty.
+	Triggers []string `json:"triggers,omitempty"`
+	// Window is the duration for which notifications are buffered before the summary is sent. Notifications are sent
+	// immediately if not set.
+	Window metav1.Duration `json:"window,omitempty"`
+	// GroupBy lists the fields by which the buffered notifications are grouped in separate summaries: trigger and/or
+	// project. Defaults to both.
+	GroupBy []string `json:"groupBy,omitempty"`
+	// Template is the template used to render the summary
+	Template string `json:"template,omitempty"`
+	// Cooldown is the duration for which repeated notifications about the same application, trigger and revision
+	// are suppressed
+	Cooldown metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// Matches returns whether the notifications of the given trigger sent to the given destination are handled by the
+// digest
+func (d *Digest) Matches(trigger string, dest services.Destination) bool {
+	if len(d.Triggers) > 0 && !contains(d.Triggers, trigger) {
+		return false
+	}
+	for _, recipient := range d.Recipients {
+		service, name, hasName := strings.Cut(recipient, ":")
+		if service == dest.Service && (!hasName || name == dest.Recipient) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupedBy returns whether the buffered notifications are grouped by the given field
+func (d *Digest) GroupedBy(field string) bool {
+	return contains(d.GroupBy, field)
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDigests returns the digest subscriptions configured in the given notifications ConfigMap
+func ParseDigests(configMap *v1.ConfigMap) ([]Digest, error) {
+	digestsYaml, ok := configMap.Data[DigestsKey]
+	if !ok {
+		return nil, nil
+	}
+	var digests []Digest
+	if err := yaml.Unmarshal([]byte(digestsYaml), &digests); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", DigestsKey, err)
+	}
+	for i := range digests {
+		d := &digests[i]
+		if d.Name == "" {
+			d.Name = fmt.Sprintf("digest-%d", i)
+		}
+		if len(d.Recipients) == 0 {
+			return nil, fmt.Errorf("digest %s has no recipients", d.Name)
+		}
+		if d.Window.Duration < 0 || d.Cooldown.Duration < 0 {
+			return nil, fmt.Errorf("digest %s has a negative window or cooldown", d.Name)
+		}
+		if d.Window.Duration == 0 && d.Cooldown.Duration == 0 {
+			return nil, fmt.Errorf("digest %s has neither a window nor a cooldown", d.Name)
+		}
+		if d.Window.Duration > 0 && d.Template == "" {
+			return nil, fmt.Errorf("digest %s has a window but no template", d.Name)
+		}
+		if d.GroupBy == nil {
+			d.GroupBy = []string{DigestGroupByTrigger, DigestGroupByProject}
+		}
+		for _, field := range d.GroupBy {
+			if field != DigestGroupByTrigger && field != DigestGroupByProject {
+				return nil, fmt.Errorf("digest %s cannot be grouped by %s: only %s and %s are supported", d.Name, field, DigestGroupByTrigger, DigestGroupByProject)
+			}
+		}
+	}
+	return digests, nil
+}
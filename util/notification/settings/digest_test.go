@@ -0,0 +1,75 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseDigests(t *testing.T) {
+	cm := &corev1.ConfigMap{Data: map[string]string{
+		DigestsKey: `
+- name: sync-failures
+  recipients: [slack:deployments]
+  triggers: [on-sync-failed]
+  window: 5m
+  cooldown: 1h
+  template: app-sync-failed-digest
+- recipients: [email]
+  cooldown: 30m
+  groupBy: [project]
+`,
+	}}
+	digests, err := ParseDigests(cm)
+	require.NoError(t, err)
+	require.Len(t, digests, 2)
+
+	assert.Equal(t, "sync-failures", digests[0].Name)
+	assert.Equal(t, 5*time.Minute, digests[0].Window.Duration)
+	assert.Equal(t, time.Hour, digests[0].Cooldown.Duration)
+	assert.True(t, digests[0].GroupedBy(DigestGroupByTrigger))
+	assert.True(t, digests[0].GroupedBy(DigestGroupByProject))
+
+	assert.Equal(t, "digest-1", digests[1].Name)
+	assert.False(t, digests[1].GroupedBy(DigestGroupByTrigger))
+	assert.True(t, digests[1].GroupedBy(DigestGroupByProject))
+}
+
+func TestParseDigests_NotConfigured(t *testing.T) {
+	digests, err := ParseDigests(&corev1.ConfigMap{})
+	require.NoError(t, err)
+	assert.Empty(t, digests)
+}
+
+func TestParseDigests_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no recipients":     "- window: 5m\n  template: digest",
+		"no template":       "- recipients: [slack]\n  window: 5m",
+		"nothing to do":     "- recipients: [slack]",
+		"negative cooldown": "- recipients: [slack]\n  cooldown: -5m",
+		"invalid group":     "- recipients: [slack]\n  cooldown: 5m\n  groupBy: [cluster]",
+		"invalid yaml":      "recipients: slack",
+	}
+	for name, digestsYaml := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseDigests(&corev1.ConfigMap{Data: map[string]string{DigestsKey: digestsYaml}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDigest_Matches(t *testing.T) {
+	digest := Digest{Recipients: []string{"slack:deployments", "email"}, Triggers: []string{"on-sync-failed"}}
+
+	assert.True(t, digest.Matches("on-sync-failed", services.Destination{Service: "slack", Recipient: "deployments"}))
+	assert.True(t, digest.Matches("on-sync-failed", services.Destination{Service: "email", Recipient: "ops@example.com"}))
+	assert.False(t, digest.Matches("on-sync-failed", services.Destination{Service: "slack", Recipient: "alerts"}))
+	assert.False(t, digest.Matches("on-deployed", services.Destination{Service: "slack", Recipient: "deployments"}))
+
+	digest.Triggers = nil
+	assert.True(t, digest.Matches("on-deployed", services.Destination{Service: "slack", Recipient: "deployments"}))
+}
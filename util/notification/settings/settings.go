@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression"
 
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
@@ -58,6 +59,21 @@ func getContext(cfg *api.Config, configMap *v1.ConfigMap, secret *v1.Secret) (ma
 	return context, nil
 }
 
+// subjectVar returns the name of the variable the notified object is available as in triggers and templates:
+// appset for ApplicationSets, project for AppProjects, digest for notification digests and app for Applications.
+func subjectVar(obj map[string]interface{}) string {
+	switch (&unstructured.Unstructured{Object: obj}).GetKind() {
+	case DigestKind:
+		return "digest"
+	case application.ApplicationSetKind:
+		return "appset"
+	case application.AppProjectKind:
+		return "project"
+	default:
+		return "app"
+	}
+}
+
 func initGetVarsWithoutSecret(argocdService service.Service, cfg *api.Config, configMap *v1.ConfigMap, secret *v1.Secret) (api.GetVars, error) {
 	context, err := getContext(cfg, configMap, secret)
 	if err != nil {
@@ -66,8 +82,8 @@ func initGetVarsWithoutSecret(argocdService service.Service, cfg *api.Config, co
 
 	return func(obj map[string]interface{}, dest services.Destination) map[string]interface{} {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]interface{}{
-			"app":     obj,
-			"context": injectLegacyVar(context, dest.Service),
+			subjectVar(obj): obj,
+			"context":       injectLegacyVar(context, dest.Service),
 		})
 	}, nil
 }
@@ -80,9 +96,9 @@ func initGetVars(argocdService service.Service, cfg *api.Config, configMap *v1.C
 
 	return func(obj map[string]interface{}, dest services.Destination) map[string]interface{} {
 		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]interface{}{
-			"app":     obj,
-			"context": injectLegacyVar(context, dest.Service),
-			"secrets": secret.Data,
+			subjectVar(obj): obj,
+			"context":       injectLegacyVar(context, dest.Service),
+			"secrets":       secret.Data,
 		})
 	}, nil
 }
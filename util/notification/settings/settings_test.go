@@ -78,6 +78,33 @@ func TestInitGetVars(t *testing.T) {
 		assert.NotNil(t, t, result["app"])
 		assert.Equal(t, result["app"], appData)
 	})
+	t.Run("Vars provider serves ApplicationSet data on appset key", func(t *testing.T) {
+		appSetData := map[string]interface{}{
+			"kind":     "ApplicationSet",
+			"metadata": map[string]interface{}{"name": "appset-name"},
+		}
+		result := varsProvider(appSetData, testDestination)
+		assert.Equal(t, appSetData, result["appset"])
+		assert.NotContains(t, result, "app")
+	})
+	t.Run("Vars provider serves AppProject data on project key", func(t *testing.T) {
+		projData := map[string]interface{}{
+			"kind":     "AppProject",
+			"metadata": map[string]interface{}{"name": "project-name"},
+		}
+		result := varsProvider(projData, testDestination)
+		assert.Equal(t, projData, result["project"])
+		assert.NotContains(t, result, "app")
+	})
+	t.Run("Vars provider serves digest data on digest key", func(t *testing.T) {
+		digestData := map[string]interface{}{
+			"kind":     DigestKind,
+			"metadata": map[string]interface{}{"name": "digest-name"},
+		}
+		result := varsProvider(digestData, testDestination)
+		assert.Equal(t, digestData, result["digest"])
+		assert.NotContains(t, result, "app")
+	})
 	t.Run("Vars provider serves notification context data on context key", func(t *testing.T) {
 		expectedContext := map[string]string{
 			testContextKey:     testContextKeyValue,
@@ -0,0 +1,195 @@
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/casbin/govaluate"
+)
+
+const (
+	// ConditionAttributeLabelPrefix is the prefix of condition attributes which refer to the labels of an object,
+	// e.g. labels.team
+	ConditionAttributeLabelPrefix = "labels."
+	// ConditionAttributeDestinationServer refers to the URL of the destination cluster of an Application
+	ConditionAttributeDestinationServer = "destination.server"
+	// ConditionAttributeDestinationName refers to the name of the destination cluster of an Application
+	ConditionAttributeDestinationName = "destination.name"
+	// ConditionAttributeDestinationNamespace refers to the destination namespace of an Application
+	ConditionAttributeDestinationNamespace = "destination.namespace"
+	// ConditionAttributeSourceRepoURL refers to the repository URLs of the sources of an Application. The condition is
+	// fulfilled if any of the sources matches.
+	ConditionAttributeSourceRepoURL = "source.repoURL"
+
+	conditionOperatorEqual    = "=="
+	conditionOperatorNotEqual = "!="
+	conditionClauseSeparator  = "&&"
+)
+
+// Attributes are the attributes of the object an RBAC request is made for. They are evaluated by the conditions of
+// policies, which allows to grant or deny access based on e.g. the labels and destination of an Application.
+type Attributes struct {
+	// Labels are the labels of the object
+	Labels map[string]string
+	// DestinationServer is the URL of the destination cluster
+	DestinationServer string
+	// DestinationName is the name of the destination cluster
+	DestinationName string
+	// DestinationNamespace is the destination namespace
+	DestinationNamespace string
+	// SourceRepoURLs are the URLs of the source repositories
+	SourceRepoURLs []string
+}
+
+// GetCacheKey returns the key of the attributes in the enforcement result cache of casbin
+func (a *Attributes) GetCacheKey() string {
+	if a == nil {
+		return ""
+	}
+	// maps are encoded with sorted keys, so the key is deterministic
+	data, err := json.Marshal(a)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func (a *Attributes) values(attribute string) []string {
+	switch attribute {
+	case ConditionAttributeDestinationServer:
+		return []string{a.DestinationServer}
+	case ConditionAttributeDestinationName:
+		return []string{a.DestinationName}
+	case ConditionAttributeDestinationNamespace:
+		return []string{a.DestinationNamespace}
+	case ConditionAttributeSourceRepoURL:
+		return a.SourceRepoURLs
+	}
+	return []string{a.Labels[strings.TrimPrefix(attribute, ConditionAttributeLabelPrefix)]}
+}
+
+// conditionClause is a single comparison of a condition, e.g. labels.team == payments
+type conditionClause struct {
+	attribute string
+	negate    bool
+	pattern   string
+}
+
+// condition is the optional last field of a policy line. It consists of clauses separated by &&, which all need to be
+// fulfilled for the policy to match, e.g. labels.team == payments && destination.namespace == payments-*
+type condition []conditionClause
+
+// parsedConditions caches the parsed conditions of policies, since they are evaluated on every enforcement
+var parsedConditions sync.Map
+
+func isValidConditionAttribute(attribute string) bool {
+	switch attribute {
+	case ConditionAttributeDestinationServer, ConditionAttributeDestinationName, ConditionAttributeDestinationNamespace, ConditionAttributeSourceRepoURL:
+		return true
+	}
+	return strings.HasPrefix(attribute, ConditionAttributeLabelPrefix) && len(attribute) > len(ConditionAttributeLabelPrefix)
+}
+
+func unquoteConditionValue(value string) string {
+	if len(value) >= 2 && (value[0] == '\'' && value[len(value)-1] == '\'' || value[0] == '"' && value[len(value)-1] == '"') {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// parseCondition parses the condition of a policy line
+func parseCondition(str string) (condition, error) {
+	if cached, ok := parsedConditions.Load(str); ok {
+		return cached.(condition), nil
+	}
+	var cond condition
+	for _, clauseStr := range strings.Split(str, conditionClauseSeparator) {
+		clause := conditionClause{}
+		attribute, value, ok := strings.Cut(clauseStr, conditionOperatorNotEqual)
+		if ok {
+			clause.negate = true
+		} else if attribute, value, ok = strings.Cut(clauseStr, conditionOperatorEqual); !ok {
+			return nil, fmt.Errorf("invalid condition '%s': clause '%s' must be of the form '<attribute> == <pattern>' or '<attribute> != <pattern>'", str, strings.TrimSpace(clauseStr))
+		}
+		clause.attribute = strings.TrimSpace(attribute)
+		if !isValidConditionAttribute(clause.attribute) {
+			return nil, fmt.Errorf("invalid condition '%s': unknown attribute '%s'", str, clause.attribute)
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("invalid condition '%s': missing pattern for attribute '%s'", str, clause.attribute)
+		}
+		clause.pattern = unquoteConditionValue(value)
+		cond = append(cond, clause)
+	}
+	parsedConditions.Store(str, cond)
+	return cond, nil
+}
+
+// match returns whether the given attributes fulfill all clauses of the condition
+func (c condition) match(attrs *Attributes, matchFunc govaluate.ExpressionFunction) bool {
+	for _, clause := range c {
+		matched := false
+		for _, value := range attrs.values(clause.attribute) {
+			res, err := matchFunc(value, clause.pattern)
+			if ok, _ := res.(bool); ok && err == nil {
+				matched = true
+				break
+			}
+		}
+		if matched == clause.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// newConditionMatchFunc returns the function which evaluates the conditions of policies against the attributes of a
+// request. Policies without a condition always match. If the attributes of a request are unknown, e.g. because the
+// Application does not exist (yet), conditions of deny policies are considered fulfilled and conditions of allow
+// policies are not, so that missing attributes never lead to more access.
+func newConditionMatchFunc(matchFunc govaluate.ExpressionFunction) govaluate.ExpressionFunction {
+	return func(args ...interface{}) (interface{}, error) {
+		if len(args) < 3 {
+			return false, nil
+		}
+		str, ok := args[1].(string)
+		if !ok || str == "" {
+			return true, nil
+		}
+		attrs, _ := args[0].(*Attributes)
+		if attrs == nil {
+			eft, _ := args[2].(string)
+			return eft == "deny", nil
+		}
+		cond, err := parseCondition(str)
+		if err != nil {
+			return false, nil
+		}
+		return cond.match(attrs, matchFunc), nil
+	}
+}
+
+// conditionError is returned for policy lines with invalid conditions
+type conditionError struct {
+	line string
+	err  error
+}
+
+func (e *conditionError) Error() string {
+	return fmt.Sprintf("invalid RBAC policy: %s: %v", e.line, e.err)
+}
+
+func (e *conditionError) Unwrap() error {
+	return e.err
+}
+
+// withAttributes adds unknown attributes to requests which are made without attributes
+func withAttributes(rvals []interface{}) []interface{} {
+	if len(rvals) == 4 {
+		return append(rvals[:4:4], (*Attributes)(nil))
+	}
+	return rvals
+}
@@ -0,0 +1,345 @@
+package rbac
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/v2/util"
+	"github.com/casbin/govaluate"
+	"github.com/golang-jwt/jwt/v4"
+
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+)
+
+const (
+	// PolicySourceBuiltin is the source of policy lines of the built-in policy
+	PolicySourceBuiltin = "builtin"
+	// PolicySourceUser is the source of policy lines of the user-defined policy in the RBAC ConfigMap
+	PolicySourceUser = "user"
+	// PolicySourceProjectPrefix is the prefix of the source of policy lines of project roles, followed by the project name
+	PolicySourceProjectPrefix = "project:"
+
+	// ExplainOriginSubject is the origin of the subject of a request
+	ExplainOriginSubject = "subject"
+	// ExplainOriginDefaultRole is the origin of the default role, which is evaluated for every request
+	ExplainOriginDefaultRole = "default role"
+	// ExplainOriginTokenScope is the origin of subjects whose request is denied because it is outside of the scope of
+	// their token
+	ExplainOriginTokenScope = "token scope"
+)
+
+// ClaimsExplainerFunc is func template to explain the enforcement of a request for JWT claims. It returns the
+// explanations of all subjects which are evaluated for the claims, e.g. the user and its groups.
+type ClaimsExplainerFunc func(claims jwt.Claims, rvals ...interface{}) []SubjectExplanation
+
+// Explanation describes why an RBAC request is allowed or denied
+type Explanation struct {
+	// Allowed is whether the request is allowed
+	Allowed bool `json:"allowed"`
+	// Subjects are the explanations of the subjects which were evaluated for the request, in order of evaluation.
+	// The request is allowed if it is allowed for any of the subjects.
+	Subjects []SubjectExplanation `json:"subjects"`
+}
+
+// SubjectExplanation describes why an RBAC request is allowed or denied for a single subject
+type SubjectExplanation struct {
+	// Subject is the evaluated subject, e.g. a user, a group or a role
+	Subject string `json:"subject"`
+	// Origin describes why the subject was evaluated, e.g. because it is the default role or a group of the user
+	Origin string `json:"origin"`
+	// Allowed is whether the request is allowed for the subject
+	Allowed bool `json:"allowed"`
+	// Groupings are the role assignments through which the matching policies apply to the subject
+	Groupings []PolicyLine `json:"groupings,omitempty"`
+	// Policies are the policy lines which match the request. The request is allowed if any of them allows it and
+	// none denies it.
+	Policies []PolicyLine `json:"policies,omitempty"`
+}
+
+// PolicyLine is a line of a policy along with its source
+type PolicyLine struct {
+	// Source is where the policy line is defined, i.e. builtin, user or project:<name>
+	Source string `json:"source"`
+	// Line is the policy line
+	Line string `json:"line"`
+}
+
+// Permission describes on which objects a subject is allowed or denied to perform an action on a resource
+type Permission struct {
+	Subject  string `json:"subject"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	// Allowed are the object patterns of the policies allowing the action, along with their conditions
+	Allowed []string `json:"allowed"`
+	// Denied are the object patterns of the policies denying the action, along with their conditions
+	Denied []string `json:"denied"`
+}
+
+type sourcedPolicyLine struct {
+	PolicyLine
+	tokens []string
+}
+
+// parsePolicyLines parses the lines of a policy, skipping empty lines, comments and invalid lines
+func parsePolicyLines(source string, policy string) []sourcedPolicyLine {
+	var lines []sourcedPolicyLine
+	for _, line := range strings.Split(policy, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(line))
+		reader.TrimLeadingSpace = true
+		tokens, err := reader.Read()
+		if err != nil {
+			continue
+		}
+		for i := range tokens {
+			tokens[i] = strings.TrimSpace(tokens[i])
+		}
+		switch {
+		case tokens[0] == "g" && len(tokens) == 3:
+		case tokens[0] == "p" && len(tokens) == 6:
+			tokens = append(tokens, "")
+		case tokens[0] == "p" && len(tokens) == 7:
+		default:
+			continue
+		}
+		lines = append(lines, sourcedPolicyLine{PolicyLine: PolicyLine{Source: source, Line: line}, tokens: tokens})
+	}
+	return lines
+}
+
+// policyLines returns the lines of the built-in, user-defined and the optional project policy
+func (e *Enforcer) policyLines(project string, policy string) []sourcedPolicyLine {
+	e.lock.Lock()
+	builtinPolicy, userDefinedPolicy := e.adapter.builtinPolicy, e.adapter.userDefinedPolicy
+	e.lock.Unlock()
+	lines := append(parsePolicyLines(PolicySourceBuiltin, builtinPolicy), parsePolicyLines(PolicySourceUser, userDefinedPolicy)...)
+	// invalid project policies are ignored during enforcement as well
+	if policy != "" && ValidatePolicy(policy) == nil {
+		lines = append(lines, parsePolicyLines(PolicySourceProjectPrefix+project, policy)...)
+	}
+	return lines
+}
+
+func (e *Enforcer) getMatchFunc() govaluate.ExpressionFunction {
+	if e.matchMode == RegexMatchMode {
+		return util.RegexMatchFunc
+	}
+	return globMatchFunc
+}
+
+func matches(matchFunc govaluate.ExpressionFunction, value string, pattern string) bool {
+	res, err := matchFunc(value, pattern)
+	ok, _ := res.(bool)
+	return ok && err == nil
+}
+
+// roleGraph resolves the roles of subjects through the grouping policy lines
+type roleGraph struct {
+	// parents maps each reachable role to the grouping line through which it was reached
+	parents map[string]*sourcedPolicyLine
+}
+
+// newRoleGraph returns the roles reachable from the given subjects, which are all assigned to the subjects as well
+func newRoleGraph(lines []sourcedPolicyLine, subjects ...string) *roleGraph {
+	g := &roleGraph{parents: map[string]*sourcedPolicyLine{}}
+	queue := append([]string{}, subjects...)
+	for _, subject := range subjects {
+		g.parents[subject] = nil
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for i := range lines {
+			line := &lines[i]
+			if line.tokens[0] != "g" || line.tokens[1] != current {
+				continue
+			}
+			if _, ok := g.parents[line.tokens[2]]; !ok {
+				g.parents[line.tokens[2]] = line
+				queue = append(queue, line.tokens[2])
+			}
+		}
+	}
+	return g
+}
+
+func (g *roleGraph) has(role string) bool {
+	_, ok := g.parents[role]
+	return ok
+}
+
+// groupings returns the grouping lines through which the given role was reached
+func (g *roleGraph) groupings(role string) []*sourcedPolicyLine {
+	var res []*sourcedPolicyLine
+	for line := g.parents[role]; line != nil; line = g.parents[line.tokens[1]] {
+		res = append([]*sourcedPolicyLine{line}, res...)
+	}
+	return res
+}
+
+// ExplainSubject explains the enforcement of a request for a single subject, using the built-in and user-defined
+// policy as well as the optional project policy. The request values are the resource, action and object, optionally
+// followed by the *Attributes of the object.
+func (e *Enforcer) ExplainSubject(project string, policy string, subject string, origin string, rvals ...interface{}) SubjectExplanation {
+	res := SubjectExplanation{Subject: subject, Origin: origin}
+	if len(rvals) < 3 {
+		return res
+	}
+	resource, _ := rvals[0].(string)
+	action, _ := rvals[1].(string)
+	object, _ := rvals[2].(string)
+	var attrs *Attributes
+	if len(rvals) > 3 {
+		attrs, _ = rvals[3].(*Attributes)
+	}
+
+	lines := e.policyLines(project, policy)
+	roles := newRoleGraph(lines, subject)
+	matchFunc := e.getMatchFunc()
+	conditionMatch := newConditionMatchFunc(matchFunc)
+	seenGroupings := map[*sourcedPolicyLine]bool{}
+	allowed, denied := false, false
+	for i := range lines {
+		line := &lines[i]
+		if line.tokens[0] != "p" || !roles.has(line.tokens[1]) {
+			continue
+		}
+		if !matches(matchFunc, resource, line.tokens[2]) || !matches(matchFunc, action, line.tokens[3]) || !matches(matchFunc, object, line.tokens[4]) {
+			continue
+		}
+		if matched, _ := conditionMatch(attrs, line.tokens[6], line.tokens[5]); matched != true {
+			continue
+		}
+		switch line.tokens[5] {
+		case "allow":
+			allowed = true
+		case "deny":
+			denied = true
+		}
+		res.Policies = append(res.Policies, line.PolicyLine)
+		for _, grouping := range roles.groupings(line.tokens[1]) {
+			if !seenGroupings[grouping] {
+				seenGroupings[grouping] = true
+				res.Groupings = append(res.Groupings, grouping.PolicyLine)
+			}
+		}
+	}
+	res.Allowed = allowed && !denied
+	return res
+}
+
+// Explain explains the enforcement of a request in the same way Enforce would enforce it, i.e. it evaluates the
+// default role, and then either the subject or the subjects returned by the claims explainer function.
+func (e *Enforcer) Explain(rvals ...interface{}) *Explanation {
+	exp := &Explanation{}
+	if !e.enabled {
+		exp.Allowed = true
+		return exp
+	}
+	if len(rvals) < 4 {
+		return exp
+	}
+	if !e.inClaimsScope(rvals...) {
+		exp.Subjects = append(exp.Subjects, SubjectExplanation{Subject: claimsSubject(rvals[0]), Origin: ExplainOriginTokenScope})
+		return exp
+	}
+	if e.defaultRole != "" {
+		exp.add(e.ExplainSubject("", "", e.defaultRole, ExplainOriginDefaultRole, rvals[1:]...))
+	}
+	switch s := rvals[0].(type) {
+	case string:
+		exp.add(e.ExplainSubject("", "", s, ExplainOriginSubject, rvals[1:]...))
+	case jwt.Claims:
+		if e.claimsExplainerFunc != nil {
+			for _, subjectExp := range e.claimsExplainerFunc(s, rvals...) {
+				exp.add(subjectExp)
+			}
+		}
+	}
+	return exp
+}
+
+// claimsSubject returns the subject of the JWT claims of a request
+func claimsSubject(claims interface{}) string {
+	mapClaims, err := jwtutil.MapClaims(claims.(jwt.Claims))
+	if err != nil {
+		return ""
+	}
+	return jwtutil.StringField(mapClaims, "sub")
+}
+
+func (exp *Explanation) add(subjectExp SubjectExplanation) {
+	exp.Subjects = append(exp.Subjects, subjectExp)
+	exp.Allowed = exp.Allowed || subjectExp.Allowed
+}
+
+// SetClaimsExplainerFunc sets the function which explains the enforcement of requests for JWT claims
+func (e *Enforcer) SetClaimsExplainerFunc(claimsExplainer ClaimsExplainerFunc) {
+	e.claimsExplainerFunc = claimsExplainer
+}
+
+// Subjects returns all subjects and roles of the built-in and user-defined policy
+func (e *Enforcer) Subjects() []string {
+	subjects := map[string]bool{}
+	for _, line := range e.policyLines("", "") {
+		subjects[line.tokens[1]] = true
+		if line.tokens[0] == "g" {
+			subjects[line.tokens[2]] = true
+		}
+	}
+	res := make([]string, 0, len(subjects))
+	for subject := range subjects {
+		res = append(res, subject)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// describePolicyObject returns the object pattern of a policy line, qualified with its action if it is more specific
+// than the given action and with its condition
+func describePolicyObject(line *sourcedPolicyLine, action string) string {
+	desc := line.tokens[4]
+	if line.tokens[3] != action && line.tokens[3] != "*" {
+		desc = fmt.Sprintf("%s (%s)", desc, line.tokens[3])
+	}
+	if line.tokens[6] != "" {
+		desc = fmt.Sprintf("%s if %s", desc, line.tokens[6])
+	}
+	return desc
+}
+
+// Permission returns the objects the subject is allowed or denied to perform the action on the resource on, according
+// to the built-in and user-defined policy including the default role. Policies of actions with a path, e.g.
+// action/apps/Deployment/restart, are included in the permission of their action.
+func (e *Enforcer) Permission(subject string, resource string, action string) Permission {
+	res := Permission{Subject: subject, Resource: resource, Action: action, Allowed: []string{}, Denied: []string{}}
+	lines := e.policyLines("", "")
+	subjects := []string{subject}
+	if e.defaultRole != "" && e.defaultRole != subject {
+		subjects = append(subjects, e.defaultRole)
+	}
+	roles := newRoleGraph(lines, subjects...)
+	matchFunc := e.getMatchFunc()
+	for i := range lines {
+		line := &lines[i]
+		if line.tokens[0] != "p" || !roles.has(line.tokens[1]) || !matches(matchFunc, resource, line.tokens[2]) {
+			continue
+		}
+		if !matches(matchFunc, action, line.tokens[3]) && !strings.HasPrefix(line.tokens[3], action+"/") {
+			continue
+		}
+		desc := describePolicyObject(line, action)
+		switch line.tokens[5] {
+		case "allow":
+			res.Allowed = append(res.Allowed, desc)
+		case "deny":
+			res.Denied = append(res.Denied, desc)
+		}
+	}
+	return res
+}
@@ -0,0 +1,131 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newExplainEnforcer(t *testing.T) *Enforcer {
+	t.Helper()
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow`))
+	require.NoError(t, enf.SetUserPolicy(`g, alice, my-org:payments`+"\n"+
+		`g, my-org:payments, role:payments`+"\n"+
+		`p, role:payments, applications, *, payments/*, allow`+"\n"+
+		`p, role:payments, applications, delete, payments/*, deny, labels.protected == true`+"\n"+
+		`# comment`+"\n"+
+		`p, bob, applications, action/apps/Deployment/restart, */*, allow`))
+	return enf
+}
+
+func TestExplainSubject(t *testing.T) {
+	enf := newExplainEnforcer(t)
+
+	t.Run("allowed through groupings", func(t *testing.T) {
+		exp := enf.ExplainSubject("", "", "alice", ExplainOriginSubject, "applications", "sync", "payments/app")
+		assert.True(t, exp.Allowed)
+		assert.Equal(t, "alice", exp.Subject)
+		assert.Equal(t, ExplainOriginSubject, exp.Origin)
+		assert.Equal(t, []PolicyLine{
+			{Source: PolicySourceUser, Line: "g, alice, my-org:payments"},
+			{Source: PolicySourceUser, Line: "g, my-org:payments, role:payments"},
+		}, exp.Groupings)
+		assert.Equal(t, []PolicyLine{{Source: PolicySourceUser, Line: "p, role:payments, applications, *, payments/*, allow"}}, exp.Policies)
+	})
+	t.Run("denied by condition", func(t *testing.T) {
+		exp := enf.ExplainSubject("", "", "alice", ExplainOriginSubject, "applications", "delete", "payments/app", &Attributes{Labels: map[string]string{"protected": "true"}})
+		assert.False(t, exp.Allowed)
+		assert.Len(t, exp.Policies, 2)
+		exp = enf.ExplainSubject("", "", "alice", ExplainOriginSubject, "applications", "delete", "payments/app", &Attributes{})
+		assert.True(t, exp.Allowed)
+		assert.Len(t, exp.Policies, 1)
+	})
+	t.Run("no matching policy", func(t *testing.T) {
+		exp := enf.ExplainSubject("", "", "alice", ExplainOriginSubject, "applications", "sync", "billing/app")
+		assert.False(t, exp.Allowed)
+		assert.Empty(t, exp.Policies)
+		assert.Empty(t, exp.Groupings)
+	})
+	t.Run("project policy", func(t *testing.T) {
+		exp := enf.ExplainSubject("billing", "p, proj:billing:ci, applications, sync, billing/*, allow", "proj:billing:ci", "project token", "applications", "sync", "billing/app")
+		assert.True(t, exp.Allowed)
+		assert.Equal(t, []PolicyLine{{Source: PolicySourceProjectPrefix + "billing", Line: "p, proj:billing:ci, applications, sync, billing/*, allow"}}, exp.Policies)
+	})
+	t.Run("invalid project policy is ignored", func(t *testing.T) {
+		exp := enf.ExplainSubject("billing", "p, proj:billing:ci, applications, sync, billing/*, allow, labels.team == billing", "proj:billing:ci", "project token", "applications", "sync", "billing/app")
+		assert.False(t, exp.Allowed)
+		assert.Empty(t, exp.Policies)
+	})
+}
+
+func TestExplain(t *testing.T) {
+	enf := newExplainEnforcer(t)
+	enf.SetDefaultRole("role:readonly")
+
+	exp := enf.Explain("alice", "applications", "get", "billing/app")
+	assert.True(t, exp.Allowed)
+	require.Len(t, exp.Subjects, 2)
+	assert.Equal(t, "role:readonly", exp.Subjects[0].Subject)
+	assert.Equal(t, ExplainOriginDefaultRole, exp.Subjects[0].Origin)
+	assert.True(t, exp.Subjects[0].Allowed)
+	assert.Equal(t, []PolicyLine{{Source: PolicySourceBuiltin, Line: "p, role:readonly, applications, get, */*, allow"}}, exp.Subjects[0].Policies)
+	assert.False(t, exp.Subjects[1].Allowed)
+
+	exp = enf.Explain("alice", "applications", "sync", "billing/app")
+	assert.False(t, exp.Allowed)
+
+	t.Run("claims", func(t *testing.T) {
+		exp := enf.Explain(&jwt.RegisteredClaims{Subject: "alice"}, "applications", "sync", "payments/app")
+		require.Len(t, exp.Subjects, 1)
+		assert.False(t, exp.Allowed)
+
+		enf.SetClaimsExplainerFunc(func(claims jwt.Claims, rvals ...interface{}) []SubjectExplanation {
+			return []SubjectExplanation{enf.ExplainSubject("", "", "alice", ExplainOriginSubject, rvals[1:]...)}
+		})
+		defer enf.SetClaimsExplainerFunc(nil)
+		exp = enf.Explain(&jwt.RegisteredClaims{Subject: "alice"}, "applications", "sync", "payments/app")
+		require.Len(t, exp.Subjects, 2)
+		assert.True(t, exp.Allowed)
+	})
+	t.Run("enforcement disabled", func(t *testing.T) {
+		enf.EnableEnforce(false)
+		defer enf.EnableEnforce(true)
+		exp := enf.Explain("alice", "applications", "sync", "billing/app")
+		assert.True(t, exp.Allowed)
+		assert.Empty(t, exp.Subjects)
+	})
+}
+
+func TestSubjects(t *testing.T) {
+	enf := newExplainEnforcer(t)
+	assert.Equal(t, []string{"alice", "bob", "my-org:payments", "role:payments", "role:readonly"}, enf.Subjects())
+}
+
+func TestPermission(t *testing.T) {
+	enf := newExplainEnforcer(t)
+
+	assert.Equal(t, Permission{
+		Subject:  "alice",
+		Resource: "applications",
+		Action:   "delete",
+		Allowed:  []string{"payments/*"},
+		Denied:   []string{"payments/* if labels.protected == true"},
+	}, enf.Permission("alice", "applications", "delete"))
+	assert.Equal(t, Permission{
+		Subject:  "bob",
+		Resource: "applications",
+		Action:   "action",
+		Allowed:  []string{"*/* (action/apps/Deployment/restart)"},
+		Denied:   []string{},
+	}, enf.Permission("bob", "applications", "action"))
+
+	// the default role applies to every subject
+	assert.Empty(t, enf.Permission("bob", "applications", "get").Allowed)
+	enf.SetDefaultRole("role:readonly")
+	assert.Equal(t, []string{"*/*"}, enf.Permission("bob", "applications", "get").Allowed)
+}
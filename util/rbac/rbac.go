@@ -69,9 +69,13 @@ type Enforcer struct {
 	namespace          string
 	configmap          string
 	claimsEnforcerFunc ClaimsEnforcerFunc
-	model              model.Model
-	defaultRole        string
-	matchMode          string
+	// claimsScopeFunc restricts the requests of JWT claims to the scope of their token
+	claimsScopeFunc ClaimsScopeFunc
+	// claimsExplainerFunc explains the enforcement of requests for JWT claims
+	claimsExplainerFunc ClaimsExplainerFunc
+	model               model.Model
+	defaultRole         string
+	matchMode           string
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
@@ -133,6 +137,7 @@ func (e *Enforcer) tryGetCabinEnforcer(project string, policy string) (CasbinEnf
 	}
 
 	enforcer.AddFunction("globOrRegexMatch", matchFunc)
+	enforcer.AddFunction("conditionMatch", newConditionMatchFunc(matchFunc))
 	enforcer.EnableLog(e.enableLog)
 	enforcer.EnableEnforce(e.enabled)
 	e.enforcerCache.SetDefault(project, &cachedEnforcer{enforcer: enforcer, policy: policy})
@@ -142,6 +147,10 @@ func (e *Enforcer) tryGetCabinEnforcer(project string, policy string) (CasbinEnf
 // ClaimsEnforcerFunc is func template to enforce a JWT claims. The subject is replaced
 type ClaimsEnforcerFunc func(claims jwt.Claims, rvals ...interface{}) bool
 
+// ClaimsScopeFunc is func template to check whether a request is within the scope of the token the JWT claims were
+// issued for. Requests outside of the scope are denied, regardless of the policy and the default role.
+type ClaimsScopeFunc func(claims jwt.Claims, rvals ...interface{}) bool
+
 func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...interface{}) (e CasbinEnforcer, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -154,6 +163,7 @@ func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...inter
 		return nil, err
 	}
 	enfs.AddFunction("globOrRegexMatch", matchFunction)
+	enfs.AddFunction("conditionMatch", newConditionMatchFunc(matchFunction))
 	return enfs, nil
 }
 
@@ -234,9 +244,29 @@ func (e *Enforcer) SetClaimsEnforcerFunc(claimsEnforcer ClaimsEnforcerFunc) {
 	e.claimsEnforcerFunc = claimsEnforcer
 }
 
+// SetClaimsScopeFunc sets the function which restricts the requests of JWT claims to the scope of their token
+func (e *Enforcer) SetClaimsScopeFunc(claimsScope ClaimsScopeFunc) {
+	e.claimsScopeFunc = claimsScope
+}
+
+// inClaimsScope returns false if the subject of the request are JWT claims and the request is outside of the scope of
+// their token
+func (e *Enforcer) inClaimsScope(rvals ...interface{}) bool {
+	if e.claimsScopeFunc == nil || len(rvals) == 0 {
+		return true
+	}
+	if claims, ok := rvals[0].(jwt.Claims); ok {
+		return e.claimsScopeFunc(claims, rvals...)
+	}
+	return true
+}
+
 // Enforce is a wrapper around casbin.Enforce to additionally enforce a default role and a custom
 // claims function
 func (e *Enforcer) Enforce(rvals ...interface{}) bool {
+	if !e.inClaimsScope(rvals...) {
+		return false
+	}
 	return enforce(e.getCabinEnforcer("", ""), e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
@@ -245,9 +275,12 @@ func (e *Enforcer) EnforceErr(rvals ...interface{}) error {
 	if !e.Enforce(rvals...) {
 		errMsg := "permission denied"
 		if len(rvals) > 0 {
-			rvalsStrs := make([]string, len(rvals)-1)
-			for i, rval := range rvals[1:] {
-				rvalsStrs[i] = fmt.Sprintf("%s", rval)
+			rvalsStrs := make([]string, 0, len(rvals)-1)
+			for _, rval := range rvals[1:] {
+				if _, ok := rval.(*Attributes); ok {
+					continue
+				}
+				rvalsStrs = append(rvalsStrs, fmt.Sprintf("%s", rval))
 			}
 			switch s := rvals[0].(type) {
 			case jwt.Claims:
@@ -289,11 +322,12 @@ func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...interf
 	return enforce(enf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
-// enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
+// enforce is a helper to additionally check a default role and invoke a custom claims enforcement function.
+// The request values may be followed by the *Attributes of the object, which are evaluated by policy conditions.
 func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...interface{}) bool {
 	// check the default role
 	if defaultRole != "" && len(rvals) >= 2 {
-		if ok, err := enf.Enforce(append([]interface{}{defaultRole}, rvals[1:]...)...); ok && err == nil {
+		if ok, err := enf.Enforce(withAttributes(append([]interface{}{defaultRole}, rvals[1:]...))...); ok && err == nil {
 			return true
 		}
 	}
@@ -314,7 +348,7 @@ func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEn
 	default:
 		rvals = append([]interface{}{""}, rvals[1:]...)
 	}
-	ok, err := enf.Enforce(rvals...)
+	ok, err := enf.Enforce(withAttributes(rvals)...)
 	return ok && err == nil
 }
 
@@ -440,10 +474,14 @@ func (e *Enforcer) syncUpdate(cm *apiv1.ConfigMap, onUpdated func(cm *apiv1.Conf
 	return e.SetUserPolicy(policyCSV)
 }
 
-// ValidatePolicy verifies a policy string is acceptable to casbin
+// ValidatePolicy verifies a policy string is acceptable to casbin, including the conditions of its policy lines
 func ValidatePolicy(policy string) error {
 	_, err := newEnforcerSafe(globMatchFunc, newBuiltInModel(), newAdapter("", "", policy))
 	if err != nil {
+		var condErr *conditionError
+		if errors.As(err, &condErr) {
+			return fmt.Errorf("policy syntax error: %w", condErr)
+		}
 		return fmt.Errorf("policy syntax error: %s", policy)
 	}
 	return nil
@@ -505,9 +543,19 @@ func loadPolicyLine(line string, model model.Model) error {
 	if tokenLen < 1 ||
 		tokens[0] == "" ||
 		(tokens[0] == "g" && tokenLen != 3) ||
-		(tokens[0] == "p" && tokenLen != 6) {
+		(tokens[0] == "p" && tokenLen != 6 && tokenLen != 7) {
 		return fmt.Errorf("invalid RBAC policy: %s", line)
 	}
+	if tokens[0] == "p" {
+		// the condition is optional
+		if tokenLen == 6 {
+			tokens = append(tokens, "")
+		} else if tokens[6] = strings.TrimSpace(tokens[6]); tokens[6] != "" {
+			if _, err := parseCondition(tokens[6]); err != nil {
+				return &conditionError{line: line, err: err}
+			}
+		}
+	}
 
 	key := tokens[0]
 	sec := key[:1]
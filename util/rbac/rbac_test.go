@@ -347,6 +347,57 @@ func TestValidatePolicy(t *testing.T) {
 	for _, bad := range badPolicies {
 		require.Error(t, ValidatePolicy(bad))
 	}
+	t.Run("conditions", func(t *testing.T) {
+		require.NoError(t, ValidatePolicy("p, role:payments, applications, sync, */*, allow, labels.team == payments && destination.namespace != 'prod-*'"))
+		require.NoError(t, ValidatePolicy(`p, role:payments, applications, sync, */*, allow, "source.repoURL == https://github.com/{payments,billing}/*"`))
+		err := ValidatePolicy("p, role:payments, applications, sync, */*, allow, spec.project == payments")
+		require.ErrorContains(t, err, "unknown attribute 'spec.project'")
+		err = ValidatePolicy("p, role:payments, applications, sync, */*, allow, labels.team = payments")
+		require.ErrorContains(t, err, "must be of the form")
+		err = ValidatePolicy("p, role:payments, applications, sync, */*, allow, labels.team ==")
+		require.ErrorContains(t, err, "missing pattern")
+	})
+}
+
+func TestEnforceConditions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetUserPolicy(`p, role:payments, applications, *, */*, allow, labels.team == payments && destination.namespace != prod-*`+"\n"+
+		`p, role:payments, applications, get, */*, allow, source.repoURL == https://github.com/payments/*`+"\n"+
+		`p, role:payments, applications, delete, */*, deny, labels.protected == true`+"\n"+
+		`p, role:payments, applications, delete, */*, allow`))
+
+	payments := &Attributes{Labels: map[string]string{"team": "payments"}, DestinationNamespace: "payments"}
+	paymentsProd := &Attributes{Labels: map[string]string{"team": "payments"}, DestinationNamespace: "prod-payments"}
+	billing := &Attributes{Labels: map[string]string{"team": "billing"}, SourceRepoURLs: []string{"https://github.com/billing/apps", "https://github.com/payments/apps"}}
+	protected := &Attributes{Labels: map[string]string{"protected": "true"}}
+
+	assert.True(t, enf.Enforce("role:payments", "applications", "sync", "default/app", payments))
+	assert.False(t, enf.Enforce("role:payments", "applications", "sync", "default/app", paymentsProd))
+	assert.False(t, enf.Enforce("role:payments", "applications", "sync", "default/app", billing))
+	// any of the sources may match
+	assert.True(t, enf.Enforce("role:payments", "applications", "get", "default/app", billing))
+	assert.True(t, enf.Enforce("role:payments", "applications", "delete", "default/app", billing))
+	assert.False(t, enf.Enforce("role:payments", "applications", "delete", "default/app", protected))
+
+	// without attributes, conditions of allow policies are not fulfilled and conditions of deny policies are
+	assert.False(t, enf.Enforce("role:payments", "applications", "sync", "default/app"))
+	assert.False(t, enf.Enforce("role:payments", "applications", "delete", "default/app"))
+	// empty attributes are known to have no labels
+	assert.True(t, enf.Enforce("role:payments", "applications", "delete", "default/app", &Attributes{}))
+
+	err := enf.EnforceErr("role:payments", "applications", "sync", "default/app", paymentsProd)
+	require.Error(t, err)
+	assert.Equal(t, "rpc error: code = PermissionDenied desc = permission denied: applications, sync, default/app", err.Error())
+
+	t.Run("regex match mode", func(t *testing.T) {
+		enf.SetMatchMode(RegexMatchMode)
+		defer enf.SetMatchMode(GlobMatchMode)
+		require.NoError(t, enf.SetUserPolicy(`p, role:payments, applications, sync, .*, allow, labels.team == ^(payments|billing)$`))
+		assert.True(t, enf.Enforce("role:payments", "applications", "sync", "default/app", payments))
+		assert.True(t, enf.Enforce("role:payments", "applications", "sync", "default/app", billing))
+		assert.False(t, enf.Enforce("role:payments", "applications", "sync", "default/app", protected))
+	})
 }
 
 // TestEnforceErrorMessage ensures we give descriptive error message
@@ -489,6 +540,23 @@ func TestLoadPolicyLine(t *testing.T) {
 		model := newBuiltInModel()
 		require.Error(t, loadPolicyLine(policy, model))
 	})
+	t.Run("Valid permission line with condition", func(t *testing.T) {
+		policy := `p, role:Myrole, applications, *, myproj/*, allow, labels.team == payments`
+		model := newBuiltInModel()
+		require.NoError(t, loadPolicyLine(policy, model))
+		assert.Equal(t, [][]string{{"role:Myrole", "applications", "*", "myproj/*", "allow", "labels.team == payments"}}, model["p"]["p"].Policy)
+	})
+	t.Run("Valid permission line without condition", func(t *testing.T) {
+		policy := `p, role:Myrole, applications, *, myproj/*, allow`
+		model := newBuiltInModel()
+		require.NoError(t, loadPolicyLine(policy, model))
+		assert.Equal(t, [][]string{{"role:Myrole", "applications", "*", "myproj/*", "allow", ""}}, model["p"]["p"].Policy)
+	})
+	t.Run("Invalid policy line condition", func(t *testing.T) {
+		policy := `p, role:Myrole, applications, *, myproj/*, allow, team == payments`
+		model := newBuiltInModel()
+		require.Error(t, loadPolicyLine(policy, model))
+	})
 	t.Run("Invalid policy line missing policy type", func(t *testing.T) {
 		policy := ", role:Myrole, applications, *, myproj/*, allow"
 		model := newBuiltInModel()
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -21,6 +23,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/cache/appstate"
@@ -43,6 +46,16 @@ type SessionManager struct {
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
 	failedLock                    sync.RWMutex
+	tokenUsageLock                sync.Mutex
+	// tokenLastUsed holds when the last use of API tokens was recorded, by token ID
+	tokenLastUsed map[string]time.Time
+}
+
+// scopedClaims are the claims of tokens which are restricted to a subset of the permissions of their subject
+type scopedClaims struct {
+	jwt.RegisteredClaims
+	// Scope is stored in the rbacpolicy.TokenScopeClaim claim
+	Scope *appv1.TokenScope `json:"token_scope,omitempty"`
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -65,6 +78,8 @@ const (
 	usernameTooLongError        = "Username is too long (%d bytes max)"
 	userDoesNotHaveCapability   = "Account %s does not have %s capability"
 	autoRegenerateTokenDuration = time.Minute * 5
+	// tokenLastUsedUpdateInterval is the minimal interval between updates of the last use of an API token
+	tokenLastUsedUpdateInterval = time.Minute * 5
 )
 
 const (
@@ -95,6 +110,9 @@ const (
 
 var InvalidLoginErr = status.Errorf(codes.Unauthenticated, invalidLoginError)
 
+// ErrTokenRevoked is returned for tokens which are revoked, either individually or along with all sessions of the user
+var ErrTokenRevoked = errors.New("token is revoked, please re-login")
+
 // Returns the maximum cache size as number of entries
 func getMaximumCacheSize() int {
 	return env.ParseNumFromEnv(envLoginMaxCacheSize, defaultMaxCacheSize, 1, math.MaxInt32)
@@ -118,6 +136,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		sleep:                         time.Sleep,
 		projectsLister:                projectsLister,
 		verificationDelayNoiseEnabled: true,
+		tokenLastUsed:                 map[string]time.Time{},
 	}
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
@@ -156,6 +175,12 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateWithScope(subject, secondsBeforeExpiry, id, nil)
+}
+
+// CreateWithScope creates a new token like Create, which is restricted to the given scope of the permissions of the
+// subject. A nil or empty scope does not restrict the token.
+func (mgr *SessionManager) CreateWithScope(subject string, secondsBeforeExpiry int64, id string, scope *appv1.TokenScope) (string, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	now := time.Now().UTC()
@@ -170,6 +195,9 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = jwt.NewNumericDate(expires)
 	}
+	if !scope.IsEmpty() {
+		return mgr.signClaims(scopedClaims{RegisteredClaims: claims, Scope: scope})
+	}
 
 	return mgr.signClaims(claims)
 }
@@ -259,7 +287,9 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	}
 
 	if id == "" || mgr.storage.IsTokenRevoked(id) {
-		return nil, "", errors.New("token is revoked, please re-login")
+		return nil, "", ErrTokenRevoked
+	} else if capability == settings.AccountCapabilityLogin && mgr.storage.IsSessionRevoked(subject, issuedAt) {
+		return nil, "", ErrTokenRevoked
 	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
 		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
 	}
@@ -268,6 +298,18 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return nil, "", fmt.Errorf("account password has changed since token issued")
 	}
 
+	if capability == settings.AccountCapabilityApiKey {
+		token := account.Tokens[account.TokenIndex(id)]
+		if token.IsExpired(time.Now()) {
+			return nil, "", fmt.Errorf("token %s of account %s has expired", id, subject)
+		}
+		mgr.trackTokenUsage(subject, token)
+	}
+
+	if capability == settings.AccountCapabilityLogin {
+		mgr.trackSession(claims, id, subject, subject)
+	}
+
 	newToken := ""
 	if exp, err := jwtutil.ExpirationTime(claims); err == nil {
 		tokenExpDuration := exp.Sub(issuedAt)
@@ -284,6 +326,39 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	return token.Claims, newToken, nil
 }
 
+// trackTokenUsage records the use of an API token of the account in the background. The last use of a token is kept in
+// the user state storage rather than in the account settings, so that using tokens does not write to argocd-secret. It
+// is updated at most once per tokenLastUsedUpdateInterval.
+func (mgr *SessionManager) trackTokenUsage(accountName string, token settings.Token) {
+	now := time.Now()
+	mgr.tokenUsageLock.Lock()
+	if lastUsed, ok := mgr.tokenLastUsed[token.ID]; ok && now.Sub(lastUsed) < tokenLastUsedUpdateInterval {
+		mgr.tokenUsageLock.Unlock()
+		return
+	}
+	mgr.tokenLastUsed[token.ID] = now
+	mgr.tokenUsageLock.Unlock()
+
+	var expiringAt time.Duration
+	if token.ExpiresAt > 0 {
+		expiringAt = time.Until(time.Unix(token.ExpiresAt, 0))
+	}
+	go func() {
+		if err := mgr.storage.SetTokenLastUsed(context.Background(), token.ID, now, expiringAt); err != nil {
+			log.Warnf("Failed to record last use of token '%s' of account '%s': %v", token.ID, accountName, err)
+			// forget the use, so that recording it is retried with the next use of the token
+			mgr.tokenUsageLock.Lock()
+			delete(mgr.tokenLastUsed, token.ID)
+			mgr.tokenUsageLock.Unlock()
+		}
+	}()
+}
+
+// GetTokensLastUsed returns the recorded last use of the API tokens with the given ids, by id
+func (mgr *SessionManager) GetTokensLastUsed(ctx context.Context, ids []string) (map[string]time.Time, error) {
+	return mgr.storage.GetTokensLastUsed(ctx, ids)
+}
+
 // GetLoginFailures retrieves the login failure information from the cache. Any modifications to the LoginAttemps map must be done in a thread-safe manner.
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -560,10 +635,119 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		subject := jwtutil.StringField(claims, "sub")
+		id := SSOSessionID(claims, tokenString)
+		// tokens without issue time are considered to be issued before any revocation
+		issuedAt, _ := jwtutil.IssuedAtTime(claims)
+		if mgr.storage.IsTokenRevoked(id) || mgr.storage.IsSessionRevoked(subject, issuedAt) {
+			return nil, "", ErrTokenRevoked
+		}
+		mgr.trackSession(claims, id, subject, jwtutil.StringField(claims, "email"))
 		return claims, "", nil
 	}
 }
 
+// VerifyClaims returns an error if the token the claims were verified from has since expired or was revoked, or if the
+// local account or project role it was issued for no longer accepts it. It is used to check the claims of requests
+// which keep running after they were authenticated. Tokens issued by the SSO provider without an ID can only be revoked
+// by revoking the sessions of their subject.
+func (mgr *SessionManager) VerifyClaims(claims jwt.Claims) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	if exp, err := jwtutil.ExpirationTime(mapClaims); err == nil && time.Now().After(exp) {
+		return fmt.Errorf("token has expired")
+	}
+	subject := jwtutil.StringField(mapClaims, "sub")
+	id := jwtutil.StringField(mapClaims, "jti")
+	// tokens without issue time are considered to be issued before any revocation
+	issuedAt, _ := jwtutil.IssuedAtTime(mapClaims)
+	if (id != "" && mgr.storage.IsTokenRevoked(id)) || mgr.storage.IsSessionRevoked(subject, issuedAt) {
+		return ErrTokenRevoked
+	}
+	if jwtutil.StringField(mapClaims, "iss") != SessionManagerClaimsIssuer {
+		return nil
+	}
+	if projName, role, ok := rbacpolicy.GetProjectRoleFromSubject(subject); ok {
+		proj, err := mgr.projectsLister.Get(projName)
+		if err != nil {
+			return err
+		}
+		_, _, err = proj.GetJWTToken(role, issuedAt.Unix(), id)
+		return err
+	}
+	subject, _ = GetSubjectAccountAndCapability(subject)
+	account, err := mgr.settingsMgr.GetAccount(subject)
+	if err != nil {
+		return err
+	}
+	if !account.Enabled {
+		return fmt.Errorf("account %s is disabled", subject)
+	}
+	if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
+		return fmt.Errorf("account password has changed since token issued")
+	}
+	return nil
+}
+
+// SSOSessionID returns the ID of the session of a token issued by the SSO provider, which is its ID if it has one and
+// a hash of the token otherwise
+func SSOSessionID(claims jwt.MapClaims, tokenString string) string {
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		return id
+	}
+	hash := sha256.Sum256([]byte(tokenString))
+	return hex.EncodeToString(hash[:16])
+}
+
+// trackSession records the session of a verified token, so that it is listed among the sessions of the user
+func (mgr *SessionManager) trackSession(claims jwt.MapClaims, id string, subject string, username string) {
+	session := SessionInfo{
+		ID:       id,
+		Subject:  subject,
+		Username: username,
+		Issuer:   jwtutil.StringField(claims, "iss"),
+	}
+	if issuedAt, err := jwtutil.IssuedAtTime(claims); err == nil {
+		session.IssuedAt = issuedAt
+	} else {
+		// sessions without issue time cannot be revoked by time and are not tracked
+		return
+	}
+	if exp, err := jwtutil.ExpirationTime(claims); err == nil {
+		session.ExpiresAt = exp
+	}
+	mgr.TrackSession(context.Background(), session)
+}
+
+// TrackSession records the session, so that it is listed among the sessions of the user. Failures are only logged,
+// since they must not prevent the user from logging in.
+func (mgr *SessionManager) TrackSession(ctx context.Context, session SessionInfo) {
+	if err := mgr.storage.TrackSession(ctx, session); err != nil {
+		log.Warnf("Failed to track session '%s' of '%s': %v", session.ID, session.Subject, err)
+	}
+}
+
+// GetSessions returns the valid tracked sessions of the subject, most recently issued first
+func (mgr *SessionManager) GetSessions(ctx context.Context, subject string) ([]SessionInfo, error) {
+	return mgr.storage.GetSessions(ctx, subject)
+}
+
+// RevokeSessions revokes all login sessions of the subject issued before the given time, and returns the revoked
+// tracked sessions. Tokens of local accounts with the apiKey capability are not revoked. The revocation is kept for at
+// least the lifetime of the login tokens issued by the session manager.
+func (mgr *SessionManager) RevokeSessions(ctx context.Context, subject string, issuedBefore time.Time, expiringAt time.Duration) ([]SessionInfo, error) {
+	settings, err := mgr.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if settings.UserSessionDuration > expiringAt {
+		expiringAt = settings.UserSessionDuration
+	}
+	return mgr.storage.RevokeSessions(ctx, subject, issuedBefore, expiringAt)
+}
+
 func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	if mgr.prov != nil {
 		return mgr.prov, nil
@@ -625,6 +809,26 @@ func Sub(ctx context.Context) string {
 	return jwtutil.StringField(mapClaims, "sub")
 }
 
+// UserID returns an identifier of the user the request is authenticated as, which is composed of the issuer and the
+// subject of its token. Unlike the username, it cannot be changed by the user, e.g. by changing the email address of
+// their SSO account. It is empty if the request is not authenticated.
+func UserID(ctx context.Context) string {
+	sub := Sub(ctx)
+	if sub == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s", Iss(ctx), sub)
+}
+
+// Jti returns the unique identifier of the token the request was authenticated with, if any
+func Jti(ctx context.Context) string {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return ""
+	}
+	return jwtutil.StringField(mapClaims, "jti")
+}
+
 func Groups(ctx context.Context, scopes []string) []string {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
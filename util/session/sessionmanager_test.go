@@ -28,6 +28,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/password"
@@ -150,6 +151,74 @@ func TestSessionManager_AdminToken_Revoked(t *testing.T) {
 	assert.Equal(t, "token is revoked, please re-login", err.Error())
 }
 
+func TestSessionManager_AdminToken_SessionsRevoked(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.Create("admin:login", 3600, "123")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	sessions, err := mgr.GetSessions(context.Background(), "admin")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "123", sessions[0].ID)
+	assert.Equal(t, "admin", sessions[0].Username)
+	assert.Equal(t, SessionManagerClaimsIssuer, sessions[0].Issuer)
+
+	// sessions issued after the given time are kept
+	revoked, err := mgr.RevokeSessions(context.Background(), "admin", time.Now().Add(-time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, revoked)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	revoked, err = mgr.RevokeSessions(context.Background(), "admin", time.Now().Add(time.Second), time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, revoked, 1)
+	_, _, err = mgr.Parse(token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+}
+
+func TestSessionManager_VerifyClaims(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	storage := NewUserStateStorage(redisClient)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	token, err := mgr.Create("admin:login", 0, "123")
+	require.NoError(t, err)
+	claims, _, err := mgr.Parse(token)
+	require.NoError(t, err)
+	require.NoError(t, mgr.VerifyClaims(claims))
+
+	require.NoError(t, storage.RevokeToken(context.Background(), "123", time.Hour))
+	require.ErrorIs(t, mgr.VerifyClaims(claims), ErrTokenRevoked)
+
+	expired := jwt.MapClaims{"sub": "alice", "iss": "https://dex.example.com", "exp": float64(time.Now().Add(-time.Minute).Unix())}
+	require.ErrorContains(t, mgr.VerifyClaims(expired), "expired")
+
+	sso := jwt.MapClaims{"sub": "alice", "iss": "https://dex.example.com", "iat": float64(time.Now().Add(-time.Minute).Unix())}
+	require.NoError(t, mgr.VerifyClaims(sso))
+	_, err = mgr.RevokeSessions(context.Background(), "alice", time.Now(), time.Hour)
+	require.NoError(t, err)
+	require.ErrorIs(t, mgr.VerifyClaims(sso), ErrTokenRevoked)
+}
+
+func TestSessionManager_VerifyClaims_Deactivated(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", false), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	claims := jwt.MapClaims{"sub": "admin", "iss": SessionManagerClaimsIssuer, "jti": "123", "iat": float64(time.Now().Unix())}
+	require.ErrorContains(t, mgr.VerifyClaims(claims), "account admin is disabled")
+}
+
 func TestSessionManager_AdminToken_Deactivated(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", false), "argocd")
 	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
@@ -220,6 +289,40 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 	})
 }
 
+func TestSessionManager_AccountToken(t *testing.T) {
+	now := time.Now()
+	kubeClient := getKubeClientWithConfig(map[string]string{"accounts.ci": "apiKey"}, map[string][]byte{
+		"accounts.ci.tokens": []byte(fmt.Sprintf(`[{"id":"valid","iat":%d},{"id":"rotated","iat":%d,"exp":%d}]`, now.Unix(), now.Unix(), now.Add(-time.Minute).Unix())),
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	t.Run("Valid Token", func(t *testing.T) {
+		token, err := mgr.CreateWithScope("ci:apiKey", 0, "valid", &appv1.TokenScope{Resources: []string{"applications"}})
+		require.NoError(t, err)
+
+		claims, _, err := mgr.Parse(token)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"resources": []interface{}{"applications"}}, (*claims.(*jwt.MapClaims))[rbacpolicy.TokenScopeClaim])
+
+		// the use of the token is recorded in the background
+		assert.Eventually(t, func() bool {
+			lastUsed, err := mgr.GetTokensLastUsed(context.Background(), []string{"valid"})
+			require.NoError(t, err)
+			return !lastUsed["valid"].Before(now.Truncate(time.Second))
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Token Revoked After Rotation", func(t *testing.T) {
+		// the token itself does not expire, but the stored token expired at the end of the grace period of the rotation
+		token, err := mgr.Create("ci:apiKey", 0, "rotated")
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(token)
+		assert.ErrorContains(t, err, "token rotated of account ci has expired")
+	})
+}
+
 type claimsMock struct {
 	err error
 }
@@ -2,6 +2,10 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,26 +17,68 @@ import (
 )
 
 const (
-	revokedTokenPrefix = "revoked-token|"
-	newRevokedTokenKey = "new-revoked-token"
+	revokedTokenPrefix    = "revoked-token|"
+	newRevokedTokenKey    = "new-revoked-token"
+	sessionsPrefix        = "sessions|"
+	revokedSessionsPrefix = "revoked-sessions|"
+	newRevokedSessionsKey = "new-revoked-sessions"
+	tokenLastUsedPrefix   = "token-last-used|"
+
+	// defaultSessionDuration is the assumed lifetime of tracked sessions whose token has no expiration time
+	defaultSessionDuration = 24 * time.Hour
+	// revokedSessionsMinExpiration is the minimum time the revocation of the sessions of a user is kept for. It has to
+	// outlive the tokens which are not tracked, e.g. SSO tokens which were never presented to Argo CD, whose expiration
+	// time is only known to the SSO provider
+	revokedSessionsMinExpiration = 7 * 24 * time.Hour
+	// trackedSessionsPruneInterval is the interval in which expired sessions are removed from the tracked sessions
+	trackedSessionsPruneInterval = time.Minute
 )
 
+// SessionInfo describes a session of a user, i.e. a token issued by Argo CD for a login or by the SSO provider
+type SessionInfo struct {
+	// ID is the ID of the token
+	ID string `json:"id"`
+	// Subject is the subject of the token, i.e. the local account name or the subject of the SSO user
+	Subject string `json:"subject"`
+	// Username is the human readable name of the user, e.g. the email of SSO users
+	Username string `json:"username,omitempty"`
+	// Issuer is the issuer of the token
+	Issuer string `json:"issuer"`
+	// IssuedAt is the time the token was issued at
+	IssuedAt time.Time `json:"issuedAt"`
+	// ExpiresAt is the time the token expires at
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
 type userStateStorage struct {
 	attempts       map[string]LoginAttempts
 	redis          *redis.Client
 	revokedTokens  map[string]bool
 	lock           sync.RWMutex
 	resyncDuration time.Duration
+	// revokedSessions maps subjects to the time before which all their sessions are revoked
+	revokedSessions map[string]time.Time
+	// trackedSessions maps the IDs of already tracked sessions to their expiration time
+	trackedSessions map[string]time.Time
+	lastPruned      time.Time
+	// sessions holds the tracked sessions by subject if no Redis client is configured
+	sessions map[string]map[string]SessionInfo
+	// tokensLastUsed holds the last use of API tokens by token ID if no Redis client is configured
+	tokensLastUsed map[string]time.Time
 }
 
 var _ UserStateStorage = &userStateStorage{}
 
 func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 	return &userStateStorage{
-		attempts:       map[string]LoginAttempts{},
-		revokedTokens:  map[string]bool{},
-		resyncDuration: time.Hour,
-		redis:          redis,
+		attempts:        map[string]LoginAttempts{},
+		revokedTokens:   map[string]bool{},
+		revokedSessions: map[string]time.Time{},
+		trackedSessions: map[string]time.Time{},
+		sessions:        map[string]map[string]SessionInfo{},
+		tokensLastUsed:  map[string]time.Time{},
+		resyncDuration:  time.Hour,
+		redis:           redis,
 	}
 }
 
@@ -52,7 +98,7 @@ func (storage *userStateStorage) Init(ctx context.Context) {
 }
 
 func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
-	pubsub := storage.redis.Subscribe(ctx, newRevokedTokenKey)
+	pubsub := storage.redis.Subscribe(ctx, newRevokedTokenKey, newRevokedSessionsKey)
 	defer util.Close(pubsub)
 
 	ch := pubsub.Channel()
@@ -61,6 +107,15 @@ func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case val := <-ch:
+			if val.Channel == newRevokedSessionsKey {
+				subject, issuedBefore, err := parseRevokedSessions(val.Payload)
+				if err != nil {
+					log.Warnf("Unexpected revoked sessions message '%s': %v", val.Payload, err)
+					continue
+				}
+				storage.setRevokedSessions(subject, issuedBefore)
+				continue
+			}
 			storage.lock.Lock()
 			storage.revokedTokens[val.Payload] = true
 			storage.lock.Unlock()
@@ -68,6 +123,45 @@ func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
 	}
 }
 
+// formatRevokedSessions formats the revocation of the sessions of a subject as published to other replicas
+func formatRevokedSessions(subject string, issuedBefore time.Time) string {
+	return fmt.Sprintf("%d|%s", issuedBefore.UnixNano(), subject)
+}
+
+func parseRevokedSessions(payload string) (string, time.Time, error) {
+	nanos, subject, ok := strings.Cut(payload, "|")
+	if !ok || subject == "" {
+		return "", time.Time{}, fmt.Errorf("must be of the form '<unix nanos>|<subject>'")
+	}
+	issuedBefore, err := parseUnixNano(nanos)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return subject, issuedBefore, nil
+}
+
+func parseUnixNano(str string) (time.Time, error) {
+	nanos, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp '%s': %w", str, err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// setRevokedSessions revokes the sessions of the subject issued before the given time, unless sessions issued before a
+// later time are already revoked. It returns the effective time. The time is truncated to seconds, which is the
+// precision of the issue time of tokens, so that sessions issued within the same second are not revoked.
+func (storage *userStateStorage) setRevokedSessions(subject string, issuedBefore time.Time) time.Time {
+	issuedBefore = issuedBefore.Truncate(time.Second)
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	if existing, ok := storage.revokedSessions[subject]; ok && existing.After(issuedBefore) {
+		return existing
+	}
+	storage.revokedSessions[subject] = issuedBefore
+	return issuedBefore
+}
+
 func (storage *userStateStorage) loadRevokedTokensSafe() {
 	err := storage.loadRevokedTokens()
 	for err != nil {
@@ -78,8 +172,13 @@ func (storage *userStateStorage) loadRevokedTokensSafe() {
 }
 
 func (storage *userStateStorage) loadRevokedTokens() error {
+	revokedSessions, err := storage.loadRevokedSessions()
+	if err != nil {
+		return err
+	}
 	storage.lock.Lock()
 	defer storage.lock.Unlock()
+	storage.revokedSessions = revokedSessions
 	storage.revokedTokens = map[string]bool{}
 	iterator := storage.redis.Scan(context.Background(), 0, revokedTokenPrefix+"*", -1).Iterator()
 	for iterator.Next(context.Background()) {
@@ -99,6 +198,31 @@ func (storage *userStateStorage) loadRevokedTokens() error {
 	return nil
 }
 
+func (storage *userStateStorage) loadRevokedSessions() (map[string]time.Time, error) {
+	ctx := context.Background()
+	revokedSessions := map[string]time.Time{}
+	iterator := storage.redis.Scan(ctx, 0, revokedSessionsPrefix+"*", -1).Iterator()
+	for iterator.Next(ctx) {
+		subject := strings.TrimPrefix(iterator.Val(), revokedSessionsPrefix)
+		val, err := storage.redis.Get(ctx, iterator.Val()).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		issuedBefore, err := parseUnixNano(val)
+		if err != nil {
+			log.Warnf("Unexpected value of redis key '%s': %v", iterator.Val(), err)
+			continue
+		}
+		revokedSessions[subject] = issuedBefore.Truncate(time.Second)
+	}
+	if iterator.Err() != nil {
+		return nil, iterator.Err()
+	}
+	return revokedSessions, nil
+}
+
 func (storage *userStateStorage) GetLoginAttempts(attempts *map[string]LoginAttempts) error {
 	*attempts = storage.attempts
 	return nil
@@ -113,6 +237,9 @@ func (storage *userStateStorage) RevokeToken(ctx context.Context, id string, exp
 	storage.lock.Lock()
 	storage.revokedTokens[id] = true
 	storage.lock.Unlock()
+	if storage.redis == nil {
+		return nil
+	}
 	if err := storage.redis.Set(ctx, revokedTokenPrefix+id, "", expiringAt).Err(); err != nil {
 		return err
 	}
@@ -125,6 +252,242 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) TrackSession(ctx context.Context, session SessionInfo) error {
+	if session.ExpiresAt.IsZero() {
+		session.ExpiresAt = session.IssuedAt.Add(defaultSessionDuration)
+	}
+	ttl := time.Until(session.ExpiresAt)
+	if session.ID == "" || ttl <= 0 {
+		return nil
+	}
+
+	// sessions are tracked on every request, so avoid the write lock for the common case of known sessions
+	storage.lock.RLock()
+	_, tracked := storage.trackedSessions[session.ID]
+	storage.lock.RUnlock()
+	if tracked {
+		return nil
+	}
+
+	storage.lock.Lock()
+	if _, ok := storage.trackedSessions[session.ID]; ok {
+		storage.lock.Unlock()
+		return nil
+	}
+	storage.pruneTrackedSessions()
+	storage.trackedSessions[session.ID] = session.ExpiresAt
+	if storage.redis == nil {
+		if storage.sessions[session.Subject] == nil {
+			storage.sessions[session.Subject] = map[string]SessionInfo{}
+		}
+		storage.sessions[session.Subject][session.ID] = session
+	}
+	storage.lock.Unlock()
+	if storage.redis == nil {
+		return nil
+	}
+
+	err := storage.storeSession(ctx, session, ttl)
+	if err != nil {
+		// forget the session, so that tracking it is retried with its next use
+		storage.lock.Lock()
+		delete(storage.trackedSessions, session.ID)
+		storage.lock.Unlock()
+	}
+	return err
+}
+
+func (storage *userStateStorage) storeSession(ctx context.Context, session SessionInfo, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	key := sessionsPrefix + session.Subject
+	if err := storage.redis.HSet(ctx, key, session.ID, data).Err(); err != nil {
+		return err
+	}
+	// the sessions of a subject are kept until the last of them expires
+	currentTTL, err := storage.redis.TTL(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if currentTTL < ttl {
+		return storage.redis.Expire(ctx, key, ttl).Err()
+	}
+	return nil
+}
+
+// pruneTrackedSessions removes expired sessions from the tracked sessions. The caller must hold the lock.
+func (storage *userStateStorage) pruneTrackedSessions() {
+	now := time.Now()
+	if now.Sub(storage.lastPruned) < trackedSessionsPruneInterval {
+		return
+	}
+	storage.lastPruned = now
+	for id, expiresAt := range storage.trackedSessions {
+		if now.After(expiresAt) {
+			delete(storage.trackedSessions, id)
+		}
+	}
+	for subject, sessions := range storage.sessions {
+		for id, session := range sessions {
+			if now.After(session.ExpiresAt) {
+				delete(sessions, id)
+			}
+		}
+		if len(sessions) == 0 {
+			delete(storage.sessions, subject)
+		}
+	}
+}
+
+func (storage *userStateStorage) GetSessions(ctx context.Context, subject string) ([]SessionInfo, error) {
+	var sessions []SessionInfo
+	if storage.redis == nil {
+		storage.lock.RLock()
+		for _, session := range storage.sessions[subject] {
+			sessions = append(sessions, session)
+		}
+		storage.lock.RUnlock()
+	} else {
+		values, err := storage.redis.HGetAll(ctx, sessionsPrefix+subject).Result()
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range values {
+			var session SessionInfo
+			if err := json.Unmarshal([]byte(value), &session); err != nil {
+				log.Warnf("Failed to unmarshal session '%s' of subject '%s': %v", id, subject, err)
+				continue
+			}
+			sessions = append(sessions, session)
+		}
+	}
+
+	now := time.Now()
+	res := make([]SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if now.Before(session.ExpiresAt) && !storage.IsTokenRevoked(session.ID) && !storage.IsSessionRevoked(subject, session.IssuedAt) {
+			res = append(res, session)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].IssuedAt.After(res[j].IssuedAt)
+	})
+	return res, nil
+}
+
+func (storage *userStateStorage) RevokeSessions(ctx context.Context, subject string, issuedBefore time.Time, expiringAt time.Duration) ([]SessionInfo, error) {
+	sessions, err := storage.GetSessions(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	issuedBefore = storage.setRevokedSessions(subject, issuedBefore)
+	if expiringAt < revokedSessionsMinExpiration {
+		expiringAt = revokedSessionsMinExpiration
+	}
+
+	var revoked []SessionInfo
+	var ids []string
+	for _, session := range sessions {
+		if !session.IssuedAt.Before(issuedBefore) {
+			continue
+		}
+		revoked = append(revoked, session)
+		ids = append(ids, session.ID)
+		// the revocation must be kept at least as long as any of the revoked sessions is valid
+		if remaining := time.Until(session.ExpiresAt); remaining > expiringAt {
+			expiringAt = remaining
+		}
+	}
+
+	storage.lock.Lock()
+	for _, id := range ids {
+		delete(storage.trackedSessions, id)
+		delete(storage.sessions[subject], id)
+	}
+	storage.lock.Unlock()
+	if storage.redis == nil {
+		return revoked, nil
+	}
+
+	// a previous revocation may have to be kept longer than this one, since it is overwritten
+	ttl, err := storage.redis.TTL(ctx, revokedSessionsPrefix+subject).Result()
+	if err != nil {
+		return nil, err
+	}
+	if ttl > expiringAt {
+		expiringAt = ttl
+	}
+	if err := storage.redis.Set(ctx, revokedSessionsPrefix+subject, strconv.FormatInt(issuedBefore.UnixNano(), 10), expiringAt).Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		if err := storage.redis.HDel(ctx, sessionsPrefix+subject, ids...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	if err := storage.redis.Publish(ctx, newRevokedSessionsKey, formatRevokedSessions(subject, issuedBefore)).Err(); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+func (storage *userStateStorage) IsSessionRevoked(subject string, issuedAt time.Time) bool {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	issuedBefore, ok := storage.revokedSessions[subject]
+	return ok && issuedAt.Before(issuedBefore)
+}
+
+func (storage *userStateStorage) SetTokenLastUsed(ctx context.Context, id string, lastUsed time.Time, expiringAt time.Duration) error {
+	if storage.redis == nil {
+		storage.lock.Lock()
+		storage.tokensLastUsed[id] = lastUsed
+		storage.lock.Unlock()
+		return nil
+	}
+	return storage.redis.Set(ctx, tokenLastUsedPrefix+id, strconv.FormatInt(lastUsed.Unix(), 10), expiringAt).Err()
+}
+
+func (storage *userStateStorage) GetTokensLastUsed(ctx context.Context, ids []string) (map[string]time.Time, error) {
+	res := map[string]time.Time{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+	if storage.redis == nil {
+		storage.lock.RLock()
+		defer storage.lock.RUnlock()
+		for _, id := range ids {
+			if lastUsed, ok := storage.tokensLastUsed[id]; ok {
+				res[id] = lastUsed
+			}
+		}
+		return res, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = tokenLastUsedPrefix + id
+	}
+	values, err := storage.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		seconds, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			log.Warnf("Unexpected value of redis key '%s': %v", keys[i], err)
+			continue
+		}
+		res[ids[i]] = time.Unix(seconds, 0)
+	}
+	return res, nil
+}
+
 type UserStateStorage interface {
 	Init(ctx context.Context)
 	// GetLoginAttempts return number of concurrent login attempts
@@ -135,4 +498,18 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// TrackSession records the session, so that it is listed by GetSessions until it expires or is revoked
+	TrackSession(ctx context.Context, session SessionInfo) error
+	// GetSessions returns the valid tracked sessions of the subject, most recently issued first
+	GetSessions(ctx context.Context, subject string) ([]SessionInfo, error)
+	// RevokeSessions revokes all sessions of the subject issued before the given time, including sessions which are not
+	// tracked, and returns the revoked tracked sessions. The revocation is kept for at least the given duration.
+	RevokeSessions(ctx context.Context, subject string, issuedBefore time.Time, expiringAt time.Duration) ([]SessionInfo, error)
+	// IsSessionRevoked checks if the sessions of the subject issued at the given time are revoked
+	IsSessionRevoked(subject string, issuedAt time.Time) bool
+	// SetTokenLastUsed records the last use of the API token with the given id (the record expires after the specified
+	// timeout, or never if it is zero)
+	SetTokenLastUsed(ctx context.Context, id string, lastUsed time.Time, expiringAt time.Duration) error
+	// GetTokensLastUsed returns the recorded last use of the API tokens with the given ids, by id
+	GetTokensLastUsed(ctx context.Context, ids []string) (map[string]time.Time, error)
 }
@@ -2,9 +2,13 @@ package session
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+
 	"github.com/argoproj/argo-cd/v2/test"
 
 	"github.com/stretchr/testify/assert"
@@ -27,3 +31,139 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_LoadRevokedSessions(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	revokedAt := time.Now()
+	err := redis.Set(context.Background(), revokedSessionsPrefix+"alice", strconv.FormatInt(revokedAt.UnixNano(), 10), time.Hour).Err()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage := NewUserStateStorage(redis)
+	storage.Init(ctx)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.True(t, storage.IsSessionRevoked("alice", revokedAt.Add(-time.Second)))
+	assert.False(t, storage.IsSessionRevoked("alice", revokedAt.Add(time.Second)))
+	assert.False(t, storage.IsSessionRevoked("bob", revokedAt.Add(-time.Second)))
+
+	// revocations of other replicas are received
+	err = redis.Publish(ctx, newRevokedSessionsKey, formatRevokedSessions("bob", revokedAt)).Err()
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return storage.IsSessionRevoked("bob", revokedAt.Add(-time.Second))
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestUserStateStorage_Sessions(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	for name, storage := range map[string]*userStateStorage{"redis": NewUserStateStorage(redis), "in-memory": NewUserStateStorage(nil)} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			now := time.Now().UTC().Truncate(time.Second)
+			older := SessionInfo{ID: "1", Subject: "alice", Issuer: "argocd", IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(time.Hour)}
+			newer := SessionInfo{ID: "2", Subject: "alice", Issuer: "argocd", IssuedAt: now.Add(-time.Hour), ExpiresAt: now.Add(2 * time.Hour)}
+			expired := SessionInfo{ID: "3", Subject: "alice", Issuer: "argocd", IssuedAt: now.Add(-3 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+			other := SessionInfo{ID: "4", Subject: "bob", Issuer: "argocd", IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(time.Hour)}
+			for _, session := range []SessionInfo{older, newer, expired, other, older} {
+				require.NoError(t, storage.TrackSession(ctx, session))
+			}
+
+			sessions, err := storage.GetSessions(ctx, "alice")
+			require.NoError(t, err)
+			assert.Equal(t, []SessionInfo{newer, older}, sessions)
+
+			revoked, err := storage.RevokeSessions(ctx, "alice", now.Add(-90*time.Minute), time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, []SessionInfo{older}, revoked)
+			assert.True(t, storage.IsSessionRevoked("alice", older.IssuedAt))
+			assert.False(t, storage.IsSessionRevoked("alice", newer.IssuedAt))
+
+			sessions, err = storage.GetSessions(ctx, "alice")
+			require.NoError(t, err)
+			assert.Equal(t, []SessionInfo{newer}, sessions)
+
+			// an earlier revocation does not undo a later one
+			revoked, err = storage.RevokeSessions(ctx, "alice", now.Add(-3*time.Hour), time.Hour)
+			require.NoError(t, err)
+			assert.Empty(t, revoked)
+			assert.True(t, storage.IsSessionRevoked("alice", older.IssuedAt))
+
+			revoked, err = storage.RevokeSessions(ctx, "alice", now.Add(time.Second), time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, []SessionInfo{newer}, revoked)
+			sessions, err = storage.GetSessions(ctx, "alice")
+			require.NoError(t, err)
+			assert.Empty(t, sessions)
+
+			sessions, err = storage.GetSessions(ctx, "bob")
+			require.NoError(t, err)
+			assert.Equal(t, []SessionInfo{other}, sessions)
+		})
+	}
+}
+
+func TestUserStateStorage_RevokeSessionsSecondPrecision(t *testing.T) {
+	storage := NewUserStateStorage(nil)
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+
+	// the issue time of tokens has a precision of seconds, so a token issued right after the revocation within the
+	// same second must remain valid
+	_, err := storage.RevokeSessions(context.Background(), "alice", issuedAt.Add(500*time.Millisecond), time.Hour)
+	require.NoError(t, err)
+	assert.False(t, storage.IsSessionRevoked("alice", issuedAt))
+	assert.True(t, storage.IsSessionRevoked("alice", issuedAt.Add(-time.Second)))
+}
+
+func TestUserStateStorage_RevokeSessionsOutlivesTokens(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	redis := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	tracked := SessionInfo{ID: "1", Subject: "alice", Issuer: "argocd", IssuedAt: now.Add(-time.Hour), ExpiresAt: now.Add(10 * 24 * time.Hour)}
+	storage := NewUserStateStorage(redis)
+	require.NoError(t, storage.TrackSession(ctx, tracked))
+	_, err = storage.RevokeSessions(ctx, "alice", now, time.Hour)
+	require.NoError(t, err)
+
+	// a later revocation does not shorten the lifetime of the key
+	_, err = storage.RevokeSessions(ctx, "alice", now.Add(time.Second), time.Hour)
+	require.NoError(t, err)
+
+	isRevokedAfter := func(d time.Duration, issuedAt time.Time) bool {
+		mr.FastForward(d)
+		storage := NewUserStateStorage(redis)
+		require.NoError(t, storage.loadRevokedTokens())
+		return storage.IsSessionRevoked("alice", issuedAt)
+	}
+	// a token which is not tracked is still revoked after the requested expiration
+	assert.True(t, isRevokedAfter(2*time.Hour, now.Add(-time.Minute)))
+	// the tracked token is revoked as long as it is valid
+	assert.True(t, isRevokedAfter(9*24*time.Hour, tracked.IssuedAt))
+	assert.False(t, isRevokedAfter(2*24*time.Hour, tracked.IssuedAt))
+}
+
+func TestUserStateStorage_TokensLastUsed(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	lastUsed := time.Unix(time.Now().Unix(), 0)
+	for name, storage := range map[string]*userStateStorage{"Redis": NewUserStateStorage(redis), "InMemory": NewUserStateStorage(nil)} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, storage.SetTokenLastUsed(context.Background(), "abc", lastUsed, time.Hour))
+
+			tokensLastUsed, err := storage.GetTokensLastUsed(context.Background(), []string{"abc", "def"})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]time.Time{"abc": lastUsed}, tokensLastUsed)
+		})
+	}
+}
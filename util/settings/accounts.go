@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 const (
@@ -46,6 +47,13 @@ type Token struct {
 	ID        string `json:"id"`
 	IssuedAt  int64  `json:"iat"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	// Scope restricts the token to a subset of the permissions of the account
+	Scope *v1alpha1.TokenScope `json:"scope,omitempty"`
+}
+
+// IsExpired returns true if the token has expired, e.g. because it was replaced by rotation and its grace period is over
+func (t *Token) IsExpired(now time.Time) bool {
+	return t.ExpiresAt > 0 && now.Unix() >= t.ExpiresAt
 }
 
 // Account holds local account information
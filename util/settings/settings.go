@@ -90,6 +90,8 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// TokenMaxLifetime is the maximum lifetime of API tokens of local accounts and project roles. Zero means unlimited.
+	TokenMaxLifetime time.Duration `json:"tokenMaxLifetime,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"`
 	// Content of UI Banner
@@ -477,6 +479,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// tokenMaxLifetimeKey is the key which specifies the maximum lifetime of API tokens
+	tokenMaxLifetimeKey = "users.tokens.maxLifetime"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUiCssURLKey designates the key for user-defined CSS URL for UI customization
@@ -1515,6 +1519,14 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 			settings.UserSessionDuration = *val
 		}
 	}
+	settings.TokenMaxLifetime = 0
+	if tokenMaxLifetimeStr, ok := argoCDCM.Data[tokenMaxLifetimeKey]; ok {
+		if val, err := timeutil.ParseDuration(tokenMaxLifetimeStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", tokenMaxLifetimeKey, err)
+		} else {
+			settings.TokenMaxLifetime = *val
+		}
+	}
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1778,6 +1790,27 @@ func (mgr *SettingsManager) SaveGPGPublicKeyData(ctx context.Context, gpgPublicK
 	return mgr.ResyncInformers()
 }
 
+// SaveSSHSigningKeyData saves the given SSH signing keys to the ConfigMap storing them
+func (mgr *SettingsManager) SaveSSHSigningKeyData(ctx context.Context, signingKeys map[string]string) error {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return err
+	}
+
+	keysCM, err := mgr.GetConfigMapByName(common.ArgoCDSigningKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	keysCM.Data = signingKeys
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(ctx, keysCM, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return mgr.ResyncInformers()
+}
+
 type SettingsManagerOpts func(mgs *SettingsManager)
 
 func WithRepoOrClusterChangedHandler(handler func()) SettingsManagerOpts {
@@ -1939,6 +1972,26 @@ func (a *ArgoCDSettings) UserInfoCacheExpiration() time.Duration {
 	return 0
 }
 
+// TokenExpiresIn returns the lifetime in seconds of a new API token which is requested to expire in the given number of
+// seconds, zero meaning never. If a maximum lifetime is configured, tokens which are requested to never expire expire
+// after the maximum lifetime, and tokens which are requested to live longer are rejected.
+func (a *ArgoCDSettings) TokenExpiresIn(expiresIn int64) (int64, error) {
+	if expiresIn < 0 {
+		return 0, fmt.Errorf("token expiration must not be negative")
+	}
+	maxLifetime := int64(a.TokenMaxLifetime.Seconds())
+	if maxLifetime <= 0 {
+		return expiresIn, nil
+	}
+	if expiresIn == 0 {
+		return maxLifetime, nil
+	}
+	if expiresIn > maxLifetime {
+		return 0, fmt.Errorf("token expiration of %v exceeds the maximum lifetime of %v", time.Duration(expiresIn)*time.Second, a.TokenMaxLifetime)
+	}
+	return expiresIn, nil
+}
+
 func (a *ArgoCDSettings) OAuth2ClientID() string {
 	if oidcConfig := a.OIDCConfig(); oidcConfig != nil {
 		return oidcConfig.ClientID
@@ -962,6 +962,26 @@ func TestSettingsManager_GetSettings(t *testing.T) {
 	})
 }
 
+func TestArgoCDSettings_TokenExpiresIn(t *testing.T) {
+	t.Run("Unlimited", func(t *testing.T) {
+		s := &ArgoCDSettings{}
+		expiresIn, err := s.TokenExpiresIn(0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), expiresIn)
+	})
+	t.Run("MaxLifetime", func(t *testing.T) {
+		s := &ArgoCDSettings{TokenMaxLifetime: time.Hour}
+		expiresIn, err := s.TokenExpiresIn(0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3600), expiresIn)
+		expiresIn, err = s.TokenExpiresIn(60)
+		require.NoError(t, err)
+		assert.Equal(t, int64(60), expiresIn)
+		_, err = s.TokenExpiresIn(7200)
+		assert.ErrorContains(t, err, "exceeds the maximum lifetime of 1h0m0s")
+	})
+}
+
 func TestGetOIDCConfig(t *testing.T) {
 	kubeClient := fake.NewSimpleClientset(
 		&v1.ConfigMap{
@@ -0,0 +1,282 @@
+package signing
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+)
+
+// Types of commit and chart signatures
+const (
+	SignatureTypeGPG      = "GPG"
+	SignatureTypeSSH      = "SSH"
+	SignatureTypeSigstore = "Sigstore"
+	SignatureTypeCosign   = "Cosign"
+)
+
+// Regular expression to match the result of the verification of an SSH signature, e.g.
+// Good "git" signature for alice@example.com with ED25519 key SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s
+var sshVerificationMatch = regexp.MustCompile(`^Good "git" signature(?: for (.+))? with ([A-Z0-9-]+) key (SHA256:\S+)$`)
+
+// Regular expression to match the certificate of a signature verified by gitsign
+var gitsignCertificateMatch = regexp.MustCompile(`^gitsign: Signature made using certificate ID (\S+)`)
+
+// Regular expression to match the identity of a good signature verified by gitsign, e.g.
+// gitsign: Good signature from [alice@example.com](https://github.com/login/oauth)
+var gitsignGoodSignatureMatch = regexp.MustCompile(`^gitsign: Good signature from \[(.*)\]\((.*)\)$`)
+
+// Regular expression to match a good cosign signature of an OCI chart made with a cosign key, e.g.
+// cosign: Good signature with key SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c
+var cosignGoodKeySignatureMatch = regexp.MustCompile(`^cosign: Good signature with key (SHA256:\S+)$`)
+
+// Regular expression to match a good keyless cosign signature of an OCI chart, e.g.
+// cosign: Good signature from [https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main](https://token.actions.githubusercontent.com)
+var cosignGoodIdentitySignatureMatch = regexp.MustCompile(`^cosign: Good signature from \[(.*)\]\((.*)\)$`)
+
+// VerifyResult is the result of the verification of a GnuPG, SSH or Sigstore commit signature, or of the signature of a
+// Helm chart
+type VerifyResult struct {
+	// Type of the signature
+	Type string
+	// Result of verification - one of the gpg.VerifyResult* values
+	Result string
+	// KeyID is the ID of the GnuPG key, the fingerprint of the SSH or cosign key or the ID of the Sigstore certificate
+	KeyID string
+	// Cipher of the key the signature was made with
+	Cipher string
+	// Identity is the owner of the GnuPG key, the principal of the SSH key or the subject of the Sigstore certificate
+	Identity string
+	// Issuer is the OIDC issuer of the Sigstore certificate
+	Issuer string
+	// Additional informational message
+	Message string
+}
+
+// String returns the signature info shown in the revision metadata
+func (r VerifyResult) String() string {
+	if r.Result == gpg.VerifyResultUnknown {
+		return fmt.Sprintf("UNKNOWN signature: %s", r.Message)
+	}
+	switch r.Type {
+	case SignatureTypeSSH:
+		if r.Identity != "" {
+			return fmt.Sprintf("%s signature from %s SSH key %s for %s", r.Result, r.Cipher, r.KeyID, r.Identity)
+		}
+		return fmt.Sprintf("%s signature from %s SSH key %s", r.Result, r.Cipher, r.KeyID)
+	case SignatureTypeSigstore:
+		if r.Identity != "" {
+			return fmt.Sprintf("%s signature from Sigstore identity %s issued by %s", r.Result, r.Identity, r.Issuer)
+		}
+		return fmt.Sprintf("%s Sigstore signature: %s", r.Result, r.Message)
+	case SignatureTypeCosign:
+		if r.KeyID != "" {
+			return fmt.Sprintf("%s signature from cosign key %s", r.Result, r.KeyID)
+		}
+		return fmt.Sprintf("%s cosign signature: %s", r.Result, r.Message)
+	default:
+		return fmt.Sprintf("%s signature from %s key %s", r.Result, r.Cipher, gpg.KeyID(r.KeyID))
+	}
+}
+
+// ParseVerification parses the output of the given signature verifier, which is one of the common.SignatureVerifier*
+// values: the output of "git verify-commit" for GnuPG, SSH and Sigstore signatures, as well as the verification output
+// of the provenance file or cosign signature of a Helm chart. The output of an empty verifier is parsed as the output
+// of GnuPG, since it is the only verifier of repository servers which do not report the verifier.
+func ParseVerification(verifier string, signature string) VerifyResult {
+	switch verifier {
+	case common.SignatureVerifierCosign:
+		return parseCosignVerification(signature)
+	case common.SignatureVerifierSSH:
+		return parseSSHVerification(signature)
+	case common.SignatureVerifierGitsign:
+		return parseGitsignVerification(signature)
+	case common.SignatureVerifierGPG, "":
+		r := gpg.ParseGitCommitVerification(signature)
+		return VerifyResult{
+			Type:     SignatureTypeGPG,
+			Result:   r.Result,
+			KeyID:    r.KeyID,
+			Cipher:   r.Cipher,
+			Identity: r.Identity,
+			Message:  r.Message,
+		}
+	default:
+		return VerifyResult{Result: gpg.VerifyResultUnknown, Message: fmt.Sprintf("Unknown signature verifier %s.", verifier)}
+	}
+}
+
+func parseSSHVerification(signature string) VerifyResult {
+	result := VerifyResult{Type: SignatureTypeSSH, Result: gpg.VerifyResultUnknown, Message: "Could not parse output of verify-commit, no verification data found."}
+	scanner := bufio.NewScanner(strings.NewReader(signature))
+	for linesParsed := 0; scanner.Scan() && linesParsed < gpg.MaxVerificationLinesToParse; linesParsed++ {
+		line := strings.TrimSpace(scanner.Text())
+		if m := sshVerificationMatch.FindStringSubmatch(line); m != nil {
+			result.Identity = m[1]
+			result.Cipher = m[2]
+			result.KeyID = m[3]
+			if result.Identity == "" {
+				// The signature is valid, but the key is not one of the configured signing keys
+				result.Result = gpg.VerifyResultInvalid
+				result.Message = "The key is not a configured SSH signing key."
+			} else {
+				result.Result = gpg.VerifyResultGood
+				result.Message = "Success verifying the commit signature."
+			}
+		} else if strings.HasPrefix(line, "Could not verify signature") || strings.HasPrefix(line, "Signature verification failed") {
+			result.Result = gpg.VerifyResultBad
+			result.Message = line
+			return result
+		}
+	}
+	return result
+}
+
+func parseGitsignVerification(signature string) VerifyResult {
+	result := VerifyResult{Type: SignatureTypeSigstore, Result: gpg.VerifyResultUnknown, Message: "Could not parse output of verify-commit, no verification data found."}
+	scanner := bufio.NewScanner(strings.NewReader(signature))
+	lastMessage := ""
+	for linesParsed := 0; scanner.Scan() && linesParsed < gpg.MaxVerificationLinesToParse; linesParsed++ {
+		line := strings.TrimSpace(scanner.Text())
+		if m := gitsignCertificateMatch.FindStringSubmatch(line); m != nil {
+			result.KeyID = m[1]
+		} else if m := gitsignGoodSignatureMatch.FindStringSubmatch(line); m != nil {
+			result.Result = gpg.VerifyResultGood
+			result.Identity = m[1]
+			result.Issuer = m[2]
+			result.Message = "Success verifying the commit signature."
+			return result
+		} else if line != "" {
+			lastMessage = line
+		}
+	}
+	if lastMessage != "" {
+		// gitsign reports verification failures, e.g. certificates not issued by the trust root, as errors
+		result.Result = gpg.VerifyResultBad
+		result.Message = lastMessage
+	}
+	return result
+}
+
+func parseCosignVerification(signature string) VerifyResult {
+	line := strings.TrimSpace(signature)
+	if m := cosignGoodKeySignatureMatch.FindStringSubmatch(line); m != nil {
+		return VerifyResult{Type: SignatureTypeCosign, Result: gpg.VerifyResultGood, KeyID: m[1], Message: "Success verifying the chart signature."}
+	}
+	if m := cosignGoodIdentitySignatureMatch.FindStringSubmatch(line); m != nil {
+		// Keyless signatures are verified against the Sigstore identities
+		return VerifyResult{Type: SignatureTypeSigstore, Result: gpg.VerifyResultGood, Identity: m[1], Issuer: m[2], Message: "Success verifying the chart signature."}
+	}
+	return VerifyResult{Type: SignatureTypeCosign, Result: gpg.VerifyResultBad, Message: strings.TrimSpace(strings.TrimPrefix(line, "cosign:"))}
+}
+
+// IsKeyAllowed returns true if the given verification result is a good signature made with one of the given keys
+func IsKeyAllowed(keys []appsv1.SignatureKey, result VerifyResult) bool {
+	if result.Result != gpg.VerifyResultGood {
+		return false
+	}
+	for _, k := range keys {
+		switch {
+		case k.SSH != nil:
+			if result.Type == SignatureTypeSSH && k.SSH.Fingerprint == result.KeyID && (k.SSH.Principal == "" || hasPrincipal(result.Identity, k.SSH.Principal)) {
+				return true
+			}
+		case k.Sigstore != nil:
+			if result.Type == SignatureTypeSigstore && k.Sigstore.Matches(result.Issuer, result.Identity) {
+				return true
+			}
+		case k.Cosign != nil:
+			if result.Type == SignatureTypeCosign && k.Cosign.Fingerprint == result.KeyID {
+				return true
+			}
+		default:
+			if result.Type == SignatureTypeGPG && gpg.KeyID(k.KeyID) == gpg.KeyID(result.KeyID) && gpg.KeyID(k.KeyID) != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasPrincipal returns true if the given comma-separated list of principals contains the given principal
+func hasPrincipal(principals string, principal string) bool {
+	for _, p := range strings.Split(principals, ",") {
+		if p == principal {
+			return true
+		}
+	}
+	return false
+}
+
+// DescribeSignatureKey returns a human readable representation of the given signature key
+func DescribeSignatureKey(k appsv1.SignatureKey) string {
+	switch {
+	case k.SSH != nil:
+		if k.SSH.Principal != "" {
+			return fmt.Sprintf("%s (%s)", k.SSH.Fingerprint, k.SSH.Principal)
+		}
+		return k.SSH.Fingerprint
+	case k.Sigstore != nil:
+		return fmt.Sprintf("%s (%s)", k.Sigstore.SubjectRegex, k.Sigstore.Issuer)
+	case k.Cosign != nil:
+		return fmt.Sprintf("%s (cosign)", k.Cosign.Fingerprint)
+	default:
+		return k.KeyID
+	}
+}
+
+// ParseSSHSigningKeys parses the SSH signing keys in the given data, which contains entries of an SSH allowed signers
+// file or SSH public keys, one per line. Public keys are allowed to sign for any principal.
+func ParseSSHSigningKeys(data string) ([]*appsv1.SSHSigningKey, error) {
+	var keys []*appsv1.SSHSigningKey
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		principals := "*"
+		entry := line
+		pubKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil || len(options) > 0 {
+			// Not a plain public key, so the line must start with the principals of an allowed signers entry
+			fields := strings.SplitN(line, " ", 2)
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid SSH signing key entry: %s", line)
+			}
+			principals = fields[0]
+			pubKey, _, _, _, err = ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(fields[1])))
+			if err != nil {
+				return nil, fmt.Errorf("invalid SSH signing key entry for %s: %w", principals, err)
+			}
+		} else {
+			entry = principals + " " + line
+		}
+		keys = append(keys, &appsv1.SSHSigningKey{
+			Fingerprint: ssh.FingerprintSHA256(pubKey),
+			KeyType:     pubKey.Type(),
+			Principals:  principals,
+			KeyData:     entry,
+		})
+	}
+	return keys, nil
+}
+
+// SSHSigningKeyName returns the name of the entry of the SSH signing key with the given fingerprint in the ConfigMap
+// storing the signing keys. The characters of base64 encoded fingerprints which are not allowed in ConfigMap keys are
+// replaced by the ones of URL-safe base64 encoding.
+func SSHSigningKeyName(fingerprint string) string {
+	return strings.NewReplacer(":", ".", "+", "-", "/", "_").Replace(fingerprint)
+}
+
+// SSHSigningKeyFingerprint returns the fingerprint of the SSH signing key with the given name in the ConfigMap
+// storing the signing keys
+func SSHSigningKeyFingerprint(name string) string {
+	return strings.NewReplacer(".", ":", "-", "+", "_", "/").Replace(name)
+}
@@ -0,0 +1,171 @@
+package signing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+)
+
+const (
+	aliceKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINqdiVOvGAKmjvKo1pggr/avARgEMl4LhHTCvgRWfN49 alice"
+	aliceFingerprint = "SHA256:qvIK3CoIXYte9e2oPn8W39/WgCKQAb8CvQSfnMf0j6s"
+	bobKey           = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBPpagSUWO1/A58tkBsQCLRHIV3qmtsRvVoeRZqiVySRrR/kNCdks/X91gUmscKUy3/eTngXJP0WOM+X/b6oJRZA= bob"
+	bobFingerprint   = "SHA256:C4U93tAg/FKuOHXgckjCTjhcbCsmsKNahlhFu8o1y50"
+
+	cosignFingerprint = "SHA256:oB4DOnzX2+LqoPHEEeiTWfZwWtHuGXsSyZ0Nd26oW7c"
+)
+
+func TestParseVerification(t *testing.T) {
+	t.Run("GPG", func(t *testing.T) {
+		out, err := os.ReadFile("../gpg/testdata/good_signature.txt")
+		require.NoError(t, err)
+		r := ParseVerification(common.SignatureVerifierGPG, string(out))
+		assert.Equal(t, SignatureTypeGPG, r.Type)
+		assert.Equal(t, gpg.VerifyResultGood, r.Result)
+		assert.Equal(t, "Good signature from RSA key 4AEE18F83AFDEB23", r.String())
+	})
+
+	t.Run("SSH", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierSSH, `Good "git" signature for alice@example.com with ED25519 key `+aliceFingerprint+"\n")
+		assert.Equal(t, VerifyResult{
+			Type:     SignatureTypeSSH,
+			Result:   gpg.VerifyResultGood,
+			KeyID:    aliceFingerprint,
+			Cipher:   "ED25519",
+			Identity: "alice@example.com",
+			Message:  "Success verifying the commit signature.",
+		}, r)
+		assert.Equal(t, "Good signature from ED25519 SSH key "+aliceFingerprint+" for alice@example.com", r.String())
+	})
+
+	t.Run("SSHNotConfigured", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierSSH, `Good "git" signature with ED25519 key `+aliceFingerprint+"\nNo principal matched.\n")
+		assert.Equal(t, gpg.VerifyResultInvalid, r.Result)
+		assert.Equal(t, aliceFingerprint, r.KeyID)
+		assert.Empty(t, r.Identity)
+	})
+
+	t.Run("SSHBad", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierSSH, "Signature verification failed: incorrect signature\nCould not verify signature.\n")
+		assert.Equal(t, SignatureTypeSSH, r.Type)
+		assert.Equal(t, gpg.VerifyResultBad, r.Result)
+	})
+
+	t.Run("Sigstore", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierGitsign, `tlog index: 123
+gitsign: Signature made using certificate ID 0xabcdef | CN=sigstore-intermediate,O=sigstore.dev
+gitsign: Good signature from [alice@example.com](https://github.com/login/oauth)
+Validated Git signature: true
+Validated Rekor entry: true
+`)
+		assert.Equal(t, VerifyResult{
+			Type:     SignatureTypeSigstore,
+			Result:   gpg.VerifyResultGood,
+			KeyID:    "0xabcdef",
+			Identity: "alice@example.com",
+			Issuer:   "https://github.com/login/oauth",
+			Message:  "Success verifying the commit signature.",
+		}, r)
+		assert.Equal(t, "Good signature from Sigstore identity alice@example.com issued by https://github.com/login/oauth", r.String())
+	})
+
+	t.Run("SigstoreBad", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierGitsign, "error verifying signature\ngitsign: failed to verify signature: x509: certificate signed by unknown authority\n")
+		assert.Equal(t, SignatureTypeSigstore, r.Type)
+		assert.Equal(t, gpg.VerifyResultBad, r.Result)
+		assert.Equal(t, "gitsign: failed to verify signature: x509: certificate signed by unknown authority", r.Message)
+	})
+
+	t.Run("Cosign", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierCosign, "cosign: Good signature with key "+cosignFingerprint)
+		assert.Equal(t, SignatureTypeCosign, r.Type)
+		assert.Equal(t, gpg.VerifyResultGood, r.Result)
+		assert.Equal(t, cosignFingerprint, r.KeyID)
+		assert.Equal(t, "Good signature from cosign key "+cosignFingerprint, r.String())
+	})
+
+	t.Run("CosignKeyless", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierCosign, "cosign: Good signature from [alice@example.com](https://github.com/login/oauth)")
+		assert.Equal(t, SignatureTypeSigstore, r.Type)
+		assert.Equal(t, gpg.VerifyResultGood, r.Result)
+		assert.Equal(t, "alice@example.com", r.Identity)
+		assert.Equal(t, "https://github.com/login/oauth", r.Issuer)
+	})
+
+	t.Run("CosignBad", func(t *testing.T) {
+		r := ParseVerification(common.SignatureVerifierCosign, "cosign: Error: no matching signatures: invalid signature")
+		assert.Equal(t, SignatureTypeCosign, r.Type)
+		assert.Equal(t, gpg.VerifyResultBad, r.Result)
+		assert.Equal(t, "Error: no matching signatures: invalid signature", r.Message)
+	})
+
+	t.Run("VerifierSelectsParser", func(t *testing.T) {
+		// The output of a GnuPG verification is not parsed as a good SSH or Sigstore signature, whatever it contains
+		out := `gpg: Good "git" signature for alice@example.com with ED25519 key ` + aliceFingerprint + "\ngitsign: Good signature from [alice@example.com](https://github.com/login/oauth)\n"
+		r := ParseVerification(common.SignatureVerifierGPG, out)
+		assert.Equal(t, SignatureTypeGPG, r.Type)
+		assert.NotEqual(t, gpg.VerifyResultGood, r.Result)
+
+		r = ParseVerification(common.SignatureVerifierSSH, "gitsign: Good signature from [alice@example.com](https://github.com/login/oauth)")
+		assert.Equal(t, SignatureTypeSSH, r.Type)
+		assert.NotEqual(t, gpg.VerifyResultGood, r.Result)
+	})
+
+	t.Run("UnknownVerifier", func(t *testing.T) {
+		r := ParseVerification("x509", `Good "git" signature for alice@example.com with ED25519 key `+aliceFingerprint)
+		assert.Equal(t, gpg.VerifyResultUnknown, r.Result)
+		assert.Equal(t, "UNKNOWN signature: Unknown signature verifier x509.", r.String())
+	})
+}
+
+func TestIsKeyAllowed(t *testing.T) {
+	keys := []appsv1.SignatureKey{
+		{KeyID: "4AEE18F83AFDEB23"},
+		{SSH: &appsv1.SSHSignatureKey{Fingerprint: aliceFingerprint, Principal: "alice@example.com"}},
+		{SSH: &appsv1.SSHSignatureKey{Fingerprint: bobFingerprint}},
+		{Sigstore: &appsv1.SigstoreIdentity{Issuer: "https://github.com/login/oauth", SubjectRegex: ".*@example\\.com"}},
+		{Cosign: &appsv1.CosignSignatureKey{Fingerprint: cosignFingerprint}},
+	}
+	good := func(typ, keyID, identity, issuer string) VerifyResult {
+		return VerifyResult{Type: typ, Result: gpg.VerifyResultGood, KeyID: keyID, Identity: identity, Issuer: issuer}
+	}
+
+	assert.True(t, IsKeyAllowed(keys, good(SignatureTypeGPG, "5DE3E0509C47EA3CF04A42D34AEE18F83AFDEB23", "", "")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeGPG, "FDC79815400D88A9", "", "")))
+	assert.True(t, IsKeyAllowed(keys, good(SignatureTypeSSH, aliceFingerprint, "alice@example.com,alice@example.org", "")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeSSH, aliceFingerprint, "*", "")))
+	assert.True(t, IsKeyAllowed(keys, good(SignatureTypeSSH, bobFingerprint, "*", "")))
+	assert.True(t, IsKeyAllowed(keys, good(SignatureTypeSigstore, "", "alice@example.com", "https://github.com/login/oauth")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeSigstore, "", "alice@example.com.evil.org", "https://github.com/login/oauth")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeSigstore, "", "alice@example.com", "https://accounts.google.com")))
+	assert.False(t, IsKeyAllowed(keys, VerifyResult{Type: SignatureTypeSSH, Result: gpg.VerifyResultInvalid, KeyID: bobFingerprint}))
+	assert.True(t, IsKeyAllowed(keys, good(SignatureTypeCosign, cosignFingerprint, "", "")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeCosign, aliceFingerprint, "", "")))
+	assert.False(t, IsKeyAllowed(keys, good(SignatureTypeSSH, cosignFingerprint, "*", "")))
+}
+
+func TestParseSSHSigningKeys(t *testing.T) {
+	keys, err := ParseSSHSigningKeys("# allowed signers\n" + aliceKey + "\n\nbob@example.com,bob@example.org namespaces=\"git\" " + bobKey + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, []*appsv1.SSHSigningKey{
+		{Fingerprint: aliceFingerprint, KeyType: "ssh-ed25519", Principals: "*", KeyData: "* " + aliceKey},
+		{Fingerprint: bobFingerprint, KeyType: "ecdsa-sha2-nistp256", Principals: "bob@example.com,bob@example.org", KeyData: "bob@example.com,bob@example.org namespaces=\"git\" " + bobKey},
+	}, keys)
+
+	_, err = ParseSSHSigningKeys("alice@example.com ssh-ed25519 invalid")
+	require.Error(t, err)
+	_, err = ParseSSHSigningKeys("garbage")
+	require.Error(t, err)
+}
+
+func TestSSHSigningKeyName(t *testing.T) {
+	name := SSHSigningKeyName("SHA256:C4U93tAg/FKuOHXgckjCTjhcbCsmsKNahlhFu8o1+50")
+	assert.Equal(t, "SHA256.C4U93tAg_FKuOHXgckjCTjhcbCsmsKNahlhFu8o1-50", name)
+	assert.Equal(t, "SHA256:C4U93tAg/FKuOHXgckjCTjhcbCsmsKNahlhFu8o1+50", SSHSigningKeyFingerprint(name))
+}